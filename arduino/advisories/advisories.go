@@ -0,0 +1,171 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package advisories checks the installed libraries and platforms against
+// feeds of security and deprecation notices.
+package advisories
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+var tr = i18n.Tr
+
+// Feed is a list of advisories
+type Feed struct {
+	Advisories []*Advisory `json:"advisories"`
+}
+
+// Advisory is a security or deprecation notice about a range of versions of a
+// library or of a platform.
+type Advisory struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Severity string `json:"severity,omitempty"`
+	Library  string `json:"library,omitempty"`
+	Platform string `json:"platform,omitempty"`
+	// Versions is the range of the affected versions, in the format of the
+	// library dependencies (e.g. `>=1.0.0 && <1.2.3`). All the versions are
+	// affected if empty.
+	Versions string `json:"versions,omitempty"`
+	Summary  string `json:"summary"`
+	URL      string `json:"url,omitempty"`
+	FixedIn  string `json:"fixed_in,omitempty"`
+
+	versions semver.Constraint
+}
+
+// LoadFile loads the feed from the JSON file
+func LoadFile(file *paths.Path) (*Feed, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var feed Feed
+	if err := json.Unmarshal(data, &feed); err != nil {
+		return nil, fmt.Errorf(tr("invalid advisories feed %[1]s: %[2]s"), file, err)
+	}
+	for _, advisory := range feed.Advisories {
+		if (advisory.Library == "") == (advisory.Platform == "") {
+			return nil, fmt.Errorf(tr("invalid advisories feed %[1]s: advisory %[2]s must have either a library or a platform"), file, advisory.ID)
+		}
+		constraint, err := semver.ParseConstraint(advisory.Versions)
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid advisories feed %[1]s: invalid versions of advisory %[2]s: %[3]s"), file, advisory.ID, err)
+		}
+		advisory.versions = constraint
+	}
+	return &feed, nil
+}
+
+// FeedFile returns the local file of the feed at feedURL: the file itself
+// for the `file://` URLs, otherwise the copy downloaded in dir.
+func FeedFile(feedURL string, dir *paths.Path) (*paths.Path, error) {
+	u, err := url.Parse(feedURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "file" {
+		return paths.New(u.Path), nil
+	}
+	fileName, err := (&resources.IndexResource{URL: u}).IndexFileName()
+	if err != nil {
+		return nil, err
+	}
+	return dir.Join(fileName), nil
+}
+
+// Load loads and merges the feeds at the urls, the feeds that have not been
+// downloaded in dir yet are skipped.
+func Load(urls []string, dir *paths.Path) (*Feed, error) {
+	res := &Feed{}
+	for _, feedURL := range urls {
+		file, err := FeedFile(feedURL, dir)
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid advisories feed URL %[1]s: %[2]s"), feedURL, err)
+		}
+		if !file.Exist() {
+			continue
+		}
+		feed, err := LoadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		res.Advisories = append(res.Advisories, feed.Advisories...)
+	}
+	return res, nil
+}
+
+// matches returns true if the advisory affects the version. Only the
+// advisories for all the versions affect the libraries without a version.
+func (a *Advisory) matches(version *semver.Version) bool {
+	if version == nil {
+		return a.Versions == ""
+	}
+	return a.versions.Match(version)
+}
+
+// ToRPC converts the advisory into an rpc.Advisory about the given version
+func (a *Advisory) ToRPC(version string) *rpc.Advisory {
+	return &rpc.Advisory{
+		Id:       a.ID,
+		Type:     a.Type,
+		Severity: a.Severity,
+		Summary:  a.Summary,
+		Url:      a.URL,
+		FixedIn:  a.FixedIn,
+		Library:  a.Library,
+		Platform: a.Platform,
+		Version:  version,
+	}
+}
+
+// Library returns the advisories affecting the version of the library
+func (f *Feed) Library(name string, version *semver.Version) []*rpc.Advisory {
+	res := []*rpc.Advisory{}
+	if f == nil {
+		return res
+	}
+	for _, advisory := range f.Advisories {
+		if strings.EqualFold(advisory.Library, name) && advisory.matches(version) {
+			res = append(res, advisory.ToRPC(version.String()))
+		}
+	}
+	return res
+}
+
+// Platform returns the advisories affecting the version of the platform
+// with the given ID (`packager:arch`).
+func (f *Feed) Platform(id string, version *semver.Version) []*rpc.Advisory {
+	res := []*rpc.Advisory{}
+	if f == nil {
+		return res
+	}
+	for _, advisory := range f.Advisories {
+		if advisory.Platform == id && advisory.matches(version) {
+			res = append(res, advisory.ToRPC(version.String()))
+		}
+	}
+	return res
+}
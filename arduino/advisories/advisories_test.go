@@ -0,0 +1,70 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package advisories
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestFeed(t *testing.T) {
+	feed, err := LoadFile(paths.New("testdata", "advisories.json"))
+	require.NoError(t, err)
+	require.Len(t, feed.Advisories, 2)
+
+	res := feed.Library("acmewifi", semver.MustParse("1.3.0"))
+	require.Len(t, res, 1)
+	require.Equal(t, "ACME-2023-0001", res[0].GetId())
+	require.Equal(t, "1.3.0", res[0].GetVersion())
+	require.Equal(t, "1.4.2", res[0].GetFixedIn())
+	require.Empty(t, feed.Library("AcmeWiFi", semver.MustParse("1.4.2")))
+	require.Empty(t, feed.Library("AcmeWiFi", nil))
+	require.Empty(t, feed.Library("Other", semver.MustParse("1.3.0")))
+
+	res = feed.Platform("acme:avr", semver.MustParse("2.0.0"))
+	require.Len(t, res, 1)
+	require.Equal(t, "deprecation", res[0].GetType())
+	require.Len(t, feed.Platform("acme:avr", nil), 1)
+	require.Empty(t, feed.Platform("acme:megaavr", semver.MustParse("2.0.0")))
+
+	var noFeed *Feed
+	require.Empty(t, noFeed.Library("AcmeWiFi", semver.MustParse("1.3.0")))
+}
+
+func TestLoad(t *testing.T) {
+	dir := paths.New(t.TempDir())
+	require.NoError(t, paths.New("testdata", "advisories.json").CopyTo(dir.Join("acme.json")))
+
+	feed, err := Load([]string{
+		"https://acme.example.com/acme.json",
+		"https://example.com/not_downloaded_yet.json",
+		"file://" + paths.New("testdata", "advisories.json").Canonical().String(),
+	}, dir)
+	require.NoError(t, err)
+	require.Len(t, feed.Advisories, 4)
+
+	invalid := dir.Join("invalid.json")
+	require.NoError(t, invalid.WriteFile([]byte(`{"advisories": [{"id": "X-1", "library": "Lib", "versions": ">>1.0"}]}`)))
+	_, err = Load([]string{"https://example.com/invalid.json"}, dir)
+	require.ErrorContains(t, err, "invalid versions of advisory X-1")
+
+	require.NoError(t, invalid.WriteFile([]byte(`{"advisories": [{"id": "X-2", "library": "Lib", "platform": "a:b"}]}`)))
+	_, err = Load([]string{"https://example.com/invalid.json"}, dir)
+	require.ErrorContains(t, err, "advisory X-2 must have either a library or a platform")
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/builder/internal/utils"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	arduinoutils "github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/go-paths-helper"
 	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
@@ -35,7 +36,7 @@ type buildOptions struct {
 	hardwareDirs              paths.PathList
 	builtInToolsDirs          paths.PathList
 	otherLibrariesDirs        paths.PathList
-	builtInLibrariesDirs      *paths.Path
+	builtInLibrariesDirs      paths.PathList
 	buildPath                 *paths.Path
 	runtimePlatformPath       *paths.Path
 	buildCorePath             *paths.Path
@@ -47,8 +48,8 @@ type buildOptions struct {
 
 // newBuildOptions fixdoc
 func newBuildOptions(
-	hardwareDirs, builtInToolsDirs, otherLibrariesDirs paths.PathList,
-	builtInLibrariesDirs, buildPath *paths.Path,
+	hardwareDirs, builtInToolsDirs, otherLibrariesDirs, builtInLibrariesDirs paths.PathList,
+	buildPath *paths.Path,
 	sketch *sketch.Sketch,
 	customBuildProperties []string,
 	fqbn *cores.FQBN,
@@ -66,8 +67,8 @@ func newBuildOptions(
 	opts.Set("customBuildProperties", strings.Join(customBuildProperties, ","))
 	opts.Set("compiler.optimization_flags", compilerOptimizationFlags)
 
-	if builtInLibrariesDirs != nil {
-		opts.Set("builtInLibrariesFolders", builtInLibrariesDirs.String())
+	if len(builtInLibrariesDirs) > 0 {
+		opts.Set("builtInLibrariesFolders", strings.Join(builtInLibrariesDirs.AsStrings(), ","))
 	}
 
 	absPath := sketch.FullPath.Parent()
@@ -81,6 +82,25 @@ func newBuildOptions(
 	}
 	opts.Set("additionalFiles", strings.Join(additionalFilesRelative, ","))
 
+	// Rebuild everything if the library overrides of the sketch project file change
+	if sketch.Project != nil && len(sketch.Project.LibraryOverrides) > 0 {
+		var libraryOverrides []string
+		for _, name := range sketch.Project.LibraryOverrides.LibraryNames() {
+			libraryOverrides = append(libraryOverrides, name+":"+sketch.Project.LibraryOverrides[name].CompilerFlags())
+		}
+		opts.Set("libraryOverrides", strings.Join(libraryOverrides, ","))
+	}
+
+	// Rebuild everything if the library selections change, since different
+	// libraries may be used
+	if sketch.Project != nil && len(sketch.Project.LibrarySelection) > 0 {
+		var librarySelection []string
+		for _, header := range sketch.Project.LibrarySelection.Headers() {
+			librarySelection = append(librarySelection, header+":"+sketch.Project.LibrarySelection[header].String())
+		}
+		opts.Set("librarySelection", strings.Join(librarySelection, ","))
+	}
+
 	return &buildOptions{
 		currentOptions:            opts,
 		hardwareDirs:              hardwareDirs,
@@ -102,17 +122,17 @@ func (b *Builder) createBuildOptionsJSON() error {
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	return b.buildOptions.buildPath.Join("build.options.json").WriteFile(buildOptionsJSON)
+	return arduinoutils.LongPath(b.buildOptions.buildPath.Join("build.options.json")).WriteFile(buildOptionsJSON)
 }
 
 func (b *Builder) wipeBuildPath() error {
 	// FIXME: this should go outside legacy and behind a `logrus` call so users can
 	// control when this should be printed.
 	// logger.Println(constants.LOG_LEVEL_INFO, constants.MSG_BUILD_OPTIONS_CHANGED + constants.MSG_REBUILD_ALL)
-	if err := b.buildOptions.buildPath.RemoveAll(); err != nil {
+	if err := arduinoutils.LongPath(b.buildOptions.buildPath).RemoveAll(); err != nil {
 		return errors.WithMessage(err, tr("cleaning build path"))
 	}
-	if err := b.buildOptions.buildPath.MkdirAll(); err != nil {
+	if err := arduinoutils.LongPath(b.buildOptions.buildPath).MkdirAll(); err != nil {
 		return errors.WithMessage(err, tr("cleaning build path"))
 	}
 	return nil
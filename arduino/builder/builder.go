@@ -16,12 +16,15 @@
 package builder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/builder/internal/compilation"
 	"github.com/arduino/arduino-cli/arduino/builder/internal/detector"
@@ -32,17 +35,40 @@ import (
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	arduinoutils "github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/executils"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 )
 
+// maxBuildPathSuffixLength is the estimated maximum length of the paths of the
+// files created inside the build path
+const maxBuildPathSuffixLength = 100
+
 // ErrSketchCannotBeLocatedInBuildPath fixdoc
 var ErrSketchCannotBeLocatedInBuildPath = errors.New("sketch cannot be located in build path")
 
+// InvalidBuildPropertiesError is returned when the custom build properties
+// of the build can't be parsed.
+type InvalidBuildPropertiesError struct {
+	Cause error
+}
+
+func (e *InvalidBuildPropertiesError) Error() string {
+	return tr("invalid build properties: %v", e.Cause)
+}
+
+// Unwrap returns the parsing error
+func (e *InvalidBuildPropertiesError) Unwrap() error {
+	return e.Cause
+}
+
 // Builder is a Sketch builder.
 type Builder struct {
+	// The context of the build, the running processes are killed when it's canceled
+	ctx context.Context
+
 	sketch          *sketch.Sketch
 	buildProperties *properties.Map
 
@@ -90,6 +116,29 @@ type Builder struct {
 	buildOptions *buildOptions
 
 	libsDetector *detector.SketchLibrariesDetector
+
+	// Wall time spent in the build phases
+	timings *BuildTimings
+
+	// The compiler cache, nil if not enabled
+	ccache *ccache
+
+	// The command prefixed to the compilations, e.g. distcc, nil if not set
+	compileWrapper []string
+
+	// The max time a tool of the build can run, 0 means no limit
+	toolTimeout time.Duration
+
+	// Groups of files with names differing only by case
+	fileNameCaseCollisions []paths.PathList
+
+	// The libraries used by the sketch not declaring the board architecture
+	incompatibleLibraries []*IncompatibleLibrary
+	// Set to true to fail the build on the incompatible libraries
+	failOnIncompatibleLibraries bool
+
+	// Precompiled libraries linked in the build
+	usedPrecompiledLibraries []*usedPrecompiledLibrary
 }
 
 // buildArtifacts contains the result of various build
@@ -107,6 +156,7 @@ type buildArtifacts struct {
 
 // NewBuilder creates a sketch Builder.
 func NewBuilder(
+	ctx context.Context,
 	sk *sketch.Sketch,
 	boardBuildProperties *properties.Map,
 	buildPath *paths.Path,
@@ -115,7 +165,7 @@ func NewBuilder(
 	jobs int,
 	requestBuildProperties []string,
 	hardwareDirs, builtInToolsDirs, otherLibrariesDirs paths.PathList,
-	builtInLibrariesDirs *paths.Path,
+	builtInLibrariesDirs paths.PathList,
 	fqbn *cores.FQBN,
 	clean bool,
 	sourceOverrides map[string]string,
@@ -124,7 +174,7 @@ func NewBuilder(
 	useCachedLibrariesResolution bool,
 	librariesManager *librariesmanager.LibrariesManager,
 	libraryDirs paths.PathList,
-	stdout, stderr io.Writer, verbose bool, warningsLevel string,
+	stdout, stderr io.Writer, verbosity int, verbosePhases []string, quiet bool, warningsLevel string,
 	progresCB rpc.TaskProgressCB,
 ) (*Builder, error) {
 	buildProperties := properties.NewMap()
@@ -154,7 +204,7 @@ func NewBuilder(
 	// Add user provided custom build properties
 	customBuildProperties, err := properties.LoadFromSlice(requestBuildProperties)
 	if err != nil {
-		return nil, fmt.Errorf("invalid build properties: %w", err)
+		return nil, &InvalidBuildPropertiesError{Cause: err}
 	}
 	buildProperties.Merge(customBuildProperties)
 	customBuildPropertiesArgs := append(requestBuildProperties, "build.warn_data_percentage=75")
@@ -176,11 +226,11 @@ func NewBuilder(
 		return nil, ErrSketchCannotBeLocatedInBuildPath
 	}
 
-	logger := logger.New(stdout, stderr, verbose, warningsLevel)
+	logger := logger.New(stdout, stderr, verbosity, verbosePhases, quiet, warningsLevel)
 	libsManager, libsResolver, verboseOut, err := detector.LibrariesLoader(
 		useCachedLibrariesResolution, librariesManager,
 		builtInLibrariesDirs, libraryDirs, otherLibrariesDirs,
-		actualPlatform, targetPlatform,
+		sk.FullPath, actualPlatform, targetPlatform,
 	)
 	if err != nil {
 		return nil, err
@@ -188,8 +238,18 @@ func NewBuilder(
 	if logger.Verbose() {
 		logger.Warn(string(verboseOut))
 	}
+	selectLibraries(libsResolver, sk, logger)
+	if runtime.GOOS == "windows" {
+		// The toolchains are usually not aware of long paths: leave some room for the
+		// object files paths, that are nested in the build path.
+		if longest := buildPath.Join(strings.Repeat("x", maxBuildPathSuffixLength)); arduinoutils.IsPathTooLongForWindows(longest) {
+			logger.Warn(tr("The build path %s is very long: the compilation may fail if the paths of the build files exceed %d characters. Use --build-path to set a shorter build path.",
+				buildPath, arduinoutils.WindowsMaxPath))
+		}
+	}
 
 	return &Builder{
+		ctx:                           ctx,
 		sketch:                        sk,
 		buildProperties:               buildProperties,
 		buildPath:                     buildPath,
@@ -207,6 +267,7 @@ func NewBuilder(
 		Progress:                      progress.New(progresCB),
 		executableSectionsSize:        []ExecutableSectionSize{},
 		buildArtifacts:                &buildArtifacts{},
+		timings:                       &BuildTimings{},
 		targetPlatform:                targetPlatform,
 		actualPlatform:                actualPlatform,
 		libsDetector: detector.NewSketchLibrariesDetector(
@@ -244,6 +305,15 @@ func (b *Builder) ExecutableSectionsSize() ExecutablesFileSections {
 	return b.executableSectionsSize
 }
 
+// Timings returns the wall time spent in the build phases and in the
+// compilation of the source files.
+func (b *Builder) Timings() *BuildTimings {
+	if b.ccache != nil {
+		b.timings.setCcacheStatistics(b.ccache.statistics())
+	}
+	return b.timings
+}
+
 // ImportedLibraries fixdoc
 func (b *Builder) ImportedLibraries() libraries.List {
 	return b.libsDetector.ImportedLibraries()
@@ -268,6 +338,10 @@ func (b *Builder) preprocess() error {
 		return err
 	}
 
+	if err := b.checkToolchain(); err != nil {
+		return err
+	}
+
 	if err := b.wipeBuildPathIfBuildOptionsChanged(); err != nil {
 		return err
 	}
@@ -286,27 +360,37 @@ func (b *Builder) preprocess() error {
 	}
 	b.Progress.CompleteStep()
 
-	b.logIfVerbose(false, tr("Detecting libraries used..."))
-	err := b.libsDetector.FindIncludes(
-		b.buildPath,
-		b.buildProperties.GetPath("build.core.path"),
-		b.buildProperties.GetPath("build.variant.path"),
-		b.sketchBuildPath,
-		b.sketch,
-		b.librariesBuildPath,
-		b.buildProperties,
-		b.targetPlatform.Platform.Architecture,
-	)
+	b.logger.Section(PhaseLibrariesDetection, tr("Detecting libraries used..."))
+	err := b.timings.measurePhase(PhaseLibrariesDetection, func() error {
+		return b.libsDetector.FindIncludes(
+			b.ctx,
+			b.buildPath,
+			b.buildProperties.GetPath("build.core.path"),
+			b.buildProperties.GetPath("build.variant.path"),
+			b.sketchBuildPath,
+			b.sketch,
+			b.librariesBuildPath,
+			b.buildProperties,
+			b.targetPlatform.Platform.Architecture,
+		)
+	})
 	if err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
 
-	b.warnAboutArchIncompatibleLibraries(b.libsDetector.ImportedLibraries())
+	if err := b.checkFileNameCaseCollisions(b.libsDetector.ImportedLibraries()); err != nil {
+		return err
+	}
+	if err := b.checkArchIncompatibleLibraries(b.libsDetector.ImportedLibraries()); err != nil {
+		return err
+	}
 	b.Progress.CompleteStep()
 
-	b.logIfVerbose(false, tr("Generating function prototypes..."))
-	if err := b.preprocessSketch(b.libsDetector.IncludeFolders()); err != nil {
+	b.logger.Section(PhasePrototypeGeneration, tr("Generating function prototypes..."))
+	if err := b.timings.measurePhase(PhasePrototypeGeneration, func() error {
+		return b.preprocessSketch(b.libsDetector.IncludeFolders())
+	}); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
@@ -314,20 +398,9 @@ func (b *Builder) preprocess() error {
 	return nil
 }
 
-func (b *Builder) logIfVerbose(warn bool, msg string) {
-	if !b.logger.Verbose() {
-		return
-	}
-	if warn {
-		b.logger.Warn(msg)
-		return
-	}
-	b.logger.Info(msg)
-}
-
 // Build fixdoc
 func (b *Builder) Build() error {
-	b.Progress.AddSubSteps(6 /** preprocess **/ + 21 /** build **/)
+	b.Progress.AddSubSteps(6 /** preprocess **/ + 22 /** build **/)
 	defer b.Progress.RemoveSubSteps()
 
 	if err := b.preprocess(); err != nil {
@@ -336,6 +409,9 @@ func (b *Builder) Build() error {
 
 	buildErr := b.build()
 
+	// The libraries used are reported in the verbose output of the
+	// libraries detection
+	b.logger.SetPhase(PhaseLibrariesDetection)
 	b.libsDetector.PrintUsedAndNotUsedLibraries(buildErr != nil)
 	b.Progress.CompleteStep()
 
@@ -350,7 +426,8 @@ func (b *Builder) Build() error {
 	}
 	b.Progress.CompleteStep()
 
-	if err := b.size(); err != nil {
+	b.logger.SetPhase(PhaseSize)
+	if err := b.timings.measurePhase(PhaseSize, b.size); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
@@ -360,13 +437,15 @@ func (b *Builder) Build() error {
 
 // Build fixdoc
 func (b *Builder) build() error {
-	b.logIfVerbose(false, tr("Compiling sketch..."))
+	b.logger.Section(PhaseSketch, tr("Compiling sketch..."))
 	if err := b.RunRecipe("recipe.hooks.sketch.prebuild", ".pattern", false); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
 
-	if err := b.buildSketch(b.libsDetector.IncludeFolders()); err != nil {
+	if err := b.timings.measurePhase(PhaseSketch, func() error {
+		return b.buildSketch(b.libsDetector.IncludeFolders())
+	}); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
@@ -376,7 +455,7 @@ func (b *Builder) build() error {
 	}
 	b.Progress.CompleteStep()
 
-	b.logIfVerbose(false, tr("Compiling libraries..."))
+	b.logger.Section(PhaseLibraries, tr("Compiling libraries..."))
 	if err := b.RunRecipe("recipe.hooks.libraries.prebuild", ".pattern", false); err != nil {
 		return err
 	}
@@ -387,7 +466,9 @@ func (b *Builder) build() error {
 	}
 	b.Progress.CompleteStep()
 
-	if err := b.buildLibraries(b.libsDetector.IncludeFolders(), b.libsDetector.ImportedLibraries()); err != nil {
+	if err := b.timings.measurePhase(PhaseLibraries, func() error {
+		return b.buildLibraries(b.libsDetector.IncludeFolders(), b.libsDetector.ImportedLibraries())
+	}); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
@@ -397,13 +478,13 @@ func (b *Builder) build() error {
 	}
 	b.Progress.CompleteStep()
 
-	b.logIfVerbose(false, tr("Compiling core..."))
+	b.logger.Section(PhaseCore, tr("Compiling core..."))
 	if err := b.RunRecipe("recipe.hooks.core.prebuild", ".pattern", false); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
 
-	if err := b.buildCore(); err != nil {
+	if err := b.timings.measurePhase(PhaseCore, b.buildCore); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
@@ -413,13 +494,13 @@ func (b *Builder) build() error {
 	}
 	b.Progress.CompleteStep()
 
-	b.logIfVerbose(false, tr("Linking everything together..."))
+	b.logger.Section(PhaseLink, tr("Linking everything together..."))
 	if err := b.RunRecipe("recipe.hooks.linking.prelink", ".pattern", false); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
 
-	if err := b.link(); err != nil {
+	if err := b.timings.measurePhase(PhaseLink, b.link); err != nil {
 		return err
 	}
 	b.Progress.CompleteStep()
@@ -429,6 +510,7 @@ func (b *Builder) build() error {
 	}
 	b.Progress.CompleteStep()
 
+	b.logger.SetPhase(PhaseObjcopy)
 	if err := b.RunRecipe("recipe.hooks.objcopy.preobjcopy", ".pattern", false); err != nil {
 		return err
 	}
@@ -449,6 +531,11 @@ func (b *Builder) build() error {
 	}
 	b.Progress.CompleteStep()
 
+	if err := b.exportUF2(); err != nil {
+		return err
+	}
+	b.Progress.CompleteStep()
+
 	if err := b.RunRecipe("recipe.hooks.postbuild", ".pattern", true); err != nil {
 		return err
 	}
@@ -460,7 +547,7 @@ func (b *Builder) build() error {
 	return nil
 }
 
-func (b *Builder) prepareCommandForRecipe(buildProperties *properties.Map, recipe string, removeUnsetProperties bool) (*executils.Process, error) {
+func prepareCommandForRecipe(buildProperties *properties.Map, recipe string, removeUnsetProperties bool) (*executils.Process, error) {
 	pattern := buildProperties.Get(recipe)
 	if pattern == "" {
 		return nil, fmt.Errorf(tr("%[1]s pattern is missing"), recipe)
@@ -504,16 +591,44 @@ func (b *Builder) prepareCommandForRecipe(buildProperties *properties.Map, recip
 	return command, nil
 }
 
+// SetToolTimeout sets the max time each tool of the build (compiler,
+// preprocessors, linker...) can run before being killed, 0 means no limit.
+func (b *Builder) SetToolTimeout(timeout time.Duration) {
+	b.toolTimeout = timeout
+	b.libsDetector.SetToolTimeout(timeout)
+}
+
+// toolContext returns the context to run the command, canceled after the tool timeout.
+func (b *Builder) toolContext(command *executils.Process) (context.Context, context.CancelFunc) {
+	return utils.ToolContext(b.ctx, b.toolTimeout, filepath.Base(command.GetArgs()[0]))
+}
+
 func (b *Builder) execCommand(command *executils.Process) error {
+	return b.execCommandCopyingStderr(command, nil)
+}
+
+// execCommandCopyingStderr runs the command like execCommand, the error output
+// of the command is also copied to stderrCopy, if not nil.
+func (b *Builder) execCommandCopyingStderr(command *executils.Process, stderrCopy io.Writer) error {
 	if b.logger.Verbose() {
 		b.logger.Info(utils.PrintableCommand(command.GetArgs()))
 		command.RedirectStdoutTo(b.logger.Stdout())
 	}
-	command.RedirectStderrTo(b.logger.Stderr())
+	if stderrCopy != nil {
+		command.RedirectStderrTo(io.MultiWriter(b.logger.Stderr(), stderrCopy))
+	} else {
+		command.RedirectStderrTo(b.logger.Stderr())
+	}
 
-	if err := command.Start(); err != nil {
+	ctx, cancel := b.toolContext(command)
+	defer cancel()
+	start := time.Now()
+	if err := command.StartWithinContext(ctx); err != nil {
 		return err
 	}
-
-	return command.Wait()
+	err := command.Wait()
+	if b.logger.VerboseLevel(3) {
+		b.logger.Info(tr("Command completed in %s", time.Since(start).Round(time.Millisecond)))
+	}
+	return err
 }
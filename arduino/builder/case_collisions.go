@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/builder/internal/utils"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+)
+
+// checkFileNameCaseCollisions looks for files, in the sketch or in the given
+// libraries, with names differing only by case. These files collide on
+// case-insensitive file systems (the default on Windows and macOS), so a
+// warning is printed for each group of colliding files.
+func (b *Builder) checkFileNameCaseCollisions(importedLibraries libraries.List) error {
+	sketchFiles := paths.NewPathList(b.sketch.MainFile.String())
+	sketchFiles.AddAll(b.sketch.OtherSketchFiles)
+	sketchFiles.AddAll(b.sketch.AdditionalFiles)
+	collisions := findFileNameCaseCollisions(sketchFiles)
+
+	for _, library := range importedLibraries {
+		libraryFiles := paths.PathList{}
+		for _, dir := range library.SourceDirs() {
+			files, err := utils.FindFilesInFolder(dir.Dir, dir.Recurse)
+			if err != nil {
+				return err
+			}
+			libraryFiles.AddAll(files)
+		}
+		collisions = append(collisions, findFileNameCaseCollisions(libraryFiles)...)
+	}
+
+	for _, collision := range collisions {
+		b.logger.Warn(tr("WARNING: the following files have names differing only by case and will collide on case-insensitive file systems: %s",
+			strings.Join(collision.AsStrings(), ", ")))
+	}
+	b.fileNameCaseCollisions = collisions
+	return nil
+}
+
+// FileNameCaseCollisions returns the groups of files, in the sketch or in the
+// libraries used, with names differing only by case.
+func (b *Builder) FileNameCaseCollisions() []paths.PathList {
+	return b.fileNameCaseCollisions
+}
+
+// findFileNameCaseCollisions returns the groups of files, among the given ones,
+// whose paths differ only by case.
+func findFileNameCaseCollisions(files paths.PathList) []paths.PathList {
+	groups := map[string]paths.PathList{}
+	for _, file := range files {
+		key := strings.ToLower(file.String())
+		group := groups[key]
+		if !group.Contains(file) {
+			groups[key] = append(group, file)
+		}
+	}
+
+	res := []paths.PathList{}
+	for _, group := range groups {
+		if len(group) > 1 {
+			group.Sort()
+			res = append(res, group)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i][0].String() < res[j][0].String()
+	})
+	return res
+}
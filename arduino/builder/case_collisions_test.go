@@ -0,0 +1,42 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFileNameCaseCollisions(t *testing.T) {
+	files := paths.NewPathList(
+		"sketch/sketch.ino",
+		"sketch/Utils.h",
+		"sketch/utils.h",
+		"sketch/src/Config.h",
+		"sketch/SRC/config.h",
+		"sketch/src/other.cpp",
+		"sketch/utils.cpp",
+	)
+	collisions := findFileNameCaseCollisions(files)
+	require.Equal(t, []paths.PathList{
+		paths.NewPathList("sketch/SRC/config.h", "sketch/src/Config.h"),
+		paths.NewPathList("sketch/Utils.h", "sketch/utils.h"),
+	}, collisions)
+
+	require.Empty(t, findFileNameCaseCollisions(paths.NewPathList("a/b.h", "a/c.h", "a/b.h")))
+}
@@ -0,0 +1,148 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/go-paths-helper"
+)
+
+// ccache runs the compilations of the source files through ccache
+type ccache struct {
+	path     *paths.Path
+	statsLog *paths.Path
+}
+
+// CcacheStatistics are the results of the compilations run through ccache
+type CcacheStatistics struct {
+	Hits        int `json:"hits"`
+	Misses      int `json:"misses"`
+	Uncacheable int `json:"uncacheable"`
+}
+
+// EnableCcache makes the builder run the compilations of the source files
+// through ccache. It returns an error if ccache is not found in the PATH.
+func (b *Builder) EnableCcache() error {
+	path, err := exec.LookPath("ccache")
+	if err != nil {
+		return errors.New(tr("ccache not found in PATH"))
+	}
+	statsLog := b.buildPath.Join("ccache.stats.log")
+	_ = statsLog.Remove()
+	b.ccache = &ccache{path: paths.New(path), statsLog: statsLog}
+	return nil
+}
+
+// wrap returns the command running the given compilation through ccache.
+// The compilations already run through a compiler cache are left unchanged.
+// The response files (`@file` arguments) are added to the files hashed by
+// ccache, since not all the ccache versions expand them. The compile wrapper,
+// if any, is run by ccache only when the compilation is not found in the cache.
+func (c *ccache) wrap(command *executils.Process, compileWrapper []string) (*executils.Process, error) {
+	args := command.GetArgs()
+	if len(args) == 0 || isCompilerCache(args[0]) {
+		return command, nil
+	}
+	env := []string{"CCACHE_STATSLOG=" + c.statsLog.String()}
+	if len(compileWrapper) > 0 {
+		env = append(env, "CCACHE_PREFIX="+ccachePrefix(compileWrapper))
+	}
+	var responseFiles []string
+	for _, arg := range args[1:] {
+		if len(arg) < 2 || arg[0] != '@' {
+			continue
+		}
+		file := arg[1:]
+		if !filepath.IsAbs(file) && command.GetDir() != "" {
+			file = filepath.Join(command.GetDir(), file)
+		}
+		responseFiles = append(responseFiles, file)
+	}
+	if len(responseFiles) > 0 {
+		env = append(env, "CCACHE_EXTRAFILES="+strings.Join(responseFiles, string(os.PathListSeparator)))
+	}
+	wrapped, err := executils.NewProcessFromPath(env, c.path, args...)
+	if err != nil {
+		return nil, err
+	}
+	wrapped.SetDir(command.GetDir())
+	return wrapped, nil
+}
+
+// isCompilerCache returns true if the executable is a compiler cache
+func isCompilerCache(executable string) bool {
+	name := strings.TrimSuffix(filepath.Base(executable), filepath.Ext(executable))
+	return name == "ccache" || name == "sccache"
+}
+
+// statistics reads the results of the compilations from the stats log written
+// by ccache. Each compilation is reported as a `# <source file>` line followed
+// by the counters it updated. It returns nil if the log is not available, e.g.
+// because the installed ccache doesn't support it.
+func (c *ccache) statistics() *CcacheStatistics {
+	f, err := c.statsLog.Open()
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	stats := &CcacheStatistics{}
+	var counters []string
+	addCompilation := func() {
+		if counters == nil {
+			return
+		}
+		switch {
+		case containsAny(counters, "direct_cache_hit", "preprocessed_cache_hit"):
+			stats.Hits++
+		case containsAny(counters, "cache_miss"):
+			stats.Misses++
+		default:
+			stats.Uncacheable++
+		}
+		counters = nil
+	}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			addCompilation()
+			counters = []string{}
+		} else if line != "" && counters != nil {
+			counters = append(counters, line)
+		}
+	}
+	addCompilation()
+	return stats
+}
+
+func containsAny(list []string, values ...string) bool {
+	for _, item := range list {
+		for _, value := range values {
+			if item == value {
+				return true
+			}
+		}
+	}
+	return false
+}
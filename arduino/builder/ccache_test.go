@@ -0,0 +1,62 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCcacheWrap(t *testing.T) {
+	cache := &ccache{path: paths.New("/usr/bin/ccache"), statsLog: paths.New("/tmp/build/ccache.stats.log")}
+
+	command, err := executils.NewProcess(nil, "avr-g++", "-c", "@/tmp/build/includes.txt", "@flags.txt", "-flto", "sketch.cpp")
+	require.NoError(t, err)
+	command.SetDir("/tmp/sketch")
+	wrapped, err := cache.wrap(command, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/usr/bin/ccache", "avr-g++", "-c", "@/tmp/build/includes.txt", "@flags.txt", "-flto", "sketch.cpp"}, wrapped.GetArgs())
+	require.Equal(t, "/tmp/sketch", wrapped.GetDir())
+
+	command, err = executils.NewProcess(nil, "/usr/bin/sccache", "avr-gcc", "-c", "wiring.c")
+	require.NoError(t, err)
+	wrapped, err = cache.wrap(command, nil)
+	require.NoError(t, err)
+	require.Equal(t, command, wrapped)
+}
+
+func TestCcacheStatistics(t *testing.T) {
+	statsLog := paths.New(t.TempDir()).Join("ccache.stats.log")
+	require.NoError(t, statsLog.WriteFile([]byte(
+		"# /tmp/build/sketch/sketch.ino.cpp\n"+
+			"cache_miss\n"+
+			"files_in_cache\n"+
+			"# /tmp/build/core/wiring.c\n"+
+			"direct_cache_hit\n"+
+			"# /tmp/build/core/wiring_digital.c\n"+
+			"preprocessed_cache_hit\n"+
+			"# /tmp/build/core/hooks.S\n"+
+			"unsupported_source_language\n")))
+
+	cache := &ccache{statsLog: statsLog}
+	require.Equal(t, &CcacheStatistics{Hits: 2, Misses: 1, Uncacheable: 1}, cache.statistics())
+
+	cache = &ccache{statsLog: statsLog.Parent().Join("missing.log")}
+	require.Nil(t, cache.statistics())
+}
@@ -21,10 +21,13 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/builder/internal/utils"
 	"github.com/arduino/arduino-cli/arduino/globals"
+	arduinoutils "github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
 )
 
@@ -33,6 +36,7 @@ func (b *Builder) compileFiles(
 	buildPath *paths.Path,
 	recurse bool,
 	includes []string,
+	overrides *properties.Map,
 ) (paths.PathList, error) {
 	validExtensions := []string{}
 	for ext := range globals.SourceFilesValidExtensions {
@@ -61,7 +65,7 @@ func (b *Builder) compileFiles(
 		if !b.buildProperties.ContainsKey(recipe) {
 			recipe = fmt.Sprintf("recipe%s.o.pattern", globals.SourceFilesValidExtensions[source.Ext()])
 		}
-		objectFile, err := b.compileFileWithRecipe(sourceDir, source, buildPath, includes, recipe)
+		objectFile, err := b.compileFileWithRecipe(sourceDir, source, buildPath, includes, recipe, overrides)
 		if err != nil {
 			errorsMux.Lock()
 			errorsList = append(errorsList, err)
@@ -117,8 +121,13 @@ func (b *Builder) compileFileWithRecipe(
 	buildPath *paths.Path,
 	includes []string,
 	recipe string,
+	overrides *properties.Map,
 ) (*paths.Path, error) {
+	start := time.Now()
 	properties := b.buildProperties.Clone()
+	if overrides != nil {
+		properties.Merge(overrides)
+	}
 	properties.Set("compiler.warning_flags", properties.Get("compiler.warning_flags."+b.logger.WarningsLevel()))
 	properties.Set("includes", strings.Join(includes, " "))
 	properties.SetPath("source_file", source)
@@ -130,7 +139,7 @@ func (b *Builder) compileFileWithRecipe(
 	objectFile := buildPath.Join(relativeSource.String() + ".o")
 
 	properties.SetPath("object_file", objectFile)
-	err = objectFile.Parent().MkdirAll()
+	err = arduinoutils.LongPath(objectFile.Parent()).MkdirAll()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -140,7 +149,7 @@ func (b *Builder) compileFileWithRecipe(
 		return nil, errors.WithStack(err)
 	}
 
-	command, err := b.prepareCommandForRecipe(properties, recipe, false)
+	command, err := prepareCommandForRecipe(properties, recipe, false)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -148,6 +157,17 @@ func (b *Builder) compileFileWithRecipe(
 		b.compilationDatabase.Add(source, command)
 	}
 	if !objIsUpToDate && !b.onlyUpdateCompilationDatabase {
+		// The compilation database keeps the command without the compiler cache
+		// and the compile wrapper
+		if b.ccache != nil {
+			if command, err = b.ccache.wrap(command, b.compileWrapper); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		} else if b.compileWrapper != nil {
+			if command, err = wrapCompileCommand(b.compileWrapper, command); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
 		commandStdout, commandStderr := &bytes.Buffer{}, &bytes.Buffer{}
 		command.RedirectStdoutTo(commandStdout)
 		command.RedirectStderrTo(commandStderr)
@@ -156,7 +176,9 @@ func (b *Builder) compileFileWithRecipe(
 			b.logger.Info(utils.PrintableCommand(command.GetArgs()))
 		}
 		// Since this compile could be multithreaded, we first capture the command output
-		if err := command.Start(); err != nil {
+		ctx, cancel := utils.ToolContext(b.ctx, b.toolTimeout, tr("Compilation of %s", source))
+		defer cancel()
+		if err := command.StartWithinContext(ctx); err != nil {
 			return nil, err
 		}
 		err := command.Wait()
@@ -168,9 +190,19 @@ func (b *Builder) compileFileWithRecipe(
 
 		// ...and then return the error
 		if err != nil {
+			if ctx.Err() != nil {
+				// The compiler has been killed: remove the partially written
+				// object file, otherwise it could be considered up to date
+				// in the next build.
+				objectFile.Remove()
+				depsFile.Remove()
+			}
 			return nil, errors.WithStack(err)
 		}
-	} else if b.logger.Verbose() {
+		if b.logger.VerboseLevel(3) {
+			b.logger.Info(tr("Compiled %[1]s in %[2]s", source, time.Since(start).Round(time.Millisecond)))
+		}
+	} else if b.logger.VerboseLevel(2) {
 		if objIsUpToDate {
 			b.logger.Info(tr("Using previously compiled file: %[1]s", objectFile))
 		} else {
@@ -178,5 +210,6 @@ func (b *Builder) compileFileWithRecipe(
 		}
 	}
 
+	b.timings.addFile(source.String(), time.Since(start), objIsUpToDate)
 	return objectFile, nil
 }
@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// SetCompileWrapper sets the command used as a prefix of the compilations of
+// the source files, e.g. `distcc` or `icecc`, to distribute them on a cluster.
+// The link and archive steps always run locally. It returns an error if the
+// wrapper command can't be parsed or its executable is not found.
+func (b *Builder) SetCompileWrapper(wrapper string) error {
+	args, err := properties.SplitQuotedString(wrapper, `"'`, false)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New(tr("empty compile wrapper"))
+	}
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return errors.New(tr("%s not found in PATH", args[0]))
+	}
+	args[0] = path
+	b.compileWrapper = args
+	return nil
+}
+
+// CompileWrapper returns the command used as a prefix of the compilations, or
+// nil if not set.
+func (b *Builder) CompileWrapper() []string {
+	return b.compileWrapper
+}
+
+// wrapCompileCommand returns the command running the compilation through the
+// given wrapper.
+func wrapCompileCommand(wrapper []string, command *executils.Process) (*executils.Process, error) {
+	wrapped, err := executils.NewProcess(nil, append(append([]string{}, wrapper...), command.GetArgs()...)...)
+	if err != nil {
+		return nil, err
+	}
+	wrapped.SetDir(command.GetDir())
+	return wrapped, nil
+}
+
+// ccachePrefix returns the wrapper in the format of the CCACHE_PREFIX variable,
+// so that ccache detects the real compiler and runs the wrapper only on misses.
+func ccachePrefix(wrapper []string) string {
+	return strings.Join(wrapper, " ")
+}
@@ -0,0 +1,53 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCompileWrapper(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+
+	b := &Builder{}
+	require.NoError(t, b.SetCompileWrapper(`sh -c 'exec "$@"' --`))
+	require.Equal(t, []string{sh, "-c", `exec "$@"`, "--"}, b.CompileWrapper())
+
+	b = &Builder{}
+	require.Error(t, b.SetCompileWrapper("   "))
+	require.Error(t, b.SetCompileWrapper("not-existing-distcc"))
+	require.Error(t, b.SetCompileWrapper(`distcc "unterminated`))
+	require.Nil(t, b.CompileWrapper())
+}
+
+func TestWrapCompileCommand(t *testing.T) {
+	command, err := executils.NewProcess(nil, "avr-g++", "-c", "-flto", "sketch.cpp")
+	require.NoError(t, err)
+	command.SetDir("/tmp/sketch")
+
+	wrapped, err := wrapCompileCommand([]string{"/usr/bin/distcc"}, command)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/usr/bin/distcc", "avr-g++", "-c", "-flto", "sketch.cpp"}, wrapped.GetArgs())
+	require.Equal(t, "/tmp/sketch", wrapped.GetDir())
+	require.Equal(t, []string{"avr-g++", "-c", "-flto", "sketch.cpp"}, command.GetArgs())
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino/builder/cpp"
 	"github.com/arduino/arduino-cli/arduino/builder/internal/utils"
+	arduinoutils "github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/buildcache"
 	f "github.com/arduino/arduino-cli/internal/algorithms"
 	"github.com/arduino/go-paths-helper"
@@ -75,6 +76,7 @@ func (b *Builder) compileCore() (*paths.Path, paths.PathList, error) {
 			variantFolder, b.coreBuildPath,
 			true, /** recursive **/
 			includes,
+			nil, /** properties overrides **/
 		)
 		if err != nil {
 			return nil, nil, errors.WithStack(err)
@@ -84,9 +86,15 @@ func (b *Builder) compileCore() (*paths.Path, paths.PathList, error) {
 	var targetArchivedCore *paths.Path
 	if b.coreBuildCachePath != nil {
 		realCoreFolder := coreFolder.Parent().Parent()
+		configuration := ""
+		if name, ok := b.buildProperties.GetOk("build.configuration"); ok {
+			// The defines of the build configuration are added to build.extra_flags
+			configuration = name + " " + b.buildProperties.Get("build.extra_flags")
+		}
 		archivedCoreName := getCachedCoreArchiveDirName(
 			b.buildProperties.Get("build.fqbn"),
 			b.buildProperties.Get("compiler.optimization_flags"),
+			configuration,
 			realCoreFolder,
 		)
 		targetArchivedCore = b.coreBuildCachePath.Join(archivedCoreName, "core.a")
@@ -123,6 +131,7 @@ func (b *Builder) compileCore() (*paths.Path, paths.PathList, error) {
 		coreFolder, b.coreBuildPath,
 		true, /** recursive **/
 		includes,
+		nil, /** properties overrides **/
 	)
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
@@ -135,7 +144,7 @@ func (b *Builder) compileCore() (*paths.Path, paths.PathList, error) {
 
 	// archive core.a
 	if targetArchivedCore != nil && !b.onlyUpdateCompilationDatabase {
-		err := archiveFile.CopyTo(targetArchivedCore)
+		err := archiveFile.CopyTo(arduinoutils.LongPath(targetArchivedCore))
 		if b.logger.Verbose() {
 			if err == nil {
 				b.logger.Info(tr("Archiving built core (caching) in: %[1]s", targetArchivedCore))
@@ -153,8 +162,9 @@ func (b *Builder) compileCore() (*paths.Path, paths.PathList, error) {
 }
 
 // getCachedCoreArchiveDirName returns the directory name to be used to store
-// the global cached core.a.
-func getCachedCoreArchiveDirName(fqbn string, optimizationFlags string, coreFolder *paths.Path) string {
+// the global cached core.a. Each build configuration of the sketch project file
+// has its own archive, since it may add defines to the core build.
+func getCachedCoreArchiveDirName(fqbn string, optimizationFlags string, configuration string, coreFolder *paths.Path) string {
 	fqbnToUnderscore := strings.ReplaceAll(fqbn, ":", "_")
 	fqbnToUnderscore = strings.ReplaceAll(fqbnToUnderscore, "=", "_")
 	if absCoreFolder, err := coreFolder.Abs(); err == nil {
@@ -165,7 +175,11 @@ func getCachedCoreArchiveDirName(fqbn string, optimizationFlags string, coreFold
 		md5sumBytes := md5.Sum(data)
 		return hex.EncodeToString(md5sumBytes[:])
 	}
-	hash := md5Sum([]byte(coreFolder.String() + optimizationFlags))
+	key := coreFolder.String() + optimizationFlags
+	if configuration != "" {
+		key += "\n" + configuration
+	}
+	hash := md5Sum([]byte(key))
 	realName := fqbnToUnderscore + "_" + hash
 	if len(realName) > 100 {
 		// avoid really long names, simply hash the name again
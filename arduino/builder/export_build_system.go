@@ -0,0 +1,524 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+// The build systems that can be generated by ExportBuildSystem
+const (
+	BuildSystemCMake = "cmake"
+	BuildSystemMake  = "make"
+)
+
+// BuildSystems returns the build systems that can be generated by ExportBuildSystem
+func BuildSystems() []string {
+	return []string{BuildSystemCMake, BuildSystemMake}
+}
+
+// Placeholders used in the exported commands, they are replaced with the
+// variables of the generated build system.
+const (
+	exportBuildDir    = "@BUILD_DIR@"
+	exportSketchDir   = "@SKETCH_DIR@"
+	exportObjectFiles = "@OBJECT_FILES@"
+	exportArchiveFile = "@ARCHIVE_FILE@"
+)
+
+// exportedSource is a source file compiled by the exported build system
+type exportedSource struct {
+	source  string
+	object  string
+	command []string
+	// flags are the arguments of the command without the compiler, the
+	// source file and the object file
+	flags []string
+}
+
+// exportedArchive is an archive built from the object files of some sources
+type exportedArchive struct {
+	path    string
+	sources []*exportedSource
+}
+
+// buildSystemExport contains everything needed to reproduce a build outside
+// of the builder: the paths inside the build directory are replaced with the
+// exportBuildDir and exportSketchDir placeholders.
+type buildSystemExport struct {
+	projectName string
+	fqbn        string
+	// compilers by CMake language (C, CXX, ASM)
+	compilers map[string]string
+	// sources linked directly into the executable
+	sources  []*exportedSource
+	archives []*exportedArchive
+	// all the inputs of the link, in the order used by the builder
+	linkInputs     []string
+	archiveCommand []string
+	linkCommand    []string
+	objcopy        [][]string
+	// recipes of the platform that are not run by the exported build system
+	skippedRecipes []string
+}
+
+// ExportBuildSystem writes in dir a standalone build system, in the given
+// format, that compiles and links the sketch with the same toolchain, flags
+// and sources of the last build. The preprocessed sketch is copied in the
+// sketch subdirectory of dir, the platform and the libraries are used from
+// their installation directories.
+func (b *Builder) ExportBuildSystem(format string, dir *paths.Path) error {
+	var filename, content string
+	export, err := b.collectBuildSystemExport()
+	if err != nil {
+		return err
+	}
+	switch format {
+	case BuildSystemCMake:
+		filename, content = "CMakeLists.txt", export.cmakeLists()
+	case BuildSystemMake:
+		filename, content = "Makefile", export.makefile()
+	default:
+		return errors.New(tr("unsupported build system: %s", format))
+	}
+
+	if err := dir.MkdirAll(); err != nil {
+		return errors.WithStack(err)
+	}
+	exportedSketchDir := dir.Join("sketch")
+	if err := exportedSketchDir.RemoveAll(); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := copySketchSources(b.sketchBuildPath, exportedSketchDir); err != nil {
+		return err
+	}
+	return errors.WithStack(dir.Join(filename).WriteFile([]byte(content)))
+}
+
+// copySketchSources copies the preprocessed sketch, without the build
+// artifacts, from the build path to dst
+func copySketchSources(sketchBuildPath, dst *paths.Path) error {
+	files, err := sketchBuildPath.ReadDirRecursive()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	files.FilterOutDirs()
+	files.FilterOutSuffix(".o", ".d")
+	for _, file := range files {
+		rel, err := sketchBuildPath.RelTo(file)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		target := dst.JoinPath(rel)
+		if err := target.Parent().MkdirAll(); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := file.CopyTo(target); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// collectBuildSystemExport gathers the commands run by the last build
+func (b *Builder) collectBuildSystemExport() (*buildSystemExport, error) {
+	if b.compilationDatabase == nil || b.buildArtifacts.coreArchiveFilePath == nil {
+		return nil, errors.New(tr("the sketch must be built before exporting the build system"))
+	}
+	buildPath := b.buildPath.String()
+	sketchBuildPath := b.sketchBuildPath.String()
+	// The sources of the sketch are exported, while the artifacts of the build
+	// go to the build directory
+	rewriteArtifact := func(arg string) string {
+		return strings.ReplaceAll(arg, buildPath, exportBuildDir)
+	}
+	rewrite := func(arg string) string {
+		return rewriteArtifact(strings.ReplaceAll(arg, sketchBuildPath, exportSketchDir))
+	}
+	rewriteAll := func(args []string, rewrite func(string) string) []string {
+		res := make([]string, len(args))
+		for i, arg := range args {
+			res[i] = rewrite(arg)
+		}
+		return res
+	}
+
+	export := &buildSystemExport{
+		projectName: b.buildProperties.Get("build.project_name"),
+		fqbn:        b.buildProperties.Get("build.fqbn"),
+		compilers:   map[string]string{},
+	}
+
+	for lang, recipe := range map[string]string{"C": "recipe.c.o.pattern", "CXX": "recipe.cpp.o.pattern", "ASM": "recipe.S.o.pattern"} {
+		if command, err := prepareCommandForRecipe(b.buildProperties, recipe, true); err == nil {
+			export.compilers[lang] = command.GetArgs()[0]
+		}
+	}
+
+	// The compilation database holds the command of every compiled source
+	compiled := []*exportedSource{}
+	objects := map[*exportedSource]*paths.Path{}
+	for _, entry := range b.compilationDatabase.Contents {
+		source := &exportedSource{source: rewrite(entry.File), command: rewriteAll(entry.Arguments, rewrite)}
+		var object *paths.Path
+		for i := 1; i < len(entry.Arguments); i++ {
+			arg := entry.Arguments[i]
+			switch {
+			case arg == "-c", arg == entry.File:
+			case arg == "-o" && i+1 < len(entry.Arguments):
+				i++
+				object = paths.New(entry.Arguments[i])
+				source.command[i] = rewriteArtifact(entry.Arguments[i])
+			default:
+				source.flags = append(source.flags, rewrite(arg))
+			}
+		}
+		if object == nil {
+			return nil, errors.New(tr("cannot find the object file in the compile command of %s", entry.File))
+		}
+		if !object.IsAbs() {
+			object = paths.New(entry.Directory).JoinPath(object)
+		}
+		source.object = rewriteArtifact(object.String())
+		compiled = append(compiled, source)
+		objects[source] = object
+	}
+	sourceOf := func(object *paths.Path) *exportedSource {
+		for _, source := range compiled {
+			if objects[source].EqualsTo(object) {
+				return source
+			}
+		}
+		return nil
+	}
+
+	linkInputs := paths.NewPathList()
+	linkInputs.AddAll(b.buildArtifacts.sketchObjectFiles)
+	linkInputs.AddAll(b.buildArtifacts.librariesObjectFiles)
+	linkInputs.AddAll(b.buildArtifacts.coreObjectsFiles)
+	archives := paths.NewPathList()
+	linked := map[*exportedSource]bool{}
+	for _, input := range linkInputs {
+		if source := sourceOf(input); source != nil {
+			export.sources = append(export.sources, source)
+			linked[source] = true
+		} else if input.HasSuffix(".a") {
+			archives.Add(input)
+		}
+		export.linkInputs = append(export.linkInputs, rewriteArtifact(input.String()))
+	}
+	archives.Add(b.buildArtifacts.coreArchiveFilePath)
+	for _, archive := range archives {
+		// The archives of the build path are made of the objects compiled in
+		// their directory, the others are precompiled and used as they are
+		if inside, _ := archive.IsInsideDir(b.buildPath); !inside {
+			continue
+		}
+		exportedArchive := &exportedArchive{path: rewriteArtifact(archive.String())}
+		for _, source := range compiled {
+			if inside, _ := objects[source].IsInsideDir(archive.Parent()); inside && !linked[source] {
+				exportedArchive.sources = append(exportedArchive.sources, source)
+				linked[source] = true
+			}
+		}
+		export.archives = append(export.archives, exportedArchive)
+	}
+
+	properties := b.buildProperties.Clone()
+	properties.Set("archive_file", exportArchiveFile)
+	properties.Set("archive_file_path", exportArchiveFile)
+	properties.Set("object_file", exportObjectFiles)
+	if command, err := prepareCommandForRecipe(properties, "recipe.ar.pattern", false); err == nil {
+		export.archiveCommand = rewriteAll(command.GetArgs(), rewriteArtifact)
+	} else if len(export.archives) > 0 {
+		return nil, errors.WithStack(err)
+	}
+
+	coreArchiveRelPath, err := b.buildPath.RelTo(b.buildArtifacts.coreArchiveFilePath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	properties = b.buildProperties.Clone()
+	properties.Set("compiler.warning_flags", properties.Get("compiler.warning_flags."+b.logger.WarningsLevel()))
+	properties.Set("archive_file", coreArchiveRelPath.String())
+	properties.Set("archive_file_path", b.buildArtifacts.coreArchiveFilePath.String())
+	properties.Set("object_files", exportObjectFiles)
+	command, err := prepareCommandForRecipe(properties, "recipe.c.combine.pattern", false)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	export.linkCommand = rewriteAll(command.GetArgs(), rewriteArtifact)
+
+	for _, recipe := range findRecipes(b.buildProperties, "recipe.objcopy.", ".pattern") {
+		command, err := prepareCommandForRecipe(b.buildProperties, recipe, false)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		export.objcopy = append(export.objcopy, rewriteAll(command.GetArgs(), rewriteArtifact))
+	}
+	export.skippedRecipes = findRecipes(b.buildProperties, "recipe.hooks.", ".pattern")
+	return export, nil
+}
+
+var (
+	makeVariables  = strings.NewReplacer(exportBuildDir, "$(BUILD_DIR)", exportSketchDir, "$(SKETCH_DIR)")
+	cmakeVariables = strings.NewReplacer(exportBuildDir, "${CMAKE_CURRENT_BINARY_DIR}", exportSketchDir, "${SKETCH_DIR}")
+	cmakeEscaper   = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`)
+	cmakeTargetRe  = regexp.MustCompile(`[^A-Za-z0-9_.+-]`)
+)
+
+// shellQuote quotes arg, if needed, to be passed as a single argument to a shell
+func shellQuote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n\"'\\$`;&|<>()*?[]{}#~!@") {
+		return arg
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "`", "\\`").Replace(arg) + `"`
+}
+
+// commandLine joins the arguments quoted with quote, the arguments found in
+// expand are replaced with their value as it is
+func commandLine(args []string, expand map[string]string, quote func(string) string) string {
+	res := make([]string, len(args))
+	for i, arg := range args {
+		if value, ok := expand[arg]; ok {
+			res[i] = value
+		} else {
+			res[i] = quote(arg)
+		}
+	}
+	return strings.Join(res, " ")
+}
+
+func (e *buildSystemExport) header(out *strings.Builder, generated string) {
+	fmt.Fprintf(out, "# %s generated by arduino-cli for the sketch %s and the board %s.\n", generated, e.projectName, e.fqbn)
+	fmt.Fprintln(out, "# The platform and the libraries are used from their installation directories,")
+	fmt.Fprintln(out, "# export the build system again after installing or updating them.")
+	if len(e.skippedRecipes) > 0 {
+		fmt.Fprintln(out, "#")
+		fmt.Fprintln(out, "# The following recipes of the platform are not run by this build system:")
+		for _, recipe := range e.skippedRecipes {
+			fmt.Fprintf(out, "#   %s\n", recipe)
+		}
+	}
+	fmt.Fprintln(out)
+}
+
+// allSources returns the sources linked directly and the ones archived
+func (e *buildSystemExport) allSources() []*exportedSource {
+	res := append([]*exportedSource{}, e.sources...)
+	for _, archive := range e.archives {
+		res = append(res, archive.sources...)
+	}
+	return res
+}
+
+func (e *buildSystemExport) executable() string {
+	return exportBuildDir + "/" + e.projectName + ".elf"
+}
+
+// makefile returns the content of a Makefile reproducing the build
+func (e *buildSystemExport) makefile() string {
+	quote := func(arg string) string {
+		return makeVariables.Replace(strings.ReplaceAll(shellQuote(arg), "$", "$$"))
+	}
+	target := func(path string) string {
+		return makeVariables.Replace(strings.NewReplacer("$", "$$", " ", `\ `).Replace(path))
+	}
+	list := func(name string, values []string, format func(string) string) string {
+		res := name + " ="
+		for _, value := range values {
+			res += " \\\n\t" + format(value)
+		}
+		return res + "\n"
+	}
+
+	out := &strings.Builder{}
+	e.header(out, "Makefile")
+	fmt.Fprintln(out, "BUILD_DIR ?= build")
+	fmt.Fprintln(out, "SKETCH_DIR ?= sketch")
+	fmt.Fprintln(out)
+	objects := []string{}
+	for _, source := range e.allSources() {
+		objects = append(objects, source.object)
+	}
+	fmt.Fprintln(out, list("OBJECTS", objects, target))
+	fmt.Fprintln(out, list("LINK_INPUTS", e.linkInputs, quote))
+	fmt.Fprintf(out, "all: %s\n\n", target(e.executable()))
+
+	for _, source := range e.allSources() {
+		fmt.Fprintf(out, "%s: %s\n", target(source.object), target(source.source))
+		fmt.Fprintln(out, "\t@mkdir -p \"$(@D)\"")
+		fmt.Fprintf(out, "\t%s\n\n", commandLine(source.command, nil, quote))
+	}
+
+	prerequisites := []string{}
+	for _, input := range e.linkInputs {
+		prerequisites = append(prerequisites, target(input))
+	}
+	for _, archive := range e.archives {
+		if !slices.Contains(e.linkInputs, archive.path) {
+			prerequisites = append(prerequisites, target(archive.path))
+		}
+		if len(archive.sources) == 0 {
+			continue
+		}
+		members := []string{}
+		for _, source := range archive.sources {
+			members = append(members, target(source.object))
+		}
+		fmt.Fprintf(out, "%s: %s\n", target(archive.path), strings.Join(members, " "))
+		fmt.Fprintln(out, "\t@rm -f \"$@\"")
+		expand := map[string]string{exportArchiveFile: `"$@"`, exportObjectFiles: "$^"}
+		fmt.Fprintf(out, "\t%s\n\n", commandLine(e.archiveCommand, expand, quote))
+	}
+
+	fmt.Fprintf(out, "%s: %s\n", target(e.executable()), strings.Join(prerequisites, " "))
+	fmt.Fprintf(out, "\t%s\n", commandLine(e.linkCommand, map[string]string{exportObjectFiles: "$(LINK_INPUTS)"}, quote))
+	for _, command := range e.objcopy {
+		fmt.Fprintf(out, "\t%s\n", commandLine(command, nil, quote))
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "clean:")
+	fmt.Fprintln(out, "\trm -rf \"$(BUILD_DIR)\"")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "-include $(OBJECTS:.o=.d)")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, ".PHONY: all clean")
+	return out.String()
+}
+
+// cmakeLists returns the content of a CMakeLists.txt reproducing the build
+func (e *buildSystemExport) cmakeLists() string {
+	str := func(s string) string {
+		return `"` + cmakeVariables.Replace(cmakeEscaper.Replace(s)) + `"`
+	}
+	rule := func(args []string, expand map[string]string) string {
+		return `"` + commandLine(args, expand, func(arg string) string {
+			return cmakeVariables.Replace(cmakeEscaper.Replace(shellQuote(arg)))
+		}) + `"`
+	}
+	targetName := func(path string) string {
+		name := strings.TrimSuffix(filepath.Base(path), ".a")
+		return cmakeTargetRe.ReplaceAllString(name, "_")
+	}
+
+	out := &strings.Builder{}
+	e.header(out, "CMakeLists.txt")
+	fmt.Fprintln(out, "cmake_minimum_required(VERSION 3.13)")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "set(CMAKE_SYSTEM_NAME Generic)")
+	languages := []string{}
+	for _, lang := range []string{"C", "CXX", "ASM"} {
+		if compiler, ok := e.compilers[lang]; ok {
+			fmt.Fprintf(out, "set(CMAKE_%s_COMPILER %s)\n", lang, str(compiler))
+			languages = append(languages, lang)
+		}
+	}
+	fmt.Fprintln(out, "set(CMAKE_TRY_COMPILE_TARGET_TYPE STATIC_LIBRARY)")
+	fmt.Fprintln(out, "set(CMAKE_EXPORT_COMPILE_COMMANDS ON)")
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "project(%s LANGUAGES %s)\n", str(cmakeTargetRe.ReplaceAllString(e.projectName, "_")), strings.Join(languages, " "))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, `set(SKETCH_DIR "${CMAKE_CURRENT_SOURCE_DIR}/sketch")`)
+	fmt.Fprintln(out)
+
+	// The objects compiled by CMake replace the ones of the link recipe, the
+	// archives and the precompiled objects are added as they are
+	direct := map[string]bool{}
+	for _, source := range e.sources {
+		direct[source.object] = true
+	}
+	objects := []string{"<OBJECTS>"}
+	for _, input := range e.linkInputs {
+		if !direct[input] {
+			objects = append(objects, cmakeVariables.Replace(cmakeEscaper.Replace(shellQuote(input))))
+		}
+	}
+	fmt.Fprintf(out, "foreach(lang %s)\n", strings.Join(languages, " "))
+	fmt.Fprintf(out, "  set(CMAKE_${lang}_LINK_EXECUTABLE %s)\n", rule(e.linkCommand, map[string]string{exportObjectFiles: strings.Join(objects, " "), e.executable(): "<TARGET>"}))
+	if len(e.archives) > 0 {
+		archive := rule(e.archiveCommand, map[string]string{exportArchiveFile: "<TARGET>", exportObjectFiles: "<OBJECTS>"})
+		fmt.Fprintf(out, "  set(CMAKE_${lang}_ARCHIVE_CREATE %s)\n", archive)
+		fmt.Fprintf(out, "  set(CMAKE_${lang}_ARCHIVE_APPEND %s)\n", archive)
+		fmt.Fprintln(out, `  set(CMAKE_${lang}_ARCHIVE_FINISH "")`)
+	}
+	fmt.Fprintln(out, "endforeach()")
+	fmt.Fprintln(out)
+
+	sourceList := func(sources []*exportedSource) string {
+		res := ""
+		for _, source := range sources {
+			res += "\n  " + str(source.source)
+		}
+		return res
+	}
+	archiveTargets := []string{}
+	archivePaths := []string{}
+	for _, archive := range e.archives {
+		if len(archive.sources) == 0 {
+			continue
+		}
+		name := targetName(archive.path)
+		fmt.Fprintf(out, "add_library(%s STATIC%s\n)\n", name, sourceList(archive.sources))
+		fmt.Fprintf(out, "set_target_properties(%s PROPERTIES\n", name)
+		fmt.Fprintln(out, `  PREFIX ""`)
+		fmt.Fprintf(out, "  OUTPUT_NAME %s\n", str(strings.TrimSuffix(filepath.Base(archive.path), ".a")))
+		fmt.Fprintln(out, `  SUFFIX ".a"`)
+		fmt.Fprintf(out, "  ARCHIVE_OUTPUT_DIRECTORY %s\n)\n\n", str(filepath.Dir(archive.path)))
+		archiveTargets = append(archiveTargets, name)
+		archivePaths = append(archivePaths, cmakeVariables.Replace(cmakeEscaper.Replace(archive.path)))
+	}
+
+	executable := cmakeTargetRe.ReplaceAllString(e.projectName, "_") + ".elf"
+	fmt.Fprintf(out, "add_executable(%s%s\n)\n", executable, sourceList(e.sources))
+	fmt.Fprintf(out, "set_target_properties(%s PROPERTIES\n", executable)
+	fmt.Fprintf(out, "  OUTPUT_NAME %s\n", str(e.projectName))
+	fmt.Fprintln(out, `  SUFFIX ".elf"`)
+	fmt.Fprintln(out, `  RUNTIME_OUTPUT_DIRECTORY "${CMAKE_CURRENT_BINARY_DIR}"`)
+	if len(archivePaths) > 0 {
+		fmt.Fprintf(out, "  LINK_DEPENDS \"%s\"\n", strings.Join(archivePaths, ";"))
+	}
+	fmt.Fprintln(out, ")")
+	if len(archiveTargets) > 0 {
+		fmt.Fprintf(out, "add_dependencies(%s %s)\n", executable, strings.Join(archiveTargets, " "))
+	}
+	fmt.Fprintln(out)
+
+	for _, source := range e.allSources() {
+		flags := make([]string, len(source.flags))
+		for i, flag := range source.flags {
+			flags[i] = cmakeVariables.Replace(strings.ReplaceAll(cmakeEscaper.Replace(flag), ";", `\;`))
+		}
+		fmt.Fprintf(out, "set_source_files_properties(%s PROPERTIES COMPILE_OPTIONS \"%s\")\n", str(source.source), strings.Join(flags, ";"))
+	}
+
+	if len(e.objcopy) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "add_custom_command(TARGET %s POST_BUILD\n", executable)
+		for _, command := range e.objcopy {
+			fmt.Fprintf(out, "  COMMAND %s\n", commandLine(command, nil, str))
+		}
+		fmt.Fprintln(out, "  VERBATIM\n)")
+	}
+	return out.String()
+}
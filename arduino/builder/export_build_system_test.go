@@ -0,0 +1,120 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/builder/internal/compilation"
+	"github.com/arduino/arduino-cli/arduino/builder/internal/logger"
+	"github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func newExportTestBuilder(t *testing.T) *Builder {
+	buildPath := paths.New(t.TempDir())
+	sketchBuildPath := buildPath.Join("sketch")
+	require.NoError(t, sketchBuildPath.MkdirAll())
+	require.NoError(t, sketchBuildPath.Join("Blink.ino.cpp").WriteFile([]byte("void setup() {}\n")))
+	require.NoError(t, sketchBuildPath.Join("Blink.ino.cpp.o").WriteFile([]byte{}))
+
+	buildProperties := properties.NewFromHashmap(map[string]string{
+		"build.path":                      "{build.path}",
+		"build.project_name":              "Blink.ino",
+		"build.fqbn":                      "arduino:avr:uno",
+		"compiler.warning_flags":          "-w",
+		"recipe.c.o.pattern":              `avr-gcc -c {compiler.warning_flags} {includes} "{source_file}" -o "{object_file}"`,
+		"recipe.cpp.o.pattern":            `avr-g++ -c {compiler.warning_flags} {includes} "{source_file}" -o "{object_file}"`,
+		"recipe.ar.pattern":               `avr-gcc-ar rcs "{archive_file_path}" "{object_file}"`,
+		"recipe.c.combine.pattern":        `avr-gcc -o "{build.path}/{build.project_name}.elf" {object_files} "{build.path}/{archive_file}" -lm`,
+		"recipe.objcopy.hex.pattern":      `avr-objcopy -O ihex "{build.path}/{build.project_name}.elf" "{build.path}/{build.project_name}.hex"`,
+		"recipe.hooks.prebuild.1.pattern": "echo prebuild",
+	})
+	buildProperties.SetPath("build.path", buildPath)
+
+	db := compilation.NewDatabase(buildPath.Join("compile_commands.json"))
+	addCommand := func(source string, args ...string) {
+		db.Contents = append(db.Contents, compilation.Command{Directory: "/", Arguments: args, File: source})
+	}
+	sketchSource := sketchBuildPath.Join("Blink.ino.cpp").String()
+	addCommand(sketchSource, "avr-g++", "-c", "-w", "-I"+sketchBuildPath.String(), "-DNAME=\"Blink\"", sketchSource, "-o", sketchSource+".o")
+	addCommand("/hw/cores/arduino/wiring.c", "avr-gcc", "-c", "-w", "/hw/cores/arduino/wiring.c", "-o", buildPath.Join("core", "wiring.c.o").String())
+	addCommand("/hw/cores/arduino/main.cpp", "avr-g++", "-c", "-w", "/hw/cores/arduino/main.cpp", "-o", buildPath.Join("core", "main.cpp.o").String())
+
+	return &Builder{
+		buildProperties:     buildProperties,
+		buildPath:           buildPath,
+		sketchBuildPath:     sketchBuildPath,
+		compilationDatabase: db,
+		logger:              logger.New(nil, nil, 0, nil, false, "none"),
+		buildArtifacts: &buildArtifacts{
+			sketchObjectFiles:   paths.NewPathList(sketchSource + ".o"),
+			coreArchiveFilePath: buildPath.Join("core", "core.a"),
+		},
+	}
+}
+
+func TestExportBuildSystemMakefile(t *testing.T) {
+	b := newExportTestBuilder(t)
+	dir := paths.New(t.TempDir()).Join("make")
+	require.NoError(t, b.ExportBuildSystem(BuildSystemMake, dir))
+
+	require.FileExists(t, dir.Join("sketch", "Blink.ino.cpp").String())
+	require.NoFileExists(t, dir.Join("sketch", "Blink.ino.cpp.o").String())
+
+	makefile, err := dir.Join("Makefile").ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(makefile), "#   recipe.hooks.prebuild.1.pattern\n")
+	require.Contains(t, string(makefile), "$(BUILD_DIR)/sketch/Blink.ino.cpp.o: $(SKETCH_DIR)/Blink.ino.cpp\n"+
+		"\t@mkdir -p \"$(@D)\"\n"+
+		"\tavr-g++ -c -w \"-I$(SKETCH_DIR)\" \"-DNAME=\\\"Blink\\\"\" \"$(SKETCH_DIR)/Blink.ino.cpp\" -o \"$(BUILD_DIR)/sketch/Blink.ino.cpp.o\"\n")
+	require.Contains(t, string(makefile), "$(BUILD_DIR)/core/core.a: $(BUILD_DIR)/core/wiring.c.o $(BUILD_DIR)/core/main.cpp.o\n"+
+		"\t@rm -f \"$@\"\n"+
+		"\tavr-gcc-ar rcs \"$@\" $^\n")
+	require.Contains(t, string(makefile), "$(BUILD_DIR)/Blink.ino.elf: $(BUILD_DIR)/sketch/Blink.ino.cpp.o $(BUILD_DIR)/core/core.a\n"+
+		"\tavr-gcc -o \"$(BUILD_DIR)/Blink.ino.elf\" $(LINK_INPUTS) \"$(BUILD_DIR)/core/core.a\" -lm\n"+
+		"\tavr-objcopy -O ihex \"$(BUILD_DIR)/Blink.ino.elf\" \"$(BUILD_DIR)/Blink.ino.hex\"\n")
+}
+
+func TestExportBuildSystemCMake(t *testing.T) {
+	b := newExportTestBuilder(t)
+	dir := paths.New(t.TempDir()).Join("cmake")
+	require.NoError(t, b.ExportBuildSystem(BuildSystemCMake, dir))
+
+	cmakeLists, err := dir.Join("CMakeLists.txt").ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(cmakeLists), "set(CMAKE_C_COMPILER \"avr-gcc\")\nset(CMAKE_CXX_COMPILER \"avr-g++\")\n")
+	require.Contains(t, string(cmakeLists), "project(\"Blink.ino\" LANGUAGES C CXX)\n")
+	require.Contains(t, string(cmakeLists), `set(CMAKE_${lang}_LINK_EXECUTABLE "avr-gcc -o <TARGET> <OBJECTS> \"${CMAKE_CURRENT_BINARY_DIR}/core/core.a\" -lm")`)
+	require.Contains(t, string(cmakeLists), `set(CMAKE_${lang}_ARCHIVE_CREATE "avr-gcc-ar rcs <TARGET> <OBJECTS>")`)
+	require.Contains(t, string(cmakeLists), "add_library(core STATIC\n  \"/hw/cores/arduino/wiring.c\"\n  \"/hw/cores/arduino/main.cpp\"\n)\n")
+	require.Contains(t, string(cmakeLists), "add_executable(Blink.ino.elf\n  \"${SKETCH_DIR}/Blink.ino.cpp\"\n)\n")
+	require.Contains(t, string(cmakeLists), `set_source_files_properties("${SKETCH_DIR}/Blink.ino.cpp" PROPERTIES COMPILE_OPTIONS "-w;-I${SKETCH_DIR};-DNAME=\"Blink\"")`)
+	require.Contains(t, string(cmakeLists), `COMMAND "avr-objcopy" "-O" "ihex" "${CMAKE_CURRENT_BINARY_DIR}/Blink.ino.elf" "${CMAKE_CURRENT_BINARY_DIR}/Blink.ino.hex"`)
+}
+
+func TestExportBuildSystemUnsupported(t *testing.T) {
+	b := newExportTestBuilder(t)
+	require.Error(t, b.ExportBuildSystem("ninja", paths.New(t.TempDir())))
+}
+
+func TestShellQuote(t *testing.T) {
+	require.Equal(t, "-Os", shellQuote("-Os"))
+	require.Equal(t, `""`, shellQuote(""))
+	require.Equal(t, `"/path with spaces/g++"`, shellQuote("/path with spaces/g++"))
+	require.Equal(t, `"-DNAME=\"a \$b\""`, shellQuote(`-DNAME="a $b"`))
+}
@@ -358,7 +358,7 @@ func (b *Builder) extractCompileFlags(buildProperties *properties.Map, recipe st
 		return target
 	}
 
-	command, _ := b.prepareCommandForRecipe(buildProperties, recipe, true)
+	command, _ := prepareCommandForRecipe(buildProperties, recipe, true)
 
 	for _, arg := range command.GetArgs() {
 		if strings.HasPrefix(arg, "-D") {
@@ -17,6 +17,7 @@ package detector
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
@@ -45,6 +46,8 @@ var tr = i18n.Tr
 type libraryResolutionResult struct {
 	Library          *libraries.Library
 	NotUsedLibraries []*libraries.Library
+	// Reason explains why the library has been selected
+	Reason string
 }
 
 // SketchLibrariesDetector todo
@@ -57,6 +60,7 @@ type SketchLibrariesDetector struct {
 	librariesResolutionResults    map[string]libraryResolutionResult
 	includeFolders                paths.PathList
 	logger                        *logger.BuilderLogger
+	toolTimeout                   time.Duration
 }
 
 // NewSketchLibrariesDetector todo
@@ -80,11 +84,24 @@ func NewSketchLibrariesDetector(
 }
 
 // ResolveLibrary todo
+// SetToolTimeout sets the max time each run of the preprocessor can take
+// before being killed, 0 means no limit.
+func (l *SketchLibrariesDetector) SetToolTimeout(timeout time.Duration) {
+	l.toolTimeout = timeout
+}
+
+// runPreprocessor runs the gcc preprocessor on the source file, within the tool timeout.
+func (l *SketchLibrariesDetector) runPreprocessor(ctx context.Context, sourcePath, targetFilePath *paths.Path, includeFolders paths.PathList, buildProperties *properties.Map) ([]byte, []byte, error) {
+	ctx, cancel := utils.ToolContext(ctx, l.toolTimeout, tr("Library detection of %s", sourcePath))
+	defer cancel()
+	return preprocessor.GCC(ctx, sourcePath, targetFilePath, includeFolders, buildProperties)
+}
+
 func (l *SketchLibrariesDetector) resolveLibrary(header, platformArch string) *libraries.Library {
 	importedLibraries := l.importedLibraries
 	candidates := l.librariesResolver.AlternativesFor(header)
 
-	if l.logger.Verbose() {
+	if l.logger.VerboseLevel(2) {
 		l.logger.Info(tr("Alternatives for %[1]s: %[2]s", header, candidates))
 		l.logger.Info(fmt.Sprintf("ResolveLibrary(%s)", header))
 		l.logger.Info(fmt.Sprintf("  -> %s: %s", tr("candidates"), candidates))
@@ -100,7 +117,7 @@ func (l *SketchLibrariesDetector) resolveLibrary(header, platformArch string) *l
 		}
 	}
 
-	selected := l.librariesResolver.ResolveFor(header, platformArch)
+	selected, reason := l.librariesResolver.ResolveForWithReason(header, platformArch)
 	if alreadyImported := importedLibraries.FindByName(selected.Name); alreadyImported != nil {
 		// Certain libraries might have the same name but be different.
 		// This usually happens when the user includes two or more custom libraries that have
@@ -118,6 +135,7 @@ func (l *SketchLibrariesDetector) resolveLibrary(header, platformArch string) *l
 	l.librariesResolutionResults[header] = libraryResolutionResult{
 		Library:          selected,
 		NotUsedLibraries: candidates,
+		Reason:           reason,
 	}
 
 	return selected
@@ -155,6 +173,9 @@ func (l *SketchLibrariesDetector) PrintUsedAndNotUsedLibraries(sketchError bool)
 		for _, notUsedLibrary := range libResResult.NotUsedLibraries {
 			res += fmt.Sprintln("  " + tr("Not used: %[1]s", notUsedLibrary.InstallDir))
 		}
+		if libResResult.Reason != "" {
+			res += fmt.Sprintln("  " + tr("Selection reason: %[1]s", libResResult.Reason))
+		}
 	}
 	res = strings.TrimSpace(res)
 	if sketchError {
@@ -192,6 +213,7 @@ func (l *SketchLibrariesDetector) appendIncludeFolder(
 
 // FindIncludes todo
 func (l *SketchLibrariesDetector) FindIncludes(
+	ctx context.Context,
 	buildPath *paths.Path,
 	buildCorePath *paths.Path,
 	buildVariantPath *paths.Path,
@@ -201,7 +223,7 @@ func (l *SketchLibrariesDetector) FindIncludes(
 	buildProperties *properties.Map,
 	platformArch string,
 ) error {
-	err := l.findIncludes(buildPath, buildCorePath, buildVariantPath, sketchBuildPath, sketch, librariesBuildPath, buildProperties, platformArch)
+	err := l.findIncludes(ctx, buildPath, buildCorePath, buildVariantPath, sketchBuildPath, sketch, librariesBuildPath, buildProperties, platformArch)
 	if err != nil && l.onlyUpdateCompilationDatabase {
 		l.logger.Info(
 			fmt.Sprintf(
@@ -216,6 +238,7 @@ func (l *SketchLibrariesDetector) FindIncludes(
 }
 
 func (l *SketchLibrariesDetector) findIncludes(
+	ctx context.Context,
 	buildPath *paths.Path,
 	buildCorePath *paths.Path,
 	buildVariantPath *paths.Path,
@@ -236,7 +259,7 @@ func (l *SketchLibrariesDetector) findIncludes(
 			return err
 		}
 		if l.logger.Verbose() {
-			l.logger.Info("Using cached library discovery: " + librariesResolutionCache.String())
+			l.logger.Info(tr("Using cached library discovery: %[1]s", librariesResolutionCache))
 		}
 		return nil
 	}
@@ -266,7 +289,7 @@ func (l *SketchLibrariesDetector) findIncludes(
 		}
 
 		for !sourceFileQueue.empty() {
-			err := l.findIncludesUntilDone(cache, sourceFileQueue, buildProperties, sketchBuildPath, librariesBuildPath, platformArch)
+			err := l.findIncludesUntilDone(ctx, cache, sourceFileQueue, buildProperties, sketchBuildPath, librariesBuildPath, platformArch)
 			if err != nil {
 				cachePath.Remove()
 				return errors.WithStack(err)
@@ -294,6 +317,7 @@ func (l *SketchLibrariesDetector) findIncludes(
 }
 
 func (l *SketchLibrariesDetector) findIncludesUntilDone(
+	ctx context.Context,
 	cache *includeCache,
 	sourceFileQueue *uniqueSourceFileQueue,
 	buildProperties *properties.Map,
@@ -343,12 +367,12 @@ func (l *SketchLibrariesDetector) findIncludesUntilDone(
 		var missingIncludeH string
 		if unchanged && cache.valid {
 			missingIncludeH = cache.Next().Include
-			if first && l.logger.Verbose() {
+			if first && l.logger.VerboseLevel(2) {
 				l.logger.Info(tr("Using cached library dependencies for file: %[1]s", sourcePath))
 			}
 		} else {
 			var preprocStdout []byte
-			preprocStdout, preprocStderr, preprocErr = preprocessor.GCC(sourcePath, targetFilePath, includeFolders, buildProperties)
+			preprocStdout, preprocStderr, preprocErr = l.runPreprocessor(ctx, sourcePath, targetFilePath, includeFolders, buildProperties)
 			if l.logger.Verbose() {
 				l.logger.WriteStdout(preprocStdout)
 			}
@@ -379,7 +403,7 @@ func (l *SketchLibrariesDetector) findIncludesUntilDone(
 			if preprocErr == nil || preprocStderr == nil {
 				// Filename came from cache, so run preprocessor to obtain error to show
 				var preprocStdout []byte
-				preprocStdout, preprocStderr, preprocErr = preprocessor.GCC(sourcePath, targetFilePath, includeFolders, buildProperties)
+				preprocStdout, preprocStderr, preprocErr = l.runPreprocessor(ctx, sourcePath, targetFilePath, includeFolders, buildProperties)
 				if l.logger.Verbose() {
 					l.logger.WriteStdout(preprocStdout)
 				}
@@ -587,10 +611,13 @@ func (f *sourceFile) DepfilePath() *paths.Path {
 }
 
 // LibrariesLoader todo
+// The libraryDirs inside the sketchDir, if not nil, are loaded as vendored in
+// the sketch.
 func LibrariesLoader(
 	useCachedLibrariesResolution bool,
 	librariesManager *librariesmanager.LibrariesManager,
-	builtInLibrariesDirs *paths.Path, libraryDirs, otherLibrariesDirs paths.PathList,
+	builtInLibrariesDirs, libraryDirs, otherLibrariesDirs paths.PathList,
+	sketchDir *paths.Path,
 	actualPlatform, targetPlatform *cores.PlatformRelease,
 ) (*librariesmanager.LibrariesManager, *librariesresolver.Cpp, []byte, error) {
 	verboseOut := &bytes.Buffer{}
@@ -603,12 +630,12 @@ func LibrariesLoader(
 	if librariesManager == nil {
 		lm = librariesmanager.NewLibraryManager(nil, nil)
 
-		builtInLibrariesFolders := builtInLibrariesDirs
-		if builtInLibrariesFolders != nil {
-			if err := builtInLibrariesFolders.ToAbs(); err != nil {
-				return nil, nil, nil, errors.WithStack(err)
-			}
-			lm.AddLibrariesDir(builtInLibrariesFolders, libraries.IDEBuiltIn)
+		builtInLibrariesFolders := builtInLibrariesDirs.Clone()
+		if err := builtInLibrariesFolders.ToAbs(); err != nil {
+			return nil, nil, nil, errors.WithStack(err)
+		}
+		for _, folder := range builtInLibrariesFolders {
+			lm.AddLibrariesDir(folder, libraries.IDEBuiltIn)
 		}
 
 		if actualPlatform != targetPlatform {
@@ -636,7 +663,13 @@ func LibrariesLoader(
 
 		for _, dir := range libraryDirs {
 			// Libraries specified this way have top priority
-			if err := lm.LoadLibraryFromDir(dir, libraries.Unmanaged); err != nil {
+			location := libraries.Unmanaged
+			if sketchDir != nil {
+				if inside, _ := dir.Canonical().IsInsideDir(sketchDir.Canonical()); inside {
+					location = libraries.Sketch
+				}
+			}
+			if err := lm.LoadLibraryFromDir(dir, location); err != nil {
 				return nil, nil, nil, errors.WithStack(err)
 			}
 		}
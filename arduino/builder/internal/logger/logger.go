@@ -19,21 +19,31 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sync"
+
+	"github.com/fatih/color"
 )
 
+var sectionColor = color.New(color.FgHiCyan, color.Bold)
+
 // BuilderLogger fixdoc
 type BuilderLogger struct {
 	stdLock sync.Mutex
 	stdout  io.Writer
 	stderr  io.Writer
 
-	verbose       bool
+	verbosity     int
+	verbosePhases []string
+	phase         string
+	quiet         bool
 	warningsLevel string
 }
 
-// New fixdoc
-func New(stdout, stderr io.Writer, verbose bool, warningsLevel string) *BuilderLogger {
+// New creates a BuilderLogger. The verbosity is the level of detail of the
+// verbose output, 0 turns it off. If verbosePhases is not empty the verbose
+// output is printed only during the given phases of the build.
+func New(stdout, stderr io.Writer, verbosity int, verbosePhases []string, quiet bool, warningsLevel string) *BuilderLogger {
 	if stdout == nil {
 		stdout = os.Stdout
 	}
@@ -43,16 +53,44 @@ func New(stdout, stderr io.Writer, verbose bool, warningsLevel string) *BuilderL
 	if warningsLevel == "" {
 		warningsLevel = "none"
 	}
+	if quiet {
+		verbosity = 0
+	}
 	return &BuilderLogger{
 		stdout:        stdout,
 		stderr:        stderr,
-		verbose:       verbose,
+		verbosity:     verbosity,
+		verbosePhases: verbosePhases,
+		quiet:         quiet,
 		warningsLevel: warningsLevel,
 	}
 }
 
-// Info fixdoc
+// Info prints an informational message, it's not printed in quiet mode.
 func (l *BuilderLogger) Info(msg string) {
+	if l.quiet {
+		return
+	}
+	l.stdLock.Lock()
+	defer l.stdLock.Unlock()
+	fmt.Fprintln(l.stdout, msg)
+}
+
+// Section starts a new phase of the build and prints its header, the header
+// is not printed in quiet mode.
+func (l *BuilderLogger) Section(phase, title string) {
+	l.SetPhase(phase)
+	if l.quiet {
+		return
+	}
+	l.stdLock.Lock()
+	defer l.stdLock.Unlock()
+	fmt.Fprintln(l.stdout, sectionColor.Sprint(title))
+}
+
+// Summary prints a message of the summary at the end of the build, it's
+// printed also in quiet mode.
+func (l *BuilderLogger) Summary(msg string) {
 	l.stdLock.Lock()
 	defer l.stdLock.Unlock()
 	fmt.Fprintln(l.stdout, msg)
@@ -79,9 +117,38 @@ func (l *BuilderLogger) WriteStderr(data []byte) (int, error) {
 	return l.stderr.Write(data)
 }
 
-// Verbose fixdoc
+// SetPhase sets the current phase of the build, used to select the verbose
+// output to print.
+func (l *BuilderLogger) SetPhase(phase string) {
+	l.stdLock.Lock()
+	defer l.stdLock.Unlock()
+	l.phase = phase
+}
+
+// Verbose returns true if the verbose output of the current phase of the
+// build must be printed.
 func (l *BuilderLogger) Verbose() bool {
-	return l.verbose
+	return l.VerboseLevel(1)
+}
+
+// VerboseLevel returns true if the verbose output of the current phase of
+// the build must be printed with at least the given level of detail.
+func (l *BuilderLogger) VerboseLevel(level int) bool {
+	if l.verbosity < level {
+		return false
+	}
+	if len(l.verbosePhases) == 0 {
+		return true
+	}
+	l.stdLock.Lock()
+	defer l.stdLock.Unlock()
+	return slices.Contains(l.verbosePhases, l.phase)
+}
+
+// Quiet returns true if only the warnings, the errors and the summary of
+// the build are printed.
+func (l *BuilderLogger) Quiet() bool {
+	return l.quiet
 }
 
 // WarningsLevel fixdoc
@@ -0,0 +1,61 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietLogger(t *testing.T) {
+	color.NoColor = true
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	l := New(stdout, stderr, 1, nil, true, "")
+	require.False(t, l.Verbose())
+	l.Section("sketch", "Compiling sketch...")
+	l.Info("Using previously compiled file")
+	l.Warn("warning: unused variable")
+	l.Summary("Sketch uses 924 bytes")
+	require.Equal(t, "Sketch uses 924 bytes\n", stdout.String())
+	require.Equal(t, "warning: unused variable\n", stderr.String())
+
+	stdout.Reset()
+	l = New(stdout, stderr, 0, nil, false, "")
+	l.Section("sketch", "Compiling sketch...")
+	l.Info("Using previously compiled file")
+	l.Summary("Sketch uses 924 bytes")
+	require.Equal(t, "Compiling sketch...\nUsing previously compiled file\nSketch uses 924 bytes\n", stdout.String())
+}
+
+func TestVerbosePhases(t *testing.T) {
+	l := New(&bytes.Buffer{}, &bytes.Buffer{}, 2, []string{"link"}, false, "")
+	require.False(t, l.Verbose())
+	l.Section("sketch", "Compiling sketch...")
+	require.False(t, l.Verbose())
+	l.Section("link", "Linking everything together...")
+	require.True(t, l.Verbose())
+	require.True(t, l.VerboseLevel(2))
+	require.False(t, l.VerboseLevel(3))
+	l.SetPhase("size")
+	require.False(t, l.Verbose())
+
+	l = New(&bytes.Buffer{}, &bytes.Buffer{}, 1, nil, false, "")
+	require.True(t, l.Verbose())
+	require.False(t, l.VerboseLevel(2))
+}
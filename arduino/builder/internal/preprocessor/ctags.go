@@ -41,7 +41,7 @@ var tr = i18n.Tr
 var DebugPreprocessor bool
 
 // PreprocessSketchWithCtags performs preprocessing of the arduino sketch using CTags.
-func PreprocessSketchWithCtags(sketch *sketch.Sketch, buildPath *paths.Path, includes paths.PathList, lineOffset int, buildProperties *properties.Map, onlyUpdateCompilationDatabase bool) ([]byte, []byte, error) {
+func PreprocessSketchWithCtags(ctx context.Context, sketch *sketch.Sketch, buildPath *paths.Path, includes paths.PathList, lineOffset int, buildProperties *properties.Map, onlyUpdateCompilationDatabase bool) ([]byte, []byte, error) {
 	// Create a temporary working directory
 	tmpDir, err := paths.MkTempDir("", "")
 	if err != nil {
@@ -55,7 +55,7 @@ func PreprocessSketchWithCtags(sketch *sketch.Sketch, buildPath *paths.Path, inc
 
 	// Run GCC preprocessor
 	sourceFile := buildPath.Join("sketch", sketch.MainFile.Base()+".cpp")
-	gccStdout, gccStderr, err := GCC(sourceFile, ctagsTarget, includes, buildProperties)
+	gccStdout, gccStderr, err := GCC(ctx, sourceFile, ctagsTarget, includes, buildProperties)
 	verboseOutput.Write(gccStdout)
 	verboseOutput.Write(gccStderr)
 	normalOutput.Write(gccStderr)
@@ -83,7 +83,7 @@ func PreprocessSketchWithCtags(sketch *sketch.Sketch, buildPath *paths.Path, inc
 	}
 
 	// Run CTags on gcc-preprocessed source
-	ctagsOutput, ctagsStdErr, err := RunCTags(ctagsTarget, buildProperties)
+	ctagsOutput, ctagsStdErr, err := RunCTags(ctx, ctagsTarget, buildProperties)
 	verboseOutput.Write(ctagsStdErr)
 	if err != nil {
 		return normalOutput.Bytes(), verboseOutput.Bytes(), err
@@ -176,7 +176,7 @@ func isFirstFunctionOutsideOfSource(firstFunctionLine int, sourceRows []string)
 }
 
 // RunCTags performs a run of ctags on the given source file. Returns the ctags output and the stderr contents.
-func RunCTags(sourceFile *paths.Path, buildProperties *properties.Map) ([]byte, []byte, error) {
+func RunCTags(ctx context.Context, sourceFile *paths.Path, buildProperties *properties.Map) ([]byte, []byte, error) {
 	ctagsBuildProperties := properties.NewMap()
 	ctagsBuildProperties.Set("tools.ctags.path", "{runtime.tools.ctags.path}")
 	ctagsBuildProperties.Set("tools.ctags.cmd.path", "{path}/ctags")
@@ -199,7 +199,7 @@ func RunCTags(sourceFile *paths.Path, buildProperties *properties.Map) ([]byte,
 	if err != nil {
 		return nil, nil, err
 	}
-	stdout, stderr, err := proc.RunAndCaptureOutput(context.Background())
+	stdout, stderr, err := proc.RunAndCaptureOutput(ctx)
 
 	// Append ctags arguments to stderr
 	args := fmt.Sprintln(strings.Join(parts, " "))
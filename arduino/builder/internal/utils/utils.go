@@ -16,10 +16,14 @@
 package utils
 
 import (
+	"context"
 	"os"
 	"strings"
+	"time"
 	"unicode"
 
+	"github.com/arduino/arduino-cli/arduino"
+	arduinoutils "github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/i18n"
 	f "github.com/arduino/arduino-cli/internal/algorithms"
 	"github.com/arduino/go-paths-helper"
@@ -193,7 +197,7 @@ func FindFilesInFolder(dir *paths.Path, recurse bool, extensions ...string) (pat
 			filterOutHiddenFiles,
 			filterOutSCCS,
 		)
-		return dir.ReadDirRecursiveFiltered(dirFilter, fileFilter)
+		return arduinoutils.ReadDirRecursiveFiltered(dir, arduinoutils.SymlinksFollow, dirFilter, fileFilter)
 	}
 	return dir.ReadDir(fileFilter)
 }
@@ -240,3 +244,17 @@ func DirContentIsOlderThan(dir *paths.Path, target *paths.Path, extensions ...st
 	}
 	return true, nil
 }
+
+// ToolContext returns the context to run a tool of the build, the tool is killed
+// if it doesn't complete within the timeout and the *arduino.TimeoutError for the
+// given operation is returned. A timeout of 0 means no limit.
+func ToolContext(ctx context.Context, timeout time.Duration, operation string) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeoutCause(ctx, timeout, &arduino.TimeoutError{
+		Operation: operation,
+		Timeout:   timeout,
+		Setting:   "build.tool_timeout",
+	})
+}
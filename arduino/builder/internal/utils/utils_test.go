@@ -16,10 +16,12 @@
 package utils
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
 )
@@ -172,3 +174,18 @@ func TestObjFileIsUpToDateDepIsWrong(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, upToDate)
 }
+
+func TestToolContext(t *testing.T) {
+	ctx, cancel := ToolContext(context.Background(), 0, "Compilation")
+	defer cancel()
+	_, hasDeadline := ctx.Deadline()
+	require.False(t, hasDeadline)
+
+	ctx, cancel = ToolContext(context.Background(), 10*time.Millisecond, "Compilation")
+	defer cancel()
+	<-ctx.Done()
+	var timeoutErr *arduino.TimeoutError
+	require.ErrorAs(t, context.Cause(ctx), &timeoutErr)
+	require.Equal(t, "Compilation", timeoutErr.Operation)
+	require.Equal(t, "build.tool_timeout", timeoutErr.Setting)
+}
@@ -21,7 +21,10 @@ import (
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino/builder/cpp"
+	"github.com/arduino/arduino-cli/arduino/builder/internal/logger"
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/arduino/sketch"
 	f "github.com/arduino/arduino-cli/internal/algorithms"
 	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
@@ -63,12 +66,40 @@ func (b *Builder) findExpectedPrecompiledLibFolder(
 	library *libraries.Library,
 	buildProperties *properties.Map,
 ) *paths.Path {
-	mcu := buildProperties.Get("build.mcu")
+	mcu, fpuSpecs := precompiledLibrarySpecs(buildProperties)
+
+	b.logger.Info(tr("Library %[1]s has been declared precompiled:", library.Name))
+
+	// Try directory with full fpuSpecs first, if available
+	if len(fpuSpecs) > 0 {
+		fullPrecompDir := library.SourceDir.Join(mcu).Join(fpuSpecs)
+		if fullPrecompDir.Exist() && directoryContainsFile(fullPrecompDir) {
+			b.logger.Info(tr("Using precompiled library in %[1]s", fullPrecompDir))
+			return fullPrecompDir
+		}
+		b.logger.Info(tr(`Precompiled library in "%[1]s" not found`, fullPrecompDir))
+	}
+
+	precompDir := library.SourceDir.Join(mcu)
+	if precompDir.Exist() && directoryContainsFile(precompDir) {
+		b.logger.Info(tr("Using precompiled library in %[1]s", precompDir))
+		return precompDir
+	}
+	b.logger.Info(tr(`Precompiled library in "%[1]s" not found`, precompDir))
+	return nil
+}
+
+// precompiledLibrarySpecs returns the MCU and the FPU specifications (if any)
+// used to select the folder containing the precompiled libraries.
+func precompiledLibrarySpecs(buildProperties *properties.Map) (mcu string, fpuSpecs string) {
+	mcu = buildProperties.Get("build.mcu")
 	// Add fpu specifications if they exist
 	// To do so, resolve recipe.cpp.o.pattern,
 	// search for -mfpu=xxx -mfloat-abi=yyy and add to a subfolder
-	command, _ := b.prepareCommandForRecipe(buildProperties, "recipe.cpp.o.pattern", true)
-	fpuSpecs := ""
+	command, err := prepareCommandForRecipe(buildProperties, "recipe.cpp.o.pattern", true)
+	if err != nil {
+		return mcu, ""
+	}
 	for _, el := range command.GetArgs() {
 		if strings.Contains(el, FpuCflag) {
 			toAdd := strings.Split(el, "=")
@@ -87,32 +118,89 @@ func (b *Builder) findExpectedPrecompiledLibFolder(
 			}
 		}
 	}
+	return mcu, strings.TrimRight(fpuSpecs, "-")
+}
 
-	b.logger.Info(tr("Library %[1]s has been declared precompiled:", library.Name))
+// libraryPropertiesOverrides returns the build properties adding the compiler
+// flags and the defines of the library overrides of the sketch project file to
+// the compilation of the library, or nil if the library is not overridden.
+func (b *Builder) libraryPropertiesOverrides(library *libraries.Library) *properties.Map {
+	if b.sketch == nil || b.sketch.Project == nil {
+		return nil
+	}
+	override := b.sketch.Project.LibraryOverrides.Get(library.Name, library.DirName)
+	if override == nil {
+		return nil
+	}
+	flags := override.CompilerFlags()
+	if b.logger.Verbose() {
+		b.logger.Info(tr(`Using the flags "%[1]s" to compile library "%[2]s"`, flags, library.Name))
+	}
+	overrides := properties.NewMap()
+	for _, key := range []string{"compiler.c.extra_flags", "compiler.cpp.extra_flags", "compiler.S.extra_flags"} {
+		overrides.Set(key, strings.TrimSpace(b.buildProperties.Get(key)+" "+flags))
+	}
+	return overrides
+}
 
-	// Try directory with full fpuSpecs first, if available
-	if len(fpuSpecs) > 0 {
-		fpuSpecs = strings.TrimRight(fpuSpecs, "-")
-		fullPrecompDir := library.SourceDir.Join(mcu).Join(fpuSpecs)
-		if fullPrecompDir.Exist() && directoryContainsFile(fullPrecompDir) {
-			b.logger.Info(tr("Using precompiled library in %[1]s", fullPrecompDir))
-			return fullPrecompDir
+// selectLibraries applies the library selections of the sketch project file to
+// the libraries resolver, warning about the selections not matching any of the
+// libraries providing the header.
+func selectLibraries(resolver *librariesresolver.Cpp, sk *sketch.Sketch, log *logger.BuilderLogger) {
+	if sk == nil || sk.Project == nil {
+		return
+	}
+	for _, header := range sk.Project.LibrarySelection.Headers() {
+		selection := sk.Project.LibrarySelection[header]
+		var match func(*libraries.Library) bool
+		if selection.Path != "" {
+			dir := paths.New(selection.Path)
+			if !dir.IsAbs() {
+				dir = sk.FullPath.Join(selection.Path)
+			}
+			dir = dir.Canonical()
+			match = func(lib *libraries.Library) bool {
+				return lib.InstallDir != nil && lib.InstallDir.Canonical().EqualsTo(dir)
+			}
+		} else {
+			match = func(lib *libraries.Library) bool {
+				if lib.Name != selection.Name && lib.DirName != selection.Name {
+					return false
+				}
+				return selection.Location == "" || lib.Location.String() == selection.Location
+			}
+		}
+		resolver.SelectLibraryFor(header, match)
+		if len(resolver.AlternativesFor(header)) > 0 && len(resolver.SelectedAlternativesFor(header)) == 0 {
+			log.Warn(tr("The library %[1]s selected in the sketch project file doesn't provide %[2]s, the selection is ignored", selection, header))
 		}
-		b.logger.Info(tr(`Precompiled library in "%[1]s" not found`, fullPrecompDir))
 	}
+}
 
-	precompDir := library.SourceDir.Join(mcu)
-	if precompDir.Exist() && directoryContainsFile(precompDir) {
-		b.logger.Info(tr("Using precompiled library in %[1]s", precompDir))
-		return precompDir
+// warnUnusedLibraryOverrides warns about the library overrides of the sketch
+// project file not matching any of the libraries used by the sketch.
+func (b *Builder) warnUnusedLibraryOverrides(importedLibraries libraries.List) {
+	if b.sketch == nil || b.sketch.Project == nil {
+		return
+	}
+	for _, name := range b.sketch.Project.LibraryOverrides.LibraryNames() {
+		used := false
+		for _, library := range importedLibraries {
+			if library.Name == name || library.DirName == name {
+				used = true
+				break
+			}
+		}
+		if !used {
+			b.logger.Warn(tr("The library %s has build overrides in the sketch project file but it's not used by the sketch", name))
+		}
 	}
-	b.logger.Info(tr(`Precompiled library in "%[1]s" not found`, precompDir))
-	return nil
 }
 
 func (b *Builder) compileLibraries(libraries libraries.List, includes []string) (paths.PathList, error) {
 	b.Progress.AddSubSteps(len(libraries))
 	defer b.Progress.RemoveSubSteps()
+	b.warnUnusedLibraryOverrides(libraries)
 
 	objectFiles := paths.NewPathList()
 	for _, library := range libraries {
@@ -139,6 +227,7 @@ func (b *Builder) compileLibrary(library *libraries.Library, includes []string)
 	}
 
 	objectFiles := paths.NewPathList()
+	overrides := b.libraryPropertiesOverrides(library)
 
 	if library.Precompiled {
 		coreSupportPrecompiled := b.buildProperties.ContainsKey("compiler.libraries.ldflags")
@@ -167,6 +256,11 @@ func (b *Builder) compileLibrary(library *libraries.Library, includes []string)
 				}
 			}
 
+			b.usedPrecompiledLibraries = append(b.usedPrecompiledLibraries, &usedPrecompiledLibrary{
+				library: library,
+				folder:  precompiledPath,
+			})
+
 			currLDFlags := b.buildProperties.Get("compiler.libraries.ldflags")
 			b.buildProperties.Set("compiler.libraries.ldflags", currLDFlags+" \"-L"+precompiledPath.String()+"\" "+libsCmd+" ")
 
@@ -192,6 +286,7 @@ func (b *Builder) compileLibrary(library *libraries.Library, includes []string)
 			library.SourceDir, libraryBuildPath,
 			true, /** recursive **/
 			includes,
+			overrides,
 		)
 		if err != nil {
 			return nil, errors.WithStack(err)
@@ -213,6 +308,7 @@ func (b *Builder) compileLibrary(library *libraries.Library, includes []string)
 			library.SourceDir, libraryBuildPath,
 			false, /** recursive **/
 			includes,
+			overrides,
 		)
 		if err != nil {
 			return nil, errors.WithStack(err)
@@ -225,6 +321,7 @@ func (b *Builder) compileLibrary(library *libraries.Library, includes []string)
 				library.UtilityDir, utilityBuildPath,
 				false, /** recursive **/
 				includes,
+				overrides,
 			)
 			if err != nil {
 				return nil, errors.WithStack(err)
@@ -269,23 +366,93 @@ func (b *Builder) removeUnusedCompiledLibraries(importedLibraries libraries.List
 	return nil
 }
 
-// warnAboutArchIncompatibleLibraries fixdoc
-func (b *Builder) warnAboutArchIncompatibleLibraries(importedLibraries libraries.List) {
+// IncompatibleLibrary is a library used by the sketch that doesn't declare
+// the architecture of the board.
+type IncompatibleLibrary struct {
+	Library            *libraries.Library
+	BoardArchitectures []string
+	// Accepted is true if the library is listed in the accept_incompatible
+	// field of the sketch project file
+	Accepted bool
+}
+
+// IncompatibleLibrariesError is returned when the sketch uses incompatible
+// libraries and the build is set to fail on them.
+type IncompatibleLibrariesError struct {
+	Libraries []*IncompatibleLibrary
+}
+
+func (e *IncompatibleLibrariesError) Error() string {
+	names := []string{}
+	for _, lib := range e.Libraries {
+		names = append(names, lib.Library.Name)
+	}
+	return tr("the following libraries don't declare the architecture of the board: %[1]s. Add them to accept_incompatible in the sketch project file to use them anyway.",
+		strings.Join(names, ", "))
+}
+
+// checkArchIncompatibleLibraries looks for the imported libraries not declaring
+// the architecture of the board, warning about them. The libraries accepted
+// by the sketch project file are only reported in verbose mode. An error is
+// returned if the build is set to fail on the libraries not accepted.
+func (b *Builder) checkArchIncompatibleLibraries(importedLibraries libraries.List) error {
 	archs := []string{b.targetPlatform.Platform.Architecture}
 	overrides, _ := b.buildProperties.GetOk("architecture.override_check")
 	if overrides != "" {
 		archs = append(archs, strings.Split(overrides, ",")...)
 	}
 
+	var accepted []string
+	if b.sketch != nil && b.sketch.Project != nil {
+		accepted = b.sketch.Project.AcceptIncompatible
+	}
+
+	b.incompatibleLibraries = nil
+	notAccepted := []*IncompatibleLibrary{}
 	for _, importedLibrary := range importedLibraries {
-		if !importedLibrary.SupportsAnyArchitectureIn(archs...) {
-			b.logger.Info(
-				tr("WARNING: library %[1]s claims to run on %[2]s architecture(s) and may be incompatible with your current board which runs on %[3]s architecture(s).",
-					importedLibrary.Name,
-					strings.Join(importedLibrary.Architectures, ", "),
-					strings.Join(archs, ", ")))
+		if importedLibrary.SupportsAnyArchitectureIn(archs...) {
+			continue
 		}
+		incompatible := &IncompatibleLibrary{
+			Library:            importedLibrary,
+			BoardArchitectures: archs,
+			Accepted:           slices.Contains(accepted, importedLibrary.Name) || slices.Contains(accepted, importedLibrary.DirName),
+		}
+		b.incompatibleLibraries = append(b.incompatibleLibraries, incompatible)
+		if incompatible.Accepted {
+			if b.logger.Verbose() {
+				b.logger.Info(
+					tr("Library %[1]s claims to run on %[2]s architecture(s), it's accepted by the sketch project file.",
+						importedLibrary.Name,
+						strings.Join(importedLibrary.Architectures, ", ")))
+			}
+			continue
+		}
+		notAccepted = append(notAccepted, incompatible)
+		b.logger.Info(
+			tr("WARNING: library %[1]s claims to run on %[2]s architecture(s) and may be incompatible with your current board which runs on %[3]s architecture(s).",
+				importedLibrary.Name,
+				strings.Join(importedLibrary.Architectures, ", "),
+				strings.Join(archs, ", ")))
+	}
+
+	if b.failOnIncompatibleLibraries && len(notAccepted) > 0 {
+		return &IncompatibleLibrariesError{Libraries: notAccepted}
 	}
+	return nil
+}
+
+// IncompatibleLibraries returns the libraries used by the sketch that don't
+// declare the architecture of the board.
+func (b *Builder) IncompatibleLibraries() []*IncompatibleLibrary {
+	return b.incompatibleLibraries
+}
+
+// SetFailOnIncompatibleLibraries sets the build to fail if the sketch uses
+// libraries that don't declare the architecture of the board, and that are
+// not accepted by the sketch project file.
+func (b *Builder) SetFailOnIncompatibleLibraries(fail bool) {
+	b.failOnIncompatibleLibraries = fail
 }
 
 // printUsedLibraries fixdoc
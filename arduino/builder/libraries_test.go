@@ -0,0 +1,102 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/builder/internal/logger"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryPropertiesOverrides(t *testing.T) {
+	stderr := &bytes.Buffer{}
+	b := &Builder{
+		sketch: &sketch.Sketch{
+			Project: &sketch.Project{
+				LibraryOverrides: sketch.ProjectLibraryOverrides{
+					"CMSIS-DSP": {Flags: []string{"-O3"}, Defines: []string{"ARM_MATH_CM4"}},
+					"LogLib":    {Defines: []string{"NO_DEBUG"}},
+					"Missing":   {Flags: []string{"-O2"}},
+				},
+			},
+		},
+		buildProperties: properties.NewFromHashmap(map[string]string{
+			"compiler.cpp.extra_flags": "-DBOARD_FLAG",
+		}),
+		logger: logger.New(&bytes.Buffer{}, stderr, 0, nil, false, ""),
+	}
+
+	dsp := &libraries.Library{Name: "CMSIS-DSP", DirName: "CMSIS_DSP"}
+	overrides := b.libraryPropertiesOverrides(dsp)
+	require.Equal(t, "-O3 -DARM_MATH_CM4", overrides.Get("compiler.c.extra_flags"))
+	require.Equal(t, "-DBOARD_FLAG -O3 -DARM_MATH_CM4", overrides.Get("compiler.cpp.extra_flags"))
+	require.Equal(t, "-O3 -DARM_MATH_CM4", overrides.Get("compiler.S.extra_flags"))
+
+	// Libraries are matched by folder name too
+	logLib := &libraries.Library{Name: "Log Library", DirName: "LogLib"}
+	require.Equal(t, "-DNO_DEBUG", b.libraryPropertiesOverrides(logLib).Get("compiler.c.extra_flags"))
+
+	require.Nil(t, b.libraryPropertiesOverrides(&libraries.Library{Name: "Servo", DirName: "Servo"}))
+
+	b.warnUnusedLibraryOverrides(libraries.List{dsp, logLib})
+	require.Contains(t, stderr.String(), "Missing")
+	require.NotContains(t, stderr.String(), "CMSIS-DSP")
+}
+
+func TestCheckArchIncompatibleLibraries(t *testing.T) {
+	stdout := &bytes.Buffer{}
+	b := &Builder{
+		sketch: &sketch.Sketch{
+			Project: &sketch.Project{AcceptIncompatible: []string{"LowPower"}},
+		},
+		targetPlatform:  &cores.PlatformRelease{Platform: &cores.Platform{Architecture: "samd"}},
+		buildProperties: properties.NewMap(),
+		logger:          logger.New(stdout, &bytes.Buffer{}, 0, nil, false, ""),
+	}
+
+	servo := &libraries.Library{Name: "Servo", Architectures: []string{"*"}}
+	lowPower := &libraries.Library{Name: "Low Power", DirName: "LowPower", Architectures: []string{"avr"}}
+	wire := &libraries.Library{Name: "Wire", Architectures: []string{"avr", "megaavr"}}
+	libs := libraries.List{servo, lowPower, wire}
+
+	require.NoError(t, b.checkArchIncompatibleLibraries(libs))
+	require.Len(t, b.IncompatibleLibraries(), 2)
+	require.Equal(t, lowPower, b.IncompatibleLibraries()[0].Library)
+	require.True(t, b.IncompatibleLibraries()[0].Accepted)
+	require.Equal(t, wire, b.IncompatibleLibraries()[1].Library)
+	require.False(t, b.IncompatibleLibraries()[1].Accepted)
+	require.Equal(t, []string{"samd"}, b.IncompatibleLibraries()[1].BoardArchitectures)
+	require.Contains(t, stdout.String(), "WARNING: library Wire")
+	require.NotContains(t, stdout.String(), "Low Power")
+
+	b.SetFailOnIncompatibleLibraries(true)
+	err := b.checkArchIncompatibleLibraries(libs)
+	var incompatibleErr *IncompatibleLibrariesError
+	require.ErrorAs(t, err, &incompatibleErr)
+	require.Len(t, incompatibleErr.Libraries, 1)
+	require.Equal(t, wire, incompatibleErr.Libraries[0].Library)
+
+	// The architectures in architecture.override_check are compatible
+	b.buildProperties.Set("architecture.override_check", "avr")
+	require.NoError(t, b.checkArchIncompatibleLibraries(libs))
+	require.Empty(t, b.IncompatibleLibraries())
+}
@@ -16,6 +16,7 @@
 package builder
 
 import (
+	"bytes"
 	"strings"
 
 	f "github.com/arduino/arduino-cli/internal/algorithms"
@@ -71,7 +72,7 @@ func (b *Builder) link() error {
 			properties.SetPath("archive_file_path", archive)
 			properties.SetPath("object_file", object)
 
-			command, err := b.prepareCommandForRecipe(properties, "recipe.ar.pattern", false)
+			command, err := prepareCommandForRecipe(properties, "recipe.ar.pattern", false)
 			if err != nil {
 				return errors.WithStack(err)
 			}
@@ -92,10 +93,18 @@ func (b *Builder) link() error {
 	properties.Set("archive_file_path", b.buildArtifacts.coreArchiveFilePath.String())
 	properties.Set("object_files", objectFileList)
 
-	command, err := b.prepareCommandForRecipe(properties, "recipe.c.combine.pattern", false)
+	command, err := prepareCommandForRecipe(properties, "recipe.c.combine.pattern", false)
 	if err != nil {
 		return err
 	}
 
-	return b.execCommand(command)
+	if len(b.usedPrecompiledLibraries) == 0 {
+		return b.execCommand(command)
+	}
+	linkerErrors := &bytes.Buffer{}
+	if err := b.execCommandCopyingStderr(command, linkerErrors); err != nil {
+		b.diagnosePrecompiledLibrariesLinkError(linkerErrors.String())
+		return err
+	}
+	return nil
 }
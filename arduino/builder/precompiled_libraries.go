@@ -0,0 +1,115 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"io"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/builder/internal/logger"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// usedPrecompiledLibrary is a precompiled library linked in the build
+type usedPrecompiledLibrary struct {
+	library *libraries.Library
+	folder  *paths.Path
+}
+
+// linkerABIMismatchMessages are fragments of the linker errors reported when
+// an object or an archive has been compiled for an incompatible target or with
+// incompatible ABI flags.
+var linkerABIMismatchMessages = []string{
+	"uses VFP register arguments",
+	"does not use VFP register arguments",
+	"skipping incompatible",
+	"file format not recognized",
+	"file in wrong format",
+	"Relocations in generic ELF",
+	"failed to merge target specific data",
+	"is incompatible with",
+	"conflicting CPU architectures",
+	"can't link hard-float modules with soft-float modules",
+	"can't link soft-float modules with double-float modules",
+}
+
+// diagnosePrecompiledLibrariesLinkError looks for ABI mismatches in the
+// output of a failed link and, if found, explains which precompiled libraries
+// are likely the cause.
+func (b *Builder) diagnosePrecompiledLibrariesLinkError(linkerOutput string) {
+	mismatch := false
+	for _, msg := range linkerABIMismatchMessages {
+		if strings.Contains(linkerOutput, msg) {
+			mismatch = true
+			break
+		}
+	}
+	if !mismatch {
+		return
+	}
+
+	// Blame the libraries mentioned in the linker output, or all of them if
+	// none is explicitly mentioned.
+	suspects := []*usedPrecompiledLibrary{}
+	for _, used := range b.usedPrecompiledLibraries {
+		if strings.Contains(linkerOutput, used.folder.String()) || strings.Contains(linkerOutput, used.library.Name) {
+			suspects = append(suspects, used)
+		}
+	}
+	if len(suspects) == 0 {
+		suspects = b.usedPrecompiledLibraries
+	}
+
+	mcu, fpuSpecs := precompiledLibrarySpecs(b.buildProperties)
+	target := mcu
+	if fpuSpecs != "" {
+		target += "/" + fpuSpecs
+	}
+	for _, used := range suspects {
+		b.logger.Warn(tr("The precompiled library %[1]s in %[2]s seems to be incompatible with the current build (%[3]s): it may have been compiled for another target or with different compiler flags.",
+			used.library.Name, used.folder, target))
+	}
+	b.logger.Warn(tr("Rebuild the precompiled libraries for this board with `%[1]s` or ask their maintainers for an updated release.", "arduino-cli lib precompile"))
+}
+
+// PrecompiledLibraryFolder returns the folder where the precompiled archives of
+// the library must be placed to be used in a build with the given build
+// properties.
+func PrecompiledLibraryFolder(library *libraries.Library, buildProperties *properties.Map) *paths.Path {
+	mcu, fpuSpecs := precompiledLibrarySpecs(buildProperties)
+	folder := library.SourceDir.Join(mcu)
+	if fpuSpecs != "" {
+		folder = folder.Join(fpuSpecs)
+	}
+	return folder
+}
+
+// ArchiveObjectFiles creates (or updates) the archive archiveFilePath with the
+// given object files, using the archiver recipe of the given build properties.
+func ArchiveObjectFiles(buildProperties *properties.Map, archiveFilePath *paths.Path, objectFiles paths.PathList, stdout, stderr io.Writer, verbose bool) error {
+	verbosity := 0
+	if verbose {
+		verbosity = 1
+	}
+	b := &Builder{
+		buildProperties: buildProperties,
+		logger:          logger.New(stdout, stderr, verbosity, nil, false, ""),
+	}
+	_, err := b.archiveCompiledFiles(archiveFilePath.Parent(), paths.New(archiveFilePath.Base()), objectFiles)
+	return err
+}
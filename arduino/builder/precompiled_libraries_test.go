@@ -0,0 +1,66 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/builder/internal/logger"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecompiledLibraryFolder(t *testing.T) {
+	lib := &libraries.Library{Name: "MyLib", SourceDir: paths.New("MyLib", "src")}
+
+	props := properties.NewFromHashmap(map[string]string{
+		"build.mcu":            "cortex-m4",
+		"recipe.cpp.o.pattern": `"g++" -mcpu={build.mcu} -mfloat-abi=hard -mfpu=fpv4-sp-d16 -c {source_file}`,
+	})
+	require.Equal(t, paths.New("MyLib", "src", "cortex-m4", "fpv4-sp-d16-hard"), PrecompiledLibraryFolder(lib, props))
+
+	props = properties.NewFromHashmap(map[string]string{
+		"build.mcu":            "atmega328p",
+		"recipe.cpp.o.pattern": `"g++" -mmcu={build.mcu} -c {source_file}`,
+	})
+	require.Equal(t, paths.New("MyLib", "src", "atmega328p"), PrecompiledLibraryFolder(lib, props))
+}
+
+func TestDiagnosePrecompiledLibrariesLinkError(t *testing.T) {
+	stderr := &bytes.Buffer{}
+	b := &Builder{
+		buildProperties: properties.NewFromHashmap(map[string]string{
+			"build.mcu":            "cortex-m4",
+			"recipe.cpp.o.pattern": `"g++" -mfloat-abi=hard -mfpu=fpv4-sp-d16 -c {source_file}`,
+		}),
+		logger: logger.New(&bytes.Buffer{}, stderr, 0, nil, false, ""),
+		usedPrecompiledLibraries: []*usedPrecompiledLibrary{
+			{library: &libraries.Library{Name: "LibA"}, folder: paths.New("/libs/LibA/src/cortex-m4")},
+			{library: &libraries.Library{Name: "LibB"}, folder: paths.New("/libs/LibB/src/cortex-m4")},
+		},
+	}
+
+	// Unrelated errors are not diagnosed
+	b.diagnosePrecompiledLibrariesLinkError("undefined reference to `foo'")
+	require.Empty(t, stderr.String())
+
+	b.diagnosePrecompiledLibrariesLinkError("ld: error: /tmp/sketch.elf uses VFP register arguments, /libs/LibB/src/cortex-m4/libLibB.a(b.o) does not")
+	require.NotContains(t, stderr.String(), "LibA")
+	require.Contains(t, stderr.String(), "The precompiled library LibB in /libs/LibB/src/cortex-m4 seems to be incompatible with the current build (cortex-m4/fpv4-sp-d16-hard)")
+}
@@ -17,14 +17,17 @@ package builder
 
 import (
 	"github.com/arduino/arduino-cli/arduino/builder/internal/preprocessor"
+	"github.com/arduino/arduino-cli/arduino/builder/internal/utils"
 	"github.com/arduino/go-paths-helper"
 )
 
 // preprocessSketch fixdoc
 func (b *Builder) preprocessSketch(includes paths.PathList) error {
+	ctx, cancel := utils.ToolContext(b.ctx, b.toolTimeout, tr("Generation of the function prototypes"))
+	defer cancel()
 	// In the future we might change the preprocessor
 	normalOutput, verboseOutput, err := preprocessor.PreprocessSketchWithCtags(
-		b.sketch, b.buildPath, includes, b.lineOffset,
+		ctx, b.sketch, b.buildPath, includes, b.lineOffset,
 		b.buildProperties, b.onlyUpdateCompilationDatabase,
 	)
 	if b.logger.Verbose() {
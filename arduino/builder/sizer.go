@@ -73,7 +73,7 @@ func (b *Builder) size() error {
 }
 
 func (b *Builder) checkSizeAdvanced() (ExecutablesFileSections, error) {
-	command, err := b.prepareCommandForRecipe(b.buildProperties, "recipe.advanced_size.pattern", false)
+	command, err := prepareCommandForRecipe(b.buildProperties, "recipe.advanced_size.pattern", false)
 	if err != nil {
 		return nil, errors.New(tr("Error while determining sketch size: %s", err))
 	}
@@ -83,7 +83,9 @@ func (b *Builder) checkSizeAdvanced() (ExecutablesFileSections, error) {
 	out := &bytes.Buffer{}
 	command.RedirectStdoutTo(out)
 	command.RedirectStderrTo(b.logger.Stderr())
-	if err := command.Start(); err != nil {
+	ctx, cancel := b.toolContext(command)
+	defer cancel()
+	if err := command.StartWithinContext(ctx); err != nil {
 		return nil, errors.New(tr("Error while determining sketch size: %s", err))
 	}
 	if err := command.Wait(); err != nil {
@@ -117,9 +119,9 @@ func (b *Builder) checkSizeAdvanced() (ExecutablesFileSections, error) {
 	case "warning":
 		b.logger.Warn(resp.Output)
 	case "info":
-		b.logger.Info(resp.Output)
+		b.logger.Summary(resp.Output)
 	default:
-		return executableSectionsSize, fmt.Errorf("invalid '%s' severity from sketch sizer: it must be 'error', 'warning' or 'info'", resp.Severity)
+		return executableSectionsSize, errors.New(tr("invalid '%[1]s' severity from sketch sizer: it must be 'error', 'warning' or 'info'", resp.Severity))
 	}
 	return executableSectionsSize, nil
 }
@@ -154,19 +156,19 @@ func (b *Builder) checkSize() (ExecutablesFileSections, error) {
 		return nil, nil
 	}
 
-	b.logger.Info(tr("Sketch uses %[1]s bytes (%[3]s%%) of program storage space. Maximum is %[2]s bytes.",
+	b.logger.Summary(tr("Sketch uses %[1]s bytes (%[3]s%%) of program storage space. Maximum is %[2]s bytes.",
 		strconv.Itoa(textSize),
 		strconv.Itoa(maxTextSize),
 		strconv.Itoa(textSize*100/maxTextSize)))
 	if dataSize >= 0 {
 		if maxDataSize > 0 {
-			b.logger.Info(tr("Global variables use %[1]s bytes (%[3]s%%) of dynamic memory, leaving %[4]s bytes for local variables. Maximum is %[2]s bytes.",
+			b.logger.Summary(tr("Global variables use %[1]s bytes (%[3]s%%) of dynamic memory, leaving %[4]s bytes for local variables. Maximum is %[2]s bytes.",
 				strconv.Itoa(dataSize),
 				strconv.Itoa(maxDataSize),
 				strconv.Itoa(dataSize*100/maxDataSize),
 				strconv.Itoa(maxDataSize-dataSize)))
 		} else {
-			b.logger.Info(tr("Global variables use %[1]s bytes of dynamic memory.", strconv.Itoa(dataSize)))
+			b.logger.Summary(tr("Global variables use %[1]s bytes of dynamic memory.", strconv.Itoa(dataSize)))
 		}
 	}
 
@@ -209,7 +211,7 @@ func (b *Builder) checkSize() (ExecutablesFileSections, error) {
 }
 
 func (b *Builder) execSizeRecipe(properties *properties.Map) (textSize int, dataSize int, eepromSize int, resErr error) {
-	command, err := b.prepareCommandForRecipe(properties, "recipe.size.pattern", false)
+	command, err := prepareCommandForRecipe(properties, "recipe.size.pattern", false)
 	if err != nil {
 		resErr = fmt.Errorf(tr("Error while determining sketch size: %s"), err)
 		return
@@ -220,7 +222,9 @@ func (b *Builder) execSizeRecipe(properties *properties.Map) (textSize int, data
 	commandStdout := &bytes.Buffer{}
 	command.RedirectStdoutTo(commandStdout)
 	command.RedirectStderrTo(b.logger.Stderr())
-	if err := command.Start(); err != nil {
+	ctx, cancel := b.toolContext(command)
+	defer cancel()
+	if err := command.StartWithinContext(ctx); err != nil {
 		resErr = fmt.Errorf(tr("Error while determining sketch size: %s"), err)
 		return
 	}
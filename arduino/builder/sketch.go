@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/builder/cpp"
+	arduinoutils "github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/i18n"
 	f "github.com/arduino/arduino-cli/internal/algorithms"
 	"github.com/arduino/go-paths-helper"
@@ -41,7 +42,7 @@ var (
 // The .ino files are merged together to create a .cpp file (by the way, the
 // .cpp file still needs to be Arduino-preprocessed to compile).
 func (b *Builder) prepareSketchBuildPath() error {
-	if err := b.sketchBuildPath.MkdirAll(); err != nil {
+	if err := arduinoutils.LongPath(b.sketchBuildPath).MkdirAll(); err != nil {
 		return errors.Wrap(err, tr("unable to create a folder to save the sketch"))
 	}
 
@@ -51,7 +52,7 @@ func (b *Builder) prepareSketchBuildPath() error {
 	}
 
 	destFile := b.sketchBuildPath.Join(b.sketch.MainFile.Base() + ".cpp")
-	if err := destFile.WriteFile([]byte(mergedSource)); err != nil {
+	if err := arduinoutils.LongPath(destFile).WriteFile([]byte(mergedSource)); err != nil {
 		return err
 	}
 
@@ -120,7 +121,7 @@ func (b *Builder) sketchCopyAdditionalFiles(buildPath *paths.Path, overrides map
 			return errors.Wrap(err, tr("unable to compute relative path to the sketch for the item"))
 		}
 
-		targetPath := buildPath.JoinPath(relpath)
+		targetPath := arduinoutils.LongPath(buildPath.JoinPath(relpath))
 		// create the directory containing the target
 		if err = targetPath.Parent().MkdirAll(); err != nil {
 			return errors.Wrap(err, tr("unable to create the folder containing the item"))
@@ -185,6 +186,7 @@ func (b *Builder) buildSketch(includesFolders paths.PathList) error {
 		b.sketchBuildPath, b.sketchBuildPath,
 		false, /** recursive **/
 		includes,
+		nil, /** properties overrides **/
 	)
 	if err != nil {
 		return errors.WithStack(err)
@@ -197,6 +199,7 @@ func (b *Builder) buildSketch(includesFolders paths.PathList) error {
 			sketchSrcPath, sketchSrcPath,
 			true, /** recursive **/
 			includes,
+			nil, /** properties overrides **/
 		)
 		if err != nil {
 			return errors.WithStack(err)
@@ -0,0 +1,146 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"sync"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// The build phases measured in BuildTimings
+const (
+	PhaseLibrariesDetection  = "libraries-detection"
+	PhasePrototypeGeneration = "prototype-generation"
+	PhaseSketch              = "sketch"
+	PhaseLibraries           = "libraries"
+	PhaseCore                = "core"
+	PhaseLink                = "link"
+	PhaseSize                = "size"
+)
+
+// PhaseObjcopy is the phase that creates the binaries to upload from the
+// executable, it's not measured in BuildTimings.
+const PhaseObjcopy = "objcopy"
+
+// Phases are the phases of the build, in execution order, that can be
+// selected to print their verbose output.
+var Phases = []string{
+	PhaseLibrariesDetection,
+	PhasePrototypeGeneration,
+	PhaseSketch,
+	PhaseLibraries,
+	PhaseCore,
+	PhaseLink,
+	PhaseObjcopy,
+	PhaseSize,
+}
+
+// PhaseTiming is the wall time spent in a build phase
+type PhaseTiming struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+}
+
+// FileTiming is the wall time spent compiling a source file
+type FileTiming struct {
+	Path     string        `json:"path"`
+	Duration time.Duration `json:"duration"`
+	UpToDate bool          `json:"up_to_date"`
+}
+
+// BuildTimings collects the wall time spent in each phase of the build
+// and in the compilation of each source file.
+type BuildTimings struct {
+	mux    sync.Mutex
+	phases []*PhaseTiming
+	files  []*FileTiming
+	ccache *CcacheStatistics
+}
+
+// measurePhase runs f and records its execution time in the given phase.
+func (t *BuildTimings) measurePhase(phase string, f func() error) error {
+	start := time.Now()
+	err := f()
+	t.mux.Lock()
+	t.phases = append(t.phases, &PhaseTiming{Name: phase, Duration: time.Since(start)})
+	t.mux.Unlock()
+	return err
+}
+
+// addFile records the compilation time of a source file, it's safe
+// to call from concurrent compile jobs.
+func (t *BuildTimings) addFile(path string, duration time.Duration, upToDate bool) {
+	t.mux.Lock()
+	t.files = append(t.files, &FileTiming{Path: path, Duration: duration, UpToDate: upToDate})
+	t.mux.Unlock()
+}
+
+// Phases returns the timings of the build phases in execution order.
+func (t *BuildTimings) Phases() []*PhaseTiming {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return append([]*PhaseTiming{}, t.phases...)
+}
+
+// Files returns the timings of the compiled source files in completion order.
+func (t *BuildTimings) Files() []*FileTiming {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return append([]*FileTiming{}, t.files...)
+}
+
+// setCcacheStatistics records the results of the compilations run through ccache.
+func (t *BuildTimings) setCcacheStatistics(stats *CcacheStatistics) {
+	t.mux.Lock()
+	t.ccache = stats
+	t.mux.Unlock()
+}
+
+// Ccache returns the results of the compilations run through ccache, or nil
+// if ccache is not enabled or its statistics are not available.
+func (t *BuildTimings) Ccache() *CcacheStatistics {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.ccache
+}
+
+// ToRPCBuildTimings converts the timings into a *rpc.BuildTimings
+func (t *BuildTimings) ToRPCBuildTimings() *rpc.BuildTimings {
+	res := &rpc.BuildTimings{}
+	for _, phase := range t.Phases() {
+		res.Phases = append(res.Phases, &rpc.BuildPhaseTiming{
+			Name:       phase.Name,
+			DurationMs: phase.Duration.Milliseconds(),
+		})
+	}
+	for _, file := range t.Files() {
+		res.Files = append(res.Files, &rpc.BuildFileTiming{
+			Path:       file.Path,
+			DurationMs: file.Duration.Milliseconds(),
+			UpToDate:   file.UpToDate,
+		})
+	}
+	if ccache := t.Ccache(); ccache != nil {
+		res.Ccache = &rpc.CcacheStatistics{
+			Hits:        int32(ccache.Hits),
+			Misses:      int32(ccache.Misses),
+			Uncacheable: int32(ccache.Uncacheable),
+		}
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTimings(t *testing.T) {
+	timings := &BuildTimings{}
+	require.NoError(t, timings.measurePhase(PhaseCore, func() error { return nil }))
+	testErr := errors.New("link failed")
+	require.Equal(t, testErr, timings.measurePhase(PhaseLink, func() error { return testErr }))
+	timings.addFile("sketch.ino.cpp", 1500*time.Millisecond, false)
+	timings.addFile("wiring.c", 0, true)
+
+	phases := timings.Phases()
+	require.Len(t, phases, 2)
+	require.Equal(t, PhaseCore, phases[0].Name)
+	require.Equal(t, PhaseLink, phases[1].Name)
+
+	rpcTimings := timings.ToRPCBuildTimings()
+	require.Len(t, rpcTimings.GetPhases(), 2)
+	require.Len(t, rpcTimings.GetFiles(), 2)
+	require.Equal(t, "sketch.ino.cpp", rpcTimings.GetFiles()[0].GetPath())
+	require.Equal(t, int64(1500), rpcTimings.GetFiles()[0].GetDurationMs())
+	require.False(t, rpcTimings.GetFiles()[0].GetUpToDate())
+	require.True(t, rpcTimings.GetFiles()[1].GetUpToDate())
+}
@@ -0,0 +1,243 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"debug/elf"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// ToolchainProblem is a tool referenced by a recipe that can't be run
+type ToolchainProblem struct {
+	Tool       string
+	Reason     string
+	Suggestion string
+	Recipes    []string
+}
+
+// ToolchainError reports all the tools referenced by the recipes that can't be
+// run on this host.
+type ToolchainError struct {
+	Problems []*ToolchainProblem
+}
+
+func (e *ToolchainError) Error() string {
+	msg := tr("The toolchain required by the build is not usable:")
+	for _, problem := range e.Problems {
+		msg += "\n  - " + fmt.Sprintf("%s: %s (%s: %s)", problem.Tool, problem.Reason, tr("used by"), strings.Join(problem.Recipes, ", "))
+		if problem.Suggestion != "" {
+			msg += "\n    " + problem.Suggestion
+		}
+	}
+	return msg
+}
+
+var runtimeToolPathRegexp = regexp.MustCompile(`\{runtime\.tools\.([^}]+)\.path\}`)
+
+// checkToolchain verifies that the executables run by the recipes exist and
+// can be run on this host, so that a missing tool is reported before starting
+// the build instead of failing in the middle of it.
+func (b *Builder) checkToolchain() error {
+	problems := map[string]*ToolchainProblem{}
+	for _, recipe := range toolchainRecipes(b.buildProperties) {
+		executable, err := recipeExecutable(b.buildProperties, recipe)
+		if err != nil || executable == "" {
+			// Malformed recipes are reported when they are run
+			continue
+		}
+		problem := b.checkToolchainExecutable(executable)
+		if problem == nil {
+			continue
+		}
+		if found, ok := problems[problem.Tool]; ok {
+			found.Recipes = append(found.Recipes, recipe)
+			continue
+		}
+		problem.Recipes = []string{recipe}
+		problems[problem.Tool] = problem
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	res := &ToolchainError{}
+	for _, problem := range problems {
+		res.Problems = append(res.Problems, problem)
+	}
+	sort.Slice(res.Problems, func(i, j int) bool { return res.Problems[i].Tool < res.Problems[j].Tool })
+	return res
+}
+
+// toolchainRecipes returns the sorted names of the recipes run by the build
+func toolchainRecipes(buildProperties *properties.Map) []string {
+	res := []string{}
+	for _, key := range buildProperties.Keys() {
+		if !strings.HasPrefix(key, "recipe.") {
+			continue
+		}
+		if strings.HasSuffix(key, ".pattern") || key == "recipe.preproc.macros" {
+			res = append(res, key)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// recipeExecutable returns the executable run by the given recipe, with the
+// properties expanded.
+func recipeExecutable(buildProperties *properties.Map, recipe string) (string, error) {
+	pattern := buildProperties.ExpandPropsInString(buildProperties.Get(recipe))
+	parts, err := properties.SplitQuotedString(pattern, `"'`, false)
+	if err != nil || len(parts) == 0 {
+		return "", err
+	}
+	return parts[0], nil
+}
+
+// checkToolchainExecutable returns the problem preventing the executable to be
+// run on this host, or nil if it looks fine.
+func (b *Builder) checkToolchainExecutable(executable string) *ToolchainProblem {
+	if match := runtimeToolPathRegexp.FindStringSubmatch(executable); match != nil {
+		return &ToolchainProblem{
+			Tool:       match[1],
+			Reason:     tr("tool not installed"),
+			Suggestion: b.missingToolSuggestion(match[1]),
+		}
+	}
+	if strings.Contains(executable, "{") {
+		// Depends on properties set while building, can't be checked in advance
+		return nil
+	}
+
+	if !strings.ContainsAny(executable, `/\`) {
+		if _, err := exec.LookPath(executable); err != nil {
+			return &ToolchainProblem{
+				Tool:       executable,
+				Reason:     tr("not found in PATH"),
+				Suggestion: tr("Install it or add the folder containing it to the PATH."),
+			}
+		}
+		return nil
+	}
+
+	path := paths.New(executable)
+	if runtime.GOOS == "windows" && path.Ext() == "" && !path.Exist() {
+		path = paths.New(executable + ".exe")
+	}
+	if !path.Exist() {
+		return &ToolchainProblem{
+			Tool:       executable,
+			Reason:     tr("file not found"),
+			Suggestion: b.reinstallPlatformSuggestion(),
+		}
+	}
+	if isDir, _ := path.IsDirCheck(); isDir {
+		return &ToolchainProblem{
+			Tool:       executable,
+			Reason:     tr("is a directory"),
+			Suggestion: tr("Check the recipes of the platform %s.", b.platformName()),
+		}
+	}
+	if runtime.GOOS != "windows" {
+		if info, err := path.Stat(); err == nil && info.Mode().Perm()&0111 == 0 {
+			return &ToolchainProblem{
+				Tool:       executable,
+				Reason:     tr("not executable"),
+				Suggestion: tr("Check the permissions of the file or reinstall the platform %s.", b.platformName()),
+			}
+		}
+	}
+	if arch := executableArch(path); arch != "" && (runtime.GOOS != "linux" || !isHostCompatibleArch(arch)) {
+		return &ToolchainProblem{
+			Tool:       executable,
+			Reason:     tr("built for %[1]s, not for this host (%[2]s)", "linux/"+arch, runtime.GOOS+"/"+runtime.GOARCH),
+			Suggestion: b.reinstallPlatformSuggestion(),
+		}
+	}
+	return nil
+}
+
+// missingToolSuggestion returns how to install the tool with the given name
+func (b *Builder) missingToolSuggestion(name string) string {
+	for _, platform := range []*cores.PlatformRelease{b.actualPlatform, b.targetPlatform} {
+		if platform == nil {
+			continue
+		}
+		for _, dep := range platform.ToolDependencies {
+			if name == dep.ToolName || name == dep.ToolName+"-"+dep.ToolVersion.String() {
+				return tr("%[1]s is required by the platform %[2]s, install it with: %[3]s",
+					dep.String(), platform.Platform.String(), "arduino-cli core install "+platform.Platform.String())
+			}
+		}
+	}
+	return tr("Install the platform providing the tool %s.", name)
+}
+
+// reinstallPlatformSuggestion returns how to fix a broken platform installation
+func (b *Builder) reinstallPlatformSuggestion() string {
+	return tr("Reinstall the platform with: %s", "arduino-cli core uninstall "+b.platformName()+" && arduino-cli core install "+b.platformName())
+}
+
+func (b *Builder) platformName() string {
+	if b.actualPlatform == nil {
+		return ""
+	}
+	return b.actualPlatform.Platform.String()
+}
+
+// executableArch returns the architecture of a Linux (ELF) executable in the
+// GOARCH format, or an empty string if the format or architecture is not known.
+func executableArch(path *paths.Path) string {
+	f, err := elf.Open(path.String())
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	switch f.Machine {
+	case elf.EM_X86_64:
+		return "amd64"
+	case elf.EM_386:
+		return "386"
+	case elf.EM_AARCH64:
+		return "arm64"
+	case elf.EM_ARM:
+		return "arm"
+	}
+	return ""
+}
+
+// isHostCompatibleArch returns true if an executable built for the given
+// architecture can run on this host.
+func isHostCompatibleArch(arch string) bool {
+	switch runtime.GOARCH {
+	case arch:
+		return true
+	case "amd64":
+		return arch == "386"
+	case "arm64":
+		return arch == "arm"
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestCheckToolchain(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not checked on Windows")
+	}
+	tmp := paths.New(t.TempDir())
+	notExecutable := tmp.Join("objcopy")
+	require.NoError(t, notExecutable.WriteFile([]byte("#!/bin/sh\n")))
+	require.NoError(t, os.Chmod(notExecutable.String(), 0644))
+
+	buildProperties := properties.NewFromHashmap(map[string]string{
+		"compiler.path":                   "{runtime.tools.avr-gcc.path}/bin/",
+		"recipe.c.o.pattern":              `"{compiler.path}avr-gcc" -c "{source_file}"`,
+		"recipe.cpp.o.pattern":            `"{compiler.path}avr-g++" -c "{source_file}"`,
+		"recipe.ar.pattern":               `"` + tmp.Join("missing", "avr-gcc-ar").String() + `" rcs "{archive_file_path}"`,
+		"recipe.objcopy.hex.pattern":      `"` + notExecutable.String() + `" -O ihex`,
+		"recipe.size.pattern":             `sh -c "echo {build.path}"`,
+		"recipe.hooks.prebuild.1.pattern": `"{build.unknown}/hook.sh"`,
+		"recipe.preproc.macros":           "not-existing-preprocessor -E",
+		"recipe.output.tmp_file":          "not-existing-recipe",
+	})
+	platform := &cores.PlatformRelease{
+		Platform: &cores.Platform{Architecture: "avr", Package: &cores.Package{Name: "arduino"}},
+		ToolDependencies: cores.ToolDependencies{
+			{ToolName: "avr-gcc", ToolVersion: semver.ParseRelaxed("7.3.0-atmel3.6.1-arduino7"), ToolPackager: "arduino"},
+		},
+	}
+	b := &Builder{buildProperties: buildProperties, actualPlatform: platform, targetPlatform: platform}
+
+	err := b.checkToolchain()
+	var toolchainErr *ToolchainError
+	require.True(t, errors.As(err, &toolchainErr))
+	require.Len(t, toolchainErr.Problems, 4)
+
+	require.Equal(t, tmp.Join("missing", "avr-gcc-ar").String(), toolchainErr.Problems[0].Tool)
+	require.Equal(t, "file not found", toolchainErr.Problems[0].Reason)
+	require.Equal(t, notExecutable.String(), toolchainErr.Problems[1].Tool)
+	require.Equal(t, "not executable", toolchainErr.Problems[1].Reason)
+	require.Equal(t, []string{"recipe.objcopy.hex.pattern"}, toolchainErr.Problems[1].Recipes)
+	require.Equal(t, "avr-gcc", toolchainErr.Problems[2].Tool)
+	require.Equal(t, "tool not installed", toolchainErr.Problems[2].Reason)
+	require.Equal(t, []string{"recipe.c.o.pattern", "recipe.cpp.o.pattern"}, toolchainErr.Problems[2].Recipes)
+	require.Contains(t, toolchainErr.Problems[2].Suggestion, "arduino:avr-gcc@7.3.0-atmel3.6.1-arduino7")
+	require.Contains(t, toolchainErr.Problems[2].Suggestion, "arduino-cli core install arduino:avr")
+	require.Equal(t, "not-existing-preprocessor", toolchainErr.Problems[3].Tool)
+	require.Equal(t, "not found in PATH", toolchainErr.Problems[3].Reason)
+
+	require.Contains(t, err.Error(), "avr-gcc: tool not installed")
+
+	b.buildProperties = properties.NewFromHashmap(map[string]string{
+		"recipe.size.pattern": `sh -c "echo {build.path}"`,
+	})
+	require.NoError(t, b.checkToolchain())
+}
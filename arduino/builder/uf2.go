@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/arduino/arduino-cli/arduino/uf2"
+	"github.com/marcinbor85/gohex"
+)
+
+// exportUF2 converts the compiled sketch to the UF2 format, for the boards
+// uploaded through a UF2 bootloader (declared with the `build.uf2.family_id`
+// property). The conversion is skipped if the recipes of the platform already
+// produced an up to date .uf2 file.
+func (b *Builder) exportUF2() error {
+	if b.onlyUpdateCompilationDatabase {
+		return nil
+	}
+	familyIDProp, ok := b.buildProperties.GetOk("build.uf2.family_id")
+	if !ok {
+		return nil
+	}
+	familyID, err := strconv.ParseUint(familyIDProp, 0, 32)
+	if err != nil {
+		return fmt.Errorf(tr("invalid value for %[1]s: %[2]s"), "build.uf2.family_id", familyIDProp)
+	}
+
+	sketchFileName := b.sketch.MainFile.Base()
+	hexFile := b.buildPath.Join(sketchFileName + ".hex")
+	binFile := b.buildPath.Join(sketchFileName + ".bin")
+	uf2File := b.buildPath.Join(sketchFileName + ".uf2")
+
+	var segments []uf2.Segment
+	source := hexFile
+	if hexFile.Exist() {
+		data, err := hexFile.ReadFile()
+		if err != nil {
+			return err
+		}
+		mem := gohex.NewMemory()
+		if err := mem.ParseIntelHex(bytes.NewReader(data)); err != nil {
+			return fmt.Errorf("%s: %w", hexFile, err)
+		}
+		for _, segment := range mem.GetDataSegments() {
+			segments = append(segments, uf2.Segment{Address: segment.Address, Data: segment.Data})
+		}
+	} else if binFile.Exist() {
+		// A binary file doesn't carry the address where it must be flashed
+		baseAddressProp := b.buildProperties.Get("build.uf2.base_address")
+		baseAddress, err := strconv.ParseUint(baseAddressProp, 0, 32)
+		if err != nil {
+			return fmt.Errorf(tr("invalid value for %[1]s: %[2]s"), "build.uf2.base_address", baseAddressProp)
+		}
+		data, err := binFile.ReadFile()
+		if err != nil {
+			return err
+		}
+		segments = append(segments, uf2.Segment{Address: uint32(baseAddress), Data: data})
+		source = binFile
+	} else {
+		if b.logger.Verbose() {
+			b.logger.Warn(tr("Cannot export the UF2 file: no .hex or .bin file found"))
+		}
+		return nil
+	}
+
+	if uf2Info, err := uf2File.Stat(); err == nil {
+		if sourceInfo, err := source.Stat(); err == nil && !uf2Info.ModTime().Before(sourceInfo.ModTime()) {
+			// Already exported by the platform
+			return nil
+		}
+	}
+	if b.logger.Verbose() {
+		b.logger.Info(tr("Exporting %[1]s to %[2]s", source.Base(), uf2File.Base()))
+	}
+	return uf2File.WriteFile(uf2.Encode(segments, uint32(familyID)))
+}
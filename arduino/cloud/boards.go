@@ -0,0 +1,56 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cloud
+
+import (
+	"strings"
+)
+
+// cryptoBoards are the boards with a crypto element, authenticated to the
+// cloud with a certificate, by FQBN (without the board options)
+var cryptoBoards = map[string]string{
+	"arduino:samd:mkr1000":                  "mkr1000",
+	"arduino:samd:mkrwifi1010":              "mkrwifi1010",
+	"arduino:samd:mkrgsm1400":               "gsm",
+	"arduino:samd:mkrnb1500":                "nb",
+	"arduino:samd:nano_33_iot":              "nano_33_iot",
+	"arduino:mbed_nano:nanorp2040connect":   "nanorp2040connect",
+	"arduino:mbed_portenta:envie_m7":        "envie_m7",
+	"arduino:mbed_nicla:nicla_vision":       "nicla_vision",
+	"arduino:mbed_opta:opta":                "opta",
+	"arduino:mbed_giga:giga":                "giga",
+	"arduino:renesas_portenta:portenta_c33": "portenta_c33",
+}
+
+// DeviceType returns the type of device of the board with the given FQBN and
+// whether it is authenticated with a certificate (crypto element) or with a
+// secret key.
+func DeviceType(fqbn string) (deviceType string, useCertificate bool) {
+	// Remove the board options
+	if parts := strings.SplitN(fqbn, ":", 4); len(parts) == 4 {
+		fqbn = strings.Join(parts[:3], ":")
+	}
+	if deviceType, ok := cryptoBoards[fqbn]; ok {
+		return deviceType, true
+	}
+	switch {
+	case strings.HasPrefix(fqbn, "esp32:esp32:"):
+		return "esp32", false
+	case strings.HasPrefix(fqbn, "esp8266:esp8266:"):
+		return "esp8266", false
+	}
+	return "login_and_secretkey_wifi", false
+}
@@ -0,0 +1,164 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package cloud is a client of the public Arduino IoT Cloud API, it creates
+// and provisions the devices and binds them to the things of the cloud.
+package cloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/i18n"
+)
+
+var tr = i18n.Tr
+
+// Client is an authenticated client of the Arduino IoT Cloud API. The
+// credentials are the ones of an API key created in the Arduino Cloud.
+type Client struct {
+	http         *http.Client
+	baseURL      string
+	clientID     string
+	clientSecret string
+	organization string
+
+	token       string
+	tokenExpiry time.Time
+}
+
+// NewClient creates a Client of the API at baseURL, the optional organization
+// is the ID of the shared space to work on, if empty the personal space of
+// the owner of the API key is used.
+func NewClient(httpClient *http.Client, baseURL, clientID, clientSecret, organization string) *Client {
+	return &Client{
+		http:         httpClient,
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		organization: organization,
+	}
+}
+
+// APIError is an error returned by the Arduino IoT Cloud API
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return tr("Arduino Cloud API error: %s", http.StatusText(e.StatusCode))
+	}
+	return tr("Arduino Cloud API error: %[1]s (%[2]s)", e.Message, http.StatusText(e.StatusCode))
+}
+
+// newAPIError reads the error message from the body of a failed response
+func newAPIError(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+	msg := struct {
+		Detail  string `json:"detail"`
+		Message string `json:"message"`
+		Error   string `json:"error_description"`
+	}{}
+	res := &APIError{StatusCode: resp.StatusCode}
+	if json.Unmarshal(body, &msg) == nil {
+		for _, m := range []string{msg.Detail, msg.Message, msg.Error} {
+			if m != "" {
+				res.Message = m
+				break
+			}
+		}
+	}
+	return res
+}
+
+// authenticate gets an access token with the client credentials, the token is
+// reused until it expires
+func (c *Client) authenticate() error {
+	if c.token != "" && time.Now().Before(c.tokenExpiry) {
+		return nil
+	}
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+	form.Set("audience", "https://api2.arduino.cc/iot")
+	resp, err := c.http.PostForm(c.baseURL+"/iot/v1/clients/token", form)
+	if err != nil {
+		return fmt.Errorf(tr("connecting to the Arduino Cloud: %s"), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(tr("authenticating to the Arduino Cloud: %s"), newAPIError(resp))
+	}
+	token := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf(tr("authenticating to the Arduino Cloud: %s"), err)
+	}
+	c.token = token.AccessToken
+	// Renew the token a bit before its expiration
+	c.tokenExpiry = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - 10*time.Second)
+	return nil
+}
+
+// do sends a request to the API with the JSON encoding of body, if not nil,
+// and decodes the JSON response in result, if not nil
+func (c *Client) do(method, path string, body, result interface{}) error {
+	if err := c.authenticate(); err != nil {
+		return err
+	}
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.organization != "" {
+		req.Header.Set("X-Organization", c.organization)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf(tr("connecting to the Arduino Cloud: %s"), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newAPIError(resp)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,156 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *[]string) {
+	requests := []string{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/iot/v1/clients/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.Form.Get("client_id") != "id" || r.Form.Get("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error_description":"invalid client"}`))
+			return
+		}
+		requests = append(requests, "token")
+		w.Write([]byte(`{"access_token":"tok","expires_in":300}`))
+	})
+	mux.HandleFunc("/iot/v2/", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer tok", r.Header.Get("Authorization"))
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get("X-Organization"))
+		body := map[string]interface{}{}
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&body)
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /iot/v2/devices":
+			require.Equal(t, "mkrwifi1010", body["type"])
+			w.Write([]byte(`{"id":"dev1","name":"` + body["name"].(string) + `","type":"mkrwifi1010","fqbn":"arduino:samd:mkrwifi1010"}`))
+		case "GET /iot/v2/devices":
+			w.Write([]byte(`[{"id":"dev1","name":"MyMKR","type":"mkrwifi1010","thing":{"id":"thing1"}}]`))
+		case "PUT /iot/v2/devices/dev1/certs":
+			require.Equal(t, "CSR", body["csr"])
+			w.Write([]byte(`{"id":"cert1","pem":"PEM","compressed":{"serial":"01","signature_asn1_x":"AA","signature_asn1_y":"BB"}}`))
+		case "PUT /iot/v2/devices/dev1/pass":
+			require.Equal(t, "key", body["password"])
+			w.Write([]byte(`{}`))
+		case "POST /iot/v2/things/thing1":
+			w.Write([]byte(`{"id":"thing1","name":"Weather","device_id":"` + body["device_id"].(string) + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail":"not found"}`))
+		}
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestClient(t *testing.T) {
+	server, requests := newTestServer(t)
+	client := NewClient(server.Client(), server.URL+"/", "id", "secret", "org1")
+
+	device, err := client.CreateDevice("MyMKR", "", "mkrwifi1010", "arduino:samd:mkrwifi1010")
+	require.NoError(t, err)
+	require.Equal(t, &Device{ID: "dev1", Name: "MyMKR", Type: "mkrwifi1010", FQBN: "arduino:samd:mkrwifi1010"}, device)
+
+	cert, err := client.CreateCertificate("dev1", "CSR")
+	require.NoError(t, err)
+	require.Equal(t, "PEM", cert.PEM)
+	require.Equal(t, "AA", cert.Compressed.SignatureAsn1X)
+
+	require.NoError(t, client.SetDeviceKey("dev1", "key"))
+
+	devices, err := client.ListDevices()
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	require.Equal(t, "thing1", devices[0].ThingID)
+
+	thing, err := client.BindThing("thing1", "dev1")
+	require.NoError(t, err)
+	require.Equal(t, &Thing{ID: "thing1", Name: "Weather", DeviceID: "dev1"}, thing)
+
+	_, err = client.GetThing("missing")
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "not found", apiErr.Message)
+
+	// The token is requested only once
+	require.Equal(t, []string{
+		"token",
+		"PUT /iot/v2/devices org1",
+		"PUT /iot/v2/devices/dev1/certs org1",
+		"PUT /iot/v2/devices/dev1/pass org1",
+		"GET /iot/v2/devices org1",
+		"POST /iot/v2/things/thing1 org1",
+		"GET /iot/v2/things/missing org1",
+	}, *requests)
+}
+
+func TestClientAuthenticationError(t *testing.T) {
+	server, _ := newTestServer(t)
+	client := NewClient(server.Client(), server.URL, "id", "wrong", "")
+	_, err := client.ListThings()
+	require.ErrorContains(t, err, "invalid client")
+}
+
+func TestDeviceType(t *testing.T) {
+	deviceType, useCertificate := DeviceType("arduino:samd:mkrwifi1010")
+	require.Equal(t, "mkrwifi1010", deviceType)
+	require.True(t, useCertificate)
+	deviceType, useCertificate = DeviceType("esp32:esp32:esp32:PartitionScheme=huge_app")
+	require.Equal(t, "esp32", deviceType)
+	require.False(t, useCertificate)
+	deviceType, useCertificate = DeviceType("arduino:renesas_portenta:portenta_c33:opt=1")
+	require.Equal(t, "portenta_c33", deviceType)
+	require.True(t, useCertificate)
+	deviceType, _ = DeviceType("rp2040:rp2040:rpipicow")
+	require.Equal(t, "login_and_secretkey_wifi", deviceType)
+}
+
+func TestDeviceKeys(t *testing.T) {
+	dataDir := paths.New(t.TempDir())
+	keys, err := LoadDeviceKeys(dataDir)
+	require.NoError(t, err)
+	_, ok := keys.Get("dev1")
+	require.False(t, ok)
+
+	key, err := GenerateDeviceKey()
+	require.NoError(t, err)
+	require.Len(t, key, 32)
+	require.Regexp(t, "^[A-Za-z0-9]+$", key)
+	keys.Set("dev1", key)
+	require.NoError(t, keys.Save())
+
+	keys, err = LoadDeviceKeys(dataDir)
+	require.NoError(t, err)
+	stored, ok := keys.Get("dev1")
+	require.True(t, ok)
+	require.Equal(t, key, stored)
+	require.Equal(t, []string{`ARDUINO_CLOUD_THING_ID="thing1"`, `ARDUINO_CLOUD_DEVICE_ID="dev1"`, `ARDUINO_CLOUD_DEVICE_KEY="` + key + `"`},
+		keys.BuildDefines("thing1", "dev1"))
+}
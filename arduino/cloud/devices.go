@@ -0,0 +1,114 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cloud
+
+import (
+	"net/url"
+)
+
+// Device is a device registered in the Arduino Cloud
+type Device struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Serial  string `json:"serial,omitempty"`
+	Type    string `json:"type"`
+	FQBN    string `json:"fqbn,omitempty"`
+	ThingID string `json:"thing_id,omitempty"`
+}
+
+// deviceResponse is a device as returned by the API, the bound thing is an
+// embedded object
+type deviceResponse struct {
+	Device
+	Thing *struct {
+		ID string `json:"id"`
+	} `json:"thing,omitempty"`
+}
+
+func (d *deviceResponse) toDevice() *Device {
+	res := d.Device
+	if d.Thing != nil {
+		res.ThingID = d.Thing.ID
+	}
+	return &res
+}
+
+// CreateDevice registers a new device in the cloud
+func (c *Client) CreateDevice(name, serial, deviceType, fqbn string) (*Device, error) {
+	body := map[string]string{"name": name, "type": deviceType, "fqbn": fqbn}
+	if serial != "" {
+		body["serial"] = serial
+	}
+	res := &deviceResponse{}
+	if err := c.do("PUT", "/iot/v2/devices", body, res); err != nil {
+		return nil, err
+	}
+	return res.toDevice(), nil
+}
+
+// ListDevices returns the devices registered in the cloud
+func (c *Client) ListDevices() ([]*Device, error) {
+	devices := []*deviceResponse{}
+	if err := c.do("GET", "/iot/v2/devices", nil, &devices); err != nil {
+		return nil, err
+	}
+	res := make([]*Device, len(devices))
+	for i, device := range devices {
+		res[i] = device.toDevice()
+	}
+	return res, nil
+}
+
+// DeleteDevice removes a device from the cloud
+func (c *Client) DeleteDevice(deviceID string) error {
+	return c.do("DELETE", "/iot/v2/devices/"+url.PathEscape(deviceID), nil, nil)
+}
+
+// SetDeviceKey sets the secret key used by a device without a crypto element
+// to connect to the cloud
+func (c *Client) SetDeviceKey(deviceID, key string) error {
+	return c.do("PUT", "/iot/v2/devices/"+url.PathEscape(deviceID)+"/pass", map[string]string{"password": key}, nil)
+}
+
+// Certificate is the certificate of a device with a crypto element, signed by
+// the Arduino Cloud certificate authority
+type Certificate struct {
+	ID         string                `json:"id"`
+	PEM        string                `json:"pem"`
+	Compressed CompressedCertificate `json:"compressed"`
+}
+
+// CompressedCertificate contains the fields of the certificate that are
+// stored in the crypto element of the device
+type CompressedCertificate struct {
+	Serial                 string `json:"serial"`
+	AuthorityKeyIdentifier string `json:"authority_key_identifier"`
+	NotBefore              string `json:"not_before"`
+	NotAfter               string `json:"not_after"`
+	SignatureAsn1X         string `json:"signature_asn1_x"`
+	SignatureAsn1Y         string `json:"signature_asn1_y"`
+}
+
+// CreateCertificate signs the certificate signing request (in PEM format)
+// generated by the crypto element of the device
+func (c *Client) CreateCertificate(deviceID, csr string) (*Certificate, error) {
+	body := map[string]interface{}{"ca": "Arduino", "csr": csr, "enabled": true}
+	res := &Certificate{}
+	if err := c.do("PUT", "/iot/v2/devices/"+url.PathEscape(deviceID)+"/certs", body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
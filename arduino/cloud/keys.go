@@ -0,0 +1,105 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cloud
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/arduino/go-paths-helper"
+	"gopkg.in/yaml.v3"
+)
+
+// DeviceKeys are the secret keys of the devices without a crypto element,
+// they are kept on this computer since the cloud never returns them
+type DeviceKeys struct {
+	file *paths.Path
+	keys map[string]string
+}
+
+// LoadDeviceKeys reads the device keys stored in the data directory
+func LoadDeviceKeys(dataDir *paths.Path) (*DeviceKeys, error) {
+	res := &DeviceKeys{
+		file: dataDir.Join("cloud", "device_keys.yaml"),
+		keys: map[string]string{},
+	}
+	if !res.file.Exist() {
+		return res, nil
+	}
+	data, err := res.file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading the device keys: %s"), err)
+	}
+	if err := yaml.Unmarshal(data, &res.keys); err != nil {
+		return nil, fmt.Errorf(tr("reading the device keys: %s"), err)
+	}
+	return res, nil
+}
+
+// Get returns the secret key of a device
+func (k *DeviceKeys) Get(deviceID string) (string, bool) {
+	key, ok := k.keys[deviceID]
+	return key, ok
+}
+
+// Set changes the secret key of a device, Save must be called to store it
+func (k *DeviceKeys) Set(deviceID, key string) {
+	k.keys[deviceID] = key
+}
+
+// Save stores the device keys, the file is readable only by the current user
+func (k *DeviceKeys) Save() error {
+	data, err := yaml.Marshal(k.keys)
+	if err != nil {
+		return err
+	}
+	if err := k.file.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	return os.WriteFile(k.file.String(), data, 0600)
+}
+
+// GenerateDeviceKey returns a new random secret key for a device
+func GenerateDeviceKey() (string, error) {
+	const chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	key := make([]byte, 32)
+	for i := range key {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return "", err
+		}
+		key[i] = chars[n.Int64()]
+	}
+	return string(key), nil
+}
+
+// BuildDefines returns the defines that make the thing, the device and its
+// secret key, if known, available to the sketch
+func (k *DeviceKeys) BuildDefines(thingID, deviceID string) []string {
+	res := []string{}
+	if thingID != "" {
+		res = append(res, fmt.Sprintf(`ARDUINO_CLOUD_THING_ID="%s"`, thingID))
+	}
+	if deviceID != "" {
+		res = append(res, fmt.Sprintf(`ARDUINO_CLOUD_DEVICE_ID="%s"`, deviceID))
+		if key, ok := k.Get(deviceID); ok {
+			res = append(res, fmt.Sprintf(`ARDUINO_CLOUD_DEVICE_KEY="%s"`, key))
+		}
+	}
+	return res
+}
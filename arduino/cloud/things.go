@@ -0,0 +1,55 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cloud
+
+import (
+	"net/url"
+)
+
+// Thing is a thing of the Arduino Cloud, the set of variables synchronized by
+// the device bound to it
+type Thing struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	DeviceID string `json:"device_id,omitempty"`
+}
+
+// ListThings returns the things of the cloud
+func (c *Client) ListThings() ([]*Thing, error) {
+	res := []*Thing{}
+	if err := c.do("GET", "/iot/v2/things", nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BindThing binds a device to a thing, replacing the device previously bound
+func (c *Client) BindThing(thingID, deviceID string) (*Thing, error) {
+	res := &Thing{}
+	if err := c.do("POST", "/iot/v2/things/"+url.PathEscape(thingID), map[string]string{"device_id": deviceID}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetThing returns a thing of the cloud
+func (c *Client) GetThing(thingID string) (*Thing, error) {
+	res := &Thing{}
+	if err := c.do("GET", "/iot/v2/things/"+url.PathEscape(thingID), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,223 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package boardsymbols extracts the constants defined in the headers of the
+// core and of the variant of a board, like the names of the pins, the value
+// of LED_BUILTIN and the macros telling the features of the board, to offer
+// completions in the editors. The headers are not preprocessed: if a constant
+// is defined more than once, for example in different #if branches, only the
+// first definition is kept.
+package boardsymbols
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Kind is the kind of a constant
+type Kind int
+
+const (
+	// Pin is the name of a pin, like A0 or LED_BUILTIN
+	Pin Kind = iota
+	// Macro is a macro or a constant with a value
+	Macro
+	// Feature is a macro without a value telling a feature of the board,
+	// like HAVE_HWSERIAL1
+	Feature
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Pin:
+		return "pin"
+	case Macro:
+		return "macro"
+	case Feature:
+		return "feature"
+	}
+	return "unknown"
+}
+
+// Constant is a constant defined in a header
+type Constant struct {
+	Name string
+	// Value is the value as written in the header, empty for the features
+	Value string
+	// ResolvedValue is the value with the references to the other constants
+	// replaced, when the value is the name of another constant
+	ResolvedValue string
+	Kind          Kind
+	// Line is the line of the definition, starting from 1
+	Line int
+}
+
+var (
+	defineRegexp   = regexp.MustCompile(`^#\s*define\s+([A-Za-z_]\w*)(\([^)]*\))?\s*(.*)$`)
+	ifndefRegexp   = regexp.MustCompile(`^#\s*ifndef\s+([A-Za-z_]\w*)\s*$`)
+	constantRegexp = regexp.MustCompile(`^(?:static\s+)?(?:constexpr|const)\s+(?:[A-Za-z_][\w:]*\s+)+([A-Za-z_]\w*)\s*=\s*([^;{]+);`)
+	pinRegexp      = regexp.MustCompile(`^(?:[AD]\d+|PIN_\w+|\w+_PIN|LED_\w+|LED|SDA\d?|SCL\d?|SS\d?|MOSI\d?|MISO\d?|SCK\d?|TX\d?|RX\d?|DAC\d?)$`)
+)
+
+// Scan returns the constants defined in a header: the object-like macros, the
+// global const and constexpr variables and the macros without a value, except
+// the include guards. The function-like macros are ignored.
+func Scan(source []byte) []*Constant {
+	res := []*Constant{}
+	guard := ""
+	lines := strings.Split(stripComments(string(source)), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+		// Join the continuation lines
+		for strings.HasSuffix(line, "\\") && i+1 < len(lines) {
+			i++
+			line = strings.TrimSuffix(line, "\\") + " " + strings.TrimSpace(lines[i])
+		}
+
+		if m := ifndefRegexp.FindStringSubmatch(line); m != nil {
+			guard = m[1]
+			continue
+		}
+		if m := defineRegexp.FindStringSubmatch(line); m != nil {
+			name, params, value := m[1], m[2], strings.TrimSpace(m[3])
+			isGuard := name == guard
+			guard = ""
+			if params != "" || isGuard {
+				continue
+			}
+			c := &Constant{Name: name, Value: value, Kind: KindOf(name, value), Line: lineNumber}
+			res = append(res, c)
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			guard = ""
+			continue
+		}
+		if m := constantRegexp.FindStringSubmatch(line); m != nil {
+			value := strings.TrimSpace(m[2])
+			res = append(res, &Constant{Name: m[1], Value: value, Kind: KindOf(m[1], value), Line: lineNumber})
+		}
+	}
+	return res
+}
+
+// KindOf guesses the kind of a constant from its name and value
+func KindOf(name, value string) Kind {
+	switch {
+	case value == "":
+		return Feature
+	case pinRegexp.MatchString(name):
+		return Pin
+	}
+	return Macro
+}
+
+// stripComments replaces the comments with spaces, keeping the newlines so
+// the line numbers don't change
+func stripComments(src string) string {
+	var res strings.Builder
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				if src[i] == '\\' && i+1 < len(src) && src[i+1] == '\n' {
+					// the comment continues on the next line
+					res.WriteByte('\n')
+					i++
+				}
+				i++
+			}
+			if i < len(src) {
+				res.WriteByte('\n')
+			}
+		case src[i] == '/' && i+1 < len(src) && src[i+1] == '*':
+			i += 2
+			for i < len(src) && !(src[i] == '*' && i+1 < len(src) && src[i+1] == '/') {
+				if src[i] == '\n' {
+					res.WriteByte('\n')
+				}
+				i++
+			}
+			i++
+			res.WriteByte(' ')
+		case src[i] == '"' || src[i] == '\'':
+			quote := src[i]
+			res.WriteByte(quote)
+			for i++; i < len(src) && src[i] != quote && src[i] != '\n'; i++ {
+				if src[i] == '\\' && i+1 < len(src) {
+					res.WriteByte(src[i])
+					i++
+				}
+				res.WriteByte(src[i])
+			}
+			if i < len(src) {
+				res.WriteByte(src[i])
+			}
+		default:
+			res.WriteByte(src[i])
+		}
+	}
+	return res.String()
+}
+
+// Resolve sets the resolved value of the constants whose value is the name
+// of another constant of the list, following the chains of references. The
+// parentheses around the values are removed.
+func Resolve(constants []*Constant) {
+	byName := map[string]*Constant{}
+	for _, c := range constants {
+		if _, exists := byName[c.Name]; !exists {
+			byName[c.Name] = c
+		}
+	}
+	for _, c := range constants {
+		value := unwrap(c.Value)
+		visited := map[string]bool{c.Name: true}
+		for {
+			ref, ok := byName[value]
+			if !ok || visited[value] || ref.Value == "" {
+				break
+			}
+			visited[value] = true
+			value = unwrap(ref.Value)
+		}
+		c.ResolvedValue = value
+	}
+}
+
+// unwrap removes the parentheses around a value, e.g. "(13u)" -> "13u"
+func unwrap(value string) string {
+	value = strings.TrimSpace(value)
+	for len(value) > 1 && value[0] == '(' && value[len(value)-1] == ')' && strings.Count(value, "(") == 1 {
+		value = strings.TrimSpace(value[1 : len(value)-1])
+	}
+	return value
+}
+
+// Dedup removes the constants defined more than once, keeping the first
+// definition
+func Dedup(constants []*Constant) []*Constant {
+	res := []*Constant{}
+	seen := map[string]bool{}
+	for _, c := range constants {
+		if seen[c.Name] {
+			continue
+		}
+		seen[c.Name] = true
+		res = append(res, c)
+	}
+	return res
+}
@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package boardsymbols
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScan(t *testing.T) {
+	source, err := paths.New("testdata", "pins_arduino.h").ReadFile()
+	require.NoError(t, err)
+	constants := Scan(source)
+	Resolve(constants)
+
+	byName := map[string]*Constant{}
+	names := []string{}
+	for _, c := range constants {
+		byName[c.Name] = c
+		names = append(names, c.Name)
+	}
+	require.Equal(t, []string{
+		"NUM_DIGITAL_PINS", "NUM_ANALOG_INPUTS",
+		"PIN_SPI_SS", "PIN_SPI_MOSI", "SS", "MOSI",
+		"PIN_WIRE_SDA", "PIN_WIRE_SCL", "SDA", "SCL",
+		"LED_BUILTIN", "PIN_A0", "PIN_A1", "A0", "A1",
+		"SERIAL_PORT_MONITOR", "SERIAL_PORT_HARDWARE", "HAVE_HWSERIAL0",
+	}, names)
+
+	require.Equal(t, &Constant{Name: "NUM_ANALOG_INPUTS", Value: "6", ResolvedValue: "6", Kind: Macro, Line: 7}, byName["NUM_ANALOG_INPUTS"])
+	require.Equal(t, &Constant{Name: "LED_BUILTIN", Value: "13", ResolvedValue: "13", Kind: Pin, Line: 28}, byName["LED_BUILTIN"])
+	require.Equal(t, &Constant{Name: "A0", Value: "PIN_A0", ResolvedValue: "14", Kind: Pin, Line: 35}, byName["A0"])
+	require.Equal(t, &Constant{Name: "SS", Value: "PIN_SPI_SS", ResolvedValue: "10", Kind: Pin, Line: 19}, byName["SS"])
+	require.Equal(t, &Constant{Name: "SERIAL_PORT_MONITOR", Value: "Serial", ResolvedValue: "Serial", Kind: Macro, Line: 38}, byName["SERIAL_PORT_MONITOR"])
+	require.Equal(t, &Constant{Name: "HAVE_HWSERIAL0", Kind: Feature, Line: 41}, byName["HAVE_HWSERIAL0"])
+}
+
+func TestResolveCycles(t *testing.T) {
+	constants := Scan([]byte("#define A B\n#define B A\n#define C (D)\n"))
+	Resolve(constants)
+	require.Equal(t, "A", constants[0].ResolvedValue)
+	require.Equal(t, "D", constants[2].ResolvedValue)
+}
+
+func TestDedup(t *testing.T) {
+	constants := Dedup(Scan([]byte("#if defined(X)\n#define LED_BUILTIN 13\n#else\n#define LED_BUILTIN 2\n#endif\n")))
+	require.Len(t, constants, 1)
+	require.Equal(t, "13", constants[0].Value)
+}
@@ -62,26 +62,30 @@ type PlatformRelease struct {
 	DiscoveryDependencies   DiscoveryDependencies
 	MonitorDependencies     MonitorDependencies
 	Deprecated              bool
-	Help                    PlatformReleaseHelp           `json:"-"`
-	Platform                *Platform                     `json:"-"`
-	Properties              *properties.Map               `json:"-"`
-	Boards                  map[string]*Board             `json:"-"`
-	orderedBoards           []*Board                      `json:"-"` // The Boards of this platform, in the order they are defined in the boards.txt file.
-	Programmers             map[string]*Programmer        `json:"-"`
-	Menus                   *properties.Map               `json:"-"`
-	InstallDir              *paths.Path                   `json:"-"`
-	IsIDEBundled            bool                          `json:"-"`
-	IsTrusted               bool                          `json:"-"`
-	PluggableDiscoveryAware bool                          `json:"-"` // true if the Platform supports pluggable discovery (no compatibility layer required)
-	Monitors                map[string]*MonitorDependency `json:"-"`
-	MonitorsDevRecipes      map[string]string             `json:"-"`
+	Help                    PlatformReleaseHelp            `json:"-"`
+	Platform                *Platform                      `json:"-"`
+	Properties              *properties.Map                `json:"-"`
+	Boards                  map[string]*Board              `json:"-"`
+	orderedBoards           []*Board                       `json:"-"` // The Boards of this platform, in the order they are defined in the boards.txt file.
+	Programmers             map[string]*Programmer         `json:"-"`
+	Menus                   *properties.Map                `json:"-"`
+	InstallDir              *paths.Path                    `json:"-"`
+	IsIDEBundled            bool                           `json:"-"`
+	IsTrusted               bool                           `json:"-"`
+	PluggableDiscoveryAware bool                           `json:"-"` // true if the Platform supports pluggable discovery (no compatibility layer required)
+	Monitors                map[string]*MonitorDependency  `json:"-"`
+	MonitorsDevRecipes      map[string]string              `json:"-"`
+	Uploaders               map[string]*UploaderDependency `json:"-"`
+	UploadersDevRecipes     map[string]string              `json:"-"`
 }
 
 // BoardManifest contains information about a board. These metadata are usually
 // provided by the package_index.json
 type BoardManifest struct {
-	Name string             `json:"-"`
-	ID   []*BoardManifestID `json:"-"`
+	Name         string             `json:"-"`
+	ID           []*BoardManifestID `json:"-"`
+	MCU          string             `json:"-"`
+	Connectivity []string           `json:"-"`
 }
 
 // BoardManifestID contains information on how to identify a board. These metadata
@@ -191,6 +195,17 @@ func (d *MonitorDependency) String() string {
 	return fmt.Sprintf("%s:%s", d.Packager, d.Name)
 }
 
+// UploaderDependency identifies the pluggable uploader that implements an
+// upload tool
+type UploaderDependency struct {
+	Name     string
+	Packager string
+}
+
+func (d *UploaderDependency) String() string {
+	return fmt.Sprintf("%s:%s", d.Packager, d.Name)
+}
+
 // GetOrCreateRelease returns the specified release corresponding the provided version,
 // or creates a new one if not found.
 func (platform *Platform) GetOrCreateRelease(version *semver.Version) *PlatformRelease {
@@ -374,21 +389,26 @@ func (release *PlatformRelease) String() string {
 
 // ToRPCPlatformReference creates a gRPC PlatformReference message out of this PlatformRelease
 func (release *PlatformRelease) ToRPCPlatformReference() *rpc.InstalledPlatformReference {
+	return &rpc.InstalledPlatformReference{
+		Id:         release.Platform.String(),
+		Version:    release.Version.String(),
+		InstallDir: release.InstallDir.String(),
+		PackageUrl: release.Platform.Package.AdditionalIndexURL(),
+	}
+}
+
+// AdditionalIndexURL returns the URL of the package index providing the
+// package, or an empty string if it's the default package index.
+func (pkg *Package) AdditionalIndexURL() string {
 	defaultURLPrefix := globals.DefaultIndexURL
 	// TODO: create a IndexURL object to factorize this
 	defaultURLPrefix = strings.TrimSuffix(defaultURLPrefix, filepath.Ext(defaultURLPrefix))
 	defaultURLPrefix = strings.TrimSuffix(defaultURLPrefix, filepath.Ext(defaultURLPrefix)) // removes .tar.bz2
 
-	url := release.Platform.Package.URL
-	if strings.HasPrefix(url, defaultURLPrefix) {
-		url = ""
-	}
-	return &rpc.InstalledPlatformReference{
-		Id:         release.Platform.String(),
-		Version:    release.Version.String(),
-		InstallDir: release.InstallDir.String(),
-		PackageUrl: url,
+	if strings.HasPrefix(pkg.URL, defaultURLPrefix) {
+		return ""
 	}
+	return pkg.URL
 }
 
 // MarshalJSON provides a more user friendly serialization for
@@ -62,6 +62,7 @@ type indexPlatformRelease struct {
 	Deprecated            bool                       `json:"deprecated"`
 	Category              string                     `json:"category"`
 	URL                   string                     `json:"url"`
+	Mirrors               []string                   `json:"mirrors,omitempty"`
 	ArchiveFileName       string                     `json:"archiveFileName"`
 	Checksum              string                     `json:"checksum"`
 	Size                  json.Number                `json:"size"`
@@ -112,6 +113,7 @@ type indexToolRelease struct {
 type indexToolReleaseFlavour struct {
 	OS              string      `json:"host"`
 	URL             string      `json:"url"`
+	Mirrors         []string    `json:"mirrors,omitempty"`
 	ArchiveFileName string      `json:"archiveFileName"`
 	Size            json.Number `json:"size"`
 	Checksum        string      `json:"checksum"`
@@ -121,8 +123,10 @@ type indexToolReleaseFlavour struct {
 //
 //easyjson:json
 type indexBoard struct {
-	Name string         `json:"name"`
-	ID   []indexBoardID `json:"id,omitempty"`
+	Name         string         `json:"name"`
+	ID           []indexBoardID `json:"id,omitempty"`
+	MCU          string         `json:"mcu,omitempty"`
+	Connectivity []string       `json:"connectivity,omitempty"`
 }
 
 // indexBoardID represents the ID of a single board. i.e. uno, yun, diecimila, micro and the likes
@@ -156,7 +160,9 @@ func IndexFromPlatformRelease(pr *cores.PlatformRelease) Index {
 	boards := []indexBoard{}
 	for _, manifest := range pr.BoardsManifest {
 		board := indexBoard{
-			Name: manifest.Name,
+			Name:         manifest.Name,
+			MCU:          manifest.MCU,
+			Connectivity: manifest.Connectivity,
 		}
 		for _, id := range manifest.ID {
 			if id.USB != "" {
@@ -199,6 +205,7 @@ func IndexFromPlatformRelease(pr *cores.PlatformRelease) Index {
 				flavours = append(flavours, indexToolReleaseFlavour{
 					OS:              flavour.OS,
 					URL:             flavour.Resource.URL,
+					Mirrors:         flavour.Resource.Mirrors,
 					ArchiveFileName: flavour.Resource.ArchiveFileName,
 					Size:            json.Number(fmt.Sprintf("%d", flavour.Resource.Size)),
 					Checksum:        flavour.Resource.Checksum,
@@ -228,6 +235,7 @@ func IndexFromPlatformRelease(pr *cores.PlatformRelease) Index {
 					Deprecated:            pr.Deprecated,
 					Category:              pr.Category,
 					URL:                   pr.Resource.URL,
+					Mirrors:               pr.Resource.Mirrors,
 					ArchiveFileName:       pr.Resource.ArchiveFileName,
 					Checksum:              pr.Resource.Checksum,
 					Size:                  json.Number(fmt.Sprintf("%d", pr.Resource.Size)),
@@ -286,6 +294,7 @@ func (inPlatformRelease indexPlatformRelease) extractPlatformIn(outPackage *core
 		Checksum:        inPlatformRelease.Checksum,
 		Size:            size,
 		URL:             inPlatformRelease.URL,
+		Mirrors:         inPlatformRelease.Mirrors,
 		CachePath:       "packages",
 	}
 	outPlatformRelease.Help = cores.PlatformReleaseHelp{Online: inPlatformRelease.Help.Online}
@@ -334,7 +343,11 @@ func (inPlatformRelease indexPlatformRelease) extractMonitorDependencies() cores
 func (inPlatformRelease indexPlatformRelease) extractBoardsManifest() []*cores.BoardManifest {
 	boards := make([]*cores.BoardManifest, len(inPlatformRelease.Boards))
 	for i, board := range inPlatformRelease.Boards {
-		manifest := &cores.BoardManifest{Name: board.Name}
+		manifest := &cores.BoardManifest{
+			Name:         board.Name,
+			MCU:          board.MCU,
+			Connectivity: board.Connectivity,
+		}
 		for _, id := range board.ID {
 			if id.USB != "" {
 				manifest.ID = append(manifest.ID, &cores.BoardManifestID{USB: id.USB})
@@ -364,6 +377,7 @@ func (inToolRelease indexToolRelease) extractFlavours() []*cores.Flavor {
 				Checksum:        flavour.Checksum,
 				Size:            size,
 				URL:             flavour.URL,
+				Mirrors:         flavour.Mirrors,
 				CachePath:       "packages",
 			},
 		}
@@ -42,6 +42,29 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex(in
 			out.OS = string(in.String())
 		case "url":
 			out.URL = string(in.String())
+		case "mirrors":
+			if in.IsNull() {
+				in.Skip()
+				out.Mirrors = nil
+			} else {
+				in.Delim('[')
+				if out.Mirrors == nil {
+					if !in.IsDelim(']') {
+						out.Mirrors = make([]string, 0, 4)
+					} else {
+						out.Mirrors = []string{}
+					}
+				} else {
+					out.Mirrors = (out.Mirrors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Mirrors = append(out.Mirrors, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "archiveFileName":
 			out.ArchiveFileName = string(in.String())
 		case "size":
@@ -54,6 +77,29 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex(in
 				out.OS = string(in.String())
 			case "url":
 				out.URL = string(in.String())
+			case "mirrors":
+				if in.IsNull() {
+					in.Skip()
+					out.Mirrors = nil
+				} else {
+					in.Delim('[')
+					if out.Mirrors == nil {
+						if !in.IsDelim(']') {
+							out.Mirrors = make([]string, 0, 4)
+						} else {
+							out.Mirrors = []string{}
+						}
+					} else {
+						out.Mirrors = (out.Mirrors)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v2 string
+						v2 = string(in.String())
+						out.Mirrors = append(out.Mirrors, v2)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
 			case "archivefilename":
 				out.ArchiveFileName = string(in.String())
 			case "size":
@@ -85,6 +131,20 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex(ou
 		out.RawString(prefix)
 		out.String(string(in.URL))
 	}
+	if len(in.Mirrors) != 0 {
+		const prefix string = ",\"mirrors\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v3, v4 := range in.Mirrors {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v4))
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"archiveFileName\":"
 		out.RawString(prefix)
@@ -175,9 +235,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex1(i
 					out.Systems = (out.Systems)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 indexToolReleaseFlavour
-					(v1).UnmarshalEasyJSON(in)
-					out.Systems = append(out.Systems, v1)
+					var v5 indexToolReleaseFlavour
+					(v5).UnmarshalEasyJSON(in)
+					out.Systems = append(out.Systems, v5)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -214,9 +274,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex1(i
 						out.Systems = (out.Systems)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v2 indexToolReleaseFlavour
-						(v2).UnmarshalEasyJSON(in)
-						out.Systems = append(out.Systems, v2)
+						var v6 indexToolReleaseFlavour
+						(v6).UnmarshalEasyJSON(in)
+						out.Systems = append(out.Systems, v6)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -257,11 +317,11 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex1(o
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v3, v4 := range in.Systems {
-				if v3 > 0 {
+			for v7, v8 := range in.Systems {
+				if v7 > 0 {
 					out.RawByte(',')
 				}
-				(v4).MarshalEasyJSON(out)
+				(v8).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -446,6 +506,29 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 			out.Category = string(in.String())
 		case "url":
 			out.URL = string(in.String())
+		case "mirrors":
+			if in.IsNull() {
+				in.Skip()
+				out.Mirrors = nil
+			} else {
+				in.Delim('[')
+				if out.Mirrors == nil {
+					if !in.IsDelim(']') {
+						out.Mirrors = make([]string, 0, 4)
+					} else {
+						out.Mirrors = []string{}
+					}
+				} else {
+					out.Mirrors = (out.Mirrors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 string
+					v9 = string(in.String())
+					out.Mirrors = append(out.Mirrors, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "archiveFileName":
 			out.ArchiveFileName = string(in.String())
 		case "checksum":
@@ -460,7 +543,7 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 				in.Delim('[')
 				if out.Boards == nil {
 					if !in.IsDelim(']') {
-						out.Boards = make([]indexBoard, 0, 1)
+						out.Boards = make([]indexBoard, 0, 0)
 					} else {
 						out.Boards = []indexBoard{}
 					}
@@ -468,9 +551,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 					out.Boards = (out.Boards)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v5 indexBoard
-					(v5).UnmarshalEasyJSON(in)
-					out.Boards = append(out.Boards, v5)
+					var v10 indexBoard
+					(v10).UnmarshalEasyJSON(in)
+					out.Boards = append(out.Boards, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -493,9 +576,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 					out.ToolDependencies = (out.ToolDependencies)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v6 indexToolDependency
-					(v6).UnmarshalEasyJSON(in)
-					out.ToolDependencies = append(out.ToolDependencies, v6)
+					var v11 indexToolDependency
+					(v11).UnmarshalEasyJSON(in)
+					out.ToolDependencies = append(out.ToolDependencies, v11)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -516,9 +599,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 					out.DiscoveryDependencies = (out.DiscoveryDependencies)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 indexDiscoveryDependency
-					(v7).UnmarshalEasyJSON(in)
-					out.DiscoveryDependencies = append(out.DiscoveryDependencies, v7)
+					var v12 indexDiscoveryDependency
+					(v12).UnmarshalEasyJSON(in)
+					out.DiscoveryDependencies = append(out.DiscoveryDependencies, v12)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -539,9 +622,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 					out.MonitorDependencies = (out.MonitorDependencies)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v8 indexMonitorDependency
-					(v8).UnmarshalEasyJSON(in)
-					out.MonitorDependencies = append(out.MonitorDependencies, v8)
+					var v13 indexMonitorDependency
+					(v13).UnmarshalEasyJSON(in)
+					out.MonitorDependencies = append(out.MonitorDependencies, v13)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -570,6 +653,29 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 				out.Category = string(in.String())
 			case "url":
 				out.URL = string(in.String())
+			case "mirrors":
+				if in.IsNull() {
+					in.Skip()
+					out.Mirrors = nil
+				} else {
+					in.Delim('[')
+					if out.Mirrors == nil {
+						if !in.IsDelim(']') {
+							out.Mirrors = make([]string, 0, 4)
+						} else {
+							out.Mirrors = []string{}
+						}
+					} else {
+						out.Mirrors = (out.Mirrors)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v14 string
+						v14 = string(in.String())
+						out.Mirrors = append(out.Mirrors, v14)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
 			case "archivefilename":
 				out.ArchiveFileName = string(in.String())
 			case "checksum":
@@ -584,7 +690,7 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 					in.Delim('[')
 					if out.Boards == nil {
 						if !in.IsDelim(']') {
-							out.Boards = make([]indexBoard, 0, 1)
+							out.Boards = make([]indexBoard, 0, 0)
 						} else {
 							out.Boards = []indexBoard{}
 						}
@@ -592,9 +698,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 						out.Boards = (out.Boards)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v9 indexBoard
-						(v9).UnmarshalEasyJSON(in)
-						out.Boards = append(out.Boards, v9)
+						var v15 indexBoard
+						(v15).UnmarshalEasyJSON(in)
+						out.Boards = append(out.Boards, v15)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -617,9 +723,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 						out.ToolDependencies = (out.ToolDependencies)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v10 indexToolDependency
-						(v10).UnmarshalEasyJSON(in)
-						out.ToolDependencies = append(out.ToolDependencies, v10)
+						var v16 indexToolDependency
+						(v16).UnmarshalEasyJSON(in)
+						out.ToolDependencies = append(out.ToolDependencies, v16)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -640,9 +746,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 						out.DiscoveryDependencies = (out.DiscoveryDependencies)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v11 indexDiscoveryDependency
-						(v11).UnmarshalEasyJSON(in)
-						out.DiscoveryDependencies = append(out.DiscoveryDependencies, v11)
+						var v17 indexDiscoveryDependency
+						(v17).UnmarshalEasyJSON(in)
+						out.DiscoveryDependencies = append(out.DiscoveryDependencies, v17)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -663,9 +769,9 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(i
 						out.MonitorDependencies = (out.MonitorDependencies)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v12 indexMonitorDependency
-						(v12).UnmarshalEasyJSON(in)
-						out.MonitorDependencies = append(out.MonitorDependencies, v12)
+						var v18 indexMonitorDependency
+						(v18).UnmarshalEasyJSON(in)
+						out.MonitorDependencies = append(out.MonitorDependencies, v18)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -719,6 +825,20 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(o
 		out.RawString(prefix)
 		out.String(string(in.URL))
 	}
+	if len(in.Mirrors) != 0 {
+		const prefix string = ",\"mirrors\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v19, v20 := range in.Mirrors {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v20))
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"archiveFileName\":"
 		out.RawString(prefix)
@@ -741,11 +861,11 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(o
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v13, v14 := range in.Boards {
-				if v13 > 0 {
+			for v21, v22 := range in.Boards {
+				if v21 > 0 {
 					out.RawByte(',')
 				}
-				(v14).MarshalEasyJSON(out)
+				(v22).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -762,11 +882,11 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(o
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v15, v16 := range in.ToolDependencies {
-				if v15 > 0 {
+			for v23, v24 := range in.ToolDependencies {
+				if v23 > 0 {
 					out.RawByte(',')
 				}
-				(v16).MarshalEasyJSON(out)
+				(v24).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -778,11 +898,11 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(o
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v17, v18 := range in.DiscoveryDependencies {
-				if v17 > 0 {
+			for v25, v26 := range in.DiscoveryDependencies {
+				if v25 > 0 {
 					out.RawByte(',')
 				}
-				(v18).MarshalEasyJSON(out)
+				(v26).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -794,11 +914,11 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex3(o
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v19, v20 := range in.MonitorDependencies {
-				if v19 > 0 {
+			for v27, v28 := range in.MonitorDependencies {
+				if v27 > 0 {
 					out.RawByte(',')
 				}
-				(v20).MarshalEasyJSON(out)
+				(v28).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -874,17 +994,17 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex4(i
 					out.Platforms = (out.Platforms)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v21 *indexPlatformRelease
+					var v29 *indexPlatformRelease
 					if in.IsNull() {
 						in.Skip()
-						v21 = nil
+						v29 = nil
 					} else {
-						if v21 == nil {
-							v21 = new(indexPlatformRelease)
+						if v29 == nil {
+							v29 = new(indexPlatformRelease)
 						}
-						(*v21).UnmarshalEasyJSON(in)
+						(*v29).UnmarshalEasyJSON(in)
 					}
-					out.Platforms = append(out.Platforms, v21)
+					out.Platforms = append(out.Platforms, v29)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -905,17 +1025,17 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex4(i
 					out.Tools = (out.Tools)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v22 *indexToolRelease
+					var v30 *indexToolRelease
 					if in.IsNull() {
 						in.Skip()
-						v22 = nil
+						v30 = nil
 					} else {
-						if v22 == nil {
-							v22 = new(indexToolRelease)
+						if v30 == nil {
+							v30 = new(indexToolRelease)
 						}
-						(*v22).UnmarshalEasyJSON(in)
+						(*v30).UnmarshalEasyJSON(in)
 					}
-					out.Tools = append(out.Tools, v22)
+					out.Tools = append(out.Tools, v30)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -950,17 +1070,17 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex4(i
 						out.Platforms = (out.Platforms)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v23 *indexPlatformRelease
+						var v31 *indexPlatformRelease
 						if in.IsNull() {
 							in.Skip()
-							v23 = nil
+							v31 = nil
 						} else {
-							if v23 == nil {
-								v23 = new(indexPlatformRelease)
+							if v31 == nil {
+								v31 = new(indexPlatformRelease)
 							}
-							(*v23).UnmarshalEasyJSON(in)
+							(*v31).UnmarshalEasyJSON(in)
 						}
-						out.Platforms = append(out.Platforms, v23)
+						out.Platforms = append(out.Platforms, v31)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -981,17 +1101,17 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex4(i
 						out.Tools = (out.Tools)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v24 *indexToolRelease
+						var v32 *indexToolRelease
 						if in.IsNull() {
 							in.Skip()
-							v24 = nil
+							v32 = nil
 						} else {
-							if v24 == nil {
-								v24 = new(indexToolRelease)
+							if v32 == nil {
+								v32 = new(indexToolRelease)
 							}
-							(*v24).UnmarshalEasyJSON(in)
+							(*v32).UnmarshalEasyJSON(in)
 						}
-						out.Tools = append(out.Tools, v24)
+						out.Tools = append(out.Tools, v32)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -1045,14 +1165,14 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex4(o
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.Platforms {
-				if v25 > 0 {
+			for v33, v34 := range in.Platforms {
+				if v33 > 0 {
 					out.RawByte(',')
 				}
-				if v26 == nil {
+				if v34 == nil {
 					out.RawString("null")
 				} else {
-					(*v26).MarshalEasyJSON(out)
+					(*v34).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1065,14 +1185,14 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex4(o
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v27, v28 := range in.Tools {
-				if v27 > 0 {
+			for v35, v36 := range in.Tools {
+				if v35 > 0 {
 					out.RawByte(',')
 				}
-				if v28 == nil {
+				if v36 == nil {
 					out.RawString("null")
 				} else {
-					(*v28).MarshalEasyJSON(out)
+					(*v36).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -1449,9 +1569,34 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex9(i
 					out.ID = (out.ID)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v29 indexBoardID
-					(v29).UnmarshalEasyJSON(in)
-					out.ID = append(out.ID, v29)
+					var v37 indexBoardID
+					(v37).UnmarshalEasyJSON(in)
+					out.ID = append(out.ID, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "mcu":
+			out.MCU = string(in.String())
+		case "connectivity":
+			if in.IsNull() {
+				in.Skip()
+				out.Connectivity = nil
+			} else {
+				in.Delim('[')
+				if out.Connectivity == nil {
+					if !in.IsDelim(']') {
+						out.Connectivity = make([]string, 0, 4)
+					} else {
+						out.Connectivity = []string{}
+					}
+				} else {
+					out.Connectivity = (out.Connectivity)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 string
+					v38 = string(in.String())
+					out.Connectivity = append(out.Connectivity, v38)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1476,9 +1621,34 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex9(i
 						out.ID = (out.ID)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v30 indexBoardID
-						(v30).UnmarshalEasyJSON(in)
-						out.ID = append(out.ID, v30)
+						var v39 indexBoardID
+						(v39).UnmarshalEasyJSON(in)
+						out.ID = append(out.ID, v39)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
+			case "mcu":
+				out.MCU = string(in.String())
+			case "connectivity":
+				if in.IsNull() {
+					in.Skip()
+					out.Connectivity = nil
+				} else {
+					in.Delim('[')
+					if out.Connectivity == nil {
+						if !in.IsDelim(']') {
+							out.Connectivity = make([]string, 0, 4)
+						} else {
+							out.Connectivity = []string{}
+						}
+					} else {
+						out.Connectivity = (out.Connectivity)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v40 string
+						v40 = string(in.String())
+						out.Connectivity = append(out.Connectivity, v40)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -1508,11 +1678,30 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex9(o
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v31, v32 := range in.ID {
-				if v31 > 0 {
+			for v41, v42 := range in.ID {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				(v42).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MCU != "" {
+		const prefix string = ",\"mcu\":"
+		out.RawString(prefix)
+		out.String(string(in.MCU))
+	}
+	if len(in.Connectivity) != 0 {
+		const prefix string = ",\"connectivity\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v43, v44 := range in.Connectivity {
+				if v43 > 0 {
 					out.RawByte(',')
 				}
-				(v32).MarshalEasyJSON(out)
+				out.String(string(v44))
 			}
 			out.RawByte(']')
 		}
@@ -1578,17 +1767,17 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex10(
 					out.Packages = (out.Packages)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v33 *indexPackage
+					var v45 *indexPackage
 					if in.IsNull() {
 						in.Skip()
-						v33 = nil
+						v45 = nil
 					} else {
-						if v33 == nil {
-							v33 = new(indexPackage)
+						if v45 == nil {
+							v45 = new(indexPackage)
 						}
-						(*v33).UnmarshalEasyJSON(in)
+						(*v45).UnmarshalEasyJSON(in)
 					}
-					out.Packages = append(out.Packages, v33)
+					out.Packages = append(out.Packages, v45)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1613,17 +1802,17 @@ func easyjsonE2a549a6DecodeGithubComArduinoArduinoCliArduinoCoresPackageindex10(
 						out.Packages = (out.Packages)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v34 *indexPackage
+						var v46 *indexPackage
 						if in.IsNull() {
 							in.Skip()
-							v34 = nil
+							v46 = nil
 						} else {
-							if v34 == nil {
-								v34 = new(indexPackage)
+							if v46 == nil {
+								v46 = new(indexPackage)
 							}
-							(*v34).UnmarshalEasyJSON(in)
+							(*v46).UnmarshalEasyJSON(in)
 						}
-						out.Packages = append(out.Packages, v34)
+						out.Packages = append(out.Packages, v46)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -1652,14 +1841,14 @@ func easyjsonE2a549a6EncodeGithubComArduinoArduinoCliArduinoCoresPackageindex10(
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.Packages {
-				if v35 > 0 {
+			for v47, v48 := range in.Packages {
+				if v47 > 0 {
 					out.RawByte(',')
 				}
-				if v36 == nil {
+				if v48 == nil {
 					out.RawString("null")
 				} else {
-					(*v36).MarshalEasyJSON(out)
+					(*v48).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -16,6 +16,7 @@
 package packagemanager
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/arduino/arduino-cli/arduino"
@@ -120,21 +121,21 @@ func (pme *Explorer) FindPlatformReleaseDependencies(item *PlatformReference) (*
 
 // DownloadToolRelease downloads a ToolRelease. If the tool is already downloaded a nil Downloader
 // is returned. Uses the given downloader configuration for download, or the default config if nil.
-func (pme *Explorer) DownloadToolRelease(tool *cores.ToolRelease, config *downloader.Config, progressCB rpc.DownloadProgressCB) error {
+func (pme *Explorer) DownloadToolRelease(ctx context.Context, tool *cores.ToolRelease, config *downloader.Config, progressCB rpc.DownloadProgressCB) error {
 	resource := tool.GetCompatibleFlavour()
 	if resource == nil {
 		return &arduino.FailedDownloadError{
 			Message: tr("Error downloading tool %s", tool),
 			Cause:   errors.New(tr("no versions available for the current OS, try contacting %s", tool.Tool.Package.Email))}
 	}
-	return resource.Download(pme.DownloadDir, config, tool.String(), progressCB, "")
+	return resource.Download(ctx, pme.DownloadDir, config, tool.String(), progressCB, "")
 }
 
 // DownloadPlatformRelease downloads a PlatformRelease. If the platform is already downloaded a
 // nil Downloader is returned.
-func (pme *Explorer) DownloadPlatformRelease(platform *cores.PlatformRelease, config *downloader.Config, progressCB rpc.DownloadProgressCB) error {
+func (pme *Explorer) DownloadPlatformRelease(ctx context.Context, platform *cores.PlatformRelease, config *downloader.Config, progressCB rpc.DownloadProgressCB) error {
 	if platform.Resource == nil {
 		return &arduino.PlatformNotFoundError{Platform: platform.String()}
 	}
-	return platform.Resource.Download(pme.DownloadDir, config, platform.String(), progressCB, "")
+	return platform.Resource.Download(ctx, pme.DownloadDir, config, platform.String(), progressCB, "")
 }
@@ -0,0 +1,94 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/codeclysm/extract/v3"
+)
+
+// InstallPlatformArchive installs a platform distributed as an archive in
+// hardwareDir, the hardware folder of the sketchbook, as
+// <vendor>/<architecture>. The platform is the shallowest folder of the
+// archive containing a boards.txt file. If it's not the top level folder of
+// the archive its name is used as architecture instead of the given one
+// (e.g. `MyCore-1.0.0/avr/boards.txt` is installed as <vendor>/avr).
+// It returns the folder of the installed platform.
+func InstallPlatformArchive(ctx context.Context, archive, hardwareDir *paths.Path, vendor, architecture string, overwrite bool) (*paths.Path, error) {
+	tmpDir, err := paths.MkTempDir("", "platform-")
+	if err != nil {
+		return nil, err
+	}
+	defer tmpDir.RemoveAll()
+
+	file, err := archive.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if err := extract.Archive(ctx, file, tmpDir.String(), nil); err != nil {
+		return nil, fmt.Errorf(tr("extracting archive: %w"), err)
+	}
+
+	files, err := tmpDir.ReadDirRecursiveFiltered(nil, paths.FilterNames("boards.txt"))
+	if err != nil {
+		return nil, err
+	}
+	var platformDir *paths.Path
+	depth := 0
+	for _, boardsTxt := range files {
+		rel, err := boardsTxt.Parent().RelFrom(tmpDir)
+		if err != nil {
+			return nil, err
+		}
+		relDepth := 0
+		if rel.String() != "." {
+			relDepth = len(strings.Split(filepath.ToSlash(rel.String()), "/"))
+		}
+		if platformDir == nil || relDepth < depth {
+			platformDir, depth = boardsTxt.Parent(), relDepth
+		}
+	}
+	if platformDir == nil {
+		return nil, errors.New(tr("archive is not valid: no boards.txt file found"))
+	}
+	if depth > 1 {
+		architecture = platformDir.Base()
+	}
+
+	installDir := hardwareDir.Join(strings.ToLower(vendor), strings.ToLower(architecture))
+	if installDir.Exist() {
+		if !overwrite {
+			return nil, errors.New(tr("platform already installed in %s", installDir))
+		}
+		if err := installDir.RemoveAll(); err != nil {
+			return nil, err
+		}
+	}
+	if err := installDir.Parent().MkdirAll(); err != nil {
+		return nil, err
+	}
+	if err := platformDir.CopyDirTo(installDir); err != nil {
+		return nil, fmt.Errorf(tr("installing platform in %[1]s: %[2]s"), installDir, err)
+	}
+	return installDir, nil
+}
@@ -0,0 +1,75 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager
+
+import (
+	"archive/zip"
+	"context"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func createZip(t *testing.T, files map[string]string) *paths.Path {
+	archive := paths.New(t.TempDir()).Join("platform.zip")
+	out, err := archive.Create()
+	require.NoError(t, err)
+	defer out.Close()
+	w := zip.NewWriter(out)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return archive
+}
+
+func TestInstallPlatformArchive(t *testing.T) {
+	hardwareDir := paths.New(t.TempDir())
+
+	archive := createZip(t, map[string]string{
+		"MyCore-1.0.0/boards.txt":   "uno.name=Uno\n",
+		"MyCore-1.0.0/platform.txt": "name=My Core\n",
+	})
+	installDir, err := InstallPlatformArchive(context.Background(), archive, hardwareDir, "Owner", "MyCore", false)
+	require.NoError(t, err)
+	require.Equal(t, hardwareDir.Join("owner", "mycore").String(), installDir.String())
+	require.FileExists(t, installDir.Join("boards.txt").String())
+	require.FileExists(t, installDir.Join("platform.txt").String())
+
+	_, err = InstallPlatformArchive(context.Background(), archive, hardwareDir, "Owner", "MyCore", false)
+	require.ErrorContains(t, err, "platform already installed")
+	_, err = InstallPlatformArchive(context.Background(), archive, hardwareDir, "Owner", "MyCore", true)
+	require.NoError(t, err)
+
+	archive = createZip(t, map[string]string{
+		"MyCore-1.0.0/README.md":               "readme",
+		"MyCore-1.0.0/avr/boards.txt":          "uno.name=Uno\n",
+		"MyCore-1.0.0/avr/platform.txt":        "name=My Core\n",
+		"MyCore-1.0.0/avr/variants/boards.txt": "",
+	})
+	installDir, err = InstallPlatformArchive(context.Background(), archive, hardwareDir, "Owner", "MyCore", false)
+	require.NoError(t, err)
+	require.Equal(t, hardwareDir.Join("owner", "avr").String(), installDir.String())
+	require.FileExists(t, installDir.Join("variants", "boards.txt").String())
+
+	archive = createZip(t, map[string]string{"README.md": "readme"})
+	_, err = InstallPlatformArchive(context.Background(), archive, hardwareDir, "Owner", "Other", false)
+	require.ErrorContains(t, err, "no boards.txt file found")
+}
@@ -17,6 +17,7 @@ package packagemanager
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"runtime"
@@ -24,17 +25,22 @@ import (
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/executils"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
+	semver "go.bug.st/relaxed-semver"
 )
 
 // DownloadAndInstallPlatformUpgrades runs a full installation process to upgrade the given platform.
+// If pinnedVersion is not nil the platform is not upgraded past that version.
 // This method takes care of downloading missing archives, upgrading platforms and tools, and
 // removing the previously installed platform/tools that are no longer needed after the upgrade.
 func (pme *Explorer) DownloadAndInstallPlatformUpgrades(
+	ctx context.Context,
 	platformRef *PlatformReference,
+	pinnedVersion *semver.Version,
 	downloadCB rpc.DownloadProgressCB,
 	taskCB rpc.TaskProgressCB,
 	skipPostInstall bool,
@@ -57,13 +63,26 @@ func (pme *Explorer) DownloadAndInstallPlatformUpgrades(
 	if !latest.Version.GreaterThan(installed.Version) {
 		return installed, &arduino.PlatformAlreadyAtTheLatestVersionError{Platform: platformRef.String()}
 	}
+	if pinnedVersion != nil && latest.Version.GreaterThan(pinnedVersion) {
+		// Pick the latest release not past the pinned version
+		latest = nil
+		for _, release := range platform.GetAllReleases() {
+			if release.Version.GreaterThan(installed.Version) && !release.Version.GreaterThan(pinnedVersion) &&
+				(latest == nil || release.Version.GreaterThan(latest.Version)) {
+				latest = release
+			}
+		}
+		if latest == nil {
+			return installed, &arduino.PlatformPinnedError{Platform: platformRef.String(), Version: pinnedVersion.String()}
+		}
+	}
 	platformRef.PlatformVersion = latest.Version
 
 	platformRelease, tools, err := pme.FindPlatformReleaseDependencies(platformRef)
 	if err != nil {
 		return nil, &arduino.PlatformNotFoundError{Platform: platformRef.String()}
 	}
-	if err := pme.DownloadAndInstallPlatformAndTools(platformRelease, tools, downloadCB, taskCB, skipPostInstall, skipPreUninstall); err != nil {
+	if err := pme.DownloadAndInstallPlatformAndTools(ctx, platformRelease, tools, downloadCB, taskCB, skipPostInstall, skipPreUninstall); err != nil {
 		return nil, err
 	}
 
@@ -74,6 +93,7 @@ func (pme *Explorer) DownloadAndInstallPlatformUpgrades(
 // This method takes care of downloading missing archives, installing/upgrading platforms and tools, and
 // removing the previously installed platform/tools that are no longer needed after the upgrade.
 func (pme *Explorer) DownloadAndInstallPlatformAndTools(
+	ctx context.Context,
 	platformRelease *cores.PlatformRelease, requiredTools []*cores.ToolRelease,
 	downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB,
 	skipPostInstall bool, skipPreUninstall bool) error {
@@ -93,18 +113,18 @@ func (pme *Explorer) DownloadAndInstallPlatformAndTools(
 	// Package download
 	taskCB(&rpc.TaskProgress{Name: tr("Downloading packages")})
 	for _, tool := range toolsToInstall {
-		if err := pme.DownloadToolRelease(tool, nil, downloadCB); err != nil {
+		if err := pme.DownloadToolRelease(ctx, tool, nil, downloadCB); err != nil {
 			return err
 		}
 	}
-	if err := pme.DownloadPlatformRelease(platformRelease, nil, downloadCB); err != nil {
+	if err := pme.DownloadPlatformRelease(ctx, platformRelease, nil, downloadCB); err != nil {
 		return err
 	}
 	taskCB(&rpc.TaskProgress{Completed: true})
 
 	// Install tools first
 	for _, tool := range toolsToInstall {
-		if err := pme.InstallTool(tool, taskCB, skipPostInstall); err != nil {
+		if err := pme.InstallTool(ctx, tool, taskCB, skipPostInstall); err != nil {
 			return err
 		}
 	}
@@ -136,7 +156,7 @@ func (pme *Explorer) DownloadAndInstallPlatformAndTools(
 	}
 
 	// Install
-	if err := pme.InstallPlatform(platformRelease); err != nil {
+	if err := pme.InstallPlatform(ctx, platformRelease); err != nil {
 		log.WithError(err).Error("Cannot install platform")
 		return &arduino.FailedInstallError{Message: tr("Cannot install platform"), Cause: err}
 	}
@@ -194,19 +214,19 @@ func (pme *Explorer) DownloadAndInstallPlatformAndTools(
 }
 
 // InstallPlatform installs a specific release of a platform.
-func (pme *Explorer) InstallPlatform(platformRelease *cores.PlatformRelease) error {
+func (pme *Explorer) InstallPlatform(ctx context.Context, platformRelease *cores.PlatformRelease) error {
 	destDir := pme.PackagesDir.Join(
 		platformRelease.Platform.Package.Name,
 		"hardware",
 		platformRelease.Platform.Architecture,
 		platformRelease.Version.String())
-	return pme.InstallPlatformInDirectory(platformRelease, destDir)
+	return pme.InstallPlatformInDirectory(ctx, platformRelease, destDir)
 }
 
 // InstallPlatformInDirectory installs a specific release of a platform in a specific directory.
-func (pme *Explorer) InstallPlatformInDirectory(platformRelease *cores.PlatformRelease, destDir *paths.Path) error {
-	if err := platformRelease.Resource.Install(pme.DownloadDir, pme.tempDir, destDir); err != nil {
-		return errors.Errorf(tr("installing platform %[1]s: %[2]s"), platformRelease, err)
+func (pme *Explorer) InstallPlatformInDirectory(ctx context.Context, platformRelease *cores.PlatformRelease, destDir *paths.Path) error {
+	if err := platformRelease.Resource.Install(ctx, pme.DownloadDir, pme.tempDir, destDir); err != nil {
+		return fmt.Errorf("%s: %w", tr("installing platform %s", platformRelease), err)
 	}
 	if d, err := destDir.Abs(); err == nil {
 		platformRelease.InstallDir = d
@@ -304,7 +324,7 @@ func (pme *Explorer) UninstallPlatform(platformRelease *cores.PlatformRelease, t
 		taskCB(&rpc.TaskProgress{Message: tr("Skipping pre_uninstall script.")})
 	}
 
-	if err := platformRelease.InstallDir.RemoveAll(); err != nil {
+	if err := utils.LongPath(platformRelease.InstallDir).RemoveAll(); err != nil {
 		err = fmt.Errorf(tr("removing platform files: %s"), err)
 		log.WithError(err).Error("Error uninstalling")
 		return &arduino.FailedUninstallError{Message: err.Error()}
@@ -318,7 +338,7 @@ func (pme *Explorer) UninstallPlatform(platformRelease *cores.PlatformRelease, t
 }
 
 // InstallTool installs a specific release of a tool.
-func (pme *Explorer) InstallTool(toolRelease *cores.ToolRelease, taskCB rpc.TaskProgressCB, skipPostInstall bool) error {
+func (pme *Explorer) InstallTool(ctx context.Context, toolRelease *cores.ToolRelease, taskCB rpc.TaskProgressCB, skipPostInstall bool) error {
 	log := pme.log.WithField("Tool", toolRelease)
 
 	if toolRelease.IsInstalled() {
@@ -340,7 +360,7 @@ func (pme *Explorer) InstallTool(toolRelease *cores.ToolRelease, taskCB rpc.Task
 		"tools",
 		toolRelease.Tool.Name,
 		toolRelease.Version.String())
-	err := toolResource.Install(pme.DownloadDir, pme.tempDir, destDir)
+	err := toolResource.Install(ctx, pme.DownloadDir, pme.tempDir, destDir)
 	if err != nil {
 		log.WithError(err).Warn("Cannot install tool")
 		return &arduino.FailedInstallError{Message: tr("Cannot install tool %s", toolRelease), Cause: err}
@@ -417,7 +437,7 @@ func (pme *Explorer) UninstallTool(toolRelease *cores.ToolRelease, taskCB rpc.Ta
 		taskCB(&rpc.TaskProgress{Message: tr("Skipping pre_uninstall script.")})
 	}
 
-	if err := toolRelease.InstallDir.RemoveAll(); err != nil {
+	if err := utils.LongPath(toolRelease.InstallDir).RemoveAll(); err != nil {
 		err = &arduino.FailedUninstallError{Message: err.Error()}
 		log.WithError(err).Error("Error uninstalling")
 		return err
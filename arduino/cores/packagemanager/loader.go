@@ -287,11 +287,7 @@ func (pm *Builder) loadPlatform(targetPackage *cores.Package, architecture strin
 func (pm *Builder) loadPlatformRelease(platform *cores.PlatformRelease, path *paths.Path) error {
 	platform.InstallDir = path
 
-	// Some useful paths
 	installedJSONPath := path.Join("installed.json")
-	platformTxtPath := path.Join("platform.txt")
-	platformTxtLocalPath := path.Join("platform.local.txt")
-	programmersTxtPath := path.Join("programmers.txt")
 
 	// If the installed.json file is found load it, this is done to handle the
 	// case in which the platform's index and its url have been deleted locally,
@@ -302,6 +298,23 @@ func (pm *Builder) loadPlatformRelease(platform *cores.PlatformRelease, path *pa
 		}
 	}
 
+	if pm.deferPlatformsLoading {
+		// The remaining configuration files will be loaded on demand
+		pm.deferredPlatformReleases = append(pm.deferredPlatformReleases, platform)
+		return nil
+	}
+	return pm.loadPlatformReleaseConfiguration(platform)
+}
+
+// loadPlatformReleaseConfiguration loads the configuration files (platform.txt,
+// programmers.txt, boards.txt, etc.) of a platform release already installed
+// in platform.InstallDir.
+func (pm *Builder) loadPlatformReleaseConfiguration(platform *cores.PlatformRelease) error {
+	path := platform.InstallDir
+	platformTxtPath := path.Join("platform.txt")
+	platformTxtLocalPath := path.Join("platform.local.txt")
+	programmersTxtPath := path.Join("programmers.txt")
+
 	// Create platform properties
 	platform.Properties = platform.Properties.Clone() // TODO: why CLONE?
 	if p, err := properties.SafeLoad(platformTxtPath.String()); err == nil {
@@ -376,6 +389,27 @@ func (pm *Builder) loadPlatformRelease(platform *cores.PlatformRelease, path *pa
 		platform.MonitorsDevRecipes[protocol] = recipe
 	}
 
+	// Build pluggable uploader references, by upload tool
+	platform.Uploaders = map[string]*cores.UploaderDependency{}
+	for toolID, ref := range platform.Properties.SubTree("pluggable_uploader.required").AsMap() {
+		split := strings.Split(ref, ":")
+		if len(split) != 2 {
+			return fmt.Errorf(tr("invalid pluggable uploader reference: %s"), ref)
+		}
+		pm.log.WithField("upload_tool", toolID).WithField("tool", ref).Info("Adding uploader tool")
+		platform.Uploaders[toolID] = &cores.UploaderDependency{
+			Packager: split[0],
+			Name:     split[1],
+		}
+	}
+
+	// Support for pluggable uploaders in debugging/development environments
+	platform.UploadersDevRecipes = map[string]string{}
+	for toolID, recipe := range platform.Properties.SubTree("pluggable_uploader.pattern").AsMap() {
+		pm.log.WithField("upload_tool", toolID).WithField("recipe", recipe).Info("Adding uploader recipe")
+		platform.UploadersDevRecipes[toolID] = recipe
+	}
+
 	return nil
 }
 
@@ -46,9 +46,11 @@ import (
 // The manager also keeps track of the status of the Packages (their Platform Releases, actually)
 // installed in the system.
 type PackageManager struct {
-	packagesLock                   sync.RWMutex // Protects packages and packagesCustomGlobalProperties
+	packagesLock                   sync.RWMutex // Protects packages, packagesCustomGlobalProperties and deferredPlatformReleases
 	packages                       cores.Packages
 	packagesCustomGlobalProperties *properties.Map
+	deferPlatformsLoading          bool
+	deferredPlatformReleases       []*cores.PlatformRelease
 
 	log              logrus.FieldLogger
 	IndexDir         *paths.Path
@@ -99,6 +101,8 @@ func (pmb *Builder) BuildIntoExistingPackageManager(target *PackageManager) {
 	target.DownloadDir = pmb.DownloadDir
 	target.tempDir = pmb.tempDir
 	target.packagesCustomGlobalProperties = pmb.packagesCustomGlobalProperties
+	target.deferPlatformsLoading = pmb.deferPlatformsLoading
+	target.deferredPlatformReleases = pmb.deferredPlatformReleases
 	target.profile = pmb.profile
 	target.discoveryManager.Clear()
 	target.discoveryManager.AddAllDiscoveriesFrom(pmb.discoveryManager)
@@ -115,12 +119,23 @@ func (pmb *Builder) Build() *PackageManager {
 		DownloadDir:                    pmb.DownloadDir,
 		tempDir:                        pmb.tempDir,
 		packagesCustomGlobalProperties: pmb.packagesCustomGlobalProperties,
+		deferPlatformsLoading:          pmb.deferPlatformsLoading,
+		deferredPlatformReleases:       pmb.deferredPlatformReleases,
 		profile:                        pmb.profile,
 		discoveryManager:               pmb.discoveryManager,
 		userAgent:                      pmb.userAgent,
 	}
 }
 
+// SetDeferPlatformsLoading enables or disables the deferred loading of platforms.
+// When enabled, the Builder only scans the installed platforms and their installation
+// directories, while the remaining configuration files (platform.txt, boards.txt,
+// programmers.txt, etc.) are loaded on demand, the first time an Explorer is created
+// or explicitly through PackageManager.LoadDeferredPlatforms.
+func (pmb *Builder) SetDeferPlatformsLoading(deferLoading bool) {
+	pmb.deferPlatformsLoading = deferLoading
+}
+
 // NewBuilder creates a Builder with the same configuration
 // of this PackageManager. A "commit" function callback is returned: calling
 // this function will make the builder write the new configuration into this
@@ -136,8 +151,32 @@ func (pm *PackageManager) NewBuilder() (builder *Builder, commit func()) {
 // The Explorer will keep a read-lock on the underlying PackageManager,
 // the user must call the "release" callback function to release the lock
 // when the Explorer is no more needed.
+// If the loading of some platforms has been deferred, those platforms are
+// loaded before returning the Explorer.
 func (pm *PackageManager) NewExplorer() (explorer *Explorer, release func()) {
 	pm.packagesLock.RLock()
+	for len(pm.deferredPlatformReleases) > 0 {
+		pm.packagesLock.RUnlock()
+		_, errs := pm.LoadDeferredPlatforms()
+		for _, err := range errs {
+			pm.log.WithError(err).Warn("Error loading platform")
+		}
+		pm.packagesLock.RLock()
+	}
+	return pm.newExplorer()
+}
+
+// NewPartialExplorer creates an Explorer like NewExplorer does, but without loading
+// the platforms whose loading has been deferred: for those platforms only the
+// installation directory is available. This is useful for the operations that
+// do not need the boards or the properties of the platforms.
+func (pm *PackageManager) NewPartialExplorer() (explorer *Explorer, release func()) {
+	pm.packagesLock.RLock()
+	return pm.newExplorer()
+}
+
+// newExplorer creates an Explorer, the read-lock must be already held by the caller.
+func (pm *PackageManager) newExplorer() (explorer *Explorer, release func()) {
 	return &Explorer{
 		log:                            pm.log,
 		packages:                       pm.packages,
@@ -152,6 +191,48 @@ func (pm *PackageManager) NewExplorer() (explorer *Explorer, release func()) {
 	}, pm.packagesLock.RUnlock
 }
 
+// LoadDeferredPlatforms completes the loading of the platforms whose loading has
+// been deferred (see Builder.SetDeferPlatformsLoading) and loads the discoveries
+// they require. If one or more platformIDs (in the form PACKAGER:ARCHITECTURE) are
+// given, only the matching platforms are loaded, otherwise all of them are.
+// The loaded platform releases are returned together with the loading errors.
+func (pm *PackageManager) LoadDeferredPlatforms(platformIDs ...string) ([]*cores.PlatformRelease, []error) {
+	pm.packagesLock.Lock()
+	defer pm.packagesLock.Unlock()
+
+	selected := func(release *cores.PlatformRelease) bool {
+		if len(platformIDs) == 0 {
+			return true
+		}
+		id := release.Platform.String()
+		for _, platformID := range platformIDs {
+			if strings.EqualFold(platformID, id) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var loaded []*cores.PlatformRelease
+	var merr []error
+	pending := []*cores.PlatformRelease{}
+	for _, release := range pm.deferredPlatformReleases {
+		if !selected(release) {
+			pending = append(pending, release)
+			continue
+		}
+		if err := (*Builder)(pm).loadPlatformReleaseConfiguration(release); err != nil {
+			merr = append(merr, fmt.Errorf("%s: %w", tr("loading platform release %s", release), err))
+			continue
+		}
+		pm.log.WithField("platform", release).Infof("Loaded deferred platform")
+		merr = append(merr, (*Explorer)(pm).loadDiscoveries(release)...)
+		loaded = append(loaded, release)
+	}
+	pm.deferredPlatformReleases = pending
+	return loaded, merr
+}
+
 // GetProfile returns the active profile for this package manager, or nil if no profile is selected.
 func (pme *Explorer) GetProfile() *sketch.Profile {
 	return pme.profile
@@ -840,6 +921,18 @@ func (pme *Explorer) FindMonitorDependency(discovery *cores.MonitorDependency) *
 	}
 }
 
+// FindUploaderDependency returns the ToolRelease referenced by the UploaderDependency or nil if
+// the referenced uploader doesn't exists.
+func (pme *Explorer) FindUploaderDependency(uploader *cores.UploaderDependency) *cores.ToolRelease {
+	if pack := pme.packages[uploader.Packager]; pack == nil {
+		return nil
+	} else if toolRelease := pack.Tools[uploader.Name]; toolRelease == nil {
+		return nil
+	} else {
+		return toolRelease.GetLatestInstalled()
+	}
+}
+
 // NormalizeFQBN return a normalized copy of the given FQBN, that is the same
 // FQBN but with the unneeded or invalid options removed.
 func (pme *Explorer) NormalizeFQBN(fqbn *cores.FQBN) (*cores.FQBN, error) {
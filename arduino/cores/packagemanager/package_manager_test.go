@@ -54,6 +54,45 @@ func TestFindBoardWithFQBN(t *testing.T) {
 	require.Equal(t, board.Name(), "Arduino/Genuino Mega or Mega 2560")
 }
 
+func TestDeferredPlatformsLoading(t *testing.T) {
+	pmb := NewBuilder(customHardware, customHardware, customHardware, customHardware, "test")
+	pmb.SetDeferPlatformsLoading(true)
+	pmb.LoadHardwareFromDirectory(customHardware)
+	pm := pmb.Build()
+
+	{
+		// A partial explorer must not trigger the loading of the platforms
+		pme, release := pm.NewPartialExplorer()
+		platform := pme.FindPlatform(&PlatformReference{Package: "arduino", PlatformArchitecture: "avr"})
+		require.NotNil(t, platform)
+		platformRelease := pme.GetInstalledPlatformRelease(platform)
+		require.NotNil(t, platformRelease)
+		require.NotNil(t, platformRelease.InstallDir)
+		require.Empty(t, platformRelease.Boards)
+		release()
+	}
+
+	// Load only the requested platform (the errors are about the
+	// discoveries that are not installed in the test data)
+	loaded, _ := pm.LoadDeferredPlatforms("arduino:avr")
+	require.Len(t, loaded, 1)
+	require.Equal(t, "arduino:avr", loaded[0].Platform.String())
+	require.NotEmpty(t, loaded[0].Boards)
+
+	// Already loaded platforms are not loaded again
+	loaded, errs := pm.LoadDeferredPlatforms("arduino:avr")
+	require.Empty(t, errs)
+	require.Empty(t, loaded)
+
+	// A full explorer loads all the remaining platforms
+	pme, release := pm.NewExplorer()
+	defer release()
+	board, err := pme.FindBoardWithFQBN("arduino:avr:uno")
+	require.NoError(t, err)
+	require.Equal(t, "Arduino/Genuino Uno", board.Name())
+	require.Empty(t, pm.deferredPlatformReleases)
+}
+
 func TestResolveFQBN(t *testing.T) {
 	// Pass nil, since these paths are only used for installing
 	pmb := NewBuilder(nil, nil, nil, nil, "test")
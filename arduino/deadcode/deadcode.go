@@ -0,0 +1,123 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package deadcode cross-references the symbols linked in the executable of a
+// sketch with the symbols defined by the compiled object files, to find the
+// libraries and the objects that contributed nothing to the firmware and the
+// sketch functions removed by the linker.
+package deadcode
+
+import (
+	"debug/elf"
+	"errors"
+	"sort"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// Report is the result of the analysis
+type Report struct {
+	// UnusedLibraries are the libraries whose objects contributed no symbol
+	// to the executable, sorted by name
+	UnusedLibraries []string
+	// UnusedObjects are the object files, of the sketch or of the libraries,
+	// that contributed no symbol to the executable
+	UnusedObjects paths.PathList
+	// EliminatedFunctions are the functions defined in the sketch and removed
+	// by the linker, sorted by name
+	EliminatedFunctions []string
+}
+
+// Analyze compares the symbols of the executable with the symbols defined in
+// the object files of the sketch and of each library (indexed by name).
+func Analyze(executable *paths.Path, sketchObjects paths.PathList, libraryObjects map[string]paths.PathList) (*Report, error) {
+	linked, err := readSymbols(executable)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		UnusedLibraries:     []string{},
+		UnusedObjects:       paths.PathList{},
+		EliminatedFunctions: []string{},
+	}
+	for _, object := range sketchObjects {
+		defined, err := readSymbols(object)
+		if err != nil {
+			return nil, err
+		}
+		if !contributes(defined, linked) {
+			report.UnusedObjects.Add(object)
+		}
+		for name, isFunc := range defined {
+			if _, ok := linked[name]; isFunc && !ok {
+				report.EliminatedFunctions = append(report.EliminatedFunctions, name)
+			}
+		}
+	}
+	for library, objects := range libraryObjects {
+		used := false
+		for _, object := range objects {
+			defined, err := readSymbols(object)
+			if err != nil {
+				return nil, err
+			}
+			if contributes(defined, linked) {
+				used = true
+			} else {
+				report.UnusedObjects.Add(object)
+			}
+		}
+		if !used && len(objects) > 0 {
+			report.UnusedLibraries = append(report.UnusedLibraries, library)
+		}
+	}
+	sort.Strings(report.UnusedLibraries)
+	sort.Strings(report.EliminatedFunctions)
+	report.UnusedObjects.Sort()
+	return report, nil
+}
+
+func contributes(defined, linked map[string]bool) bool {
+	for name := range defined {
+		if _, ok := linked[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// readSymbols returns the functions and the objects defined in an ELF file,
+// the value is true for functions.
+func readSymbols(file *paths.Path) (map[string]bool, error) {
+	f, err := elf.Open(file.String())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	symbols, err := f.Symbols()
+	if err != nil && !errors.Is(err, elf.ErrNoSymbols) {
+		return nil, err
+	}
+	res := map[string]bool{}
+	for _, symbol := range symbols {
+		typ := elf.ST_TYPE(symbol.Info)
+		if (typ != elf.STT_FUNC && typ != elf.STT_OBJECT) || symbol.Name == "" || symbol.Section == elf.SHN_UNDEF {
+			continue
+		}
+		res[symbol.Name] = typ == elf.STT_FUNC
+	}
+	return res, nil
+}
@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package deadcode
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	// The executable has been linked with `--gc-sections` from sketch.c.o,
+	// that calls used_helper from used.c.o, and from unused.c.o, that defines
+	// only never_called
+	testdata := paths.New("testdata")
+	report, err := Analyze(
+		testdata.Join("sketch.elf"),
+		paths.NewPathList(testdata.Join("sketch.c.o").String()),
+		map[string]paths.PathList{
+			"Used":   paths.NewPathList(testdata.Join("used.c.o").String()),
+			"Unused": paths.NewPathList(testdata.Join("unused.c.o").String()),
+		})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Unused"}, report.UnusedLibraries)
+	require.Equal(t, paths.NewPathList(testdata.Join("unused.c.o").String()), report.UnusedObjects)
+	require.Equal(t, []string{"unused_function"}, report.EliminatedFunctions)
+
+	_, err = Analyze(testdata.Join("missing.elf"), nil, nil)
+	require.Error(t, err)
+}
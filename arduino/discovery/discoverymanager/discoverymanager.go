@@ -94,6 +94,7 @@ func (dm *DiscoveryManager) Start() []error {
 			dm.feedEvent(ev)
 		}
 	}()
+	go dm.watchUF2Drives()
 
 	errs := []error{}
 	var errsLock sync.Mutex
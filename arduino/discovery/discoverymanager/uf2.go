@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package discoverymanager
+
+import (
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/arduino/arduino-cli/arduino/recovery"
+	properties "github.com/arduino/go-properties-orderedmap"
+)
+
+// uf2DiscoveryID is the ID of the discovery of the drives exposed by the UF2
+// bootloaders. It's built into the DiscoveryManager since it doesn't need any
+// tool to run, and it's always active.
+const uf2DiscoveryID = "builtin:uf2-discovery"
+
+// uf2PollInterval is the interval between two scans of the mounted drives
+var uf2PollInterval = time.Second
+
+// watchUF2Drives scans the mounted drives periodically and sends an event to
+// the feed for each UF2 drive added or removed.
+func (dm *DiscoveryManager) watchUF2Drives() {
+	known := map[string]*discovery.Port{}
+	for {
+		current := map[string]*discovery.Port{}
+		for _, drive := range recovery.FindUF2Drives() {
+			port := uf2Port(drive)
+			current[port.Address] = port
+			if _, ok := known[port.Address]; !ok {
+				dm.feed <- &discovery.Event{Type: "add", Port: port, DiscoveryID: uf2DiscoveryID}
+			}
+		}
+		for address, port := range known {
+			if _, ok := current[address]; !ok {
+				dm.feed <- &discovery.Event{Type: "remove", Port: port, DiscoveryID: uf2DiscoveryID}
+			}
+		}
+		known = current
+		time.Sleep(uf2PollInterval)
+	}
+}
+
+// uf2Port returns the port of a UF2 drive. The board ID and the model
+// reported by the bootloader are available as port properties, to let the
+// boards be identified with `upload_port.N.board_id`.
+func uf2Port(drive *recovery.UF2Drive) *discovery.Port {
+	props := properties.NewMap()
+	if drive.BoardID != "" {
+		props.Set("board_id", drive.BoardID)
+	}
+	if drive.Model != "" {
+		props.Set("model", drive.Model)
+	}
+	if drive.Bootloader != "" {
+		props.Set("bootloader", drive.Bootloader)
+	}
+	return &discovery.Port{
+		Address:       drive.Path.String(),
+		AddressLabel:  drive.String(),
+		Protocol:      "uf2",
+		ProtocolLabel: "UF2 Drive",
+		Properties:    props,
+	}
+}
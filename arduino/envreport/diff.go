@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package envreport
+
+import (
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Difference is an item of a report that is missing or different in the
+// other one
+type Difference struct {
+	// Type is `platform`, `tool` or `library`
+	Type     string                     `json:"type"`
+	ID       string                     `json:"id"`
+	Location string                     `json:"location,omitempty"`
+	Left     *rpc.EnvironmentReportItem `json:"left,omitempty"`
+	Right    *rpc.EnvironmentReportItem `json:"right,omitempty"`
+}
+
+// Diff returns the items that are installed only in one of the reports or
+// that have a different version or content. The machine details and the
+// generation time of the reports are not compared.
+func Diff(left, right *rpc.EnvironmentReport) []*Difference {
+	res := []*Difference{}
+	res = append(res, diffItems("platform", left.GetPlatforms(), right.GetPlatforms())...)
+	res = append(res, diffItems("tool", left.GetTools(), right.GetTools())...)
+	res = append(res, diffItems("library", left.GetLibraries(), right.GetLibraries())...)
+	return res
+}
+
+func diffItems(itemType string, left, right []*rpc.EnvironmentReportItem) []*Difference {
+	key := func(item *rpc.EnvironmentReportItem) string {
+		return item.GetId() + "\x00" + item.GetLocation()
+	}
+	rightByKey := map[string]*rpc.EnvironmentReportItem{}
+	for _, item := range right {
+		rightByKey[key(item)] = item
+	}
+	res := []*Difference{}
+	for _, l := range left {
+		r := rightByKey[key(l)]
+		delete(rightByKey, key(l))
+		if r != nil && r.GetVersion() == l.GetVersion() && r.GetHash() == l.GetHash() {
+			continue
+		}
+		res = append(res, &Difference{Type: itemType, ID: l.GetId(), Location: l.GetLocation(), Left: l, Right: r})
+	}
+	for _, r := range right {
+		if _, ok := rightByKey[key(r)]; ok {
+			res = append(res, &Difference{Type: itemType, ID: r.GetId(), Location: r.GetLocation(), Right: r})
+		}
+	}
+	return res
+}
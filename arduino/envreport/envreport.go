@@ -0,0 +1,150 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package envreport builds, checks and compares the inventories of the
+// platforms, tools and libraries installed on a machine.
+package envreport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"google.golang.org/protobuf/proto"
+)
+
+var tr = i18n.Tr
+
+// HashDir computes the hash of the files in a directory: the relative path
+// and the content of each file, walked in lexical order. The permissions and
+// the modification times are ignored, so the hash of the same installation is
+// identical on every machine.
+func HashDir(dir *paths.Path) (string, error) {
+	h := sha256.New()
+	root := dir.String()
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		switch {
+		case d.Type()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(h, "L %s\x00%s\x00", rel, filepath.ToSlash(target))
+		case d.IsDir():
+			fmt.Fprintf(h, "D %s\x00", rel)
+		default:
+			fmt.Fprintf(h, "F %s\x00", rel)
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(h, f)
+			f.Close()
+			if err != nil {
+				return err
+			}
+			h.Write([]byte{0})
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return "SHA-256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Checksum computes the checksum of a report, ignoring its Checksum field
+func Checksum(report *rpc.EnvironmentReport) (string, error) {
+	unsigned := proto.Clone(report).(*rpc.EnvironmentReport)
+	unsigned.Checksum = ""
+	data, err := json.Marshal(unsigned)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "SHA-256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// Sort sorts the items of a report and sets its checksum
+func Sort(report *rpc.EnvironmentReport) error {
+	for _, items := range [][]*rpc.EnvironmentReportItem{report.Platforms, report.Tools, report.Libraries} {
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].GetId() != items[j].GetId() {
+				return items[i].GetId() < items[j].GetId()
+			}
+			return items[i].GetLocation() < items[j].GetLocation()
+		})
+	}
+	checksum, err := Checksum(report)
+	if err != nil {
+		return err
+	}
+	report.Checksum = checksum
+	return nil
+}
+
+// Verify checks that the checksum of a report matches its content
+func Verify(report *rpc.EnvironmentReport) error {
+	checksum, err := Checksum(report)
+	if err != nil {
+		return err
+	}
+	if report.GetChecksum() != checksum {
+		return fmt.Errorf(tr("checksum mismatch: the report has been modified"))
+	}
+	return nil
+}
+
+// Load reads a report saved in JSON format and verifies its checksum
+func Load(path *paths.Path) (*rpc.EnvironmentReport, error) {
+	data, err := path.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	report := &rpc.EnvironmentReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, fmt.Errorf(tr("reading report %[1]s: %[2]s"), path, err)
+	}
+	if err := Verify(report); err != nil {
+		return nil, fmt.Errorf(tr("reading report %[1]s: %[2]s"), path, err)
+	}
+	return report, nil
+}
+
+// Save writes a report in JSON format
+func Save(report *rpc.EnvironmentReport, path *paths.Path) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return path.WriteFile(data)
+}
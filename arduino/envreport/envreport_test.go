@@ -0,0 +1,97 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package envreport
+
+import (
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashDir(t *testing.T) {
+	dir := paths.New(t.TempDir())
+	require.NoError(t, dir.Join("src").MkdirAll())
+	require.NoError(t, dir.Join("src", "lib.h").WriteFile([]byte("int f();\n")))
+	require.NoError(t, dir.Join("library.properties").WriteFile([]byte("name=Lib\n")))
+	hash, err := HashDir(dir)
+	require.NoError(t, err)
+	require.Regexp(t, "^SHA-256:[0-9a-f]{64}$", hash)
+
+	// The same content in another directory has the same hash
+	other := paths.New(t.TempDir()).Join("copy")
+	require.NoError(t, dir.CopyDirTo(other))
+	otherHash, err := HashDir(other)
+	require.NoError(t, err)
+	require.Equal(t, hash, otherHash)
+
+	// Renaming or changing a file changes the hash
+	require.NoError(t, other.Join("src", "lib.h").Rename(other.Join("src", "lib2.h")))
+	renamedHash, err := HashDir(other)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, renamedHash)
+	require.NoError(t, other.Join("src", "lib2.h").WriteFile([]byte("int g();\n")))
+	changedHash, err := HashDir(other)
+	require.NoError(t, err)
+	require.NotEqual(t, renamedHash, changedHash)
+}
+
+func TestChecksum(t *testing.T) {
+	report := &rpc.EnvironmentReport{
+		CliVersion: "1.0.0",
+		Libraries: []*rpc.EnvironmentReportItem{
+			{Id: "Servo", Version: "1.2.1", Location: "user", Hash: "SHA-256:01"},
+			{Id: "Servo", Version: "1.1.8", Location: "ide", Hash: "SHA-256:02"},
+		},
+	}
+	require.NoError(t, Sort(report))
+	require.Equal(t, "ide", report.GetLibraries()[0].GetLocation())
+	require.NoError(t, Verify(report))
+
+	path := paths.New(t.TempDir()).Join("report.json")
+	require.NoError(t, Save(report, path))
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, report.GetChecksum(), loaded.GetChecksum())
+
+	report.Libraries[0].Version = "1.2.0"
+	require.Error(t, Verify(report))
+}
+
+func TestDiff(t *testing.T) {
+	left := &rpc.EnvironmentReport{
+		Platforms: []*rpc.EnvironmentReportItem{{Id: "arduino:avr", Version: "1.8.6", Location: "managed", Hash: "SHA-256:01"}},
+		Libraries: []*rpc.EnvironmentReportItem{
+			{Id: "Servo", Version: "1.2.1", Location: "user", Hash: "SHA-256:02"},
+			{Id: "WiFi", Version: "1.2.7", Location: "user", Hash: "SHA-256:03"},
+		},
+	}
+	right := &rpc.EnvironmentReport{
+		Hostname:  "other",
+		Platforms: []*rpc.EnvironmentReportItem{{Id: "arduino:avr", Version: "1.8.6", Location: "managed", Hash: "SHA-256:01"}},
+		Tools:     []*rpc.EnvironmentReportItem{{Id: "arduino:avrdude", Version: "6.3.0", Location: "managed", Hash: "SHA-256:04"}},
+		Libraries: []*rpc.EnvironmentReportItem{
+			{Id: "Servo", Version: "1.2.1", Location: "user", Hash: "SHA-256:05"},
+		},
+	}
+	require.Empty(t, Diff(left, left))
+	require.Equal(t, []*Difference{
+		{Type: "tool", ID: "arduino:avrdude", Location: "managed", Right: right.Tools[0]},
+		{Type: "library", ID: "Servo", Location: "user", Left: left.Libraries[0], Right: right.Libraries[0]},
+		{Type: "library", ID: "WiFi", Location: "user", Left: left.Libraries[1]},
+	}, Diff(left, right))
+}
@@ -16,8 +16,10 @@
 package arduino
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -308,6 +310,29 @@ func (e *ProgrammerRequiredForUploadError) ToRPCStatus() *status.Status {
 	return st
 }
 
+// NoBootloaderDetectedError is returned when the probe performed before the upload
+// doesn't find a bootloader answering on the upload port
+type NoBootloaderDetectedError struct {
+	Port  string
+	Cause error
+}
+
+func (e *NoBootloaderDetectedError) Error() string {
+	return composeErrorMsg(tr("No bootloader detected on port %s: burn the bootloader with the burn-bootloader command or upload using a programmer", e.Port), e.Cause)
+}
+
+func (e *NoBootloaderDetectedError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *NoBootloaderDetectedError) ToRPCStatus() *status.Status {
+	st, _ := status.
+		New(codes.FailedPrecondition, e.Error()).
+		WithDetails(&rpc.NoBootloaderDetectedError{Port: e.Port})
+	return st
+}
+
 // InitFailedError is returned when the instance initialization fails
 type InitFailedError struct {
 	Code   codes.Code
@@ -491,6 +516,22 @@ func (e *PlatformAlreadyAtTheLatestVersionError) ToRPCStatus() *status.Status {
 	return st
 }
 
+// PlatformPinnedError is returned when a platform can't be upgraded because
+// it's pinned to the installed version
+type PlatformPinnedError struct {
+	Platform string
+	Version  string
+}
+
+func (e *PlatformPinnedError) Error() string {
+	return tr("Platform '%[1]s' is pinned to version %[2]s", e.Platform, e.Version)
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *PlatformPinnedError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
 // MissingSketchPathError is returned when the sketch path is mandatory and not specified
 type MissingSketchPathError struct{}
 
@@ -638,9 +679,48 @@ func (e *FailedUploadError) Unwrap() error {
 
 // ToRPCStatus converts the error into a *status.Status
 func (e *FailedUploadError) ToRPCStatus() *status.Status {
+	var timeoutErr *TimeoutError
+	if errors.As(e.Cause, &timeoutErr) {
+		return status.New(codes.DeadlineExceeded, e.Error())
+	}
 	return status.New(codes.Internal, e.Error())
 }
 
+// PortBusyError is returned when an operation fails because the port is kept
+// open by other processes
+type PortBusyError struct {
+	Port    string
+	Holders []*rpc.PortHolder
+	Cause   error
+}
+
+func (e *PortBusyError) Error() string {
+	holders := []string{}
+	for _, holder := range e.Holders {
+		holders = append(holders, fmt.Sprintf("%s (pid %d)", holder.GetName(), holder.GetPid()))
+	}
+	msg := tr("Port %[1]s is in use by: %[2]s. Close these programs and try again.", e.Port, strings.Join(holders, ", "))
+	if e.Cause == nil {
+		return msg
+	}
+	return fmt.Sprintf("%v\n%v", e.Cause, msg)
+}
+
+func (e *PortBusyError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *PortBusyError) ToRPCStatus() *status.Status {
+	st, _ := status.
+		New(codes.FailedPrecondition, e.Error()).
+		WithDetails(&rpc.PortBusyError{
+			Address: e.Port,
+			Holders: e.Holders,
+		})
+	return st
+}
+
 // FailedDebugError is returned when the debug fails
 type FailedDebugError struct {
 	Message string
@@ -660,6 +740,25 @@ func (e *FailedDebugError) ToRPCStatus() *status.Status {
 	return status.New(codes.Internal, e.Error())
 }
 
+// FailedFilesystemImageError is returned when the build of a filesystem image fails
+type FailedFilesystemImageError struct {
+	Message string
+	Cause   error
+}
+
+func (e *FailedFilesystemImageError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+func (e *FailedFilesystemImageError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *FailedFilesystemImageError) ToRPCStatus() *status.Status {
+	return status.New(codes.Internal, e.Error())
+}
+
 // FailedMonitorError is returned when opening the monitor port of a board fails
 type FailedMonitorError struct {
 	Cause error
@@ -868,3 +967,54 @@ func (e *MultipleLibraryInstallDetected) Error() string {
 func (e *MultipleLibraryInstallDetected) ToRPCStatus() *status.Status {
 	return status.New(codes.InvalidArgument, e.Error())
 }
+
+// UnsupportedAPIVersionError is returned when none of the gRPC API versions
+// supported by the client is served by the daemon.
+type UnsupportedAPIVersionError struct {
+	ClientVersions []string
+	ServerVersions []string
+}
+
+func (e *UnsupportedAPIVersionError) Error() string {
+	return tr("No common gRPC API version: the client supports %[1]s, the daemon serves %[2]s",
+		strings.Join(e.ClientVersions, ", "), strings.Join(e.ServerVersions, ", "))
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *UnsupportedAPIVersionError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+// InstanceBusyError is returned when an operation needs a resource of the
+// instance that is in use by other operations in a conflicting mode.
+type InstanceBusyError struct {
+	Operation string
+	Resource  string
+	HeldBy    []string
+}
+
+func (e *InstanceBusyError) Error() string {
+	return tr("Cannot run %[1]s: the %[2]s of the instance are in use by %[3]s", e.Operation, e.Resource, strings.Join(e.HeldBy, ", "))
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *InstanceBusyError) ToRPCStatus() *status.Status {
+	return status.New(codes.Aborted, e.Error())
+}
+
+// TimeoutError is returned when a download or a tool doesn't complete within
+// the time limit configured in Setting.
+type TimeoutError struct {
+	Operation string
+	Timeout   time.Duration
+	Setting   string
+}
+
+func (e *TimeoutError) Error() string {
+	return tr("%[1]s timed out after %[2]s, the limit can be changed with the %[3]s setting", e.Operation, e.Timeout, e.Setting)
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *TimeoutError) ToRPCStatus() *status.Status {
+	return status.New(codes.DeadlineExceeded, e.Error())
+}
@@ -0,0 +1,144 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package fsimage determines the layout of the filesystem images (LittleFS,
+// SPIFFS, FatFS) built from the data folder of a sketch and flashed alongside
+// it, using the metadata provided by the platform.
+package fsimage
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/arduino/arduino-cli/arduino/partitions"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+var tr = i18n.Tr
+
+// Types are the supported filesystem types
+var Types = []string{"littlefs", "spiffs", "fatfs"}
+
+// partitionSubTypes maps each filesystem type to the subtypes of the
+// partitions that may hold it. The ESP32 platform historically uses the
+// `spiffs` subtype also for LittleFS partitions.
+var partitionSubTypes = map[string][]string{
+	"littlefs": {"littlefs", "spiffs"},
+	"spiffs":   {"spiffs"},
+	"fatfs":    {"fat"},
+}
+
+const (
+	defaultType      = "littlefs"
+	defaultBlockSize = 4096
+	defaultPageSize  = 256
+)
+
+// Image is the layout of a filesystem image
+type Image struct {
+	Type      string
+	Offset    uint64
+	Size      uint64
+	BlockSize uint64
+	PageSize  uint64
+}
+
+// Resolve determines the layout of the filesystem image of the given type
+// (or of the type preferred by the board if empty). The offset and the size
+// of the image are taken from the first suitable partition of the partition
+// table, if given, or from the `fsimage.offset` and `fsimage.size` properties.
+func Resolve(buildProperties *properties.Map, partitionsFile *paths.Path, fsType string) (*Image, error) {
+	if fsType == "" {
+		fsType = buildProperties.Get("fsimage.default")
+	}
+	if fsType == "" {
+		fsType = defaultType
+	}
+	if !slices.Contains(Types, fsType) {
+		return nil, errors.New(tr("invalid filesystem type %[1]s, must be one of: %[2]s", fsType, Types))
+	}
+	if !buildProperties.ContainsKey("recipe.fsimage." + fsType + ".pattern") {
+		return nil, errors.New(tr("the platform doesn't support %s filesystem images", fsType))
+	}
+
+	image := &Image{Type: fsType}
+	if partitionsFile != nil {
+		table, err := partitions.Load(partitionsFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range table.Partitions {
+			if p.Type == "data" && slices.Contains(partitionSubTypes[fsType], p.SubType) {
+				image.Offset, image.Size = p.Offset, p.Size
+				break
+			}
+		}
+		if image.Size == 0 {
+			return nil, errors.New(tr("no partition suitable for a %[1]s filesystem in %[2]s", fsType, partitionsFile))
+		}
+	} else {
+		var err error
+		if image.Offset, err = partitions.ParseSize(buildProperties.Get("fsimage.offset")); err != nil {
+			return nil, errors.New(tr("the board doesn't define the offset of the filesystem image"))
+		}
+		if image.Size, err = partitions.ParseSize(buildProperties.Get("fsimage.size")); err != nil || image.Size == 0 {
+			return nil, errors.New(tr("the board doesn't define the size of the filesystem image"))
+		}
+	}
+
+	var err error
+	if image.BlockSize, err = sizeProperty(buildProperties, "fsimage."+fsType+".block_size", defaultBlockSize); err != nil {
+		return nil, err
+	}
+	if image.PageSize, err = sizeProperty(buildProperties, "fsimage."+fsType+".page_size", defaultPageSize); err != nil {
+		return nil, err
+	}
+	if image.Size%image.BlockSize != 0 {
+		return nil, errors.New(tr("the filesystem size %[1]d is not a multiple of the block size %[2]d", image.Size, image.BlockSize))
+	}
+	return image, nil
+}
+
+func sizeProperty(props *properties.Map, key string, defaultValue uint64) (uint64, error) {
+	value, ok := props.GetOk(key)
+	if !ok {
+		return defaultValue, nil
+	}
+	size, err := partitions.ParseSize(value)
+	if err != nil || size == 0 {
+		return 0, errors.New(tr("invalid value for property %[1]s: %[2]s", key, value))
+	}
+	return size, nil
+}
+
+// Properties returns the properties describing the image, to be used in the
+// recipes that build and upload it.
+func (img *Image) Properties(sourcePath, imagePath *paths.Path) *properties.Map {
+	props := properties.NewMap()
+	props.Set("fsimage.type", img.Type)
+	props.Set("fsimage.offset", fmt.Sprintf("0x%X", img.Offset))
+	props.Set("fsimage.size", strconv.FormatUint(img.Size, 10))
+	props.Set("fsimage.block_size", strconv.FormatUint(img.BlockSize, 10))
+	props.Set("fsimage.page_size", strconv.FormatUint(img.PageSize, 10))
+	if sourcePath != nil {
+		props.SetPath("fsimage.source_path", sourcePath)
+	}
+	props.SetPath("fsimage.image_path", imagePath)
+	return props
+}
@@ -0,0 +1,72 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package fsimage
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveFromPartitionTable(t *testing.T) {
+	partitionsFile := paths.New("..", "partitions", "testdata", "default.csv")
+	props := properties.NewMap()
+	props.Set("recipe.fsimage.littlefs.pattern", "mklittlefs")
+	props.Set("recipe.fsimage.fatfs.pattern", "mkfatfs")
+
+	image, err := Resolve(props, partitionsFile, "")
+	require.NoError(t, err)
+	require.Equal(t, &Image{Type: "littlefs", Offset: 0x290000, Size: 0x160000, BlockSize: 4096, PageSize: 256}, image)
+
+	// The partition table has no FAT partition
+	_, err = Resolve(props, partitionsFile, "fatfs")
+	require.Error(t, err)
+
+	// The platform doesn't support SPIFFS
+	_, err = Resolve(props, partitionsFile, "spiffs")
+	require.Error(t, err)
+
+	_, err = Resolve(props, partitionsFile, "ext4")
+	require.Error(t, err)
+}
+
+func TestResolveFromProperties(t *testing.T) {
+	props := properties.NewMap()
+	props.Set("fsimage.default", "spiffs")
+	props.Set("recipe.fsimage.spiffs.pattern", "mkspiffs")
+	props.Set("fsimage.spiffs.block_size", "8K")
+	props.Set("fsimage.offset", "0x300000")
+	props.Set("fsimage.size", "1M")
+
+	image, err := Resolve(props, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, &Image{Type: "spiffs", Offset: 0x300000, Size: 0x100000, BlockSize: 8192, PageSize: 256}, image)
+
+	imageProps := image.Properties(paths.New("data"), paths.New("image.bin"))
+	require.Equal(t, "0x300000", imageProps.Get("fsimage.offset"))
+	require.Equal(t, "1048576", imageProps.Get("fsimage.size"))
+	require.Equal(t, "8192", imageProps.Get("fsimage.block_size"))
+
+	props.Set("fsimage.size", "1000")
+	_, err = Resolve(props, nil, "")
+	require.Error(t, err)
+
+	props.Remove("fsimage.offset")
+	_, err = Resolve(props, nil, "")
+	require.Error(t, err)
+}
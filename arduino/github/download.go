@@ -0,0 +1,118 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// checksumFiles are the names, lowercase, of the assets commonly used to
+// publish the checksums of the other assets of a release.
+var checksumFiles = []string{"sha256sums", "sha256sums.txt", "checksums.txt", "checksums.sha256"}
+
+// DownloadAsset downloads the asset of the release to the dest file and
+// verifies its size and its SHA-256 checksum. The checksum is the one
+// computed by GitHub or, for older assets, the one published in a checksums
+// asset of the release (`SHA256SUMS`, `checksums.txt` or `<asset>.sha256`).
+func (c *Client) DownloadAsset(release *Release, asset *Asset, dest *paths.Path) error {
+	expected, err := c.assetChecksum(release, asset)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.get(asset.DownloadURL, "application/octet-stream")
+	if err != nil {
+		return fmt.Errorf(tr("downloading %[1]s: %[2]s"), asset.Name, err)
+	}
+	defer resp.Body.Close()
+	out, err := dest.Create()
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(out, hash), resp.Body)
+	if err != nil {
+		return fmt.Errorf(tr("downloading %[1]s: %[2]s"), asset.Name, err)
+	}
+
+	if size != asset.Size {
+		return errors.New(tr("the size of %[1]s is %[2]d bytes instead of %[3]d", asset.Name, size, asset.Size))
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != expected {
+		return errors.New(tr("the SHA-256 checksum of %[1]s is %[2]s instead of %[3]s", asset.Name, checksum, expected))
+	}
+	return nil
+}
+
+// assetChecksum returns the expected SHA-256 checksum of the asset
+func (c *Client) assetChecksum(release *Release, asset *Asset) (string, error) {
+	if digest, ok := strings.CutPrefix(asset.Digest, "sha256:"); ok {
+		return strings.ToLower(digest), nil
+	}
+
+	for _, checksumAsset := range release.Assets {
+		name := strings.ToLower(checksumAsset.Name)
+		isAssetChecksum := name == strings.ToLower(asset.Name)+".sha256"
+		isChecksumList := false
+		for _, checksumFile := range checksumFiles {
+			isChecksumList = isChecksumList || name == checksumFile
+		}
+		if !isAssetChecksum && !isChecksumList {
+			continue
+		}
+		data, err := c.readAll(checksumAsset.DownloadURL)
+		if err != nil {
+			return "", fmt.Errorf(tr("downloading %[1]s: %[2]s"), checksumAsset.Name, err)
+		}
+		if checksum := findChecksum(data, asset.Name, isAssetChecksum); checksum != "" {
+			return checksum, nil
+		}
+	}
+	return "", errors.New(tr("no checksum published for %[1]s in release %[2]s, the asset can't be verified", asset.Name, release.TagName))
+}
+
+// findChecksum returns the checksum of the file in the list of checksums in
+// the `sha256sum` format. If single is true the list may contain only the
+// checksum, without the file name.
+func findChecksum(data []byte, file string, single bool) string {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || len(fields[0]) != sha256.Size*2 {
+			continue
+		}
+		if _, err := hex.DecodeString(fields[0]); err != nil {
+			continue
+		}
+		if len(fields) == 1 && single {
+			return strings.ToLower(fields[0])
+		}
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == file {
+			return strings.ToLower(fields[0])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,210 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package github downloads the assets of the releases of GitHub repositories,
+// to install libraries and platforms that are not in the official indexes.
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+)
+
+var tr = i18n.Tr
+
+// APIURL is the base URL of the public GitHub API
+const APIURL = "https://api.github.com"
+
+// ReleaseReference is a reference to a release of a GitHub repository in
+// the format `owner/repo[@tag][#asset]`. An empty Tag means the latest
+// release, an empty Asset means the asset is selected by its extension.
+type ReleaseReference struct {
+	Owner string
+	Repo  string
+	Tag   string
+	Asset string
+}
+
+var releaseReferenceRegexp = regexp.MustCompile(`^([\w.-]+)/([\w.-]+)(?:@([^#]+))?(?:#(.+))?$`)
+
+// IsReleaseReference returns true if arg is a reference to a GitHub release.
+// Library names and platform references never contain a `/`.
+func IsReleaseReference(arg string) bool {
+	return releaseReferenceRegexp.MatchString(arg)
+}
+
+// ParseReleaseReference parses a reference in the `owner/repo[@tag][#asset]`
+// format.
+func ParseReleaseReference(arg string) (*ReleaseReference, error) {
+	match := releaseReferenceRegexp.FindStringSubmatch(arg)
+	if match == nil {
+		return nil, errors.New(tr("invalid GitHub release '%s', expected owner/repo@tag", arg))
+	}
+	return &ReleaseReference{Owner: match[1], Repo: match[2], Tag: match[3], Asset: match[4]}, nil
+}
+
+func (r *ReleaseReference) String() string {
+	res := r.Owner + "/" + r.Repo
+	if r.Tag != "" {
+		res += "@" + r.Tag
+	}
+	if r.Asset != "" {
+		res += "#" + r.Asset
+	}
+	return res
+}
+
+// Release is a release of a GitHub repository
+type Release struct {
+	TagName string   `json:"tag_name"`
+	Assets  []*Asset `json:"assets"`
+}
+
+// Asset is a file attached to a release
+type Asset struct {
+	Name        string `json:"name"`
+	DownloadURL string `json:"browser_download_url"`
+	Size        int64  `json:"size"`
+	// Digest is the checksum computed by GitHub, in the `sha256:<hex>`
+	// format. It's empty for the assets uploaded before June 2025.
+	Digest string `json:"digest"`
+}
+
+// Client is a client of the GitHub API. The GITHUB_TOKEN environment
+// variable, if set, is used to authenticate the requests, raising the rate
+// limits and giving access to the private repositories.
+type Client struct {
+	http    *http.Client
+	baseURL string
+	token   string
+}
+
+// NewClient creates a Client of the GitHub API at baseURL
+func NewClient(httpClient *http.Client, baseURL string) *Client {
+	return &Client{
+		http:    httpClient,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   os.Getenv("GITHUB_TOKEN"),
+	}
+}
+
+// get sends an authenticated GET request to the URL
+func (c *Client) get(u string, accept string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf(tr("GET %[1]s: %[2]s"), u, resp.Status)
+	}
+	return resp, nil
+}
+
+// GetRelease returns the release of the reference, the latest one if the
+// reference has no tag.
+func (c *Client) GetRelease(ref *ReleaseReference) (*Release, error) {
+	u := c.baseURL + "/repos/" + url.PathEscape(ref.Owner) + "/" + url.PathEscape(ref.Repo) + "/releases/"
+	if ref.Tag == "" {
+		u += "latest"
+	} else {
+		u += "tags/" + url.PathEscape(ref.Tag)
+	}
+	resp, err := c.get(u, "application/vnd.github+json")
+	if err != nil {
+		return nil, fmt.Errorf(tr("getting release %[1]s: %[2]s"), ref, err)
+	}
+	defer resp.Body.Close()
+	var release Release
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, fmt.Errorf(tr("getting release %[1]s: %[2]s"), ref, err)
+	}
+	return &release, nil
+}
+
+// FindAsset returns the asset of the release named as in the reference or,
+// if the reference doesn't name one, the only asset with one of the given
+// extensions. If more assets have the extensions the ones containing the
+// name of the repository are preferred.
+func (r *Release) FindAsset(ref *ReleaseReference, extensions ...string) (*Asset, error) {
+	if ref.Asset != "" {
+		for _, asset := range r.Assets {
+			if asset.Name == ref.Asset {
+				return asset, nil
+			}
+		}
+		return nil, errors.New(tr("asset %[1]s not found in release %[2]s", ref.Asset, r.TagName))
+	}
+
+	candidates := []*Asset{}
+	for _, asset := range r.Assets {
+		for _, ext := range extensions {
+			if strings.HasSuffix(strings.ToLower(asset.Name), ext) {
+				candidates = append(candidates, asset)
+				break
+			}
+		}
+	}
+	if len(candidates) > 1 {
+		named := []*Asset{}
+		for _, asset := range candidates {
+			if strings.Contains(strings.ToLower(asset.Name), strings.ToLower(ref.Repo)) {
+				named = append(named, asset)
+			}
+		}
+		if len(named) > 0 {
+			candidates = named
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, errors.New(tr("no %[1]s asset found in release %[2]s", strings.Join(extensions, ", "), r.TagName))
+	case 1:
+		return candidates[0], nil
+	default:
+		names := []string{}
+		for _, asset := range candidates {
+			names = append(names, asset.Name)
+		}
+		return nil, errors.New(tr("multiple assets found in release %[1]s: %[2]s, select one with %[3]s", r.TagName, strings.Join(names, ", "), ref.String()+"#"+tr("ASSET")))
+	}
+}
+
+// readAll reads the content of the URL
+func (c *Client) readAll(u string) ([]byte, error) {
+	resp, err := c.get(u, "application/octet-stream")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,127 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package github
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReleaseReference(t *testing.T) {
+	ref, err := ParseReleaseReference("owner/MyLib@v1.2.0")
+	require.NoError(t, err)
+	require.Equal(t, &ReleaseReference{Owner: "owner", Repo: "MyLib", Tag: "v1.2.0"}, ref)
+	require.Equal(t, "owner/MyLib@v1.2.0", ref.String())
+
+	ref, err = ParseReleaseReference("owner/my-core#my-core-avr.tar.bz2")
+	require.NoError(t, err)
+	require.Equal(t, &ReleaseReference{Owner: "owner", Repo: "my-core", Asset: "my-core-avr.tar.bz2"}, ref)
+
+	require.True(t, IsReleaseReference("owner/repo"))
+	require.False(t, IsReleaseReference("Servo@1.2.1"))
+	require.False(t, IsReleaseReference("arduino:avr@1.8.6"))
+	require.False(t, IsReleaseReference("owner/repo/extra"))
+	_, err = ParseReleaseReference("Servo")
+	require.Error(t, err)
+}
+
+func TestFindAsset(t *testing.T) {
+	release := &Release{
+		TagName: "v1.0.0",
+		Assets: []*Asset{
+			{Name: "docs.zip"},
+			{Name: "MyLib-1.0.0.zip"},
+			{Name: "SHA256SUMS"},
+		},
+	}
+	ref := &ReleaseReference{Owner: "owner", Repo: "MyLib"}
+	asset, err := release.FindAsset(ref, ".zip")
+	require.NoError(t, err)
+	require.Equal(t, "MyLib-1.0.0.zip", asset.Name)
+
+	_, err = release.FindAsset(ref, ".tar.bz2")
+	require.ErrorContains(t, err, "no .tar.bz2 asset found")
+
+	_, err = release.FindAsset(&ReleaseReference{Owner: "owner", Repo: "Other"}, ".zip")
+	require.ErrorContains(t, err, "multiple assets found in release v1.0.0: docs.zip, MyLib-1.0.0.zip")
+
+	asset, err = release.FindAsset(&ReleaseReference{Owner: "owner", Repo: "Other", Asset: "docs.zip"}, ".zip")
+	require.NoError(t, err)
+	require.Equal(t, "docs.zip", asset.Name)
+}
+
+func TestDownloadAsset(t *testing.T) {
+	content := []byte("library archive")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/MyLib/releases/tags/v1.0.0":
+			json.NewEncoder(w).Encode(&Release{
+				TagName: "v1.0.0",
+				Assets: []*Asset{
+					{Name: "MyLib.zip", DownloadURL: server.URL + "/MyLib.zip", Size: int64(len(content))},
+					{Name: "Corrupted.zip", DownloadURL: server.URL + "/MyLib.zip", Size: int64(len(content))},
+					{Name: "SHA256SUMS", DownloadURL: server.URL + "/SHA256SUMS"},
+				},
+			})
+		case "/repos/owner/MyLib/releases/latest":
+			json.NewEncoder(w).Encode(&Release{
+				TagName: "v2.0.0",
+				Assets: []*Asset{
+					{Name: "MyLib.zip", DownloadURL: server.URL + "/MyLib.zip", Size: int64(len(content)), Digest: "sha256:" + checksum},
+					{Name: "Unverified.zip", DownloadURL: server.URL + "/MyLib.zip", Size: int64(len(content))},
+				},
+			})
+		case "/MyLib.zip":
+			w.Write(content)
+		case "/SHA256SUMS":
+			w.Write([]byte(checksum + "  MyLib.zip\n" + "0000000000000000000000000000000000000000000000000000000000000000 *Corrupted.zip\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client(), server.URL)
+	dest := paths.New(t.TempDir()).Join("MyLib.zip")
+
+	release, err := client.GetRelease(&ReleaseReference{Owner: "owner", Repo: "MyLib", Tag: "v1.0.0"})
+	require.NoError(t, err)
+	require.NoError(t, client.DownloadAsset(release, release.Assets[0], dest))
+	data, err := dest.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+	require.ErrorContains(t, client.DownloadAsset(release, release.Assets[1], dest), "the SHA-256 checksum of Corrupted.zip is")
+
+	release, err = client.GetRelease(&ReleaseReference{Owner: "owner", Repo: "MyLib"})
+	require.NoError(t, err)
+	require.Equal(t, "v2.0.0", release.TagName)
+	require.NoError(t, client.DownloadAsset(release, release.Assets[0], dest))
+	require.ErrorContains(t, client.DownloadAsset(release, release.Assets[1], dest), "no checksum published for Unverified.zip")
+
+	_, err = client.GetRelease(&ReleaseReference{Owner: "owner", Repo: "Missing", Tag: "v1.0.0"})
+	require.ErrorContains(t, err, "404 Not Found")
+}
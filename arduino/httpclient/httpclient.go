@@ -16,6 +16,8 @@
 package httpclient
 
 import (
+	"context"
+	"net"
 	"net/http"
 	"net/url"
 	"time"
@@ -34,12 +36,24 @@ var tr = i18n.Tr
 // DownloadFile downloads a file from a URL into the specified path. An optional config and options may be passed (or nil to use the defaults).
 // A DownloadProgressCB callback function must be passed to monitor download progress.
 // If a not empty queryParameter is passed, it is appended to the URL for analysis purposes.
-func DownloadFile(path *paths.Path, URL string, queryParameter string, label string, downloadCB rpc.DownloadProgressCB, config *downloader.Config, options ...downloader.DownloadOptions) (returnedError error) {
-	if queryParameter != "" {
-		URL = URL + "?query=" + queryParameter
+// The download is aborted if the given context is canceled, the partially downloaded file is
+// kept to resume the download later. If the server doesn't respond or doesn't send data for
+// more than the network.request_timeout setting the download fails with a *arduino.TimeoutError.
+// If the download fails, it's retried from the mirrors of the URL configured in network.mirrors.
+func DownloadFile(ctx context.Context, path *paths.Path, URL string, queryParameter string, label string, downloadCB rpc.DownloadProgressCB, config *downloader.Config, options ...downloader.DownloadOptions) error {
+	return DownloadFileFromMirrors(ctx, path, []string{URL}, queryParameter, label, downloadCB, config, options...)
+}
+
+// DownloadFileFromMirrors works like DownloadFile, but the file is available from more
+// URLs: if the download fails it's retried from the next URL, and then from the mirrors
+// of the URLs configured in network.mirrors. The error of the first URL is returned if
+// all the downloads fail.
+func DownloadFileFromMirrors(ctx context.Context, path *paths.Path, URLs []string, queryParameter string, label string, downloadCB rpc.DownloadProgressCB, config *downloader.Config, options ...downloader.DownloadOptions) (returnedError error) {
+	URLs, err := withConfiguredMirrors(URLs)
+	if err != nil {
+		return err
 	}
-	logrus.WithField("url", URL).Info("Starting download")
-	downloadCB.Start(URL, label)
+	downloadCB.Start(URLs[0], label)
 	defer func() {
 		if returnedError == nil {
 			downloadCB.End(true, "")
@@ -56,14 +70,70 @@ func DownloadFile(path *paths.Path, URL string, queryParameter string, label str
 		config = c
 	}
 
-	d, err := downloader.DownloadWithConfig(path.String(), URL, *config, options...)
+	var firstError error
+	for i, URL := range URLs {
+		if i > 0 {
+			logrus.WithError(err).WithField("mirror", URL).Warn("Download failed, trying from mirror")
+			// The partial file of the failed download can't be resumed from another server
+			_ = path.Remove()
+		}
+		if queryParameter != "" {
+			URL = URL + "?query=" + queryParameter
+		}
+		err = downloadFile(ctx, path, URL, downloadCB, config, options...)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		if firstError == nil {
+			firstError = err
+		}
+	}
+	return firstError
+}
+
+func downloadFile(ctx context.Context, path *paths.Path, URL string, downloadCB rpc.DownloadProgressCB, config *downloader.Config, options ...downloader.DownloadOptions) error {
+	logrus.WithField("url", URL).Info("Starting download")
+
+	// The download is canceled by the watchdog if it stalls for more than the
+	// request timeout, the timer is restarted every time some data is received.
+	downloadCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	timeout := configuration.NetworkRequestTimeout(configuration.Settings)
+	errStalled := &arduino.TimeoutError{Operation: tr("Download of %s", URL), Timeout: timeout, Setting: "network.request_timeout"}
+	var watchdog *time.Timer
+	if timeout > 0 {
+		watchdog = time.AfterFunc(timeout, func() { cancel(errStalled) })
+		defer watchdog.Stop()
+	}
+	canceled := func() error {
+		if downloadCtx.Err() != nil {
+			return context.Cause(downloadCtx)
+		}
+		return nil
+	}
+
+	contextConfig := *config
+	contextConfig.HttpClient.Transport = &contextRoundTripper{ctx: downloadCtx, transport: config.HttpClient.Transport}
+
+	d, err := downloader.DownloadWithConfig(path.String(), URL, contextConfig, options...)
 	if err != nil {
+		if cause := canceled(); cause != nil {
+			return cause
+		}
 		return err
 	}
 
+	lastDownloaded := int64(-1)
 	err = d.RunAndPoll(func(downloaded int64) {
+		if watchdog != nil && downloaded != lastDownloaded {
+			watchdog.Reset(timeout)
+			lastDownloaded = downloaded
+		}
 		downloadCB.Update(downloaded, d.Size())
 	}, 250*time.Millisecond)
+	if cause := canceled(); cause != nil {
+		return cause
+	}
 	if err != nil {
 		return err
 	}
@@ -81,24 +151,58 @@ func DownloadFile(path *paths.Path, URL string, queryParameter string, label str
 type Config struct {
 	UserAgent string
 	Proxy     *url.URL
+	// ProxyFunc selects the proxy of each request, it's used if Proxy is
+	// not set. If both are nil the connections are made directly.
+	ProxyFunc func(*url.URL) (*url.URL, error)
+	// RequestTimeout is the max time to wait for the connection to the
+	// server (at most maxConnectTimeout) and for its response, 0 means no limit
+	RequestTimeout time.Duration
 }
 
 // New returns a default http client for use in the arduino-cli
 func New() (*http.Client, error) {
 	userAgent := configuration.UserAgent(configuration.Settings)
-	proxy, err := configuration.NetworkProxy(configuration.Settings)
+	proxyFunc, err := proxyFromSettings(configuration.Settings)
 	if err != nil {
 		return nil, err
 	}
-	return NewWithConfig(&Config{UserAgent: userAgent, Proxy: proxy}), nil
+	return NewWithConfig(&Config{
+		UserAgent:      userAgent,
+		ProxyFunc:      proxyFunc,
+		RequestTimeout: configuration.NetworkRequestTimeout(configuration.Settings),
+	}), nil
 }
 
+// maxConnectTimeout limits the time to wait for the connection to a server, so
+// that the downloads from an unreachable server quickly fall back to the mirrors
+const maxConnectTimeout = 15 * time.Second
+
 // NewWithConfig creates a http client for use in the arduino-cli, with a given configuration
 func NewWithConfig(config *Config) *http.Client {
+	connectTimeout := config.RequestTimeout
+	if connectTimeout > maxConnectTimeout {
+		connectTimeout = maxConnectTimeout
+	}
+	dialer := &net.Dialer{
+		Timeout: connectTimeout,
+		// If the host has both IPv6 and IPv4 addresses, the IPv4 connection is
+		// attempted if the IPv6 one doesn't succeed within this delay (RFC 6555),
+		// so that a broken IPv6 network doesn't block the connections
+		FallbackDelay: 300 * time.Millisecond,
+	}
+	proxy := func(*http.Request) (*url.URL, error) { return nil, nil }
+	if config.Proxy != nil {
+		proxy = http.ProxyURL(config.Proxy)
+	} else if config.ProxyFunc != nil {
+		proxy = func(req *http.Request) (*url.URL, error) { return config.ProxyFunc(req.URL) }
+	}
 	return &http.Client{
 		Transport: &httpClientRoundTripper{
 			transport: &http.Transport{
-				Proxy: http.ProxyURL(config.Proxy),
+				Proxy:                 proxy,
+				DialContext:           dialer.DialContext,
+				TLSHandshakeTimeout:   config.RequestTimeout,
+				ResponseHeaderTimeout: config.RequestTimeout,
 			},
 			userAgent: config.UserAgent,
 		},
@@ -125,3 +229,18 @@ func (h *httpClientRoundTripper) RoundTrip(req *http.Request) (*http.Response, e
 	req.Header.Add("User-Agent", h.userAgent)
 	return h.transport.RoundTrip(req)
 }
+
+// contextRoundTripper binds the requests to a context, the requests (and the
+// reads of the response bodies) are aborted when the context is canceled.
+type contextRoundTripper struct {
+	ctx       context.Context
+	transport http.RoundTripper
+}
+
+func (c *contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := c.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return transport.RoundTrip(req.WithContext(c.ctx))
+}
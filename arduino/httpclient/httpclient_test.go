@@ -16,14 +16,21 @@
 package httpclient
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
+	"go.bug.st/downloader/v2"
 )
 
 func TestUserAgentHeader(t *testing.T) {
@@ -68,3 +75,87 @@ func TestProxy(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, http.StatusNoContent, response.StatusCode)
 }
+
+func TestDownloadFileCanceled(t *testing.T) {
+	// The server sends the first chunk of the file and then stalls
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "2048")
+		w.Write(make([]byte, 1024))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	progress := func(p *rpc.DownloadProgress) {
+		if p.GetUpdate().GetDownloaded() > 0 {
+			cancel()
+		}
+	}
+	file := paths.New(t.TempDir(), "download")
+	start := time.Now()
+	err := DownloadFile(ctx, file, ts.URL, "", "test", progress, &downloader.Config{})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestDownloadFileStalled(t *testing.T) {
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("network.request_timeout", "500ms")
+
+	// The server sends the first chunk of the file and then stalls
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "2048")
+		w.Write(make([]byte, 1024))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	file := paths.New(t.TempDir(), "download")
+	start := time.Now()
+	err := DownloadFile(context.Background(), file, ts.URL, "", "test", func(*rpc.DownloadProgress) {}, &downloader.Config{})
+	var timeoutErr *arduino.TimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, "network.request_timeout", timeoutErr.Setting)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestDownloadFileFromMirrors(t *testing.T) {
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "mirrored "+r.URL.Path)
+	}))
+	defer mirror.Close()
+	// A server that is not listening anymore, the connection is refused
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("network.mirrors", map[string]any{
+		"test": map[string]any{
+			"url":     unreachable.URL + "/packages/",
+			"mirrors": []string{broken.URL + "/", mirror.URL + "/mirror/"},
+		},
+	})
+
+	// The mirrors listed in the index are tried first, then the configured ones
+	file := paths.New(t.TempDir(), "download")
+	err := DownloadFileFromMirrors(context.Background(), file, []string{unreachable.URL + "/packages/core.zip", broken.URL + "/core.zip"}, "", "test", func(*rpc.DownloadProgress) {}, &downloader.Config{})
+	require.NoError(t, err)
+	content, err := file.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "mirrored /mirror/core.zip", string(content))
+
+	// The error of the first URL is returned if all the mirrors fail
+	require.NoError(t, file.Remove())
+	err = DownloadFileFromMirrors(context.Background(), file, []string{broken.URL + "/core.zip", unreachable.URL + "/other/core.zip"}, "", "test", func(*rpc.DownloadProgress) {}, &downloader.Config{})
+	require.ErrorContains(t, err, "503")
+}
@@ -0,0 +1,54 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package httpclient
+
+import (
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/configuration"
+)
+
+// withConfiguredMirrors returns the URLs followed by their mirrors configured in
+// network.mirrors, the mirror URLs are obtained by replacing the matching prefix
+// of each URL with the mirrors. Duplicated URLs are removed.
+func withConfiguredMirrors(URLs []string) ([]string, error) {
+	mirrors, err := configuration.NetworkMirrors(configuration.Settings)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid network.mirrors configuration"), Cause: err}
+	}
+	res := []string{}
+	added := map[string]bool{}
+	add := func(URL string) {
+		if !added[URL] {
+			added[URL] = true
+			res = append(res, URL)
+		}
+	}
+	for _, URL := range URLs {
+		add(URL)
+	}
+	for _, URL := range URLs {
+		for _, mirror := range mirrors {
+			if path, ok := strings.CutPrefix(URL, mirror.URL); ok {
+				for _, mirrorURL := range mirror.Mirrors {
+					add(mirrorURL + path)
+				}
+			}
+		}
+	}
+	return res, nil
+}
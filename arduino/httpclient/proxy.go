@@ -0,0 +1,100 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package httpclient
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxyFunc selects the proxy of a request, a nil URL means direct connection
+type proxyFunc func(*url.URL) (*url.URL, error)
+
+// proxyFromSettings returns the proxy selection function configured in the
+// settings. The proxies are selected, in order of precedence, by:
+//   - network.proxy, where "direct" disables any proxy
+//   - the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables
+//   - the static proxy settings of the operating system
+//
+// The hosts matching network.no_proxy are always reached directly. The proxy
+// auto-config (PAC) files are not supported.
+func proxyFromSettings(settings *viper.Viper) (proxyFunc, error) {
+	if configuration.NetworkProxyDisabled(settings) {
+		return nil, nil
+	}
+	proxy, err := configuration.NetworkProxy(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var selectProxy proxyFunc
+	if proxy != nil {
+		selectProxy = func(*url.URL) (*url.URL, error) { return proxy, nil }
+	} else {
+		selectProxy = systemProxyFunc()
+	}
+
+	if noProxy := configuration.NetworkNoProxy(settings); len(noProxy) > 0 && selectProxy != nil {
+		selectProxy = bypassProxyFunc(noProxy, selectProxy)
+	}
+	return selectProxy, nil
+}
+
+// systemProxyFunc returns the proxy selection function of the environment
+// variables, if set, or of the operating system settings.
+func systemProxyFunc() proxyFunc {
+	env := httpproxy.FromEnvironment()
+	if env.HTTPProxy != "" || env.HTTPSProxy != "" {
+		return env.ProxyFunc()
+	}
+
+	system := readSystemProxy()
+	if system.HTTPProxy == "" && system.HTTPSProxy == "" {
+		if system.PACURL != "" {
+			logrus.WithField("pac", system.PACURL).Warn("The system proxy auto-config file is not supported, connecting directly: set network.proxy to use a proxy")
+		}
+		return nil
+	}
+	return (&httpproxy.Config{
+		HTTPProxy:  system.HTTPProxy,
+		HTTPSProxy: system.HTTPSProxy,
+		NoProxy:    strings.Join(system.NoProxy, ","),
+	}).ProxyFunc()
+}
+
+// bypassProxyFunc wraps the proxy selection function so that the hosts matching
+// the given no_proxy rules are reached directly. The rules have the format of
+// the NO_PROXY environment variable: host names, domain suffixes (".example.com"),
+// IP addresses and CIDR ranges, optionally followed by a port.
+func bypassProxyFunc(noProxy []string, selectProxy proxyFunc) proxyFunc {
+	// The placeholder proxy is returned only for the hosts not matching the rules
+	matcher := (&httpproxy.Config{
+		HTTPProxy:  "bypass",
+		HTTPSProxy: "bypass",
+		NoProxy:    strings.Join(noProxy, ","),
+	}).ProxyFunc()
+	return func(u *url.URL) (*url.URL, error) {
+		if proxy, err := matcher(u); err != nil || proxy == nil {
+			return nil, err
+		}
+		return selectProxy(u)
+	}
+}
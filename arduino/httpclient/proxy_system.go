@@ -0,0 +1,148 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package httpclient
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+)
+
+// systemProxy are the proxy settings of the operating system
+type systemProxy struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    []string
+	PACURL     string
+}
+
+// parseScutilProxy parses the output of the macOS `scutil --proxy` command
+func parseScutilProxy(output string) systemProxy {
+	values := map[string]string{}
+	exceptions := []string{}
+	inExceptions := false
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if inExceptions {
+			if line == "}" {
+				inExceptions = false
+			} else if _, exception, ok := strings.Cut(line, " : "); ok {
+				exceptions = append(exceptions, exception)
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, " : ")
+		if !ok {
+			continue
+		}
+		if key == "ExceptionsList" {
+			inExceptions = true
+			continue
+		}
+		values[key] = value
+	}
+
+	res := systemProxy{NoProxy: convertNoProxyRules(exceptions)}
+	if values["ProxyAutoConfigEnable"] == "1" {
+		res.PACURL = values["ProxyAutoConfigURLString"]
+	}
+	if values["HTTPEnable"] == "1" && values["HTTPProxy"] != "" {
+		res.HTTPProxy = joinHostPort(values["HTTPProxy"], values["HTTPPort"])
+	}
+	if values["HTTPSEnable"] == "1" && values["HTTPSProxy"] != "" {
+		res.HTTPSProxy = joinHostPort(values["HTTPSProxy"], values["HTTPSPort"])
+	}
+	return res
+}
+
+// parseWindowsProxy parses the ProxyServer and ProxyOverride values of the
+// Internet Settings of Windows. The server is either a single proxy for all the
+// protocols ("host:port") or a list of proxies per protocol ("http=host:port;https=host:port").
+func parseWindowsProxy(server, override string) systemProxy {
+	res := systemProxy{}
+	if !strings.Contains(server, "=") {
+		res.HTTPProxy = strings.TrimSpace(server)
+		res.HTTPSProxy = res.HTTPProxy
+	} else {
+		for _, entry := range strings.Split(server, ";") {
+			protocol, proxy, _ := strings.Cut(entry, "=")
+			switch strings.ToLower(strings.TrimSpace(protocol)) {
+			case "http":
+				res.HTTPProxy = strings.TrimSpace(proxy)
+			case "https":
+				res.HTTPSProxy = strings.TrimSpace(proxy)
+			}
+		}
+	}
+	if override != "" {
+		res.NoProxy = convertNoProxyRules(strings.Split(override, ";"))
+	}
+	return res
+}
+
+// convertNoProxyRules converts the proxy exceptions of the operating systems to
+// the NO_PROXY format: "*.example.com" becomes ".example.com", the IP address
+// prefixes ("10.*" or "169.254/16") become CIDR ranges and "<local>" is dropped.
+func convertNoProxyRules(rules []string) []string {
+	res := []string{}
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "" || rule == "<local>" {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(rule, ".*"); ok && isIPv4Prefix(prefix) {
+			rule = ipv4PrefixToCIDR(prefix, 8*(strings.Count(prefix, ".")+1))
+		} else if prefix, bits, ok := strings.Cut(rule, "/"); ok && isIPv4Prefix(prefix) {
+			rule = ipv4PrefixToCIDR(prefix, 0) + "/" + bits
+		} else {
+			rule = strings.TrimPrefix(rule, "*")
+		}
+		res = append(res, rule)
+	}
+	return res
+}
+
+// isIPv4Prefix returns true if s is made of up to 4 numeric components
+func isIPv4Prefix(s string) bool {
+	components := strings.Split(s, ".")
+	if len(components) > 4 {
+		return false
+	}
+	for _, c := range components {
+		if c == "" || strings.Trim(c, "0123456789") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ipv4PrefixToCIDR pads the IPv4 prefix with zeros, adding the given
+// number of bits as CIDR mask if it's not 0
+func ipv4PrefixToCIDR(prefix string, bits int) string {
+	addr := prefix + strings.Repeat(".0", 3-strings.Count(prefix, "."))
+	if bits == 0 {
+		return addr
+	}
+	return addr + "/" + strconv.Itoa(bits)
+}
+
+func joinHostPort(host, port string) string {
+	if port == "" || port == "0" {
+		return host
+	}
+	return host + ":" + port
+}
@@ -0,0 +1,32 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package httpclient
+
+import (
+	"os/exec"
+
+	"github.com/sirupsen/logrus"
+)
+
+// readSystemProxy returns the proxy settings of macOS
+func readSystemProxy() systemProxy {
+	output, err := exec.Command("scutil", "--proxy").Output()
+	if err != nil {
+		logrus.WithError(err).Warn("Could not read the system proxy settings")
+		return systemProxy{}
+	}
+	return parseScutilProxy(string(output))
+}
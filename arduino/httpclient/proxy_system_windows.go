@@ -0,0 +1,43 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package httpclient
+
+import (
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/windows/registry"
+)
+
+// readSystemProxy returns the proxy settings of Windows, from the Internet
+// Settings of the current user
+func readSystemProxy() systemProxy {
+	key, err := registry.OpenKey(registry.CURRENT_USER, `Software\Microsoft\Windows\CurrentVersion\Internet Settings`, registry.QUERY_VALUE)
+	if err != nil {
+		logrus.WithError(err).Warn("Could not read the system proxy settings")
+		return systemProxy{}
+	}
+	defer key.Close()
+
+	res := systemProxy{}
+	if enabled, _, err := key.GetIntegerValue("ProxyEnable"); err == nil && enabled != 0 {
+		server, _, _ := key.GetStringValue("ProxyServer")
+		override, _, _ := key.GetStringValue("ProxyOverride")
+		res = parseWindowsProxy(server, override)
+	}
+	if pacURL, _, err := key.GetStringValue("AutoConfigURL"); err == nil {
+		res.PACURL = pacURL
+	}
+	return res
+}
@@ -0,0 +1,111 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package httpclient
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/stretchr/testify/require"
+)
+
+func selectedProxy(t *testing.T, selectProxy proxyFunc, target string) string {
+	if selectProxy == nil {
+		return "DIRECT"
+	}
+	u, err := url.Parse(target)
+	require.NoError(t, err)
+	proxy, err := selectProxy(u)
+	require.NoError(t, err)
+	if proxy == nil {
+		return "DIRECT"
+	}
+	return proxy.String()
+}
+
+func clearProxyEnvironment(t *testing.T) {
+	for _, env := range []string{"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy"} {
+		t.Setenv(env, "")
+	}
+}
+
+func TestProxyFromSettings(t *testing.T) {
+	clearProxyEnvironment(t)
+	settings := configuration.Init("")
+	settings.Set("network.proxy", "http://proxy.example.com:3128")
+	settings.Set("network.no_proxy", []string{".internal.example.com", "10.0.0.0/8"})
+	selectProxy, err := proxyFromSettings(settings)
+	require.NoError(t, err)
+	require.Equal(t, "http://proxy.example.com:3128", selectedProxy(t, selectProxy, "https://downloads.arduino.cc/index.json"))
+	require.Equal(t, "DIRECT", selectedProxy(t, selectProxy, "https://mirror.internal.example.com/index.json"))
+	require.Equal(t, "DIRECT", selectedProxy(t, selectProxy, "http://10.1.2.3/index.json"))
+
+	settings.Set("network.proxy", "direct")
+	selectProxy, err = proxyFromSettings(settings)
+	require.NoError(t, err)
+	require.Equal(t, "DIRECT", selectedProxy(t, selectProxy, "https://downloads.arduino.cc/index.json"))
+}
+
+func TestProxyFromEnvironment(t *testing.T) {
+	clearProxyEnvironment(t)
+	t.Setenv("HTTPS_PROXY", "http://env-proxy.example.com:8080")
+	settings := configuration.Init("")
+	settings.Set("network.no_proxy", []string{"arduino.cc"})
+	selectProxy, err := proxyFromSettings(settings)
+	require.NoError(t, err)
+	require.Equal(t, "http://env-proxy.example.com:8080", selectedProxy(t, selectProxy, "https://github.com/arduino"))
+	require.Equal(t, "DIRECT", selectedProxy(t, selectProxy, "https://downloads.arduino.cc/index.json"))
+	require.Equal(t, "DIRECT", selectedProxy(t, selectProxy, "http://github.com/arduino"))
+}
+
+func TestParseScutilProxy(t *testing.T) {
+	output := `<dictionary> {
+  ExceptionsList : <array> {
+    0 : *.local
+    1 : 169.254/16
+    2 : 10.*
+  }
+  FTPPassive : 1
+  HTTPEnable : 1
+  HTTPPort : 3128
+  HTTPProxy : proxy.example.com
+  HTTPSEnable : 0
+  HTTPSPort : 3129
+  HTTPSProxy : secure-proxy.example.com
+  ProxyAutoConfigEnable : 1
+  ProxyAutoConfigURLString : http://wpad.example.com/wpad.dat
+}
+`
+	require.Equal(t, systemProxy{
+		HTTPProxy: "proxy.example.com:3128",
+		NoProxy:   []string{".local", "169.254.0.0/16", "10.0.0.0/8"},
+		PACURL:    "http://wpad.example.com/wpad.dat",
+	}, parseScutilProxy(output))
+	require.Equal(t, systemProxy{NoProxy: []string{}}, parseScutilProxy("<dictionary> {\n}\n"))
+}
+
+func TestParseWindowsProxy(t *testing.T) {
+	require.Equal(t, systemProxy{
+		HTTPProxy:  "proxy.example.com:8080",
+		HTTPSProxy: "proxy.example.com:8080",
+		NoProxy:    []string{".example.com", "192.168.0.0/16"},
+	}, parseWindowsProxy("proxy.example.com:8080", "*.example.com;192.168.*;<local>"))
+	require.Equal(t, systemProxy{
+		HTTPProxy:  "http-proxy.example.com:80",
+		HTTPSProxy: "https-proxy.example.com:443",
+	}, parseWindowsProxy("http=http-proxy.example.com:80;https=https-proxy.example.com:443;ftp=ftp.example.com:21", ""))
+}
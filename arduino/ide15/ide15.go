@@ -0,0 +1,307 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package ide15 reads the installations of the Java Arduino IDE (1.5 or later)
+// and migrates their settings, libraries and platforms to the Arduino CLI.
+package ide15
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+var tr = i18n.Tr
+
+// Installation is the data folder (usually named Arduino15) of the Java
+// Arduino IDE, containing the preferences and the installed board packages.
+type Installation struct {
+	DataDir     *paths.Path
+	Preferences *properties.Map
+}
+
+// Open reads the Java IDE installation in the given data folder
+func Open(dataDir *paths.Path) (*Installation, error) {
+	preferencesFile := dataDir.Join("preferences.txt")
+	if !preferencesFile.Exist() {
+		return nil, fmt.Errorf(tr("%s not found, is it the data folder of the Arduino IDE?"), preferencesFile)
+	}
+	preferences, err := properties.LoadFromPath(preferencesFile)
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading %[1]s: %[2]s"), preferencesFile, err)
+	}
+	return &Installation{DataDir: dataDir, Preferences: preferences}, nil
+}
+
+// SketchbookDir returns the sketchbook folder set in the IDE preferences, or
+// nil if not set.
+func (i *Installation) SketchbookDir() *paths.Path {
+	if dir := strings.TrimSpace(i.Preferences.Get("sketchbook.path")); dir != "" {
+		return paths.New(dir)
+	}
+	return nil
+}
+
+// Setting is a CLI setting migrated from an IDE preference
+type Setting struct {
+	Key        string      `json:"key"`
+	Value      interface{} `json:"value"`
+	Preference string      `json:"preference"`
+}
+
+// CopiedItem is a library, platform, tool or index copied from the IDE
+type CopiedItem struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// SkippedItem is a preference, library, platform or tool that was not migrated
+type SkippedItem struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Report is the result of a migration
+type Report struct {
+	Settings []*Setting     `json:"settings"`
+	Copied   []*CopiedItem  `json:"copied"`
+	Skipped  []*SkippedItem `json:"skipped"`
+}
+
+func (r *Report) skip(kind, name, reason string) {
+	r.Skipped = append(r.Skipped, &SkippedItem{Kind: kind, Name: name, Reason: reason})
+}
+
+// Migrate copies the board packages of the IDE to dataDir and the libraries
+// and hardware folders of the sketchbook to userDir, skipping anything already
+// present. It returns the CLI settings corresponding to the IDE preferences,
+// that are not applied by Migrate. If dryRun is true nothing is copied, the
+// report lists what would be done.
+func (i *Installation) Migrate(dataDir, userDir, sketchbookDir *paths.Path, dryRun bool) (*Report, error) {
+	report := &Report{Settings: []*Setting{}, Copied: []*CopiedItem{}, Skipped: []*SkippedItem{}}
+	i.migratePreferences(report)
+
+	copyItem := func(kind, name string, from, to *paths.Path) error {
+		if to.Exist() {
+			report.skip(kind, name, tr("already present in %s", to))
+			return nil
+		}
+		if !dryRun {
+			if err := to.Parent().MkdirAll(); err != nil {
+				return err
+			}
+			if err := from.CopyDirTo(to); err != nil {
+				return fmt.Errorf(tr("copying %[1]s: %[2]s"), from, err)
+			}
+		}
+		report.Copied = append(report.Copied, &CopiedItem{Kind: kind, Name: name, From: from.String(), To: to.String()})
+		return nil
+	}
+
+	if !isSameDir(i.DataDir, dataDir) {
+		if err := i.migrateIndexes(report, dataDir, dryRun); err != nil {
+			return nil, err
+		}
+		// packages/<packager>/hardware/<architecture>/<version>
+		// packages/<packager>/tools/<tool>/<version>
+		for _, packager := range readDirs(i.DataDir.Join("packages")) {
+			for _, kind := range []string{"hardware", "tools"} {
+				for _, item := range readDirs(packager.Join(kind)) {
+					for _, version := range readDirs(item) {
+						name := packager.Base() + ":" + item.Base() + "@" + version.Base()
+						to := dataDir.Join("packages", packager.Base(), kind, item.Base(), version.Base())
+						if err := copyItem(itemKind(kind), name, version, to); err != nil {
+							return nil, err
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if sketchbookDir == nil {
+		report.skip(tr("sketchbook"), "", tr("the sketchbook folder is not set in the IDE preferences"))
+	} else if !isSameDir(sketchbookDir, userDir) {
+		for _, library := range readDirs(sketchbookDir.Join("libraries")) {
+			if err := copyItem(tr("library"), library.Base(), library, userDir.Join("libraries", library.Base())); err != nil {
+				return nil, err
+			}
+		}
+		// hardware/<vendor>/<architecture>
+		for _, vendor := range readDirs(sketchbookDir.Join("hardware")) {
+			for _, architecture := range readDirs(vendor) {
+				name := vendor.Base() + ":" + architecture.Base()
+				if err := copyItem(tr("hardware"), name, architecture, userDir.Join("hardware", vendor.Base(), architecture.Base())); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return report, nil
+}
+
+func itemKind(dir string) string {
+	if dir == "hardware" {
+		return tr("platform")
+	}
+	return tr("tool")
+}
+
+// migrateIndexes copies the package indexes of the installed board packages,
+// so that the CLI knows them before the first `core update-index`.
+func (i *Installation) migrateIndexes(report *Report, dataDir *paths.Path, dryRun bool) error {
+	files, err := i.DataDir.ReadDir()
+	if err != nil {
+		return err
+	}
+	files.FilterOutDirs()
+	for _, file := range files {
+		name := file.Base()
+		isIndex := name == "package_index.json" || name == "library_index.json" ||
+			(strings.HasPrefix(name, "package_") && strings.HasSuffix(name, "_index.json"))
+		if !isIndex {
+			continue
+		}
+		to := dataDir.Join(name)
+		if to.Exist() {
+			continue
+		}
+		if !dryRun {
+			if err := dataDir.MkdirAll(); err != nil {
+				return err
+			}
+			if err := file.CopyTo(to); err != nil {
+				return fmt.Errorf(tr("copying %[1]s: %[2]s"), file, err)
+			}
+			if sig := file.Parent().Join(name + ".sig"); sig.Exist() {
+				if err := sig.CopyTo(dataDir.Join(name + ".sig")); err != nil {
+					return fmt.Errorf(tr("copying %[1]s: %[2]s"), sig, err)
+				}
+			}
+		}
+		report.Copied = append(report.Copied, &CopiedItem{Kind: tr("index"), Name: name, From: file.String(), To: to.String()})
+	}
+	return nil
+}
+
+// unsupportedPreferences are the IDE preferences affecting builds and uploads
+// that have no equivalent in the CLI settings.
+var unsupportedPreferences = map[string]string{
+	"board":                  "use `board attach` or the default_fqbn of sketch.yaml",
+	"serial.port":            "use `board attach` or the default_port of sketch.yaml",
+	"programmer":             "use the --programmer flag of upload",
+	"build.path":             "use the --build-path flag of compile",
+	"build.verbose":          "use the --verbose flag of compile",
+	"upload.verbose":         "use the --verbose flag of upload",
+	"upload.verify":          "use the --verify flag of upload",
+	"compiler.warning_level": "use the --warnings flag of compile",
+	"compiler.cache_core":    "the core is always cached",
+}
+
+func (i *Installation) migratePreferences(report *Report) {
+	prefs := i.Preferences
+	if urls := splitList(prefs.Get("boardsmanager.additional.urls")); len(urls) > 0 {
+		report.Settings = append(report.Settings, &Setting{
+			Key: "board_manager.additional_urls", Value: urls, Preference: "boardsmanager.additional.urls",
+		})
+	}
+	if check, ok := prefs.GetOk("update.check"); ok && strings.TrimSpace(check) == "false" {
+		report.Settings = append(report.Settings, &Setting{
+			Key: "updater.enable_notification", Value: false, Preference: "update.check",
+		})
+	}
+	i.migrateProxy(report)
+
+	keys := []string{}
+	for key := range unsupportedPreferences {
+		if strings.TrimSpace(prefs.Get(key)) != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		report.skip(tr("preference"), key+"="+prefs.Get(key), tr("no equivalent setting, %s", unsupportedPreferences[key]))
+	}
+}
+
+func (i *Installation) migrateProxy(report *Report) {
+	prefs := i.Preferences
+	switch proxyType := strings.TrimSpace(prefs.Get("proxy.type")); proxyType {
+	case "", "none":
+		return
+	case "manual":
+		host := strings.TrimSpace(prefs.Get("proxy.manual.hostname"))
+		if host == "" {
+			report.skip(tr("preference"), "proxy.type=manual", tr("proxy hostname not set"))
+			return
+		}
+		scheme := "http"
+		if strings.EqualFold(prefs.Get("proxy.manual.type"), "SOCKS") {
+			scheme = "socks5"
+		}
+		proxy := &url.URL{Scheme: scheme, Host: host}
+		if port := strings.TrimSpace(prefs.Get("proxy.manual.port")); port != "" {
+			proxy.Host = net.JoinHostPort(host, port)
+		}
+		if username := prefs.Get("proxy.manual.username"); username != "" {
+			proxy.User = url.UserPassword(username, prefs.Get("proxy.manual.password"))
+		}
+		report.Settings = append(report.Settings, &Setting{
+			Key: "network.proxy", Value: proxy.String(), Preference: "proxy.manual.*",
+		})
+	default:
+		report.skip(tr("preference"), "proxy.type="+proxyType, tr("only manual proxy configurations can be migrated, set %s", "network.proxy"))
+	}
+}
+
+// splitList splits a comma separated list, dropping the empty items
+func splitList(list string) []string {
+	res := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// readDirs returns the sorted subfolders of dir, or nothing if dir doesn't
+// exist. Hidden folders are skipped.
+func readDirs(dir *paths.Path) paths.PathList {
+	dirs, err := dir.ReadDir()
+	if err != nil {
+		return nil
+	}
+	dirs.FilterDirs()
+	dirs.FilterOutPrefix(".")
+	dirs.Sort()
+	return dirs
+}
+
+func isSameDir(a, b *paths.Path) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return a.EquivalentTo(b)
+}
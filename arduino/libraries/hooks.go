@@ -0,0 +1,115 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraries
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// The permissions that a post-install hook may declare
+const (
+	// HookPermissionNetwork allows the hook to download files
+	HookPermissionNetwork = "network"
+	// HookPermissionSystem allows the hook to change the system outside the
+	// library folder, for example to install udev rules
+	HookPermissionSystem = "system"
+)
+
+// PostInstallHook is a script declared in the library.properties that completes
+// the installation of a library, for example downloading large files or
+// installing udev rules. The script is never run without the user consent.
+type PostInstallHook struct {
+	Script      *paths.Path
+	Description string
+	Permissions []string
+}
+
+// PostInstallHook returns the post-install hook declared in the library.properties
+// with the post_install property (the OS specific post_install.linux,
+// post_install.macosx and post_install.windows take precedence), or nil if the
+// library doesn't declare one. The script must be inside the library folder.
+func (library *Library) PostInstallHook() (*PostInstallHook, error) {
+	if library.Properties == nil || library.InstallDir == nil {
+		return nil, nil
+	}
+	script := strings.TrimSpace(library.Properties.Get("post_install"))
+	if script == "" {
+		return nil, nil
+	}
+
+	scriptPath := paths.New(script)
+	if scriptPath.IsAbs() {
+		return nil, fmt.Errorf(tr("the post-install hook %s must be a path relative to the library folder"), script)
+	}
+	scriptPath = library.InstallDir.Join(script)
+	if inside, err := scriptPath.IsInsideDir(library.InstallDir); err != nil || !inside {
+		return nil, fmt.Errorf(tr("the post-install hook %s must be inside the library folder"), script)
+	}
+	// The script may be a symlink pointing outside the library folder
+	resolved := scriptPath.Clone()
+	if err := resolved.FollowSymLink(); err != nil {
+		return nil, fmt.Errorf(tr("the post-install hook %[1]s is not valid: %[2]s"), script, err)
+	}
+	installDir := library.InstallDir.Clone()
+	if err := installDir.FollowSymLink(); err != nil {
+		return nil, err
+	}
+	if inside, err := resolved.IsInsideDir(installDir); err != nil || !inside {
+		return nil, fmt.Errorf(tr("the post-install hook %s must be inside the library folder"), script)
+	}
+	if !resolved.IsNotDir() {
+		return nil, fmt.Errorf(tr("the post-install hook %s is not a file"), script)
+	}
+
+	hook := &PostInstallHook{
+		Script:      scriptPath,
+		Description: strings.TrimSpace(library.Properties.Get("post_install.description")),
+		Permissions: []string{},
+	}
+	for _, permission := range strings.Split(library.Properties.Get("post_install.permissions"), ",") {
+		permission = strings.TrimSpace(permission)
+		if permission == "" {
+			continue
+		}
+		if permission != HookPermissionNetwork && permission != HookPermissionSystem {
+			return nil, fmt.Errorf(tr("invalid permission %[1]s for the post-install hook, must be %[2]s or %[3]s"), permission, HookPermissionNetwork, HookPermissionSystem)
+		}
+		hook.Permissions = append(hook.Permissions, permission)
+	}
+	return hook, nil
+}
+
+// NotAllowedPermissions returns the permissions of the hook that are not in
+// the given allowed permissions.
+func (hook *PostInstallHook) NotAllowedPermissions(allowed []string) []string {
+	res := []string{}
+	for _, permission := range hook.Permissions {
+		found := false
+		for _, a := range allowed {
+			if a == permission {
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, permission)
+		}
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraries
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostInstallHook(t *testing.T) {
+	libDir, err := paths.MkTempDir("", "hook-library")
+	require.NoError(t, err)
+	defer libDir.RemoveAll()
+	require.NoError(t, libDir.Join("src").MkdirAll())
+	require.NoError(t, libDir.Join("src", "HookLib.h").WriteFile([]byte{}))
+	require.NoError(t, libDir.Join("extras").MkdirAll())
+	require.NoError(t, libDir.Join("extras", "setup.sh").WriteFile([]byte("#!/bin/sh\n")))
+
+	load := func(props string) (*PostInstallHook, error) {
+		require.NoError(t, libDir.Join("library.properties").WriteFile([]byte("name=HookLib\nversion=1.0.0\n"+props)))
+		lib, err := Load(libDir, User)
+		require.NoError(t, err)
+		return lib.PostInstallHook()
+	}
+
+	hook, err := load("")
+	require.NoError(t, err)
+	require.Nil(t, hook)
+
+	hook, err = load("post_install=extras/setup.sh\npost_install.description=Installs the udev rules\npost_install.permissions=system, network\n")
+	require.NoError(t, err)
+	require.NotNil(t, hook)
+	require.Equal(t, "Installs the udev rules", hook.Description)
+	require.Equal(t, []string{"system", "network"}, hook.Permissions)
+	require.Equal(t, "setup.sh", hook.Script.Base())
+	require.Equal(t, []string{"system"}, hook.NotAllowedPermissions([]string{"network"}))
+	require.Empty(t, hook.NotAllowedPermissions([]string{"network", "system"}))
+
+	_, err = load("post_install=extras/setup.sh\npost_install.permissions=root\n")
+	require.ErrorContains(t, err, "invalid permission root")
+
+	_, err = load("post_install=../setup.sh\n")
+	require.ErrorContains(t, err, "must be inside the library folder")
+
+	_, err = load("post_install=extras\n")
+	require.ErrorContains(t, err, "is not a file")
+
+	_, err = load("post_install=extras/missing.sh\n")
+	require.Error(t, err)
+}
@@ -0,0 +1,307 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+// Package keywords reads, generates and checks the keywords.txt files of the
+// libraries, that list the symbols of the library to be highlighted by the
+// editors. Each line of the file is a keyword followed by its type and,
+// optionally, by the link to its reference page, separated by tabs.
+package keywords
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/symbols"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// The types of the keywords
+const (
+	// Datatype is used for the classes, the types and the global objects
+	Datatype = "KEYWORD1"
+	// Function is used for the functions and the methods
+	Function = "KEYWORD2"
+	// Structure is used for the language structures
+	Structure = "KEYWORD3"
+	// Constant is used for the macros and the constants
+	Constant = "LITERAL1"
+	// Literal is used for the other literals
+	Literal = "LITERAL2"
+)
+
+// types are the valid types of the keywords, in the order of the sections of
+// the generated files
+var types = []string{Datatype, Function, Structure, Constant, Literal}
+
+// sectionTitles are the titles of the sections of the generated files
+var sectionTitles = map[string]string{
+	Datatype:  "Datatypes (KEYWORD1)",
+	Function:  "Methods and Functions (KEYWORD2)",
+	Structure: "Structures (KEYWORD3)",
+	Constant:  "Constants (LITERAL1)",
+	Literal:   "Literals (LITERAL2)",
+}
+
+// IsValidType returns true if the string is a valid type of keyword
+func IsValidType(t string) bool {
+	for _, valid := range types {
+		if t == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// Entry is a keyword of a keywords.txt file
+type Entry struct {
+	Keyword string
+	Type    string
+	// Fields are the fields following the type, like the link to the
+	// reference page
+	Fields []string
+	// Line is the line of the entry in the file, starting from 1, or 0 if
+	// the entry has been generated
+	Line int
+}
+
+// Problem is an error found in a keywords.txt file
+type Problem struct {
+	// Line is the line of the problem, starting from 1
+	Line    int
+	Keyword string
+	Message string
+}
+
+func (p *Problem) String() string {
+	return fmt.Sprintf("%d: %s", p.Line, p.Message)
+}
+
+// File is the content of a keywords.txt file
+type File struct {
+	Entries []*Entry
+	// Problems are the format errors found reading the file
+	Problems []*Problem
+}
+
+// Load reads a keywords.txt file
+func Load(file *paths.Path) (*File, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data), nil
+}
+
+// Parse reads the content of a keywords.txt file. The lines that can't be
+// understood are reported in the Problems of the File, the entries with an
+// invalid type or separated by spaces are kept to be fixed.
+func Parse(data []byte) *File {
+	res := &File{}
+	seen := map[string]int{}
+	for i, line := range strings.Split(string(data), "\n") {
+		n := i + 1
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		problem := func(keyword, format string, args ...interface{}) {
+			res.Problems = append(res.Problems, &Problem{Line: n, Keyword: keyword, Message: tr(format, args...)})
+		}
+		if line != strings.TrimLeft(line, " \t") {
+			problem("", "leading whitespace before the keyword")
+			line = strings.TrimLeft(line, " \t")
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 {
+			fields = strings.Fields(line)
+			if len(fields) != 2 {
+				problem("", "the keyword and its type must be separated by a tab")
+				continue
+			}
+			problem(fields[0], "the keyword %s and its type must be separated by a tab instead of spaces", fields[0])
+		}
+		if fields[1] == "" {
+			problem(fields[0], "the keyword %s must be separated by a single tab from its type", fields[0])
+			j := 1
+			for j < len(fields)-1 && fields[j] == "" {
+				j++
+			}
+			fields = append([]string{fields[0]}, fields[j:]...)
+		}
+		entry := &Entry{Keyword: fields[0], Type: strings.TrimSpace(fields[1]), Fields: fields[2:], Line: n}
+		if !symbols.IsIdentifier(entry.Keyword) {
+			problem(entry.Keyword, "invalid keyword %s", entry.Keyword)
+		}
+		if !IsValidType(entry.Type) {
+			problem(entry.Keyword, "invalid type %[1]s for the keyword %[2]s, must be one of: %[3]s", entry.Type, entry.Keyword, strings.Join(types, ", "))
+		}
+		if prev, ok := seen[entry.Keyword]; ok {
+			problem(entry.Keyword, "the keyword %[1]s is already defined at line %[2]d", entry.Keyword, prev)
+		} else {
+			seen[entry.Keyword] = n
+		}
+		res.Entries = append(res.Entries, entry)
+	}
+	return res
+}
+
+// TypeOf returns the type of keyword used for a symbol
+func TypeOf(kind symbols.Kind) string {
+	switch kind {
+	case symbols.Function, symbols.Method:
+		return Function
+	case symbols.Macro, symbols.Enumerator:
+		return Constant
+	}
+	return Datatype
+}
+
+// Generate returns the keywords of the symbols declared in the public headers
+// of a library, sorted by name. The symbols starting with an underscore are
+// considered private and skipped.
+func Generate(decls []*symbols.Declaration) []*Entry {
+	res := []*Entry{}
+	seen := map[string]bool{}
+	for _, decl := range decls {
+		if strings.HasPrefix(decl.Name, "_") || seen[decl.Name] {
+			continue
+		}
+		seen[decl.Name] = true
+		res = append(res, &Entry{Keyword: decl.Name, Type: TypeOf(decl.Kind)})
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Keyword < res[j].Keyword })
+	return res
+}
+
+// FromLibrary returns the keywords of the symbols declared in the public
+// headers of the library, that are the headers in its source folder.
+func FromLibrary(lib *libraries.Library) ([]*Entry, error) {
+	headers, err := lib.SourceHeaders()
+	if err != nil {
+		return nil, err
+	}
+	decls := []*symbols.Declaration{}
+	for _, header := range headers {
+		source, err := lib.SourceDir.Join(header).ReadFile()
+		if err != nil {
+			return nil, err
+		}
+		decls = append(decls, symbols.ScanMembers(source)...)
+	}
+	return Generate(decls), nil
+}
+
+// Merge adds to the entries of the file the generated ones that are missing.
+// The existing entries keep their type, unless it's invalid, and fields, with
+// the duplicates removed. If prune is set the existing entries that are not
+// generated are removed.
+func (f *File) Merge(generated []*Entry, prune bool) []*Entry {
+	generatedByKeyword := map[string]*Entry{}
+	for _, entry := range generated {
+		generatedByKeyword[entry.Keyword] = entry
+	}
+	res := []*Entry{}
+	seen := map[string]bool{}
+	for _, entry := range f.Entries {
+		gen, isGenerated := generatedByKeyword[entry.Keyword]
+		if seen[entry.Keyword] || (prune && !isGenerated) {
+			continue
+		}
+		seen[entry.Keyword] = true
+		if isGenerated && !IsValidType(entry.Type) {
+			fixed := *entry
+			fixed.Type = gen.Type
+			entry = &fixed
+		}
+		res = append(res, entry)
+	}
+	for _, entry := range generated {
+		if !seen[entry.Keyword] {
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
+// Check returns the entries of the file that don't match any of the
+// generated keywords, as problems, and the generated keywords missing from
+// the file.
+func (f *File) Check(generated []*Entry) ([]*Problem, []*Entry) {
+	inFile := map[string]bool{}
+	for _, entry := range f.Entries {
+		inFile[entry.Keyword] = true
+	}
+	isGenerated := map[string]bool{}
+	missing := []*Entry{}
+	for _, entry := range generated {
+		isGenerated[entry.Keyword] = true
+		if !inFile[entry.Keyword] {
+			missing = append(missing, entry)
+		}
+	}
+	problems := []*Problem{}
+	for _, entry := range f.Entries {
+		if !isGenerated[entry.Keyword] {
+			problems = append(problems, &Problem{
+				Line:    entry.Line,
+				Keyword: entry.Keyword,
+				Message: tr("the keyword %s doesn't match any symbol declared in the public headers of the library", entry.Keyword),
+			})
+		}
+	}
+	return problems, missing
+}
+
+// Format returns the content of a keywords.txt file with the given entries,
+// grouped in sections by type. The entries with an unknown type are placed
+// in a final section to be fixed.
+func Format(libraryName string, entries []*Entry) []byte {
+	rule := "#######################################\n"
+	out := &bytes.Buffer{}
+	out.WriteString(rule)
+	fmt.Fprintf(out, "# Syntax Coloring Map For %s\n", libraryName)
+	out.WriteString(rule)
+
+	sections := map[string][]*Entry{}
+	for _, entry := range entries {
+		t := entry.Type
+		if !IsValidType(t) {
+			t = ""
+		}
+		sections[t] = append(sections[t], entry)
+	}
+	for _, t := range append(types, "") {
+		section := sections[t]
+		if len(section) == 0 {
+			continue
+		}
+		title := sectionTitles[t]
+		if t == "" {
+			title = "Invalid types"
+		}
+		out.WriteString("\n" + rule)
+		fmt.Fprintf(out, "# %s\n", title)
+		out.WriteString(rule + "\n")
+		for _, entry := range section {
+			out.WriteString(strings.Join(append([]string{entry.Keyword, entry.Type}, entry.Fields...), "\t") + "\n")
+		}
+	}
+	return out.Bytes()
+}
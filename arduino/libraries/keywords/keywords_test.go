@@ -0,0 +1,143 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package keywords
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func entriesToStrings(entries []*Entry) []string {
+	res := []string{}
+	for _, entry := range entries {
+		res = append(res, entry.Keyword+" "+entry.Type)
+	}
+	return res
+}
+
+func problemsToStrings(problems []*Problem) []string {
+	res := []string{}
+	for _, problem := range problems {
+		res = append(res, problem.String())
+	}
+	return res
+}
+
+func TestParse(t *testing.T) {
+	file, err := Load(paths.New("testdata", "Led", "keywords.txt"))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Led KEYWORD1",
+		"on KEYWORD2",
+		"toggle KEYWORD2",
+		"off KEYWORD2",
+		"blink KEYWORD2",
+		"LED_BLINK LITERAL1",
+		"on KEYWORD2",
+		"LED_FADE LITERAL3",
+	}, entriesToStrings(file.Entries))
+	require.Equal(t, []string{"Led"}, file.Entries[0].Fields)
+	require.Equal(t, 5, file.Entries[0].Line)
+	require.Equal(t, []string{
+		"8: the keyword off and its type must be separated by a tab instead of spaces",
+		"9: the keyword blink must be separated by a single tab from its type",
+		"10: leading whitespace before the keyword",
+		"11: the keyword on is already defined at line 6",
+		"12: invalid type LITERAL3 for the keyword LED_FADE, must be one of: KEYWORD1, KEYWORD2, KEYWORD3, LITERAL1, LITERAL2",
+	}, problemsToStrings(file.Problems))
+
+	require.Equal(t, []string{"1: invalid keyword Led.h"}, problemsToStrings(Parse([]byte("Led.h\tKEYWORD1\n")).Problems))
+	require.Equal(t, []string{"1: the keyword and its type must be separated by a tab"}, problemsToStrings(Parse([]byte("Led\n")).Problems))
+}
+
+func TestFromLibrary(t *testing.T) {
+	lib, err := libraries.Load(paths.New("testdata", "Led"), libraries.Unmanaged)
+	require.NoError(t, err)
+	generated, err := FromLibrary(lib)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"LED_BLINK LITERAL1",
+		"LED_DEFAULT_PIN LITERAL1",
+		"LED_FADE LITERAL1",
+		"Led KEYWORD1",
+		"LedMode KEYWORD1",
+		"blink KEYWORD2",
+		"builtinLed KEYWORD1",
+		"off KEYWORD2",
+		"on KEYWORD2",
+	}, entriesToStrings(generated))
+
+	file, err := Load(lib.InstallDir.Join("keywords.txt"))
+	require.NoError(t, err)
+	problems, missing := file.Check(generated)
+	require.Equal(t, []string{"7: the keyword toggle doesn't match any symbol declared in the public headers of the library"}, problemsToStrings(problems))
+	require.Equal(t, []string{"LED_DEFAULT_PIN LITERAL1", "LedMode KEYWORD1", "builtinLed KEYWORD1"}, entriesToStrings(missing))
+
+	merged := file.Merge(generated, false)
+	require.Equal(t, []string{
+		"Led KEYWORD1",
+		"on KEYWORD2",
+		"toggle KEYWORD2",
+		"off KEYWORD2",
+		"blink KEYWORD2",
+		"LED_BLINK LITERAL1",
+		"LED_FADE LITERAL1",
+		"LED_DEFAULT_PIN LITERAL1",
+		"LedMode KEYWORD1",
+		"builtinLed KEYWORD1",
+	}, entriesToStrings(merged))
+	require.NotContains(t, entriesToStrings(file.Merge(generated, true)), "toggle KEYWORD2")
+}
+
+func TestFormat(t *testing.T) {
+	out := Format("Led", []*Entry{
+		{Keyword: "Led", Type: Datatype, Fields: []string{"Led"}},
+		{Keyword: "on", Type: Function},
+		{Keyword: "LED_FADE", Type: "LITERAL3"},
+		{Keyword: "builtinLed", Type: Datatype},
+	})
+	require.Equal(t, `#######################################
+# Syntax Coloring Map For Led
+#######################################
+
+#######################################
+# Datatypes (KEYWORD1)
+#######################################
+
+Led	KEYWORD1	Led
+builtinLed	KEYWORD1
+
+#######################################
+# Methods and Functions (KEYWORD2)
+#######################################
+
+on	KEYWORD2
+
+#######################################
+# Invalid types
+#######################################
+
+LED_FADE	LITERAL3
+`, string(out))
+
+	// The formatted file can be read back, only the invalid type is reported
+	file := Parse(out)
+	require.Len(t, file.Entries, 4)
+	require.Equal(t, []string{"22: invalid type LITERAL3 for the keyword LED_FADE, must be one of: KEYWORD1, KEYWORD2, KEYWORD3, LITERAL1, LITERAL2"}, problemsToStrings(file.Problems))
+}
@@ -0,0 +1,24 @@
+#ifndef LED_H
+#define LED_H
+
+#include <Arduino.h>
+
+#define LED_DEFAULT_PIN 13
+
+enum LedMode { LED_BLINK, LED_FADE };
+
+class Led {
+ public:
+  Led(int pin = LED_DEFAULT_PIN);
+  void on();
+  void off();
+  void blink(unsigned long period);
+
+ private:
+  void _update();
+  int _pin;
+};
+
+extern Led builtinLed;
+
+#endif
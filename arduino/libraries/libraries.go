@@ -67,6 +67,8 @@ type Library struct {
 	SourceDir              *paths.Path
 	UtilityDir             *paths.Path
 	Location               LibraryLocation
+	InstallSource          LibraryInstallSource
+	InstallSourceURL       string
 	ContainerPlatform      *cores.PlatformRelease `json:""`
 	Layout                 LibraryLayout
 	DotALinkage            bool
@@ -143,6 +145,8 @@ func (library *Library) ToRPCLibrary() (*rpc.Library, error) {
 		Examples:          library.Examples.AsStrings(),
 		ProvidesIncludes:  headers,
 		CompatibleWith:    library.CompatibleWith,
+		InstallSource:     library.InstallSource.ToRPCLibraryInstallSource(),
+		InstallSourceUrl:  library.InstallSourceURL,
 	}, nil
 }
 
@@ -0,0 +1,119 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraries
+
+import (
+	"encoding/json"
+	"fmt"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// ManifestFileName is the name of the file, in the folder of a library installed
+// by the CLI, recording the install source and the hashes of the files of the
+// library.
+const ManifestFileName = ".arduino-manifest.json"
+
+// LibraryInstallSource represents where the library has been installed from
+type LibraryInstallSource int
+
+const (
+	// LocalSource are libraries copied in the libraries directory by the user,
+	// or installed by a version of the CLI that didn't record the source
+	LocalSource LibraryInstallSource = iota
+	// IndexSource are libraries installed from the libraries index
+	IndexSource
+	// GitSource are libraries installed from a git repository
+	GitSource
+	// ZipSource are libraries installed from a zip archive
+	ZipSource
+)
+
+func (s LibraryInstallSource) String() string {
+	switch s {
+	case LocalSource:
+		return "local"
+	case IndexSource:
+		return "index"
+	case GitSource:
+		return "git"
+	case ZipSource:
+		return "zip"
+	default:
+		panic(fmt.Sprintf("invalid LibraryInstallSource value %d", s))
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s LibraryInstallSource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *LibraryInstallSource) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	switch str {
+	case "local":
+		*s = LocalSource
+	case "index", "":
+		// The manifests written before the source was recorded are from index installs
+		*s = IndexSource
+	case "git":
+		*s = GitSource
+	case "zip":
+		*s = ZipSource
+	default:
+		return fmt.Errorf(tr("invalid library install source: %s"), str)
+	}
+	return nil
+}
+
+// ToRPCLibraryInstallSource converts this LibraryInstallSource to rpc.LibraryInstallSource
+func (s LibraryInstallSource) ToRPCLibraryInstallSource() rpc.LibraryInstallSource {
+	switch s {
+	case LocalSource:
+		return rpc.LibraryInstallSource_LIBRARY_INSTALL_SOURCE_LOCAL
+	case IndexSource:
+		return rpc.LibraryInstallSource_LIBRARY_INSTALL_SOURCE_INDEX
+	case GitSource:
+		return rpc.LibraryInstallSource_LIBRARY_INSTALL_SOURCE_GIT
+	case ZipSource:
+		return rpc.LibraryInstallSource_LIBRARY_INSTALL_SOURCE_ZIP
+	default:
+		panic(fmt.Sprintf("invalid LibraryInstallSource value %d", s))
+	}
+}
+
+// readInstallSource returns the install source, and the URL for the git
+// installs, recorded in the manifest of the library.
+func readInstallSource(libDir *paths.Path) (LibraryInstallSource, string) {
+	data, err := libDir.Join(ManifestFileName).ReadFile()
+	if err != nil {
+		return LocalSource, ""
+	}
+	var manifest struct {
+		Source LibraryInstallSource `json:"source"`
+		URL    string               `json:"url"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return LocalSource, ""
+	}
+	return manifest.Source, manifest.URL
+}
@@ -35,9 +35,14 @@ const (
 	ReferencedPlatformBuiltIn
 	// User are user installed libraries
 	User
+	// Profile are libraries installed for a build profile of a sketch
+	Profile
 	// Unmanaged is for libraries set manually by the user in the CLI command or from the gRPC function.
 	// Ideally it's used for `libraries` outside folders managed by the CLI.
 	Unmanaged
+	// Sketch are libraries set manually by the user, like Unmanaged, that are
+	// vendored inside the sketch folder
+	Sketch
 )
 
 func (d *LibraryLocation) String() string {
@@ -50,8 +55,12 @@ func (d *LibraryLocation) String() string {
 		return "ref-platform"
 	case User:
 		return "user"
+	case Profile:
+		return "profile"
 	case Unmanaged:
 		return "unmanaged"
+	case Sketch:
+		return "sketch"
 	default:
 		panic(fmt.Sprintf("invalid LibraryLocation value %d", *d))
 	}
@@ -68,24 +77,34 @@ func (d *LibraryLocation) UnmarshalJSON(b []byte) error {
 	if err := json.Unmarshal(b, &s); err != nil {
 		return err
 	}
+	location, err := ParseLibraryLocation(s)
+	if err != nil {
+		return err
+	}
+	*d = location
+	return nil
+}
+
+// ParseLibraryLocation returns the LibraryLocation with the given name, as
+// returned by String
+func ParseLibraryLocation(s string) (LibraryLocation, error) {
 	switch s {
 	case "ide":
-		*d = IDEBuiltIn
-		return nil
+		return IDEBuiltIn, nil
 	case "platform":
-		*d = PlatformBuiltIn
-		return nil
+		return PlatformBuiltIn, nil
 	case "ref-platform":
-		*d = ReferencedPlatformBuiltIn
-		return nil
+		return ReferencedPlatformBuiltIn, nil
 	case "user":
-		*d = User
-		return nil
+		return User, nil
+	case "profile":
+		return Profile, nil
 	case "unmanaged":
-		*d = Unmanaged
-		return nil
+		return Unmanaged, nil
+	case "sketch":
+		return Sketch, nil
 	default:
-		return fmt.Errorf(tr("invalid library location: %s"), s)
+		return 0, fmt.Errorf(tr("invalid library location: %s"), s)
 	}
 }
 
@@ -100,8 +119,12 @@ func (d *LibraryLocation) ToRPCLibraryLocation() rpc.LibraryLocation {
 		return rpc.LibraryLocation_LIBRARY_LOCATION_REFERENCED_PLATFORM_BUILTIN
 	case User:
 		return rpc.LibraryLocation_LIBRARY_LOCATION_USER
+	case Profile:
+		return rpc.LibraryLocation_LIBRARY_LOCATION_PROFILE
 	case Unmanaged:
 		return rpc.LibraryLocation_LIBRARY_LOCATION_UNMANAGED
+	case Sketch:
+		return rpc.LibraryLocation_LIBRARY_LOCATION_SKETCH
 	default:
 		panic(fmt.Sprintf("invalid LibraryLocation value %d", *d))
 	}
@@ -118,8 +141,12 @@ func FromRPCLibraryLocation(l rpc.LibraryLocation) LibraryLocation {
 		return ReferencedPlatformBuiltIn
 	case rpc.LibraryLocation_LIBRARY_LOCATION_USER:
 		return User
+	case rpc.LibraryLocation_LIBRARY_LOCATION_PROFILE:
+		return Profile
 	case rpc.LibraryLocation_LIBRARY_LOCATION_UNMANAGED:
 		return Unmanaged
+	case rpc.LibraryLocation_LIBRARY_LOCATION_SKETCH:
+		return Sketch
 	default:
 		panic(fmt.Sprintf("invalid rpc.LibraryLocation value %d", l))
 	}
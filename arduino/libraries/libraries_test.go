@@ -44,12 +44,18 @@ func TestLibLayoutAndLocationJSONUnMarshaler(t *testing.T) {
 		err = json.Unmarshal(d, &m)
 		require.NoError(t, err)
 		require.Equal(t, l, m)
+		require.Equal(t, l, FromRPCLibraryLocation(l.ToRPCLibraryLocation()))
 	}
 	testLocation(IDEBuiltIn)
 	testLocation(PlatformBuiltIn)
 	testLocation(ReferencedPlatformBuiltIn)
 	testLocation(User)
+	testLocation(Profile)
 	testLocation(Unmanaged)
+	testLocation(Sketch)
+
+	_, err := ParseLibraryLocation("sketchbook")
+	require.Error(t, err)
 }
 
 func TestLibrariesLoader(t *testing.T) {
@@ -16,7 +16,9 @@
 package librariesindex
 
 import (
+	"path"
 	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/resources"
@@ -27,6 +29,10 @@ import (
 // Index represents the list of libraries available for download
 type Index struct {
 	Libraries map[string]*Library
+
+	// headers maps the lowercase name of a header to the libraries that
+	// provide it in their latest release.
+	headers map[string][]*Library
 }
 
 // EmptyIndex is an empty library index
@@ -128,6 +134,40 @@ func (idx *Index) FindIndexedLibrary(lib *libraries.Library) *Library {
 	return idx.Libraries[lib.Name]
 }
 
+// FindLibrariesProvidingHeader returns the libraries that provide the given
+// header in their latest release, sorted by name. The header name is compared
+// case-insensitively, a header without extension matches the headers with the
+// same name and any extension (e.g. "sd" matches "SD.h").
+func (idx *Index) FindLibrariesProvidingHeader(header string) []*Library {
+	if idx.headers == nil {
+		idx.indexHeaders()
+	}
+	return idx.headers[strings.ToLower(header)]
+}
+
+// indexHeaders builds the inverted index of the headers provided by the
+// libraries.
+func (idx *Index) indexHeaders() {
+	idx.headers = map[string][]*Library{}
+	for _, library := range idx.Libraries {
+		if library.Latest == nil {
+			continue
+		}
+		for _, header := range library.Latest.ProvidesIncludes {
+			header = strings.ToLower(header)
+			for _, key := range []string{header, strings.TrimSuffix(header, path.Ext(header))} {
+				if libs := idx.headers[key]; len(libs) > 0 && libs[len(libs)-1] == library {
+					continue
+				}
+				idx.headers[key] = append(idx.headers[key], library)
+			}
+		}
+	}
+	for _, libs := range idx.headers {
+		sort.Slice(libs, func(i, j int) bool { return libs[i].Name < libs[j].Name })
+	}
+}
+
 // FindLibraryUpdate check if an installed library may be updated using
 // one of the indexed libraries. This function returns the Release to install
 // to update the library if found, otherwise nil is returned.
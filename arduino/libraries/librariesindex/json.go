@@ -43,6 +43,7 @@ type indexRelease struct {
 	Architectures    []string           `json:"architectures"`
 	Types            []string           `json:"types"`
 	URL              string             `json:"url"`
+	Mirrors          []string           `json:"mirrors,omitempty"`
 	ArchiveFileName  string             `json:"archiveFileName"`
 	Size             int64              `json:"size"`
 	Checksum         string             `json:"checksum"`
@@ -82,6 +83,7 @@ func (i indexJSON) extractIndex() (*Index, error) {
 	for _, indexLib := range i.Libraries {
 		indexLib.extractLibraryIn(index)
 	}
+	index.indexHeaders()
 	return index, nil
 }
 
@@ -110,6 +112,7 @@ func (indexLib *indexRelease) extractReleaseIn(library *Library) {
 		Types:         indexLib.Types,
 		Resource: &resources.DownloadResource{
 			URL:             indexLib.URL,
+			Mirrors:         indexLib.Mirrors,
 			ArchiveFileName: indexLib.ArchiveFileName,
 			Size:            indexLib.Size,
 			Checksum:        indexLib.Checksum,
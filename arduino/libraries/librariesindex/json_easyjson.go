@@ -112,6 +112,29 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 			}
 		case "url":
 			out.URL = string(in.String())
+		case "mirrors":
+			if in.IsNull() {
+				in.Skip()
+				out.Mirrors = nil
+			} else {
+				in.Delim('[')
+				if out.Mirrors == nil {
+					if !in.IsDelim(']') {
+						out.Mirrors = make([]string, 0, 4)
+					} else {
+						out.Mirrors = []string{}
+					}
+				} else {
+					out.Mirrors = (out.Mirrors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.Mirrors = append(out.Mirrors, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "archiveFileName":
 			out.ArchiveFileName = string(in.String())
 		case "size":
@@ -134,17 +157,17 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 					out.Dependencies = (out.Dependencies)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v3 *indexDependency
+					var v4 *indexDependency
 					if in.IsNull() {
 						in.Skip()
-						v3 = nil
+						v4 = nil
 					} else {
-						if v3 == nil {
-							v3 = new(indexDependency)
+						if v4 == nil {
+							v4 = new(indexDependency)
 						}
-						(*v3).UnmarshalEasyJSON(in)
+						(*v4).UnmarshalEasyJSON(in)
 					}
-					out.Dependencies = append(out.Dependencies, v3)
+					out.Dependencies = append(out.Dependencies, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -167,9 +190,9 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 					out.ProvidesIncludes = (out.ProvidesIncludes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 string
-					v4 = string(in.String())
-					out.ProvidesIncludes = append(out.ProvidesIncludes, v4)
+					var v5 string
+					v5 = string(in.String())
+					out.ProvidesIncludes = append(out.ProvidesIncludes, v5)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -218,9 +241,9 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 						out.Architectures = (out.Architectures)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v5 string
-						v5 = string(in.String())
-						out.Architectures = append(out.Architectures, v5)
+						var v6 string
+						v6 = string(in.String())
+						out.Architectures = append(out.Architectures, v6)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -241,15 +264,38 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 						out.Types = (out.Types)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v6 string
-						v6 = string(in.String())
-						out.Types = append(out.Types, v6)
+						var v7 string
+						v7 = string(in.String())
+						out.Types = append(out.Types, v7)
 						in.WantComma()
 					}
 					in.Delim(']')
 				}
 			case "url":
 				out.URL = string(in.String())
+			case "mirrors":
+				if in.IsNull() {
+					in.Skip()
+					out.Mirrors = nil
+				} else {
+					in.Delim('[')
+					if out.Mirrors == nil {
+						if !in.IsDelim(']') {
+							out.Mirrors = make([]string, 0, 4)
+						} else {
+							out.Mirrors = []string{}
+						}
+					} else {
+						out.Mirrors = (out.Mirrors)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v8 string
+						v8 = string(in.String())
+						out.Mirrors = append(out.Mirrors, v8)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
 			case "archivefilename":
 				out.ArchiveFileName = string(in.String())
 			case "size":
@@ -272,17 +318,17 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 						out.Dependencies = (out.Dependencies)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v7 *indexDependency
+						var v9 *indexDependency
 						if in.IsNull() {
 							in.Skip()
-							v7 = nil
+							v9 = nil
 						} else {
-							if v7 == nil {
-								v7 = new(indexDependency)
+							if v9 == nil {
+								v9 = new(indexDependency)
 							}
-							(*v7).UnmarshalEasyJSON(in)
+							(*v9).UnmarshalEasyJSON(in)
 						}
-						out.Dependencies = append(out.Dependencies, v7)
+						out.Dependencies = append(out.Dependencies, v9)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -305,9 +351,9 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 						out.ProvidesIncludes = (out.ProvidesIncludes)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v8 string
-						v8 = string(in.String())
-						out.ProvidesIncludes = append(out.ProvidesIncludes, v8)
+						var v10 string
+						v10 = string(in.String())
+						out.ProvidesIncludes = append(out.ProvidesIncludes, v10)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -378,11 +424,11 @@ func easyjson42239ddeEncodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v9, v10 := range in.Architectures {
-				if v9 > 0 {
+			for v11, v12 := range in.Architectures {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v10))
+				out.String(string(v12))
 			}
 			out.RawByte(']')
 		}
@@ -394,11 +440,11 @@ func easyjson42239ddeEncodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Types {
-				if v11 > 0 {
+			for v13, v14 := range in.Types {
+				if v13 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v12))
+				out.String(string(v14))
 			}
 			out.RawByte(']')
 		}
@@ -408,6 +454,20 @@ func easyjson42239ddeEncodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 		out.RawString(prefix)
 		out.String(string(in.URL))
 	}
+	if len(in.Mirrors) != 0 {
+		const prefix string = ",\"mirrors\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v15, v16 := range in.Mirrors {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v16))
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"archiveFileName\":"
 		out.RawString(prefix)
@@ -428,14 +488,14 @@ func easyjson42239ddeEncodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v13, v14 := range in.Dependencies {
-				if v13 > 0 {
+			for v17, v18 := range in.Dependencies {
+				if v17 > 0 {
 					out.RawByte(',')
 				}
-				if v14 == nil {
+				if v18 == nil {
 					out.RawString("null")
 				} else {
-					(*v14).MarshalEasyJSON(out)
+					(*v18).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -453,11 +513,11 @@ func easyjson42239ddeEncodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v15, v16 := range in.ProvidesIncludes {
-				if v15 > 0 {
+			for v19, v20 := range in.ProvidesIncludes {
+				if v19 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v16))
+				out.String(string(v20))
 			}
 			out.RawByte(']')
 		}
@@ -523,9 +583,9 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 					out.Libraries = (out.Libraries)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v17 indexRelease
-					(v17).UnmarshalEasyJSON(in)
-					out.Libraries = append(out.Libraries, v17)
+					var v21 indexRelease
+					(v21).UnmarshalEasyJSON(in)
+					out.Libraries = append(out.Libraries, v21)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -548,9 +608,9 @@ func easyjson42239ddeDecodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 						out.Libraries = (out.Libraries)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v18 indexRelease
-						(v18).UnmarshalEasyJSON(in)
-						out.Libraries = append(out.Libraries, v18)
+						var v22 indexRelease
+						(v22).UnmarshalEasyJSON(in)
+						out.Libraries = append(out.Libraries, v22)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -577,11 +637,11 @@ func easyjson42239ddeEncodeGithubComArduinoArduinoCliArduinoLibrariesLibrariesin
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v19, v20 := range in.Libraries {
-				if v19 > 0 {
+			for v23, v24 := range in.Libraries {
+				if v23 > 0 {
 					out.RawByte(',')
 				}
-				(v20).MarshalEasyJSON(out)
+				(v24).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
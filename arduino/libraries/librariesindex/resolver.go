@@ -0,0 +1,244 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesindex
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// ResolutionStrategy is the strategy used to choose the version of a
+// dependency when more than one version satisfies the constraints.
+type ResolutionStrategy int
+
+const (
+	// ResolutionLatest picks the newest version of the dependencies.
+	ResolutionLatest ResolutionStrategy = iota
+	// ResolutionMinimal keeps the installed version of the dependencies if it
+	// satisfies the constraints, otherwise it picks the oldest version that
+	// satisfies them.
+	ResolutionMinimal
+)
+
+// maxResolutionSteps limits the number of releases tried by the resolver
+// before giving up.
+const maxResolutionSteps = 100000
+
+// ErrNoSolution is returned when the dependencies can not be satisfied.
+var ErrNoSolution = errors.New(tr("no combination of versions satisfies all the dependencies"))
+
+// ParseDependencies parses the "depends" field of a library.properties, a comma
+// separated list of library names, each one optionally followed by a version
+// constraint in parenthesis: "Foo (>=1.2.0), Bar".
+func ParseDependencies(depends string) ([]*Dependency, error) {
+	res := []*Dependency{}
+	for _, dep := range strings.Split(depends, ",") {
+		dep = strings.TrimSpace(dep)
+		if dep == "" {
+			continue
+		}
+		name, constraint := dep, ""
+		if i := strings.Index(dep, "("); i != -1 {
+			name, constraint = strings.TrimSpace(dep[:i]), dep[i:]
+		}
+		c, err := semver.ParseConstraint(constraint)
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid version constraint for %[1]s: %[2]s"), name, err)
+		}
+		res = append(res, &Dependency{Name: name, VersionConstraint: c})
+	}
+	return res, nil
+}
+
+// ResolveDependenciesWithStrategy returns the dependencies of a library
+// release, choosing their versions with the given strategy. The dependencies
+// declared by the installed libraries are honored as well: a version is not
+// chosen if it breaks an installed library that is not replaced by the
+// solution. If there is no solution ErrNoSolution is returned, or an error
+// describing the installed library that prevents the resolution.
+func (idx *Index) ResolveDependenciesWithStrategy(lib *Release, installed []*libraries.Library, strategy ResolutionStrategy) ([]*Release, error) {
+	r := &resolver{
+		index:     idx,
+		strategy:  strategy,
+		installed: map[string]*libraries.Library{},
+		required:  map[string][]*installedDependency{},
+	}
+	for _, installedLib := range installed {
+		r.installed[installedLib.Name] = installedLib
+		if installedLib.Properties == nil {
+			continue
+		}
+		// Installed libraries with an invalid depends field do not constraint the resolution
+		deps, _ := ParseDependencies(installedLib.Properties.Get("depends"))
+		for _, dep := range deps {
+			r.required[dep.Name] = append(r.required[dep.Name], &installedDependency{library: installedLib, dependency: dep})
+		}
+	}
+
+	if solution := r.solve(lib, true); solution != nil {
+		return solution, nil
+	}
+
+	// Check if the installed libraries are preventing the resolution
+	r.steps = 0
+	solution := r.solve(lib, false)
+	if solution == nil {
+		return nil, ErrNoSolution
+	}
+	chosen := map[string]*Release{}
+	for _, release := range solution {
+		chosen[release.GetName()] = release
+	}
+	if broken := r.brokenDependencies(chosen); len(broken) > 0 {
+		b := broken[0]
+		return nil, fmt.Errorf(tr("the installed library %[1]s requires %[2]s %[3]s, but %[4]s is needed"),
+			b.library, b.dependency.Name, b.dependency.VersionConstraint, chosen[b.dependency.Name])
+	}
+	return nil, ErrNoSolution
+}
+
+type installedDependency struct {
+	library    *libraries.Library
+	dependency *Dependency
+}
+
+type resolver struct {
+	index     *Index
+	strategy  ResolutionStrategy
+	installed map[string]*libraries.Library
+	// required maps a library name to the dependencies of the installed libraries on it
+	required map[string][]*installedDependency
+	steps    int
+}
+
+func (r *resolver) solve(lib *Release, honorInstalled bool) []*Release {
+	main := &Dependency{Name: lib.GetName(), VersionConstraint: &semver.Equals{Version: lib.GetVersion()}}
+	solution := map[string]*Release{}
+	if !r.resolve(solution, []semver.Dependency{main}, honorInstalled) {
+		return nil
+	}
+	res := []*Release{}
+	for _, release := range solution {
+		res = append(res, release)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].GetName() < res[j].GetName() })
+	return res
+}
+
+func (r *resolver) resolve(solution map[string]*Release, pending []semver.Dependency, honorInstalled bool) bool {
+	if len(pending) == 0 {
+		return !honorInstalled || len(r.brokenDependencies(solution)) == 0
+	}
+	dep := pending[0]
+	if existing, has := solution[dep.GetName()]; has {
+		return matches(existing, dep) && r.resolve(solution, pending[1:], honorInstalled)
+	}
+
+	for _, release := range r.candidates(dep, solution) {
+		if r.steps++; r.steps > maxResolutionSteps {
+			return false
+		}
+		missing := false
+		for _, releaseDep := range release.GetDependencies() {
+			if _, ok := r.index.Libraries[releaseDep.GetName()]; !ok {
+				missing = true
+				break
+			}
+		}
+		if missing {
+			continue
+		}
+		solution[dep.GetName()] = release
+		newPending := append(append([]semver.Dependency{}, pending[1:]...), release.GetDependencies()...)
+		if r.resolve(solution, newPending, honorInstalled) {
+			return true
+		}
+		delete(solution, dep.GetName())
+	}
+	return false
+}
+
+// candidates returns the releases matching the dependency in the order they
+// should be tried: the releases that do not break the installed libraries
+// come first, then the strategy decides.
+func (r *resolver) candidates(dep semver.Dependency, solution map[string]*Release) []*Release {
+	indexLib, ok := r.index.Libraries[dep.GetName()]
+	if !ok {
+		return nil
+	}
+	var installedVersion *semver.Version
+	if installedLib, ok := r.installed[dep.GetName()]; ok {
+		installedVersion = installedLib.Version
+	}
+
+	res := []*Release{}
+	for _, release := range indexLib.Releases {
+		if matches(release, dep) {
+			res = append(res, release)
+		}
+	}
+	breaks := func(release *Release) bool {
+		for _, req := range r.required[release.GetName()] {
+			if _, replaced := solution[req.library.Name]; !replaced && !matches(release, req.dependency) {
+				return true
+			}
+		}
+		return false
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if bi, bj := breaks(res[i]), breaks(res[j]); bi != bj {
+			return bj
+		}
+		if r.strategy == ResolutionMinimal {
+			if installedVersion != nil {
+				if ii, ij := res[i].Version.Equal(installedVersion), res[j].Version.Equal(installedVersion); ii != ij {
+					return ii
+				}
+			}
+			return res[i].Version.LessThan(res[j].Version)
+		}
+		return res[i].Version.GreaterThan(res[j].Version)
+	})
+	return res
+}
+
+// brokenDependencies returns the dependencies of the installed libraries, not
+// replaced by the solution, that are not satisfied by the solution.
+func (r *resolver) brokenDependencies(solution map[string]*Release) []*installedDependency {
+	res := []*installedDependency{}
+	for name, release := range solution {
+		for _, req := range r.required[name] {
+			if _, replaced := solution[req.library.Name]; !replaced && !matches(release, req.dependency) {
+				res = append(res, req)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].library.Name < res[j].library.Name })
+	return res
+}
+
+func matches(release *Release, dep semver.Dependency) bool {
+	if release.GetName() != dep.GetName() {
+		return false
+	}
+	constraint := dep.GetConstraint()
+	return constraint == nil || constraint.Match(release.GetVersion())
+}
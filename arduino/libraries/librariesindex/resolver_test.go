@@ -0,0 +1,120 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesindex
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestParseDependencies(t *testing.T) {
+	deps, err := ParseDependencies("Foo (>=1.2.0), Adafruit GFX Library,Bar (>=1.0.0 && <2.0.0)")
+	require.NoError(t, err)
+	require.Len(t, deps, 3)
+	require.Equal(t, "Foo", deps[0].Name)
+	require.Equal(t, ">=1.2.0", deps[0].VersionConstraint.String())
+	require.Equal(t, "Adafruit GFX Library", deps[1].Name)
+	require.True(t, deps[1].VersionConstraint.Match(semver.MustParse("0.1.0")))
+	require.Equal(t, "Bar", deps[2].Name)
+	require.False(t, deps[2].VersionConstraint.Match(semver.MustParse("2.0.0")))
+
+	deps, err = ParseDependencies("")
+	require.NoError(t, err)
+	require.Empty(t, deps)
+
+	_, err = ParseDependencies("Foo (>=)")
+	require.Error(t, err)
+}
+
+func TestResolveDependenciesWithStrategy(t *testing.T) {
+	index := &Index{Libraries: map[string]*Library{}}
+	addRelease := func(name, version, depends string) *Release {
+		lib, ok := index.Libraries[name]
+		if !ok {
+			lib = &Library{Name: name, Releases: map[semver.NormalizedString]*Release{}, Index: index}
+			index.Libraries[name] = lib
+		}
+		deps, err := ParseDependencies(depends)
+		require.NoError(t, err)
+		release := &Release{Version: semver.MustParse(version), Library: lib}
+		for _, dep := range deps {
+			release.Dependencies = append(release.Dependencies, dep)
+		}
+		lib.Releases[release.Version.NormalizedString()] = release
+		if lib.Latest == nil || lib.Latest.Version.LessThan(release.Version) {
+			lib.Latest = release
+		}
+		return release
+	}
+	installedLib := func(name, version, depends string) *libraries.Library {
+		props := properties.NewMap()
+		props.Set("depends", depends)
+		return &libraries.Library{Name: name, Version: semver.MustParse(version), Properties: props}
+	}
+	names := func(releases []*Release) []string {
+		res := []string{}
+		for _, release := range releases {
+			res = append(res, release.String())
+		}
+		return res
+	}
+
+	addRelease("Foo", "1.0.0", "")
+	addRelease("Foo", "1.2.0", "")
+	addRelease("Foo", "1.5.0", "")
+	addRelease("Foo", "2.0.0", "")
+	addRelease("Bar", "1.0.0", "Foo (>=1.2.0)")
+	addRelease("Bar", "1.1.0", "Foo (>=1.2.0)")
+	app := addRelease("App", "1.0.0", "Foo (>=1.2.0),Bar")
+	broken := addRelease("Broken", "1.0.0", "Foo (>=3.0.0)")
+
+	res, err := index.ResolveDependenciesWithStrategy(app, nil, ResolutionLatest)
+	require.NoError(t, err)
+	require.Equal(t, []string{"App@1.0.0", "Bar@1.1.0", "Foo@2.0.0"}, names(res))
+
+	res, err = index.ResolveDependenciesWithStrategy(app, nil, ResolutionMinimal)
+	require.NoError(t, err)
+	require.Equal(t, []string{"App@1.0.0", "Bar@1.0.0", "Foo@1.2.0"}, names(res))
+
+	// The minimal strategy keeps the installed versions
+	installed := []*libraries.Library{installedLib("Foo", "1.5.0", "")}
+	res, err = index.ResolveDependenciesWithStrategy(app, installed, ResolutionMinimal)
+	require.NoError(t, err)
+	require.Equal(t, []string{"App@1.0.0", "Bar@1.0.0", "Foo@1.5.0"}, names(res))
+
+	// The installed libraries constraint the versions
+	installed = []*libraries.Library{installedLib("Other", "1.0.0", "Foo (<2.0.0)")}
+	res, err = index.ResolveDependenciesWithStrategy(app, installed, ResolutionLatest)
+	require.NoError(t, err)
+	require.Equal(t, []string{"App@1.0.0", "Bar@1.1.0", "Foo@1.5.0"}, names(res))
+
+	// ...unless they are replaced by the solution
+	installed = []*libraries.Library{installedLib("Bar", "0.9.0", "Foo (<1.0.0)")}
+	res, err = index.ResolveDependenciesWithStrategy(app, installed, ResolutionLatest)
+	require.NoError(t, err)
+	require.Equal(t, []string{"App@1.0.0", "Bar@1.1.0", "Foo@2.0.0"}, names(res))
+
+	installed = []*libraries.Library{installedLib("Other", "1.0.0", "Foo (<1.2.0)")}
+	_, err = index.ResolveDependenciesWithStrategy(app, installed, ResolutionLatest)
+	require.EqualError(t, err, "the installed library Other@1.0.0 requires Foo <1.2.0, but Foo@2.0.0 is needed")
+
+	_, err = index.ResolveDependenciesWithStrategy(broken, nil, ResolutionLatest)
+	require.ErrorIs(t, err, ErrNoSolution)
+}
@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/executils"
+)
+
+// postInstallHookTimeout is the maximum time a post-install hook may run
+const postInstallHookTimeout = 10 * time.Minute
+
+// RunPostInstallHook runs the post-install hook of the library if the user
+// approved the hooks of the library, listing it in allowedLibraries, and all
+// the permissions the hook declares are allowed. A hook that declares no
+// permission must be approved as well. The permissions are advisory, they're
+// not enforced: the hook runs with the privileges of the user. The hook runs
+// in the library folder, with a minimal environment and a timeout: the system
+// variables needed to run a script, the proxy variables if the network
+// permission is granted, and ARDUINO_LIBRARY_NAME, ARDUINO_LIBRARY_VERSION
+// and ARDUINO_LIBRARY_DIR.
+func (lm *LibrariesManager) RunPostInstallHook(ctx context.Context, lib *libraries.Library, hook *libraries.PostInstallHook, allowedLibraries, allowedPermissions []string) ([]byte, []byte, error) {
+	if !slices.Contains(allowedLibraries, lib.Name) {
+		return nil, nil, fmt.Errorf(tr("the post-install hooks of %s are not approved by the library.post_install_libraries setting"), lib.Name)
+	}
+	if notAllowed := hook.NotAllowedPermissions(allowedPermissions); len(notAllowed) > 0 {
+		return nil, nil, fmt.Errorf(tr("the post-install hook requires the permissions not allowed by the library.post_install_permissions setting: %s"), strings.Join(notAllowed, ", "))
+	}
+
+	args := []string{hook.Script.String()}
+	if runtime.GOOS == "windows" && hook.Script.Ext() != ".exe" {
+		args = []string{"cmd", "/c", hook.Script.String()}
+	}
+	cmd, err := executils.NewProcess(nil, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	cmd.SetEnvironment(postInstallHookEnvironment(lib, hook))
+	cmd.SetDirFromPath(lib.InstallDir)
+
+	ctx, cancel := context.WithTimeout(ctx, postInstallHookTimeout)
+	defer cancel()
+	stdout, stderr, err := cmd.RunAndCaptureOutput(ctx)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf(tr("the post-install hook didn't complete within %s"), postInstallHookTimeout)
+	}
+	if err == nil && lib.InstallDir.Join(libraries.ManifestFileName).Exist() {
+		// The files created by the hook are part of the installed library
+		err = WriteManifest(lib.InstallDir, lib.Name, lib.Version.String(), lib.InstallSource, lib.InstallSourceURL)
+	}
+	return stdout, stderr, err
+}
+
+func postInstallHookEnvironment(lib *libraries.Library, hook *libraries.PostInstallHook) []string {
+	inherited := []string{"PATH", "HOME", "USER", "LANG", "TMPDIR", "TEMP", "TMP", "SYSTEMROOT", "COMSPEC", "PATHEXT", "USERPROFILE"}
+	for _, permission := range hook.Permissions {
+		if permission == libraries.HookPermissionNetwork {
+			inherited = append(inherited, "HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy")
+		}
+	}
+	env := []string{}
+	for _, name := range inherited {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	return append(env,
+		"ARDUINO_LIBRARY_NAME="+lib.Name,
+		"ARDUINO_LIBRARY_VERSION="+lib.Version.String(),
+		"ARDUINO_LIBRARY_DIR="+lib.InstallDir.String())
+}
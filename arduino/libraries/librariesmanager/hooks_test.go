@@ -0,0 +1,79 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesmanager
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPostInstallHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the hook is a shell script")
+	}
+	baseDir := paths.New(t.TempDir())
+	lm := NewLibraryManager(baseDir.Join("index_dir"), baseDir.Join("downloads_dir"))
+
+	libDir := baseDir.Join("HookLib")
+	require.NoError(t, libDir.Join("src").MkdirAll())
+	require.NoError(t, libDir.Join("src", "HookLib.h").WriteFile([]byte{}))
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte(
+		"name=HookLib\nversion=1.2.3\npost_install=setup.sh\npost_install.permissions=network\n")))
+	script := "#!/bin/sh\necho \"$ARDUINO_LIBRARY_NAME $ARDUINO_LIBRARY_VERSION $(pwd)\"\necho \"secret=$SECRET_TOKEN\"\n"
+	require.NoError(t, libDir.Join("setup.sh").WriteFile([]byte(script)))
+	require.NoError(t, os.Chmod(libDir.Join("setup.sh").String(), 0755))
+	t.Setenv("SECRET_TOKEN", "1234")
+
+	lib, err := libraries.Load(libDir, libraries.User)
+	require.NoError(t, err)
+	hook, err := lib.PostInstallHook()
+	require.NoError(t, err)
+
+	// The hooks of the library must be approved
+	_, _, err = lm.RunPostInstallHook(context.Background(), lib, hook, []string{"OtherLib"}, []string{"network"})
+	require.ErrorContains(t, err, "not approved by the library.post_install_libraries setting")
+
+	// The permissions of the hook must be allowed
+	_, _, err = lm.RunPostInstallHook(context.Background(), lib, hook, []string{"HookLib"}, []string{})
+	require.ErrorContains(t, err, "not allowed by the library.post_install_permissions setting: network")
+
+	stdout, _, err := lm.RunPostInstallHook(context.Background(), lib, hook, []string{"HookLib"}, []string{"network"})
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(stdout)), "\n")
+	require.Equal(t, "HookLib 1.2.3 "+lib.InstallDir.String(), lines[0])
+	// The environment of the CLI is not passed to the hook
+	require.Equal(t, "secret=", lines[1])
+
+	// A hook that declares no permission must be approved too
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte(
+		"name=HookLib\nversion=1.2.3\npost_install=setup.sh\n")))
+	lib, err = libraries.Load(libDir, libraries.User)
+	require.NoError(t, err)
+	hook, err = lib.PostInstallHook()
+	require.NoError(t, err)
+	require.Empty(t, hook.Permissions)
+	_, _, err = lm.RunPostInstallHook(context.Background(), lib, hook, []string{}, []string{})
+	require.ErrorContains(t, err, "not approved by the library.post_install_libraries setting")
+	_, _, err = lm.RunPostInstallHook(context.Background(), lib, hook, []string{"HookLib"}, []string{})
+	require.NoError(t, err)
+}
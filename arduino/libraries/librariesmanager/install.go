@@ -103,13 +103,21 @@ func (lm *LibrariesManager) InstallPrerequisiteCheck(name string, version *semve
 	}, nil
 }
 
-// Install installs a library on the specified path.
-func (lm *LibrariesManager) Install(indexLibrary *librariesindex.Release, installPath *paths.Path) error {
-	return indexLibrary.Resource.Install(lm.DownloadsDir, installPath.Parent(), installPath)
+// Install installs a library on the specified path. The hashes of the installed
+// files are recorded in the library folder to detect the local modifications.
+func (lm *LibrariesManager) Install(ctx context.Context, indexLibrary *librariesindex.Release, installPath *paths.Path) error {
+	if err := indexLibrary.Resource.Install(ctx, lm.DownloadsDir, installPath.Parent(), installPath); err != nil {
+		return err
+	}
+	if err := WriteManifest(installPath, indexLibrary.GetName(), indexLibrary.GetVersion().String(), libraries.IndexSource, ""); err != nil {
+		return fmt.Errorf(tr("writing the manifest of the library: %s"), err)
+	}
+	return nil
 }
 
 // importLibraryFromDirectory installs a library by copying it from the given directory.
-func (lm *LibrariesManager) importLibraryFromDirectory(libPath *paths.Path, overwrite bool) error {
+// The install source is recorded in the manifest of the installed library.
+func (lm *LibrariesManager) importLibraryFromDirectory(libPath *paths.Path, source libraries.LibraryInstallSource, url string, overwrite bool) error {
 	// Check if the library is valid and load metatada
 	if err := validateLibrary(libPath); err != nil {
 		return err
@@ -118,6 +126,9 @@ func (lm *LibrariesManager) importLibraryFromDirectory(libPath *paths.Path, over
 	if err != nil {
 		return err
 	}
+	if err := WriteManifest(libPath, library.Name, library.Version.String(), source, url); err != nil {
+		return fmt.Errorf(tr("writing the manifest of the library: %s"), err)
+	}
 
 	// Check if the library is already installed and determine install path
 	installPlan, err := lm.InstallPrerequisiteCheck(library.Name, library.Version, libraries.User)
@@ -141,7 +152,7 @@ func (lm *LibrariesManager) importLibraryFromDirectory(libPath *paths.Path, over
 	if installPlan.TargetPath.Exist() {
 		return fmt.Errorf("%s: %s", tr("destination directory already exists"), installPlan.TargetPath)
 	}
-	if err := libPath.CopyDirTo(installPlan.TargetPath); err != nil {
+	if err := libPath.CopyDirTo(utils.LongPath(installPlan.TargetPath)); err != nil {
 		return fmt.Errorf("%s: %w", tr("copying library to destination directory:"), err)
 	}
 	return nil
@@ -152,7 +163,7 @@ func (lm *LibrariesManager) Uninstall(lib *libraries.Library) error {
 	if lib == nil || lib.InstallDir == nil {
 		return fmt.Errorf(tr("install directory not set"))
 	}
-	if err := lib.InstallDir.RemoveAll(); err != nil {
+	if err := utils.LongPath(lib.InstallDir).RemoveAll(); err != nil {
 		return fmt.Errorf(tr("removing library directory: %s"), err)
 	}
 
@@ -169,6 +180,7 @@ func (lm *LibrariesManager) InstallZipLib(ctx context.Context, archivePath *path
 	if err != nil {
 		return err
 	}
+	tmpDir = utils.LongPath(tmpDir)
 	defer tmpDir.RemoveAll()
 
 	file, err := archivePath.Open()
@@ -197,7 +209,7 @@ func (lm *LibrariesManager) InstallZipLib(ctx context.Context, archivePath *path
 	tmpInstallPath := libRootFiles[0]
 
 	// Install extracted library in the destination directory
-	if err := lm.importLibraryFromDirectory(tmpInstallPath, overwrite); err != nil {
+	if err := lm.importLibraryFromDirectory(tmpInstallPath, libraries.ZipSource, "", overwrite); err != nil {
 		return fmt.Errorf(tr("moving extracted archive to destination dir: %s"), err)
 	}
 
@@ -216,6 +228,7 @@ func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
 	if err != nil {
 		return err
 	}
+	tmp = utils.LongPath(tmp)
 	defer tmp.RemoveAll()
 	tmpInstallPath := tmp.Join(gitLibraryName)
 
@@ -246,7 +259,7 @@ func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
 	tmpInstallPath.Join(".git").RemoveAll()
 
 	// Install extracted library in the destination directory
-	if err := lm.importLibraryFromDirectory(tmpInstallPath, overwrite); err != nil {
+	if err := lm.importLibraryFromDirectory(tmpInstallPath, libraries.GitSource, gitURL, overwrite); err != nil {
 		return fmt.Errorf(tr("moving extracted archive to destination dir: %s"), err)
 	}
 
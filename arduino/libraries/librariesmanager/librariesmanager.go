@@ -25,6 +25,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/i18n"
 	paths "github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
@@ -42,6 +43,8 @@ type LibrariesManager struct {
 	IndexFile          *paths.Path
 	IndexFileSignature *paths.Path
 	DownloadsDir       *paths.Path
+
+	symlinksPolicy utils.SymlinksPolicy
 }
 
 // LibrariesDir is a directory containing libraries
@@ -83,9 +86,15 @@ func NewLibraryManager(indexDir *paths.Path, downloadsDir *paths.Path) *Librarie
 		IndexFileSignature: indexFileSignature,
 		DownloadsDir:       downloadsDir,
 		Index:              librariesindex.EmptyIndex,
+		symlinksPolicy:     utils.SymlinksFollow,
 	}
 }
 
+// SetSymlinksPolicy sets how the symlinks found in the libraries directories are handled.
+func (lm *LibrariesManager) SetSymlinksPolicy(policy utils.SymlinksPolicy) {
+	lm.symlinksPolicy = policy
+}
+
 // LoadIndex reads a library_index.json from a file and returns
 // the corresponding Index structure.
 func (lm *LibrariesManager) LoadIndex() error {
@@ -180,6 +189,11 @@ func (lm *LibrariesManager) LoadLibrariesFromDir(librariesDir *LibrariesDir) []*
 	subDirs.FilterOutHiddenFiles()
 
 	for _, subDir := range subDirs {
+		if err := lm.checkLibrarySymlink(librariesDir.Path, subDir); err != nil {
+			s := status.Newf(codes.FailedPrecondition, tr("loading library from %[1]s: %[2]s"), subDir, err)
+			statuses = append(statuses, s)
+			continue
+		}
 		library, err := libraries.Load(subDir, librariesDir.Location)
 		if err != nil {
 			s := status.Newf(codes.Internal, tr("loading library from %[1]s: %[2]s"), subDir, err)
@@ -195,6 +209,22 @@ func (lm *LibrariesManager) LoadLibrariesFromDir(librariesDir *LibrariesDir) []*
 	return statuses
 }
 
+// checkLibrarySymlink returns an error if libDir is a symlink not allowed by the
+// symlinks policy or if it points to the libraries directory containing it.
+func (lm *LibrariesManager) checkLibrarySymlink(librariesDir, libDir *paths.Path) error {
+	if err := utils.CheckSymlink(libDir, lm.symlinksPolicy); err != nil {
+		return err
+	}
+	if !utils.IsSymlink(libDir) {
+		return nil
+	}
+	target := libDir.Canonical()
+	if inside, _ := librariesDir.Canonical().IsInsideDir(target); inside || target.EqualsTo(librariesDir.Canonical()) {
+		return &utils.SymlinkLoopError{Path: libDir, Target: target}
+	}
+	return nil
+}
+
 // LoadLibraryFromDir loads one single library from the libRootDir.
 // libRootDir must point to the root of a valid library.
 // An error is returned if the path doesn't exist or loading of the library fails.
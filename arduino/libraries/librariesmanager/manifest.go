@@ -0,0 +1,135 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesmanager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+)
+
+type libraryManifest struct {
+	Name    string                         `json:"name"`
+	Version string                         `json:"version"`
+	Source  libraries.LibraryInstallSource `json:"source"`
+	URL     string                         `json:"url,omitempty"`
+	Files   map[string]string              `json:"files"`
+}
+
+// WriteManifest writes the manifest with the install source and the hashes of
+// the files of the library installed in libDir. The url is the URL of the
+// repository of the libraries installed from git.
+func WriteManifest(libDir *paths.Path, name, version string, source libraries.LibraryInstallSource, url string) error {
+	files, err := hashLibraryFiles(libDir)
+	if err != nil {
+		return err
+	}
+	manifest := &libraryManifest{Name: name, Version: version, Source: source, URL: url, Files: files}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return libDir.Join(libraries.ManifestFileName).WriteFile(data)
+}
+
+// ModifiedFiles returns the files of the library, relative to the library
+// folder, that were changed, added or removed since the library was installed.
+// The second value is false if the library has no manifest, because it was
+// not installed by the CLI.
+func ModifiedFiles(lib *libraries.Library) ([]string, bool, error) {
+	if lib.InstallDir == nil {
+		return nil, false, nil
+	}
+	data, err := lib.InstallDir.Join(libraries.ManifestFileName).ReadFile()
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	var manifest libraryManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, true, err
+	}
+	files, err := hashLibraryFiles(lib.InstallDir)
+	if err != nil {
+		return nil, true, err
+	}
+
+	res := []string{}
+	for file, hash := range files {
+		if manifest.Files[file] != hash {
+			res = append(res, file)
+		}
+	}
+	for file := range manifest.Files {
+		if _, ok := files[file]; !ok {
+			res = append(res, file)
+		}
+	}
+	sort.Strings(res)
+	return res, true, nil
+}
+
+// hashLibraryFiles returns the SHA-256 of the files in the library folder,
+// indexed by their slash separated path relative to the folder.
+func hashLibraryFiles(libDir *paths.Path) (map[string]string, error) {
+	root := libDir.String()
+	res := map[string]string{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == libraries.ManifestFileName {
+			return nil
+		}
+		hash, err := hashFile(path)
+		if err != nil {
+			return err
+		}
+		res[rel] = hash
+		return nil
+	})
+	return res, err
+}
+
+func hashFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	return "SHA-256:" + hex.EncodeToString(hasher.Sum(nil)), nil
+}
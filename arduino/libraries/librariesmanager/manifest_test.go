@@ -0,0 +1,59 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesmanager
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifiedFiles(t *testing.T) {
+	libDir := paths.New(t.TempDir()).Join("ModLib")
+	require.NoError(t, libDir.Join("src", "utility").MkdirAll())
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte("name=ModLib\nversion=1.0.0\n")))
+	require.NoError(t, libDir.Join("src", "ModLib.h").WriteFile([]byte("#define A 1\n")))
+	require.NoError(t, libDir.Join("src", "utility", "util.h").WriteFile([]byte("#define B 1\n")))
+	lib, err := libraries.Load(libDir, libraries.User)
+	require.NoError(t, err)
+
+	// Libraries without manifest are not installed from the index
+	_, hasManifest, err := ModifiedFiles(lib)
+	require.NoError(t, err)
+	require.False(t, hasManifest)
+
+	require.Equal(t, libraries.LocalSource, lib.InstallSource)
+
+	require.NoError(t, WriteManifest(libDir, "ModLib", "1.0.0", libraries.GitSource, "https://example.com/ModLib.git#1.0.0"))
+	lib, err = libraries.Load(libDir, libraries.User)
+	require.NoError(t, err)
+	require.Equal(t, libraries.GitSource, lib.InstallSource)
+	require.Equal(t, "https://example.com/ModLib.git#1.0.0", lib.InstallSourceURL)
+	modified, hasManifest, err := ModifiedFiles(lib)
+	require.NoError(t, err)
+	require.True(t, hasManifest)
+	require.Empty(t, modified)
+
+	require.NoError(t, libDir.Join("src", "ModLib.h").WriteFile([]byte("#define A 2\n")))
+	require.NoError(t, libDir.Join("src", "utility", "util.h").Remove())
+	require.NoError(t, libDir.Join("src", "extra.h").WriteFile([]byte{}))
+	modified, hasManifest, err = ModifiedFiles(lib)
+	require.NoError(t, err)
+	require.True(t, hasManifest)
+	require.Equal(t, []string{"src/ModLib.h", "src/extra.h", "src/utility/util.h"}, modified)
+}
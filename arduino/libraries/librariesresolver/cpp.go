@@ -18,6 +18,7 @@ package librariesresolver
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -32,6 +33,8 @@ import (
 // Cpp finds libraries made for the C++ language
 type Cpp struct {
 	headers map[string]libraries.List
+	// selections are the functions forcing the libraries to use for a header
+	selections map[string]func(*libraries.Library) bool
 }
 
 var tr = i18n.Tr
@@ -39,7 +42,8 @@ var tr = i18n.Tr
 // NewCppResolver creates a new Cpp resolver
 func NewCppResolver() *Cpp {
 	return &Cpp{
-		headers: map[string]libraries.List{},
+		headers:    map[string]libraries.List{},
+		selections: map[string]func(*libraries.Library) bool{},
 	}
 }
 
@@ -72,7 +76,8 @@ func (resolver *Cpp) ScanIDEBuiltinLibraries(lm *librariesmanager.LibrariesManag
 func (resolver *Cpp) ScanUserAndUnmanagedLibraries(lm *librariesmanager.LibrariesManager) error {
 	for _, libAlternatives := range lm.Libraries {
 		for _, lib := range libAlternatives {
-			if lib.Location == libraries.User || lib.Location == libraries.Unmanaged {
+			switch lib.Location {
+			case libraries.User, libraries.Profile, libraries.Unmanaged, libraries.Sketch:
 				resolver.ScanLibrary(lib)
 			}
 		}
@@ -116,13 +121,63 @@ func (resolver *Cpp) AlternativesFor(header string) libraries.List {
 	return resolver.headers[header]
 }
 
+// SelectLibraryFor forces the resolution of the header to the libraries
+// accepted by the match function, the priority of the libraries is used only
+// to choose among them. If no library providing the header is accepted the
+// selection is ignored.
+func (resolver *Cpp) SelectLibraryFor(header string, match func(*libraries.Library) bool) {
+	resolver.selections[header] = match
+}
+
+// SelectedAlternativesFor returns the libraries providing the header that are
+// accepted by the selection set with SelectLibraryFor, or nil if there is no
+// selection for the header.
+func (resolver *Cpp) SelectedAlternativesFor(header string) libraries.List {
+	match, ok := resolver.selections[header]
+	if !ok {
+		return nil
+	}
+	res := libraries.List{}
+	for _, lib := range resolver.headers[header] {
+		if match(lib) {
+			res.Add(lib)
+		}
+	}
+	return res
+}
+
+// AmbiguousHeaders returns the sorted list of the headers provided by more than
+// one library.
+func (resolver *Cpp) AmbiguousHeaders() []string {
+	res := []string{}
+	for header, libs := range resolver.headers {
+		if len(libs) > 1 {
+			res = append(res, header)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
 // ResolveFor finds the most suitable library for the specified combination of
 // header and architecture. If no libraries provides the requested header, nil is returned
 func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library {
+	lib, _ := resolver.ResolveForWithReason(header, architecture)
+	return lib
+}
+
+// ResolveForWithReason works like ResolveFor and also returns the reason why
+// the library has been selected among the alternatives.
+func (resolver *Cpp) ResolveForWithReason(header, architecture string) (*libraries.Library, string) {
 	logrus.Infof("Resolving include %s for arch %s", header, architecture)
+	candidates := resolver.headers[header]
+	if selected := resolver.SelectedAlternativesFor(header); len(selected) > 0 {
+		logrus.Infof("  using the selected libraries %s", selected)
+		candidates = selected
+	}
 	var found libraries.List
 	var foundPriority int
-	for _, lib := range resolver.headers[header] {
+	for _, lib := range candidates {
 		libPriority := ComputePriority(lib, header, architecture)
 		msg := "  discarded"
 		if found == nil || foundPriority < libPriority {
@@ -140,22 +195,32 @@ func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library
 			Infof(msg)
 	}
 	if found == nil {
-		return nil
+		return nil, ""
+	}
+	if len(candidates) < len(resolver.headers[header]) {
+		if len(found) > 1 {
+			found.SortByName()
+		}
+		return found[0], tr("selected in the sketch project file")
 	}
 	if len(found) == 1 {
-		return found[0]
+		if len(resolver.headers[header]) == 1 {
+			return found[0], tr("the only library providing the header")
+		}
+		reasons := PriorityReasons(found[0], header, architecture)
+		return found[0], tr("highest priority (%s)", strings.Join(reasons, ", "))
 	}
 
 	// If more than one library qualifies use the "closestmatch" algorithm to
 	// find the best matching one (instead of choosing it randomly)
 	if best := findLibraryWithNameBestDistance(header, found); best != nil {
 		logrus.WithField("lib", best.Name).Info("  library with the best matching name")
-		return best
+		return best, tr("same priority as other libraries, name closest to the header")
 	}
 
 	found.SortByName()
 	logrus.WithField("lib", found[0].Name).Info("  first library in alphabetic order")
-	return found[0]
+	return found[0], tr("same priority as other libraries, first in alphabetical order")
 }
 
 func simplify(name string) string {
@@ -168,58 +233,96 @@ func simplify(name string) string {
 // for the specified header and architecture. The higher the value, the higher the
 // priority.
 func ComputePriority(lib *libraries.Library, header, arch string) int {
+	priority, _ := computePriority(lib, header, arch)
+	return priority
+}
+
+// PriorityReasons returns a description of the factors determining the
+// priority computed by ComputePriority.
+func PriorityReasons(lib *libraries.Library, header, arch string) []string {
+	_, reasons := computePriority(lib, header, arch)
+	return reasons
+}
+
+func computePriority(lib *libraries.Library, header, arch string) (int, []string) {
 	header = strings.TrimSuffix(header, filepath.Ext(header))
 	header = simplify(header)
 	name := simplify(lib.Name)
 	dirName := simplify(lib.DirName)
 
 	priority := 0
+	reasons := []string{}
 
 	// Bonus for core-optimized libraries
 	if lib.IsOptimizedForArchitecture(arch) {
 		// give a slightly better bonus for libraries that have specific optimization
 		// (it is more important than Location but less important than Name)
 		priority += 1010
+		reasons = append(reasons, tr("optimized for %s", arch))
 	} else if lib.IsArchitectureIndependent() {
 		// standard bonus for architecture independent (vanilla) libraries
 		priority += 1000
+		reasons = append(reasons, tr("architecture independent"))
 	} else {
 		// the library is not architecture compatible
 		priority += 0
+		if arch == "" {
+			reasons = append(reasons, tr("architecture specific"))
+		} else {
+			reasons = append(reasons, tr("not compatible with %s", arch))
+		}
 	}
 
 	if name == header && dirName == header {
 		priority += 700
+		reasons = append(reasons, tr("name and folder matching the header"))
 	} else if name == header || dirName == header {
 		priority += 600
+		reasons = append(reasons, tr("name or folder matching the header"))
 	} else if name == header+"-main" || dirName == header+"-main" {
 		priority += 500
+		reasons = append(reasons, tr("name or folder matching the header with -main suffix"))
 	} else if name == header+"-master" || dirName == header+"-master" {
 		priority += 400
+		reasons = append(reasons, tr("name or folder matching the header with -master suffix"))
 	} else if strings.HasPrefix(name, header) || strings.HasPrefix(dirName, header) {
 		priority += 300
+		reasons = append(reasons, tr("name or folder starting with the header name"))
 	} else if strings.HasSuffix(name, header) || strings.HasSuffix(dirName, header) {
 		priority += 200
+		reasons = append(reasons, tr("name or folder ending with the header name"))
 	} else if strings.Contains(name, header) || strings.Contains(dirName, header) {
 		priority += 100
+		reasons = append(reasons, tr("name or folder containing the header name"))
 	}
 
 	switch lib.Location {
 	case libraries.IDEBuiltIn:
 		priority += 0
+		reasons = append(reasons, tr("bundled with the IDE"))
 	case libraries.ReferencedPlatformBuiltIn:
 		priority++
+		reasons = append(reasons, tr("bundled with the referenced platform"))
 	case libraries.PlatformBuiltIn:
 		priority += 2
+		reasons = append(reasons, tr("bundled with the platform"))
 	case libraries.User:
 		priority += 3
+		reasons = append(reasons, tr("installed in the sketchbook"))
+	case libraries.Profile:
+		priority += 3
+		reasons = append(reasons, tr("installed for the build profile"))
 	case libraries.Unmanaged:
 		// Bonus for libraries specified via --libraries flags, those libraries gets the highest priority
 		priority += 10000
+		reasons = append(reasons, tr("specified with --library"))
+	case libraries.Sketch:
+		priority += 10000
+		reasons = append(reasons, tr("specified with --library from the sketch folder"))
 	default:
 		panic(fmt.Sprintf("Invalid library location: %d", lib.Location))
 	}
-	return priority
+	return priority, reasons
 }
 
 func findLibraryWithNameBestDistance(name string, libs libraries.List) *libraries.Library {
@@ -162,3 +162,58 @@ func TestCppHeaderResolverWithLibrariesInStrangeDirectoryNames(t *testing.T) {
 	resolver.headers["OneWire.h"] = librarylist2
 	require.Equal(t, "OneWire", resolver.ResolveFor("OneWire.h", "avr").DirName)
 }
+
+func TestCppHeaderResolverReason(t *testing.T) {
+	userServo := &libraries.Library{Name: "Servo", DirName: "Servo", Location: libraries.User, Architectures: []string{"*"}}
+	forkServo := &libraries.Library{Name: "Servo Fork", DirName: "ServoFork", Location: libraries.User, Architectures: []string{"*"}}
+	otherServo := &libraries.Library{Name: "Servo", DirName: "Servo", Location: libraries.User, Architectures: []string{"*"}}
+
+	resolver := NewCppResolver()
+	resolver.headers["Servo.h"] = libraries.List{userServo}
+	resolver.headers["ServoEasing.h"] = libraries.List{forkServo}
+	require.Empty(t, resolver.AmbiguousHeaders())
+	lib, reason := resolver.ResolveForWithReason("Servo.h", "avr")
+	require.Equal(t, userServo, lib)
+	require.Equal(t, "the only library providing the header", reason)
+
+	resolver.headers["Servo.h"] = libraries.List{bundleServo, forkServo, userServo}
+	require.Equal(t, []string{"Servo.h"}, resolver.AmbiguousHeaders())
+	lib, reason = resolver.ResolveForWithReason("Servo.h", "avr")
+	require.Equal(t, userServo, lib)
+	require.Equal(t, "highest priority (architecture independent, name and folder matching the header, installed in the sketchbook)", reason)
+
+	resolver.headers["Servo.h"] = libraries.List{userServo, otherServo}
+	_, reason = resolver.ResolveForWithReason("Servo.h", "avr")
+	require.Equal(t, "same priority as other libraries, name closest to the header", reason)
+
+	require.Equal(t, []string{"optimized for avr", "name or folder matching the header", "bundled with the IDE"}, PriorityReasons(bundleServo, "Servo.h", "avr"))
+	require.Equal(t, []string{"not compatible with esp32", "name or folder matching the header", "bundled with the IDE"}, PriorityReasons(bundleServo, "Servo.h", "esp32"))
+	require.Equal(t, []string{"architecture specific", "name or folder matching the header", "bundled with the IDE"}, PriorityReasons(bundleServo, "Servo.h", ""))
+}
+
+func TestCppHeaderResolverSelection(t *testing.T) {
+	userServo := &libraries.Library{Name: "Servo", DirName: "Servo", Location: libraries.User, Architectures: []string{"*"}}
+	forkServo := &libraries.Library{Name: "Servo Fork", DirName: "ServoFork", Location: libraries.User, Architectures: []string{"*"}}
+
+	resolver := NewCppResolver()
+	resolver.headers["Servo.h"] = libraries.List{bundleServo, forkServo, userServo}
+	require.Nil(t, resolver.SelectedAlternativesFor("Servo.h"))
+	require.Equal(t, userServo, resolver.ResolveFor("Servo.h", "avr"))
+
+	resolver.SelectLibraryFor("Servo.h", func(lib *libraries.Library) bool { return lib.Name == "Servo Fork" })
+	require.Equal(t, libraries.List{forkServo}, resolver.SelectedAlternativesFor("Servo.h"))
+	lib, reason := resolver.ResolveForWithReason("Servo.h", "avr")
+	require.Equal(t, forkServo, lib)
+	require.Equal(t, "selected in the sketch project file", reason)
+
+	resolver.SelectLibraryFor("Servo.h", func(lib *libraries.Library) bool { return lib.Location == libraries.IDEBuiltIn })
+	require.Equal(t, bundleServo, resolver.ResolveFor("Servo.h", "avr"))
+	// The priority is used among the selected libraries
+	resolver.SelectLibraryFor("Servo.h", func(lib *libraries.Library) bool { return lib.Name == "Servo" })
+	require.Equal(t, userServo, resolver.ResolveFor("Servo.h", "avr"))
+
+	// A selection not matching any library is ignored
+	resolver.SelectLibraryFor("Servo.h", func(lib *libraries.Library) bool { return false })
+	require.Empty(t, resolver.SelectedAlternativesFor("Servo.h"))
+	require.Equal(t, userServo, resolver.ResolveFor("Servo.h", "avr"))
+}
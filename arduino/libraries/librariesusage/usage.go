@@ -0,0 +1,130 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package librariesusage records which libraries are used by the compilations
+// in a small local database, to find the libraries that are no more used.
+package librariesusage
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+)
+
+// Usage is the usage of an installed library
+type Usage struct {
+	Name     string    `json:"name"`
+	Version  string    `json:"version"`
+	LastUsed time.Time `json:"last_used"`
+	Compiles int       `json:"compiles"`
+}
+
+// Database contains the usage of the installed libraries, indexed by their
+// installation folder.
+type Database struct {
+	// Created is the time the recording of the usage has started
+	Created   time.Time         `json:"created"`
+	Libraries map[string]*Usage `json:"libraries"`
+}
+
+// mutex serializes the updates of the database from concurrent compilations
+var mutex sync.Mutex
+
+// Load loads the database from the given file. If the file doesn't exist an
+// empty database, created now, is returned.
+func Load(dbFile *paths.Path) (*Database, error) {
+	db := &Database{Created: time.Now(), Libraries: map[string]*Usage{}}
+	data, err := dbFile.ReadFile()
+	if os.IsNotExist(err) {
+		return db, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, db); err != nil {
+		return nil, err
+	}
+	if db.Libraries == nil {
+		db.Libraries = map[string]*Usage{}
+	}
+	return db, nil
+}
+
+// Record records in the database file that the libraries have been used by a
+// compilation at the given time.
+func Record(dbFile *paths.Path, libs libraries.List, now time.Time) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	db, err := Load(dbFile)
+	if err != nil {
+		return err
+	}
+	for _, lib := range libs {
+		if lib.InstallDir == nil {
+			continue
+		}
+		usage, ok := db.Libraries[lib.InstallDir.String()]
+		if !ok {
+			usage = &Usage{}
+			db.Libraries[lib.InstallDir.String()] = usage
+		}
+		usage.Name = lib.Name
+		usage.Version = lib.Version.String()
+		usage.LastUsed = now
+		usage.Compiles++
+	}
+
+	data, err := json.MarshalIndent(db, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := dbFile.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	tmp := dbFile.Parent().Join(dbFile.Base() + ".tmp")
+	if err := tmp.WriteFile(data); err != nil {
+		return err
+	}
+	return tmp.Rename(dbFile)
+}
+
+// Get returns the usage of the library, or nil if the library has never been
+// used since the recording started.
+func (db *Database) Get(lib *libraries.Library) *Usage {
+	if lib.InstallDir == nil {
+		return nil
+	}
+	return db.Libraries[lib.InstallDir.String()]
+}
+
+// LastActivity returns the last time the library has been used or, if it has
+// never been used, the time it has been installed or the recording started,
+// whichever is the latest.
+func (db *Database) LastActivity(lib *libraries.Library) time.Time {
+	if usage := db.Get(lib); usage != nil {
+		return usage.LastUsed
+	}
+	res := db.Created
+	if lib.InstallDir != nil {
+		if info, err := lib.InstallDir.Stat(); err == nil && info.ModTime().After(res) {
+			res = info.ModTime()
+		}
+	}
+	return res
+}
@@ -0,0 +1,60 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestRecord(t *testing.T) {
+	dir := paths.New(t.TempDir())
+	dbFile := dir.Join("data", "library_usage.json")
+	libA := &libraries.Library{Name: "A", Version: semver.MustParse("1.0.0"), InstallDir: dir.Join("A")}
+	libB := &libraries.Library{Name: "B", Version: semver.MustParse("2.0.0"), InstallDir: dir.Join("B")}
+	require.NoError(t, libA.InstallDir.MkdirAll())
+	require.NoError(t, libB.InstallDir.MkdirAll())
+
+	// Without a database the recording starts now
+	db, err := Load(dbFile)
+	require.NoError(t, err)
+	require.Nil(t, db.Get(libA))
+	require.WithinDuration(t, time.Now(), db.LastActivity(libA), time.Minute)
+
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, Record(dbFile, libraries.List{libA, libB}, t1))
+	require.NoError(t, Record(dbFile, libraries.List{libA}, t2))
+
+	db, err = Load(dbFile)
+	require.NoError(t, err)
+	require.Equal(t, 2, db.Get(libA).Compiles)
+	require.True(t, t2.Equal(db.Get(libA).LastUsed))
+	require.Equal(t, 1, db.Get(libB).Compiles)
+	require.True(t, t1.Equal(db.LastActivity(libB)))
+	require.Equal(t, "2.0.0", db.Get(libB).Version)
+
+	// A library never used is active since its installation
+	libC := &libraries.Library{Name: "C", InstallDir: dir.Join("C")}
+	require.NoError(t, libC.InstallDir.MkdirAll())
+	require.Nil(t, db.Get(libC))
+	require.WithinDuration(t, time.Now(), db.LastActivity(libC), time.Minute)
+}
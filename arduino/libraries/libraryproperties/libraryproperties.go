@@ -0,0 +1,377 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+// Package libraryproperties reads and edits the library.properties file of a
+// library. The file is edited line by line, to keep its comments and the
+// order of its fields, and the values are validated with the rules of the
+// Library Manager.
+package libraryproperties
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+var tr = i18n.Tr
+
+// Field is a field of a library.properties file
+type Field struct {
+	Name  string
+	Value string
+}
+
+// File is the content of a library.properties file
+type File struct {
+	lines []string
+}
+
+// Load reads a library.properties file
+func Load(file *paths.Path) (*File, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data), nil
+}
+
+// Parse reads the content of a library.properties file
+func Parse(data []byte) *File {
+	content := strings.ReplaceAll(string(data), "\r\n", "\n")
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	if content == "" {
+		lines = nil
+	}
+	return &File{lines: lines}
+}
+
+// Bytes returns the content of the file
+func (f *File) Bytes() []byte {
+	if len(f.lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(f.lines, "\n") + "\n")
+}
+
+// Save writes the file
+func (f *File) Save(file *paths.Path) error {
+	return file.WriteFile(f.Bytes())
+}
+
+// parseLine returns the field defined in the line, if any
+func parseLine(line string) (string, string, bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return "", "", false
+	}
+	name, value, ok := strings.Cut(trimmed, "=")
+	if !ok {
+		return "", "", false
+	}
+	return strings.TrimSpace(name), strings.TrimSpace(value), true
+}
+
+// find returns the index of the last line defining the field, that is the
+// one used when the file is loaded, or -1
+func (f *File) find(name string) int {
+	for i := len(f.lines) - 1; i >= 0; i-- {
+		if n, _, ok := parseLine(f.lines[i]); ok && n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Fields returns the fields of the file, in the order they are defined
+func (f *File) Fields() []*Field {
+	res := []*Field{}
+	index := map[string]*Field{}
+	for _, line := range f.lines {
+		name, value, ok := parseLine(line)
+		if !ok {
+			continue
+		}
+		if field, ok := index[name]; ok {
+			field.Value = value
+			continue
+		}
+		field := &Field{Name: name, Value: value}
+		index[name] = field
+		res = append(res, field)
+	}
+	return res
+}
+
+// Get returns the value of a field and true if it's defined
+func (f *File) Get(name string) (string, bool) {
+	i := f.find(name)
+	if i == -1 {
+		return "", false
+	}
+	_, value, _ := parseLine(f.lines[i])
+	return value, true
+}
+
+// Set changes the value of a field after validating it, the field is
+// appended to the file if not defined.
+func (f *File) Set(name, value string) error {
+	value = strings.TrimSpace(value)
+	if err := Validate(name, value); err != nil {
+		return err
+	}
+	line := name + "=" + value
+	if i := f.find(name); i != -1 {
+		f.lines[i] = line
+	} else {
+		f.lines = append(f.lines, line)
+	}
+	return nil
+}
+
+// Unset removes all the definitions of a field, it returns false if the
+// field was not defined. The mandatory fields can't be removed.
+func (f *File) Unset(name string) (bool, error) {
+	for _, mandatory := range libraries.MandatoryProperties {
+		if name == mandatory {
+			return false, errors.New(tr("the field %s is mandatory", name))
+		}
+	}
+	found := false
+	lines := []string{}
+	for _, line := range f.lines {
+		if n, _, ok := parseLine(line); ok && n == name {
+			found = true
+			continue
+		}
+		lines = append(lines, line)
+	}
+	f.lines = lines
+	return found, nil
+}
+
+// Check validates all the fields of the file and checks that the mandatory
+// ones are defined.
+func (f *File) Check() error {
+	for _, name := range append(libraries.MandatoryProperties, "sentence") {
+		if _, ok := f.Get(name); !ok {
+			return errors.New(tr("missing mandatory field %s", name))
+		}
+	}
+	for _, field := range f.Fields() {
+		if err := Validate(field.Name, field.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var validFieldName = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+var validLibraryName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9 _.-]*$`)
+
+// Validate checks the value of a field of the library.properties
+func Validate(name, value string) error {
+	if !validFieldName.MatchString(name) {
+		return errors.New(tr("invalid field name '%s'", name))
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return errors.New(tr("the value of the field %s can't contain line breaks", name))
+	}
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%s: %s", tr("invalid %[1]s '%[2]s'", name, value), tr(format, args...))
+	}
+	switch name {
+	case "name":
+		if !validLibraryName.MatchString(value) {
+			return invalid("must start with a letter or a number and contain only letters, numbers, spaces, underscores, dots and dashes")
+		}
+		if len(value) > 63 {
+			return invalid("must be at most 63 characters long")
+		}
+	case "version":
+		if value == "" {
+			return invalid("can't be empty")
+		}
+		if _, err := semver.Parse(value); err != nil {
+			return invalid("%v", err)
+		}
+	case "author", "maintainer", "sentence":
+		if value == "" {
+			return invalid("can't be empty")
+		}
+	case "category":
+		if !libraries.ValidCategories[value] {
+			categories := []string{}
+			for category := range libraries.ValidCategories {
+				categories = append(categories, category)
+			}
+			sort.Strings(categories)
+			return invalid("must be one of: %s", strings.Join(categories, ", "))
+		}
+	case "url":
+		if u, err := url.Parse(value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return invalid("must be an http or https URL")
+		}
+	case "architectures":
+		archs := splitList(value)
+		if len(archs) == 0 {
+			return invalid("can't be empty")
+		}
+		for _, arch := range archs {
+			if strings.ContainsAny(arch, " \t") {
+				return invalid("the architectures must be separated by commas")
+			}
+		}
+	case "depends":
+		if _, err := librariesindex.ParseDependencies(value); err != nil {
+			return invalid("%v", err)
+		}
+	case "dot_a_linkage":
+		if value != "true" && value != "false" {
+			return invalid("must be true or false")
+		}
+	case "precompiled":
+		if value != "true" && value != "full" && value != "false" {
+			return invalid("must be true, full or false")
+		}
+	case "includes":
+		for _, header := range splitList(value) {
+			if !globals.HeaderFilesValidExtensions[filepath.Ext(header)] {
+				return invalid("%s is not a header file", header)
+			}
+		}
+	}
+	return nil
+}
+
+// splitList returns the items of a comma separated list
+func splitList(value string) []string {
+	res := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// The parts of the version that can be bumped
+const (
+	Major = "major"
+	Minor = "minor"
+	Patch = "patch"
+)
+
+var bumpableVersion = regexp.MustCompile(`^(\d+)(?:\.(\d+))?(?:\.(\d+))?(-[^+]*)?(?:\+.*)?$`)
+
+// BumpVersion increments a part of the version, resetting the following
+// ones and dropping the pre-release and build metadata. As in semver, the
+// pre-release of a version is released without incrementing it, e.g. bumping
+// the patch version of 1.2.3-rc1 gives 1.2.3, and the minor one 1.3.0.
+func BumpVersion(version, part string) (string, error) {
+	match := bumpableVersion.FindStringSubmatch(strings.TrimSpace(version))
+	if match == nil {
+		return "", errors.New(tr("invalid version '%s'", version))
+	}
+	parts := [3]int{}
+	for i := range parts {
+		if match[i+1] != "" {
+			n, err := strconv.Atoi(match[i+1])
+			if err != nil {
+				return "", errors.New(tr("invalid version '%s'", version))
+			}
+			parts[i] = n
+		}
+	}
+	preRelease := match[4] != ""
+	switch part {
+	case Major:
+		if !preRelease || parts[1] != 0 || parts[2] != 0 {
+			parts[0]++
+		}
+		parts[1], parts[2] = 0, 0
+	case Minor:
+		if !preRelease || parts[2] != 0 {
+			parts[1]++
+		}
+		parts[2] = 0
+	case Patch:
+		if !preRelease {
+			parts[2]++
+		}
+	default:
+		return "", errors.New(tr("invalid version part '%s', must be one of: %s", part, strings.Join([]string{Major, Minor, Patch}, ", ")))
+	}
+	return fmt.Sprintf("%d.%d.%d", parts[0], parts[1], parts[2]), nil
+}
+
+// AddArchitectures adds the architectures missing from the list. Adding an
+// architecture to the "*" wildcard, that means all the architectures, is
+// not allowed.
+func AddArchitectures(list string, archs []string) (string, error) {
+	current := splitList(list)
+	for _, arch := range archs {
+		arch = strings.TrimSpace(arch)
+		if arch == "" || strings.ContainsAny(arch, ", \t") {
+			return "", errors.New(tr("invalid architecture '%s'", arch))
+		}
+		if contains(current, arch) {
+			continue
+		}
+		if arch == "*" {
+			current = []string{"*"}
+			continue
+		}
+		if contains(current, "*") {
+			return "", errors.New(tr("the library already supports all the architectures (*), remove the wildcard before adding %s", arch))
+		}
+		current = append(current, arch)
+	}
+	return strings.Join(current, ","), nil
+}
+
+// RemoveArchitectures removes the architectures from the list, the last one
+// can't be removed.
+func RemoveArchitectures(list string, archs []string) (string, error) {
+	res := []string{}
+	for _, arch := range splitList(list) {
+		if !contains(archs, arch) {
+			res = append(res, arch)
+		}
+	}
+	if len(res) == 0 {
+		return "", errors.New(tr("the library must support at least one architecture"))
+	}
+	return strings.Join(res, ","), nil
+}
+
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraryproperties
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdit(t *testing.T) {
+	file, err := Load(paths.New("testdata", "library.properties"))
+	require.NoError(t, err)
+	require.NoError(t, file.Check())
+
+	version, ok := file.Get("version")
+	require.True(t, ok)
+	require.Equal(t, "1.2.3-rc1", version)
+	_, ok = file.Get("depends")
+	require.False(t, ok)
+	require.Len(t, file.Fields(), 9)
+	require.Equal(t, &Field{Name: "architectures", Value: "avr, samd"}, file.Fields()[8])
+
+	require.NoError(t, file.Set("version", "1.3.0"))
+	require.NoError(t, file.Set("depends", "Servo (>=1.1.0), Adafruit GFX Library"))
+	require.Error(t, file.Set("version", "one"))
+	require.Error(t, file.Set("category", "Leds"))
+	require.Error(t, file.Set("url", "www.arduino.cc"))
+	require.Error(t, file.Set("includes", "Led.cpp"))
+	require.Error(t, file.Set("sentence", "Blinks\nthe leds."))
+	require.Error(t, file.Set("bad name", "value"))
+
+	removed, err := file.Unset("paragraph")
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = file.Unset("paragraph")
+	require.NoError(t, err)
+	require.False(t, removed)
+	_, err = file.Unset("maintainer")
+	require.Error(t, err)
+
+	require.Equal(t, `# The Led library
+name=Led
+version=1.3.0
+author=Arduino
+maintainer=Arduino <info@arduino.cc>
+sentence=Blinks the leds.
+category=Display
+url=https://www.arduino.cc
+architectures=avr, samd
+depends=Servo (>=1.1.0), Adafruit GFX Library
+`, string(file.Bytes()))
+	require.NoError(t, file.Check())
+
+	require.EqualError(t, Parse([]byte("name=Led\nversion=1.0.0\n")).Check(), "missing mandatory field author")
+}
+
+func TestBumpVersion(t *testing.T) {
+	for _, test := range []struct{ version, part, res string }{
+		{"1.2.3", Patch, "1.2.4"},
+		{"1.2.3", Minor, "1.3.0"},
+		{"1.2.3", Major, "2.0.0"},
+		{"1.2.3+build5", Patch, "1.2.4"},
+		{"1.2.3-rc1+build5", Minor, "1.3.0"},
+		{"1.2.3-rc1", Patch, "1.2.3"},
+		{"1.3.0-rc1", Minor, "1.3.0"},
+		{"2.0.0-beta", Major, "2.0.0"},
+		{"2.1.0-beta", Major, "3.0.0"},
+		{"1.2", Patch, "1.2.1"},
+		{"1", Minor, "1.1.0"},
+	} {
+		res, err := BumpVersion(test.version, test.part)
+		require.NoError(t, err)
+		require.Equal(t, test.res, res, "bumping %s of %s", test.part, test.version)
+	}
+	_, err := BumpVersion("v1.2.3", Patch)
+	require.Error(t, err)
+	_, err = BumpVersion("1.2.3", "build")
+	require.Error(t, err)
+}
+
+func TestArchitectures(t *testing.T) {
+	res, err := AddArchitectures("avr, samd", []string{"samd", "mbed_nano"})
+	require.NoError(t, err)
+	require.Equal(t, "avr,samd,mbed_nano", res)
+	res, err = AddArchitectures("avr", []string{"*"})
+	require.NoError(t, err)
+	require.Equal(t, "*", res)
+	_, err = AddArchitectures("*", []string{"avr"})
+	require.Error(t, err)
+	_, err = AddArchitectures("avr", []string{"sam d"})
+	require.Error(t, err)
+
+	res, err = RemoveArchitectures("avr,samd,mbed_nano", []string{"samd"})
+	require.NoError(t, err)
+	require.Equal(t, "avr,mbed_nano", res)
+	_, err = RemoveArchitectures("avr", []string{"avr"})
+	require.Error(t, err)
+}
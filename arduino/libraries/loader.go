@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/go-paths-helper"
 	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
@@ -129,6 +130,7 @@ func makeNewLibrary(libraryDir *paths.Path, location LibraryLocation) (*Library,
 	library.LDflags = strings.TrimSpace(libProperties.Get("ldflags"))
 	library.Properties = libProperties
 	library.InDevelopment = libraryDir.Join(".development").Exist()
+	library.InstallSource, library.InstallSourceURL = readInstallSource(libraryDir)
 	return library, nil
 }
 
@@ -150,6 +152,7 @@ func makeLegacyLibrary(path *paths.Path, location LibraryLocation) (*Library, er
 		Version:       semver.MustParse(""),
 		InDevelopment: path.Join(".development").Exist(),
 	}
+	library.InstallSource, library.InstallSourceURL = readInstallSource(path)
 	if err := addExamples(library); err != nil {
 		return nil, errors.Errorf(tr("scanning examples: %s"), err)
 	}
@@ -182,7 +185,7 @@ func addExamples(lib *Library) error {
 }
 
 func addExamplesToPathList(examplesPath *paths.Path, list *paths.PathList) error {
-	files, err := examplesPath.ReadDirRecursiveFiltered(nil, paths.AndFilter(paths.FilterDirectories(), filterExamplesDirs))
+	files, err := utils.ReadDirRecursiveFiltered(examplesPath, utils.SymlinksFollow, nil, paths.AndFilter(paths.FilterDirectories(), filterExamplesDirs))
 	if err != nil {
 		return err
 	}
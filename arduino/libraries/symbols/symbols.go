@@ -0,0 +1,730 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package symbols finds the symbols declared in the C/C++ headers of the
+// libraries. It is not a C++ parser: the headers are tokenized and the
+// declarations are recognized with some heuristics, that work well enough
+// with the public headers of the libraries.
+package symbols
+
+import (
+	"strings"
+)
+
+// Kind is the kind of a declared symbol
+type Kind int
+
+const (
+	// Class is a class, a struct or a union
+	Class Kind = iota
+	// Type is an enum, a typedef or a type alias
+	Type
+	// Function is a function
+	Function
+	// Variable is a global variable or object
+	Variable
+	// Macro is a preprocessor macro
+	Macro
+	// Method is a public member function of a class, reported only by
+	// ScanMembers
+	Method
+	// Enumerator is a constant of an enum, reported only by ScanMembers
+	Enumerator
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Class:
+		return "class"
+	case Type:
+		return "type"
+	case Function:
+		return "function"
+	case Variable:
+		return "variable"
+	case Macro:
+		return "macro"
+	case Method:
+		return "method"
+	case Enumerator:
+		return "enumerator"
+	}
+	return "unknown"
+}
+
+// Declaration is a symbol declared in a header
+type Declaration struct {
+	// Name is the identifier of the symbol
+	Name string
+	// Namespace is the namespace containing the symbol, the nested namespaces
+	// are separated by "::"
+	Namespace string
+	Kind      Kind
+	// Line is the line of the declaration, starting from 1
+	Line int
+}
+
+// QualifiedName returns the name of the symbol prefixed by its namespace
+func (d *Declaration) QualifiedName() string {
+	if d.Namespace == "" {
+		return d.Name
+	}
+	return d.Namespace + "::" + d.Name
+}
+
+// Matches returns true if the symbol is the given identifier, that may be
+// qualified with the namespace (e.g. "File" or "fs::File").
+func (d *Declaration) Matches(identifier string) bool {
+	return d.Name == identifier || d.QualifiedName() == strings.TrimPrefix(identifier, "::")
+}
+
+// IsIdentifier returns true if the string is a valid C/C++ identifier,
+// optionally qualified with a namespace.
+func IsIdentifier(s string) bool {
+	for _, part := range strings.Split(strings.TrimPrefix(s, "::"), "::") {
+		if part == "" || !isIdentifierStart(part[0]) {
+			return false
+		}
+		for i := 1; i < len(part); i++ {
+			if !isIdentifierChar(part[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Scan returns the symbols declared in the global scope or in the namespaces
+// of a C/C++ source. The members of the classes, the forward declarations and
+// the macros without a value (like the include guards) are ignored.
+func Scan(source []byte) []*Declaration {
+	return scan(source, false)
+}
+
+// ScanMembers returns the same symbols of Scan, the public methods of the
+// classes, excluding the constructors, the destructors and the operators, and
+// the constants of the enums. The namespace of a method includes the name of
+// its class, the one of the constants of a scoped enum the name of the enum.
+func ScanMembers(source []byte) []*Declaration {
+	return scan(source, true)
+}
+
+func scan(source []byte, members bool) []*Declaration {
+	tokens, macros := tokenize(source)
+	p := &parser{members: members}
+	for _, tok := range tokens {
+		p.feed(tok)
+	}
+	res := append(macros, p.declarations...)
+	sortByLine(res)
+	return res
+}
+
+func sortByLine(decls []*Declaration) {
+	// insertion sort keeps the order of the declarations on the same line
+	for i := 1; i < len(decls); i++ {
+		for j := i; j > 0 && decls[j].Line < decls[j-1].Line; j-- {
+			decls[j], decls[j-1] = decls[j-1], decls[j]
+		}
+	}
+}
+
+type token struct {
+	text string
+	line int
+}
+
+func (t token) isIdentifier() bool {
+	return t.text != "" && isIdentifierStart(t.text[0]) && !keywords[t.text]
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || (c >= '0' && c <= '9')
+}
+
+// keywords are the words that can't be the name of a symbol
+var keywords = map[string]bool{
+	"alignas": true, "alignof": true, "auto": true, "bool": true, "char": true, "class": true,
+	"const": true, "constexpr": true, "decltype": true, "delete": true, "double": true, "enum": true,
+	"explicit": true, "extern": true, "float": true, "friend": true, "inline": true, "int": true,
+	"long": true, "namespace": true, "new": true, "noexcept": true, "operator": true, "register": true,
+	"return": true, "short": true, "signed": true, "sizeof": true, "static": true, "static_assert": true,
+	"struct": true, "template": true, "throw": true, "typedef": true, "typename": true, "union": true,
+	"unsigned": true, "using": true, "virtual": true, "void": true, "volatile": true,
+	"__attribute__": true, "__declspec": true, "PROGMEM": true,
+}
+
+// attributes are the keywords followed by a parenthesized argument that may
+// appear in a declaration before the name of the symbol
+var attributes = map[string]bool{
+	"__attribute__": true, "__declspec": true, "alignas": true, "decltype": true,
+}
+
+// tokenize splits the source in tokens, dropping the comments and the
+// preprocessor directives. The macros defined in the source are returned
+// separately.
+func tokenize(src []byte) ([]token, []*Declaration) {
+	tokens := []token{}
+	macros := []*Declaration{}
+	line := 1
+	lineStart := true
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			lineStart = true
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(src) && src[i+1] == '*':
+			i += 2
+			for i < len(src) && !(src[i] == '*' && i+1 < len(src) && src[i+1] == '/') {
+				if src[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			i += 2
+		case c == '#' && lineStart:
+			// Read the whole directive, including the continuation lines
+			start, startLine := i+1, line
+			for i < len(src) && src[i] != '\n' {
+				if src[i] == '\\' && i+1 < len(src) && src[i+1] == '\n' {
+					line++
+					i++
+				}
+				i++
+			}
+			if macro := parseDefine(string(src[start:i])); macro != nil {
+				macro.Line = startLine
+				macros = append(macros, macro)
+			}
+		case c == '"' || c == '\'':
+			i++
+			for i < len(src) && src[i] != c && src[i] != '\n' {
+				if src[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+			tokens = append(tokens, token{`""`, line})
+			lineStart = false
+		case isIdentifierStart(c):
+			start := i
+			for i < len(src) && isIdentifierChar(src[i]) {
+				i++
+			}
+			tokens = append(tokens, token{string(src[start:i]), line})
+			lineStart = false
+		case c >= '0' && c <= '9':
+			for i < len(src) && (isIdentifierChar(src[i]) || src[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{"0", line})
+			lineStart = false
+		case c == ':' && i+1 < len(src) && src[i+1] == ':':
+			tokens = append(tokens, token{"::", line})
+			i += 2
+			lineStart = false
+		default:
+			tokens = append(tokens, token{string(c), line})
+			i++
+			lineStart = false
+		}
+	}
+	return tokens, macros
+}
+
+// parseDefine returns the macro defined by the preprocessor directive, or nil
+// if the directive is not a #define or the macro has no value.
+func parseDefine(directive string) *Declaration {
+	directive = strings.TrimSpace(directive)
+	if !strings.HasPrefix(directive, "define") {
+		return nil
+	}
+	directive = strings.TrimPrefix(directive, "define")
+	if directive == "" || (directive[0] != ' ' && directive[0] != '\t') {
+		return nil
+	}
+	directive = strings.TrimSpace(directive)
+	end := 0
+	for end < len(directive) && isIdentifierChar(directive[end]) {
+		end++
+	}
+	name, value := directive[:end], directive[end:]
+	if name == "" || !isIdentifierStart(name[0]) {
+		return nil
+	}
+	if strings.HasPrefix(value, "(") {
+		// function-like macro
+		if idx := strings.Index(value, ")"); idx != -1 {
+			value = value[idx+1:]
+		}
+	}
+	value = strings.TrimSpace(strings.ReplaceAll(value, "\\\n", " "))
+	if value == "" || strings.HasPrefix(value, "//") || strings.HasPrefix(value, "/*") {
+		return nil
+	}
+	return &Declaration{Name: name, Kind: Macro}
+}
+
+// parser recognizes the declarations in the stream of tokens, one statement
+// at a time. The bodies of the classes, of the functions and the initializers
+// are skipped, only the namespaces and the extern "C" blocks are entered (and
+// the bodies of the classes when scanning the members).
+type parser struct {
+	declarations []*Declaration
+	scopes       []scope
+	members      bool
+	statement    []token
+	// skipDepth is the nesting level of the braces being skipped
+	skipDepth int
+	// keepStatement is set when the statement continues after the skipped
+	// braces, like in a typedef of a struct or in an initializer
+	keepStatement bool
+}
+
+// scope is a namespace, an extern "C" block or the body of a class or of an
+// enum
+type scope struct {
+	name  string
+	class bool
+	enum  bool
+	// typedef is the statement of a typedef of an enum, to be continued after
+	// the body
+	typedef []token
+	// public is set when the following members of the class are public
+	public bool
+}
+
+// class returns the class whose body is being scanned, if any
+func (p *parser) class() *scope {
+	if len(p.scopes) == 0 || !p.scopes[len(p.scopes)-1].class {
+		return nil
+	}
+	return &p.scopes[len(p.scopes)-1]
+}
+
+func (p *parser) feed(tok token) {
+	if p.skipDepth > 0 {
+		switch tok.text {
+		case "{":
+			p.skipDepth++
+		case "}":
+			p.skipDepth--
+			if p.skipDepth == 0 && !p.keepStatement {
+				p.statement = nil
+			}
+		}
+		return
+	}
+
+	if len(p.scopes) > 0 && p.scopes[len(p.scopes)-1].enum {
+		switch tok.text {
+		case ",", "}":
+			if len(p.statement) > 0 && p.statement[0].isIdentifier() {
+				p.add(p.statement[0], Enumerator)
+			}
+			p.statement = nil
+			if tok.text == "}" {
+				p.statement = p.scopes[len(p.scopes)-1].typedef
+				p.scopes = p.scopes[:len(p.scopes)-1]
+			}
+		default:
+			p.statement = append(p.statement, tok)
+		}
+		return
+	}
+
+	class := p.class()
+	switch tok.text {
+	case "{":
+		if p.members && p.enumOpening(trimStatement(p.statement)) {
+			p.statement = nil
+			return
+		}
+		if class != nil {
+			// the bodies of the methods and of the nested types are skipped
+			p.member(trimStatement(p.statement))
+			p.keepStatement = false
+			p.skipDepth = 1
+			return
+		}
+		if name, ok := namespaceOpening(p.statement); ok {
+			p.scopes = append(p.scopes, scope{name: name})
+			p.statement = nil
+			return
+		}
+		if p.members {
+			if name, public, ok := classOpening(trimStatement(p.statement)); ok {
+				p.add(name, Class)
+				p.scopes = append(p.scopes, scope{name: name.text, class: true, public: public})
+				p.statement = nil
+				return
+			}
+		}
+		p.keepStatement = p.definition(trimStatement(p.statement))
+		p.skipDepth = 1
+	case "}":
+		if len(p.scopes) > 0 {
+			p.scopes = p.scopes[:len(p.scopes)-1]
+		}
+		p.statement = nil
+	case ";":
+		if class != nil {
+			p.member(trimStatement(p.statement))
+		} else {
+			p.declaration(trimStatement(p.statement))
+		}
+		p.statement = nil
+		p.keepStatement = false
+	case ":":
+		if class != nil && len(p.statement) == 1 {
+			// access specifier
+			switch p.statement[0].text {
+			case "public":
+				class.public = true
+				p.statement = nil
+				return
+			case "private", "protected":
+				class.public = false
+				p.statement = nil
+				return
+			}
+		}
+		p.statement = append(p.statement, tok)
+	default:
+		p.statement = append(p.statement, tok)
+	}
+}
+
+// classOpening returns true if the statement opens the body of a named class,
+// with the name of the class and whether its members are public by default.
+func classOpening(stmt []token) (token, bool, bool) {
+	if len(stmt) == 0 || (stmt[0].text != "class" && stmt[0].text != "struct") {
+		return token{}, false, false
+	}
+	name, ok := nameAfter(stmt, 1)
+	return name, stmt[0].text == "struct", ok
+}
+
+// enumOpening returns true if the statement opens the body of an enum to be
+// scanned, that is declared out of the classes or in their public section,
+// and enters it.
+func (p *parser) enumOpening(stmt []token) bool {
+	typedef := len(stmt) > 1 && stmt[0].text == "typedef"
+	if typedef {
+		stmt = stmt[1:]
+	}
+	if len(stmt) == 0 || stmt[0].text != "enum" {
+		return false
+	}
+	class := p.class()
+	if class != nil && !class.public {
+		return false
+	}
+	i, scoped := 1, false
+	if i < len(stmt) && (stmt[i].text == "class" || stmt[i].text == "struct") {
+		i, scoped = i+1, true
+	}
+	name, named := nameAfter(stmt, i)
+	if named && class == nil && !typedef {
+		p.add(name, Type)
+	}
+	// the constants of the unscoped enums are in the enclosing namespace
+	sc := scope{enum: true}
+	if typedef {
+		sc.typedef = p.statement
+	}
+	if scoped {
+		sc.name = name.text
+	}
+	p.scopes = append(p.scopes, sc)
+	return true
+}
+
+// member handles a statement in the body of a class, that is a method if it's
+// a public member function.
+func (p *parser) member(stmt []token) {
+	class := p.class()
+	if !class.public || len(stmt) == 0 {
+		return
+	}
+	switch stmt[0].text {
+	case "typedef", "using", "friend", "class", "struct", "union", "enum", "static_assert":
+		return
+	}
+	// the constructors have no return type and the destructors have the
+	// name of the class
+	if name, ok := functionName(stmt); ok && name.text != class.name {
+		p.add(name, Method)
+	}
+}
+
+func (p *parser) add(name token, kind Kind) {
+	namespace := ""
+	for _, ns := range p.scopes {
+		if ns.name == "" {
+			continue
+		}
+		if namespace != "" {
+			namespace += "::"
+		}
+		namespace += ns.name
+	}
+	p.declarations = append(p.declarations, &Declaration{
+		Name:      name.text,
+		Namespace: namespace,
+		Kind:      kind,
+		Line:      name.line,
+	})
+}
+
+// trimStatement removes the template parameters, the linkage specifications
+// and the attributes at the beginning of the statement.
+func trimStatement(stmt []token) []token {
+	for len(stmt) > 0 {
+		switch {
+		case stmt[0].text == "template" && len(stmt) > 1 && stmt[1].text == "<":
+			stmt = stmt[skipGroup(stmt, 1):]
+		case stmt[0].text == "extern" && len(stmt) > 1 && stmt[1].text == `""`:
+			stmt = stmt[2:]
+		case attributes[stmt[0].text] && len(stmt) > 1 && stmt[1].text == "(":
+			stmt = stmt[skipGroup(stmt, 1):]
+		default:
+			return stmt
+		}
+	}
+	return stmt
+}
+
+// skipGroup returns the index of the token following the group of balanced
+// parentheses or angle brackets starting at index start.
+func skipGroup(stmt []token, start int) int {
+	open := stmt[start].text
+	close := map[string]string{"(": ")", "<": ">", "[": "]"}[open]
+	depth := 0
+	for i := start; i < len(stmt); i++ {
+		switch stmt[i].text {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(stmt)
+}
+
+// namespaceOpening returns true if the statement opens a block that must be
+// entered, that is a namespace or an extern "C" block, and the name of the
+// namespace.
+func namespaceOpening(stmt []token) (string, bool) {
+	if len(stmt) == 2 && stmt[0].text == "extern" && stmt[1].text == `""` {
+		return "", true
+	}
+	if len(stmt) > 0 && stmt[0].text == "inline" {
+		stmt = stmt[1:]
+	}
+	if len(stmt) == 0 || stmt[0].text != "namespace" {
+		return "", false
+	}
+	name := ""
+	for _, tok := range stmt[1:] {
+		name += tok.text
+	}
+	return name, true
+}
+
+// definition handles a statement followed by a body, and returns true if the
+// statement continues after the body.
+func (p *parser) definition(stmt []token) bool {
+	if len(stmt) == 0 {
+		return false
+	}
+	switch stmt[0].text {
+	case "typedef":
+		return true
+	case "class", "struct", "union":
+		if name, ok := nameAfter(stmt, 1); ok {
+			p.add(name, Class)
+		}
+		return false
+	case "enum":
+		i := 1
+		if i < len(stmt) && (stmt[i].text == "class" || stmt[i].text == "struct") {
+			i++
+		}
+		if name, ok := nameAfter(stmt, i); ok {
+			p.add(name, Type)
+		}
+		return false
+	}
+	if indexOf(stmt, "=") != -1 {
+		// brace initializer, the statement ends with the semicolon
+		return true
+	}
+	if name, ok := functionName(stmt); ok {
+		p.add(name, Function)
+	}
+	return false
+}
+
+// declaration handles a statement terminated by a semicolon.
+func (p *parser) declaration(stmt []token) {
+	if len(stmt) == 0 {
+		return
+	}
+	switch stmt[0].text {
+	case "typedef":
+		if name, ok := typedefName(stmt); ok {
+			p.add(name, Type)
+		}
+		return
+	case "using":
+		if len(stmt) > 2 && stmt[1].isIdentifier() && stmt[2].text == "=" {
+			p.add(stmt[1], Type)
+		}
+		return
+	case "class", "struct", "union", "enum", "friend", "namespace", "static_assert":
+		// forward declarations and other statements not declaring symbols
+		return
+	}
+
+	end := len(stmt)
+	for i := 0; i < len(stmt); i++ {
+		if attributes[stmt[i].text] && i+1 < len(stmt) && stmt[i+1].text == "(" {
+			i = skipGroup(stmt, i+1) - 1
+			continue
+		}
+		if stmt[i].text == "=" || stmt[i].text == "[" || stmt[i].text == "," {
+			end = i
+			break
+		}
+		if stmt[i].text == "(" {
+			if i+2 < len(stmt) && stmt[i+1].text == "*" && stmt[i+2].isIdentifier() {
+				// pointer to function
+				p.add(stmt[i+2], Variable)
+				return
+			}
+			if name, ok := functionName(stmt); ok {
+				p.add(name, Function)
+			}
+			return
+		}
+	}
+	for i := end - 1; i > 0; i-- {
+		if stmt[i].text == "PROGMEM" {
+			continue
+		}
+		if stmt[i].isIdentifier() && stmt[i-1].text != "::" {
+			p.add(stmt[i], Variable)
+		}
+		return
+	}
+}
+
+// functionName returns the name of the function declared by the statement,
+// the name is the identifier before the first parenthesis preceded by the
+// return type. The definitions of the class members are ignored.
+func functionName(stmt []token) (token, bool) {
+	for i := 1; i < len(stmt); i++ {
+		if stmt[i].text == "<" {
+			i = skipGroup(stmt, i) - 1
+			continue
+		}
+		if stmt[i].text != "(" {
+			continue
+		}
+		name := stmt[i-1]
+		if attributes[name.text] {
+			i = skipGroup(stmt, i) - 1
+			continue
+		}
+		if !name.isIdentifier() || i < 2 || stmt[i-2].text == "::" || stmt[i-2].text == "operator" {
+			return token{}, false
+		}
+		return name, true
+	}
+	return token{}, false
+}
+
+// nameAfter returns the name of the class or enum declared with the keyword
+// preceding the index start: it's the last identifier before the base
+// classes, the underlying type or the template arguments, so that the
+// attributes and the export macros are skipped.
+func nameAfter(stmt []token, start int) (token, bool) {
+	var name token
+	found := false
+	for i := start; i < len(stmt); i++ {
+		tok := stmt[i]
+		if attributes[tok.text] && i+1 < len(stmt) && stmt[i+1].text == "(" {
+			i = skipGroup(stmt, i+1) - 1
+			continue
+		}
+		if tok.text == ":" || tok.text == "<" || tok.text == "final" {
+			break
+		}
+		if tok.isIdentifier() {
+			name, found = tok, true
+		} else if tok.text != "::" {
+			return token{}, false
+		}
+	}
+	return name, found
+}
+
+// typedefName returns the name of the type declared by a typedef statement.
+func typedefName(stmt []token) (token, bool) {
+	// typedef void (*name)(...);
+	for i := 0; i+2 < len(stmt); i++ {
+		if stmt[i].text == "(" && stmt[i+1].text == "*" && stmt[i+2].isIdentifier() {
+			return stmt[i+2], true
+		}
+	}
+	for i := len(stmt) - 1; i > 0; i-- {
+		if stmt[i].text == "[" {
+			continue
+		}
+		if stmt[i].isIdentifier() {
+			return stmt[i], true
+		}
+		if stmt[i].text != "]" && stmt[i].text != "0" {
+			return token{}, false
+		}
+	}
+	return token{}, false
+}
+
+func indexOf(stmt []token, text string) int {
+	for i, tok := range stmt {
+		if tok.text == text {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,188 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package symbols
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const header = `#ifndef __SD_H__
+#define __SD_H__
+
+#include <Arduino.h>
+#include "utility/SdFat.h"
+
+#define FILE_READ O_READ
+#define SD_MAX(a, b) ((a) > (b) ? (a) : (b))
+
+/* Multi line
+   comment */
+namespace SDLib {
+
+class File : public Stream {
+ private:
+  char _name[13]; // our name
+  SdFile *_file;  // underlying file pointer
+
+ public:
+  File(SdFile f, const char *name);
+  virtual size_t write(uint8_t);
+  int available() { return 0; }
+};
+
+class __attribute__((packed)) SDClass {
+ public:
+  bool begin(uint8_t csPin = SD_CHIP_SELECT_PIN);
+};
+
+extern SDClass SD;
+
+};
+
+using namespace SDLib;
+
+typedef SDLib::File SDFile;
+typedef struct {
+  int x;
+} Point;
+typedef void (*callback_t)(int value);
+using Handler = void (*)(void);
+
+enum class Mode : uint8_t { READ, WRITE };
+enum Color { RED, GREEN };
+
+extern "C" {
+void sd_init(void);
+uint8_t sd_status(int *err) __attribute__((weak));
+}
+
+extern "C" int sd_raw(const char* s);
+
+template <typename T>
+T sdMax(T a, T b) {
+  return a > b ? a : b;
+}
+
+static const int chipSelect PROGMEM = 4;
+const char *names[] = {"a", "b"};
+Stream &sdLog = Serial;
+inline bool SDClass::exists(const char *path) { return true; }
+class Forward;
+struct Point3 p;
+
+#endif
+`
+
+func TestScan(t *testing.T) {
+	res := []string{}
+	for _, decl := range Scan([]byte(header)) {
+		res = append(res, fmt.Sprintf("%d %s %s", decl.Line, decl.Kind, decl.QualifiedName()))
+	}
+	require.Equal(t, []string{
+		"7 macro FILE_READ",
+		"8 macro SD_MAX",
+		"14 class SDLib::File",
+		"25 class SDLib::SDClass",
+		"30 variable SDLib::SD",
+		"36 type SDFile",
+		"39 type Point",
+		"40 type callback_t",
+		"41 type Handler",
+		"43 type Mode",
+		"44 type Color",
+		"47 function sd_init",
+		"48 function sd_status",
+		"51 function sd_raw",
+		"54 function sdMax",
+		"58 variable chipSelect",
+		"59 variable names",
+		"60 variable sdLog",
+	}, res)
+}
+
+func TestScanMembers(t *testing.T) {
+	res := []string{}
+	for _, decl := range ScanMembers([]byte(header)) {
+		if decl.Kind == Class || decl.Kind == Method || decl.Kind == Enumerator {
+			res = append(res, fmt.Sprintf("%d %s %s", decl.Line, decl.Kind, decl.QualifiedName()))
+		}
+	}
+	require.Equal(t, []string{
+		"14 class SDLib::File",
+		"21 method SDLib::File::write",
+		"22 method SDLib::File::available",
+		"25 class SDLib::SDClass",
+		"27 method SDLib::SDClass::begin",
+		"43 enumerator Mode::READ",
+		"43 enumerator Mode::WRITE",
+		"44 enumerator RED",
+		"44 enumerator GREEN",
+	}, res)
+
+	// The other symbols are the same found by Scan
+	require.Len(t, ScanMembers([]byte(header)), len(Scan([]byte(header)))+7)
+
+	res = []string{}
+	for _, decl := range ScanMembers([]byte(`
+struct Led {
+  Led(int pin);
+  ~Led();
+  void on();
+  bool operator==(const Led &other) const;
+ protected:
+  void set(bool value);
+ public:
+  static Led *find(int pin);
+  enum State { ON, OFF };
+  int pin;
+};
+typedef enum { LOW_POWER = 1, FULL_POWER } power_t;
+`)) {
+		res = append(res, fmt.Sprintf("%d %s %s", decl.Line, decl.Kind, decl.QualifiedName()))
+	}
+	require.Equal(t, []string{
+		"2 class Led",
+		"5 method Led::on",
+		"10 method Led::find",
+		"11 enumerator Led::ON",
+		"11 enumerator Led::OFF",
+		"14 enumerator LOW_POWER",
+		"14 enumerator FULL_POWER",
+		"14 type power_t",
+	}, res)
+}
+
+func TestDeclarationMatches(t *testing.T) {
+	decl := &Declaration{Name: "File", Namespace: "SDLib", Kind: Class}
+	require.True(t, decl.Matches("File"))
+	require.True(t, decl.Matches("SDLib::File"))
+	require.True(t, decl.Matches("::SDLib::File"))
+	require.False(t, decl.Matches("fs::File"))
+	require.False(t, decl.Matches("file"))
+}
+
+func TestIsIdentifier(t *testing.T) {
+	require.True(t, IsIdentifier("SD"))
+	require.True(t, IsIdentifier("_sd_init2"))
+	require.True(t, IsIdentifier("SDLib::File"))
+	require.False(t, IsIdentifier("2SD"))
+	require.False(t, IsIdentifier("SD.h"))
+	require.False(t, IsIdentifier("SDLib::"))
+	require.False(t, IsIdentifier(""))
+}
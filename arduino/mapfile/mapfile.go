@@ -0,0 +1,223 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package mapfile parses the memory map generated by the GNU linker with the
+// `-Map` option.
+package mapfile
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// MapFile is the memory map of an executable
+type MapFile struct {
+	Sections []*Section
+}
+
+// Section is an output section of the executable
+type Section struct {
+	Name          string
+	Address       uint64
+	Size          uint64
+	InputSections []*InputSection
+}
+
+// InputSection is a section of an object file placed in an output section
+type InputSection struct {
+	Name    string
+	Address uint64
+	Size    uint64
+	Object  string
+	Symbols []*Symbol
+}
+
+// Symbol is a global symbol defined in an input section
+type Symbol struct {
+	Name    string
+	Address uint64
+}
+
+var (
+	sectionRegexp      = regexp.MustCompile(`^(\.\S+)\s+0x([0-9a-fA-F]+)\s+0x([0-9a-fA-F]+)`)
+	inputSectionRegexp = regexp.MustCompile(`^ (\.\S+|COMMON)\s+0x([0-9a-fA-F]+)\s+0x([0-9a-fA-F]+)(?:\s+(\S.*))?$`)
+	continuationRegexp = regexp.MustCompile(`^\s+0x([0-9a-fA-F]+)\s+0x([0-9a-fA-F]+)(?:\s+(\S.*))?$`)
+	nameOnlyRegexp     = regexp.MustCompile(`^( ?)(\.\S+)$`)
+	symbolRegexp       = regexp.MustCompile(`^\s+0x([0-9a-fA-F]+)\s+(\S.*)$`)
+)
+
+// nonAllocatedSections are the prefixes of the sections that don't take space
+// in the memory of the board
+var nonAllocatedSections = []string{".debug", ".comment", ".stab", ".note", ".ARM.attributes", ".gnu.attributes"}
+
+// Load parses the map file at the given path
+func Load(file *paths.Path) (*MapFile, error) {
+	f, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse parses a map file generated by the GNU linker. Only the memory map
+// part of the file is read.
+func Parse(in io.Reader) (*MapFile, error) {
+	res := &MapFile{}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	inMemoryMap := false
+	var section *Section
+	var inputSection *InputSection
+	pendingName, pendingIsInput := "", false
+	addSection := func(name, address, size string) {
+		section = &Section{Name: name, Address: parseHex(address), Size: parseHex(size)}
+		inputSection = nil
+		res.Sections = append(res.Sections, section)
+	}
+	addInputSection := func(name, address, size, object string) {
+		inputSection = &InputSection{Name: name, Address: parseHex(address), Size: parseHex(size), Object: strings.TrimSpace(object)}
+		if section != nil {
+			section.InputSections = append(section.InputSections, inputSection)
+		}
+	}
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !inMemoryMap {
+			inMemoryMap = strings.HasPrefix(line, "Linker script and memory map")
+			continue
+		}
+		if pendingName != "" {
+			// The name of the section was too long and the address and size
+			// are on the following line
+			name, isInput := pendingName, pendingIsInput
+			pendingName = ""
+			if m := continuationRegexp.FindStringSubmatch(line); m != nil {
+				if isInput {
+					addInputSection(name, m[1], m[2], m[3])
+				} else {
+					addSection(name, m[1], m[2])
+				}
+				continue
+			}
+		}
+		if m := sectionRegexp.FindStringSubmatch(line); m != nil {
+			addSection(m[1], m[2], m[3])
+		} else if m := inputSectionRegexp.FindStringSubmatch(line); m != nil {
+			addInputSection(m[1], m[2], m[3], m[4])
+		} else if m := nameOnlyRegexp.FindStringSubmatch(line); m != nil {
+			pendingName, pendingIsInput = m[2], m[1] == " "
+		} else if m := symbolRegexp.FindStringSubmatch(line); m != nil && inputSection != nil {
+			name := m[2]
+			if strings.HasPrefix(name, "0x") || strings.Contains(name, "=") || strings.HasPrefix(name, "PROVIDE") {
+				// Skip the fill lines and the assignments of the linker script
+				continue
+			}
+			inputSection.Symbols = append(inputSection.Symbols, &Symbol{Name: name, Address: parseHex(m[1])})
+		} else if strings.TrimSpace(line) != "" && !strings.HasPrefix(line, " ") {
+			// Any other line starting at the first column, like the linker
+			// script statements, closes the current input section
+			inputSection = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !inMemoryMap {
+		return nil, errors.New(tr("invalid map file: memory map not found"))
+	}
+	return res, nil
+}
+
+func parseHex(s string) uint64 {
+	n, _ := strconv.ParseUint(s, 16, 64)
+	return n
+}
+
+// IsAllocated returns true if the section takes space in the memory of the
+// board, i.e. it's not a debug or metadata section.
+func (s *Section) IsAllocated() bool {
+	return IsAllocatedSection(s.Name)
+}
+
+// IsAllocatedSection returns true if the section with the given name takes
+// space in the memory of the board.
+func IsAllocatedSection(name string) bool {
+	for _, prefix := range nonAllocatedSections {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// SymbolName returns the name of the symbol contained in the input section,
+// e.g. `setup` for `.text.setup`, or the name of the section and of the object
+// file for sections not specific to a symbol.
+func (s *InputSection) SymbolName() string {
+	if idx := strings.Index(s.Name[1:], "."); idx != -1 {
+		return s.Name[idx+2:]
+	}
+	if s.Object == "" {
+		return s.Name
+	}
+	return s.Name + " (" + filepath.Base(s.Object) + ")"
+}
+
+// Placement is a symbol, or an unnamed part of an input section, with its
+// address and size
+type Placement struct {
+	Name    string
+	Address uint64
+	Size    uint64
+}
+
+// Placements splits the input section among the symbols it contains: each
+// symbol extends up to the next one or to the end of the section. The space
+// before the first symbol, or the whole section if it doesn't list any
+// symbol, is attributed to the name returned by SymbolName.
+func (s *InputSection) Placements() []*Placement {
+	end := s.Address + s.Size
+	res := []*Placement{}
+	if len(s.Symbols) == 0 || s.Symbols[0].Address > s.Address {
+		size := s.Size
+		if len(s.Symbols) > 0 {
+			size = s.Symbols[0].Address - s.Address
+		}
+		res = append(res, &Placement{Name: s.SymbolName(), Address: s.Address, Size: size})
+	}
+	for i, symbol := range s.Symbols {
+		next := end
+		if i+1 < len(s.Symbols) {
+			next = s.Symbols[i+1].Address
+		}
+		if symbol.Address < s.Address || next < symbol.Address || next > end {
+			continue
+		}
+		res = append(res, &Placement{Name: symbol.Name, Address: symbol.Address, Size: next - symbol.Address})
+	}
+	return res
+}
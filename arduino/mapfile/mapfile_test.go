@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package mapfile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	mapFile, err := Load(paths.New("testdata", "sketch.ino.map"))
+	require.NoError(t, err)
+	require.Len(t, mapFile.Sections, 5)
+
+	text := mapFile.Sections[0]
+	require.Equal(t, ".text", text.Name)
+	require.Equal(t, uint64(0), text.Address)
+	require.Equal(t, uint64(0x1c4), text.Size)
+	require.True(t, text.IsAllocated())
+	require.Len(t, text.InputSections, 5)
+
+	vectors := text.InputSections[0]
+	require.Equal(t, ".vectors", vectors.Name)
+	require.Equal(t, "/tmp/crtatmega328p.o", vectors.Object)
+	require.Equal(t, ".vectors (crtatmega328p.o)", vectors.SymbolName())
+	require.Equal(t, []*Symbol{{Name: "__vectors", Address: 0}}, vectors.Symbols)
+
+	irq := text.InputSections[3]
+	require.Equal(t, ".text._ZN14HardwareSerial17_tx_udr_empty_irqEv", irq.Name)
+	require.Equal(t, uint64(0x88), irq.Address)
+	require.Equal(t, uint64(0x44), irq.Size)
+	require.Equal(t, "/tmp/core.a(HardwareSerial.cpp.o)", irq.Object)
+	require.Equal(t, []*Symbol{{Name: "HardwareSerial::_tx_udr_empty_irq()", Address: 0x88}}, irq.Symbols)
+
+	loop := text.InputSections[4]
+	require.Equal(t, "loop", loop.SymbolName())
+	require.Empty(t, loop.Symbols)
+
+	data := mapFile.Sections[1]
+	require.Equal(t, ".data", data.Name)
+	require.Equal(t, uint64(0x800100), data.Address)
+	require.Len(t, data.InputSections, 1)
+
+	require.False(t, mapFile.Sections[3].IsAllocated())
+	require.False(t, mapFile.Sections[4].IsAllocated())
+
+	_, err = Parse(strings.NewReader("not a map file\n"))
+	require.Error(t, err)
+}
+
+func TestPlacements(t *testing.T) {
+	inputSection := &InputSection{
+		Name:    ".text",
+		Address: 0x100,
+		Size:    0x40,
+		Object:  "/build/core/core.a(wiring.c.o)",
+		Symbols: []*Symbol{{Name: "millis", Address: 0x110}, {Name: "micros", Address: 0x120}},
+	}
+	require.Equal(t, []*Placement{
+		{Name: ".text (core.a(wiring.c.o))", Address: 0x100, Size: 0x10},
+		{Name: "millis", Address: 0x110, Size: 0x10},
+		{Name: "micros", Address: 0x120, Size: 0x20},
+	}, inputSection.Placements())
+
+	inputSection = &InputSection{Name: ".text.loop", Address: 0x200, Size: 0x30}
+	require.Equal(t, []*Placement{
+		{Name: "loop", Address: 0x200, Size: 0x30},
+	}, inputSection.Placements())
+}
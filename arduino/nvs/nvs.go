@@ -0,0 +1,244 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package nvs parses the Non-Volatile Storage partitions of the ESP32-class
+// boards, in the format used by the ESP-IDF.
+package nvs
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/arduino/arduino-cli/i18n"
+)
+
+var tr = i18n.Tr
+
+const (
+	// PageSize is the size of a NVS page
+	PageSize = 4096
+
+	entrySize      = 32
+	entriesPerPage = 126
+	firstEntry     = 64
+	bitmapOffset   = 32
+
+	pageStateActive  = 0xFFFFFFFE
+	pageStateFull    = 0xFFFFFFFC
+	pageStateFreeing = 0xFFFFFFF8
+
+	entryStateWritten = 0b10
+)
+
+// Entry types as stored in the NVS
+const (
+	typeU8       = 0x01
+	typeI8       = 0x11
+	typeU16      = 0x02
+	typeI16      = 0x12
+	typeU32      = 0x04
+	typeI32      = 0x14
+	typeU64      = 0x08
+	typeI64      = 0x18
+	typeString   = 0x21
+	typeBlob     = 0x41
+	typeBlobData = 0x42
+	typeBlobIdx  = 0x48
+)
+
+var typeNames = map[byte]string{
+	typeU8: "u8", typeI8: "i8", typeU16: "u16", typeI16: "i16",
+	typeU32: "u32", typeI32: "i32", typeU64: "u64", typeI64: "i64",
+	typeString: "string", typeBlob: "blob", typeBlobIdx: "blob",
+}
+
+// Entry is a key-value pair stored in the NVS. Integers are represented in
+// decimal notation and blobs in hexadecimal notation.
+type Entry struct {
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+}
+
+type entryID struct {
+	namespace byte
+	key       string
+}
+
+type blobIndex struct {
+	size       uint32
+	chunkCount byte
+	chunkStart byte
+}
+
+type page struct {
+	seq  uint32
+	data []byte
+}
+
+// Parse decodes the content of a NVS partition and returns the entries
+// stored in it, in the order they have been written.
+func Parse(data []byte) ([]*Entry, error) {
+	if len(data) == 0 || len(data)%PageSize != 0 {
+		return nil, errors.New(tr("invalid NVS partition size %d: must be a multiple of %d", len(data), PageSize))
+	}
+
+	// Collect the pages in use, in the order they have been written
+	pages := []*page{}
+	for offset := 0; offset < len(data); offset += PageSize {
+		p := data[offset : offset+PageSize]
+		switch binary.LittleEndian.Uint32(p[0:4]) {
+		case pageStateActive, pageStateFull, pageStateFreeing:
+			pages = append(pages, &page{seq: binary.LittleEndian.Uint32(p[4:8]), data: p})
+		}
+	}
+	sort.SliceStable(pages, func(i, j int) bool { return pages[i].seq < pages[j].seq })
+
+	namespaces := map[byte]string{}
+	order := []entryID{}
+	values := map[entryID]*Entry{}
+	blobIndexes := map[entryID]blobIndex{}
+	blobChunks := map[entryID]map[byte][]byte{}
+	set := func(id entryID, entry *Entry) {
+		if _, exists := values[id]; !exists {
+			order = append(order, id)
+		}
+		values[id] = entry
+	}
+
+	for _, p := range pages {
+		for i := 0; i < entriesPerPage; {
+			if entryState(p.data, i) != entryStateWritten {
+				i++
+				continue
+			}
+			entry := p.data[firstEntry+i*entrySize : firstEntry+(i+1)*entrySize]
+			ns, typ, span, chunkIndex := entry[0], entry[1], int(entry[2]), entry[3]
+			key := cString(entry[8:24])
+			value := entry[24:32]
+			if span < 1 || i+span > entriesPerPage {
+				return nil, errors.New(tr("invalid span of entry %[1]s at page %[2]d", key, p.seq))
+			}
+			payload := p.data[firstEntry+(i+1)*entrySize : firstEntry+(i+span)*entrySize]
+			i += span
+
+			if ns == 0 {
+				// Namespace definition
+				if typ == typeU8 {
+					namespaces[value[0]] = key
+				}
+				continue
+			}
+			id := entryID{namespace: ns, key: key}
+			switch typ {
+			case typeU8, typeI8, typeU16, typeI16, typeU32, typeI32, typeU64, typeI64:
+				set(id, &Entry{Key: key, Type: typeNames[typ], Value: formatInteger(typ, value)})
+			case typeString, typeBlob:
+				size := int(binary.LittleEndian.Uint16(value[0:2]))
+				if size > len(payload) {
+					return nil, errors.New(tr("invalid size of entry %[1]s at page %[2]d", key, p.seq))
+				}
+				if typ == typeString {
+					set(id, &Entry{Key: key, Type: typeNames[typ], Value: cString(payload[:size])})
+				} else {
+					set(id, &Entry{Key: key, Type: typeNames[typ], Value: hex.EncodeToString(payload[:size])})
+				}
+			case typeBlobData:
+				size := int(binary.LittleEndian.Uint16(value[0:2]))
+				if size > len(payload) {
+					return nil, errors.New(tr("invalid size of entry %[1]s at page %[2]d", key, p.seq))
+				}
+				if blobChunks[id] == nil {
+					blobChunks[id] = map[byte][]byte{}
+				}
+				blobChunks[id][chunkIndex] = payload[:size]
+			case typeBlobIdx:
+				blobIndexes[id] = blobIndex{
+					size:       binary.LittleEndian.Uint32(value[0:4]),
+					chunkCount: value[4],
+					chunkStart: value[5],
+				}
+				set(id, &Entry{Key: key, Type: typeNames[typ]})
+			}
+		}
+	}
+
+	// Reassemble the blobs split in chunks
+	for id, index := range blobIndexes {
+		blob := []byte{}
+		for c := 0; c < int(index.chunkCount); c++ {
+			chunk, ok := blobChunks[id][index.chunkStart+byte(c)]
+			if !ok {
+				return nil, errors.New(tr("missing chunk %[1]d of blob %[2]s", c, id.key))
+			}
+			blob = append(blob, chunk...)
+		}
+		if uint32(len(blob)) != index.size {
+			return nil, errors.New(tr("invalid size of blob %s", id.key))
+		}
+		values[id].Value = hex.EncodeToString(blob)
+	}
+
+	res := []*Entry{}
+	for _, id := range order {
+		entry := values[id]
+		if ns, ok := namespaces[id.namespace]; ok {
+			entry.Namespace = ns
+		} else {
+			entry.Namespace = fmt.Sprint(id.namespace)
+		}
+		res = append(res, entry)
+	}
+	return res, nil
+}
+
+func entryState(page []byte, index int) byte {
+	return (page[bitmapOffset+index/4] >> ((index % 4) * 2)) & 0b11
+}
+
+func cString(data []byte) string {
+	for i, c := range data {
+		if c == 0 {
+			return string(data[:i])
+		}
+	}
+	return string(data)
+}
+
+func formatInteger(typ byte, value []byte) string {
+	switch typ {
+	case typeU8:
+		return strconv.FormatUint(uint64(value[0]), 10)
+	case typeI8:
+		return strconv.FormatInt(int64(int8(value[0])), 10)
+	case typeU16:
+		return strconv.FormatUint(uint64(binary.LittleEndian.Uint16(value)), 10)
+	case typeI16:
+		return strconv.FormatInt(int64(int16(binary.LittleEndian.Uint16(value))), 10)
+	case typeU32:
+		return strconv.FormatUint(uint64(binary.LittleEndian.Uint32(value)), 10)
+	case typeI32:
+		return strconv.FormatInt(int64(int32(binary.LittleEndian.Uint32(value))), 10)
+	case typeU64:
+		return strconv.FormatUint(binary.LittleEndian.Uint64(value), 10)
+	default:
+		return strconv.FormatInt(int64(binary.LittleEndian.Uint64(value)), 10)
+	}
+}
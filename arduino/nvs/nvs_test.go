@@ -0,0 +1,101 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package nvs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testPage builds a NVS page for the tests
+type testPage struct {
+	data []byte
+	next int
+}
+
+func newTestPage(seq uint32) *testPage {
+	data := bytes.Repeat([]byte{0xFF}, PageSize)
+	binary.LittleEndian.PutUint32(data[0:4], pageStateActive)
+	binary.LittleEndian.PutUint32(data[4:8], seq)
+	return &testPage{data: data}
+}
+
+func (p *testPage) add(ns, typ, chunkIndex byte, key string, value []byte, payload []byte) {
+	span := 1 + (len(payload)+entrySize-1)/entrySize
+	entry := p.data[firstEntry+p.next*entrySize:]
+	entry[0], entry[1], entry[2], entry[3] = ns, typ, byte(span), chunkIndex
+	keyField := make([]byte, 16)
+	copy(keyField, key)
+	copy(entry[8:24], keyField)
+	valueField := bytes.Repeat([]byte{0xFF}, 8)
+	copy(valueField, value)
+	copy(entry[24:32], valueField)
+	copy(entry[entrySize:], payload)
+	for i := p.next; i < p.next+span; i++ {
+		// Mark the entry as written (0b10)
+		p.data[bitmapOffset+i/4] &^= 0b01 << ((i % 4) * 2)
+	}
+	p.next += span
+}
+
+func sized(size int, extra ...byte) []byte {
+	res := binary.LittleEndian.AppendUint16(nil, uint16(size))
+	return append(res, extra...)
+}
+
+func TestParse(t *testing.T) {
+	p1 := newTestPage(1)
+	p1.add(0, typeU8, 0xFF, "wifi", []byte{1}, nil)
+	p1.add(0, typeU8, 0xFF, "app", []byte{2}, nil)
+	p1.add(1, typeString, 0xFF, "ssid", sized(8), []byte("MyWiFi\x00\x00"))
+	p1.add(2, typeI32, 0xFF, "offset", binary.LittleEndian.AppendUint32(nil, uint32(0xFFFFFFFE)), nil)
+	p1.add(2, typeU8, 0xFF, "boots", []byte{3}, nil)
+	p1.add(2, typeBlobData, 0, "cert", sized(40), bytes.Repeat([]byte{0xAB}, 40))
+
+	// A second page, written later, updates a value and completes the blob
+	p2 := newTestPage(2)
+	p2.add(2, typeBlobData, 1, "cert", sized(2), []byte{0x01, 0x02})
+	p2.add(2, typeBlobIdx, 0xFF, "cert", []byte{42, 0, 0, 0, 2, 0}, nil)
+	p2.add(2, typeU8, 0xFF, "boots", []byte{4}, nil)
+
+	empty := bytes.Repeat([]byte{0xFF}, PageSize)
+	data := append(append(append([]byte{}, p2.data...), empty...), p1.data...)
+
+	entries, err := Parse(data)
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{
+		{Namespace: "wifi", Key: "ssid", Type: "string", Value: "MyWiFi"},
+		{Namespace: "app", Key: "offset", Type: "i32", Value: "-2"},
+		{Namespace: "app", Key: "boots", Type: "u8", Value: "4"},
+		{Namespace: "app", Key: "cert", Type: "blob", Value: string(bytes.Repeat([]byte("ab"), 40)) + "0102"},
+	}, entries)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse(nil)
+	require.Error(t, err)
+	_, err = Parse(make([]byte, 100))
+	require.Error(t, err)
+
+	// Blob with a missing chunk
+	p := newTestPage(1)
+	p.add(1, typeBlobIdx, 0xFF, "cert", []byte{42, 0, 0, 0, 2, 0}, nil)
+	_, err = Parse(p.data)
+	require.Error(t, err)
+}
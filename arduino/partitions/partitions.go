@@ -0,0 +1,311 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package partitions handles the partition tables of the ESP32-class boards,
+// in the CSV format used by the ESP-IDF.
+package partitions
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+var tr = i18n.Tr
+
+const (
+	// TableOffset is the default offset of the partition table in flash
+	TableOffset = 0x8000
+	// FirstPartitionOffset is the first offset available to the partitions,
+	// right after the partition table
+	FirstPartitionOffset = 0x9000
+
+	appAlignment  = 0x10000
+	dataAlignment = 0x1000
+	maxNameLength = 16
+)
+
+var appSubTypes = []string{"factory", "test"}
+
+var dataSubTypes = []string{
+	"ota", "phy", "nvs", "coredump", "nvs_keys", "efuse", "undefined",
+	"esphttpd", "fat", "spiffs", "littlefs",
+}
+
+// Partition is an entry of a partition table
+type Partition struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	SubType string `json:"subtype"`
+	Offset  uint64 `json:"offset"`
+	Size    uint64 `json:"size"`
+	Flags   string `json:"flags,omitempty"`
+}
+
+// End returns the offset of the first byte after the partition
+func (p *Partition) End() uint64 {
+	return p.Offset + p.Size
+}
+
+// IsApp returns true if the partition contains an application
+func (p *Partition) IsApp() bool {
+	return p.Type == "app" || p.Type == "0x00" || p.Type == "0"
+}
+
+// Table is a partition table
+type Table struct {
+	Partitions []*Partition `json:"partitions"`
+}
+
+// BoardDefaultFile returns the partition table used by default by the
+// board, following the conventions of the ESP32 platform, or nil if the board
+// doesn't define one.
+func BoardDefaultFile(buildProperties *properties.Map) *paths.Path {
+	variantPath := buildProperties.GetPath("build.variant.path")
+	if custom := buildProperties.Get("build.custom_partitions"); custom != "" && variantPath != nil {
+		if file := variantPath.Join(custom + ".csv"); file.Exist() {
+			return file
+		}
+	}
+	platformPath := buildProperties.GetPath("runtime.platform.path")
+	if name := buildProperties.Get("build.partitions"); name != "" && platformPath != nil {
+		if file := platformPath.Join("tools", "partitions", name+".csv"); file.Exist() {
+			return file
+		}
+	}
+	return nil
+}
+
+// Load reads a partition table from a CSV file
+func Load(file *paths.Path) (*Table, error) {
+	f, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	table, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return table, nil
+}
+
+// Parse reads a partition table in CSV format. Missing offsets are computed
+// following the same rules of the ESP-IDF partition table generator.
+func Parse(in io.Reader) (*Table, error) {
+	reader := csv.NewReader(in)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	table := &Table{}
+	nextOffset := uint64(FirstPartitionOffset)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		if len(record) < 5 {
+			return nil, errors.New(tr("line %d: expected at least 5 fields (name, type, subtype, offset, size)", line))
+		}
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+		partition := &Partition{
+			Name:    record[0],
+			Type:    strings.ToLower(record[1]),
+			SubType: strings.ToLower(record[2]),
+		}
+		if len(record) > 5 {
+			partition.Flags = record[5]
+		}
+		if partition.Size, err = ParseSize(record[4]); err != nil {
+			return nil, errors.New(tr("line %[1]d: invalid size: %[2]s", line, record[4]))
+		}
+		if record[3] == "" {
+			alignment := uint64(dataAlignment)
+			if partition.IsApp() {
+				alignment = appAlignment
+			}
+			partition.Offset = alignUp(nextOffset, alignment)
+		} else if partition.Offset, err = ParseSize(record[3]); err != nil {
+			return nil, errors.New(tr("line %[1]d: invalid offset: %[2]s", line, record[3]))
+		}
+		nextOffset = partition.End()
+		table.Partitions = append(table.Partitions, partition)
+	}
+	return table, nil
+}
+
+// ParseSize parses a size or an offset, in decimal or hexadecimal notation,
+// optionally followed by the K or M multipliers (e.g. `0x1000`, `64K`, `1M`).
+func ParseSize(in string) (uint64, error) {
+	s := strings.ToUpper(strings.TrimSpace(in))
+	s = strings.TrimSuffix(s, "B")
+	multiplier := uint64(1)
+	if strings.HasSuffix(s, "K") {
+		multiplier = 1024
+		s = strings.TrimSuffix(s, "K")
+	} else if strings.HasSuffix(s, "M") {
+		multiplier = 1024 * 1024
+		s = strings.TrimSuffix(s, "M")
+	}
+	if s == "" {
+		return 0, errors.New(tr("invalid size: %s", in))
+	}
+	var value uint64
+	var err error
+	if strings.HasPrefix(s, "0X") {
+		value, err = strconv.ParseUint(s[2:], 16, 64)
+	} else {
+		value, err = strconv.ParseUint(s, 10, 64)
+	}
+	if err != nil {
+		return 0, errors.New(tr("invalid size: %s", in))
+	}
+	return value * multiplier, nil
+}
+
+func alignUp(value, alignment uint64) uint64 {
+	return (value + alignment - 1) / alignment * alignment
+}
+
+// Validate checks the partition table for errors. If flashSize is not zero the
+// partitions must fit in a flash of that size.
+func (t *Table) Validate(flashSize uint64) error {
+	errs := []error{}
+	fail := func(msg string) {
+		errs = append(errs, errors.New(msg))
+	}
+
+	if len(t.Partitions) == 0 {
+		return errors.New(tr("the partition table is empty"))
+	}
+	names := map[string]bool{}
+	hasApp, hasOTAApp, hasOTAData := false, false, false
+	for _, p := range t.Partitions {
+		if p.Name == "" {
+			fail(tr("a partition has an empty name"))
+		} else if len(p.Name) > maxNameLength {
+			fail(tr("partition %[1]s: the name is longer than %[2]d characters", p.Name, maxNameLength))
+		}
+		if names[p.Name] {
+			fail(tr("partition %s: duplicated name", p.Name))
+		}
+		names[p.Name] = true
+
+		switch p.Type {
+		case "app":
+			hasApp = true
+			if strings.HasPrefix(p.SubType, "ota_") {
+				n, err := strconv.Atoi(strings.TrimPrefix(p.SubType, "ota_"))
+				if err != nil || n < 0 || n > 15 {
+					fail(tr("partition %[1]s: invalid subtype %[2]s", p.Name, p.SubType))
+				}
+				hasOTAApp = true
+			} else if !isNumeric(p.SubType) && !slices.Contains(appSubTypes, p.SubType) {
+				fail(tr("partition %[1]s: invalid subtype %[2]s", p.Name, p.SubType))
+			}
+			if p.Offset%appAlignment != 0 {
+				fail(tr("partition %[1]s: app partitions must be aligned to 0x%[2]X", p.Name, appAlignment))
+			}
+		case "data":
+			if p.SubType == "ota" {
+				hasOTAData = true
+			}
+			if !isNumeric(p.SubType) && !slices.Contains(dataSubTypes, p.SubType) {
+				fail(tr("partition %[1]s: invalid subtype %[2]s", p.Name, p.SubType))
+			}
+			if p.Offset%dataAlignment != 0 {
+				fail(tr("partition %[1]s: data partitions must be aligned to 0x%[2]X", p.Name, dataAlignment))
+			}
+		default:
+			if !isNumeric(p.Type) {
+				fail(tr("partition %[1]s: invalid type %[2]s", p.Name, p.Type))
+			}
+		}
+
+		if p.Size == 0 {
+			fail(tr("partition %s: the size must be greater than zero", p.Name))
+		}
+		if p.Offset < FirstPartitionOffset {
+			fail(tr("partition %[1]s: the offset 0x%[2]X overlaps the partition table", p.Name, p.Offset))
+		}
+		if flashSize != 0 && p.End() > flashSize {
+			fail(tr("partition %[1]s: ends at 0x%[2]X, beyond the flash size 0x%[3]X", p.Name, p.End(), flashSize))
+		}
+	}
+	for i, a := range t.Partitions {
+		for _, b := range t.Partitions[i+1:] {
+			if a.Offset < b.End() && b.Offset < a.End() {
+				fail(tr("partitions %[1]s and %[2]s overlap", a.Name, b.Name))
+			}
+		}
+	}
+	if !hasApp {
+		fail(tr("the partition table doesn't contain any app partition"))
+	}
+	if hasOTAApp && !hasOTAData {
+		fail(tr("the partition table contains OTA app partitions but no otadata partition"))
+	}
+	return errors.Join(errs...)
+}
+
+// FindByName returns the partition with the given name, or nil if not found
+func (t *Table) FindByName(name string) *Partition {
+	for _, p := range t.Partitions {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// UsedSize returns the offset of the end of the last partition
+func (t *Table) UsedSize() uint64 {
+	used := uint64(0)
+	for _, p := range t.Partitions {
+		if p.End() > used {
+			used = p.End()
+		}
+	}
+	return used
+}
+
+// isNumeric returns true if s is a numeric type or subtype (a byte value)
+func isNumeric(s string) bool {
+	var err error
+	if strings.HasPrefix(s, "0x") {
+		_, err = strconv.ParseUint(s[2:], 16, 8)
+	} else {
+		_, err = strconv.ParseUint(s, 10, 8)
+	}
+	return err == nil
+}
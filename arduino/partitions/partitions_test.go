@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package partitions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	table, err := Load(paths.New("testdata", "default.csv"))
+	require.NoError(t, err)
+	require.Len(t, table.Partitions, 6)
+	require.Equal(t, &Partition{Name: "app0", Type: "app", SubType: "ota_0", Offset: 0x10000, Size: 0x140000}, table.Partitions[2])
+	require.Equal(t, uint64(0x400000), table.UsedSize())
+	require.Equal(t, uint64(0x9000), table.FindByName("nvs").Offset)
+	require.Nil(t, table.FindByName("missing"))
+	require.NoError(t, table.Validate(4*1024*1024))
+	require.Error(t, table.Validate(2*1024*1024))
+}
+
+func TestAutomaticOffsets(t *testing.T) {
+	table, err := Load(paths.New("testdata", "auto_offsets.csv"))
+	require.NoError(t, err)
+	offsets := []uint64{}
+	for _, p := range table.Partitions {
+		offsets = append(offsets, p.Offset)
+	}
+	require.Equal(t, []uint64{0x9000, 0xE000, 0x10000, 0x110000}, offsets)
+	require.Equal(t, "readonly", table.Partitions[3].Flags)
+	require.NoError(t, table.Validate(0))
+}
+
+func TestValidate(t *testing.T) {
+	parse := func(csv string) *Table {
+		table, err := Parse(strings.NewReader(csv))
+		require.NoError(t, err)
+		return table
+	}
+	require.ErrorContains(t, parse("nvs, data, nvs, 0x9000, 0x6000\napp, app, factory, 0xe000, 1M\n").Validate(0), "aligned")
+	require.ErrorContains(t, parse("nvs, data, nvs, 0x9000, 0x8000\nnvs2, data, nvs, 0x10000, 0x1000\napp, app, factory, 0x10000, 1M\n").Validate(0), "overlap")
+	require.ErrorContains(t, parse("nvs, data, nvs, , 0x5000\nnvs, data, nvs, , 0x5000\napp, app, factory, , 1M\n").Validate(0), "duplicated")
+	require.ErrorContains(t, parse("nvs, data, nvs, , 0x5000\n").Validate(0), "app partition")
+	require.ErrorContains(t, parse("app0, app, ota_0, , 1M\n").Validate(0), "otadata")
+	require.ErrorContains(t, parse("app, app, magic, , 1M\n").Validate(0), "invalid subtype")
+	require.ErrorContains(t, parse("table, data, nvs, 0x8000, 0x1000\napp, app, factory, , 1M\n").Validate(0), "partition table")
+	require.NoError(t, parse("custom, 0x40, 0x01, , 0x1000\napp, app, factory, , 1M\n").Validate(0))
+
+	_, err := Parse(strings.NewReader("nvs, data, nvs\n"))
+	require.Error(t, err)
+	_, err = Parse(strings.NewReader("nvs, data, nvs, , 12Q\n"))
+	require.Error(t, err)
+}
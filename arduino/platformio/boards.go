@@ -0,0 +1,99 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package platformio
+
+import "strings"
+
+// boardsFQBN maps the PlatformIO development platforms and boards to the FQBN
+// of the corresponding Arduino board
+var boardsFQBN = map[string]map[string]string{
+	"atmelavr": {
+		"uno":                "arduino:avr:uno",
+		"nanoatmega328":      "arduino:avr:nano:cpu=atmega328old",
+		"nanoatmega328new":   "arduino:avr:nano:cpu=atmega328",
+		"nanoatmega168":      "arduino:avr:nano:cpu=atmega168",
+		"megaatmega2560":     "arduino:avr:mega:cpu=atmega2560",
+		"megaatmega1280":     "arduino:avr:mega:cpu=atmega1280",
+		"leonardo":           "arduino:avr:leonardo",
+		"micro":              "arduino:avr:micro",
+		"pro16MHzatmega328":  "arduino:avr:pro:cpu=16MHzatmega328",
+		"pro8MHzatmega328":   "arduino:avr:pro:cpu=8MHzatmega328",
+		"miniatmega328":      "arduino:avr:mini:cpu=atmega328",
+		"diecimilaatmega328": "arduino:avr:diecimila:cpu=atmega328",
+		"ethernet":           "arduino:avr:ethernet",
+		"yun":                "arduino:avr:yun",
+	},
+	"atmelmegaavr": {
+		"nano_every":    "arduino:megaavr:nona4809",
+		"uno_wifi_rev2": "arduino:megaavr:uno2018",
+	},
+	"atmelsam": {
+		"due":         "arduino:sam:arduino_due_x_dbg",
+		"dueUSB":      "arduino:sam:arduino_due_x",
+		"zero":        "arduino:samd:arduino_zero_edbg",
+		"zeroUSB":     "arduino:samd:arduino_zero_native",
+		"mkrzero":     "arduino:samd:mkrzero",
+		"mkr1000USB":  "arduino:samd:mkr1000",
+		"mkrwifi1010": "arduino:samd:mkrwifi1010",
+		"nano_33_iot": "arduino:samd:nano_33_iot",
+	},
+	"espressif32": {
+		"esp32dev":           "esp32:esp32:esp32",
+		"esp32-s2-saola-1":   "esp32:esp32:esp32s2",
+		"esp32-s3-devkitc-1": "esp32:esp32:esp32s3",
+		"esp32-c3-devkitm-1": "esp32:esp32:esp32c3",
+		"nodemcu-32s":        "esp32:esp32:nodemcu-32s",
+		"lolin32":            "esp32:esp32:lolin32",
+		"featheresp32":       "esp32:esp32:featheresp32",
+	},
+	"espressif8266": {
+		"nodemcuv2": "esp8266:esp8266:nodemcuv2",
+		"d1_mini":   "esp8266:esp8266:d1_mini",
+		"esp01_1m":  "esp8266:esp8266:generic",
+		"huzzah":    "esp8266:esp8266:huzzah",
+	},
+	"raspberrypi": {
+		"pico":              "arduino:mbed_rp2040:pico",
+		"nanorp2040connect": "arduino:mbed_nano:nanorp2040connect",
+	},
+	"nordicnrf52": {
+		"nano33ble": "arduino:mbed_nano:nano33ble",
+	},
+	"renesas-ra": {
+		"uno_r4_minima": "arduino:renesas_uno:minima",
+		"uno_r4_wifi":   "arduino:renesas_uno:unor4wifi",
+	},
+}
+
+// packagesIndexURL are the URLs of the package index of the third party
+// packagers providing the boards of boardsFQBN
+var packagesIndexURL = map[string]string{
+	"esp32":   "https://espressif.github.io/arduino-esp32/package_esp32_index.json",
+	"esp8266": "https://arduino.esp8266.com/stable/package_esp8266com_index.json",
+}
+
+// FQBN returns the FQBN of the board of the environment, or false if the
+// board is not known.
+func (e *Environment) FQBN() (string, bool) {
+	fqbn, ok := boardsFQBN[e.Platform()][strings.TrimSpace(e.Get("board"))]
+	return fqbn, ok
+}
+
+// PackageIndexURL returns the URL of the package index providing the given
+// packager, or an empty string if it is known by default.
+func PackageIndexURL(packager string) string {
+	return packagesIndexURL[packager]
+}
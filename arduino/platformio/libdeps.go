@@ -0,0 +1,134 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package platformio
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	semver "go.bug.st/relaxed-semver"
+)
+
+// LibraryDependency is an item of the lib_deps option of an environment
+type LibraryDependency struct {
+	// Owner is the owner of the library in the PlatformIO registry, if given
+	Owner string
+	// Name is the name of the library
+	Name string
+	// Requirements is the version requirement (e.g. `^1.2.0`), if given
+	Requirements string
+	// URL is the repository or the path of the library, if given instead of
+	// a registry reference
+	URL string
+}
+
+// ParseLibraryDependency parses an item of the lib_deps option, in one of the
+// forms `owner/name@requirements`, `name@requirements`, `name`, `name=url` or
+// `url`.
+func ParseLibraryDependency(dep string) *LibraryDependency {
+	dep = strings.TrimSpace(dep)
+	if name, url, ok := strings.Cut(dep, "="); ok && isURL(url) {
+		return &LibraryDependency{Name: strings.TrimSpace(name), URL: strings.TrimSpace(url)}
+	}
+	if isURL(dep) {
+		return &LibraryDependency{URL: dep}
+	}
+	res := &LibraryDependency{}
+	if name, requirements, ok := strings.Cut(dep, "@"); ok {
+		dep = strings.TrimSpace(name)
+		res.Requirements = strings.TrimSpace(requirements)
+	}
+	if owner, name, ok := strings.Cut(dep, "/"); ok {
+		res.Owner = strings.TrimSpace(owner)
+		dep = strings.TrimSpace(name)
+	}
+	res.Name = dep
+	return res
+}
+
+func isURL(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.Contains(value, "://") || strings.HasPrefix(value, "git@") ||
+		strings.HasPrefix(value, "file:") || strings.HasPrefix(value, "symlink:")
+}
+
+func (d *LibraryDependency) String() string {
+	if d.URL != "" {
+		return d.URL
+	}
+	res := d.Name
+	if d.Owner != "" {
+		res = d.Owner + "/" + res
+	}
+	if d.Requirements != "" {
+		res += "@" + d.Requirements
+	}
+	return res
+}
+
+// ExactVersion returns the version required by the dependency, if it requires
+// a single version.
+func (d *LibraryDependency) ExactVersion() (*semver.Version, bool) {
+	requirements := strings.TrimLeft(d.Requirements, "=")
+	if requirements == "" || !isDigit(requirements[0]) {
+		return nil, false
+	}
+	version, err := semver.Parse(requirements)
+	if err != nil {
+		return nil, false
+	}
+	return version, true
+}
+
+// Constraint converts the version requirements of the dependency, in the
+// PlatformIO format, to a version constraint.
+func (d *LibraryDependency) Constraint() (semver.Constraint, error) {
+	requirements := strings.TrimSpace(d.Requirements)
+	if requirements == "" || requirements == "*" {
+		return &semver.True{}, nil
+	}
+	terms := []string{}
+	for _, term := range strings.Split(requirements, ",") {
+		term = strings.ReplaceAll(strings.TrimSpace(term), " ", "")
+		switch {
+		case term == "":
+			continue
+		case strings.HasPrefix(term, "=="):
+			term = term[1:]
+		case strings.HasPrefix(term, "~"):
+			// ~1.2.3 allows the patch releases of 1.2
+			version, err := semver.Parse(term[1:])
+			if err != nil {
+				return nil, err
+			}
+			parts := strings.SplitN(version.String(), ".", 3)
+			minor := 0
+			if len(parts) > 1 {
+				minor, _ = strconv.Atoi(parts[1])
+			}
+			term = fmt.Sprintf("(>=%s && <%s.%d.0)", version, parts[0], minor+1)
+		case isDigit(term[0]):
+			term = "=" + term
+		}
+		terms = append(terms, term)
+	}
+	return semver.ParseConstraint(strings.Join(terms, " && "))
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
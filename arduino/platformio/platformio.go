@@ -0,0 +1,248 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package platformio reads PlatformIO project files (platformio.ini) to
+// import them into Arduino sketch projects.
+package platformio
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// Project is a PlatformIO project file
+type Project struct {
+	sections map[string]map[string]string
+	order    []string
+}
+
+// Load reads a platformio.ini file
+func Load(file *paths.Path) (*Project, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse parses the content of a platformio.ini file. Values spanning multiple
+// lines are joined with a newline, comments are removed.
+func Parse(data []byte) (*Project, error) {
+	p := &Project{sections: map[string]map[string]string{}}
+	var section map[string]string
+	lastKey := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		rawLine := scanner.Text()
+		line := strings.TrimSpace(stripComment(rawLine))
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Continuation of a multi-line value
+		if rawLine[0] == ' ' || rawLine[0] == '\t' {
+			if section == nil || lastKey == "" {
+				return nil, fmt.Errorf(tr("line %[1]d: unexpected indented line: %[2]s"), lineNumber, line)
+			}
+			if section[lastKey] == "" {
+				section[lastKey] = line
+			} else {
+				section[lastKey] += "\n" + line
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf(tr("line %[1]d: invalid section: %[2]s"), lineNumber, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := p.sections[name]; !ok {
+				p.sections[name] = map[string]string{}
+				p.order = append(p.order, name)
+			}
+			section = p.sections[name]
+			lastKey = ""
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || section == nil {
+			return nil, fmt.Errorf(tr("line %[1]d: invalid option: %[2]s"), lineNumber, line)
+		}
+		lastKey = strings.TrimSpace(key)
+		section[lastKey] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// stripComment removes the inline comments, starting with `;` after a space
+func stripComment(line string) string {
+	if idx := strings.Index(line, " ;"); idx != -1 {
+		return line[:idx]
+	}
+	if idx := strings.Index(line, "\t;"); idx != -1 {
+		return line[:idx]
+	}
+	return line
+}
+
+// DefaultEnvironments returns the environments listed in the default_envs
+// option of the [platformio] section.
+func (p *Project) DefaultEnvironments() []string {
+	return splitList(p.get("platformio", "default_envs", nil))
+}
+
+// Environments returns the environments of the project, in the order they are
+// defined, with the options inherited from the [env] section and from the
+// sections listed in `extends`.
+func (p *Project) Environments() []*Environment {
+	res := []*Environment{}
+	for _, name := range p.order {
+		if !strings.HasPrefix(name, "env:") {
+			continue
+		}
+		env := &Environment{Name: strings.TrimPrefix(name, "env:"), Options: map[string]string{}}
+		for key, value := range p.sections["env"] {
+			env.Options[key] = value
+		}
+		for _, base := range splitList(p.sections[name]["extends"]) {
+			for key, value := range p.sections[base] {
+				env.Options[key] = value
+			}
+		}
+		for key, value := range p.sections[name] {
+			env.Options[key] = value
+		}
+		delete(env.Options, "extends")
+		for key, value := range env.Options {
+			env.Options[key] = p.interpolate(value, env, 0)
+		}
+		res = append(res, env)
+	}
+	return res
+}
+
+func (p *Project) get(section, key string, env *Environment) string {
+	if section == "this" && env != nil {
+		return env.Options[key]
+	}
+	return p.sections[section][key]
+}
+
+var interpolationRegexp = regexp.MustCompile(`\$\{([^}.]+)\.([^}]+)\}`)
+
+// interpolate expands the `${section.option}` references. The references to
+// unknown options and to the system environment are left unchanged.
+func (p *Project) interpolate(value string, env *Environment, depth int) string {
+	if depth > 10 {
+		return value
+	}
+	return interpolationRegexp.ReplaceAllStringFunc(value, func(ref string) string {
+		match := interpolationRegexp.FindStringSubmatch(ref)
+		section, key := match[1], match[2]
+		if section == "sysenv" {
+			return ref
+		}
+		if _, ok := p.sections[section][key]; !ok && !(section == "this" && env != nil) {
+			return ref
+		}
+		return p.interpolate(p.get(section, key, env), env, depth+1)
+	})
+}
+
+// Environment is a build environment of the project
+type Environment struct {
+	Name    string
+	Options map[string]string
+}
+
+// Get returns the value of the given option
+func (e *Environment) Get(key string) string {
+	return e.Options[key]
+}
+
+// GetList returns the items of a list option, one per line or separated by
+// a comma followed by a space.
+func (e *Environment) GetList(key string) []string {
+	return splitList(e.Options[key])
+}
+
+// Keys returns the sorted names of the options of the environment
+func (e *Environment) Keys() []string {
+	keys := []string{}
+	for key := range e.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Platform returns the name of the development platform of the environment,
+// without the owner and the version (e.g. `atmelavr` for
+// `platformio/atmelavr@^4.0.0`).
+func (e *Environment) Platform() string {
+	platform := strings.TrimSpace(e.Get("platform"))
+	if strings.Contains(platform, "://") {
+		platform = platform[strings.LastIndex(platform, "/")+1:]
+		if name, _, ok := strings.Cut(platform, "#"); ok {
+			platform = name
+		}
+		return strings.TrimPrefix(strings.TrimSuffix(platform, ".git"), "platform-")
+	}
+	if name, _, ok := strings.Cut(platform, "@"); ok {
+		platform = strings.TrimSpace(name)
+	}
+	return platform[strings.LastIndex(platform, "/")+1:]
+}
+
+// IsArduinoFramework returns true if the environment uses the Arduino framework
+func (e *Environment) IsArduinoFramework() bool {
+	for _, framework := range e.GetList("framework") {
+		if framework == "arduino" {
+			return true
+		}
+	}
+	return false
+}
+
+// splitList splits a list option, with the items separated by newlines or by
+// a comma followed by a space, dropping the empty items.
+func splitList(value string) []string {
+	res := []string{}
+	for _, line := range strings.Split(value, "\n") {
+		for _, item := range strings.Split(strings.TrimSuffix(strings.TrimSpace(line), ","), ", ") {
+			if item = strings.TrimSpace(item); item != "" {
+				res = append(res, item)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,132 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package platformio
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestLoadProject(t *testing.T) {
+	project, err := Load(paths.New("testdata", "platformio.ini"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"uno", "esp32"}, project.DefaultEnvironments())
+
+	envs := project.Environments()
+	require.Len(t, envs, 4)
+
+	uno := envs[0]
+	require.Equal(t, "uno", uno.Name)
+	require.Equal(t, "atmelavr", uno.Platform())
+	require.True(t, uno.IsArduinoFramework())
+	require.Equal(t, "-DCOMMON=1 -DBOARD_UNO -O2", uno.Get("build_flags"))
+	require.Equal(t, "115200", uno.Get("monitor_speed"))
+	require.Equal(t, []string{"bblanchon/ArduinoJson @ ^6.21.3", "Servo"}, uno.GetList("lib_deps"))
+	fqbn, ok := uno.FQBN()
+	require.True(t, ok)
+	require.Equal(t, "arduino:avr:uno", fqbn)
+	require.Equal(t, []string{"board", "build_flags", "framework", "lib_deps", "monitor_speed", "platform", "upload_port"}, uno.Keys())
+
+	esp32 := envs[1]
+	require.Equal(t, "espressif32", esp32.Platform())
+	require.Equal(t, []string{
+		"bblanchon/ArduinoJson @ ^6.21.3",
+		"Servo",
+		"knolleary/PubSubClient@2.8",
+		"https://github.com/me/MyLib.git",
+	}, esp32.GetList("lib_deps"))
+	fqbn, ok = esp32.FQBN()
+	require.True(t, ok)
+	require.Equal(t, "esp32:esp32:esp32", fqbn)
+	require.Equal(t, "https://espressif.github.io/arduino-esp32/package_esp32_index.json", PackageIndexURL("esp32"))
+	require.Equal(t, "", PackageIndexURL("arduino"))
+
+	esp32Debug := envs[2]
+	require.Equal(t, "esp32_debug", esp32Debug.Name)
+	require.Equal(t, "esp32dev", esp32Debug.Get("board"))
+	require.Equal(t, "debug", esp32Debug.Get("build_type"))
+	require.NotContains(t, esp32Debug.Keys(), "extends")
+
+	native := envs[3]
+	require.False(t, native.IsArduinoFramework())
+	_, ok = native.FQBN()
+	require.False(t, ok)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse([]byte("board = uno\n"))
+	require.Error(t, err)
+	_, err = Parse([]byte("[env:uno\n"))
+	require.Error(t, err)
+	_, err = Parse([]byte("  indented\n"))
+	require.Error(t, err)
+	_, err = Parse([]byte("[env:uno]\nnot an option\n"))
+	require.Error(t, err)
+}
+
+func TestPlatformName(t *testing.T) {
+	for platform, expected := range map[string]string{
+		"atmelavr":                        "atmelavr",
+		"atmelavr@4.1.0":                  "atmelavr",
+		"platformio/espressif32 @ ^6.4.0": "espressif32",
+		"https://github.com/platformio/platform-espressif8266.git": "espressif8266",
+	} {
+		env := &Environment{Options: map[string]string{"platform": platform}}
+		require.Equal(t, expected, env.Platform(), platform)
+	}
+}
+
+func TestLibraryDependency(t *testing.T) {
+	dep := ParseLibraryDependency("bblanchon/ArduinoJson @ ^6.21.3")
+	require.Equal(t, &LibraryDependency{Owner: "bblanchon", Name: "ArduinoJson", Requirements: "^6.21.3"}, dep)
+	require.Equal(t, "bblanchon/ArduinoJson@^6.21.3", dep.String())
+	_, exact := dep.ExactVersion()
+	require.False(t, exact)
+
+	dep = ParseLibraryDependency("Adafruit GFX Library@1.11.5")
+	require.Equal(t, "Adafruit GFX Library", dep.Name)
+	version, exact := dep.ExactVersion()
+	require.True(t, exact)
+	require.Equal(t, "1.11.5", version.String())
+
+	dep = ParseLibraryDependency("MyLib=https://github.com/me/MyLib/archive/master.zip")
+	require.Equal(t, "MyLib", dep.Name)
+	require.Equal(t, "https://github.com/me/MyLib/archive/master.zip", dep.URL)
+	dep = ParseLibraryDependency("git@github.com:me/MyLib.git")
+	require.Equal(t, "git@github.com:me/MyLib.git", dep.URL)
+	require.Equal(t, "git@github.com:me/MyLib.git", dep.String())
+
+	for requirements, check := range map[string]map[string]bool{
+		"":               {"1.0.0": true, "7.0.0": true},
+		"^6.21.3":        {"6.21.3": true, "6.30.0": true, "7.0.0": false, "6.21.2": false},
+		"~1.2.3":         {"1.2.3": true, "1.2.9": true, "1.3.0": false},
+		"1.2.3":          {"1.2.3": true, "1.2.4": false},
+		"==1.2.3":        {"1.2.3": true, "1.2.4": false},
+		">=1.0.0,<2.0.0": {"1.5.0": true, "2.0.0": false, "0.9.0": false},
+		"!=1.2.3":        {"1.2.3": false, "1.2.4": true},
+	} {
+		constraint, err := (&LibraryDependency{Requirements: requirements}).Constraint()
+		require.NoError(t, err, requirements)
+		for version, expected := range check {
+			require.Equal(t, expected, constraint.Match(semver.MustParse(version)), requirements+" "+version)
+		}
+	}
+	_, err := (&LibraryDependency{Requirements: "^x.y"}).Constraint()
+	require.Error(t, err)
+}
@@ -0,0 +1,99 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package publish
+
+import (
+	"encoding/json"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// ManifestFileSuffix is appended to the project name of the build (for example
+// `Blink.ino`) to obtain the name of the manifest file.
+const ManifestFileSuffix = ".manifest.json"
+
+// Manifest describes a build and the components used to make it, it's
+// published together with the artifacts of the build.
+type Manifest struct {
+	Sketch        string              `json:"sketch"`
+	FQBN          string              `json:"fqbn"`
+	Configuration string              `json:"configuration,omitempty"`
+	Profile       string              `json:"profile,omitempty"`
+	BuildTime     string              `json:"build_time"`
+	CLIVersion    string              `json:"arduino_cli_version"`
+	Platforms     []*ManifestPlatform `json:"platforms"`
+	Libraries     []*ManifestLibrary  `json:"libraries"`
+	Artifacts     []*ManifestArtifact `json:"artifacts"`
+}
+
+// ManifestPlatform is a platform used by the build
+type ManifestPlatform struct {
+	ID         string `json:"id"`
+	Version    string `json:"version"`
+	PackageURL string `json:"package_url,omitempty"`
+}
+
+// ManifestLibrary is a library used by the build
+type ManifestLibrary struct {
+	Name     string `json:"name"`
+	Version  string `json:"version,omitempty"`
+	Location string `json:"location"`
+}
+
+// ManifestArtifact is a file produced by the build
+type ManifestArtifact struct {
+	File   string `json:"file"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// AddArtifacts adds the files to the artifacts of the manifest
+func (m *Manifest) AddArtifacts(files paths.PathList) error {
+	for _, file := range files {
+		data, err := file.ReadFile()
+		if err != nil {
+			return err
+		}
+		m.Artifacts = append(m.Artifacts, &ManifestArtifact{
+			File:   file.Base(),
+			Size:   int64(len(data)),
+			SHA256: sha256Hex(data),
+		})
+	}
+	return nil
+}
+
+// LoadManifest reads a manifest in JSON format from the file
+func LoadManifest(file *paths.Path) (*Manifest, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// Save writes the manifest in JSON format to the file
+func (m *Manifest) Save(file *paths.Path) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return file.WriteFile(append(data, '\n'))
+}
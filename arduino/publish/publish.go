@@ -0,0 +1,221 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package publish uploads the artifacts of a build to HTTP servers or to
+// S3-compatible object storages, for the firmware artifact pipelines.
+package publish
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// Destination is a place where the artifacts are published. The URL is a
+// template of the location of each artifact, with the scheme `http`, `https`
+// or `s3` (`s3://bucket/key`).
+type Destination struct {
+	URL             string            `mapstructure:"url"`
+	Headers         map[string]string `mapstructure:"headers"`
+	Endpoint        string            `mapstructure:"endpoint"`
+	Region          string            `mapstructure:"region"`
+	AccessKeyID     string            `mapstructure:"access_key_id"`
+	SecretAccessKey string            `mapstructure:"secret_access_key"`
+	SessionToken    string            `mapstructure:"session_token"`
+}
+
+// Artifact is a file published to a destination
+type Artifact struct {
+	File string
+	URL  string
+}
+
+// Validate checks that the URL of the destination is usable
+func (d *Destination) Validate() error {
+	u, err := url.Parse(d.URL)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "https":
+	case "s3":
+		if u.Host == "" {
+			return errors.New(tr("missing bucket in %s", d.URL))
+		}
+	default:
+		return errors.New(tr("unsupported publishing URL %s, the scheme must be http, https or s3", d.URL))
+	}
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(d.URL, -1) {
+		if !slices.Contains(Placeholders, match[1]) {
+			return errors.New(tr("unknown placeholder %[1]s in %[2]s", match[0], d.URL))
+		}
+	}
+	return nil
+}
+
+// Publish uploads the files to the destination, the URL template is expanded
+// for each file with vars and the `file` variable set to the file name. If
+// the template doesn't contain `{file}` the file name is appended to it.
+func (d *Destination) Publish(client *http.Client, files paths.PathList, vars Variables) ([]*Artifact, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	template := d.URL
+	if !strings.Contains(template, "{file}") {
+		template = strings.TrimSuffix(template, "/") + "/{file}"
+	}
+
+	res := []*Artifact{}
+	for _, file := range files {
+		fileVars := Variables{"file": file.Base()}
+		for k, v := range vars {
+			fileVars[k] = v
+		}
+		target, err := ExpandTemplate(template, fileVars)
+		if err != nil {
+			return nil, err
+		}
+		data, err := file.ReadFile()
+		if err != nil {
+			return nil, err
+		}
+		published, err := d.upload(client, target, data, contentType(file))
+		if err != nil {
+			return nil, fmt.Errorf(tr("publishing %[1]s to %[2]s: %[3]s"), file.Base(), target, err)
+		}
+		res = append(res, &Artifact{File: file.Base(), URL: published})
+	}
+	return res, nil
+}
+
+// upload sends the data to the target URL and returns the URL where the data
+// can be found
+func (d *Destination) upload(client *http.Client, target string, data []byte, mimeType string) (string, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+
+	var req *http.Request
+	if u.Scheme == "s3" {
+		req, err = d.newS3Request(u, data)
+	} else {
+		req, err = http.NewRequest(http.MethodPut, u.String(), bytes.NewReader(data))
+	}
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", mimeType)
+	for k, v := range d.Headers {
+		req.Header.Set(k, os.ExpandEnv(v))
+	}
+	if u.Scheme == "s3" {
+		if err := d.signS3Request(req, data); err != nil {
+			return "", err
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return "", fmt.Errorf("%s: %s", resp.Status, msg)
+		}
+		return "", errors.New(resp.Status)
+	}
+	return u.String(), nil
+}
+
+func contentType(file *paths.Path) string {
+	switch file.Ext() {
+	case ".json":
+		return "application/json"
+	case ".hex", ".map", ".txt":
+		return "text/plain"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// Variables are the values of the placeholders of a URL template
+type Variables map[string]string
+
+// Placeholders are the names of the placeholders that can be used in the URL
+// of a Destination.
+var Placeholders = []string{"sketch", "fqbn", "configuration", "date", "timestamp", "file"}
+
+// NewVariables returns the values of the placeholders for the build of the
+// sketch for the fqbn, with the optional build configuration, made at the
+// given time. The `:` of the FQBN are replaced by `.`, as in the name of the
+// export directory.
+func NewVariables(sketch, fqbn, configuration string, buildTime time.Time) Variables {
+	if configuration == "" {
+		configuration = "default"
+	}
+	buildTime = buildTime.UTC()
+	return Variables{
+		"sketch":        sketch,
+		"fqbn":          strings.ReplaceAll(fqbn, ":", "."),
+		"configuration": configuration,
+		"date":          buildTime.Format("2006-01-02"),
+		"timestamp":     buildTime.Format("20060102T150405Z"),
+	}
+}
+
+var placeholderRegexp = regexp.MustCompile(`\{([a-z_]*)\}`)
+
+// ExpandTemplate replaces the `{name}` placeholders of the template with the
+// values of vars, escaped to be used in a URL path. An error is returned if a
+// placeholder has no value.
+func ExpandTemplate(template string, vars Variables) (string, error) {
+	var missing []string
+	res := placeholderRegexp.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		value, ok := vars[name]
+		if !ok {
+			missing = append(missing, placeholder)
+			return placeholder
+		}
+		return url.PathEscape(value)
+	})
+	if len(missing) > 0 {
+		return "", errors.New(tr("unknown placeholder %[1]s in %[2]s", missing[0], template))
+	}
+	return res, nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
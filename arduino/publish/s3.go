@@ -0,0 +1,143 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package publish
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// now is replaced by the tests
+var now = time.Now
+
+// region returns the region of the S3 destination
+func (d *Destination) region() string {
+	if d.Region != "" {
+		return d.Region
+	}
+	if region := os.Getenv("AWS_REGION"); region != "" {
+		return region
+	}
+	return "us-east-1"
+}
+
+// credentials returns the access key of the S3 destination, taken from the
+// environment if not set in the configuration
+func (d *Destination) credentials() (accessKeyID, secretAccessKey, sessionToken string, err error) {
+	accessKeyID, secretAccessKey, sessionToken = d.AccessKeyID, d.SecretAccessKey, d.SessionToken
+	if accessKeyID == "" {
+		accessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+		secretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if accessKeyID == "" || secretAccessKey == "" {
+		return "", "", "", errors.New(tr("missing S3 credentials, set the access key of the publisher or the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables"))
+	}
+	return
+}
+
+// newS3Request creates the PUT request of the object s3://bucket/key, in the
+// path-style format supported by all the S3-compatible storages.
+func (d *Destination) newS3Request(target *url.URL, data []byte) (*http.Request, error) {
+	endpoint := d.Endpoint
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_ENDPOINT_URL")
+	}
+	if endpoint == "" {
+		endpoint = "https://s3." + d.region() + ".amazonaws.com"
+	}
+	base, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	objectURL := *base
+	objectURL.Path = base.Path + "/" + target.Host + target.Path
+	objectURL.RawPath = base.EscapedPath() + "/" + target.Host + target.EscapedPath()
+	return http.NewRequest(http.MethodPut, objectURL.String(), bytes.NewReader(data))
+}
+
+// signS3Request adds to the request the AWS Signature Version 4 of the
+// request and of its headers.
+func (d *Destination) signS3Request(req *http.Request, data []byte) error {
+	accessKeyID, secretAccessKey, sessionToken, err := d.credentials()
+	if err != nil {
+		return err
+	}
+	t := now().UTC()
+	amzDate := t.Format("20060102T150405Z")
+	payloadHash := sha256Hex(data)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	canonicalHeaders := ""
+	for _, k := range names {
+		canonicalHeaders += k + ":" + headers[k] + "\n"
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := t.Format("20060102") + "/" + d.region() + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{t.Format("20060102"), d.region(), "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature))
+	return nil
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
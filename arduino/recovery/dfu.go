@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package recovery detects the boards that are stuck in bootloader mode and
+// can't be reached through their usual upload port: DFU devices listed by
+// dfu-util and mass storage drives exposed by UF2 bootloaders.
+package recovery
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DFUDevice is a device in DFU mode as listed by `dfu-util -l`
+type DFUDevice struct {
+	VID    string
+	PID    string
+	Path   string
+	Serial string
+	Name   string
+}
+
+var dfuDeviceRegexp = regexp.MustCompile(`^Found DFU: \[([0-9a-fA-F]{4}):([0-9a-fA-F]{4})\](.*)$`)
+var dfuAttributeRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// ParseDFUList parses the output of `dfu-util -l` and returns the devices in
+// DFU mode. The devices in runtime mode are ignored, a device with several
+// alternate settings is returned once.
+func ParseDFUList(output []byte) []*DFUDevice {
+	res := []*DFUDevice{}
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		match := dfuDeviceRegexp.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if match == nil {
+			continue
+		}
+		dev := &DFUDevice{
+			VID: "0x" + strings.ToLower(match[1]),
+			PID: "0x" + strings.ToLower(match[2]),
+		}
+		for _, attr := range dfuAttributeRegexp.FindAllStringSubmatch(match[3], -1) {
+			switch attr[1] {
+			case "path":
+				dev.Path = attr[2]
+			case "serial":
+				dev.Serial = attr[2]
+			case "name":
+				dev.Name = strings.TrimSpace(attr[2])
+			}
+		}
+		key := dev.Path + "|" + dev.Serial
+		if dev.Path == "" {
+			key = dev.VID + ":" + dev.PID + "|" + dev.Serial
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		res = append(res, dev)
+	}
+	return res
+}
+
+// Address returns an address that identifies the device
+func (d *DFUDevice) Address() string {
+	if d.Path != "" {
+		return d.Path
+	}
+	return fmt.Sprintf("%s:%s", d.VID, d.PID)
+}
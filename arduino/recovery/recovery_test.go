@@ -0,0 +1,72 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package recovery
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDFUList(t *testing.T) {
+	output := []byte(`dfu-util 0.11-arduino4
+
+Copyright 2005-2009 Weston Schmidt, Harald Welte and OpenMoko Inc.
+Copyright 2010-2021 Tormod Volden and Stefan Schmidt
+This program is Free Software and has ABSOLUTELY NO WARRANTY
+Please report bugs to http://sourceforge.net/p/dfu-util/tickets/
+
+Found Runtime: [2341:025b] ver=0200, devnum=12, cfg=1, intf=2, path="1-3", alt=0, name="UNKNOWN", serial="003100303330511838363431"
+Found DFU: [2341:0364] ver=0200, devnum=25, cfg=1, intf=0, path="1-2", alt=1, name="@Option Bytes   /0x5200201C/01*128 e", serial="003D00213330510434343135"
+Found DFU: [2341:0364] ver=0200, devnum=25, cfg=1, intf=0, path="1-2", alt=0, name="@Internal Flash   /0x08000000/16*128Kg", serial="003D00213330510434343135"
+Found DFU: [0483:DF11] ver=2200, devnum=7, cfg=1, intf=0, path="2-1.4", alt=0, name="@Internal Flash  /0x08000000/128*0002Kg", serial="UNKNOWN"
+`)
+	devices := ParseDFUList(output)
+	require.Len(t, devices, 2)
+	require.Equal(t, "0x2341", devices[0].VID)
+	require.Equal(t, "0x0364", devices[0].PID)
+	require.Equal(t, "1-2", devices[0].Path)
+	require.Equal(t, "003D00213330510434343135", devices[0].Serial)
+	require.Equal(t, "@Option Bytes   /0x5200201C/01*128 e", devices[0].Name)
+	require.Equal(t, "0x0483", devices[1].VID)
+	require.Equal(t, "0xdf11", devices[1].PID)
+	require.Equal(t, "2-1.4", devices[1].Address())
+
+	require.Empty(t, ParseDFUList([]byte("dfu-util 0.11\n")))
+}
+
+func TestParseUF2Info(t *testing.T) {
+	drive := ParseUF2Info([]byte("UF2 Bootloader v3.15.0 SFHWRO\r\nModel: Adafruit Feather M0\r\nBoard-ID: SAMD21G18A-Feather-v0\r\n"))
+	require.Equal(t, "UF2 Bootloader v3.15.0 SFHWRO", drive.Bootloader)
+	require.Equal(t, "Adafruit Feather M0", drive.Model)
+	require.Equal(t, "SAMD21G18A-Feather-v0", drive.BoardID)
+}
+
+func TestUF2DriveFlash(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "uf2")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	drive := tmp.Join("drive")
+	require.NoError(t, drive.Mkdir())
+	firmware := tmp.Join("Blink.ino.uf2")
+	require.NoError(t, firmware.WriteFile([]byte("UF2\nfirmware")))
+
+	require.NoError(t, (&UF2Drive{Path: drive}).Flash(firmware))
+	data, err := drive.Join("Blink.ino.uf2").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "UF2\nfirmware", string(data))
+}
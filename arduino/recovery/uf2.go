@@ -0,0 +1,144 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package recovery
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// UF2Drive is a mass storage drive exposed by a UF2 bootloader
+type UF2Drive struct {
+	// Path is the mount point of the drive
+	Path *paths.Path
+	// Bootloader is the first line of INFO_UF2.TXT, e.g. "UF2 Bootloader v3.15.0"
+	Bootloader string
+	// Model is the board model reported by the bootloader
+	Model string
+	// BoardID is the identifier of the board reported by the bootloader
+	BoardID string
+}
+
+// ParseUF2Info parses the content of the INFO_UF2.TXT file of a UF2 drive
+func ParseUF2Info(data []byte) *UF2Drive {
+	res := &UF2Drive{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for first := true; scanner.Scan(); first = false {
+		line := strings.TrimSpace(scanner.Text())
+		if first {
+			res.Bootloader = line
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "Model":
+			res.Model = strings.TrimSpace(value)
+		case "Board-ID":
+			res.BoardID = strings.TrimSpace(value)
+		}
+	}
+	return res
+}
+
+// FindUF2Drives returns the UF2 drives mounted on the system
+func FindUF2Drives() []*UF2Drive {
+	res := []*UF2Drive{}
+	for _, mountPoint := range mountPoints() {
+		data, err := mountPoint.Join("INFO_UF2.TXT").ReadFile()
+		if err != nil {
+			continue
+		}
+		drive := ParseUF2Info(data)
+		drive.Path = mountPoint
+		res = append(res, drive)
+	}
+	return res
+}
+
+// mountPoints returns the directories where the removable drives may be mounted
+func mountPoints() paths.PathList {
+	res := paths.PathList{}
+	switch runtime.GOOS {
+	case "windows":
+		// Skip the floppy drives A: and B:
+		for letter := 'C'; letter <= 'Z'; letter++ {
+			res.Add(paths.New(string(letter) + ":\\"))
+		}
+	case "darwin":
+		if volumes, err := paths.New("/Volumes").ReadDir(); err == nil {
+			volumes.FilterDirs()
+			res.AddAll(volumes)
+		}
+	default:
+		mounts, err := os.ReadFile("/proc/mounts")
+		if err != nil {
+			return res
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(mounts))
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) < 3 || fields[2] != "vfat" {
+				// UF2 bootloaders always expose a FAT filesystem
+				continue
+			}
+			// Spaces in the mount point are escaped as \040
+			res.Add(paths.New(strings.ReplaceAll(fields[1], `\040`, " ")))
+		}
+	}
+	return res
+}
+
+// Flash copies the firmware to the drive. The bootloader writes the firmware
+// to the board and resets it as soon as the copy is completed.
+func (d *UF2Drive) Flash(firmware *paths.Path) error {
+	in, err := firmware.Open()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := d.Path.Join(firmware.Base()).Create()
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	// Make sure that the whole firmware reaches the drive before returning
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// String returns a description of the drive
+func (d *UF2Drive) String() string {
+	if d.Model == "" {
+		return d.Path.String()
+	}
+	return fmt.Sprintf("%s (%s)", d.Path, d.Model)
+}
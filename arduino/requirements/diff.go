@@ -0,0 +1,137 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package requirements
+
+import (
+	"sort"
+	"strings"
+
+	semver "go.bug.st/relaxed-semver"
+)
+
+// Action is the operation performed on a platform or a library to satisfy
+// the requirements
+type Action string
+
+const (
+	// Keep leaves the installed platform or library untouched
+	Keep Action = "keep"
+	// Install installs a missing platform or library
+	Install Action = "install"
+	// Replace installs the required version in place of the installed one
+	Replace Action = "replace"
+	// Remove uninstalls a platform or library not required
+	Remove Action = "remove"
+)
+
+// Kind is the type of item changed
+type Kind string
+
+const (
+	// PlatformKind is a platform
+	PlatformKind Kind = "platform"
+	// LibraryKind is a library
+	LibraryKind Kind = "library"
+)
+
+// Installed is an installed platform or library
+type Installed struct {
+	// Name is the identifier of the platform (e.g. `arduino:avr`) or the
+	// name of the library
+	Name string
+	// Version is nil if unknown
+	Version *semver.Version
+}
+
+// Change is the operation needed to converge an installed platform or
+// library to the requirements
+type Change struct {
+	Action Action
+	Kind   Kind
+	Name   string
+	// InstalledVersion is nil if the platform or library is not installed,
+	// or if its version is unknown
+	InstalledVersion *semver.Version
+	// Version is the required version, nil if the latest is installed
+	Version *semver.Version
+}
+
+// Diff returns the changes needed to converge the installed platforms and
+// libraries to the requirements. The installed platforms and libraries not
+// required are removed only if prune is true, in any case the libraries
+// listed in keepLibraries (for example the dependencies of the required
+// ones) are never removed. The names are compared case-insensitively.
+func (r *Requirements) Diff(installedPlatforms, installedLibraries []*Installed, keepLibraries []string, prune bool) []*Change {
+	platforms := make([]requirement, len(r.Platforms))
+	for i, platform := range r.Platforms {
+		platforms[i] = requirement{platform.ID(), platform.Version}
+	}
+	libraries := make([]requirement, len(r.Libraries))
+	for i, library := range r.Libraries {
+		libraries[i] = requirement{library.Name, library.Version}
+	}
+	res := diff(PlatformKind, platforms, installedPlatforms, nil, prune)
+	return append(res, diff(LibraryKind, libraries, installedLibraries, keepLibraries, prune)...)
+}
+
+// HasChanges returns true if any of the changes modifies the installation
+func HasChanges(changes []*Change) bool {
+	for _, change := range changes {
+		if change.Action != Keep {
+			return true
+		}
+	}
+	return false
+}
+
+type requirement struct {
+	name    string
+	version *semver.Version
+}
+
+func diff(kind Kind, required []requirement, installed []*Installed, keep []string, prune bool) []*Change {
+	installedVersions := map[string]*Installed{}
+	for _, item := range installed {
+		installedVersions[strings.ToLower(item.Name)] = item
+	}
+
+	res := []*Change{}
+	for _, req := range required {
+		change := &Change{Kind: kind, Name: req.name, Version: req.version, Action: Install}
+		if item, ok := installedVersions[strings.ToLower(req.name)]; ok {
+			change.InstalledVersion = item.Version
+			delete(installedVersions, strings.ToLower(req.name))
+			change.Action = Replace
+			if req.version == nil || (item.Version != nil && req.version.Equal(item.Version)) {
+				change.Action = Keep
+			}
+		}
+		res = append(res, change)
+	}
+	if !prune {
+		return res
+	}
+
+	for _, name := range keep {
+		delete(installedVersions, strings.ToLower(name))
+	}
+	removed := []*Change{}
+	for _, item := range installedVersions {
+		removed = append(removed, &Change{Action: Remove, Kind: kind, Name: item.Name, InstalledVersion: item.Version})
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i].Name < removed[j].Name })
+	return append(res, removed...)
+}
@@ -0,0 +1,74 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package requirements
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestDiff(t *testing.T) {
+	reqs, err := Load(paths.New("testdata", "requirements.yaml"))
+	require.NoError(t, err)
+
+	installedPlatforms := []*Installed{
+		{Name: "arduino:avr", Version: semver.MustParse("1.8.5")},
+		{Name: "esp32:esp32", Version: semver.MustParse("2.0.11")},
+		{Name: "arduino:samd", Version: semver.MustParse("1.8.13")},
+	}
+	installedLibraries := []*Installed{
+		{Name: "arduinojson", Version: semver.MustParse("6.21.3")},
+		{Name: "Adafruit BusIO", Version: semver.MustParse("1.14.4")},
+		{Name: "Servo", Version: semver.MustParse("1.2.1")},
+		{Name: "MyLibrary"},
+	}
+	toString := func(changes []*Change) []string {
+		res := []string{}
+		for _, c := range changes {
+			res = append(res, fmt.Sprintf("%s %s %s %s->%s", c.Action, c.Kind, c.Name, c.InstalledVersion, c.Version))
+		}
+		return res
+	}
+
+	changes := reqs.Diff(installedPlatforms, installedLibraries, nil, false)
+	require.Equal(t, []string{
+		"replace platform arduino:avr 1.8.5->1.8.6",
+		"keep platform esp32:esp32 2.0.11->",
+		"keep library ArduinoJson 6.21.3->6.21.3",
+		"install library Adafruit GFX Library ->",
+	}, toString(changes))
+	require.True(t, HasChanges(changes))
+
+	// The dependencies of the required libraries are not pruned
+	changes = reqs.Diff(installedPlatforms, installedLibraries, []string{"Adafruit BusIO"}, true)
+	require.Equal(t, []string{
+		"replace platform arduino:avr 1.8.5->1.8.6",
+		"keep platform esp32:esp32 2.0.11->",
+		"remove platform arduino:samd 1.8.13->",
+		"keep library ArduinoJson 6.21.3->6.21.3",
+		"install library Adafruit GFX Library ->",
+		"remove library MyLibrary ->",
+		"remove library Servo 1.2.1->",
+	}, toString(changes))
+
+	changes = (&Requirements{}).Diff(nil, installedLibraries[:1], nil, false)
+	require.Empty(t, changes)
+	require.False(t, HasChanges(changes))
+}
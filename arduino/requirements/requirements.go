@@ -0,0 +1,169 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package requirements
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+	"gopkg.in/yaml.v3"
+)
+
+var tr = i18n.Tr
+
+// Requirements are the platforms and libraries that must be installed, as
+// declared in a requirements file:
+//
+//	additional_urls:
+//	  - https://espressif.github.io/arduino-esp32/package_esp32_index.json
+//	platforms:
+//	  - arduino:avr@1.8.6
+//	  - esp32:esp32
+//	libraries:
+//	  - ArduinoJson@6.21.3
+//	  - Servo
+//
+// The platforms and libraries without a version are satisfied by any
+// installed version, the latest one is installed if they're missing.
+type Requirements struct {
+	AdditionalURLs []string    `yaml:"additional_urls,omitempty"`
+	Platforms      []*Platform `yaml:"platforms,omitempty"`
+	Libraries      []*Library  `yaml:"libraries,omitempty"`
+}
+
+// Platform is a platform required by the requirements file
+type Platform struct {
+	Package      string
+	Architecture string
+	// Version is nil if any version is accepted
+	Version *semver.Version
+}
+
+// ID returns the platform identifier (e.g. `arduino:avr`)
+func (p *Platform) ID() string {
+	return p.Package + ":" + p.Architecture
+}
+
+func (p *Platform) String() string {
+	if p.Version == nil {
+		return p.ID()
+	}
+	return p.ID() + "@" + p.Version.String()
+}
+
+// UnmarshalYAML decodes a Platform from YAML source.
+func (p *Platform) UnmarshalYAML(node *yaml.Node) error {
+	var data string
+	if err := node.Decode(&data); err != nil {
+		return err
+	}
+	id, version, err := splitVersion(data)
+	if err != nil {
+		return err
+	}
+	split := strings.Split(id, ":")
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return fmt.Errorf(tr("invalid platform identifier: %s"), data)
+	}
+	p.Package = split[0]
+	p.Architecture = split[1]
+	p.Version = version
+	return nil
+}
+
+// MarshalYAML encodes a Platform to YAML.
+func (p *Platform) MarshalYAML() (interface{}, error) {
+	return p.String(), nil
+}
+
+// Library is a library required by the requirements file
+type Library struct {
+	Name string
+	// Version is nil if any version is accepted
+	Version *semver.Version
+}
+
+func (l *Library) String() string {
+	if l.Version == nil {
+		return l.Name
+	}
+	return l.Name + "@" + l.Version.String()
+}
+
+// UnmarshalYAML decodes a Library from YAML source.
+func (l *Library) UnmarshalYAML(node *yaml.Node) error {
+	var data string
+	if err := node.Decode(&data); err != nil {
+		return err
+	}
+	name, version, err := splitVersion(data)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return fmt.Errorf(tr("invalid library: %s"), data)
+	}
+	l.Name = name
+	l.Version = version
+	return nil
+}
+
+// MarshalYAML encodes a Library to YAML.
+func (l *Library) MarshalYAML() (interface{}, error) {
+	return l.String(), nil
+}
+
+// splitVersion splits a `NAME[@VERSION]` reference
+func splitVersion(ref string) (string, *semver.Version, error) {
+	name, version, hasVersion := strings.Cut(strings.TrimSpace(ref), "@")
+	if !hasVersion {
+		return name, nil, nil
+	}
+	v, err := semver.Parse(version)
+	if err != nil {
+		return "", nil, fmt.Errorf(tr("invalid version in %[1]s: %[2]s"), ref, err)
+	}
+	return name, v, nil
+}
+
+// Load reads a requirements file
+func Load(file *paths.Path) (*Requirements, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var res Requirements
+	if err := yaml.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf(tr("error parsing %[1]s: %[2]s"), file, err)
+	}
+	seen := map[string]bool{}
+	for _, platform := range res.Platforms {
+		if seen[platform.ID()] {
+			return nil, fmt.Errorf(tr("platform %s is required more than once"), platform.ID())
+		}
+		seen[platform.ID()] = true
+	}
+	for _, library := range res.Libraries {
+		if seen["lib:"+library.Name] {
+			return nil, fmt.Errorf(tr("library %s is required more than once"), library.Name)
+		}
+		seen["lib:"+library.Name] = true
+	}
+	return &res, nil
+}
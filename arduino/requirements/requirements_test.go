@@ -0,0 +1,46 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package requirements
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	req, err := Load(paths.New("testdata", "requirements.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://espressif.github.io/arduino-esp32/package_esp32_index.json"}, req.AdditionalURLs)
+	require.Len(t, req.Platforms, 2)
+	require.Equal(t, "arduino:avr@1.8.6", req.Platforms[0].String())
+	require.Equal(t, "esp32:esp32", req.Platforms[1].String())
+	require.Nil(t, req.Platforms[1].Version)
+	require.Len(t, req.Libraries, 2)
+	require.Equal(t, "ArduinoJson@6.21.3", req.Libraries[0].String())
+	require.Equal(t, "Adafruit GFX Library", req.Libraries[1].Name)
+	require.Nil(t, req.Libraries[1].Version)
+
+	_, err = Load(paths.New("testdata", "duplicated.yaml"))
+	require.ErrorContains(t, err, "platform arduino:avr is required more than once")
+
+	_, err = Load(paths.New("testdata", "invalid.yaml"))
+	require.ErrorContains(t, err, "invalid platform identifier: arduino-avr")
+
+	_, err = Load(paths.New("testdata", "missing.yaml"))
+	require.Error(t, err)
+}
@@ -30,35 +30,43 @@ import (
 
 	"github.com/arduino/arduino-cli/i18n"
 	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
 )
 
 var tr = i18n.Tr
 
-// TestLocalArchiveChecksum test if the checksum of the local archive match the checksum of the DownloadResource
-func (r *DownloadResource) TestLocalArchiveChecksum(downloadDir *paths.Path) (bool, error) {
+// checksumHash returns the hash algorithm and the expected digest of the checksum of the DownloadResource
+func (r *DownloadResource) checksumHash() (hash.Hash, []byte, error) {
 	if r.Checksum == "" {
-		return false, fmt.Errorf(tr("missing checksum for: %s"), r.ArchiveFileName)
+		return nil, nil, fmt.Errorf(tr("missing checksum for: %s"), r.ArchiveFileName)
 	}
 	split := strings.SplitN(r.Checksum, ":", 2)
 	if len(split) != 2 {
-		return false, fmt.Errorf(tr("invalid checksum format: %s"), r.Checksum)
+		return nil, nil, fmt.Errorf(tr("invalid checksum format: %s"), r.Checksum)
 	}
 	digest, err := hex.DecodeString(split[1])
 	if err != nil {
-		return false, fmt.Errorf(tr("invalid hash '%[1]s': %[2]s"), split[1], err)
+		return nil, nil, fmt.Errorf(tr("invalid hash '%[1]s': %[2]s"), split[1], err)
 	}
 
 	// names based on: https://docs.oracle.com/javase/8/docs/technotes/guides/security/StandardNames.html#MessageDigest
-	var algo hash.Hash
 	switch split[0] {
 	case "SHA-256":
-		algo = crypto.SHA256.New()
+		return crypto.SHA256.New(), digest, nil
 	case "SHA-1":
-		algo = crypto.SHA1.New()
+		return crypto.SHA1.New(), digest, nil
 	case "MD5":
-		algo = crypto.MD5.New()
+		return crypto.MD5.New(), digest, nil
 	default:
-		return false, fmt.Errorf(tr("unsupported hash algorithm: %s"), split[0])
+		return nil, nil, fmt.Errorf(tr("unsupported hash algorithm: %s"), split[0])
+	}
+}
+
+// TestLocalArchiveChecksum test if the checksum of the local archive match the checksum of the DownloadResource
+func (r *DownloadResource) TestLocalArchiveChecksum(downloadDir *paths.Path) (bool, error) {
+	algo, digest, err := r.checksumHash()
+	if err != nil {
+		return false, err
 	}
 
 	filePath, err := r.ArchivePath(downloadDir)
@@ -99,7 +107,8 @@ func (r *DownloadResource) TestLocalArchiveSize(downloadDir *paths.Path) (bool,
 	return true, nil
 }
 
-// TestLocalArchiveIntegrity checks for integrity of the local archive.
+// TestLocalArchiveIntegrity checks for integrity of the local archive. The checksum
+// is not computed again if the archive has been already verified and not modified.
 func (r *DownloadResource) TestLocalArchiveIntegrity(downloadDir *paths.Path) (bool, error) {
 	if cached, err := r.IsCached(downloadDir); err != nil {
 		return false, fmt.Errorf(tr("testing if archive is cached: %s"), err)
@@ -113,10 +122,22 @@ func (r *DownloadResource) TestLocalArchiveIntegrity(downloadDir *paths.Path) (b
 		return false, nil
 	}
 
+	archivePath, err := r.ArchivePath(downloadDir)
+	if err != nil {
+		return false, fmt.Errorf(tr("getting archive path: %s"), err)
+	}
+	if r.isVerified(archivePath) {
+		return true, nil
+	}
 	ok, err := r.TestLocalArchiveChecksum(downloadDir)
 	if err != nil {
 		return false, fmt.Errorf(tr("testing archive checksum: %s"), err)
 	}
+	if ok {
+		if err := r.storeVerification(archivePath); err != nil {
+			logrus.WithError(err).Warn("Could not store the verification of the archive")
+		}
+	}
 	return ok, nil
 }
 
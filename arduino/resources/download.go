@@ -16,41 +16,78 @@
 package resources
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/arduino/arduino-cli/arduino/httpclient"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
 	"go.bug.st/downloader/v2"
 )
 
 // Download performs a download loop using the provided downloader.Config.
 // Messages are passed back to the DownloadProgressCB using label as text for the File field.
 // queryParameter is passed for analysis purposes.
-func (r *DownloadResource) Download(downloadDir *paths.Path, config *downloader.Config, label string, downloadCB rpc.DownloadProgressCB, queryParameter string) error {
+// The size and the checksum of the archive are verified while it's downloaded, the download
+// is aborted with an *IntegrityError as soon as a mismatch is detected. A partially downloaded
+// archive is resumed.
+// If the context is canceled the download is aborted and the partially downloaded file is removed.
+func (r *DownloadResource) Download(ctx context.Context, downloadDir *paths.Path, config *downloader.Config, label string, downloadCB rpc.DownloadProgressCB, queryParameter string) error {
 	path, err := r.ArchivePath(downloadDir)
 	if err != nil {
 		return fmt.Errorf(tr("getting archive path: %s"), err)
 	}
 
-	if _, err := path.Stat(); os.IsNotExist(err) {
+	resume := false
+	if info, err := path.Stat(); os.IsNotExist(err) {
 		// normal download
 	} else if err == nil {
 		// check local file integrity
-		ok, err := r.TestLocalArchiveIntegrity(downloadDir)
-		if err != nil || !ok {
-			if err := path.Remove(); err != nil {
-				return fmt.Errorf(tr("removing corrupted archive file: %s"), err)
-			}
-		} else {
+		if ok, err := r.TestLocalArchiveIntegrity(downloadDir); err == nil && ok {
 			// File is cached, nothing to do here
 			downloadCB.Start(r.URL, label)
 			downloadCB.End(true, tr("%s already downloaded", label))
 			return nil
 		}
+		if r.Size > 0 && info.Size() < r.Size {
+			// partially downloaded file, the download is resumed
+			resume = true
+		} else if err := path.Remove(); err != nil {
+			return fmt.Errorf(tr("removing corrupted archive file: %s"), err)
+		}
 	} else {
 		return fmt.Errorf(tr("getting archive file info: %s"), err)
 	}
-	return httpclient.DownloadFile(path, r.URL, queryParameter, label, downloadCB, config)
+
+	if config == nil {
+		c, err := httpclient.GetDownloaderConfig()
+		if err != nil {
+			return err
+		}
+		config = c
+	}
+	verifier := &verifyingTransport{transport: config.HttpClient.Transport, resource: r, path: path}
+	verifyingConfig := *config
+	verifyingConfig.HttpClient.Transport = verifier
+
+	URLs := append([]string{r.URL}, r.Mirrors...)
+	err = httpclient.DownloadFileFromMirrors(ctx, path, URLs, queryParameter, label, downloadCB, &verifyingConfig)
+	var integrityErr *IntegrityError
+	if resume && errors.As(err, &integrityErr) && ctx.Err() == nil {
+		// The partial file could not be resumed, download it again
+		_ = path.Remove()
+		err = httpclient.DownloadFileFromMirrors(ctx, path, URLs, queryParameter, label, downloadCB, &verifyingConfig)
+	}
+	if ctx.Err() != nil || errors.As(err, &integrityErr) {
+		_ = path.Remove()
+	}
+	if err == nil && verifier.verified {
+		if err := r.storeVerification(path); err != nil {
+			logrus.WithError(err).Warn("Could not store the verification of the archive")
+		}
+	}
+	return err
 }
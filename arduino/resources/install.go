@@ -20,9 +20,9 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/arduino/arduino-cli/arduino/utils"
 	paths "github.com/arduino/go-paths-helper"
 	"github.com/codeclysm/extract/v3"
-	"go.bug.st/cleanup"
 )
 
 // Install installs the resource in three steps:
@@ -31,7 +31,14 @@ import (
 // - the only root dir is moved/renamed to/as the destination directory
 // Note that tempPath and destDir must be on the same filesystem partition
 // otherwise the last step will fail.
-func (release *DownloadResource) Install(downloadDir, tempPath, destDir *paths.Path) error {
+// If the context is canceled the extraction is aborted, the temporary subdir
+// is removed and the destination directory is left untouched.
+// On Windows the extraction and the move use the extended-length form of the
+// paths, so archives with deeply nested files can be installed.
+func (release *DownloadResource) Install(ctx context.Context, downloadDir, tempPath, destDir *paths.Path) error {
+	tempPath = utils.LongPath(tempPath)
+	destDir = utils.LongPath(destDir)
+
 	// Check the integrity of the package
 	if ok, err := release.TestLocalArchiveIntegrity(downloadDir); err != nil {
 		return fmt.Errorf(tr("testing local archive integrity: %s", err))
@@ -61,11 +68,15 @@ func (release *DownloadResource) Install(downloadDir, tempPath, destDir *paths.P
 	defer file.Close()
 
 	// Extract into temp directory
-	ctx, cancel := cleanup.InterruptableContext(context.Background())
-	defer cancel()
 	if err := extract.Archive(ctx, file, tempDir.String(), nil); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 		return fmt.Errorf(tr("extracting archive: %s", err))
 	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 
 	// Check package content and find package root dir
 	root, err := findPackageRoot(tempDir)
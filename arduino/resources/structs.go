@@ -17,7 +17,10 @@ package resources
 
 // DownloadResource has all the information to download a file
 type DownloadResource struct {
-	URL             string
+	URL string
+	// Mirrors are the alternative URLs of the file, tried in order if the
+	// download from URL fails
+	Mirrors         []string
 	ArchiveFileName string
 	Checksum        string
 	Size            int64
@@ -0,0 +1,197 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// IntegrityError is returned when the size or the checksum of a downloaded
+// archive differs from the one specified in the index
+type IntegrityError struct {
+	Message string
+}
+
+func (e *IntegrityError) Error() string {
+	return e.Message
+}
+
+// verifyingTransport checks the size and the checksum of the archive while it's
+// downloaded, so that the download is aborted as soon as a mismatch is detected.
+// The bytes already downloaded are hashed before resuming a partial download.
+type verifyingTransport struct {
+	transport http.RoundTripper
+	resource  *DownloadResource
+	path      *paths.Path
+	// verified is true if the whole archive has been downloaded and verified
+	verified bool
+}
+
+func (t *verifyingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// Redirects and HTTP errors are handled by the client and the downloader
+		return resp, err
+	}
+	body, err := t.verifyingBody(req, resp)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	resp.Body = body
+	return resp, nil
+}
+
+func (t *verifyingTransport) verifyingBody(req *http.Request, resp *http.Response) (io.ReadCloser, error) {
+	r := t.resource
+	offset := int64(0)
+	if req.Header.Get("Range") != "" {
+		// The downloader appends the received data to the partial file, so the
+		// server must send exactly the missing part
+		var start, end, total int64
+		if resp.StatusCode != http.StatusPartialContent {
+			return nil, &IntegrityError{Message: tr("the server doesn't support resuming the download")}
+		}
+		if _, err := fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total); err != nil {
+			return nil, &IntegrityError{Message: tr("invalid Content-Range in the server response: %s", resp.Header.Get("Content-Range"))}
+		}
+		if r.Size > 0 && total != r.Size {
+			return nil, &IntegrityError{Message: fmt.Sprintf("%s: %d != %d", tr("fetched archive size differs from size specified in index"), total, r.Size)}
+		}
+		offset = start
+	}
+	if r.Size > 0 && resp.ContentLength >= 0 && offset+resp.ContentLength != r.Size {
+		return nil, &IntegrityError{Message: fmt.Sprintf("%s: %d != %d", tr("fetched archive size differs from size specified in index"), offset+resp.ContentLength, r.Size)}
+	}
+
+	v := &verifyingReader{body: resp.Body, transport: t, size: offset}
+	if r.Checksum != "" {
+		algo, digest, err := r.checksumHash()
+		if err != nil {
+			return nil, err
+		}
+		if offset > 0 {
+			// Hash the partially downloaded file
+			f, err := t.path.Open()
+			if err != nil {
+				return nil, fmt.Errorf(tr("opening archive file: %s"), err)
+			}
+			defer f.Close()
+			if n, err := io.CopyN(algo, f, offset); err != nil {
+				return nil, &IntegrityError{Message: tr("partial archive is shorter than expected: %d < %d", n, offset)}
+			}
+		}
+		v.hash, v.digest = algo, digest
+	}
+	return v, nil
+}
+
+// verifyingReader hashes and counts the data read from the body, returning an
+// error as soon as the archive is longer than expected and, at the end of the
+// download, if the checksum differs.
+type verifyingReader struct {
+	body      io.ReadCloser
+	transport *verifyingTransport
+	size      int64
+	hash      hash.Hash
+	digest    []byte
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.body.Read(p)
+	v.size += int64(n)
+	if v.hash != nil {
+		v.hash.Write(p[:n])
+	}
+	expectedSize := v.transport.resource.Size
+	if expectedSize > 0 && v.size > expectedSize {
+		return n, &IntegrityError{Message: fmt.Sprintf("%s: %d > %d", tr("fetched archive size differs from size specified in index"), v.size, expectedSize)}
+	}
+	if !errors.Is(err, io.EOF) {
+		return n, err
+	}
+	if expectedSize > 0 && v.size != expectedSize {
+		return n, &IntegrityError{Message: fmt.Sprintf("%s: %d != %d", tr("fetched archive size differs from size specified in index"), v.size, expectedSize)}
+	}
+	if v.hash != nil {
+		if !bytes.Equal(v.hash.Sum(nil), v.digest) {
+			return n, &IntegrityError{Message: tr("archive hash differs from hash in index")}
+		}
+		v.transport.verified = true
+	}
+	return n, err
+}
+
+func (v *verifyingReader) Close() error {
+	return v.body.Close()
+}
+
+// verificationMetadata is stored in the cache next to a verified archive, so
+// that the archive is not hashed again until it's modified
+type verificationMetadata struct {
+	Checksum string    `json:"checksum"`
+	Size     int64     `json:"size"`
+	ModTime  time.Time `json:"mod_time"`
+}
+
+func verificationMetadataPath(archivePath *paths.Path) *paths.Path {
+	return archivePath.Parent().Join(archivePath.Base() + ".verified")
+}
+
+// storeVerification records that the archive matches the checksum of the resource
+func (r *DownloadResource) storeVerification(archivePath *paths.Path) error {
+	info, err := archivePath.Stat()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&verificationMetadata{Checksum: r.Checksum, Size: info.Size(), ModTime: info.ModTime()})
+	if err != nil {
+		return err
+	}
+	return verificationMetadataPath(archivePath).WriteFile(data)
+}
+
+// isVerified returns true if the archive has been already verified against the
+// checksum of the resource and it has not been modified since then
+func (r *DownloadResource) isVerified(archivePath *paths.Path) bool {
+	data, err := verificationMetadataPath(archivePath).ReadFile()
+	if err != nil {
+		return false
+	}
+	var metadata verificationMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return false
+	}
+	info, err := archivePath.Stat()
+	if err != nil {
+		return false
+	}
+	return r.Checksum != "" && metadata.Checksum == r.Checksum &&
+		metadata.Size == info.Size() && metadata.ModTime.Equal(info.ModTime())
+}
@@ -0,0 +1,129 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/downloader/v2"
+)
+
+func TestDownloadVerification(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789abcdef"), 4096)
+	corrupted := bytes.Clone(content)
+	corrupted[len(corrupted)-1] = 'X'
+	digest := sha256.Sum256(content)
+
+	var ranges []string
+	var rangesMutex sync.Mutex
+	mux := http.NewServeMux()
+	serve := func(data []byte) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			rangesMutex.Lock()
+			ranges = append(ranges, r.Header.Get("Range"))
+			rangesMutex.Unlock()
+			http.ServeContent(w, r, "core.zip", time.Time{}, bytes.NewReader(data))
+		}
+	}
+	mux.HandleFunc("/core.zip", serve(content))
+	mux.HandleFunc("/corrupted.zip", serve(corrupted))
+	mux.HandleFunc("/longer.zip", serve(append(bytes.Clone(content), content...)))
+	mux.HandleFunc("/no-resume.zip", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(content)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tmp := paths.New(t.TempDir())
+	archive := tmp.Join("cache", "core.zip")
+	newResource := func(file string) *DownloadResource {
+		return &DownloadResource{
+			URL:             server.URL + "/" + file,
+			ArchiveFileName: "core.zip",
+			CachePath:       "cache",
+			Checksum:        "SHA-256:" + hex.EncodeToString(digest[:]),
+			Size:            int64(len(content)),
+		}
+	}
+	download := func(r *DownloadResource) error {
+		rangesMutex.Lock()
+		ranges = nil
+		rangesMutex.Unlock()
+		return r.Download(context.Background(), tmp, &downloader.Config{}, "core", func(*rpc.DownloadProgress) {}, "")
+	}
+
+	// The verification is stored after the download and the archive is not hashed again
+	r := newResource("core.zip")
+	require.NoError(t, download(r))
+	require.True(t, r.isVerified(archive))
+	ok, err := r.TestLocalArchiveIntegrity(tmp)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// A modified archive is hashed again
+	require.NoError(t, archive.WriteFile(corrupted))
+	require.False(t, r.isVerified(archive))
+	ok, err = r.TestLocalArchiveIntegrity(tmp)
+	require.Error(t, err)
+	require.False(t, ok)
+
+	// A partial archive is resumed
+	require.NoError(t, archive.WriteFile(content[:1000]))
+	require.NoError(t, download(r))
+	require.Equal(t, []string{"bytes=1000-"}, ranges)
+	data, err := archive.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+
+	// A corrupted partial archive is downloaded again
+	require.NoError(t, archive.WriteFile(append([]byte("X"), content[1:1000]...)))
+	require.NoError(t, download(r))
+	require.Equal(t, []string{"bytes=1000-", ""}, ranges)
+	require.True(t, r.isVerified(archive))
+
+	// The archive is downloaded again if the server doesn't support resuming
+	require.NoError(t, archive.WriteFile(content[:1000]))
+	require.NoError(t, download(newResource("no-resume.zip")))
+	data, err = archive.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+
+	// The download fails if the checksum or the size don't match (as soon as the size of
+	// the response is received), the archive is removed
+	require.NoError(t, archive.Remove())
+	err = download(newResource("corrupted.zip"))
+	var integrityErr *IntegrityError
+	require.ErrorAs(t, err, &integrityErr)
+	require.Contains(t, err.Error(), "archive hash differs from hash in index")
+	require.False(t, archive.Exist())
+
+	err = download(newResource("longer.zip"))
+	require.ErrorAs(t, err, &integrityErr)
+	require.True(t, strings.HasPrefix(integrityErr.Message, "fetched archive size differs from size specified in index"))
+	require.False(t, archive.Exist())
+}
@@ -0,0 +1,92 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package serialutils
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PortHolder is a process that keeps a port open
+type PortHolder struct {
+	PID  int
+	Name string
+}
+
+func (h *PortHolder) String() string {
+	return fmt.Sprintf("%s (pid %d)", h.Name, h.PID)
+}
+
+// FindPortHolders returns the processes that are keeping the given port open.
+// The detection relies on the tools available on the running OS (lsof on
+// unix-like systems, the Sysinternals handle tool on Windows), an error is
+// returned if the detection could not be performed.
+func FindPortHolders(port string) ([]*PortHolder, error) {
+	return findPortHolders(port)
+}
+
+// parseLsofOutput parses the output of `lsof -F pc`, made of lines starting
+// with `p<pid>` followed by `c<command>`.
+func parseLsofOutput(output string) []*PortHolder {
+	res := []*PortHolder{}
+	var current *PortHolder
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) < 2 {
+			continue
+		}
+		switch line[0] {
+		case 'p':
+			pid, err := strconv.Atoi(line[1:])
+			if err != nil {
+				current = nil
+				continue
+			}
+			current = &PortHolder{PID: pid}
+			res = append(res, current)
+		case 'c':
+			if current != nil {
+				current.Name = line[1:]
+			}
+		}
+	}
+	return res
+}
+
+var handleLineRegexp = regexp.MustCompile(`^(\S.*?)\s+pid:\s+(\d+)\s+type:\s+File\s+[0-9A-Fa-f]+:\s+(\S+)\s*$`)
+
+// parseHandleOutput parses the output of the Sysinternals `handle -a` tool and
+// returns the processes holding the given device.
+func parseHandleOutput(output string, device string) []*PortHolder {
+	res := []*PortHolder{}
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		match := handleLineRegexp.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if match == nil || !strings.EqualFold(match[3], device) {
+			continue
+		}
+		pid, err := strconv.Atoi(match[2])
+		if err != nil {
+			continue
+		}
+		res = append(res, &PortHolder{PID: pid, Name: match[1]})
+	}
+	return res
+}
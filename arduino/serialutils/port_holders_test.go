@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package serialutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLsofOutput(t *testing.T) {
+	out := "p1234\ncscreen\nf3\np5678\ncpython3\nf4\n"
+	holders := parseLsofOutput(out)
+	require.Len(t, holders, 2)
+	require.Equal(t, &PortHolder{PID: 1234, Name: "screen"}, holders[0])
+	require.Equal(t, &PortHolder{PID: 5678, Name: "python3"}, holders[1])
+	require.Equal(t, "screen (pid 1234)", holders[0].String())
+
+	require.Empty(t, parseLsofOutput(""))
+}
+
+func TestParseHandleOutput(t *testing.T) {
+	out := "" +
+		"putty.exe          pid: 4120   type: File           2A4: \\Device\\USBSER000\n" +
+		"Arduino IDE.exe    pid: 7788   type: File           1F0: \\Device\\USBSER001\n"
+	holders := parseHandleOutput(out, `\Device\USBSER000`)
+	require.Len(t, holders, 1)
+	require.Equal(t, &PortHolder{PID: 4120, Name: "putty.exe"}, holders[0])
+
+	holders = parseHandleOutput(out, `\device\usbser001`)
+	require.Len(t, holders, 1)
+	require.Equal(t, &PortHolder{PID: 7788, Name: "Arduino IDE.exe"}, holders[0])
+
+	require.Empty(t, parseHandleOutput("No matching handles found.\n", `\Device\USBSER000`))
+}
@@ -0,0 +1,76 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+//go:build !windows
+
+package serialutils
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+func findPortHolders(port string) ([]*PortHolder, error) {
+	if lsof, err := exec.LookPath("lsof"); err == nil {
+		// lsof exits with 1 when no process is using the file
+		out, err := exec.Command(lsof, "-F", "pc", "--", port).Output()
+		var exitErr *exec.ExitError
+		if err == nil || (errors.As(err, &exitErr) && exitErr.ExitCode() == 1) {
+			return parseLsofOutput(string(out)), nil
+		}
+	}
+	return findPortHoldersInProc(port)
+}
+
+// findPortHoldersInProc scans the open file descriptors in /proc, it's used
+// when lsof is not available (on Linux only).
+func findPortHoldersInProc(port string) ([]*PortHolder, error) {
+	target, err := filepath.EvalSymlinks(port)
+	if err != nil {
+		return nil, err
+	}
+	procs, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, errors.New(tr("cannot detect the processes using the port: lsof not available"))
+	}
+	res := []*PortHolder{}
+	for _, proc := range procs {
+		pid, err := strconv.Atoi(proc.Name())
+		if err != nil {
+			continue
+		}
+		fdDir := filepath.Join("/proc", proc.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			// Processes of other users are not accessible
+			continue
+		}
+		for _, fd := range fds {
+			if link, err := os.Readlink(filepath.Join(fdDir, fd.Name())); err == nil && link == target {
+				name := ""
+				if comm, err := os.ReadFile(filepath.Join("/proc", proc.Name(), "comm")); err == nil {
+					name = strings.TrimSpace(string(comm))
+				}
+				res = append(res, &PortHolder{PID: pid, Name: name})
+				break
+			}
+		}
+	}
+	return res, nil
+}
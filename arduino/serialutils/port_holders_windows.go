@@ -0,0 +1,66 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package serialutils
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/sys/windows/registry"
+)
+
+func findPortHolders(port string) ([]*PortHolder, error) {
+	device, err := serialDeviceName(port)
+	if err != nil {
+		return nil, err
+	}
+	var handle string
+	for _, tool := range []string{"handle64.exe", "handle.exe"} {
+		if path, err := exec.LookPath(tool); err == nil {
+			handle = path
+			break
+		}
+	}
+	if handle == "" {
+		return nil, errors.New(tr("cannot detect the processes using the port: the Sysinternals handle tool is not available"))
+	}
+	out, err := exec.Command(handle, "-accepteula", "-nobanner", "-a", device).Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseHandleOutput(string(out), device), nil
+}
+
+// serialDeviceName returns the kernel device name (e.g. `\Device\USBSER000`)
+// of the given COM port.
+func serialDeviceName(port string) (string, error) {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, `HARDWARE\DEVICEMAP\SERIALCOMM`, registry.QUERY_VALUE)
+	if err != nil {
+		return "", err
+	}
+	defer key.Close()
+	devices, err := key.ReadValueNames(0)
+	if err != nil {
+		return "", err
+	}
+	for _, device := range devices {
+		if comPort, _, err := key.GetStringValue(device); err == nil && strings.EqualFold(comPort, port) {
+			return device, nil
+		}
+	}
+	return "", errors.New(tr("serial port %s not found", port))
+}
@@ -0,0 +1,165 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package serialutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// ResetSequence describes how a board is put in bootloader mode before an upload.
+type ResetSequence struct {
+	// Touch enables the port touch (open and close the port at TouchBaudrate)
+	Touch bool
+	// TouchBaudrate is the baudrate used to open the port during the touch
+	TouchBaudrate int
+	// DTR and RTS are the states set on the port lines before closing it,
+	// if nil the lines are left unchanged.
+	DTR, RTS *bool
+	// Delay is the time to wait after the touch for the board to reset
+	Delay time.Duration
+	// WaitForUploadPort enables the wait for a new port to appear after the touch
+	WaitForUploadPort bool
+	// WaitTimeout is the maximum time to wait for the new port
+	WaitTimeout time.Duration
+}
+
+// ResetProperties are the board properties that define the reset sequence.
+var ResetProperties = []string{
+	"upload.use_1200bps_touch",
+	"upload.wait_for_upload_port",
+	"upload.reset.baudrate",
+	"upload.reset.dtr",
+	"upload.reset.rts",
+	"upload.reset.delay",
+	"upload.reset.wait_timeout",
+}
+
+// ResetSequenceFromProperties returns the reset sequence defined by the given
+// board properties:
+//
+//	upload.use_1200bps_touch    - enable the port touch (default false)
+//	upload.wait_for_upload_port - wait for a new port after the touch (default false)
+//	upload.reset.baudrate       - baudrate used for the touch (default 1200)
+//	upload.reset.dtr            - DTR state (on or off) set before closing the port
+//	                              (default off, left unchanged on Windows)
+//	upload.reset.rts            - RTS state (on or off) set before closing the port
+//	                              (default unchanged)
+//	upload.reset.delay          - milliseconds to wait after the touch (default 500)
+//	upload.reset.wait_timeout   - maximum milliseconds to wait for the new port (default 10000)
+func ResetSequenceFromProperties(props *properties.Map, goos string) (*ResetSequence, error) {
+	seq := &ResetSequence{
+		Touch:             props.GetBoolean("upload.use_1200bps_touch"),
+		TouchBaudrate:     1200,
+		Delay:             500 * time.Millisecond,
+		WaitForUploadPort: props.GetBoolean("upload.wait_for_upload_port"),
+		WaitTimeout:       10 * time.Second,
+	}
+	if goos != "windows" {
+		// Setting DTR is not required on Windows
+		off := false
+		seq.DTR = &off
+	}
+
+	if v, ok := props.GetOk("upload.reset.baudrate"); ok {
+		baudrate, err := strconv.Atoi(v)
+		if err != nil || baudrate <= 0 {
+			return nil, fmt.Errorf(tr("invalid value for %[1]s: %[2]s"), "upload.reset.baudrate", v)
+		}
+		seq.TouchBaudrate = baudrate
+	}
+	for _, line := range []struct {
+		property string
+		state    **bool
+	}{{"upload.reset.dtr", &seq.DTR}, {"upload.reset.rts", &seq.RTS}} {
+		v, ok := props.GetOk(line.property)
+		if !ok {
+			continue
+		}
+		state, err := parseLineState(v)
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid value for %[1]s: %[2]s"), line.property, v)
+		}
+		*line.state = state
+	}
+	for _, duration := range []struct {
+		property string
+		value    *time.Duration
+	}{{"upload.reset.delay", &seq.Delay}, {"upload.reset.wait_timeout", &seq.WaitTimeout}} {
+		v, ok := props.GetOk(duration.property)
+		if !ok {
+			continue
+		}
+		ms, err := strconv.Atoi(v)
+		if err != nil || ms < 0 {
+			return nil, fmt.Errorf(tr("invalid value for %[1]s: %[2]s"), duration.property, v)
+		}
+		*duration.value = time.Duration(ms) * time.Millisecond
+	}
+	return seq, nil
+}
+
+// IsCustomized returns true if the timings or the port settings of the reset
+// sequence differ from the default ones.
+func (seq *ResetSequence) IsCustomized(goos string) bool {
+	def, _ := ResetSequenceFromProperties(properties.NewMap(), goos)
+	sameState := func(a, b *bool) bool {
+		return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+	}
+	return seq.TouchBaudrate != def.TouchBaudrate ||
+		!sameState(seq.DTR, def.DTR) || !sameState(seq.RTS, def.RTS) ||
+		seq.Delay != def.Delay || seq.WaitTimeout != def.WaitTimeout
+}
+
+// parseLineState parses the state of a serial line, "unchanged" is parsed as nil.
+func parseLineState(v string) (*bool, error) {
+	switch strings.ToLower(v) {
+	case "on", "true", "1":
+		state := true
+		return &state, nil
+	case "off", "false", "0":
+		state := false
+		return &state, nil
+	case "unchanged", "":
+		return nil, nil
+	}
+	return nil, fmt.Errorf(tr("invalid line state: %s"), v)
+}
+
+// String returns a human readable description of the reset sequence.
+func (seq *ResetSequence) String() string {
+	if !seq.Touch {
+		return tr("no reset")
+	}
+	lineState := func(name string, state *bool) string {
+		if state == nil {
+			return name + "=unchanged"
+		} else if *state {
+			return name + "=on"
+		}
+		return name + "=off"
+	}
+	res := tr("%[1]d-bps touch (%[2]s, %[3]s), wait %[4]s",
+		seq.TouchBaudrate, lineState("dtr", seq.DTR), lineState("rts", seq.RTS), seq.Delay)
+	if seq.WaitForUploadPort {
+		res += ", " + tr("wait for upload port up to %s", seq.WaitTimeout)
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package serialutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetSequenceFromProperties(t *testing.T) {
+	props := properties.NewFromHashmap(map[string]string{
+		"upload.use_1200bps_touch":    "true",
+		"upload.wait_for_upload_port": "true",
+	})
+	seq, err := ResetSequenceFromProperties(props, "linux")
+	require.NoError(t, err)
+	require.True(t, seq.Touch)
+	require.True(t, seq.WaitForUploadPort)
+	require.Equal(t, 1200, seq.TouchBaudrate)
+	require.NotNil(t, seq.DTR)
+	require.False(t, *seq.DTR)
+	require.Nil(t, seq.RTS)
+	require.Equal(t, 500*time.Millisecond, seq.Delay)
+	require.Equal(t, 10*time.Second, seq.WaitTimeout)
+	require.Equal(t, "1200-bps touch (dtr=off, rts=unchanged), wait 500ms, wait for upload port up to 10s", seq.String())
+	require.False(t, seq.IsCustomized("linux"))
+
+	seq, err = ResetSequenceFromProperties(props, "windows")
+	require.NoError(t, err)
+	require.Nil(t, seq.DTR)
+
+	props.Set("upload.reset.baudrate", "2400")
+	props.Set("upload.reset.dtr", "unchanged")
+	props.Set("upload.reset.rts", "on")
+	props.Set("upload.reset.delay", "1000")
+	props.Set("upload.reset.wait_timeout", "3000")
+	seq, err = ResetSequenceFromProperties(props, "linux")
+	require.NoError(t, err)
+	require.Equal(t, "2400-bps touch (dtr=unchanged, rts=on), wait 1s, wait for upload port up to 3s", seq.String())
+	require.True(t, seq.IsCustomized("linux"))
+
+	props.Set("upload.reset.rts", "maybe")
+	_, err = ResetSequenceFromProperties(props, "linux")
+	require.EqualError(t, err, "invalid value for upload.reset.rts: maybe")
+
+	props.Set("upload.reset.rts", "off")
+	props.Set("upload.reset.delay", "-1")
+	_, err = ResetSequenceFromProperties(props, "linux")
+	require.EqualError(t, err, "invalid value for upload.reset.delay: -1")
+
+	seq, err = ResetSequenceFromProperties(properties.NewMap(), "linux")
+	require.NoError(t, err)
+	require.Equal(t, "no reset", seq.String())
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
 	"go.bug.st/serial"
 )
@@ -32,20 +33,29 @@ var tr = i18n.Tr
 // is used on many Arduino boards as a signal to put the board in "bootloader"
 // mode.
 func TouchSerialPortAt1200bps(port string) error {
+	seq, _ := ResetSequenceFromProperties(properties.NewMap(), runtime.GOOS)
+	return TouchSerialPort(port, seq)
+}
+
+// TouchSerialPort open and close the serial port as described by the given
+// reset sequence.
+func TouchSerialPort(port string, seq *ResetSequence) error {
 	// Open port
-	p, err := serial.Open(port, &serial.Mode{BaudRate: 1200})
+	p, err := serial.Open(port, &serial.Mode{BaudRate: seq.TouchBaudrate})
 	if err != nil {
-		return errors.WithMessage(err, tr("opening port at 1200bps"))
+		return errors.WithMessage(err, tr("opening port at %dbps", seq.TouchBaudrate))
 	}
 
-	if runtime.GOOS != "windows" {
-		// This is not required on Windows
-		// TODO: Investigate if it can be removed for other OS too
-
-		// Set DTR to false
-		if err = p.SetDTR(false); err != nil {
+	if seq.DTR != nil {
+		if err = p.SetDTR(*seq.DTR); err != nil {
+			p.Close()
+			return errors.WithMessage(err, tr("setting DTR"))
+		}
+	}
+	if seq.RTS != nil {
+		if err = p.SetRTS(*seq.RTS); err != nil {
 			p.Close()
-			return errors.WithMessage(err, tr("setting DTR to OFF"))
+			return errors.WithMessage(err, tr("setting RTS"))
 		}
 	}
 
@@ -56,7 +66,7 @@ func TouchSerialPortAt1200bps(port string) error {
 	// otherwise assert DTR, which would cancel the WDT reset if
 	// it happens within 250 ms. So we wait until the reset should
 	// have already occurred before going on.
-	time.Sleep(500 * time.Millisecond)
+	time.Sleep(seq.Delay)
 
 	return nil
 }
@@ -102,6 +112,18 @@ type ResetProgressCallbacks struct {
 // return a new "bootloader" port as `portToTouch+"0"`.
 // The error is set if the port listing fails.
 func Reset(portToTouch string, wait bool, cb *ResetProgressCallbacks, dryRun bool) (string, error) {
+	seq, _ := ResetSequenceFromProperties(properties.NewMap(), runtime.GOOS)
+	seq.Touch = portToTouch != ""
+	seq.WaitForUploadPort = wait
+	return ResetWithSequence(portToTouch, seq, cb, dryRun)
+}
+
+// ResetWithSequence works like Reset but the touch and the wait are performed
+// as described by the given reset sequence. The touch is skipped if portToTouch
+// is "" (the Touch field of the sequence is ignored) and the wait is skipped if
+// the WaitForUploadPort field of the sequence is false.
+func ResetWithSequence(portToTouch string, seq *ResetSequence, cb *ResetProgressCallbacks, dryRun bool) (string, error) {
+	wait := seq.WaitForUploadPort
 	getPorts := getPortMap // non dry-run default
 	if dryRun {
 		emulatedPort := portToTouch
@@ -137,7 +159,7 @@ func Reset(portToTouch string, wait bool, cb *ResetProgressCallbacks, dryRun boo
 		if dryRun {
 			// do nothing!
 		} else {
-			if err := TouchSerialPortAt1200bps(portToTouch); err != nil && !wait {
+			if err := TouchSerialPort(portToTouch, seq); err != nil && !wait {
 				return "", errors.Errorf(tr("TOUCH: error during reset: %s", err))
 			}
 		}
@@ -150,7 +172,7 @@ func Reset(portToTouch string, wait bool, cb *ResetProgressCallbacks, dryRun boo
 		cb.WaitingForNewSerial()
 	}
 
-	deadline := time.Now().Add(10 * time.Second)
+	deadline := time.Now().Add(seq.WaitTimeout)
 	if dryRun {
 		// use a much lower timeout in dryRun
 		deadline = time.Now().Add(100 * time.Millisecond)
@@ -0,0 +1,162 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package sizediff compares the sizes of the sections and of the symbols of
+// two builds of a sketch, to explain the changes of the size of the firmware.
+package sizediff
+
+import (
+	"debug/elf"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/mapfile"
+	"github.com/arduino/go-paths-helper"
+)
+
+// Report contains the sizes of the sections and of the symbols of a build
+type Report struct {
+	Sections map[string]int64
+	Symbols  map[string]int64
+}
+
+func newReport() *Report {
+	return &Report{
+		Sections: map[string]int64{},
+		Symbols:  map[string]int64{},
+	}
+}
+
+// Load reads the sizes from an ELF file or, if the file has the .map
+// extension, from a map file generated by the GNU linker.
+func Load(file *paths.Path) (*Report, error) {
+	if strings.EqualFold(file.Ext(), ".map") {
+		f, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return ParseMapFile(f)
+	}
+	f, err := elf.Open(file.String())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadELF(f)
+}
+
+// LoadELF reads the sizes of the allocated sections and of the functions and
+// objects defined in an ELF file.
+func LoadELF(f *elf.File) (*Report, error) {
+	report := newReport()
+	for _, section := range f.Sections {
+		if section.Flags&elf.SHF_ALLOC != 0 && section.Size > 0 {
+			report.Sections[section.Name] += int64(section.Size)
+		}
+	}
+	symbols, err := f.Symbols()
+	if err != nil && !errors.Is(err, elf.ErrNoSymbols) {
+		return nil, err
+	}
+	for _, symbol := range symbols {
+		typ := elf.ST_TYPE(symbol.Info)
+		if (typ != elf.STT_FUNC && typ != elf.STT_OBJECT) || symbol.Size == 0 || symbol.Name == "" {
+			continue
+		}
+		report.Symbols[symbol.Name] += int64(symbol.Size)
+	}
+	return report, nil
+}
+
+// ParseMapFile reads the sizes from a map file generated by the GNU linker.
+// The size of each input section is attributed to the symbol it contains:
+// with `-ffunction-sections` and `-fdata-sections` each function and
+// object is placed in its own section.
+func ParseMapFile(in io.Reader) (*Report, error) {
+	mapFile, err := mapfile.Parse(in)
+	if err != nil {
+		return nil, err
+	}
+	report := newReport()
+	for _, section := range mapFile.Sections {
+		if !section.IsAllocated() || section.Size == 0 {
+			continue
+		}
+		report.Sections[section.Name] += int64(section.Size)
+		for _, inputSection := range section.InputSections {
+			if inputSection.Size > 0 && mapfile.IsAllocatedSection(inputSection.Name) {
+				report.Symbols[inputSection.SymbolName()] += int64(inputSection.Size)
+			}
+		}
+	}
+	return report, nil
+}
+
+// Change is the change of the size of a section or of a symbol
+type Change struct {
+	Name    string
+	OldSize int64
+	NewSize int64
+}
+
+// Delta returns the change in size
+func (c *Change) Delta() int64 {
+	return c.NewSize - c.OldSize
+}
+
+// Diff is the difference between two reports
+type Diff struct {
+	// Sections contains all the sections of the two builds, sorted by name
+	Sections []*Change
+	// Symbols contains the symbols whose size changed, from the one that grew
+	// the most to the one that shrank the most
+	Symbols []*Change
+}
+
+// Compare returns the differences between an old and a new report
+func Compare(oldReport, newReport *Report) *Diff {
+	diff := &Diff{
+		Sections: compareSizes(oldReport.Sections, newReport.Sections, true),
+		Symbols:  compareSizes(oldReport.Symbols, newReport.Symbols, false),
+	}
+	sort.Slice(diff.Sections, func(i, j int) bool {
+		return diff.Sections[i].Name < diff.Sections[j].Name
+	})
+	sort.Slice(diff.Symbols, func(i, j int) bool {
+		if diff.Symbols[i].Delta() != diff.Symbols[j].Delta() {
+			return diff.Symbols[i].Delta() > diff.Symbols[j].Delta()
+		}
+		return diff.Symbols[i].Name < diff.Symbols[j].Name
+	})
+	return diff
+}
+
+func compareSizes(oldSizes, newSizes map[string]int64, keepUnchanged bool) []*Change {
+	changes := []*Change{}
+	for name, oldSize := range oldSizes {
+		if newSize := newSizes[name]; newSize != oldSize || keepUnchanged {
+			changes = append(changes, &Change{Name: name, OldSize: oldSize, NewSize: newSize})
+		}
+	}
+	for name, newSize := range newSizes {
+		if _, ok := oldSizes[name]; !ok {
+			changes = append(changes, &Change{Name: name, NewSize: newSize})
+		}
+	}
+	return changes
+}
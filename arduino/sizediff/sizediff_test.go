@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizediff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMapFile(t *testing.T) {
+	report, err := Load(paths.New("..", "mapfile", "testdata", "sketch.ino.map"))
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{
+		".text": 0x1c4,
+		".data": 0x12,
+	}, report.Sections)
+	require.Equal(t, map[string]int64{
+		".vectors (crtatmega328p.o)": 0x68,
+		".text (crtatmega328p.o)":    0x4,
+		"setup":                      0x1c,
+		"_ZN14HardwareSerial17_tx_udr_empty_irqEv": 0x44,
+		"loop":    0xf6,
+		"message": 0x12,
+	}, report.Symbols)
+
+	_, err = ParseMapFile(strings.NewReader("not a map file\n"))
+	require.Error(t, err)
+}
+
+func TestCompare(t *testing.T) {
+	oldReport := &Report{
+		Sections: map[string]int64{".text": 1000, ".data": 20, ".bss": 100},
+		Symbols:  map[string]int64{"setup": 28, "loop": 200, "removed": 50, "same": 10},
+	}
+	newReport := &Report{
+		Sections: map[string]int64{".text": 1100, ".data": 20, ".rodata": 8},
+		Symbols:  map[string]int64{"setup": 20, "loop": 300, "added": 30, "same": 10},
+	}
+	diff := Compare(oldReport, newReport)
+	require.Equal(t, []*Change{
+		{Name: ".bss", OldSize: 100, NewSize: 0},
+		{Name: ".data", OldSize: 20, NewSize: 20},
+		{Name: ".rodata", OldSize: 0, NewSize: 8},
+		{Name: ".text", OldSize: 1000, NewSize: 1100},
+	}, diff.Sections)
+	require.Equal(t, []*Change{
+		{Name: "loop", OldSize: 200, NewSize: 300},
+		{Name: "added", OldSize: 0, NewSize: 30},
+		{Name: "setup", OldSize: 28, NewSize: 20},
+		{Name: "removed", OldSize: 50, NewSize: 0},
+	}, diff.Symbols)
+	require.Equal(t, int64(100), diff.Symbols[0].Delta())
+}
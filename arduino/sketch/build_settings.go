@@ -0,0 +1,396 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectBuildSettings are the build settings of the sketch project file,
+// applied to every build of the sketch
+type ProjectBuildSettings struct {
+	// LDScript is the path, relative to the sketch folder, of the linker
+	// script replacing the one of the board
+	LDScript string `yaml:"ldscript,omitempty"`
+}
+
+// AsYaml outputs the build settings as Yaml
+func (s *ProjectBuildSettings) AsYaml() string {
+	if s == nil || s.LDScript == "" {
+		return ""
+	}
+	return fmt.Sprintf("build:\n  ldscript: %s\n", s.LDScript)
+}
+
+// ProjectMemoryLayout is the custom memory layout of the sketch project file,
+// the keys are the names of the memory regions (e.g. `flash`, `ram`)
+type ProjectMemoryLayout map[string]*MemoryRegion
+
+// AsYaml outputs the memory layout as Yaml
+func (m ProjectMemoryLayout) AsYaml() string {
+	if len(m) == 0 {
+		return ""
+	}
+	res := "memory:\n"
+	for _, name := range m.RegionNames() {
+		region := m[name]
+		res += fmt.Sprintf("  %s:\n", name)
+		if region.Origin != nil {
+			res += fmt.Sprintf("    origin: 0x%X\n", uint64(*region.Origin))
+		}
+		res += fmt.Sprintf("    length: 0x%X\n", uint64(region.Length))
+	}
+	return res
+}
+
+// RegionNames returns the sorted names of the memory regions
+func (m ProjectMemoryLayout) RegionNames() []string {
+	names := []string{}
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MemoryRegion is a region of the memory of the board
+type MemoryRegion struct {
+	Origin *MemorySize `yaml:"origin,omitempty"`
+	Length MemorySize  `yaml:"length"`
+}
+
+// MemorySize is an address or a size in bytes. In the project file it may be
+// written in decimal or hexadecimal (`0x` prefix) notation, optionally
+// followed by a `K` or `M` multiplier (e.g. `0x2000`, `8192`, `8K`).
+type MemorySize uint64
+
+// UnmarshalYAML decodes a MemorySize from YAML source.
+func (s *MemorySize) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var data string
+	if err := unmarshal(&data); err != nil {
+		return err
+	}
+	size, err := ParseMemorySize(data)
+	if err != nil {
+		return err
+	}
+	*s = size
+	return nil
+}
+
+// ParseMemorySize parses a MemorySize
+func ParseMemorySize(in string) (MemorySize, error) {
+	s := strings.ToUpper(strings.TrimSpace(in))
+	s = strings.TrimSuffix(s, "B")
+	multiplier := uint64(1)
+	if strings.HasSuffix(s, "K") {
+		multiplier = 1024
+		s = strings.TrimSuffix(s, "K")
+	} else if strings.HasSuffix(s, "M") {
+		multiplier = 1024 * 1024
+		s = strings.TrimSuffix(s, "M")
+	}
+	var value uint64
+	var err error
+	if strings.HasPrefix(s, "0X") {
+		value, err = strconv.ParseUint(s[2:], 16, 64)
+	} else {
+		value, err = strconv.ParseUint(s, 10, 64)
+	}
+	if err != nil || s == "" {
+		return 0, errors.New(tr("invalid memory size or address: %s", in))
+	}
+	return MemorySize(value * multiplier), nil
+}
+
+// BuildConfiguration is a named set of build settings of the sketch project
+// file (e.g. `debug` or `release`), selected with `compile --config`
+type BuildConfiguration struct {
+	Name string `yaml:"-"`
+	// Optimization is `debug`, `release` or the compiler optimization flags
+	// to use (e.g. `-O2`)
+	Optimization string `yaml:"optimization,omitempty"`
+	// Defines are the preprocessor macros added to the build (e.g. `DEBUG=1`)
+	Defines []string `yaml:"defines,omitempty"`
+	// Warnings is the warnings level: `none`, `default`, `more` or `all`
+	Warnings string `yaml:"warnings,omitempty"`
+	// BuildProperties are additional build properties in the `key=value` form
+	BuildProperties []string `yaml:"build_properties,omitempty"`
+}
+
+// ProjectBuildConfigurations is the list of the build configurations of the
+// sketch project file, in the order they are defined
+type ProjectBuildConfigurations []*BuildConfiguration
+
+// Get returns the build configuration with the given name or nil if not found
+func (c ProjectBuildConfigurations) Get(name string) *BuildConfiguration {
+	for _, configuration := range c {
+		if configuration.Name == name {
+			return configuration
+		}
+	}
+	return nil
+}
+
+// Names returns the names of the build configurations
+func (c ProjectBuildConfigurations) Names() []string {
+	names := []string{}
+	for _, configuration := range c {
+		names = append(names, configuration.Name)
+	}
+	return names
+}
+
+// AsYaml outputs the build configurations as Yaml
+func (c ProjectBuildConfigurations) AsYaml() string {
+	if len(c) == 0 {
+		return ""
+	}
+	res := "configurations:\n"
+	for _, configuration := range c {
+		res += fmt.Sprintf("  %s:\n", configuration.Name)
+		if configuration.Optimization != "" {
+			res += fmt.Sprintf("    optimization: %s\n", configuration.Optimization)
+		}
+		if len(configuration.Defines) > 0 {
+			res += "    defines:\n"
+			for _, define := range configuration.Defines {
+				res += fmt.Sprintf("      - %s\n", define)
+			}
+		}
+		if configuration.Warnings != "" {
+			res += fmt.Sprintf("    warnings: %s\n", configuration.Warnings)
+		}
+		if len(configuration.BuildProperties) > 0 {
+			res += "    build_properties:\n"
+			for _, property := range configuration.BuildProperties {
+				res += fmt.Sprintf("      - %s\n", property)
+			}
+		}
+	}
+	return res
+}
+
+// validWarningsLevels are the warnings levels supported by the builder
+var validWarningsLevels = []string{"none", "default", "more", "all"}
+
+// Validate checks the settings of the build configuration
+func (c *BuildConfiguration) Validate() error {
+	switch {
+	case c.Optimization == "", c.Optimization == "debug", c.Optimization == "release":
+	case strings.HasPrefix(c.Optimization, "-"):
+	default:
+		return errors.New(tr("invalid optimization %[1]s in build configuration %[2]s, use debug, release or the compiler flags", c.Optimization, c.Name))
+	}
+	if c.Warnings != "" && !slices.Contains(validWarningsLevels, c.Warnings) {
+		return errors.New(tr("invalid warnings level %[1]s in build configuration %[2]s, use one of: %[3]s", c.Warnings, c.Name, strings.Join(validWarningsLevels, ", ")))
+	}
+	for _, define := range c.Defines {
+		if define == "" || strings.ContainsAny(define, " \t\"") {
+			return errors.New(tr("invalid define %[1]s in build configuration %[2]s", define, c.Name))
+		}
+	}
+	for _, property := range c.BuildProperties {
+		if !strings.Contains(property, "=") {
+			return errors.New(tr("invalid build property %[1]s in build configuration %[2]s, use the key=value form", property, c.Name))
+		}
+	}
+	return nil
+}
+
+// ProjectLibraryOverrides are the build settings of the sketch project file
+// applied only to the compilation of a library, the keys are the names of the
+// libraries
+type ProjectLibraryOverrides map[string]*LibraryOverride
+
+// LibraryOverride are the extra compiler flags and defines used to compile the
+// source files of a library
+type LibraryOverride struct {
+	// Flags are the extra compiler flags (e.g. `-O3`)
+	Flags []string `yaml:"flags,omitempty"`
+	// Defines are the preprocessor macros added to the build (e.g. `NO_DEBUG`)
+	Defines []string `yaml:"defines,omitempty"`
+}
+
+// Get returns the override of the library with the given name or folder name,
+// or nil if not found
+func (o ProjectLibraryOverrides) Get(name, dirName string) *LibraryOverride {
+	if override, ok := o[name]; ok {
+		return override
+	}
+	if override, ok := o[dirName]; ok {
+		return override
+	}
+	return nil
+}
+
+// LibraryNames returns the sorted names of the overridden libraries
+func (o ProjectLibraryOverrides) LibraryNames() []string {
+	names := []string{}
+	for name := range o {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AsYaml outputs the library overrides as Yaml
+func (o ProjectLibraryOverrides) AsYaml() string {
+	if len(o) == 0 {
+		return ""
+	}
+	res := "library_overrides:\n"
+	for _, name := range o.LibraryNames() {
+		override := o[name]
+		res += fmt.Sprintf("  %s:\n", name)
+		if len(override.Flags) > 0 {
+			res += "    flags:\n"
+			for _, flag := range override.Flags {
+				res += fmt.Sprintf("      - %s\n", flag)
+			}
+		}
+		if len(override.Defines) > 0 {
+			res += "    defines:\n"
+			for _, define := range override.Defines {
+				res += fmt.Sprintf("      - %s\n", define)
+			}
+		}
+	}
+	return res
+}
+
+// CompilerFlags returns the flags and the defines as compiler arguments
+func (o *LibraryOverride) CompilerFlags() string {
+	flags := append([]string{}, o.Flags...)
+	for _, define := range o.Defines {
+		flags = append(flags, "-D"+define)
+	}
+	return strings.Join(flags, " ")
+}
+
+// ProjectLibrarySelections are the libraries selected by the sketch project
+// file to resolve the headers provided by more than one library, overriding
+// the priority of the libraries. The keys are the names of the headers.
+type ProjectLibrarySelections map[string]*LibrarySelection
+
+// LibrarySelection selects a library by name, optionally installed in a
+// specific location, or by path
+type LibrarySelection struct {
+	// Name is the name or the folder name of the library
+	Name string `yaml:"name,omitempty"`
+	// Location is the location of the library: ide, platform, ref-platform,
+	// user, profile, unmanaged or sketch
+	Location string `yaml:"location,omitempty"`
+	// Path is the installation folder of the library, relative to the sketch
+	// folder if not absolute
+	Path string `yaml:"path,omitempty"`
+}
+
+var validLibraryLocations = []string{"ide", "platform", "ref-platform", "user", "profile", "unmanaged", "sketch"}
+
+// UnmarshalYAML decodes a library selection, that may be also the name of the
+// library alone
+func (s *LibrarySelection) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		s.Name = node.Value
+		return nil
+	}
+	type rawSelection LibrarySelection
+	return node.Decode((*rawSelection)(s))
+}
+
+// Validate checks the library selection for the given header
+func (s *LibrarySelection) Validate(header string) error {
+	if (s.Name == "") == (s.Path == "") {
+		return errors.New(tr("invalid library selection for %s, set either the name or the path of the library", header))
+	}
+	if s.Location != "" {
+		if s.Name == "" {
+			return errors.New(tr("invalid library selection for %s, the location can be set only with the name of the library", header))
+		}
+		if !slices.Contains(validLibraryLocations, s.Location) {
+			return errors.New(tr("invalid library location %[1]s in the library selection for %[2]s, use one of: %[3]s", s.Location, header, strings.Join(validLibraryLocations, ", ")))
+		}
+	}
+	return nil
+}
+
+// Validate checks all the library selections
+func (o ProjectLibrarySelections) Validate() error {
+	for _, header := range o.Headers() {
+		if o[header] == nil {
+			return errors.New(tr("invalid library selection for %s, set either the name or the path of the library", header))
+		}
+		if err := o[header].Validate(header); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Headers returns the sorted names of the headers with a library selection
+func (o ProjectLibrarySelections) Headers() []string {
+	headers := []string{}
+	for header := range o {
+		headers = append(headers, header)
+	}
+	sort.Strings(headers)
+	return headers
+}
+
+// AsYaml outputs the library selections as Yaml
+func (o ProjectLibrarySelections) AsYaml() string {
+	if len(o) == 0 {
+		return ""
+	}
+	res := "library_selection:\n"
+	for _, header := range o.Headers() {
+		selection := o[header]
+		if selection.Path == "" && selection.Location == "" {
+			res += fmt.Sprintf("  %s: %s\n", header, selection.Name)
+			continue
+		}
+		res += fmt.Sprintf("  %s:\n", header)
+		if selection.Name != "" {
+			res += fmt.Sprintf("    name: %s\n", selection.Name)
+		}
+		if selection.Location != "" {
+			res += fmt.Sprintf("    location: %s\n", selection.Location)
+		}
+		if selection.Path != "" {
+			res += fmt.Sprintf("    path: %s\n", selection.Path)
+		}
+	}
+	return res
+}
+
+// String returns a description of the library selection
+func (s *LibrarySelection) String() string {
+	if s.Path != "" {
+		return s.Path
+	}
+	if s.Location != "" {
+		return s.Name + " (" + s.Location + ")"
+	}
+	return s.Name
+}
@@ -0,0 +1,39 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import "fmt"
+
+// ProjectCloudSettings binds the sketch to a thing of the Arduino Cloud
+type ProjectCloudSettings struct {
+	ThingID  string `yaml:"thing_id,omitempty"`
+	DeviceID string `yaml:"device_id,omitempty"`
+}
+
+// AsYaml outputs the cloud settings as Yaml
+func (s *ProjectCloudSettings) AsYaml() string {
+	if s == nil || (s.ThingID == "" && s.DeviceID == "") {
+		return ""
+	}
+	res := "cloud:\n"
+	if s.ThingID != "" {
+		res += fmt.Sprintf("  thing_id: %s\n", s.ThingID)
+	}
+	if s.DeviceID != "" {
+		res += fmt.Sprintf("  device_id: %s\n", s.DeviceID)
+	}
+	return res
+}
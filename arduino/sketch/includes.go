@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/go-paths-helper"
+)
+
+var includeRegexp = regexp.MustCompile(`(?m)^\s*#\s*include\s*[<"]([^>"]+)[>"]`)
+
+// Includes returns the sorted list of the headers included by the sketch files
+// that are compiled, excluding the headers provided by the sketch itself.
+// These are the headers that must be provided by libraries or by the platform.
+func (s *Sketch) Includes() ([]string, error) {
+	files := paths.PathList{s.MainFile}
+	files.AddAll(s.OtherSketchFiles)
+	for _, file := range s.AdditionalFiles {
+		if s.IsCompiledFile(file) {
+			files.Add(file)
+		}
+	}
+
+	found := map[string]bool{}
+	for _, file := range files {
+		data, err := file.ReadFile()
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range includeRegexp.FindAllStringSubmatch(string(data), -1) {
+			include := strings.TrimSpace(match[1])
+			if file.Parent().Join(include).Exist() || s.FullPath.Join(include).Exist() {
+				continue
+			}
+			found[include] = true
+		}
+	}
+
+	res := []string{}
+	for include := range found {
+		res = append(res, include)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// IsCompiledFile returns true if the given additional file of the sketch is
+// compiled with the sketch: source and header files are compiled if they are
+// in the sketch root folder or in the `src` folder.
+func (s *Sketch) IsCompiledFile(file *paths.Path) bool {
+	ext := file.Ext()
+	if _, ok := globals.SourceFilesValidExtensions[ext]; !ok && !globals.HeaderFilesValidExtensions[ext] {
+		return false
+	}
+	if file.Parent().EqualsTo(s.FullPath) {
+		return true
+	}
+	inSrc, _ := file.IsInsideDir(s.FullPath.Join("src"))
+	return inSrc
+}
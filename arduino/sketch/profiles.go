@@ -18,6 +18,7 @@ package sketch
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -36,6 +37,19 @@ type projectRaw struct {
 	DefaultFqbn     string    `yaml:"default_fqbn"`
 	DefaultPort     string    `yaml:"default_port,omitempty"`
 	DefaultProtocol string    `yaml:"default_protocol,omitempty"`
+	Partitions      string    `yaml:"partitions,omitempty"`
+
+	Build  *ProjectBuildSettings `yaml:"build,omitempty"`
+	Memory ProjectMemoryLayout   `yaml:"memory,omitempty"`
+
+	ConfigurationsRaw    yaml.Node `yaml:"configurations"`
+	DefaultConfiguration string    `yaml:"default_configuration,omitempty"`
+
+	LibraryOverrides   ProjectLibraryOverrides  `yaml:"library_overrides,omitempty"`
+	LibrarySelection   ProjectLibrarySelections `yaml:"library_selection,omitempty"`
+	AcceptIncompatible []string                 `yaml:"accept_incompatible,omitempty"`
+
+	Cloud *ProjectCloudSettings `yaml:"cloud,omitempty"`
 }
 
 // Project represents the sketch project file
@@ -45,6 +59,21 @@ type Project struct {
 	DefaultFqbn     string
 	DefaultPort     string
 	DefaultProtocol string
+	Partitions      string
+	Build           *ProjectBuildSettings
+	Memory          ProjectMemoryLayout
+
+	Configurations       ProjectBuildConfigurations
+	DefaultConfiguration string
+
+	LibraryOverrides ProjectLibraryOverrides
+	LibrarySelection ProjectLibrarySelections
+
+	// The libraries accepted even if they don't declare the architecture of
+	// the board
+	AcceptIncompatible []string
+
+	Cloud *ProjectCloudSettings
 }
 
 // AsYaml outputs the sketch project file as YAML
@@ -68,6 +97,24 @@ func (p *Project) AsYaml() string {
 	if p.DefaultProtocol != "" {
 		res += fmt.Sprintf("default_protocol: %s\n", p.DefaultProtocol)
 	}
+	if p.Partitions != "" {
+		res += fmt.Sprintf("partitions: %s\n", p.Partitions)
+	}
+	res += p.Build.AsYaml()
+	res += p.Memory.AsYaml()
+	res += p.Configurations.AsYaml()
+	if p.DefaultConfiguration != "" {
+		res += fmt.Sprintf("default_configuration: %s\n", p.DefaultConfiguration)
+	}
+	res += p.LibraryOverrides.AsYaml()
+	res += p.LibrarySelection.AsYaml()
+	if len(p.AcceptIncompatible) > 0 {
+		res += "accept_incompatible:\n"
+		for _, lib := range p.AcceptIncompatible {
+			res += fmt.Sprintf("  - %s\n", lib)
+		}
+	}
+	res += p.Cloud.AsYaml()
 	return res
 }
 
@@ -88,6 +135,28 @@ func (p *projectRaw) getProfiles() []*Profile {
 	return profiles
 }
 
+func (p *projectRaw) getConfigurations() (ProjectBuildConfigurations, error) {
+	configurations := ProjectBuildConfigurations{}
+	for i, node := range p.ConfigurationsRaw.Content {
+		if node.Tag != "!!str" {
+			continue // Node is a map, so it is read out at key.
+		}
+
+		configuration := &BuildConfiguration{Name: node.Value}
+		if err := p.ConfigurationsRaw.Content[i+1].Decode(configuration); err != nil {
+			return nil, err
+		}
+		if err := configuration.Validate(); err != nil {
+			return nil, err
+		}
+		configurations = append(configurations, configuration)
+	}
+	if p.DefaultConfiguration != "" && configurations.Get(p.DefaultConfiguration) == nil {
+		return nil, errors.New(tr("default build configuration %s not found", p.DefaultConfiguration))
+	}
+	return configurations, nil
+}
+
 // UnmarshalYAML decodes a Profiles section from YAML source.
 // Profile is a sketch profile, it contains a reference to all the resources
 // needed to build and upload a sketch
@@ -260,6 +329,13 @@ func LoadProjectFile(file *paths.Path) (*Project, error) {
 	if err := yaml.Unmarshal(data, &raw); err != nil {
 		return nil, err
 	}
+	configurations, err := raw.getConfigurations()
+	if err != nil {
+		return nil, err
+	}
+	if err := raw.LibrarySelection.Validate(); err != nil {
+		return nil, err
+	}
 
 	return &Project{
 		Profiles:        raw.getProfiles(),
@@ -267,5 +343,18 @@ func LoadProjectFile(file *paths.Path) (*Project, error) {
 		DefaultFqbn:     raw.DefaultFqbn,
 		DefaultPort:     raw.DefaultPort,
 		DefaultProtocol: raw.DefaultProtocol,
+		Partitions:      raw.Partitions,
+		Build:           raw.Build,
+		Memory:          raw.Memory,
+
+		Configurations:       configurations,
+		DefaultConfiguration: raw.DefaultConfiguration,
+
+		LibraryOverrides: raw.LibraryOverrides,
+		LibrarySelection: raw.LibrarySelection,
+
+		AcceptIncompatible: raw.AcceptIncompatible,
+
+		Cloud: raw.Cloud,
 	}, nil
 }
@@ -42,4 +42,91 @@ func TestProjectFileLoading(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, proj.AsYaml(), string(golden))
 	}
+	{
+		sketchProj := paths.New("testdata", "SketchWithBuildSettings", "sketch.yml")
+		proj, err := LoadProjectFile(sketchProj)
+		require.NoError(t, err)
+		require.Equal(t, "linker/custom.ld", proj.Build.LDScript)
+		require.Equal(t, "partitions/custom.csv", proj.Partitions)
+		require.Equal(t, MemorySize(0x2000), *proj.Memory["flash"].Origin)
+		require.Equal(t, MemorySize(0x3E000), proj.Memory["flash"].Length)
+		require.Nil(t, proj.Memory["ram"].Origin)
+		require.Equal(t, "-O3 -DARM_MATH_CM4", proj.LibraryOverrides.Get("CMSIS-DSP", "CMSIS_DSP").CompilerFlags())
+		require.Nil(t, proj.LibraryOverrides.Get("Servo", "Servo"))
+		require.Equal(t, &LibrarySelection{Name: "SD"}, proj.LibrarySelection["SD.h"])
+		require.Equal(t, &LibrarySelection{Name: "Servo", Location: "user"}, proj.LibrarySelection["Servo.h"])
+		require.Equal(t, "../libraries/WiFiNINA", proj.LibrarySelection["WiFi.h"].Path)
+		require.Equal(t, []string{"Servo"}, proj.AcceptIncompatible)
+		golden, err := sketchProj.ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, proj.AsYaml(), string(golden))
+	}
+	{
+		sketchProj := paths.New("testdata", "SketchWithBuildConfigurations", "sketch.yml")
+		proj, err := LoadProjectFile(sketchProj)
+		require.NoError(t, err)
+		require.Equal(t, []string{"debug", "release"}, proj.Configurations.Names())
+		require.Equal(t, "debug", proj.DefaultConfiguration)
+		debug := proj.Configurations.Get("debug")
+		require.Equal(t, "debug", debug.Optimization)
+		require.Equal(t, []string{"DEBUG=1", "LOG_LEVEL=3"}, debug.Defines)
+		require.Equal(t, "all", debug.Warnings)
+		require.Nil(t, proj.Configurations.Get("missing"))
+		golden, err := sketchProj.ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, proj.AsYaml(), string(golden))
+	}
+	{
+		sketchProj := paths.New("testdata", "SketchWithCloudThing", "sketch.yml")
+		proj, err := LoadProjectFile(sketchProj)
+		require.NoError(t, err)
+		require.Equal(t, "5fa3c1c4-7c35-4b2e-8d0c-2a4f1f6f0b61", proj.Cloud.ThingID)
+		require.Equal(t, "8d1f2f0e-4a2b-4c55-9a0e-7e6b0d3c2f11", proj.Cloud.DeviceID)
+		golden, err := sketchProj.ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, proj.AsYaml(), string(golden))
+	}
+}
+
+func TestBuildConfigurationValidation(t *testing.T) {
+	require.NoError(t, (&BuildConfiguration{Name: "c", Optimization: "-Os -g"}).Validate())
+	require.Error(t, (&BuildConfiguration{Name: "c", Optimization: "fast"}).Validate())
+	require.Error(t, (&BuildConfiguration{Name: "c", Warnings: "extra"}).Validate())
+	require.Error(t, (&BuildConfiguration{Name: "c", Defines: []string{"A B"}}).Validate())
+	require.Error(t, (&BuildConfiguration{Name: "c", BuildProperties: []string{"key"}}).Validate())
+
+	projectFile := paths.New(t.TempDir()).Join("sketch.yaml")
+	require.NoError(t, projectFile.WriteFile([]byte("configurations:\n  debug:\n    optimization: debug\ndefault_configuration: release\n")))
+	_, err := LoadProjectFile(projectFile)
+	require.Error(t, err)
+}
+
+func TestParseMemorySize(t *testing.T) {
+	for in, expected := range map[string]MemorySize{
+		"0x2000": 0x2000,
+		"8192":   8192,
+		"8K":     8 * 1024,
+		"256KB":  256 * 1024,
+		"0x10K":  16 * 1024,
+		"1M":     1024 * 1024,
+	} {
+		size, err := ParseMemorySize(in)
+		require.NoError(t, err, in)
+		require.Equal(t, expected, size, in)
+	}
+	for _, in := range []string{"", "K", "0x", "12Q", "-1"} {
+		_, err := ParseMemorySize(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestLibrarySelectionValidate(t *testing.T) {
+	require.NoError(t, (&LibrarySelection{Name: "SD"}).Validate("SD.h"))
+	require.NoError(t, (&LibrarySelection{Name: "SD", Location: "platform"}).Validate("SD.h"))
+	require.NoError(t, (&LibrarySelection{Path: "libs/SD"}).Validate("SD.h"))
+	require.Error(t, (&LibrarySelection{}).Validate("SD.h"))
+	require.Error(t, (&LibrarySelection{Name: "SD", Path: "libs/SD"}).Validate("SD.h"))
+	require.Error(t, (&LibrarySelection{Name: "SD", Location: "sketchbook"}).Validate("SD.h"))
+	require.Error(t, (&LibrarySelection{Path: "libs/SD", Location: "user"}).Validate("SD.h"))
+	require.Error(t, ProjectLibrarySelections{"SD.h": nil}.Validate())
 }
@@ -19,10 +19,12 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
@@ -42,8 +44,14 @@ type Sketch struct {
 var tr = i18n.Tr
 
 // New creates an Sketch instance by reading all the files composing a sketch and grouping them
-// by file type.
+// by file type. Symlinks inside the sketch folder are followed.
 func New(path *paths.Path) (*Sketch, error) {
+	return NewWithSymlinksPolicy(path, utils.SymlinksFollow)
+}
+
+// NewWithSymlinksPolicy creates an Sketch instance like New, the symlinks found
+// inside the sketch folder are handled according to the given policy.
+func NewWithSymlinksPolicy(path *paths.Path, symlinksPolicy utils.SymlinksPolicy) (*Sketch, error) {
 	if path == nil {
 		return nil, fmt.Errorf(tr("sketch path is not valid"))
 	}
@@ -106,7 +114,7 @@ func New(path *paths.Path) (*Sketch, error) {
 		return nil, fmt.Errorf(tr("can't find main Sketch file in %s"), path)
 	}
 
-	sketchFolderFiles, err := sketch.supportedFiles()
+	sketchFolderFiles, err := sketch.supportedFiles(symlinksPolicy)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +165,7 @@ func New(path *paths.Path) (*Sketch, error) {
 
 // supportedFiles reads all files recursively contained in Sketch and
 // filter out unneded or unsupported ones and returns them
-func (s *Sketch) supportedFiles() (*paths.PathList, error) {
+func (s *Sketch) supportedFiles(symlinksPolicy utils.SymlinksPolicy) (*paths.PathList, error) {
 	filterValidExtensions := func(p *paths.Path) bool {
 		return globals.MainFileValidExtensions[p.Ext()] || globals.AdditionalFileValidExtensions[p.Ext()]
 	}
@@ -166,7 +174,9 @@ func (s *Sketch) supportedFiles() (*paths.PathList, error) {
 		return !p.Join("build.options.json").Exist()
 	}
 
-	files, err := s.FullPath.ReadDirRecursiveFiltered(
+	files, err := utils.ReadDirRecursiveFiltered(
+		s.FullPath,
+		symlinksPolicy,
 		filterOutBuildPaths,
 		paths.AndFilter(
 			paths.FilterOutPrefixes("."),
@@ -268,6 +278,40 @@ func (s *Sketch) SetDefaultPort(address, protocol string) error {
 	return updateOrAddYamlRootEntry(s.GetProjectPath(), "default_protocol", protocol)
 }
 
+// SaveProject writes the sketch project file (sketch.yaml) with the current
+// content of the Project. The comments of an existing project file are lost.
+func (s *Sketch) SaveProject() error {
+	return s.GetProjectPath().WriteFile([]byte(s.Project.AsYaml()))
+}
+
+// GetPartitionsFile returns the partition table file selected for the sketch (from the sketch.yaml
+// project file), or nil if not set. Relative paths are resolved from the sketch folder.
+func (s *Sketch) GetPartitionsFile() *paths.Path {
+	if s.Project.Partitions == "" {
+		return nil
+	}
+	file := paths.New(s.Project.Partitions)
+	if !file.IsAbs() {
+		file = s.FullPath.JoinPath(file)
+	}
+	return file
+}
+
+// SetPartitionsFile sets the partition table file of the sketch and saves it in the sketch.yaml
+// project file. Files inside the sketch folder are saved with a path relative to the sketch
+// folder. If file is nil the partition table selection is removed.
+func (s *Sketch) SetPartitionsFile(file *paths.Path) error {
+	value := ""
+	if file != nil {
+		value = file.String()
+		if rel, err := file.RelFrom(s.FullPath); err == nil && !strings.HasPrefix(rel.String(), "..") {
+			value = filepath.ToSlash(rel.String())
+		}
+	}
+	s.Project.Partitions = value
+	return updateOrAddYamlRootEntry(s.GetProjectPath(), "partitions", value)
+}
+
 // InvalidSketchFolderNameError is returned when the sketch directory doesn't match the sketch name
 type InvalidSketchFolderNameError struct {
 	SketchFolder *paths.Path
@@ -296,9 +340,23 @@ func CheckForPdeFiles(sketch *paths.Path) []*paths.Path {
 }
 
 // DefaultBuildPath generates the default build directory for a given sketch.
-// The build path is in a temporary directory and is unique for each sketch.
+// The build path is in a temporary directory and is unique for each sketch and
+// for the default build configuration of the sketch project file.
 func (s *Sketch) DefaultBuildPath() *paths.Path {
-	return paths.TempDir().Join("arduino", "sketches", s.Hash())
+	if s.Project == nil {
+		return s.DefaultBuildPathForConfiguration("")
+	}
+	return s.DefaultBuildPathForConfiguration(s.Project.DefaultConfiguration)
+}
+
+// DefaultBuildPathForConfiguration returns the default build path of the sketch
+// for the given build configuration. Each configuration has its own folder so
+// that switching between them doesn't invalidate the cached object files.
+func (s *Sketch) DefaultBuildPathForConfiguration(configuration string) *paths.Path {
+	if configuration == "" {
+		return paths.TempDir().Join("arduino", "sketches", s.Hash())
+	}
+	return paths.TempDir().Join("arduino", "sketches", s.Hash()+"-"+configuration)
 }
 
 // Hash generate a unique hash for the given sketch.
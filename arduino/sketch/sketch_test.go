@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -289,6 +290,12 @@ func TestGenBuildPath(t *testing.T) {
 	want := paths.TempDir().Join("arduino", "sketches", "ACBD18DB4CC2F85CEDEF654FCCC4A4D8")
 	assert.True(t, (&Sketch{FullPath: paths.New("foo")}).DefaultBuildPath().EquivalentTo(want))
 	assert.Equal(t, "ACBD18DB4CC2F85CEDEF654FCCC4A4D8", (&Sketch{FullPath: paths.New("foo")}).Hash())
+
+	sk := &Sketch{FullPath: paths.New("foo"), Project: &Project{DefaultConfiguration: "debug"}}
+	want = paths.TempDir().Join("arduino", "sketches", "ACBD18DB4CC2F85CEDEF654FCCC4A4D8-debug")
+	assert.True(t, sk.DefaultBuildPath().EquivalentTo(want))
+	want = paths.TempDir().Join("arduino", "sketches", "ACBD18DB4CC2F85CEDEF654FCCC4A4D8-release")
+	assert.True(t, sk.DefaultBuildPathForConfiguration("release").EquivalentTo(want))
 }
 
 func TestCheckForPdeFiles(t *testing.T) {
@@ -349,6 +356,26 @@ func TestNewSketchWithSymlink(t *testing.T) {
 	require.Equal(t, sketch.RootFolderFiles.Len(), 0)
 }
 
+func TestNewSketchWithSymlinkRejected(t *testing.T) {
+	sketchPath, _ := paths.New("testdata", "SketchWithSymlink").Abs()
+	srcPath := sketchPath.Join("src")
+
+	// Without symlinks the sketch is loaded
+	sketch, err := NewWithSymlinksPolicy(sketchPath, utils.SymlinksReject)
+	require.NoError(t, err)
+	require.NotNil(t, sketch)
+
+	// Create a symlink in the Sketch folder
+	os.Symlink(sketchPath.Join("some_folder").String(), srcPath.String())
+	defer srcPath.Remove()
+
+	sketch, err = NewWithSymlinksPolicy(sketchPath, utils.SymlinksReject)
+	var symlinkErr *utils.SymlinkNotAllowedError
+	require.ErrorAs(t, err, &symlinkErr)
+	require.Equal(t, srcPath, symlinkErr.Path)
+	require.Nil(t, sketch)
+}
+
 func TestNewSketchWithSymlinkLoop(t *testing.T) {
 	sketchPath, _ := paths.New("testdata", "SketchWithSymlinkLoop").Abs()
 	someSymlinkPath := sketchPath.Join("some_folder", "some_symlink")
@@ -78,7 +78,7 @@ func updateOrAddYamlRootEntry(path *paths.Path, key, newValue string) error {
 		return fmt.Errorf("%s: %w", tr("could not update sketch project file"), err)
 	}
 	dstMap, ok := dst.(map[string]interface{})
-	if !ok {
+	if !ok && dst != nil {
 		return fmt.Errorf(tr("could not update sketch project file"))
 	}
 	writtenValue, notRemoved := dstMap[key]
@@ -74,5 +74,12 @@ func TestYamlUpdate(t *testing.T) {
 		require.NoError(t, err)
 		expected := "default_fqbn: TEST1\n"
 		require.Equal(t, expected, string(updated))
+
+		// Removing the last entry leaves an empty project file
+		err = updateOrAddYamlRootEntry(tmp, "default_fqbn", "")
+		require.NoError(t, err)
+		updated, err = tmp.ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, "\n", string(updated))
 	}
 }
@@ -0,0 +1,87 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package uf2 encodes firmwares in the UF2 format, used by the bootloaders
+// that expose the board as a mass storage drive.
+// The format is documented here: https://github.com/microsoft/uf2
+package uf2
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+const (
+	magicStart0         = 0x0A324655 // "UF2\n"
+	magicStart1         = 0x9E5D5157
+	magicEnd            = 0x0AB16F30
+	flagFamilyIDPresent = 0x00002000
+
+	blockSize   = 512
+	payloadSize = 256
+)
+
+// Segment is a contiguous area of the firmware
+type Segment struct {
+	Address uint32
+	Data    []byte
+}
+
+// Encode returns the UF2 encoding of the firmware segments. The payload of
+// each block is 256 bytes long and aligned to 256 bytes, the gaps are filled
+// with 0xFF. If familyID is not zero it's written in every block.
+func Encode(segments []Segment, familyID uint32) []byte {
+	pages := map[uint32][]byte{}
+	for _, segment := range segments {
+		for i, b := range segment.Data {
+			address := segment.Address + uint32(i)
+			pageAddress := address &^ (payloadSize - 1)
+			page, ok := pages[pageAddress]
+			if !ok {
+				page = make([]byte, payloadSize)
+				for j := range page {
+					page[j] = 0xFF
+				}
+				pages[pageAddress] = page
+			}
+			page[address-pageAddress] = b
+		}
+	}
+	addresses := make([]uint32, 0, len(pages))
+	for address := range pages {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i] < addresses[j] })
+
+	flags := uint32(0)
+	if familyID != 0 {
+		flags |= flagFamilyIDPresent
+	}
+	res := make([]byte, len(addresses)*blockSize)
+	for n, address := range addresses {
+		block := res[n*blockSize : (n+1)*blockSize]
+		binary.LittleEndian.PutUint32(block[0:], magicStart0)
+		binary.LittleEndian.PutUint32(block[4:], magicStart1)
+		binary.LittleEndian.PutUint32(block[8:], flags)
+		binary.LittleEndian.PutUint32(block[12:], address)
+		binary.LittleEndian.PutUint32(block[16:], payloadSize)
+		binary.LittleEndian.PutUint32(block[20:], uint32(n))
+		binary.LittleEndian.PutUint32(block[24:], uint32(len(addresses)))
+		binary.LittleEndian.PutUint32(block[28:], familyID)
+		copy(block[32:], pages[address])
+		binary.LittleEndian.PutUint32(block[blockSize-4:], magicEnd)
+	}
+	return res
+}
@@ -0,0 +1,62 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package uf2
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	data := make([]byte, 300)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	res := Encode([]Segment{{Address: 0x10000080, Data: data}}, 0xe48bff56)
+	// 0x10000080-0x100001AB spans the pages at 0x10000000 and 0x10000100
+	require.Len(t, res, 2*512)
+
+	field := func(block, offset int) uint32 {
+		return binary.LittleEndian.Uint32(res[block*512+offset:])
+	}
+	for block := 0; block < 2; block++ {
+		require.Equal(t, uint32(0x0A324655), field(block, 0))
+		require.Equal(t, uint32(0x9E5D5157), field(block, 4))
+		require.Equal(t, uint32(0x00002000), field(block, 8))
+		require.Equal(t, uint32(256), field(block, 16))
+		require.Equal(t, uint32(block), field(block, 20))
+		require.Equal(t, uint32(2), field(block, 24))
+		require.Equal(t, uint32(0xe48bff56), field(block, 28))
+		require.Equal(t, uint32(0x0AB16F30), field(block, 508))
+	}
+	require.Equal(t, uint32(0x10000000), field(0, 12))
+	require.Equal(t, uint32(0x10000100), field(1, 12))
+
+	// The gap before the segment is filled with 0xFF
+	require.Equal(t, byte(0xFF), res[32+0x7F])
+	require.Equal(t, byte(0), res[32+0x80])
+	require.Equal(t, byte(0x7F), res[32+0xFF])
+	require.Equal(t, byte(0x80), res[512+32])
+	require.Equal(t, byte(0xFF), res[512+32+300-128])
+
+	// Without a family ID the flags are not set
+	res = Encode([]Segment{{Address: 0x2000, Data: []byte{1, 2, 3}}}, 0)
+	require.Len(t, res, 512)
+	require.Equal(t, uint32(0), field(0, 8))
+	require.Equal(t, uint32(0), field(0, 28))
+}
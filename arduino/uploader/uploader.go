@@ -0,0 +1,278 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package uploader provides a client for Pluggable Uploaders.
+// Documentation is available here:
+// https://arduino.github.io/arduino-cli/latest/pluggable-uploader-specification/
+package uploader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/version"
+	"github.com/sirupsen/logrus"
+)
+
+var tr = i18n.Tr
+
+// PluggableUploader is a tool that writes the build artifacts of a sketch to a board.
+type PluggableUploader struct {
+	id                   string
+	processArgs          []string
+	processEnv           []string
+	process              *executils.Process
+	outgoingCommandsPipe io.Writer
+	incomingMessagesChan <-chan *uploaderMessage
+	log                  *logrus.Entry
+
+	// incomingMessagesError is set by the decode loop before closing the
+	// incoming messages channel
+	incomingMessagesError error
+}
+
+type uploaderMessage struct {
+	EventType       string `json:"eventType"`
+	Message         string `json:"message"`
+	Error           bool   `json:"error"`
+	ProtocolVersion int    `json:"protocolVersion"` // Used in HELLO command
+	UpdatedPort     *Port  `json:"updated_port,omitempty"`
+}
+
+// Port is the port used by an upload
+type Port struct {
+	Address       string            `json:"address"`
+	Label         string            `json:"label,omitempty"`
+	Protocol      string            `json:"protocol"`
+	ProtocolLabel string            `json:"protocol_label,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+}
+
+// Request is the description of an action sent to the uploader with the
+// UPLOAD command
+type Request struct {
+	// Action is `upload`, `program`, `erase`, `bootloader` or the name of an
+	// additional action of the upload tool (e.g., `restore`).
+	Action      string            `json:"action"`
+	FQBN        string            `json:"fqbn"`
+	Port        *Port             `json:"port,omitempty"`
+	BuildPath   string            `json:"build_path,omitempty"`
+	ProjectName string            `json:"project_name,omitempty"`
+	Verbose     bool              `json:"verbose"`
+	Verify      bool              `json:"verify"`
+	Properties  map[string]string `json:"properties,omitempty"`
+}
+
+// New creates a client for the pluggable uploader started with the given
+// command line and the additional environment variables
+func New(id string, env []string, args ...string) *PluggableUploader {
+	return &PluggableUploader{
+		id:          id,
+		processArgs: args,
+		processEnv:  env,
+		log:         logrus.WithField("uploader", id),
+	}
+}
+
+// GetID returns the identifier for this uploader
+func (up *PluggableUploader) GetID() string {
+	return up.id
+}
+
+func (up *PluggableUploader) String() string {
+	return up.id
+}
+
+func jsonDecodeLoop(in io.Reader, outChan chan<- *uploaderMessage, log *logrus.Entry, lastError *error) {
+	decoder := json.NewDecoder(in)
+
+	for {
+		var msg uploaderMessage
+		if err := decoder.Decode(&msg); err != nil {
+			*lastError = err
+			close(outChan)
+			log.Errorf("stopped decode loop: %s", err)
+			return
+		}
+		log.WithField("event_type", msg.EventType).
+			WithField("message", msg.Message).
+			WithField("error", msg.Error).
+			Infof("received message")
+		outChan <- &msg
+	}
+}
+
+// waitMessage waits for the next message that is not an `output` event,
+// writing the output events to the streams.
+func (up *PluggableUploader) waitMessage(timeout time.Duration, expectedEvt string, outStream, errStream io.Writer) (*uploaderMessage, error) {
+	up.log.WithField("expected", expectedEvt).Debugf("waiting for event")
+	var msg *uploaderMessage
+	for msg == nil {
+		select {
+		case m, ok := <-up.incomingMessagesChan:
+			if !ok {
+				// channel has been closed
+				return nil, up.incomingMessagesError
+			}
+			if m.EventType != "output" {
+				msg = m
+				continue
+			}
+			stream := outStream
+			if m.Error {
+				stream = errStream
+			}
+			if stream != nil {
+				io.WriteString(stream, m.Message)
+			}
+		case <-time.After(timeout):
+			return nil, fmt.Errorf(tr("timeout waiting for message"))
+		}
+	}
+	if msg.EventType != expectedEvt {
+		return msg, fmt.Errorf(tr("communication out of sync, expected '%[1]s', received '%[2]s'"), expectedEvt, msg.EventType)
+	}
+	if msg.Error {
+		return msg, fmt.Errorf(tr("command '%[1]s' failed: %[2]s"), expectedEvt, msg.Message)
+	}
+	if strings.ToUpper(msg.Message) != "OK" {
+		return msg, fmt.Errorf(tr("communication out of sync, expected '%[1]s', received '%[2]s'"), "OK", msg.Message)
+	}
+	return msg, nil
+}
+
+func (up *PluggableUploader) sendCommand(command string) error {
+	up.log.WithField("command", strings.TrimSpace(command)).Infof("sending command")
+	data := []byte(command)
+	for {
+		n, err := up.outgoingCommandsPipe.Write(data)
+		if err != nil {
+			return err
+		}
+		if n == len(data) {
+			return nil
+		}
+		data = data[n:]
+	}
+}
+
+func (up *PluggableUploader) runProcess(ctx context.Context) error {
+	up.log.Infof("Starting uploader process")
+	proc, err := executils.NewProcess(up.processEnv, up.processArgs...)
+	if err != nil {
+		return err
+	}
+	stdout, err := proc.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stdin, err := proc.StdinPipe()
+	if err != nil {
+		return err
+	}
+	up.outgoingCommandsPipe = stdin
+	up.process = proc
+
+	if err := up.process.StartWithinContext(ctx); err != nil {
+		return err
+	}
+
+	messageChan := make(chan *uploaderMessage)
+	up.incomingMessagesChan = messageChan
+	go jsonDecodeLoop(stdout, messageChan, up.log, &up.incomingMessagesError)
+
+	up.log.Infof("Uploader process started successfully!")
+	return nil
+}
+
+func (up *PluggableUploader) killProcess() {
+	up.log.Infof("Killing uploader process")
+	if err := up.process.KillProcessGroup(); err != nil {
+		up.log.WithError(err).Error("Sent kill signal")
+	}
+	if err := up.process.Wait(); err != nil {
+		up.log.WithError(err).Error("Waiting for process end")
+	}
+	up.log.Infof("Uploader process killed")
+}
+
+// Run starts the uploader executable process and sends the HELLO command to the uploader to agree on the
+// pluggable uploader protocol. This must be the first command to run in the communication with the uploader.
+// If the process is started but the HELLO command fails the process is killed.
+// The uploader process, and all the processes it spawned, are killed if the
+// given context is canceled.
+func (up *PluggableUploader) Run(ctx context.Context) (err error) {
+	if err = up.runProcess(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		// If the uploader process is started successfully but the HELLO handshake
+		// fails the uploader is in an unusable state, we kill the process to avoid
+		// further issues down the line.
+		if err != nil {
+			up.killProcess()
+		}
+	}()
+
+	if err = up.sendCommand("HELLO 1 \"arduino-cli " + version.VersionInfo.VersionString + "\"\n"); err != nil {
+		return err
+	}
+	if msg, err := up.waitMessage(time.Second*10, "hello", nil, nil); err != nil {
+		return err
+	} else if msg.ProtocolVersion > 1 {
+		return fmt.Errorf(tr("protocol version not supported: requested %[1]d, got %[2]d"), 1, msg.ProtocolVersion)
+	}
+	return nil
+}
+
+// Upload sends the UPLOAD command and waits for its completion, writing the
+// output of the uploader to the streams. It returns the port of the board
+// after the upload if the uploader reports a change.
+func (up *PluggableUploader) Upload(req *Request, outStream, errStream io.Writer) (*Port, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := up.sendCommand("UPLOAD " + string(data) + "\n"); err != nil {
+		return nil, err
+	}
+	// Flashing a large firmware may take minutes, the uploader is expected to
+	// report its progress through output events
+	msg, err := up.waitMessage(time.Minute*10, "upload", outStream, errStream)
+	if err != nil {
+		return nil, err
+	}
+	return msg.UpdatedPort, nil
+}
+
+// Quit terminates the uploader. No more commands can be accepted by the uploader.
+func (up *PluggableUploader) Quit() error {
+	defer up.killProcess() // ensure that killProcess is called in any case...
+
+	if err := up.sendCommand("QUIT\n"); err != nil {
+		return err
+	}
+	if _, err := up.waitMessage(time.Millisecond*250, "quit", nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package uploader
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain runs the test binary as a dummy uploader when requested by the
+// environment, so the tests don't need an external tool.
+func TestMain(m *testing.M) {
+	if os.Getenv("DUMMY_UPLOADER") == "1" {
+		dummyUploader()
+		return
+	}
+	os.Exit(m.Run())
+}
+
+func dummyUploader() {
+	send := func(msg map[string]interface{}) {
+		data, _ := json.Marshal(msg)
+		fmt.Println(string(data))
+	}
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		command, args, _ := strings.Cut(scanner.Text(), " ")
+		switch command {
+		case "HELLO":
+			send(map[string]interface{}{"eventType": "hello", "protocolVersion": 1, "message": "OK"})
+		case "UPLOAD":
+			var req Request
+			if err := json.Unmarshal([]byte(args), &req); err != nil {
+				send(map[string]interface{}{"eventType": "upload", "error": true, "message": err.Error()})
+				continue
+			}
+			if req.Action != "upload" {
+				send(map[string]interface{}{"eventType": "upload", "error": true, "message": "unsupported action " + req.Action})
+				continue
+			}
+			send(map[string]interface{}{"eventType": "output", "message": "Flashing " + req.ProjectName + ".bin to " + req.Port.Address + "\n"})
+			send(map[string]interface{}{"eventType": "output", "message": "speed " + req.Properties["upload.speed"] + "\n", "error": true})
+			send(map[string]interface{}{"eventType": "upload", "message": "OK", "updated_port": map[string]string{"address": "/dev/ttyACM1", "protocol": "serial"}})
+		case "QUIT":
+			send(map[string]interface{}{"eventType": "quit", "message": "OK"})
+			return
+		}
+	}
+}
+
+func TestDummyUploader(t *testing.T) {
+	up := New("dummy", []string{"DUMMY_UPLOADER=1"}, os.Args[0])
+	require.NoError(t, up.Run(context.Background()))
+
+	var out, errOut bytes.Buffer
+	port, err := up.Upload(&Request{
+		Action:      "upload",
+		FQBN:        "test:test:board",
+		Port:        &Port{Address: "/dev/ttyACM0", Protocol: "serial"},
+		ProjectName: "Blink.ino",
+		Properties:  map[string]string{"upload.speed": "115200"},
+	}, &out, &errOut)
+	require.NoError(t, err)
+	require.Equal(t, "Flashing Blink.ino.bin to /dev/ttyACM0\n", out.String())
+	require.Equal(t, "speed 115200\n", errOut.String())
+	require.Equal(t, &Port{Address: "/dev/ttyACM1", Protocol: "serial"}, port)
+
+	_, err = up.Upload(&Request{Action: "erase"}, &out, &errOut)
+	require.EqualError(t, err, "command 'upload' failed: unsupported action erase")
+
+	require.NoError(t, up.Quit())
+}
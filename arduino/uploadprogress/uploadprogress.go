@@ -0,0 +1,281 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package uploadprogress converts the textual progress printed by the upload
+// tools (avrdude, esptool, bossac, openocd) into structured progress events.
+package uploadprogress
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/i18n"
+)
+
+var tr = i18n.Tr
+
+// Progress is the progress of a stage of the upload
+type Progress struct {
+	// Stage is the operation in progress, e.g. "Writing" or "Verifying"
+	Stage string
+	// Percent is the completion of the stage
+	Percent float32
+	// Completed is true when the stage is complete
+	Completed bool
+}
+
+// Parser extracts the progress from the output of an upload tool
+type Parser interface {
+	// Parse returns the progress reported by the line of the tool output, or
+	// nil if there is none. The line is passed while it's being printed, with
+	// complete set to false, and again with complete set to true when it's
+	// terminated.
+	Parse(line string, complete bool) *Progress
+}
+
+// Parsers returns the parsers of all the supported upload tools
+func Parsers() []Parser {
+	return []Parser{&AvrdudeParser{}, &EsptoolParser{}, &BossacParser{}, &OpenOCDParser{}}
+}
+
+// AvrdudeParser parses the progress bars of avrdude:
+//
+//	Writing | ################################################## | 100% 0.16s
+//
+// The bar is printed one '#' at a time, every '#' is 2%.
+type AvrdudeParser struct {
+	written bool
+}
+
+var avrdudeBar = regexp.MustCompile(`^(Reading|Writing) \| (#*)`)
+
+// Parse implements Parser
+func (p *AvrdudeParser) Parse(line string, complete bool) *Progress {
+	m := avrdudeBar.FindStringSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	stage := tr("Writing")
+	if m[1] == "Reading" {
+		// The memories are read after the write to verify them
+		stage = tr("Reading")
+		if p.written {
+			stage = tr("Verifying")
+		}
+	}
+	percent := min(len(m[2])*2, 100)
+	if complete && m[1] == "Writing" {
+		p.written = true
+	}
+	return &Progress{Stage: stage, Percent: float32(percent), Completed: percent == 100}
+}
+
+// EsptoolParser parses the progress of esptool:
+//
+//	Writing at 0x00010000... (25 %)
+//	Wrote 262144 bytes (132481 compressed) at 0x00010000 in 2.3 seconds
+//	Hash of data verified.
+type EsptoolParser struct{}
+
+var (
+	esptoolWriting = regexp.MustCompile(`^Writing at 0x[0-9a-fA-F]+\.\.\. \((\d+) ?%\)`)
+	esptoolWrote   = regexp.MustCompile(`^Wrote \d+ bytes`)
+)
+
+// Parse implements Parser
+func (p *EsptoolParser) Parse(line string, complete bool) *Progress {
+	if !complete {
+		return nil
+	}
+	switch {
+	case strings.HasPrefix(line, "Erasing flash"):
+		return &Progress{Stage: tr("Erasing")}
+	case strings.HasPrefix(line, "Chip erase completed successfully"):
+		return &Progress{Stage: tr("Erasing"), Percent: 100, Completed: true}
+	case esptoolWrote.MatchString(line):
+		return &Progress{Stage: tr("Writing"), Percent: 100, Completed: true}
+	case strings.HasPrefix(line, "Hash of data verified"):
+		return &Progress{Stage: tr("Verifying"), Percent: 100, Completed: true}
+	}
+	if m := esptoolWriting.FindStringSubmatch(line); m != nil {
+		// The 100% line is followed by the "Wrote..." line
+		percent, _ := strconv.Atoi(m[1])
+		return &Progress{Stage: tr("Writing"), Percent: float32(min(percent, 99))}
+	}
+	return nil
+}
+
+// BossacParser parses the progress of bossac, the stage is printed before
+// the progress bar:
+//
+//	Write 14520 bytes to flash (57 pages)
+//	[==============================] 100% (57/57 pages)
+type BossacParser struct {
+	stage string
+}
+
+var (
+	bossacStage = regexp.MustCompile(`^(Erase|Write|Verify|Read) `)
+	bossacBar   = regexp.MustCompile(`\] ?(\d+)% \(\d+/\d+ pages\)`)
+)
+
+// Parse implements Parser
+func (p *BossacParser) Parse(line string, complete bool) *Progress {
+	if !complete {
+		return nil
+	}
+	if m := bossacStage.FindStringSubmatch(line); m != nil {
+		p.stage = map[string]string{
+			"Erase":  tr("Erasing"),
+			"Write":  tr("Writing"),
+			"Verify": tr("Verifying"),
+			"Read":   tr("Reading"),
+		}[m[1]]
+		return &Progress{Stage: p.stage}
+	}
+	if m := bossacBar.FindStringSubmatch(line); m != nil && p.stage != "" {
+		percent, _ := strconv.Atoi(m[1])
+		return &Progress{Stage: p.stage, Percent: float32(percent), Completed: percent == 100}
+	}
+	return nil
+}
+
+// OpenOCDParser parses the messages of the program command of openocd, that
+// doesn't print the percentage of the progress:
+//
+//	** Programming Started **
+//	** Programming Finished **
+//	** Verify Started **
+//	** Verified OK **
+type OpenOCDParser struct{}
+
+// Parse implements Parser
+func (p *OpenOCDParser) Parse(line string, complete bool) *Progress {
+	if !complete {
+		return nil
+	}
+	switch strings.TrimSpace(line) {
+	case "** Programming Started **":
+		return &Progress{Stage: tr("Writing")}
+	case "** Programming Finished **":
+		return &Progress{Stage: tr("Writing"), Percent: 100, Completed: true}
+	case "** Verify Started **":
+		return &Progress{Stage: tr("Verifying")}
+	case "** Verified OK **":
+		return &Progress{Stage: tr("Verifying"), Percent: 100, Completed: true}
+	}
+	return nil
+}
+
+// Writer is an io.Writer that forwards the output of an upload tool and
+// reports the progress found in it. The lines are separated by '\n' or '\r',
+// since the progress bars are often redrawn on the same line.
+type Writer struct {
+	out       io.Writer
+	parsers   []Parser
+	cb        func(*Progress)
+	hide      bool
+	mux       sync.Mutex
+	line      []byte
+	last      Progress
+	reporting bool
+}
+
+// NewWriter returns a Writer that forwards the output to out and calls cb
+// for each progress found by the parsers
+func NewWriter(out io.Writer, parsers []Parser, cb func(*Progress)) *Writer {
+	return &Writer{out: out, parsers: parsers, cb: cb}
+}
+
+// NewFilterWriter returns a Writer that forwards the output to out except
+// the lines reporting a progress, to show the progress in a different way.
+// The lines are forwarded when they are complete.
+func NewFilterWriter(out io.Writer, parsers []Parser) *Writer {
+	return &Writer{out: out, parsers: parsers, hide: true}
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	// The output is forwarded line by line, to report the progress of each
+	// line before the following output
+	data := p
+	for len(data) > 0 {
+		i := bytes.IndexAny(data, "\r\n")
+		if i == -1 {
+			if !w.hide {
+				if _, err := w.out.Write(data); err != nil {
+					return 0, err
+				}
+			}
+			w.line = append(w.line, data...)
+			w.parse(false)
+			break
+		}
+		if !w.hide {
+			if _, err := w.out.Write(data[:i+1]); err != nil {
+				return 0, err
+			}
+		}
+		w.line = append(w.line, data[:i+1]...)
+		data = data[i+1:]
+		progress := w.parse(true)
+		if w.hide && !progress {
+			if _, err := w.out.Write(w.line); err != nil {
+				return 0, err
+			}
+		}
+		w.line = w.line[:0]
+	}
+	return len(p), nil
+}
+
+// Close forwards the last line if not terminated
+func (w *Writer) Close() error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if w.hide && len(w.line) > 0 && !w.parse(true) {
+		_, err := w.out.Write(w.line)
+		return err
+	}
+	return nil
+}
+
+// parse passes the current line to the parsers, it returns true if the line
+// reports a progress.
+func (w *Writer) parse(complete bool) bool {
+	line := strings.TrimRight(string(w.line), "\r\n")
+	if line == "" {
+		return false
+	}
+	found := false
+	for _, parser := range w.parsers {
+		progress := parser.Parse(line, complete)
+		if progress == nil {
+			continue
+		}
+		found = true
+		if w.cb != nil && (!w.reporting || *progress != w.last) {
+			w.last = *progress
+			w.reporting = true
+			w.cb(progress)
+		}
+	}
+	return found
+}
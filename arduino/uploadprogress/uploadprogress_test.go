@@ -0,0 +1,123 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package uploadprogress
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func collect(t *testing.T, chunks ...string) ([]Progress, string) {
+	progress := []Progress{}
+	out := &bytes.Buffer{}
+	w := NewWriter(out, Parsers(), func(p *Progress) { progress = append(progress, *p) })
+	for _, chunk := range chunks {
+		n, err := w.Write([]byte(chunk))
+		require.NoError(t, err)
+		require.Equal(t, len(chunk), n)
+	}
+	require.NoError(t, w.Close())
+	return progress, out.String()
+}
+
+func TestAvrdude(t *testing.T) {
+	chunks := []string{
+		"avrdude: reading input file\n\nReading | ",
+		"##################################################",
+		" | 100% 0.00s\n\navrdude: writing flash (924 bytes):\n\nWriting | ",
+	}
+	for i := 0; i < 50; i++ {
+		chunks = append(chunks, "#")
+	}
+	chunks = append(chunks, " | 100% 0.16s\n\nReading | ####################", "############################## | 100% 0.13s\n")
+	progress, out := collect(t, chunks...)
+	require.Contains(t, out, "Writing | ####")
+
+	require.Equal(t, Progress{Stage: "Reading", Percent: 0}, progress[0])
+	require.Equal(t, Progress{Stage: "Reading", Percent: 100, Completed: true}, progress[1])
+	require.Equal(t, Progress{Stage: "Writing", Percent: 0}, progress[2])
+	require.Equal(t, Progress{Stage: "Writing", Percent: 2}, progress[3])
+	require.Equal(t, Progress{Stage: "Writing", Percent: 100, Completed: true}, progress[52])
+	require.Equal(t, Progress{Stage: "Verifying", Percent: 40}, progress[53])
+	require.Equal(t, Progress{Stage: "Verifying", Percent: 100, Completed: true}, progress[54])
+	require.Len(t, progress, 55)
+}
+
+func TestEsptool(t *testing.T) {
+	progress, _ := collect(t,
+		"Erasing flash (this may take a while)...\n",
+		"Chip erase completed successfully in 2.1s\n",
+		"Writing at 0x00010000... (25 %)\r",
+		"Writing at 0x00014000... (50 %)\rWriting at 0x00018000... (100 %)\n",
+		"Wrote 262144 bytes (132481 compressed) at 0x00010000 in 2.3 seconds (effective 911.8 kbit/s)...\n",
+		"Hash of data verified.\n",
+	)
+	require.Equal(t, []Progress{
+		{Stage: "Erasing"},
+		{Stage: "Erasing", Percent: 100, Completed: true},
+		{Stage: "Writing", Percent: 25},
+		{Stage: "Writing", Percent: 50},
+		{Stage: "Writing", Percent: 99},
+		{Stage: "Writing", Percent: 100, Completed: true},
+		{Stage: "Verifying", Percent: 100, Completed: true},
+	}, progress)
+}
+
+func TestBossac(t *testing.T) {
+	progress, _ := collect(t,
+		"Erase flash\n",
+		"Write 14520 bytes to flash (57 pages)\n",
+		"\r[=====                         ] 17% (10/57 pages)",
+		"\r[==============================] 100% (57/57 pages)\n",
+		"Verify 14520 bytes of flash\n",
+		"\r[==============================] 100% (57/57 pages)\n",
+	)
+	require.Equal(t, []Progress{
+		{Stage: "Erasing"},
+		{Stage: "Writing"},
+		{Stage: "Writing", Percent: 17},
+		{Stage: "Writing", Percent: 100, Completed: true},
+		{Stage: "Verifying"},
+		{Stage: "Verifying", Percent: 100, Completed: true},
+	}, progress)
+}
+
+func TestOpenOCD(t *testing.T) {
+	progress, _ := collect(t,
+		"** Programming Started **\n",
+		"Info : Padding image section 0 at 0x08004fe8 with 8 bytes\n",
+		"** Programming Finished **\n** Verify Started **\n** Verified OK **\n** Resetting Target **\n",
+	)
+	require.Equal(t, []Progress{
+		{Stage: "Writing"},
+		{Stage: "Writing", Percent: 100, Completed: true},
+		{Stage: "Verifying"},
+		{Stage: "Verifying", Percent: 100, Completed: true},
+	}, progress)
+}
+
+func TestFilterWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := NewFilterWriter(out, Parsers())
+	w.Write([]byte("Sketch uses 924 bytes\nWriting at 0x00010000... (25 %)\rWriting at 0x00014000... (50 %)\n"))
+	w.Write([]byte("Writing | ####"))
+	w.Write([]byte("## | 100% 0.16s\nLeaving...\nHard resetting"))
+	require.Equal(t, "Sketch uses 924 bytes\nLeaving...\n", out.String())
+	require.NoError(t, w.Close())
+	require.Equal(t, "Sketch uses 924 bytes\nLeaving...\nHard resetting", out.String())
+}
@@ -16,7 +16,8 @@
 package utils
 
 // SanitizeName replaces with underscores all chars that are not included
-// in the ranges: 0-9, A-Z, a-z, "-" and "."
+// in the ranges: 0-9, A-Z, a-z, "-" and ".". An underscore is also appended
+// to the names reserved by Windows, since they can't be used as directory names.
 func SanitizeName(origName string) string {
 	sanitized := ""
 	for i, c := range origName {
@@ -32,5 +33,8 @@ func SanitizeName(origName string) string {
 	if len(sanitized) > 63 {
 		sanitized = sanitized[:64]
 	}
+	if IsReservedName(sanitized) {
+		sanitized += "_"
+	}
 	return sanitized
 }
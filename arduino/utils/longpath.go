@@ -0,0 +1,87 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// WindowsMaxPath is the maximum length of a path supported by the Windows
+// programs (like most toolchains) that are not aware of long paths.
+const WindowsMaxPath = 260
+
+// windowsReservedNames are the device names that can't be used as file or
+// directory names on Windows, even if followed by an extension.
+var windowsReservedNames = []string{"CON", "PRN", "AUX", "NUL",
+	"COM0", "COM1", "COM2", "COM3", "COM4", "COM5", "COM6", "COM7", "COM8", "COM9",
+	"LPT0", "LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9"}
+
+// IsReservedName returns true if the given file or directory name is a device
+// name reserved by Windows. The check is case insensitive and ignores the
+// extension, for example "aux.h" is reserved too.
+func IsReservedName(name string) bool {
+	stem, _, _ := strings.Cut(name, ".")
+	stem = strings.TrimRight(stem, " ")
+	for _, reserved := range windowsReservedNames {
+		if strings.EqualFold(stem, reserved) {
+			return true
+		}
+	}
+	return false
+}
+
+// LongPath returns the given path converted to the Windows extended-length
+// form (\\?\C:\dir or \\?\UNC\server\share\dir) that allows the Windows API
+// to handle paths longer than WindowsMaxPath. Relative paths are made absolute
+// before the conversion. On the other OS the path is returned unchanged.
+func LongPath(p *paths.Path) *paths.Path {
+	if runtime.GOOS != "windows" {
+		return p
+	}
+	abs, err := p.Abs()
+	if err != nil {
+		return p
+	}
+	return paths.New(toLongPath(abs.String()))
+}
+
+// toLongPath converts an absolute Windows path to the extended-length form.
+// The extended-length form disables the path normalization done by Windows, so
+// the input must be already cleaned and use backslashes as separators.
+func toLongPath(p string) string {
+	switch {
+	case strings.HasPrefix(p, `\\?\`), strings.HasPrefix(p, `\\.\`):
+		// Already in extended-length form or a device path
+		return p
+	case strings.HasPrefix(p, `\\`):
+		// UNC path: \\server\share\dir -> \\?\UNC\server\share\dir
+		return `\\?\UNC\` + p[2:]
+	case len(p) >= 3 && p[1] == ':' && p[2] == '\\':
+		// Drive letter path: C:\dir -> \\?\C:\dir
+		return `\\?\` + p
+	default:
+		return p
+	}
+}
+
+// IsPathTooLongForWindows returns true if the given path exceeds the maximum
+// path length supported by the Windows programs that are not aware of long paths.
+func IsPathTooLongForWindows(p *paths.Path) bool {
+	return len(p.String()) >= WindowsMaxPath
+}
@@ -0,0 +1,55 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToLongPath(t *testing.T) {
+	tests := map[string]string{
+		`C:\Users\me\sketch`:              `\\?\C:\Users\me\sketch`,
+		`\\server\share\dir`:              `\\?\UNC\server\share\dir`,
+		`\\?\C:\Users\me\sketch`:          `\\?\C:\Users\me\sketch`,
+		`\\?\UNC\server\share\dir`:        `\\?\UNC\server\share\dir`,
+		`\\.\COM3`:                        `\\.\COM3`,
+		`relative\dir`:                    `relative\dir`,
+		`C:relative`:                      `C:relative`,
+		`/home/user/Arduino/sketch/build`: `/home/user/Arduino/sketch/build`,
+	}
+	for input, expected := range tests {
+		require.Equal(t, expected, toLongPath(input), "converting %s", input)
+	}
+}
+
+func TestIsReservedName(t *testing.T) {
+	for _, name := range []string{"CON", "con", "Aux", "nul.txt", "COM1", "lpt9.h", "PRN .cpp"} {
+		require.True(t, IsReservedName(name), name)
+	}
+	for _, name := range []string{"CONSOLE", "aux_", "COM10", "Servo", "my.aux", ""} {
+		require.False(t, IsReservedName(name), name)
+	}
+}
+
+func TestSanitizeName(t *testing.T) {
+	require.Equal(t, "Servo", SanitizeName("Servo"))
+	require.Equal(t, "My_Library", SanitizeName("My Library"))
+	require.Equal(t, "_ESP8266", SanitizeName("-ESP8266"))
+	require.Equal(t, "AUX_", SanitizeName("AUX"))
+	require.Equal(t, "com1.x_", SanitizeName("com1.x"))
+}
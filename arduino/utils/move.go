@@ -0,0 +1,56 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"errors"
+	"runtime"
+	"syscall"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// errorNotSameDevice is the ERROR_NOT_SAME_DEVICE returned by Windows when a
+// file is renamed to another volume
+const errorNotSameDevice = syscall.Errno(17)
+
+// Move renames the file or the directory from to the path to. If they're on
+// different file systems the rename fails and from is copied to to and then
+// removed, if the copy fails the partial copy is removed and from is left
+// untouched. Any other error of the rename is returned as is.
+func Move(from, to *paths.Path) error {
+	err := from.Rename(to)
+	if err == nil || !isCrossDeviceError(err) {
+		return err
+	}
+	if from.IsDir() {
+		err = from.CopyDirTo(to)
+	} else {
+		err = from.CopyTo(to)
+	}
+	if err != nil {
+		_ = to.RemoveAll()
+		return err
+	}
+	return from.RemoveAll()
+}
+
+func isCrossDeviceError(err error) bool {
+	if runtime.GOOS == "windows" {
+		return errors.Is(err, errorNotSameDevice)
+	}
+	return errors.Is(err, syscall.EXDEV)
+}
@@ -0,0 +1,53 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"os"
+	"runtime"
+	"syscall"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMove(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	require.NoError(t, tmp.Join("src", "sub").MkdirAll())
+	require.NoError(t, tmp.Join("src", "sub", "file.txt").WriteFile([]byte("data")))
+
+	require.NoError(t, Move(tmp.Join("src"), tmp.Join("dst")))
+	require.False(t, tmp.Join("src").Exist())
+	data, err := tmp.Join("dst", "sub", "file.txt").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "data", string(data))
+
+	// The errors other than the cross-device ones are returned without
+	// copying anything
+	require.NoError(t, tmp.Join("other").MkdirAll())
+	require.NoError(t, tmp.Join("other", "file.txt").WriteFile([]byte("other")))
+	require.Error(t, Move(tmp.Join("dst"), tmp.Join("other")))
+	require.True(t, tmp.Join("dst", "sub", "file.txt").Exist())
+	require.False(t, tmp.Join("other", "sub").Exist())
+}
+
+func TestIsCrossDeviceError(t *testing.T) {
+	require.False(t, isCrossDeviceError(os.ErrNotExist))
+	if runtime.GOOS != "windows" {
+		require.True(t, isCrossDeviceError(&os.LinkError{Op: "rename", Err: syscall.EXDEV}))
+	}
+}
@@ -53,28 +53,107 @@ func SearchTermsFromQueryString(query string) []string {
 	})
 }
 
+// cleanForMatch transforms s to lower case and removes the accents and
+// other unicode diatrics.
+func cleanForMatch(s string) string {
+	s = strings.ToLower(s)
+	if s2, err := removeDiatrics(s); err == nil {
+		return s2
+	}
+	return s
+}
+
 // Match returns true if all substrings are contained in str.
 // Both str and substrings are transforms to lower case and have their
 // accents and other unicode diatrics removed.
 // If strings transformation fails an error is returned.
 func Match(str string, substrings []string) bool {
-	clean := func(s string) string {
-		s = strings.ToLower(s)
-		if s2, err := removeDiatrics(s); err == nil {
-			return s2
+	str = cleanForMatch(str)
+	for _, sub := range substrings {
+		if !strings.Contains(str, cleanForMatch(sub)) {
+			return false
 		}
-		return s
 	}
+	return true
+}
 
-	str = clean(str)
+// MatchFuzzy works like Match, but tolerates typos: a substring not contained
+// in str also matches a word of str within a small edit distance, one edit
+// for substrings of 4 to 7 characters and two edits for longer ones. For
+// example "adafrut" matches "Adafruit GFX Library".
+func MatchFuzzy(str string, substrings []string) bool {
+	str = cleanForMatch(str)
+	var words []string
 	for _, sub := range substrings {
-		if !strings.Contains(str, clean(sub)) {
+		sub = cleanForMatch(sub)
+		if strings.Contains(str, sub) {
+			continue
+		}
+		maxDistance := maxEditDistance(sub)
+		if maxDistance == 0 {
+			return false
+		}
+		if words == nil {
+			words = SearchTermsFromQueryString(str)
+		}
+		found := false
+		for _, word := range words {
+			if editDistance(sub, word, maxDistance) <= maxDistance {
+				found = true
+				break
+			}
+		}
+		if !found {
 			return false
 		}
 	}
 	return true
 }
 
+// maxEditDistance returns the number of typos tolerated in a search term
+func maxEditDistance(term string) int {
+	switch n := len([]rune(term)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// editDistance returns the Levenshtein distance between a and b, the
+// computation stops as soon as the distance exceeds limit (in that case
+// a value greater than limit is returned).
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > limit || -d > limit {
+		return limit + 1
+	}
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
 // MatchAny checks if query matches at least one of the
 // string in arrayToMatch using the utils.Match function.
 func MatchAny(query string, arrayToMatch []string) bool {
@@ -89,3 +168,16 @@ func MatchAny(query string, arrayToMatch []string) bool {
 	}
 	return false
 }
+
+// Paginate returns the page of items that starts at offset and contains at
+// most limit items, a limit of 0 means no limit.
+func Paginate[T any](items []T, offset, limit uint32) []T {
+	if int(offset) >= len(items) {
+		return items[:0]
+	}
+	items = items[offset:]
+	if limit > 0 && int(limit) < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
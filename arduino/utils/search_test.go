@@ -0,0 +1,45 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchFuzzy(t *testing.T) {
+	require.True(t, MatchFuzzy("Adafruit GFX Library", []string{"adafrut", "gfx"}))
+	require.True(t, MatchFuzzy("Adafruit GFX Library", []string{"Adafruitt"}))
+	require.False(t, MatchFuzzy("Arduino Low Power", []string{"lowpwer"}))
+	require.True(t, MatchFuzzy("Müller Sensors", []string{"muler"}))
+	// Short terms must match exactly
+	require.False(t, MatchFuzzy("Adafruit GFX Library", []string{"gfz"}))
+	// Longer terms tolerate two typos
+	require.True(t, MatchFuzzy("ArduinoJson", []string{"ardunojsn"}))
+	require.False(t, MatchFuzzy("ArduinoJson", []string{"ardnojsn"}))
+	// All the terms must match
+	require.False(t, MatchFuzzy("Adafruit GFX Library", []string{"adafrut", "sensor"}))
+}
+
+func TestEditDistance(t *testing.T) {
+	require.Equal(t, 0, editDistance("servo", "servo", 2))
+	require.Equal(t, 1, editDistance("servo", "serv", 2))
+	require.Equal(t, 1, editDistance("servo", "sarvo", 2))
+	require.Equal(t, 2, editDistance("servo", "srevo", 2))
+	require.Equal(t, 3, editDistance("servo", "motor", 2))
+	require.Equal(t, 2, editDistance("a", "abcd", 1))
+}
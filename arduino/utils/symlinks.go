@@ -0,0 +1,142 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// SymlinksPolicy defines how the symlinks found in sketches and libraries are handled
+type SymlinksPolicy string
+
+const (
+	// SymlinksFollow follows the symlinks, symlinks pointing to one of their
+	// parent directories are reported as errors to avoid infinite loops.
+	SymlinksFollow SymlinksPolicy = "follow"
+	// SymlinksReject reports an error when a symlink is found
+	SymlinksReject SymlinksPolicy = "reject"
+)
+
+// ParseSymlinksPolicy parses the given string into a SymlinksPolicy,
+// an empty string is parsed as SymlinksFollow.
+func ParseSymlinksPolicy(policy string) (SymlinksPolicy, error) {
+	switch SymlinksPolicy(policy) {
+	case "", SymlinksFollow:
+		return SymlinksFollow, nil
+	case SymlinksReject:
+		return SymlinksReject, nil
+	}
+	return "", fmt.Errorf("invalid symlinks policy '%s', must be '%s' or '%s'", policy, SymlinksFollow, SymlinksReject)
+}
+
+// SymlinkNotAllowedError is returned when a symlink is found and the
+// SymlinksReject policy is in effect.
+type SymlinkNotAllowedError struct {
+	Path *paths.Path
+}
+
+func (e *SymlinkNotAllowedError) Error() string {
+	return fmt.Sprintf("symlinks are not allowed: %s", e.Path)
+}
+
+// SymlinkLoopError is returned when a symlink points to one of its parent directories.
+type SymlinkLoopError struct {
+	Path   *paths.Path
+	Target *paths.Path
+}
+
+func (e *SymlinkLoopError) Error() string {
+	return fmt.Sprintf("symlink loop detected: %s points to %s", e.Path, e.Target)
+}
+
+// IsSymlink returns true if the given path is a symlink.
+func IsSymlink(p *paths.Path) bool {
+	info, err := os.Lstat(p.String())
+	return err == nil && info.Mode()&os.ModeSymlink != 0
+}
+
+// CheckSymlink returns an error if the given path is a symlink not allowed by
+// the given policy.
+func CheckSymlink(p *paths.Path, policy SymlinksPolicy) error {
+	if policy == SymlinksReject && IsSymlink(p) {
+		return &SymlinkNotAllowedError{Path: p}
+	}
+	return nil
+}
+
+// ReadDirRecursiveFiltered works like paths.Path.ReadDirRecursiveFiltered but
+// handles the symlinks according to the given policy: with SymlinksReject
+// an error is returned as soon as a symlink is found, with SymlinksFollow the
+// symlinked directories are visited, unless they point to one of their parents.
+func ReadDirRecursiveFiltered(dir *paths.Path, policy SymlinksPolicy, recursionFilter paths.ReadDirFilter, filters ...paths.ReadDirFilter) (paths.PathList, error) {
+	canonicalDir := dir.Canonical()
+	return readDirRecursiveFiltered(dir, policy, paths.PathList{canonicalDir}, recursionFilter, filters...)
+}
+
+func readDirRecursiveFiltered(dir *paths.Path, policy SymlinksPolicy, visiting paths.PathList, recursionFilter paths.ReadDirFilter, filters ...paths.ReadDirFilter) (paths.PathList, error) {
+	entries, err := dir.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+
+	accept := func(p *paths.Path) bool {
+		for _, filter := range filters {
+			if !filter(p) {
+				return false
+			}
+		}
+		return true
+	}
+
+	res := paths.PathList{}
+	for _, path := range entries {
+		if err := CheckSymlink(path, policy); err != nil {
+			return nil, err
+		}
+
+		if accept(path) {
+			res.Add(path)
+		}
+
+		if recursionFilter != nil && !recursionFilter(path) {
+			continue
+		}
+		if isDir, err := path.IsDirCheck(); err != nil {
+			return nil, err
+		} else if !isDir {
+			continue
+		}
+
+		canonicalPath := path
+		if IsSymlink(path) {
+			canonicalPath = path.Canonical()
+			if visiting.Contains(canonicalPath) {
+				return nil, &SymlinkLoopError{Path: path, Target: canonicalPath}
+			}
+		} else {
+			canonicalPath = visiting[len(visiting)-1].Join(path.Base())
+		}
+		subPaths, err := readDirRecursiveFiltered(path, policy, append(visiting, canonicalPath), recursionFilter, filters...)
+		if err != nil {
+			return nil, err
+		}
+		res.AddAll(subPaths)
+	}
+	return res, nil
+}
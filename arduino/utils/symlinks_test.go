@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSymlinksPolicy(t *testing.T) {
+	for input, expected := range map[string]SymlinksPolicy{"": SymlinksFollow, "follow": SymlinksFollow, "reject": SymlinksReject} {
+		policy, err := ParseSymlinksPolicy(input)
+		require.NoError(t, err)
+		require.Equal(t, expected, policy)
+	}
+	_, err := ParseSymlinksPolicy("ignore")
+	require.Error(t, err)
+}
+
+func TestReadDirRecursiveFilteredWithSymlinks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks require special privileges on Windows")
+	}
+	tmp, err := paths.MkTempDir("", "symlinks_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	// tmp/dir/a.h
+	// tmp/dir/sub/b.h
+	// tmp/vendored/c.h
+	require.NoError(t, tmp.Join("dir", "sub").MkdirAll())
+	require.NoError(t, tmp.Join("vendored").MkdirAll())
+	require.NoError(t, tmp.Join("dir", "a.h").WriteFile([]byte{}))
+	require.NoError(t, tmp.Join("dir", "sub", "b.h").WriteFile([]byte{}))
+	require.NoError(t, tmp.Join("vendored", "c.h").WriteFile([]byte{}))
+
+	dir := tmp.Join("dir")
+	files, err := ReadDirRecursiveFiltered(dir, SymlinksReject, nil, paths.FilterOutDirectories())
+	require.NoError(t, err)
+	require.Equal(t, paths.NewPathList(dir.Join("a.h").String(), dir.Join("sub", "b.h").String()), files)
+
+	// tmp/dir/vendored -> tmp/vendored
+	require.NoError(t, os.Symlink(tmp.Join("vendored").String(), dir.Join("vendored").String()))
+	files, err = ReadDirRecursiveFiltered(dir, SymlinksFollow, nil, paths.FilterOutDirectories())
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.True(t, files.Contains(dir.Join("vendored", "c.h")))
+
+	_, err = ReadDirRecursiveFiltered(dir, SymlinksReject, nil, paths.FilterOutDirectories())
+	var notAllowedErr *SymlinkNotAllowedError
+	require.ErrorAs(t, err, &notAllowedErr)
+	require.Equal(t, dir.Join("vendored"), notAllowedErr.Path)
+
+	// tmp/dir/sub/loop -> tmp/dir
+	require.NoError(t, os.Symlink(dir.String(), dir.Join("sub", "loop").String()))
+	_, err = ReadDirRecursiveFiltered(dir, SymlinksFollow, nil, paths.FilterOutDirectories())
+	var loopErr *SymlinkLoopError
+	require.ErrorAs(t, err, &loopErr)
+	require.Equal(t, dir.Join("sub", "loop"), loopErr.Path)
+}
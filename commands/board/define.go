@@ -0,0 +1,179 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+var validBoardID = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// Define adds to the boards.local.txt of a platform a new board cloned from an
+// existing one, with the given name and properties. The configuration options
+// set in the FQBN of the cloned board are fixed in the new board.
+func Define(ctx context.Context, req *rpc.BoardDefineRequest) (*rpc.BoardDefineResponse, error) {
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+
+	fqbn, err := cores.ParseFQBN(req.GetFqbn())
+	if err != nil {
+		return nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+	_, platformRelease, board, _, _, err := pme.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, &arduino.UnknownFQBNError{Cause: err}
+	}
+
+	boardID := req.GetBoardId()
+	if !validBoardID.MatchString(boardID) {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid board ID '%s': only letters, numbers, underscores and dashes are allowed", boardID)}
+	}
+	if _, exists := platformRelease.Boards[boardID]; exists {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Board '%[1]s' already exists in platform %[2]s", boardID, platformRelease)}
+	}
+
+	// The definitions are read again from the platform files because the
+	// properties of the loaded board have been already processed
+	boardsLocalTxt := platformRelease.InstallDir.Join("boards.local.txt")
+	definitions := properties.NewMap()
+	for _, file := range (paths.PathList{platformRelease.InstallDir.Join("boards.txt"), boardsLocalTxt}) {
+		if file.NotExist() {
+			continue
+		}
+		if err := loadRawProperties(file, definitions); err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Error reading %s", file), Cause: err}
+		}
+	}
+	boardProperties := definitions.SubTree(board.BoardID)
+
+	// Fix the configuration options selected in the FQBN
+	for _, option := range fqbn.Configs.Keys() {
+		value := fqbn.Configs.Get(option)
+		if !boardProperties.ContainsKey("menu." + option + "." + value) {
+			return nil, &arduino.InvalidFQBNError{Cause: fmt.Errorf(tr("invalid value '%[1]s' for option '%[2]s'"), value, option)}
+		}
+		selected := boardProperties.SubTree("menu." + option + "." + value)
+		for _, k := range boardProperties.Keys() {
+			if strings.HasPrefix(k, "menu."+option+".") {
+				boardProperties.Remove(k)
+			}
+		}
+		boardProperties.Merge(selected)
+	}
+
+	name := req.GetName()
+	if name == "" {
+		name = board.Name()
+	}
+	boardProperties.Set("name", name)
+	overrides := req.GetProperties()
+	keys := make([]string, 0, len(overrides))
+	for k := range overrides {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		boardProperties.Set(k, overrides[k])
+	}
+
+	// Check that the new board can be loaded
+	if variant := boardProperties.Get("build.variant"); variant != "" && !strings.Contains(variant, ":") {
+		if !platformRelease.InstallDir.Join("variants", variant).IsDir() {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Variant '%[1]s' not found in platform %[2]s", variant, platformRelease)}
+		}
+	}
+	newFQBN := &cores.FQBN{
+		Package:      fqbn.Package,
+		PlatformArch: fqbn.PlatformArch,
+		BoardID:      boardID,
+		Configs:      properties.NewMap(),
+	}
+	newBoard := &cores.Board{
+		BoardID:         boardID,
+		Properties:      boardProperties.Clone(),
+		PlatformRelease: platformRelease,
+	}
+	if _, err := newBoard.GetBuildProperties(newFQBN); err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid definition of board %s", newFQBN), Cause: err}
+	}
+
+	definition := fmt.Sprintf("# %s\n", tr("%[1]s, cloned from %[2]s", name, fqbn))
+	for _, k := range boardProperties.Keys() {
+		definition += boardID + "." + k + "=" + boardProperties.Get(k) + "\n"
+	}
+
+	content := ""
+	if boardsLocalTxt.Exist() {
+		data, err := boardsLocalTxt.ReadFile()
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading %s", boardsLocalTxt), Cause: err}
+		}
+		if content = string(data); content != "" {
+			content = strings.TrimRight(content, "\n") + "\n\n"
+		}
+	}
+	content += definition
+	if _, err := properties.LoadFromBytes([]byte(content)); err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid definition of board %s", newFQBN), Cause: err}
+	}
+
+	if !req.GetDryRun() {
+		if err := boardsLocalTxt.WriteFile([]byte(content)); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error writing %s", boardsLocalTxt), Cause: err}
+		}
+	}
+
+	return &rpc.BoardDefineResponse{
+		Fqbn:               newFQBN.String(),
+		Definition:         definition,
+		BoardsLocalTxtPath: boardsLocalTxt.String(),
+	}, nil
+}
+
+// loadRawProperties loads the properties of the given file into the map, keeping
+// the OS specific keys as they are written in the file.
+func loadRawProperties(file *paths.Path, props *properties.Map) error {
+	data, err := file.ReadFile()
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf(tr("invalid line format, should be 'key=value'"))
+		}
+		props.Set(strings.TrimSpace(key), strings.TrimSpace(value))
+	}
+	return nil
+}
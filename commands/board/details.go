@@ -93,29 +93,7 @@ func Details(ctx context.Context, req *rpc.BoardDetailsRequest) (*rpc.BoardDetai
 		details.Platform.Size = boardPlatformRelease.Resource.Size
 	}
 
-	details.ConfigOptions = []*rpc.ConfigOption{}
-	options := board.GetConfigOptions()
-	for _, option := range options.Keys() {
-		configOption := &rpc.ConfigOption{}
-		configOption.Option = option
-		configOption.OptionLabel = options.Get(option)
-		selected, hasSelected := fqbn.Configs.GetOk(option)
-
-		values := board.GetConfigOptionValues(option)
-		for i, value := range values.Keys() {
-			configValue := &rpc.ConfigValue{}
-			if hasSelected && value == selected {
-				configValue.Selected = true
-			} else if !hasSelected && i == 0 {
-				configValue.Selected = true
-			}
-			configValue.Value = value
-			configValue.ValueLabel = values.Get(value)
-			configOption.Values = append(configOption.Values, configValue)
-		}
-
-		details.ConfigOptions = append(details.ConfigOptions, configOption)
-	}
+	details.ConfigOptions = configOptionsToRPC(board, fqbn)
 
 	details.ToolsDependencies = []*rpc.ToolsDependencies{}
 	for _, tool := range boardPlatformRelease.ToolDependencies {
@@ -151,3 +129,34 @@ func Details(ctx context.Context, req *rpc.BoardDetailsRequest) (*rpc.BoardDetai
 
 	return details, nil
 }
+
+// configOptionsToRPC converts the custom configuration options of the board to
+// their gRPC representation. The values set in the given FQBN are marked as
+// selected, for the options not set in the FQBN the default value is selected.
+func configOptionsToRPC(board *cores.Board, fqbn *cores.FQBN) []*rpc.ConfigOption {
+	res := []*rpc.ConfigOption{}
+	options := board.GetConfigOptions()
+	for _, option := range options.Keys() {
+		configOption := &rpc.ConfigOption{}
+		configOption.Option = option
+		configOption.OptionLabel = options.Get(option)
+		selected, hasSelected := fqbn.Configs.GetOk(option)
+
+		values := board.GetConfigOptionValues(option)
+		for i, value := range values.Keys() {
+			configValue := &rpc.ConfigValue{}
+			if hasSelected && value == selected {
+				configValue.Selected = true
+			} else if !hasSelected && i == 0 {
+				configValue.Selected = true
+			}
+			configValue.IsDefault = i == 0
+			configValue.Value = value
+			configValue.ValueLabel = values.Get(value)
+			configOption.Values = append(configOption.Values, configValue)
+		}
+
+		res = append(res, configOption)
+	}
+	return res
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/discovery"
 	"github.com/arduino/arduino-cli/arduino/httpclient"
+	"github.com/arduino/arduino-cli/arduino/platformio"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
 	"github.com/arduino/arduino-cli/internal/inventory"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -180,6 +181,12 @@ func identify(pme *packagemanager.Explorer, port *discovery.Port) ([]*rpc.BoardL
 		boards = items
 	}
 
+	// Tell how to install the platforms of the boards identified by the
+	// builder API that are not installed
+	for _, board := range boards {
+		board.MissingPlatform = missingPlatform(pme, board.GetFqbn())
+	}
+
 	// Sort by FQBN alphabetically
 	sort.Slice(boards, func(i, j int) bool {
 		return strings.ToLower(boards[i].Fqbn) < strings.ToLower(boards[j].Fqbn)
@@ -201,6 +208,32 @@ func identify(pme *packagemanager.Explorer, port *discovery.Port) ([]*rpc.BoardL
 	return boards, nil
 }
 
+// missingPlatform returns the information needed to install the platform of
+// the board, or nil if the platform is already installed.
+func missingPlatform(pme *packagemanager.Explorer, fqbnIn string) *rpc.BoardMissingPlatform {
+	fqbn, err := cores.ParseFQBN(fqbnIn)
+	if err != nil {
+		return nil
+	}
+	res := &rpc.BoardMissingPlatform{Id: fqbn.Package + ":" + fqbn.PlatformArch}
+	platform := pme.FindPlatform(&packagemanager.PlatformReference{
+		Package:              fqbn.Package,
+		PlatformArchitecture: fqbn.PlatformArch,
+	})
+	if platform == nil {
+		// The package index providing the platform is not loaded, it may
+		// be one of the well known third party indexes
+		res.IndexUrl = platformio.PackageIndexURL(fqbn.Package)
+		return res
+	}
+	if pme.GetInstalledPlatformRelease(platform) != nil {
+		return nil
+	}
+	res.IndexUrl = platform.Package.AdditionalIndexURL()
+	res.Indexed = true
+	return res
+}
+
 // List returns a list of boards found by the loaded discoveries.
 // In case of errors partial results from discoveries that didn't fail
 // are returned.
@@ -161,3 +161,51 @@ func TestBoardIdentifySorting(t *testing.T) {
 	require.Equal(t, res[2].Fqbn, "packager:platform:boardA")
 	require.Equal(t, res[3].Fqbn, "packager:platform:boardB")
 }
+
+func TestMissingPlatform(t *testing.T) {
+	dataDir := paths.TempDir().Join("test", "data_dir")
+	t.Setenv("ARDUINO_DATA_DIR", dataDir.String())
+	dataDir.MkdirAll()
+	defer paths.TempDir().Join("test").RemoveAll()
+
+	pmb := packagemanager.NewBuilder(dataDir, dataDir, dataDir, dataDir, "test")
+
+	// An installed platform
+	pack := pmb.GetOrCreatePackage("arduino")
+	pack.URL = "https://downloads.arduino.cc/packages/package_index.tar.bz2"
+	platformRelease := pack.GetOrCreatePlatform("avr").GetOrCreateRelease(semver.MustParse("1.8.6"))
+	platformRelease.InstallDir = dataDir
+	// A platform available in the default package index
+	pack.GetOrCreatePlatform("samd").GetOrCreateRelease(semver.MustParse("1.8.13"))
+	// A platform available in an additional package index
+	pack = pmb.GetOrCreatePackage("third")
+	pack.URL = "https://example.com/package_third_index.json"
+	pack.GetOrCreatePlatform("arm").GetOrCreateRelease(semver.MustParse("1.0.0"))
+
+	pm := pmb.Build()
+	pme, release := pm.NewExplorer()
+	defer release()
+
+	require.Nil(t, missingPlatform(pme, "arduino:avr:uno"))
+
+	missing := missingPlatform(pme, "arduino:samd:mkr1000")
+	require.Equal(t, "arduino:samd", missing.GetId())
+	require.Empty(t, missing.GetIndexUrl())
+	require.True(t, missing.GetIndexed())
+
+	missing = missingPlatform(pme, "third:arm:board")
+	require.Equal(t, "third:arm", missing.GetId())
+	require.Equal(t, "https://example.com/package_third_index.json", missing.GetIndexUrl())
+	require.True(t, missing.GetIndexed())
+
+	// A platform from a well known package index not loaded
+	missing = missingPlatform(pme, "esp32:esp32:esp32")
+	require.Equal(t, "esp32:esp32", missing.GetId())
+	require.Equal(t, "https://espressif.github.io/arduino-esp32/package_esp32_index.json", missing.GetIndexUrl())
+	require.False(t, missing.GetIndexed())
+
+	// A platform from an unknown package index
+	missing = missingPlatform(pme, "unknown:arch:board")
+	require.Empty(t, missing.GetIndexUrl())
+	require.False(t, missing.GetIndexed())
+}
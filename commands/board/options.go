@@ -0,0 +1,73 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// Options returns all the custom configuration options of a board, with all
+// their possible values, labels and defaults.
+func Options(ctx context.Context, req *rpc.BoardOptionsRequest) (*rpc.BoardOptionsResponse, error) {
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+
+	fqbn, err := cores.ParseFQBN(req.GetFqbn())
+	if err != nil {
+		return nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+
+	_, _, board, _, _, err := pme.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, &arduino.UnknownFQBNError{Cause: err}
+	}
+
+	res := &rpc.BoardOptionsResponse{
+		Fqbn:          board.FQBN(),
+		Name:          board.Name(),
+		ConfigOptions: configOptionsToRPC(board, fqbn),
+	}
+
+	// Rebuild the configurations to list the options in the same order of the boards.txt
+	defaultFQBN := fqbn.Clone()
+	defaultFQBN.Configs = properties.NewMap()
+	selectedFQBN := fqbn.Clone()
+	selectedFQBN.Configs = properties.NewMap()
+	res.Combinations = 1
+	for _, option := range res.ConfigOptions {
+		for _, value := range option.GetValues() {
+			if value.GetIsDefault() {
+				defaultFQBN.Configs.Set(option.GetOption(), value.GetValue())
+			}
+			if value.GetSelected() {
+				selectedFQBN.Configs.Set(option.GetOption(), value.GetValue())
+			}
+		}
+		res.Combinations *= int64(len(option.GetValues()))
+	}
+	res.DefaultFqbn = defaultFQBN.String()
+	res.SelectedFqbn = selectedFQBN.String()
+	return res, nil
+}
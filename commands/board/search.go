@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
@@ -38,6 +39,10 @@ func Search(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchR
 	}
 	defer release()
 
+	if req.GetInstalledOnly() && req.GetNotInstalledOnly() {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Cannot search only installed and only not installed boards at the same time")}
+	}
+
 	foundBoards := []*rpc.BoardListItem{}
 	for _, targetPackage := range pme.GetPackages() {
 		for _, platform := range targetPackage.Platforms {
@@ -54,6 +59,9 @@ func Search(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchR
 			// The only boards information for platforms that are not installed
 			// is that found in the index, usually that's only a board name.
 			if installedPlatformRelease != nil {
+				if req.GetNotInstalledOnly() {
+					continue
+				}
 				for _, board := range installedPlatformRelease.Boards {
 					if !req.GetIncludeHiddenBoards() && board.IsHidden() {
 						continue
@@ -64,10 +72,26 @@ func Search(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchR
 						continue
 					}
 
+					// The capabilities are available only in the index, the MCU may
+					// also be taken from the board properties
+					mcu := board.Properties.Get("build.mcu")
+					var connectivity []string
+					if manifest := findBoardManifest(installedPlatformRelease, board.Name()); manifest != nil {
+						if manifest.MCU != "" {
+							mcu = manifest.MCU
+						}
+						connectivity = manifest.Connectivity
+					}
+					if !matchCapabilities(req, mcu, connectivity) {
+						continue
+					}
+
 					foundBoards = append(foundBoards, &rpc.BoardListItem{
-						Name:     board.Name(),
-						Fqbn:     board.FQBN(),
-						IsHidden: board.IsHidden(),
+						Name:         board.Name(),
+						Fqbn:         board.FQBN(),
+						IsHidden:     board.IsHidden(),
+						Mcu:          mcu,
+						Connectivity: connectivity,
 						Platform: &rpc.Platform{
 							Metadata: commands.PlatformToRPCPlatformMetadata(platform),
 							Release:  commands.PlatformReleaseToRPC(installedPlatformRelease),
@@ -75,14 +99,22 @@ func Search(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchR
 					})
 				}
 			} else if latestPlatformRelease != nil {
+				if req.GetInstalledOnly() {
+					continue
+				}
 				for _, board := range latestPlatformRelease.BoardsManifest {
 					toTest := append(strings.Split(board.Name, " "), board.Name)
 					if !utils.MatchAny(req.GetSearchArgs(), toTest) {
 						continue
 					}
+					if !matchCapabilities(req, board.MCU, board.Connectivity) {
+						continue
+					}
 
 					foundBoards = append(foundBoards, &rpc.BoardListItem{
-						Name: strings.Trim(board.Name, " \n"),
+						Name:         strings.Trim(board.Name, " \n"),
+						Mcu:          board.MCU,
+						Connectivity: board.Connectivity,
 						Platform: &rpc.Platform{
 							Metadata: commands.PlatformToRPCPlatformMetadata(platform),
 							Release:  commands.PlatformReleaseToRPC(latestPlatformRelease),
@@ -102,3 +134,36 @@ func Search(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchR
 
 	return &rpc.BoardSearchResponse{Boards: foundBoards}, nil
 }
+
+// findBoardManifest returns the board metadata found in the index for the board
+// with the given name, or nil if the board is not listed in the index.
+func findBoardManifest(release *cores.PlatformRelease, name string) *cores.BoardManifest {
+	name = strings.TrimSpace(name)
+	for _, manifest := range release.BoardsManifest {
+		if strings.TrimSpace(manifest.Name) == name {
+			return manifest
+		}
+	}
+	return nil
+}
+
+// matchCapabilities returns true if a board with the given MCU and connectivity
+// capabilities satisfies the filters of the search request.
+func matchCapabilities(req *rpc.BoardSearchRequest, mcu string, connectivity []string) bool {
+	if filter := strings.ToLower(req.GetMcu()); filter != "" && !strings.Contains(strings.ToLower(mcu), filter) {
+		return false
+	}
+	for _, required := range req.GetConnectivity() {
+		found := false
+		for _, c := range connectivity {
+			if strings.EqualFold(c, required) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
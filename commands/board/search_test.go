@@ -0,0 +1,35 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchCapabilities(t *testing.T) {
+	connectivity := []string{"wifi", "BLE"}
+	require.True(t, matchCapabilities(&rpc.BoardSearchRequest{}, "", nil))
+	require.True(t, matchCapabilities(&rpc.BoardSearchRequest{Connectivity: []string{"ble"}}, "esp32s3", connectivity))
+	require.True(t, matchCapabilities(&rpc.BoardSearchRequest{Connectivity: []string{"wifi", "ble"}}, "esp32s3", connectivity))
+	require.False(t, matchCapabilities(&rpc.BoardSearchRequest{Connectivity: []string{"wifi", "lora"}}, "esp32s3", connectivity))
+	require.False(t, matchCapabilities(&rpc.BoardSearchRequest{Connectivity: []string{"wifi"}}, "atmega328p", nil))
+	require.True(t, matchCapabilities(&rpc.BoardSearchRequest{Mcu: "ESP32"}, "esp32s3", connectivity))
+	require.False(t, matchCapabilities(&rpc.BoardSearchRequest{Mcu: "samd21"}, "esp32s3", connectivity))
+	require.False(t, matchCapabilities(&rpc.BoardSearchRequest{Mcu: "esp32"}, "", nil))
+}
@@ -0,0 +1,152 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/boardsymbols"
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// coreHeaders are the headers of the core scanned for the constants of the
+// board, the other headers of the core are the same for all the boards
+var coreHeaders = []string{"Arduino.h", "pins_arduino.h"}
+
+// Symbols returns the constants of a board: the macros defined by its build
+// properties and the constants defined in the headers of its variant and of
+// its core.
+func Symbols(ctx context.Context, req *rpc.BoardSymbolsRequest) (*rpc.BoardSymbolsResponse, error) {
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+
+	fqbn, err := cores.ParseFQBN(req.GetFqbn())
+	if err != nil {
+		return nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+	_, _, _, buildProperties, _, err := pme.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, &arduino.UnknownFQBNError{Cause: err}
+	}
+
+	// files maps the constants to the headers defining them
+	files := map[*boardsymbols.Constant]*paths.Path{}
+	constants := buildPropertiesConstants(buildProperties)
+
+	headers := paths.PathList{}
+	variantPath := buildProperties.GetPath("build.variant.path")
+	if variantPath != nil {
+		if entries, err := variantPath.ReadDir(); err == nil {
+			entries.FilterOutDirs()
+			entries.Sort()
+			for _, file := range entries {
+				if globals.HeaderFilesValidExtensions[file.Ext()] {
+					headers.Add(file)
+				}
+			}
+		}
+	}
+	corePath := buildProperties.GetPath("build.core.path")
+	for _, header := range coreHeaders {
+		if file := corePath.Join(header); file.Exist() {
+			headers.Add(file)
+		}
+	}
+	for _, header := range headers {
+		source, err := header.ReadFile()
+		if err != nil {
+			logrus.WithError(err).Warnf("Error reading header %s", header)
+			continue
+		}
+		for _, c := range boardsymbols.Scan(source) {
+			constants = append(constants, c)
+			files[c] = header
+		}
+	}
+	// Only the first definition of each constant is kept
+	constants = boardsymbols.Dedup(constants)
+	boardsymbols.Resolve(constants)
+
+	res := &rpc.BoardSymbolsResponse{CorePath: corePath.String()}
+	if variantPath != nil {
+		res.VariantPath = variantPath.String()
+	}
+	for _, c := range constants {
+		symbol := &rpc.BoardSymbol{
+			Name:          c.Name,
+			Value:         c.Value,
+			ResolvedValue: c.ResolvedValue,
+			Kind:          toRPCBoardSymbolKind(c.Kind),
+		}
+		if file := files[c]; file != nil {
+			symbol.File = file.String()
+			symbol.Line = uint32(c.Line)
+		}
+		res.Symbols = append(res.Symbols, symbol)
+	}
+	return res, nil
+}
+
+// buildPropertiesConstants returns the macros defined on the command line of
+// the compiler by the build properties of the board: the ones always defined
+// by the platform recipes and the ones in build.extra_flags and build.defines.
+func buildPropertiesConstants(buildProperties *properties.Map) []*boardsymbols.Constant {
+	res := []*boardsymbols.Constant{}
+	if board := buildProperties.Get("build.board"); board != "" {
+		res = append(res, &boardsymbols.Constant{Name: "ARDUINO_" + board, Kind: boardsymbols.Feature})
+	}
+	if arch := buildProperties.Get("build.arch"); arch != "" {
+		res = append(res, &boardsymbols.Constant{Name: "ARDUINO_ARCH_" + strings.ToUpper(arch), Kind: boardsymbols.Feature})
+	}
+	if fcpu := buildProperties.Get("build.f_cpu"); fcpu != "" {
+		res = append(res, &boardsymbols.Constant{Name: "F_CPU", Value: fcpu, Kind: boardsymbols.Macro})
+	}
+	for _, key := range []string{"build.extra_flags", "build.defines"} {
+		flags, err := properties.SplitQuotedString(buildProperties.ExpandPropsInString(buildProperties.Get(key)), `"'`, false)
+		if err != nil {
+			continue
+		}
+		for _, flag := range flags {
+			if !strings.HasPrefix(flag, "-D") || len(flag) == 2 {
+				continue
+			}
+			name, value, _ := strings.Cut(flag[2:], "=")
+			res = append(res, &boardsymbols.Constant{Name: name, Value: value, Kind: boardsymbols.KindOf(name, value)})
+		}
+	}
+	return res
+}
+
+func toRPCBoardSymbolKind(kind boardsymbols.Kind) rpc.BoardSymbolKind {
+	switch kind {
+	case boardsymbols.Macro:
+		return rpc.BoardSymbolKind_BOARD_SYMBOL_KIND_MACRO
+	case boardsymbols.Feature:
+		return rpc.BoardSymbolKind_BOARD_SYMBOL_KIND_FEATURE
+	}
+	return rpc.BoardSymbolKind_BOARD_SYMBOL_KIND_PIN
+}
@@ -0,0 +1,46 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/boardsymbols"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPropertiesConstants(t *testing.T) {
+	buildProperties := properties.NewFromHashmap(map[string]string{
+		"build.board":       "AVR_UNO",
+		"build.arch":        "avr",
+		"build.f_cpu":       "16000000L",
+		"build.usb_flags":   "-DUSB_VID={build.vid} -DUSBCON",
+		"build.vid":         "0x2341",
+		"build.extra_flags": "{build.usb_flags} -DLED_BUILTIN=13 -Wall",
+		"build.defines":     `-DBOARD_NAME="UNO"`,
+	})
+	constants := buildPropertiesConstants(buildProperties)
+	require.Equal(t, []*boardsymbols.Constant{
+		{Name: "ARDUINO_AVR_UNO", Kind: boardsymbols.Feature},
+		{Name: "ARDUINO_ARCH_AVR", Kind: boardsymbols.Feature},
+		{Name: "F_CPU", Value: "16000000L", Kind: boardsymbols.Macro},
+		{Name: "USB_VID", Value: "0x2341", Kind: boardsymbols.Macro},
+		{Name: "USBCON", Kind: boardsymbols.Feature},
+		{Name: "LED_BUILTIN", Value: "13", Kind: boardsymbols.Pin},
+		{Name: "BOARD_NAME", Value: `"UNO"`, Kind: boardsymbols.Macro},
+	}, constants)
+}
@@ -0,0 +1,47 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"github.com/arduino/arduino-cli/arduino/advisories"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// buildAdvisories returns the advisories of the configured feeds affecting the
+// libraries and the platforms used by the build.
+func buildAdvisories(libs libraries.List, platforms ...*cores.PlatformRelease) ([]*rpc.Advisory, error) {
+	feed, err := advisories.Load(
+		configuration.Settings.GetStringSlice("advisories.urls"),
+		configuration.AdvisoriesDir(configuration.Settings))
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*rpc.Advisory{}
+	for i, platform := range platforms {
+		if i > 0 && platform == platforms[0] {
+			continue
+		}
+		res = append(res, feed.Platform(platform.Platform.String(), platform.Version)...)
+	}
+	for _, lib := range libs {
+		res = append(res, feed.Library(lib.Name, lib.Version)...)
+	}
+	return res, nil
+}
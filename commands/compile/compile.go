@@ -20,13 +20,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesusage"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/buildcache"
@@ -57,6 +60,22 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		exportBinaries = reqExportBinaries.Value
 	}
 
+	exportBuildSystem := req.GetExportBuildSystem()
+	if exportBuildSystem != "" && !slices.Contains(builder.BuildSystems(), exportBuildSystem) {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid build system '%[1]s', expected one of: %[2]s", exportBuildSystem, strings.Join(builder.BuildSystems(), ", "))}
+	}
+
+	destinations, err := publishDestinations(req.GetPublishTo())
+	if err != nil {
+		return nil, err
+	}
+
+	releaseResources, err := instances.AcquireRead(req.GetInstance(), "Compile", instances.Platforms, instances.Libraries)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseResources()
+
 	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
 	if pme == nil {
 		return nil, &arduino.InvalidInstanceError{}
@@ -73,7 +92,7 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		return nil, &arduino.MissingSketchPathError{}
 	}
 	sketchPath := paths.New(req.GetSketchPath())
-	sk, err := sketch.New(sketchPath)
+	sk, err := sketch.NewWithSymlinksPolicy(sketchPath, configuration.SketchSymlinksPolicy(configuration.Settings))
 	if err != nil {
 		return nil, &arduino.CantOpenSketchError{Cause: err}
 	}
@@ -131,6 +150,36 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		return nil, fmt.Errorf(tr("Firmware encryption/signing requires all the following properties to be defined: %s", "build.keys.keychain, build.keys.sign_key, build.keys.encrypt_key"))
 	}
 
+	// Apply the linker script and memory layout overrides of the sketch project file
+	if err := applyProjectBuildSettings(sk, fqbn.String(), boardBuildProperties); err != nil {
+		return nil, err
+	}
+
+	// Make the Arduino Cloud thing bound to the sketch available to it
+	if err := applyProjectCloudSettings(sk, configuration.DataDir(configuration.Settings), boardBuildProperties); err != nil {
+		return nil, err
+	}
+
+	// Select the build configuration of the sketch project file, the settings
+	// of the request take precedence over the ones of the configuration
+	buildConfiguration, err := selectBuildConfiguration(sk, req.GetConfiguration())
+	if err != nil {
+		return nil, err
+	}
+	optimizeForDebug := req.GetOptimizeForDebug()
+	warnings := req.GetWarnings()
+	requestBuildProperties := req.GetBuildProperties()
+	if buildConfiguration != nil {
+		r.Configuration = buildConfiguration.Name
+		if buildConfiguration.Optimization == "debug" {
+			optimizeForDebug = true
+		}
+		if warnings == "" {
+			warnings = buildConfiguration.Warnings
+		}
+		requestBuildProperties = append(buildConfigurationProperties(buildConfiguration, boardBuildProperties), requestBuildProperties...)
+	}
+
 	// Generate or retrieve build path
 	var buildPath *paths.Path
 	if buildPathArg := req.GetBuildPath(); buildPathArg != "" {
@@ -142,12 +191,15 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		}
 	}
 	if buildPath == nil {
-		buildPath = sk.DefaultBuildPath()
+		buildPath = sk.DefaultBuildPathForConfiguration(r.GetConfiguration())
 	}
 	if err = buildPath.MkdirAll(); err != nil {
 		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot create build directory"), Cause: err}
 	}
 	buildcache.New(buildPath.Parent()).GetOrCreate(buildPath.Base())
+	if err := copyProjectPartitionsFile(boardBuildProperties, buildPath); err != nil {
+		return nil, err
+	}
 	// cache is purged after compilation to not remove entries that might be required
 	defer maybePurgeBuildCache()
 
@@ -164,6 +216,11 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		}
 		coreBuildCachePath = buildCachePath.Join("core")
 	}
+	if exportBuildSystem != "" {
+		// The exported build system compiles the core from its sources, all
+		// of them must be compiled to record their commands
+		coreBuildCachePath = nil
+	}
 
 	if _, err := pme.FindToolsRequiredForBuild(targetPlatform, buildPlatform); err != nil {
 		return nil, err
@@ -177,18 +234,34 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	if pme.GetProfile() != nil {
 		libsManager = lm
 	}
+	// The preprocessed sketch is printed on the output stream, the headers
+	// of the build phases must not be mixed with it
+	quiet := req.GetQuiet() || (req.GetPreprocess() && !req.GetVerbose())
+	verbosity := 0
+	if req.GetVerbose() {
+		verbosity = min(max(int(req.GetVerbosityLevel()), 1), 3)
+	}
+	for _, phase := range req.GetVerbosePhases() {
+		if !slices.Contains(builder.Phases, phase) {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid verbose phase '%[1]s', valid phases are: %[2]s", phase, strings.Join(builder.Phases, ", "))}
+		}
+	}
+	// The general information is printed only if the verbose output is not
+	// limited to some phases
+	verbose := req.GetVerbose() && len(req.GetVerbosePhases()) == 0
 	sketchBuilder, err := builder.NewBuilder(
+		ctx,
 		sk,
 		boardBuildProperties,
 		buildPath,
-		req.GetOptimizeForDebug(),
+		optimizeForDebug,
 		coreBuildCachePath,
 		int(req.GetJobs()),
-		req.GetBuildProperties(),
+		requestBuildProperties,
 		configuration.HardwareDirectories(configuration.Settings),
 		configuration.BuiltinToolsDirectories(configuration.Settings),
 		otherLibrariesDirs,
-		configuration.IDEBuiltinLibrariesDir(configuration.Settings),
+		configuration.BuiltinLibrariesDirectories(configuration.Settings),
 		fqbn,
 		req.GetClean(),
 		req.GetSourceOverride(),
@@ -197,12 +270,13 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		req.GetSkipLibrariesDiscovery(),
 		libsManager,
 		paths.NewPathList(req.Library...),
-		outStream, errStream, req.GetVerbose(), req.GetWarnings(),
+		outStream, errStream, verbosity, req.GetVerbosePhases(), quiet, warnings,
 		progressCB,
 	)
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid build properties") {
-			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid build properties"), Cause: err}
+		var invalidPropertiesErr *builder.InvalidBuildPropertiesError
+		if errors.As(err, &invalidPropertiesErr) {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid build properties"), Cause: invalidPropertiesErr.Cause}
 		}
 		if errors.Is(err, builder.ErrSketchCannotBeLocatedInBuildPath) {
 			return r, &arduino.CompileFailedError{
@@ -211,6 +285,8 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		}
 		return r, &arduino.CompileFailedError{Message: err.Error()}
 	}
+	sketchBuilder.SetToolTimeout(configuration.BuildToolTimeout(configuration.Settings))
+	sketchBuilder.SetFailOnIncompatibleLibraries(req.GetFailOnIncompatibleLibraries())
 
 	defer func() {
 		if p := sketchBuilder.GetBuildPath(); p != nil {
@@ -218,6 +294,25 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		}
 	}()
 
+	defer func() {
+		for _, collision := range sketchBuilder.FileNameCaseCollisions() {
+			r.FileNameCaseCollisions = append(r.FileNameCaseCollisions, &rpc.FileNameCaseCollision{
+				Paths: collision.AsStrings(),
+			})
+		}
+	}()
+
+	defer func() {
+		for _, incompatible := range sketchBuilder.IncompatibleLibraries() {
+			r.IncompatibleLibraries = append(r.IncompatibleLibraries, &rpc.IncompatibleLibrary{
+				Name:               incompatible.Library.Name,
+				Architectures:      incompatible.Library.Architectures,
+				BoardArchitectures: incompatible.BoardArchitectures,
+				Accepted:           incompatible.Accepted,
+			})
+		}
+	}()
+
 	defer func() {
 		buildProperties := sketchBuilder.GetBuildProperties()
 		if buildProperties == nil {
@@ -264,7 +359,7 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 
 	// if it's a regular build, go on...
 
-	if req.GetVerbose() {
+	if verbose {
 		core := sketchBuilder.GetBuildProperties().Get("build.core")
 		if core == "" {
 			core = "arduino"
@@ -272,10 +367,10 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		// select the core name in case of "package:core" format
 		normalizedFQBN, err := pme.NormalizeFQBN(fqbn)
 		if err != nil {
-			outStream.Write([]byte(fmt.Sprintf("Could not normalize FQBN: %s\n", err)))
+			outStream.Write([]byte(tr("Could not normalize FQBN: %s", err) + "\n"))
 			normalizedFQBN = fqbn
 		}
-		outStream.Write([]byte(fmt.Sprintf("FQBN: %s\n", normalizedFQBN)))
+		outStream.Write([]byte(tr("FQBN: %s", normalizedFQBN) + "\n"))
 		core = core[strings.Index(core, ":")+1:]
 		outStream.Write([]byte(tr("Using board '%[1]s' from platform in folder: %[2]s", targetBoard.BoardID, targetPlatform.InstallDir) + "\n"))
 		outStream.Write([]byte(tr("Using core '%[1]s' from platform in folder: %[2]s", core, buildPlatform.InstallDir) + "\n"))
@@ -287,10 +382,44 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 				targetBoard.String(), "'build.board'", sketchBuilder.GetBuildProperties().Get("build.board")) + "\n"))
 	}
 
+	if wrapper := configuration.Settings.GetString("build.compile_wrapper"); wrapper != "" {
+		if err := sketchBuilder.SetCompileWrapper(wrapper); err != nil {
+			errStream.Write([]byte(tr("Warning: invalid compile wrapper '%[1]s': %[2]s, the source files are compiled locally", wrapper, err) + "\n"))
+		} else if verbose {
+			outStream.Write([]byte(tr("Using compile wrapper: %s", strings.Join(sketchBuilder.CompileWrapper(), " ")) + "\n"))
+		}
+	}
+
+	if configuration.Settings.GetBool("build.use_ccache") {
+		if err := sketchBuilder.EnableCcache(); err != nil {
+			errStream.Write([]byte(tr("Warning: %s, the compiler cache is disabled", err) + "\n"))
+		}
+	}
+
+	if req.GetReportTimings() {
+		defer func() {
+			r.Timings = sketchBuilder.Timings().ToRPCBuildTimings()
+		}()
+	}
+
 	if err := sketchBuilder.Build(); err != nil {
+		var timeoutErr *arduino.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			return r, timeoutErr
+		}
 		return r, &arduino.CompileFailedError{Message: err.Error()}
 	}
 
+	if err := librariesusage.Record(configuration.LibrariesUsageFile(configuration.Settings), sketchBuilder.ImportedLibraries(), time.Now()); err != nil {
+		logrus.WithError(err).Warn("Recording the usage of the libraries")
+	}
+
+	if advisories, err := buildAdvisories(sketchBuilder.ImportedLibraries(), targetPlatform, buildPlatform); err != nil {
+		errStream.Write([]byte(tr("Warning: cannot check the advisories: %v", err) + "\n"))
+	} else {
+		r.Advisories = advisories
+	}
+
 	// If the export directory is set we assume you want to export the binaries
 	if req.GetExportDir() != "" {
 		exportBinaries = true
@@ -337,14 +466,67 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 			}
 		}
 
+		// The manifest of the exported build lets the upload pick the right artifacts
+		if _, _, err := writeBuildManifest(exportPath, sketchBuilder, r, sk.Name, fqbn, buildProfileName(pme), time.Now()); err != nil {
+			return r, err
+		}
+
 		err = sketchBuilder.RunRecipe("recipe.hooks.savehex.postsavehex", ".pattern", false)
 		if err != nil {
 			return r, err
 		}
 	}
 
+	if exportBuildSystem != "" && !req.GetCreateCompilationDatabaseOnly() {
+		exportPath := paths.New(req.GetExportDir())
+		if exportPath == nil {
+			fqbnSuffix := strings.ReplaceAll(fqbn.StringWithoutConfig(), ":", ".")
+			exportPath = sk.FullPath.Join("build", fqbnSuffix)
+		}
+		buildSystemPath := exportPath.Join(exportBuildSystem)
+		if err := sketchBuilder.ExportBuildSystem(exportBuildSystem, buildSystemPath); err != nil {
+			return r, &arduino.CompileFailedError{Message: tr("Error exporting the build system"), Cause: err}
+		}
+		r.BuildSystemPath = buildSystemPath.String()
+	}
+
+	if len(destinations) > 0 && !req.GetCreateCompilationDatabaseOnly() {
+		published, err := publishArtifacts(destinations, sketchBuilder, r, sk.Name, fqbn, buildProfileName(pme))
+		r.PublishedArtifacts = published
+		if err != nil {
+			return r, err
+		}
+	}
+
 	r.ExecutableSectionsSize = sketchBuilder.ExecutableSectionsSize().ToRPCExecutableSectionSizeArray()
 
+	if compareWith := req.GetCompareWith(); compareWith != "" && !req.GetCreateCompilationDatabaseOnly() {
+		projectName := sketchBuilder.GetBuildProperties().Get("build.project_name")
+		sizeDiff, err := compareBuildSizes(buildPath, projectName, paths.New(compareWith))
+		if err != nil {
+			return r, &arduino.InvalidArgumentError{Message: tr("Cannot compare the build with %s", compareWith), Cause: err}
+		}
+		r.SizeDiff = sizeDiff
+	}
+
+	if req.GetReportUnusedCode() && !req.GetCreateCompilationDatabaseOnly() {
+		projectName := sketchBuilder.GetBuildProperties().Get("build.project_name")
+		unusedCode, err := findUnusedCode(buildPath, projectName, sketchBuilder.ImportedLibraries())
+		if err != nil {
+			return r, &arduino.CompileFailedError{Message: tr("Cannot analyze the unused code"), Cause: err}
+		}
+		r.UnusedCode = unusedCode
+	}
+
+	if req.GetReportMemoryLayout() && !req.GetCreateCompilationDatabaseOnly() {
+		projectName := sketchBuilder.GetBuildProperties().Get("build.project_name")
+		memoryLayout, err := readMemoryLayout(buildPath, projectName)
+		if err != nil {
+			return r, &arduino.CompileFailedError{Message: tr("Cannot read the memory layout"), Cause: err}
+		}
+		r.MemoryLayout = memoryLayout
+	}
+
 	logrus.Tracef("Compile %s for %s successful", sk.Name, fqbnIn)
 
 	return r, nil
@@ -0,0 +1,71 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"errors"
+
+	"github.com/arduino/arduino-cli/arduino/deadcode"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// findUnusedCode looks for the libraries, the object files and the sketch
+// functions that didn't end up in the executable. The precompiled libraries
+// are not analyzed since their object files are not in the build path.
+func findUnusedCode(buildPath *paths.Path, projectName string, importedLibraries []*libraries.Library) (*rpc.UnusedCodeReport, error) {
+	executable := buildPath.Join(projectName + ".elf")
+	if !executable.Exist() {
+		return nil, errors.New(tr("the build didn't produce an ELF file to analyze"))
+	}
+	findObjects := func(dir *paths.Path) (paths.PathList, error) {
+		if dir.NotExist() {
+			return paths.PathList{}, nil
+		}
+		objects, err := dir.ReadDirRecursive()
+		if err != nil {
+			return nil, err
+		}
+		objects.FilterSuffix(".o")
+		return objects, nil
+	}
+
+	sketchObjects, err := findObjects(buildPath.Join("sketch"))
+	if err != nil {
+		return nil, err
+	}
+	libraryObjects := map[string]paths.PathList{}
+	for _, library := range importedLibraries {
+		objects, err := findObjects(buildPath.Join("libraries", library.DirName))
+		if err != nil {
+			return nil, err
+		}
+		if len(objects) > 0 {
+			libraryObjects[library.Name] = objects
+		}
+	}
+
+	report, err := deadcode.Analyze(executable, sketchObjects, libraryObjects)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UnusedCodeReport{
+		UnusedLibraries:     report.UnusedLibraries,
+		UnusedObjects:       report.UnusedObjects.AsStrings(),
+		EliminatedFunctions: report.EliminatedFunctions,
+	}, nil
+}
@@ -0,0 +1,271 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// compilerFragments caches the fragments by build path, with the fingerprint
+// of the sketch includes and of the platforms and libraries used
+var compilerFragments = struct {
+	sync.Mutex
+	entries map[string]*compilerFragmentEntry
+}{entries: map[string]*compilerFragmentEntry{}}
+
+// compileCommand is an entry of the compilation database, see
+// https://clang.llvm.org/docs/JSONCompilationDatabase.html
+type compileCommand struct {
+	Directory string   `json:"directory"`
+	Command   string   `json:"command,omitempty"`
+	Arguments []string `json:"arguments,omitempty"`
+	File      string   `json:"file"`
+}
+
+type compilerFragmentEntry struct {
+	fragment    *rpc.GetCompilerFragmentResponse
+	fingerprint string
+	// dependencies are the folders of the platforms and libraries used
+	dependencies paths.PathList
+}
+
+// GetCompilerFragment returns the command used to compile the main file of
+// the sketch, obtained from the compilation database created without
+// building the sketch.
+func GetCompilerFragment(ctx context.Context, req *rpc.GetCompilerFragmentRequest) (*rpc.GetCompilerFragmentResponse, error) {
+	if req.GetSketchPath() == "" {
+		return nil, &arduino.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(req.GetSketchPath()))
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+	fqbn := req.GetFqbn()
+	if fqbn == "" {
+		fqbn = sk.GetDefaultFQBN()
+	}
+	if fqbn == "" {
+		return nil, &arduino.MissingFQBNError{}
+	}
+
+	buildPath := paths.New(req.GetBuildPath())
+	if req.GetBuildPath() == "" {
+		key := sk.FullPath.String() + "\n" + fqbn + "\n" + strings.Join(req.GetBuildProperties(), "\n")
+		buildPath = paths.TempDir().Join("arduino", "compiler-fragments", fmt.Sprintf("%X", md5.Sum([]byte(key))))
+	}
+
+	compilerFragments.Lock()
+	entry := compilerFragments.entries[buildPath.String()]
+	compilerFragments.Unlock()
+	if entry != nil && entry.fingerprint == compilerFragmentFingerprint(sk, entry.dependencies) {
+		res := proto.Clone(entry.fragment).(*rpc.GetCompilerFragmentResponse)
+		res.Cached = true
+		return res, nil
+	}
+
+	logrus.WithField("build_path", buildPath).Info("Creating compilation database for the compiler fragment")
+	compileRes, err := Compile(ctx, &rpc.CompileRequest{
+		Instance:                      req.GetInstance(),
+		Fqbn:                          fqbn,
+		SketchPath:                    sk.FullPath.String(),
+		BuildPath:                     buildPath.String(),
+		BuildProperties:               req.GetBuildProperties(),
+		CreateCompilationDatabaseOnly: true,
+	}, io.Discard, io.Discard, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var commands []compileCommand
+	data, err := buildPath.Join("compile_commands.json").ReadFile()
+	if err == nil {
+		err = json.Unmarshal(data, &commands)
+	}
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error reading compilation database"), Cause: err}
+	}
+	mainFile := buildPath.Canonical().Join("sketch", sk.MainFile.Base()+".cpp")
+	var fragment *rpc.GetCompilerFragmentResponse
+	for _, command := range commands {
+		if paths.New(command.File).EqualsTo(mainFile) {
+			fragment = parseCompilerFragment(command)
+			break
+		}
+	}
+	if fragment == nil {
+		return nil, &arduino.NotFoundError{Message: tr("The compilation database doesn't contain the main file of the sketch")}
+	}
+	fragment.BuildPath = buildPath.String()
+
+	dependencies := paths.PathList{configuration.LibrariesDir(configuration.Settings)}
+	for _, platform := range []*rpc.InstalledPlatformReference{compileRes.GetBoardPlatform(), compileRes.GetBuildPlatform()} {
+		if dir := platform.GetInstallDir(); dir != "" {
+			dependencies.Add(paths.New(dir))
+		}
+	}
+	for _, lib := range compileRes.GetUsedLibraries() {
+		if dir := lib.GetInstallDir(); dir != "" {
+			dependencies.Add(paths.New(dir))
+		}
+	}
+	compilerFragments.Lock()
+	compilerFragments.entries[buildPath.String()] = &compilerFragmentEntry{
+		fragment:     fragment,
+		fingerprint:  compilerFragmentFingerprint(sk, dependencies),
+		dependencies: dependencies,
+	}
+	compilerFragments.Unlock()
+	return proto.Clone(fragment).(*rpc.GetCompilerFragmentResponse), nil
+}
+
+// compilerFragmentFingerprint returns a fingerprint that changes when the
+// compiler fragment may change: when the files of the sketch or their
+// #include directives change, or when the given platforms and libraries
+// folders are modified (e.g. a library is installed or upgraded). The changes
+// to the code of the sketch don't affect it.
+func compilerFragmentFingerprint(sk *sketch.Sketch, dependencies paths.PathList) string {
+	var res bytes.Buffer
+	files := paths.PathList{sk.MainFile}
+	files.AddAll(sk.OtherSketchFiles)
+	files.AddAll(sk.AdditionalFiles)
+	for _, file := range files {
+		fmt.Fprintln(&res, file)
+		f, err := file.Open()
+		if err != nil {
+			continue
+		}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); strings.HasPrefix(line, "#") && strings.Contains(line, "include") {
+				fmt.Fprintln(&res, line)
+			}
+		}
+		f.Close()
+	}
+	if project := sk.GetProjectPath(); project.Exist() {
+		if data, err := project.ReadFile(); err == nil {
+			res.Write(data)
+		}
+	}
+	for _, dir := range dependencies {
+		if stat, err := dir.Stat(); err == nil {
+			fmt.Fprintf(&res, "%s:%d\n", dir, stat.ModTime().UnixNano())
+		}
+	}
+	return fmt.Sprintf("%X", md5.Sum(res.Bytes()))
+}
+
+// parseCompilerFragment splits the command of the compilation database in
+// compiler path, include directories, defines and flags. The response files
+// (@file) are expanded, as the language servers may not support them.
+func parseCompilerFragment(command compileCommand) *rpc.GetCompilerFragmentResponse {
+	args := command.Arguments
+	if len(args) == 0 {
+		args, _ = properties.SplitQuotedString(command.Command, `"'`, false)
+	}
+	args = expandResponseFiles(args, paths.New(command.Directory))
+	res := &rpc.GetCompilerFragmentResponse{
+		File:      command.File,
+		Directory: command.Directory,
+		Arguments: args,
+	}
+	if len(args) == 0 {
+		return res
+	}
+	res.CompilerPath = args[0]
+
+	// next returns the value of an option, attached or in the next argument
+	next := func(i *int, arg, option string) string {
+		if arg != option {
+			return strings.TrimPrefix(arg, option)
+		}
+		*i++
+		if *i < len(args) {
+			return args[*i]
+		}
+		return ""
+	}
+	prefix := ""
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case strings.HasPrefix(arg, "-I"):
+			res.IncludeDirs = append(res.IncludeDirs, next(&i, arg, "-I"))
+		case arg == "-isystem" || arg == "-iquote" || arg == "-idirafter":
+			res.IncludeDirs = append(res.IncludeDirs, next(&i, arg, arg))
+		case arg == "-iprefix":
+			prefix = next(&i, arg, arg)
+		case arg == "-iwithprefixbefore" || arg == "-iwithprefix":
+			res.IncludeDirs = append(res.IncludeDirs, prefix+next(&i, arg, arg))
+		case strings.HasPrefix(arg, "-D"):
+			res.Defines = append(res.Defines, next(&i, arg, "-D"))
+		case arg == "-o" || arg == "-MF" || arg == "-MT":
+			// the output files are not part of the fragment
+			i++
+		case arg == "-c" || arg == "-MMD" || arg == "-MD" || arg == command.File:
+		case arg == "-include" || arg == "-imacros" || arg == "-x":
+			res.Flags = append(res.Flags, arg, next(&i, arg, arg))
+		default:
+			res.Flags = append(res.Flags, arg)
+		}
+	}
+	return res
+}
+
+// expandResponseFiles replaces the @file arguments with the content of the
+// files, relative to the given directory.
+func expandResponseFiles(args []string, dir *paths.Path) []string {
+	res := []string{}
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "@") || len(arg) == 1 {
+			res = append(res, arg)
+			continue
+		}
+		file := paths.New(arg[1:])
+		if !file.IsAbs() {
+			file = dir.JoinPath(file)
+		}
+		data, err := file.ReadFile()
+		if err != nil {
+			res = append(res, arg)
+			continue
+		}
+		expanded, err := properties.SplitQuotedString(strings.TrimSpace(string(data)), `"'`, false)
+		if err != nil {
+			res = append(res, arg)
+			continue
+		}
+		res = append(res, expandResponseFiles(expanded, dir)...)
+	}
+	return res
+}
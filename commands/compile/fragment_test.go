@@ -0,0 +1,81 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCompilerFragment(t *testing.T) {
+	build, err := paths.New("testdata", "fragment", "build").Abs()
+	require.NoError(t, err)
+	fragment := parseCompilerFragment(compileCommand{
+		Directory: build.String(),
+		Arguments: []string{
+			"/tools/bin/g++", "-c", "-g", "-Os", "-std=gnu++11", "-MMD", "-DF_CPU=16000000L", "-D", "ARDUINO=10607",
+			"-I/core", "-I", "/variant", "@flags/includes", "-include", "Arduino.h",
+			"/build/sketch/Sketch.ino.cpp", "-o", "/build/sketch/Sketch.ino.cpp.o",
+		},
+		File: "/build/sketch/Sketch.ino.cpp",
+	})
+	require.Equal(t, "/tools/bin/g++", fragment.GetCompilerPath())
+	require.Equal(t, []string{"/core", "/variant", "/esp32/include/freertos", "/esp32/include/esp wifi"}, fragment.GetIncludeDirs())
+	require.Equal(t, []string{"F_CPU=16000000L", "ARDUINO=10607"}, fragment.GetDefines())
+	require.Equal(t, []string{"-g", "-Os", "-std=gnu++11", "-include", "Arduino.h"}, fragment.GetFlags())
+	// The response files are expanded in the complete command
+	require.NotContains(t, fragment.GetArguments(), "@flags/includes")
+	require.Contains(t, fragment.GetArguments(), "freertos")
+
+	// The command may be given as a single string
+	fragment = parseCompilerFragment(compileCommand{
+		Command: `"/tools/bin/g++" -c "-I/my core" -DDEBUG /build/sketch/Sketch.ino.cpp`,
+		File:    "/build/sketch/Sketch.ino.cpp",
+	})
+	require.Equal(t, "/tools/bin/g++", fragment.GetCompilerPath())
+	require.Equal(t, []string{"/my core"}, fragment.GetIncludeDirs())
+	require.Equal(t, []string{"DEBUG"}, fragment.GetDefines())
+	require.Empty(t, fragment.GetFlags())
+}
+
+func TestCompilerFragmentFingerprint(t *testing.T) {
+	sketchPath := paths.New(t.TempDir()).Join("Sketch")
+	require.NoError(t, paths.New("testdata", "fragment", "Sketch").CopyDirTo(sketchPath))
+	sk, err := sketch.New(sketchPath)
+	require.NoError(t, err)
+	libs := paths.New(t.TempDir())
+	fingerprint := compilerFragmentFingerprint(sk, paths.PathList{libs})
+
+	// Changing the code doesn't invalidate the fragment
+	mainFile := sketchPath.Join("Sketch.ino")
+	require.NoError(t, mainFile.WriteFile([]byte("#include <Servo.h>\n\nvoid setup() {\n  pinMode(13, OUTPUT);\n}\n\nvoid loop() {\n}\n")))
+	require.Equal(t, fingerprint, compilerFragmentFingerprint(sk, paths.PathList{libs}))
+
+	// Changing the includes does
+	require.NoError(t, mainFile.WriteFile([]byte("#include <Servo.h>\n#include <Wire.h>\n\nvoid setup() {\n}\n\nvoid loop() {\n}\n")))
+	changed := compilerFragmentFingerprint(sk, paths.PathList{libs})
+	require.NotEqual(t, fingerprint, changed)
+
+	// And installing a library too
+	require.NoError(t, libs.Join("Wire").Mkdir())
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, libs.Chtimes(later, later))
+	require.NotEqual(t, changed, compilerFragmentFingerprint(sk, paths.PathList{libs}))
+}
@@ -0,0 +1,127 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"debug/elf"
+	"errors"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/mapfile"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// readMemoryLayout returns the placement of the sections and of the symbols of
+// the executable. The map file generated by the linker is used if available,
+// since it contains the object file of each symbol, otherwise the layout is
+// read from the symbol table of the ELF file.
+func readMemoryLayout(buildPath *paths.Path, projectName string) (*rpc.MemoryLayout, error) {
+	var layout *rpc.MemoryLayout
+	if mapFile := buildPath.Join(projectName + ".map"); mapFile.Exist() {
+		m, err := mapfile.Load(mapFile)
+		if err != nil {
+			return nil, err
+		}
+		layout = memoryLayoutFromMapFile(m)
+		layout.Source = mapFile.String()
+	} else if elfFile := buildPath.Join(projectName + ".elf"); elfFile.Exist() {
+		f, err := elf.Open(elfFile.String())
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if layout, err = memoryLayoutFromELF(f); err != nil {
+			return nil, err
+		}
+		layout.Source = elfFile.String()
+	} else {
+		return nil, errors.New(tr("the build didn't produce a map or ELF file to read"))
+	}
+
+	sort.SliceStable(layout.Sections, func(i, j int) bool {
+		return layout.Sections[i].Address < layout.Sections[j].Address
+	})
+	for _, section := range layout.Sections {
+		sort.SliceStable(section.Symbols, func(i, j int) bool {
+			return section.Symbols[i].Address < section.Symbols[j].Address
+		})
+	}
+	return layout, nil
+}
+
+func memoryLayoutFromMapFile(m *mapfile.MapFile) *rpc.MemoryLayout {
+	layout := &rpc.MemoryLayout{}
+	for _, section := range m.Sections {
+		if !section.IsAllocated() || section.Size == 0 {
+			continue
+		}
+		rpcSection := &rpc.MemorySection{
+			Name:    section.Name,
+			Address: section.Address,
+			Size:    section.Size,
+		}
+		for _, inputSection := range section.InputSections {
+			if inputSection.Size == 0 {
+				continue
+			}
+			for _, placement := range inputSection.Placements() {
+				rpcSection.Symbols = append(rpcSection.Symbols, &rpc.MemorySymbol{
+					Name:       placement.Name,
+					Address:    placement.Address,
+					Size:       placement.Size,
+					ObjectFile: inputSection.Object,
+				})
+			}
+		}
+		layout.Sections = append(layout.Sections, rpcSection)
+	}
+	return layout
+}
+
+func memoryLayoutFromELF(f *elf.File) (*rpc.MemoryLayout, error) {
+	layout := &rpc.MemoryLayout{}
+	sections := map[int]*rpc.MemorySection{}
+	for i, section := range f.Sections {
+		if section.Flags&elf.SHF_ALLOC == 0 || section.Size == 0 {
+			continue
+		}
+		sections[i] = &rpc.MemorySection{
+			Name:    section.Name,
+			Address: section.Addr,
+			Size:    section.Size,
+		}
+		layout.Sections = append(layout.Sections, sections[i])
+	}
+	symbols, err := f.Symbols()
+	if err != nil && !errors.Is(err, elf.ErrNoSymbols) {
+		return nil, err
+	}
+	for _, symbol := range symbols {
+		typ := elf.ST_TYPE(symbol.Info)
+		if (typ != elf.STT_FUNC && typ != elf.STT_OBJECT) || symbol.Size == 0 || symbol.Name == "" {
+			continue
+		}
+		if section, ok := sections[int(symbol.Section)]; ok {
+			section.Symbols = append(section.Symbols, &rpc.MemorySymbol{
+				Name:    symbol.Name,
+				Address: symbol.Value,
+				Size:    symbol.Size,
+			})
+		}
+	}
+	return layout, nil
+}
@@ -0,0 +1,140 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// PrecompileCore builds the core archives of the requested boards and stores
+// them in the core build cache, in the same place used by Compile, so the
+// first compilation of a sketch for those boards can reuse them.
+func PrecompileCore(ctx context.Context, req *rpc.PrecompileCoreRequest, outStream, errStream io.Writer) (*rpc.PrecompileCoreResult, error) {
+	if len(req.GetFqbns()) == 0 {
+		return nil, &arduino.MissingFQBNError{}
+	}
+	fqbns, err := expandPrecompileFQBNs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compile an empty sketch to trigger the build of the core
+	tmp, err := paths.MkTempDir("", "arduino-core-precompile")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+	sketchPath := tmp.Join("precompile")
+	if err := sketchPath.MkdirAll(); err != nil {
+		return nil, &arduino.CantCreateSketchError{Cause: err}
+	}
+	if err := sketchPath.Join("precompile.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")); err != nil {
+		return nil, &arduino.CantCreateSketchError{Cause: err}
+	}
+
+	compileOut := io.Discard
+	if req.GetVerbose() {
+		compileOut = outStream
+	}
+	res := &rpc.PrecompileCoreResult{}
+	for i, fqbn := range fqbns {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		fmt.Fprintln(outStream, tr("Precompiling core for %[1]s (%[2]d of %[3]d)...", fqbn, i+1, len(fqbns)))
+		logrus.Infof("Precompiling core for %s", fqbn)
+		_, err := Compile(ctx, &rpc.CompileRequest{
+			Instance:         req.GetInstance(),
+			Fqbn:             fqbn,
+			SketchPath:       sketchPath.String(),
+			BuildPath:        tmp.Join("build", fmt.Sprint(i)).String(),
+			BuildCachePath:   req.GetBuildCachePath(),
+			OptimizeForDebug: req.GetOptimizeForDebug(),
+			Verbose:          req.GetVerbose(),
+		}, compileOut, errStream, nil)
+		if err != nil {
+			return res, &arduino.CompileFailedError{Message: tr("Error precompiling core for %s", fqbn), Cause: err}
+		}
+		res.PrecompiledFqbns = append(res.PrecompiledFqbns, fqbn)
+	}
+	return res, nil
+}
+
+// expandPrecompileFQBNs validates the requested FQBNs and, if requested,
+// expands them with all the combinations of the board options not already
+// set.
+func expandPrecompileFQBNs(req *rpc.PrecompileCoreRequest) ([]string, error) {
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+
+	res := []string{}
+	for _, fqbnIn := range req.GetFqbns() {
+		fqbn, err := cores.ParseFQBN(fqbnIn)
+		if err != nil {
+			return nil, &arduino.InvalidFQBNError{Cause: err}
+		}
+		board, err := pme.FindBoardWithFQBN(fqbn.StringWithoutConfig())
+		if err != nil {
+			return nil, &arduino.InvalidFQBNError{Cause: err}
+		}
+		if !req.GetAllBoardOptions() {
+			res = append(res, fqbn.String())
+			continue
+		}
+		for _, permutation := range boardOptionsPermutations(board, fqbn) {
+			res = append(res, permutation.String())
+		}
+	}
+	return res, nil
+}
+
+// boardOptionsPermutations returns all the FQBNs obtained combining the values
+// of the options of the board that are not already set in the given FQBN.
+func boardOptionsPermutations(board *cores.Board, fqbn *cores.FQBN) []*cores.FQBN {
+	res := []*cores.FQBN{fqbn.Clone()}
+	options := board.GetConfigOptions()
+	for _, option := range options.Keys() {
+		if fqbn.Configs.ContainsKey(option) {
+			continue
+		}
+		values := board.GetConfigOptionValues(option)
+		if values == nil || values.Size() == 0 {
+			continue
+		}
+		expanded := []*cores.FQBN{}
+		for _, partial := range res {
+			for _, value := range values.Keys() {
+				permutation := partial.Clone()
+				permutation.Configs.Set(option, value)
+				expanded = append(expanded, permutation)
+			}
+		}
+		res = expanded
+	}
+	return res
+}
@@ -0,0 +1,68 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardOptionsPermutations(t *testing.T) {
+	// The menus must be ordered
+	menus := properties.NewMap()
+	menus.Set("cpu", "Processor")
+	menus.Set("speed", "Clock")
+	board := &cores.Board{
+		BoardID: "nano",
+		Properties: properties.NewFromHashmap(map[string]string{
+			"menu.cpu.atmega328":    "ATmega328P",
+			"menu.cpu.atmega168":    "ATmega168",
+			"menu.speed.16":         "16 MHz",
+			"menu.speed.8":          "8 MHz",
+			"menu.speed.8.build.f":  "8000000L",
+			"menu.speed.16.build.f": "16000000L",
+		}),
+		PlatformRelease: &cores.PlatformRelease{Menus: menus},
+	}
+
+	toStrings := func(fqbns []*cores.FQBN) []string {
+		res := []string{}
+		for _, fqbn := range fqbns {
+			res = append(res, fqbn.String())
+		}
+		return res
+	}
+
+	fqbn, err := cores.ParseFQBN("arduino:avr:nano")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"arduino:avr:nano:cpu=atmega328,speed=16",
+		"arduino:avr:nano:cpu=atmega328,speed=8",
+		"arduino:avr:nano:cpu=atmega168,speed=16",
+		"arduino:avr:nano:cpu=atmega168,speed=8",
+	}, toStrings(boardOptionsPermutations(board, fqbn)))
+
+	// Options already set in the FQBN are not expanded
+	fqbn, err = cores.ParseFQBN("arduino:avr:nano:cpu=atmega168")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"arduino:avr:nano:cpu=atmega168,speed=16",
+		"arduino:avr:nano:cpu=atmega168,speed=8",
+	}, toStrings(boardOptionsPermutations(board, fqbn)))
+}
@@ -0,0 +1,199 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cloud"
+	"github.com/arduino/arduino-cli/arduino/partitions"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// memoryRegionSizeProperties maps the memory regions that can be customized in
+// the sketch project file to the board property holding their maximum size.
+var memoryRegionSizeProperties = map[string]string{
+	"flash": "upload.maximum_size",
+	"ram":   "upload.maximum_data_size",
+}
+
+// applyProjectBuildSettings applies the linker script and the memory layout
+// overrides of the sketch project file to the build properties, checking that
+// they are supported by the selected board.
+func applyProjectBuildSettings(sk *sketch.Sketch, fqbn string, buildProperties *properties.Map) error {
+	if sk == nil || sk.Project == nil {
+		return nil
+	}
+
+	if build := sk.Project.Build; build != nil && build.LDScript != "" {
+		ldscript := paths.New(build.LDScript)
+		if !ldscript.IsAbs() {
+			ldscript = sk.FullPath.JoinPath(ldscript)
+		}
+		if !ldscript.Exist() {
+			return &arduino.InvalidArgumentError{Message: tr("Linker script %s not found", ldscript)}
+		}
+		linkRecipe := buildProperties.Get("recipe.c.combine.pattern")
+		if !strings.Contains(linkRecipe, "{build.ldscript}") {
+			return &arduino.InvalidArgumentError{Message: tr("The board %s does not support overriding the linker script", fqbn)}
+		}
+		value := ldscript.String()
+		if variantPath := buildProperties.Get("build.variant.path"); strings.Contains(linkRecipe, "{build.variant.path}/{build.ldscript}") && variantPath != "" {
+			// The platform expects the linker script inside the variant folder
+			rel, err := filepath.Rel(variantPath, ldscript.String())
+			if err != nil {
+				return &arduino.InvalidArgumentError{Message: tr("Cannot use linker script %s", ldscript), Cause: err}
+			}
+			value = rel
+		}
+		buildProperties.Set("build.ldscript", value)
+	}
+
+	if file := sk.GetPartitionsFile(); file != nil {
+		if !platformSupportsPartitions(buildProperties) {
+			return &arduino.InvalidArgumentError{Message: tr("The board %s does not support custom partition tables", fqbn)}
+		}
+		table, err := partitions.Load(file)
+		if err != nil {
+			return &arduino.InvalidArgumentError{Message: tr("Cannot load partition table"), Cause: err}
+		}
+		flashSize, _ := partitions.ParseSize(buildProperties.Get("build.flash_size"))
+		if err := table.Validate(flashSize); err != nil {
+			return &arduino.InvalidArgumentError{Message: tr("Invalid partition table %s", file), Cause: err}
+		}
+		buildProperties.SetPath("build.partitions_file", file)
+	}
+
+	for _, name := range sk.Project.Memory.RegionNames() {
+		region := sk.Project.Memory[name]
+		sizeProperty, ok := memoryRegionSizeProperties[name]
+		if !ok {
+			return &arduino.InvalidArgumentError{Message: tr("Unknown memory region %[1]s, the supported regions are: %[2]s", name, "flash, ram")}
+		}
+		if region.Length == 0 {
+			return &arduino.InvalidArgumentError{Message: tr("The length of memory region %s must be greater than zero", name)}
+		}
+		if maxSize, err := strconv.ParseUint(buildProperties.Get(sizeProperty), 10, 64); err == nil && maxSize > 0 && uint64(region.Length) > maxSize {
+			return &arduino.InvalidArgumentError{Message: tr("The memory region %[1]s (%[2]d bytes) exceeds the size available on board %[3]s (%[4]d bytes)", name, region.Length, fqbn, maxSize)}
+		}
+		buildProperties.Set(sizeProperty, fmt.Sprint(uint64(region.Length)))
+		buildProperties.Set("build.memory."+name+".length", fmt.Sprintf("0x%X", uint64(region.Length)))
+		if region.Origin != nil {
+			buildProperties.Set("build.memory."+name+".origin", fmt.Sprintf("0x%X", uint64(*region.Origin)))
+		}
+	}
+	return nil
+}
+
+// platformSupportsPartitions returns true if the build recipes of the platform
+// handle a partition table.
+func platformSupportsPartitions(buildProperties *properties.Map) bool {
+	for _, key := range buildProperties.Keys() {
+		if strings.HasPrefix(key, "recipe.") && strings.Contains(buildProperties.Get(key), "partitions") {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionsCopyMarker marks a partition table copied in the build folder
+// from the sketch project file selection.
+const partitionsCopyMarker = "partitions.csv.from-sketch-project"
+
+// copyProjectPartitionsFile copies the partition table selected in the sketch
+// project file in the build folder, where the build recipes of the ESP32-class
+// platforms look for it. A table copied by a previous build is removed if the
+// selection has been removed.
+func copyProjectPartitionsFile(buildProperties *properties.Map, buildPath *paths.Path) error {
+	target := buildPath.Join("partitions.csv")
+	marker := buildPath.Join(partitionsCopyMarker)
+	file := buildProperties.GetPath("build.partitions_file")
+	if file == nil {
+		if marker.Exist() {
+			_ = target.Remove()
+			_ = marker.Remove()
+		}
+		return nil
+	}
+	if err := file.CopyTo(target); err != nil {
+		return &arduino.PermissionDeniedError{Message: tr("Cannot copy the partition table in the build folder"), Cause: err}
+	}
+	return marker.WriteFile([]byte(file.String()))
+}
+
+// selectBuildConfiguration returns the build configuration of the sketch
+// project file with the given name, or the default one if the name is empty.
+// It returns nil if no configuration is selected.
+func selectBuildConfiguration(sk *sketch.Sketch, name string) (*sketch.BuildConfiguration, error) {
+	if name == "" {
+		name = sk.Project.DefaultConfiguration
+	}
+	if name == "" {
+		return nil, nil
+	}
+	configuration := sk.Project.Configurations.Get(name)
+	if configuration == nil {
+		available := strings.Join(sk.Project.Configurations.Names(), ", ")
+		if available == "" {
+			available = tr("none")
+		}
+		return nil, &arduino.InvalidArgumentError{Message: tr("Build configuration %[1]s not found in the sketch project file, available configurations: %[2]s", name, available)}
+	}
+	return configuration, nil
+}
+
+// buildConfigurationProperties returns the build properties setting the
+// optimization flags and the defines of the build configuration. The defines
+// are appended to the `build.extra_flags` of the board.
+func buildConfigurationProperties(configuration *sketch.BuildConfiguration, buildProperties *properties.Map) []string {
+	res := []string{"build.configuration=" + configuration.Name}
+	if strings.HasPrefix(configuration.Optimization, "-") {
+		res = append(res, "compiler.optimization_flags="+configuration.Optimization)
+	}
+	if len(configuration.Defines) > 0 {
+		extraFlags := strings.TrimSpace(buildProperties.Get("build.extra_flags"))
+		for _, define := range configuration.Defines {
+			extraFlags += " -D" + define
+		}
+		res = append(res, "build.extra_flags="+strings.TrimSpace(extraFlags))
+	}
+	return append(res, configuration.BuildProperties...)
+}
+
+// applyProjectCloudSettings adds to build.extra_flags the defines of the
+// Arduino Cloud thing and device bound to the sketch, together with the secret
+// key of the device if it has been provisioned from this computer.
+func applyProjectCloudSettings(sk *sketch.Sketch, dataDir *paths.Path, buildProperties *properties.Map) error {
+	if sk == nil || sk.Project == nil || sk.Project.Cloud == nil {
+		return nil
+	}
+	keys, err := cloud.LoadDeviceKeys(dataDir)
+	if err != nil {
+		return &arduino.PermissionDeniedError{Message: tr("Cannot read the Arduino Cloud device keys"), Cause: err}
+	}
+	extraFlags := strings.TrimSpace(buildProperties.Get("build.extra_flags"))
+	for _, define := range keys.BuildDefines(sk.Project.Cloud.ThingID, sk.Project.Cloud.DeviceID) {
+		extraFlags += " -D" + define
+	}
+	buildProperties.Set("build.extra_flags", strings.TrimSpace(extraFlags))
+	return nil
+}
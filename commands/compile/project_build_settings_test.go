@@ -0,0 +1,186 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyProjectBuildSettings(t *testing.T) {
+	sketchPath := paths.New(t.TempDir())
+	require.NoError(t, sketchPath.Join("linker").MkdirAll())
+	require.NoError(t, sketchPath.Join("linker", "custom.ld").WriteFile([]byte{}))
+	variantPath := paths.New(t.TempDir()).Join("variants", "mkr1000")
+
+	boardProperties := func() *properties.Map {
+		return properties.NewFromHashmap(map[string]string{
+			"build.variant.path":       variantPath.String(),
+			"build.ldscript":           "linker_scripts/gcc/flash_with_bootloader.ld",
+			"recipe.c.combine.pattern": `"{compiler.path}{compiler.c.elf.cmd}" "-T{build.variant.path}/{build.ldscript}" -o "{build.path}/{build.project_name}.elf"`,
+			"upload.maximum_size":      "262144",
+			"upload.maximum_data_size": "32768",
+		})
+	}
+	flashOrigin := sketch.MemorySize(0x2000)
+	sk := &sketch.Sketch{
+		FullPath: sketchPath,
+		Project: &sketch.Project{
+			Build: &sketch.ProjectBuildSettings{LDScript: "linker/custom.ld"},
+			Memory: sketch.ProjectMemoryLayout{
+				"flash": {Origin: &flashOrigin, Length: 0x3E000},
+				"ram":   {Length: 0x4000},
+			},
+		},
+	}
+
+	props := boardProperties()
+	require.NoError(t, applyProjectBuildSettings(sk, "arduino:samd:mkr1000", props))
+	ldscript := variantPath.JoinPath(paths.New(props.Get("build.ldscript")))
+	require.True(t, ldscript.EquivalentTo(sketchPath.Join("linker", "custom.ld")), ldscript)
+	require.Equal(t, "253952", props.Get("upload.maximum_size"))
+	require.Equal(t, "16384", props.Get("upload.maximum_data_size"))
+	require.Equal(t, "0x2000", props.Get("build.memory.flash.origin"))
+	require.Equal(t, "0x3E000", props.Get("build.memory.flash.length"))
+	require.False(t, props.ContainsKey("build.memory.ram.origin"))
+
+	// Linker script not used by the platform
+	props = boardProperties()
+	props.Set("recipe.c.combine.pattern", `"{compiler.path}{compiler.c.elf.cmd}" -o "{build.path}/{build.project_name}.elf"`)
+	require.Error(t, applyProjectBuildSettings(sk, "arduino:samd:mkr1000", props))
+
+	// Linker script not passed through the variant folder
+	props = boardProperties()
+	props.Set("recipe.c.combine.pattern", `"{compiler.path}{compiler.c.elf.cmd}" "-T{build.ldscript}"`)
+	require.NoError(t, applyProjectBuildSettings(sk, "arduino:samd:mkr1000", props))
+	require.Equal(t, filepath.Join(sketchPath.String(), "linker", "custom.ld"), props.Get("build.ldscript"))
+
+	// Memory regions larger than the board memory
+	sk.Project.Memory["ram"].Length = 0x10000
+	require.Error(t, applyProjectBuildSettings(sk, "arduino:samd:mkr1000", boardProperties()))
+
+	// Unknown memory regions
+	sk.Project.Memory = sketch.ProjectMemoryLayout{"eeprom": {Length: 1024}}
+	require.Error(t, applyProjectBuildSettings(sk, "arduino:samd:mkr1000", boardProperties()))
+}
+
+func TestProjectPartitions(t *testing.T) {
+	sketchPath := paths.New(t.TempDir())
+	require.NoError(t, paths.New("..", "..", "arduino", "partitions", "testdata", "default.csv").CopyTo(sketchPath.Join("partitions.csv")))
+	sk := &sketch.Sketch{
+		FullPath: sketchPath,
+		Project:  &sketch.Project{Partitions: "partitions.csv"},
+	}
+	esp32Properties := func(flashSize string) *properties.Map {
+		return properties.NewFromHashmap(map[string]string{
+			"build.flash_size":                flashSize,
+			"recipe.hooks.prebuild.3.pattern": `cp "{runtime.platform.path}/tools/partitions/{build.partitions}.csv" "{build.path}/partitions.csv"`,
+		})
+	}
+
+	props := esp32Properties("4MB")
+	require.NoError(t, applyProjectBuildSettings(sk, "esp32:esp32:esp32", props))
+	require.Equal(t, sketchPath.Join("partitions.csv").String(), props.Get("build.partitions_file"))
+
+	buildPath := paths.New(t.TempDir())
+	require.NoError(t, copyProjectPartitionsFile(props, buildPath))
+	require.True(t, buildPath.Join("partitions.csv").Exist())
+	// The copy is removed when the selection is removed
+	require.NoError(t, copyProjectPartitionsFile(properties.NewMap(), buildPath))
+	require.False(t, buildPath.Join("partitions.csv").Exist())
+
+	// The table doesn't fit in the flash
+	require.Error(t, applyProjectBuildSettings(sk, "esp32:esp32:esp32", esp32Properties("2MB")))
+
+	// The platform doesn't support partition tables
+	require.Error(t, applyProjectBuildSettings(sk, "arduino:avr:uno", properties.NewMap()))
+}
+
+func TestBuildConfigurations(t *testing.T) {
+	sk := &sketch.Sketch{
+		FullPath: paths.New(t.TempDir()),
+		Project: &sketch.Project{
+			Configurations: sketch.ProjectBuildConfigurations{
+				{Name: "debug", Optimization: "debug", Defines: []string{"DEBUG=1", "LOG_LEVEL=3"}, Warnings: "all"},
+				{Name: "release", Optimization: "-O2", BuildProperties: []string{"compiler.c.extra_flags=-flto"}},
+			},
+		},
+	}
+
+	// No configuration selected
+	configuration, err := selectBuildConfiguration(sk, "")
+	require.NoError(t, err)
+	require.Nil(t, configuration)
+
+	// Default configuration
+	sk.Project.DefaultConfiguration = "release"
+	configuration, err = selectBuildConfiguration(sk, "")
+	require.NoError(t, err)
+	require.Equal(t, "release", configuration.Name)
+
+	// Unknown configuration
+	_, err = selectBuildConfiguration(sk, "profiling")
+	require.Error(t, err)
+
+	boardProperties := properties.NewFromHashmap(map[string]string{
+		"build.extra_flags": "-DARDUINO_SAMD_ZERO {build.usb_flags}",
+	})
+	configuration, err = selectBuildConfiguration(sk, "debug")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"build.configuration=debug",
+		"build.extra_flags=-DARDUINO_SAMD_ZERO {build.usb_flags} -DDEBUG=1 -DLOG_LEVEL=3",
+	}, buildConfigurationProperties(configuration, boardProperties))
+	require.Equal(t, []string{
+		"build.configuration=release",
+		"compiler.optimization_flags=-O2",
+		"compiler.c.extra_flags=-flto",
+	}, buildConfigurationProperties(sk.Project.Configurations.Get("release"), boardProperties))
+}
+
+func TestApplyProjectCloudSettings(t *testing.T) {
+	dataDir := paths.New(t.TempDir())
+	sk := &sketch.Sketch{
+		FullPath: paths.New(t.TempDir()),
+		Project: &sketch.Project{
+			Cloud: &sketch.ProjectCloudSettings{ThingID: "thing-1", DeviceID: "device-1"},
+		},
+	}
+
+	boardProperties := properties.NewFromHashmap(map[string]string{
+		"build.extra_flags": "-DARDUINO_SAMD_ZERO",
+	})
+	require.NoError(t, applyProjectCloudSettings(sk, dataDir, boardProperties))
+	require.Equal(t, `-DARDUINO_SAMD_ZERO -DARDUINO_CLOUD_THING_ID="thing-1" -DARDUINO_CLOUD_DEVICE_ID="device-1"`, boardProperties.Get("build.extra_flags"))
+
+	// The secret key of the device is added once provisioned
+	require.NoError(t, dataDir.Join("cloud").MkdirAll())
+	require.NoError(t, dataDir.Join("cloud", "device_keys.yaml").WriteFile([]byte("device-1: s3cr3t\n")))
+	boardProperties = properties.NewMap()
+	require.NoError(t, applyProjectCloudSettings(sk, dataDir, boardProperties))
+	require.Equal(t, `-DARDUINO_CLOUD_THING_ID="thing-1" -DARDUINO_CLOUD_DEVICE_ID="device-1" -DARDUINO_CLOUD_DEVICE_KEY="s3cr3t"`, boardProperties.Get("build.extra_flags"))
+
+	// Sketches not bound to a thing are left untouched
+	sk.Project.Cloud = nil
+	boardProperties = properties.NewMap()
+	require.NoError(t, applyProjectCloudSettings(sk, dataDir, boardProperties))
+	require.False(t, boardProperties.ContainsKey("build.extra_flags"))
+}
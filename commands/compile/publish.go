@@ -0,0 +1,150 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/httpclient"
+	"github.com/arduino/arduino-cli/arduino/publish"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/version"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// publishDestinations returns the destinations of the publishTo list, each
+// item is the name of a publisher of the configuration or a URL template.
+func publishDestinations(publishTo []string) ([]*publish.Destination, error) {
+	if len(publishTo) == 0 {
+		return nil, nil
+	}
+	publishers := map[string]*publish.Destination{}
+	if err := configuration.Settings.UnmarshalKey("publishers", &publishers); err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid publishers configuration"), Cause: err}
+	}
+
+	res := []*publish.Destination{}
+	for _, target := range publishTo {
+		dest, ok := publishers[target]
+		if !ok {
+			if !strings.Contains(target, "://") {
+				return nil, &arduino.InvalidArgumentError{Message: tr("Unknown publisher '%s'", target)}
+			}
+			dest = &publish.Destination{URL: target}
+		}
+		if err := dest.Validate(); err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid publishing destination '%s'", target), Cause: err}
+		}
+		res = append(res, dest)
+	}
+	return res, nil
+}
+
+// buildProfileName returns the name of the profile used by the build, if any.
+func buildProfileName(pme *packagemanager.Explorer) string {
+	if pme.GetProfile() == nil {
+		return ""
+	}
+	return pme.GetProfile().Name
+}
+
+// writeBuildManifest writes in dir the manifest of the build, listing the
+// artifacts of the build found in dir, and returns the manifest file together
+// with the artifacts.
+func writeBuildManifest(dir *paths.Path, sketchBuilder *builder.Builder, r *rpc.CompileResponse, sketchName string, fqbn *cores.FQBN, profile string, buildTime time.Time) (*paths.Path, paths.PathList, error) {
+	buildProperties := sketchBuilder.GetBuildProperties()
+	projectName, ok := buildProperties.GetOk("build.project_name")
+	if !ok {
+		return nil, nil, &arduino.MissingPlatformPropertyError{Property: "build.project_name"}
+	}
+	buildFiles, err := dir.ReadDir()
+	if err != nil {
+		return nil, nil, &arduino.PermissionDeniedError{Message: tr("Error reading build directory"), Cause: err}
+	}
+	buildFiles.FilterPrefix(projectName)
+	buildFiles.FilterOutDirs()
+	manifestFile := dir.Join(projectName + publish.ManifestFileSuffix)
+	buildFiles.FilterOutPrefix(manifestFile.Base())
+
+	manifest := &publish.Manifest{
+		Sketch:        sketchName,
+		FQBN:          fqbn.String(),
+		Configuration: r.GetConfiguration(),
+		Profile:       profile,
+		BuildTime:     buildTime.UTC().Format(time.RFC3339),
+		CLIVersion:    version.VersionInfo.VersionString,
+		Platforms:     []*publish.ManifestPlatform{},
+		Libraries:     []*publish.ManifestLibrary{},
+	}
+	for _, platform := range []*rpc.InstalledPlatformReference{r.GetBoardPlatform(), r.GetBuildPlatform()} {
+		if platform == nil || (len(manifest.Platforms) > 0 && manifest.Platforms[0].ID == platform.GetId()) {
+			continue
+		}
+		manifest.Platforms = append(manifest.Platforms, &publish.ManifestPlatform{
+			ID:         platform.GetId(),
+			Version:    platform.GetVersion(),
+			PackageURL: platform.GetPackageUrl(),
+		})
+	}
+	for _, lib := range sketchBuilder.ImportedLibraries() {
+		manifestLib := &publish.ManifestLibrary{Name: lib.Name, Location: lib.Location.String()}
+		if lib.Version != nil {
+			manifestLib.Version = lib.Version.String()
+		}
+		manifest.Libraries = append(manifest.Libraries, manifestLib)
+	}
+	if err := manifest.AddArtifacts(buildFiles); err != nil {
+		return nil, nil, &arduino.PermissionDeniedError{Message: tr("Error reading build directory"), Cause: err}
+	}
+	if err := manifest.Save(manifestFile); err != nil {
+		return nil, nil, &arduino.PermissionDeniedError{Message: tr("Error writing the build manifest"), Cause: err}
+	}
+	return manifestFile, buildFiles, nil
+}
+
+// publishArtifacts writes the manifest of the build and uploads it, together
+// with the artifacts of the build, to the destinations.
+func publishArtifacts(destinations []*publish.Destination, sketchBuilder *builder.Builder, r *rpc.CompileResponse, sketchName string, fqbn *cores.FQBN, profile string) ([]*rpc.PublishedArtifact, error) {
+	buildTime := time.Now()
+	manifestFile, buildFiles, err := writeBuildManifest(sketchBuilder.GetBuildPath(), sketchBuilder, r, sketchName, fqbn, profile, buildTime)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := httpclient.New()
+	if err != nil {
+		return nil, err
+	}
+	vars := publish.NewVariables(sketchName, fqbn.StringWithoutConfig(), r.GetConfiguration(), buildTime)
+	publishedFiles := append(buildFiles, manifestFile)
+	res := []*rpc.PublishedArtifact{}
+	for _, dest := range destinations {
+		artifacts, err := dest.Publish(client, publishedFiles, vars)
+		if err != nil {
+			return res, &arduino.FailedUploadError{Message: tr("Error publishing the build artifacts"), Cause: err}
+		}
+		for _, artifact := range artifacts {
+			res = append(res, &rpc.PublishedArtifact{File: artifact.File, Url: artifact.URL})
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,60 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishDestinations(t *testing.T) {
+	configFile := paths.New(t.TempDir()).Join("arduino-cli.yaml")
+	require.NoError(t, configFile.WriteFile([]byte(`
+publishers:
+  nightly:
+    url: s3://firmware/{sketch}/{date}
+    endpoint: https://minio.example.com
+    access_key_id: AKID
+    secret_access_key: SECRET
+    headers:
+      x-amz-acl: private
+`)))
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init(configFile.String())
+
+	destinations, err := publishDestinations(nil)
+	require.NoError(t, err)
+	require.Empty(t, destinations)
+
+	destinations, err = publishDestinations([]string{"nightly", "https://example.com/{fqbn}/{file}"})
+	require.NoError(t, err)
+	require.Len(t, destinations, 2)
+	require.Equal(t, "s3://firmware/{sketch}/{date}", destinations[0].URL)
+	require.Equal(t, "https://minio.example.com", destinations[0].Endpoint)
+	require.Equal(t, "AKID", destinations[0].AccessKeyID)
+	require.Equal(t, map[string]string{"x-amz-acl": "private"}, destinations[0].Headers)
+	require.Equal(t, "https://example.com/{fqbn}/{file}", destinations[1].URL)
+
+	_, err = publishDestinations([]string{"weekly"})
+	require.ErrorContains(t, err, "Unknown publisher 'weekly'")
+
+	_, err = publishDestinations([]string{"https://example.com/{version}"})
+	require.ErrorContains(t, err, "{version}")
+}
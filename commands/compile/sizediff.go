@@ -0,0 +1,71 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"errors"
+
+	"github.com/arduino/arduino-cli/arduino/sizediff"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// compareBuildSizes compares the sizes of the sections and of the symbols of
+// the current build with the ELF or map file of a previous build. The map file
+// of the current build is used if the previous build is a map file and the
+// platform generates one, otherwise the ELF file is used.
+func compareBuildSizes(buildPath *paths.Path, projectName string, previous *paths.Path) (*rpc.SizeDiff, error) {
+	if !previous.Exist() {
+		return nil, errors.New(tr("file not found: %s", previous))
+	}
+	oldReport, err := sizediff.Load(previous)
+	if err != nil {
+		return nil, err
+	}
+
+	current := buildPath.Join(projectName + ".elf")
+	if previous.Ext() == ".map" {
+		if mapFile := buildPath.Join(projectName + ".map"); mapFile.Exist() {
+			current = mapFile
+		}
+	}
+	if !current.Exist() {
+		return nil, errors.New(tr("the build didn't produce an ELF file to compare"))
+	}
+	newReport, err := sizediff.Load(current)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := sizediff.Compare(oldReport, newReport)
+	return &rpc.SizeDiff{
+		ComparedWith: previous.String(),
+		Sections:     sizeChangesToRPC(diff.Sections),
+		Symbols:      sizeChangesToRPC(diff.Symbols),
+	}, nil
+}
+
+func sizeChangesToRPC(changes []*sizediff.Change) []*rpc.SizeChange {
+	res := make([]*rpc.SizeChange, len(changes))
+	for i, change := range changes {
+		res[i] = &rpc.SizeChange{
+			Name:    change.Name,
+			OldSize: change.OldSize,
+			NewSize: change.NewSize,
+		}
+	}
+	return res
+}
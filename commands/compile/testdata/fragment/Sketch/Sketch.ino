@@ -0,0 +1,7 @@
+#include <Servo.h>
+
+void setup() {
+}
+
+void loop() {
+}
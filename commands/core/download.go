@@ -51,12 +51,12 @@ func PlatformDownload(ctx context.Context, req *rpc.PlatformDownloadRequest, dow
 		return nil, &arduino.PlatformNotFoundError{Platform: ref.String(), Cause: err}
 	}
 
-	if err := pme.DownloadPlatformRelease(platform, nil, downloadCB); err != nil {
+	if err := pme.DownloadPlatformRelease(ctx, platform, nil, downloadCB); err != nil {
 		return nil, err
 	}
 
 	for _, tool := range tools {
-		if err := pme.DownloadToolRelease(tool, nil, downloadCB); err != nil {
+		if err := pme.DownloadToolRelease(ctx, tool, nil, downloadCB); err != nil {
 			return nil, err
 		}
 	}
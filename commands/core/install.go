@@ -29,6 +29,12 @@ import (
 // PlatformInstall FIXMEDOC
 func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) (*rpc.PlatformInstallResponse, error) {
 	install := func() error {
+		releaseResources, err := instances.AcquireWrite(req.GetInstance(), "PlatformInstall", instances.Platforms)
+		if err != nil {
+			return err
+		}
+		defer releaseResources()
+
 		pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
 		if pme == nil {
 			return &arduino.InvalidInstanceError{}
@@ -64,7 +70,7 @@ func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallRequest, downl
 			}
 		}
 
-		if err := pme.DownloadAndInstallPlatformAndTools(platformRelease, tools, downloadCB, taskCB, req.GetSkipPostInstall(), req.GetSkipPreUninstall()); err != nil {
+		if err := pme.DownloadAndInstallPlatformAndTools(ctx, platformRelease, tools, downloadCB, taskCB, req.GetSkipPostInstall(), req.GetSkipPreUninstall()); err != nil {
 			return err
 		}
 
@@ -74,7 +80,7 @@ func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallRequest, downl
 	if err := install(); err != nil {
 		return nil, err
 	}
-	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
+	if err := commands.Init(ctx, &rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
 		return nil, err
 	}
 	return &rpc.PlatformInstallResponse{}, nil
@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// PlatformPreload loads the installed platforms whose loading has been deferred
+// by an Init with lazy platforms loading enabled.
+func PlatformPreload(ctx context.Context, req *rpc.PlatformPreloadRequest) (*rpc.PlatformPreloadResponse, error) {
+	pm := instances.GetPackageManager(req.GetInstance())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	loaded, errs := pm.LoadDeferredPlatforms(req.GetPlatforms()...)
+	res := &rpc.PlatformPreloadResponse{}
+	for _, release := range loaded {
+		res.LoadedPlatforms = append(res.LoadedPlatforms, release.String())
+	}
+	if len(errs) > 0 {
+		return res, &arduino.PlatformLoadingError{Cause: errors.Join(errs...)}
+	}
+	return res, nil
+}
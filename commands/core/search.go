@@ -25,6 +25,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
 
@@ -42,6 +43,10 @@ func PlatformSearch(req *rpc.PlatformSearchRequest) (*rpc.PlatformSearchResponse
 		res = pme.FindPlatformReleaseProvidingBoardsWithVidPid(vid, pid)
 	} else {
 		searchArgs := utils.SearchTermsFromQueryString(req.SearchArgs)
+		match := utils.Match
+		if req.GetFuzzy() {
+			match = utils.MatchFuzzy
+		}
 		for _, targetPackage := range pme.GetPackages() {
 			for _, platform := range targetPackage.Platforms {
 				if platform == nil {
@@ -74,7 +79,7 @@ func PlatformSearch(req *rpc.PlatformSearchRequest) (*rpc.PlatformSearchResponse
 				}
 
 				// Search
-				if !utils.Match(toTest, searchArgs) {
+				if !match(toTest, searchArgs) {
 					continue
 				}
 
@@ -83,6 +88,7 @@ func PlatformSearch(req *rpc.PlatformSearchRequest) (*rpc.PlatformSearchResponse
 		}
 	}
 
+	pinnedPlatforms := configuration.PinnedPlatforms(configuration.Settings)
 	out := []*rpc.PlatformSummary{}
 	for _, platform := range res {
 		rpcPlatformSummary := &rpc.PlatformSummary{
@@ -99,6 +105,9 @@ func PlatformSearch(req *rpc.PlatformSearchRequest) (*rpc.PlatformSearchResponse
 		if latest != nil {
 			rpcPlatformSummary.LatestVersion = latest.Version.String()
 		}
+		if pinnedVersion := pinnedPlatforms[platform.String()]; pinnedVersion != nil {
+			rpcPlatformSummary.PinnedVersion = pinnedVersion.String()
+		}
 		if req.AllVersions {
 			for _, platformRelease := range platform.GetAllReleases() {
 				rpcPlatformRelease := commands.PlatformReleaseToRPC(platformRelease)
@@ -125,5 +134,8 @@ func PlatformSearch(req *rpc.PlatformSearchRequest) (*rpc.PlatformSearchResponse
 	sort.SliceStable(out, func(i, j int) bool {
 		return !out[i].GetMetadata().Deprecated && out[j].GetMetadata().Deprecated
 	})
-	return &rpc.PlatformSearchResponse{SearchOutput: out}, nil
+	return &rpc.PlatformSearchResponse{
+		SearchOutput: utils.Paginate(out, req.GetOffset(), req.GetLimit()),
+		Total:        uint32(len(out)),
+	}, nil
 }
@@ -30,7 +30,7 @@ func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallRequest, t
 	if err := platformUninstall(ctx, req, taskCB); err != nil {
 		return nil, err
 	}
-	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
+	if err := commands.Init(ctx, &rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
 		return nil, err
 	}
 	return &rpc.PlatformUninstallResponse{}, nil
@@ -38,6 +38,12 @@ func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallRequest, t
 
 // platformUninstall is the implementation of platform unistaller
 func platformUninstall(ctx context.Context, req *rpc.PlatformUninstallRequest, taskCB rpc.TaskProgressCB) error {
+	releaseResources, err := instances.AcquireWrite(req.GetInstance(), "PlatformUninstall", instances.Platforms)
+	if err != nil {
+		return err
+	}
+	defer releaseResources()
+
 	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
 	if pme == nil {
 		return &arduino.InvalidInstanceError{}
@@ -23,12 +23,19 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
 
 // PlatformUpgrade FIXMEDOC
 func PlatformUpgrade(ctx context.Context, req *rpc.PlatformUpgradeRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) (*rpc.PlatformUpgradeResponse, error) {
 	upgrade := func() (*cores.PlatformRelease, error) {
+		releaseResources, err := instances.AcquireWrite(req.GetInstance(), "PlatformUpgrade", instances.Platforms)
+		if err != nil {
+			return nil, err
+		}
+		defer releaseResources()
+
 		pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
 		if pme == nil {
 			return nil, &arduino.InvalidInstanceError{}
@@ -40,7 +47,8 @@ func PlatformUpgrade(ctx context.Context, req *rpc.PlatformUpgradeRequest, downl
 			Package:              req.PlatformPackage,
 			PlatformArchitecture: req.Architecture,
 		}
-		platform, err := pme.DownloadAndInstallPlatformUpgrades(ref, downloadCB, taskCB, req.GetSkipPostInstall(), req.GetSkipPreUninstall())
+		pinnedVersion := configuration.PinnedPlatforms(configuration.Settings)[ref.Package+":"+ref.PlatformArchitecture]
+		platform, err := pme.DownloadAndInstallPlatformUpgrades(ctx, ref, pinnedVersion, downloadCB, taskCB, req.GetSkipPostInstall(), req.GetSkipPreUninstall())
 		if err != nil {
 			return platform, err
 		}
@@ -59,7 +67,7 @@ func PlatformUpgrade(ctx context.Context, req *rpc.PlatformUpgradeRequest, downl
 	if err != nil {
 		return &rpc.PlatformUpgradeResponse{Platform: rpcPlatform}, err
 	}
-	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
+	if err := commands.Init(ctx, &rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
 		return nil, err
 	}
 
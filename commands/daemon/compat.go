@@ -0,0 +1,111 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"google.golang.org/grpc"
+)
+
+// CurrentAPIVersion is the major version of the gRPC API implemented by
+// ArduinoCoreServerImpl.
+var CurrentAPIVersion = apiVersionOf(string(rpc.File_cc_arduino_cli_commands_v1_commands_proto.Package()))
+
+// APICompatAdapter registers in the gRPC server the services of a previous
+// major version of the API, translating the calls to and from the current
+// implementation.
+type APICompatAdapter func(s *grpc.Server, impl *ArduinoCoreServerImpl)
+
+// apiCompatAdapters contains the adapters of the previous major versions of
+// the API that the daemon is able to serve. When the API is bumped to a new
+// major version the previous one should be added here.
+var apiCompatAdapters = map[string]APICompatAdapter{}
+
+// SupportedAPIVersions returns the versions of the gRPC API that the daemon
+// is able to serve, the current one and the ones with a compatibility adapter.
+func SupportedAPIVersions() []string {
+	res := []string{CurrentAPIVersion}
+	for version := range apiCompatAdapters {
+		res = append(res, version)
+	}
+	sortAPIVersions(res)
+	return res
+}
+
+// RegisterAPICompat registers in the gRPC server the adapters for the given
+// previous versions of the API, and records them as served by impl. The
+// current version is always served and is accepted as a no-op.
+func RegisterAPICompat(s *grpc.Server, impl *ArduinoCoreServerImpl, versions []string) error {
+	for _, version := range versions {
+		if version == CurrentAPIVersion {
+			continue
+		}
+		adapter, ok := apiCompatAdapters[version]
+		if !ok {
+			return &arduino.InvalidArgumentError{Message: tr("Unsupported gRPC API version %[1]s, supported versions are: %[2]s", version, strings.Join(SupportedAPIVersions(), ", "))}
+		}
+		if slices.Contains(impl.APICompatVersions, version) {
+			continue
+		}
+		adapter(s, impl)
+		impl.APICompatVersions = append(impl.APICompatVersions, version)
+	}
+	return nil
+}
+
+// servedAPIVersions returns the versions of the API served by the daemon,
+// from the most recent to the oldest.
+func (s *ArduinoCoreServerImpl) servedAPIVersions() []string {
+	res := append([]string{CurrentAPIVersion}, s.APICompatVersions...)
+	sortAPIVersions(res)
+	return res
+}
+
+// NegotiateApiVersion selects the most recent version of the API supported by
+// both the client and the daemon.
+func (s *ArduinoCoreServerImpl) NegotiateApiVersion(ctx context.Context, req *rpc.NegotiateApiVersionRequest) (*rpc.NegotiateApiVersionResponse, error) {
+	served := s.servedAPIVersions()
+	for _, version := range served {
+		if slices.Contains(req.GetClientVersions(), version) {
+			return &rpc.NegotiateApiVersionResponse{Version: version, ServerVersions: served}, nil
+		}
+	}
+	err := &arduino.UnsupportedAPIVersionError{ClientVersions: req.GetClientVersions(), ServerVersions: served}
+	return nil, convertErrorToRPCStatus(err)
+}
+
+// apiVersionOf returns the version of the API from the name of its proto
+// package, for example "v1" from "cc.arduino.cli.commands.v1".
+func apiVersionOf(protoPackage string) string {
+	return protoPackage[strings.LastIndex(protoPackage, ".")+1:]
+}
+
+// sortAPIVersions sorts the versions from the most recent to the oldest.
+func sortAPIVersions(versions []string) {
+	number := func(version string) int {
+		n, _ := strconv.Atoi(strings.TrimPrefix(version, "v"))
+		return n
+	}
+	slices.SortFunc(versions, func(a, b string) int {
+		return number(b) - number(a)
+	})
+}
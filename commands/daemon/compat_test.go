@@ -0,0 +1,66 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNegotiateApiVersion(t *testing.T) {
+	require.Equal(t, "v1", CurrentAPIVersion)
+	s := &ArduinoCoreServerImpl{}
+
+	res, err := s.NegotiateApiVersion(context.Background(), &rpc.NegotiateApiVersionRequest{ClientVersions: []string{"v1", "v2"}})
+	require.NoError(t, err)
+	require.Equal(t, "v1", res.GetVersion())
+	require.Equal(t, []string{"v1"}, res.GetServerVersions())
+
+	_, err = s.NegotiateApiVersion(context.Background(), &rpc.NegotiateApiVersionRequest{ClientVersions: []string{"v2"}})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.NegotiateApiVersion(context.Background(), &rpc.NegotiateApiVersionRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRegisterAPICompat(t *testing.T) {
+	registered := 0
+	apiCompatAdapters["v0"] = func(s *grpc.Server, impl *ArduinoCoreServerImpl) { registered++ }
+	defer delete(apiCompatAdapters, "v0")
+	require.Equal(t, []string{"v1", "v0"}, SupportedAPIVersions())
+
+	s := &ArduinoCoreServerImpl{}
+	require.NoError(t, RegisterAPICompat(nil, s, []string{"v1", "v0", "v0"}))
+	require.Equal(t, 1, registered)
+	require.Equal(t, []string{"v0"}, s.APICompatVersions)
+
+	// The most recent version wins, the older one is still available
+	res, err := s.NegotiateApiVersion(context.Background(), &rpc.NegotiateApiVersionRequest{ClientVersions: []string{"v0", "v1"}})
+	require.NoError(t, err)
+	require.Equal(t, "v1", res.GetVersion())
+	require.Equal(t, []string{"v1", "v0"}, res.GetServerVersions())
+	res, err = s.NegotiateApiVersion(context.Background(), &rpc.NegotiateApiVersionRequest{ClientVersions: []string{"v0"}})
+	require.NoError(t, err)
+	require.Equal(t, "v0", res.GetVersion())
+
+	require.Error(t, RegisterAPICompat(nil, s, []string{"v9"}))
+}
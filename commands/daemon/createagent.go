@@ -0,0 +1,618 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// CreateAgentVersion is the version of the Arduino Create Agent reported by
+// the compatibility layer, the tools written for the Create Agent check it
+// to know the available features.
+const CreateAgentVersion = "1.3.5"
+
+// MaxCreateAgentUploadSize is the maximum size of the requests of the /upload
+// endpoint
+const MaxCreateAgentUploadSize = 32 * 1024 * 1024
+
+// CreateAgent emulates the local HTTP API of the Arduino Create Agent, so
+// the tools written for it can detect, program and monitor the boards
+// through the daemon. The following endpoints are implemented:
+//
+//   - GET /info returns the addresses and the version of the agent
+//   - POST /upload uploads the binary sent in the request to a board
+//   - /socket.io/ receives the commands of the agent (list, open, send,
+//     close...) and sends back the messages, only the websocket transport
+//     of socket.io v2 is supported
+//
+// The upload and the serial ports are handled by the gRPC service of the
+// daemon, reached through the given connection.
+type CreateAgent struct {
+	client         rpc.ArduinoCoreServiceClient
+	instance       *rpc.Instance
+	allowedOrigins []string
+
+	clientsMux sync.Mutex
+	clients    map[*createAgentClient]bool
+
+	monitorsMux sync.Mutex
+	monitors    map[string]*createAgentMonitor
+}
+
+// createAgentClient is a socket.io connection
+type createAgentClient struct {
+	ws      *websocket.Conn
+	sendMux sync.Mutex
+}
+
+// createAgentMonitor is a port opened with the `open` command, shared by
+// all the clients as in the Create Agent
+type createAgentMonitor struct {
+	baud       int
+	bufferType string
+	cancel     context.CancelFunc
+	sendMux    sync.Mutex
+	stream     rpc.ArduinoCoreService_MonitorClient
+}
+
+// NewCreateAgent returns the Create Agent compatibility layer for the gRPC
+// service reachable with the given connection, an instance is created for
+// the calls of the agent. The requests are accepted from the pages served by
+// localhost or by the allowed origins, which may contain wildcards (e.g.
+// `https://*.arduino.cc`).
+func NewCreateAgent(ctx context.Context, conn *grpc.ClientConn, allowedOrigins []string) (*CreateAgent, error) {
+	client := rpc.NewArduinoCoreServiceClient(conn)
+	inst, err := initInstance(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateAgent{
+		client:         client,
+		instance:       inst,
+		allowedOrigins: allowedOrigins,
+		clients:        map[*createAgentClient]bool{},
+		monitors:       map[string]*createAgentMonitor{},
+	}, nil
+}
+
+// Close closes the ports opened by the agent and destroys its instance
+func (a *CreateAgent) Close() error {
+	a.monitorsMux.Lock()
+	for _, m := range a.monitors {
+		m.cancel()
+	}
+	a.monitorsMux.Unlock()
+	_, err := a.client.Destroy(context.Background(), &rpc.DestroyRequest{Instance: a.instance})
+	return err
+}
+
+// Handler returns the HTTP handler of the agent endpoints
+func (a *CreateAgent) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/info", a.serveInfo)
+	mux.HandleFunc("/upload", a.serveUpload)
+	mux.Handle("/socket.io/", websocket.Server{Handler: a.serveSocketIO})
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if origin := req.Header.Get("Origin"); origin != "" {
+			if !a.originAllowed(origin) {
+				http.Error(w, tr("Origin %s not allowed", origin), http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+			w.Header().Set("Access-Control-Allow-Private-Network", "true")
+			if req.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		mux.ServeHTTP(w, req)
+	})
+}
+
+func (a *CreateAgent) originAllowed(origin string) bool {
+	return isLoopbackOrigin(origin) || originMatches(origin, a.allowedOrigins)
+}
+
+// serveInfo reports where the agent can be reached, in the Create Agent
+// format
+func (a *CreateAgent) serveInfo(w http.ResponseWriter, req *http.Request) {
+	arch := runtime.GOARCH
+	if arch == "amd64" {
+		arch = "x86_64"
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"http":    "http://" + req.Host,
+		"ws":      "ws://" + req.Host,
+		"os":      runtime.GOOS + ":" + arch,
+		"version": CreateAgentVersion,
+	})
+}
+
+// createAgentUpload is the request of the /upload endpoint, the binaries are
+// encoded in base64
+type createAgentUpload struct {
+	Board      string `json:"board"`
+	Port       string `json:"port"`
+	Filename   string `json:"filename"`
+	Hex        []byte `json:"hex"`
+	ExtraFiles []struct {
+		Filename string `json:"filename"`
+		Hex      []byte `json:"hex"`
+	} `json:"extrafiles"`
+	Extra struct {
+		Auth struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		} `json:"auth"`
+		Network bool `json:"network"`
+		Verbose bool `json:"verbose"`
+	} `json:"extra"`
+}
+
+// serveUpload starts the upload of the binary, its progress is sent to the
+// socket.io clients. The `commandline` of the request is ignored: the upload
+// recipe of the installed platform is used instead.
+func (a *CreateAgent) serveUpload(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, tr("Method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+	var data createAgentUpload
+	if err := json.NewDecoder(io.LimitReader(req.Body, MaxCreateAgentUploadSize)).Decode(&data); err != nil {
+		http.Error(w, tr("Invalid upload request: %v", err), http.StatusBadRequest)
+		return
+	}
+	switch {
+	case data.Board == "":
+		http.Error(w, tr("Missing board"), http.StatusBadRequest)
+		return
+	case data.Port == "":
+		http.Error(w, tr("Missing port"), http.StatusBadRequest)
+		return
+	case data.Filename == "" || len(data.Hex) == 0:
+		http.Error(w, tr("Missing binary"), http.StatusBadRequest)
+		return
+	}
+
+	uploadReq := &rpc.UploadRequest{
+		Instance:        a.instance,
+		Fqbn:            data.Board,
+		Port:            &rpc.Port{Address: data.Port, Protocol: "serial"},
+		Verbose:         data.Extra.Verbose,
+		UserFields:      map[string]string{},
+		ImportArtifacts: []*rpc.BuildArtifact{{Name: data.Filename, Data: data.Hex}},
+	}
+	if data.Extra.Network {
+		uploadReq.Port.Protocol = "network"
+	}
+	if data.Extra.Auth.Username != "" {
+		uploadReq.UserFields["username"] = data.Extra.Auth.Username
+	}
+	if data.Extra.Auth.Password != "" {
+		uploadReq.UserFields["password"] = data.Extra.Auth.Password
+	}
+	for _, extra := range data.ExtraFiles {
+		uploadReq.ImportArtifacts = append(uploadReq.ImportArtifacts, &rpc.BuildArtifact{Name: extra.Filename, Data: extra.Hex})
+	}
+	go a.upload(uploadReq)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (a *CreateAgent) upload(req *rpc.UploadRequest) {
+	// The port is released before the upload, as the Create Agent does
+	a.closePort(req.GetPort().GetAddress())
+	a.broadcast(map[string]string{"ProgrammerStatus": "Starting", "Cmd": "Upload"})
+	stream, err := a.client.Upload(context.Background(), req)
+	if err != nil {
+		a.broadcast(map[string]string{"ProgrammerStatus": "Error", "Msg": grpcErrorMessage(err)})
+		return
+	}
+	output := &createAgentOutput{agent: a}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			output.flush()
+			a.broadcast(map[string]string{"ProgrammerStatus": "Error", "Msg": grpcErrorMessage(err)})
+			return
+		}
+		output.Write(resp.GetOutStream())
+		output.Write(resp.GetErrStream())
+	}
+	output.flush()
+	a.broadcast(map[string]string{"ProgrammerStatus": "Done", "Flash": "Ok"})
+}
+
+// createAgentOutput sends the output of the upload to the clients, one line
+// per message
+type createAgentOutput struct {
+	agent *CreateAgent
+	line  []byte
+}
+
+func (o *createAgentOutput) Write(data []byte) (int, error) {
+	o.line = append(o.line, data...)
+	for {
+		i := strings.IndexAny(string(o.line), "\r\n")
+		if i < 0 {
+			return len(data), nil
+		}
+		if i > 0 {
+			o.agent.broadcast(map[string]string{"ProgrammerStatus": "Busy", "Msg": string(o.line[:i])})
+		}
+		o.line = o.line[i+1:]
+	}
+}
+
+func (o *createAgentOutput) flush() {
+	if len(o.line) > 0 {
+		o.agent.broadcast(map[string]string{"ProgrammerStatus": "Busy", "Msg": string(o.line)})
+		o.line = nil
+	}
+}
+
+// serveSocketIO handles a socket.io connection: the Engine.IO handshake is
+// sent, then the `command` events are executed and the results are sent to
+// all the clients with `message` events, as the Create Agent does.
+func (a *CreateAgent) serveSocketIO(ws *websocket.Conn) {
+	defer ws.Close()
+	ws.PayloadType = websocket.TextFrame
+	c := &createAgentClient{ws: ws}
+	sid := make([]byte, 10)
+	rand.Read(sid)
+	handshake, _ := json.Marshal(map[string]interface{}{
+		"sid":          hex.EncodeToString(sid),
+		"upgrades":     []string{},
+		"pingInterval": 25000,
+		"pingTimeout":  60000,
+	})
+	// The client is registered before the handshake is sent, this way it
+	// receives all the messages sent after the handshake
+	c.sendMux.Lock()
+	a.clientsMux.Lock()
+	a.clients[c] = true
+	a.clientsMux.Unlock()
+	err := websocket.Message.Send(ws, "0"+string(handshake))
+	if err == nil {
+		err = websocket.Message.Send(ws, "40")
+	}
+	c.sendMux.Unlock()
+	defer func() {
+		a.clientsMux.Lock()
+		delete(a.clients, c)
+		a.clientsMux.Unlock()
+	}()
+	if err != nil {
+		return
+	}
+
+	for {
+		var packet string
+		if err := websocket.Message.Receive(ws, &packet); err != nil {
+			if !errors.Is(err, io.EOF) {
+				logrus.WithError(err).Info("Create Agent connection closed")
+			}
+			return
+		}
+		switch {
+		case packet == "2" || packet == "2probe":
+			// ping
+			c.send("3" + packet[1:])
+		case packet == "41" || packet == "1":
+			// disconnect
+			return
+		case strings.HasPrefix(packet, "42"):
+			if cmd, ok := parseSocketIOCommand(packet[2:]); ok {
+				a.command(cmd)
+			}
+		}
+	}
+}
+
+// parseSocketIOCommand returns the argument of a socket.io `command` event,
+// the payload is the JSON array with the event name and its argument,
+// optionally preceded by the id of the ack.
+func parseSocketIOCommand(payload string) (string, bool) {
+	payload = strings.TrimLeft(payload, "0123456789")
+	var event []interface{}
+	if err := json.Unmarshal([]byte(payload), &event); err != nil || len(event) < 2 {
+		return "", false
+	}
+	if name, _ := event[0].(string); name != "command" {
+		return "", false
+	}
+	cmd, ok := event[1].(string)
+	return cmd, ok
+}
+
+func (c *createAgentClient) send(packet string) error {
+	c.sendMux.Lock()
+	defer c.sendMux.Unlock()
+	return websocket.Message.Send(c.ws, packet)
+}
+
+// broadcast sends the message, encoded in JSON, to all the clients
+func (a *CreateAgent) broadcast(msg interface{}) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		logrus.WithError(err).Error("Error encoding Create Agent message")
+		return
+	}
+	packet, _ := json.Marshal([]string{"message", string(data)})
+	a.clientsMux.Lock()
+	clients := make([]*createAgentClient, 0, len(a.clients))
+	for c := range a.clients {
+		clients = append(clients, c)
+	}
+	a.clientsMux.Unlock()
+	for _, c := range clients {
+		c.send("42" + string(packet))
+	}
+}
+
+// command executes a command of the Create Agent
+func (a *CreateAgent) command(cmd string) {
+	args := strings.SplitN(strings.TrimSpace(cmd), " ", 3)
+	switch strings.ToLower(args[0]) {
+	case "list":
+		a.list()
+	case "open":
+		if len(args) < 3 {
+			a.broadcast(map[string]string{"Error": "You did not specify a port and baud rate in your open cmd"})
+			return
+		}
+		parts := strings.Fields(args[2])
+		baud, err := strconv.Atoi(parts[0])
+		if err != nil {
+			a.broadcast(map[string]string{"Error": "Problem converting baud rate " + parts[0]})
+			return
+		}
+		bufferType := "default"
+		if len(parts) > 1 {
+			bufferType = parts[1]
+		}
+		a.openPort(args[1], baud, bufferType)
+	case "close":
+		if len(args) < 2 {
+			a.broadcast(map[string]string{"Error": "You did not specify a port to close"})
+			return
+		}
+		a.closePort(args[1])
+	case "send", "sendnobr", "sendraw":
+		if len(args) < 3 {
+			a.broadcast(map[string]string{"Error": "You did not specify a port and data to send"})
+			return
+		}
+		data := args[2]
+		if strings.ToLower(args[0]) == "send" {
+			data += "\n"
+		}
+		a.write(args[1], data)
+	case "hostname":
+		hostname, _ := os.Hostname()
+		a.broadcast(map[string]string{"Hostname": hostname})
+	case "version":
+		a.broadcast(map[string]string{"Version": CreateAgentVersion})
+	case "downloadtool":
+		// The tools are installed with the platforms by arduino-cli
+		a.broadcast(map[string]string{"DownloadStatus": "Success", "Msg": "Map Updated"})
+	case "log":
+		// The log is not available
+	default:
+		a.broadcast(map[string]string{"Error": "Could not understand command."})
+	}
+}
+
+// createAgentPort is a port in the Create Agent `list` message
+type createAgentPort struct {
+	Name            string
+	SerialNumber    string
+	DeviceClass     string
+	IsOpen          bool
+	IsPrimary       bool
+	Baud            int
+	BufferAlgorithm string
+	Ver             string
+	NetworkPort     bool
+	VendorID        string
+	ProductID       string
+}
+
+// list sends the serial ports and then the network ports detected by the
+// daemon
+func (a *CreateAgent) list() {
+	resp, err := a.client.BoardList(context.Background(), &rpc.BoardListRequest{Instance: a.instance})
+	if err != nil {
+		a.broadcast(map[string]string{"Error": grpcErrorMessage(err)})
+		return
+	}
+	serial := []createAgentPort{}
+	network := []createAgentPort{}
+	for _, detected := range resp.GetPorts() {
+		port := detected.GetPort()
+		props := port.GetProperties()
+		p := createAgentPort{
+			Name:         port.GetAddress(),
+			SerialNumber: props["serialNumber"],
+			Ver:          CreateAgentVersion,
+			NetworkPort:  port.GetProtocol() == "network",
+			VendorID:     props["vid"],
+			ProductID:    props["pid"],
+		}
+		a.monitorsMux.Lock()
+		if m, ok := a.monitors[port.GetAddress()]; ok {
+			p.IsOpen = true
+			p.Baud = m.baud
+			p.BufferAlgorithm = m.bufferType
+		}
+		a.monitorsMux.Unlock()
+		if p.NetworkPort {
+			network = append(network, p)
+		} else {
+			serial = append(serial, p)
+		}
+	}
+	a.broadcast(map[string]interface{}{"Ports": serial, "Network": false})
+	a.broadcast(map[string]interface{}{"Ports": network, "Network": true})
+}
+
+// findPort returns the detected port with the given address
+func (a *CreateAgent) findPort(address string) *rpc.Port {
+	resp, err := a.client.BoardList(context.Background(), &rpc.BoardListRequest{Instance: a.instance})
+	if err == nil {
+		for _, detected := range resp.GetPorts() {
+			if detected.GetPort().GetAddress() == address {
+				return detected.GetPort()
+			}
+		}
+	}
+	return &rpc.Port{Address: address, Protocol: "serial"}
+}
+
+// openPort opens the port with the Monitor call of the daemon, the data
+// received is sent to the clients until the port is closed
+func (a *CreateAgent) openPort(address string, baud int, bufferType string) {
+	openMsg := map[string]interface{}{"Cmd": "Open", "Desc": "Got register/open on port.", "Port": address, "Baud": baud, "BufferType": bufferType}
+	a.monitorsMux.Lock()
+	_, open := a.monitors[address]
+	a.monitorsMux.Unlock()
+	if open {
+		a.broadcast(openMsg)
+		return
+	}
+	openFail := func(err error) {
+		a.broadcast(map[string]interface{}{"Cmd": "OpenFail", "Desc": "Error opening port. " + grpcErrorMessage(err), "Port": address, "Baud": baud})
+	}
+
+	port := a.findPort(address)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := a.client.Monitor(ctx)
+	if err == nil {
+		err = stream.Send(&rpc.MonitorRequest{
+			Instance: a.instance,
+			Port:     port,
+			PortConfiguration: &rpc.MonitorPortConfiguration{
+				Settings: []*rpc.MonitorPortSetting{{SettingId: "baudrate", Value: strconv.Itoa(baud)}},
+			},
+		})
+	}
+	var resp *rpc.MonitorResponse
+	if err == nil {
+		// The first response tells if the port has been opened
+		resp, err = stream.Recv()
+	}
+	if err == nil && resp.GetError() != "" {
+		err = errors.New(resp.GetError())
+	}
+	if err != nil {
+		cancel()
+		openFail(err)
+		return
+	}
+
+	m := &createAgentMonitor{baud: baud, bufferType: bufferType, cancel: cancel, stream: stream}
+	a.monitorsMux.Lock()
+	a.monitors[address] = m
+	a.monitorsMux.Unlock()
+	a.broadcast(openMsg)
+
+	go func() {
+		defer a.removeMonitor(address, m)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			if data := resp.GetRxData(); len(data) > 0 {
+				a.broadcast(map[string]string{"P": address, "D": string(data)})
+			}
+			if msg := resp.GetError(); msg != "" {
+				a.broadcast(map[string]string{"Error": msg})
+				return
+			}
+		}
+	}()
+}
+
+// removeMonitor closes the monitor, if still open, and notifies the clients
+func (a *CreateAgent) removeMonitor(address string, m *createAgentMonitor) {
+	m.cancel()
+	a.monitorsMux.Lock()
+	if a.monitors[address] != m {
+		a.monitorsMux.Unlock()
+		return
+	}
+	delete(a.monitors, address)
+	a.monitorsMux.Unlock()
+	a.broadcast(map[string]interface{}{"Cmd": "Close", "Desc": "Got unregister/close on port.", "Port": address, "Baud": m.baud})
+}
+
+// closePort closes the port, if opened by the agent
+func (a *CreateAgent) closePort(address string) {
+	a.monitorsMux.Lock()
+	m, ok := a.monitors[address]
+	a.monitorsMux.Unlock()
+	if ok {
+		a.removeMonitor(address, m)
+	}
+}
+
+// write sends the data to an opened port
+func (a *CreateAgent) write(address, data string) {
+	a.monitorsMux.Lock()
+	m, ok := a.monitors[address]
+	a.monitorsMux.Unlock()
+	if !ok {
+		a.broadcast(map[string]string{"Error": "We could not find the serial port " + address + " that you were trying to write to."})
+		return
+	}
+	m.sendMux.Lock()
+	err := m.stream.Send(&rpc.MonitorRequest{TxData: []byte(data)})
+	m.sendMux.Unlock()
+	if err != nil {
+		a.broadcast(map[string]string{"Error": grpcErrorMessage(err)})
+	}
+}
+
+// grpcErrorMessage returns the message of a gRPC status error
+func grpcErrorMessage(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
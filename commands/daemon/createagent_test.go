@@ -0,0 +1,206 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeAgentCoreService answers to the calls used by the Create Agent
+type fakeAgentCoreService struct {
+	fakeCoreService
+}
+
+func (f *fakeAgentCoreService) BoardList(ctx context.Context, req *rpc.BoardListRequest) (*rpc.BoardListResponse, error) {
+	return &rpc.BoardListResponse{Ports: []*rpc.DetectedPort{
+		{Port: &rpc.Port{Address: "/dev/ttyACM0", Protocol: "serial", Properties: map[string]string{"vid": "0x2341", "pid": "0x0043"}}},
+		{Port: &rpc.Port{Address: "192.168.1.10", Protocol: "network"}},
+	}}, nil
+}
+
+func (f *fakeAgentCoreService) Monitor(stream rpc.ArduinoCoreService_MonitorServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if req.GetPort().GetProtocol() != "serial" {
+		return fmt.Errorf("unexpected protocol %s", req.GetPort().GetProtocol())
+	}
+	if err := stream.Send(&rpc.MonitorResponse{Success: true}); err != nil {
+		return err
+	}
+	return f.fakeCoreService.Monitor(stream)
+}
+
+func (f *fakeAgentCoreService) Upload(req *rpc.UploadRequest, stream rpc.ArduinoCoreService_UploadServer) error {
+	for _, artifact := range req.GetImportArtifacts() {
+		msg := fmt.Sprintf("Uploading %s (%s) to %s\n", artifact.GetName(), artifact.GetData(), req.GetPort().GetAddress())
+		if err := stream.Send(&rpc.UploadResponse{Message: &rpc.UploadResponse_OutStream{OutStream: []byte(msg)}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startCreateAgentTestServer(t *testing.T) string {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	rpc.RegisterArduinoCoreServiceServer(s, &fakeAgentCoreService{})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	agent, err := NewCreateAgent(context.Background(), conn, []string{"https://*.arduino.cc"})
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(agent.Handler())
+	t.Cleanup(httpServer.Close)
+	return httpServer.URL
+}
+
+// dialSocketIO opens a socket.io connection and returns the function to
+// receive the next message sent by the agent
+func dialSocketIO(t *testing.T, url string) (*websocket.Conn, func() map[string]interface{}) {
+	ws, err := websocket.Dial("ws"+strings.TrimPrefix(url, "http")+"/socket.io/?EIO=3&transport=websocket", "", "https://create.arduino.cc")
+	require.NoError(t, err)
+	t.Cleanup(func() { ws.Close() })
+
+	var packet string
+	require.NoError(t, websocket.Message.Receive(ws, &packet))
+	require.True(t, strings.HasPrefix(packet, `0{"pingInterval":25000`), packet)
+	require.NoError(t, websocket.Message.Receive(ws, &packet))
+	require.Equal(t, "40", packet)
+
+	return ws, func() map[string]interface{} {
+		var packet string
+		require.NoError(t, websocket.Message.Receive(ws, &packet))
+		require.True(t, strings.HasPrefix(packet, "42"), packet)
+		var event []string
+		require.NoError(t, json.Unmarshal([]byte(packet[2:]), &event))
+		require.Equal(t, "message", event[0])
+		var msg map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(event[1]), &msg))
+		return msg
+	}
+}
+
+func TestCreateAgentInfo(t *testing.T) {
+	url := startCreateAgentTestServer(t)
+	resp, err := http.Get(url + "/info")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var info map[string]string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&info))
+	require.Equal(t, CreateAgentVersion, info["version"])
+	require.Equal(t, url, info["http"])
+	require.Equal(t, "ws"+strings.TrimPrefix(url, "http"), info["ws"])
+
+	// The pages of other websites can't use the agent
+	req, _ := http.NewRequest(http.MethodGet, url+"/info", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	req.Header.Set("Origin", "https://create.arduino.cc")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "https://create.arduino.cc", resp.Header.Get("Access-Control-Allow-Origin"))
+}
+
+func TestCreateAgentSocketIO(t *testing.T) {
+	url := startCreateAgentTestServer(t)
+	ws, receive := dialSocketIO(t, url)
+	command := func(cmd string) {
+		packet, _ := json.Marshal([]string{"command", cmd})
+		require.NoError(t, websocket.Message.Send(ws, "42"+string(packet)))
+	}
+
+	require.NoError(t, websocket.Message.Send(ws, "2"))
+	var pong string
+	require.NoError(t, websocket.Message.Receive(ws, &pong))
+	require.Equal(t, "3", pong)
+
+	command("list")
+	msg := receive()
+	require.Equal(t, false, msg["Network"])
+	require.Len(t, msg["Ports"], 1)
+	port := msg["Ports"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "/dev/ttyACM0", port["Name"])
+	require.Equal(t, "0x2341", port["VendorID"])
+	require.Equal(t, false, port["IsOpen"])
+	msg = receive()
+	require.Equal(t, true, msg["Network"])
+	require.Equal(t, "192.168.1.10", msg["Ports"].([]interface{})[0].(map[string]interface{})["Name"])
+
+	command("open /dev/ttyACM0 9600")
+	msg = receive()
+	require.Equal(t, "Open", msg["Cmd"])
+	require.Equal(t, float64(9600), msg["Baud"])
+
+	command("send /dev/ttyACM0 hello")
+	require.Equal(t, map[string]interface{}{"P": "/dev/ttyACM0", "D": "hello\n"}, receive())
+
+	command("close /dev/ttyACM0")
+	require.Equal(t, "Close", receive()["Cmd"])
+
+	command("send /dev/ttyACM0 hello")
+	require.Contains(t, receive()["Error"], "could not find the serial port")
+
+	command("unknown")
+	require.Equal(t, "Could not understand command.", receive()["Error"])
+}
+
+func TestCreateAgentUpload(t *testing.T) {
+	url := startCreateAgentTestServer(t)
+	_, receive := dialSocketIO(t, url)
+
+	resp, err := http.Post(url+"/upload", "application/json", strings.NewReader(`{"board":"arduino:avr:uno"}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	body := `{"board":"arduino:avr:uno","port":"/dev/ttyACM0","filename":"Blink.ino.hex","hex":"OjAwMDAwMDAxRkY=","commandline":"ignored"}`
+	resp, err = http.Post(url+"/upload", "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	require.Equal(t, "Starting", receive()["ProgrammerStatus"])
+	msg := receive()
+	require.Equal(t, "Busy", msg["ProgrammerStatus"])
+	require.Equal(t, "Uploading Blink.ino.hex (:00000001FF) to /dev/ttyACM0", msg["Msg"])
+	require.Equal(t, map[string]interface{}{"ProgrammerStatus": "Done", "Flash": "Ok"}, receive())
+}
@@ -26,9 +26,11 @@ import (
 	"github.com/arduino/arduino-cli/commands/board"
 	"github.com/arduino/arduino-cli/commands/compile"
 	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/fsimage"
 	"github.com/arduino/arduino-cli/commands/lib"
 	"github.com/arduino/arduino-cli/commands/monitor"
 	"github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/commands/upgrade"
 	"github.com/arduino/arduino-cli/commands/upload"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -42,6 +44,9 @@ type ArduinoCoreServerImpl struct {
 	rpc.UnsafeArduinoCoreServiceServer
 
 	VersionString string
+	// APICompatVersions are the previous versions of the gRPC API served
+	// through a compatibility adapter, see RegisterAPICompat.
+	APICompatVersions []string
 }
 
 var tr = i18n.Tr
@@ -56,12 +61,30 @@ func convertErrorToRPCStatus(err error) error {
 	return err
 }
 
+// BoardSymbols returns the constants of a board for the completions
+func (s *ArduinoCoreServerImpl) BoardSymbols(ctx context.Context, req *rpc.BoardSymbolsRequest) (*rpc.BoardSymbolsResponse, error) {
+	resp, err := board.Symbols(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // BoardDetails FIXMEDOC
 func (s *ArduinoCoreServerImpl) BoardDetails(ctx context.Context, req *rpc.BoardDetailsRequest) (*rpc.BoardDetailsResponse, error) {
 	resp, err := board.Details(ctx, req)
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// BoardOptions lists the custom configuration options of a board
+func (s *ArduinoCoreServerImpl) BoardOptions(ctx context.Context, req *rpc.BoardOptionsRequest) (*rpc.BoardOptionsResponse, error) {
+	resp, err := board.Options(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// BoardDefine adds a custom board to a platform
+func (s *ArduinoCoreServerImpl) BoardDefine(ctx context.Context, req *rpc.BoardDefineRequest) (*rpc.BoardDefineResponse, error) {
+	resp, err := board.Define(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // BoardList FIXMEDOC
 func (s *ArduinoCoreServerImpl) BoardList(ctx context.Context, req *rpc.BoardListRequest) (*rpc.BoardListResponse, error) {
 	ports, _, err := board.List(req)
@@ -151,7 +174,7 @@ func (s *ArduinoCoreServerImpl) Create(ctx context.Context, req *rpc.CreateReque
 // Init FIXMEDOC
 func (s *ArduinoCoreServerImpl) Init(req *rpc.InitRequest, stream rpc.ArduinoCoreService_InitServer) error {
 	syncSend := NewSynchronizedSend(stream.Send)
-	err := commands.Init(req, func(message *rpc.InitResponse) { syncSend.Send(message) })
+	err := commands.Init(stream.Context(), req, func(message *rpc.InitResponse) { syncSend.Send(message) })
 	return convertErrorToRPCStatus(err)
 }
 
@@ -160,6 +183,12 @@ func (s *ArduinoCoreServerImpl) Version(ctx context.Context, req *rpc.VersionReq
 	return &rpc.VersionResponse{Version: s.VersionString}, nil
 }
 
+// EnvironmentReport builds the inventory of the installed platforms, tools and libraries
+func (s *ArduinoCoreServerImpl) EnvironmentReport(ctx context.Context, req *rpc.EnvironmentReportRequest) (*rpc.EnvironmentReportResponse, error) {
+	resp, err := commands.EnvironmentReport(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // NewSketch FIXMEDOC
 func (s *ArduinoCoreServerImpl) NewSketch(ctx context.Context, req *rpc.NewSketchRequest) (*rpc.NewSketchResponse, error) {
 	resp, err := sketch.NewSketch(ctx, req)
@@ -178,8 +207,29 @@ func (s *ArduinoCoreServerImpl) SetSketchDefaults(ctx context.Context, req *rpc.
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// ImportPlatformIOProject imports a PlatformIO project in the sketch project file
+func (s *ArduinoCoreServerImpl) ImportPlatformIOProject(ctx context.Context, req *rpc.ImportPlatformIOProjectRequest) (*rpc.ImportPlatformIOProjectResponse, error) {
+	resp, err := sketch.ImportPlatformIOProject(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// MoveSketch renames or moves a sketch
+func (s *ArduinoCoreServerImpl) MoveSketch(ctx context.Context, req *rpc.MoveSketchRequest) (*rpc.MoveSketchResponse, error) {
+	resp, err := sketch.MoveSketch(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// ConvertSketchToCpp converts the .ino files of a sketch to a C++ source file
+func (s *ArduinoCoreServerImpl) ConvertSketchToCpp(ctx context.Context, req *rpc.ConvertSketchToCppRequest) (*rpc.ConvertSketchToCppResponse, error) {
+	resp, err := sketch.ConvertSketchToCpp(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // Compile FIXMEDOC
 func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileRequest, stream rpc.ArduinoCoreService_CompileServer) error {
+	if len(req.GetSketchArchive()) > 0 {
+		return convertErrorToRPCStatus(compileArchive(req, stream))
+	}
 	syncSend := NewSynchronizedSend(stream.Send)
 	outStream := feedStreamTo(func(data []byte) { syncSend.Send(&rpc.CompileResponse{OutStream: data}) })
 	errStream := feedStreamTo(func(data []byte) { syncSend.Send(&rpc.CompileResponse{ErrStream: data}) })
@@ -198,6 +248,32 @@ func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileRequest, stream rpc.Ardu
 	return compileRespSendErr
 }
 
+// PrecompileCore builds and caches the core archives of the given boards
+func (s *ArduinoCoreServerImpl) PrecompileCore(req *rpc.PrecompileCoreRequest, stream rpc.ArduinoCoreService_PrecompileCoreServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.PrecompileCoreResponse{
+			Message: &rpc.PrecompileCoreResponse_OutStream{OutStream: data},
+		})
+	})
+	errStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.PrecompileCoreResponse{
+			Message: &rpc.PrecompileCoreResponse_ErrStream{ErrStream: data},
+		})
+	})
+	res, err := compile.PrecompileCore(stream.Context(), req, outStream, errStream)
+	outStream.Close()
+	errStream.Close()
+	if res != nil {
+		syncSend.Send(&rpc.PrecompileCoreResponse{
+			Message: &rpc.PrecompileCoreResponse_Result{
+				Result: res,
+			},
+		})
+	}
+	return convertErrorToRPCStatus(err)
+}
+
 // PlatformInstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformInstall(req *rpc.PlatformInstallRequest, stream rpc.ArduinoCoreService_PlatformInstallServer) error {
 	syncSend := NewSynchronizedSend(stream.Send)
@@ -252,12 +328,35 @@ func (s *ArduinoCoreServerImpl) PlatformUpgrade(req *rpc.PlatformUpgradeRequest,
 	return convertErrorToRPCStatus(err)
 }
 
+// UpgradePlan computes the upgrade of the installed platforms and libraries
+func (s *ArduinoCoreServerImpl) UpgradePlan(ctx context.Context, req *rpc.UpgradePlanRequest) (*rpc.UpgradePlanResponse, error) {
+	resp, err := upgrade.UpgradePlan(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// UpgradeApply applies an upgrade plan
+func (s *ArduinoCoreServerImpl) UpgradeApply(req *rpc.UpgradeApplyRequest, stream rpc.ArduinoCoreService_UpgradeApplyServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	err := upgrade.UpgradeApply(
+		stream.Context(), req,
+		func(p *rpc.DownloadProgress) { syncSend.Send(&rpc.UpgradeApplyResponse{Progress: p}) },
+		func(p *rpc.TaskProgress) { syncSend.Send(&rpc.UpgradeApplyResponse{TaskProgress: p}) },
+	)
+	return convertErrorToRPCStatus(err)
+}
+
 // PlatformSearch FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformSearch(ctx context.Context, req *rpc.PlatformSearchRequest) (*rpc.PlatformSearchResponse, error) {
 	resp, err := core.PlatformSearch(req)
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// PlatformPreload loads the platforms whose loading has been deferred
+func (s *ArduinoCoreServerImpl) PlatformPreload(ctx context.Context, req *rpc.PlatformPreloadRequest) (*rpc.PlatformPreloadResponse, error) {
+	resp, err := core.PlatformPreload(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // Upload FIXMEDOC
 func (s *ArduinoCoreServerImpl) Upload(req *rpc.UploadRequest, stream rpc.ArduinoCoreService_UploadServer) error {
 	syncSend := NewSynchronizedSend(stream.Send)
@@ -271,7 +370,11 @@ func (s *ArduinoCoreServerImpl) Upload(req *rpc.UploadRequest, stream rpc.Arduin
 			Message: &rpc.UploadResponse_ErrStream{ErrStream: data},
 		})
 	})
-	res, err := upload.Upload(stream.Context(), req, outStream, errStream)
+	res, err := upload.Upload(stream.Context(), req, outStream, errStream, func(p *rpc.TaskProgress) {
+		syncSend.Send(&rpc.UploadResponse{
+			Message: &rpc.UploadResponse_Progress{Progress: p},
+		})
+	})
 	outStream.Close()
 	errStream.Close()
 	if res != nil {
@@ -289,7 +392,9 @@ func (s *ArduinoCoreServerImpl) UploadUsingProgrammer(req *rpc.UploadUsingProgra
 	syncSend := NewSynchronizedSend(stream.Send)
 	outStream := feedStreamTo(func(data []byte) { syncSend.Send(&rpc.UploadUsingProgrammerResponse{OutStream: data}) })
 	errStream := feedStreamTo(func(data []byte) { syncSend.Send(&rpc.UploadUsingProgrammerResponse{ErrStream: data}) })
-	err := upload.UsingProgrammer(stream.Context(), req, outStream, errStream)
+	err := upload.UsingProgrammer(stream.Context(), req, outStream, errStream, func(p *rpc.TaskProgress) {
+		syncSend.Send(&rpc.UploadUsingProgrammerResponse{Progress: p})
+	})
 	outStream.Close()
 	errStream.Close()
 	if err != nil {
@@ -298,6 +403,143 @@ func (s *ArduinoCoreServerImpl) UploadUsingProgrammer(req *rpc.UploadUsingProgra
 	return nil
 }
 
+// BoardEEPROMRead reads the EEPROM of a board
+func (s *ArduinoCoreServerImpl) BoardEEPROMRead(req *rpc.BoardEEPROMReadRequest, stream rpc.ArduinoCoreService_BoardEEPROMReadServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.BoardEEPROMReadResponse{
+			Message: &rpc.BoardEEPROMReadResponse_OutStream{OutStream: data},
+		})
+	})
+	errStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.BoardEEPROMReadResponse{
+			Message: &rpc.BoardEEPROMReadResponse_ErrStream{ErrStream: data},
+		})
+	})
+	res, err := upload.EEPROMRead(stream.Context(), req, outStream, errStream)
+	outStream.Close()
+	errStream.Close()
+	if res != nil {
+		syncSend.Send(&rpc.BoardEEPROMReadResponse{
+			Message: &rpc.BoardEEPROMReadResponse_Result{
+				Result: res,
+			},
+		})
+	}
+	return convertErrorToRPCStatus(err)
+}
+
+// BoardEEPROMWrite writes the EEPROM of a board
+func (s *ArduinoCoreServerImpl) BoardEEPROMWrite(req *rpc.BoardEEPROMWriteRequest, stream rpc.ArduinoCoreService_BoardEEPROMWriteServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.BoardEEPROMWriteResponse{
+			Message: &rpc.BoardEEPROMWriteResponse_OutStream{OutStream: data},
+		})
+	})
+	errStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.BoardEEPROMWriteResponse{
+			Message: &rpc.BoardEEPROMWriteResponse_ErrStream{ErrStream: data},
+		})
+	})
+	res, err := upload.EEPROMWrite(stream.Context(), req, outStream, errStream)
+	outStream.Close()
+	errStream.Close()
+	if res != nil {
+		syncSend.Send(&rpc.BoardEEPROMWriteResponse{
+			Message: &rpc.BoardEEPROMWriteResponse_Result{
+				Result: res,
+			},
+		})
+	}
+	return convertErrorToRPCStatus(err)
+}
+
+// BoardNVSRead reads and parses the NVS partition of a board
+func (s *ArduinoCoreServerImpl) BoardNVSRead(req *rpc.BoardNVSReadRequest, stream rpc.ArduinoCoreService_BoardNVSReadServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.BoardNVSReadResponse{
+			Message: &rpc.BoardNVSReadResponse_OutStream{OutStream: data},
+		})
+	})
+	errStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.BoardNVSReadResponse{
+			Message: &rpc.BoardNVSReadResponse_ErrStream{ErrStream: data},
+		})
+	})
+	res, err := upload.NVSRead(stream.Context(), req, outStream, errStream)
+	outStream.Close()
+	errStream.Close()
+	if res != nil {
+		syncSend.Send(&rpc.BoardNVSReadResponse{
+			Message: &rpc.BoardNVSReadResponse_Result{
+				Result: res,
+			},
+		})
+	}
+	return convertErrorToRPCStatus(err)
+}
+
+// FilesystemImageBuild builds the filesystem image of the data folder of a sketch
+func (s *ArduinoCoreServerImpl) FilesystemImageBuild(req *rpc.FilesystemImageBuildRequest, stream rpc.ArduinoCoreService_FilesystemImageBuildServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.FilesystemImageBuildResponse{
+			Message: &rpc.FilesystemImageBuildResponse_OutStream{OutStream: data},
+		})
+	})
+	errStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.FilesystemImageBuildResponse{
+			Message: &rpc.FilesystemImageBuildResponse_ErrStream{ErrStream: data},
+		})
+	})
+	res, err := fsimage.Build(stream.Context(), req, outStream, errStream)
+	outStream.Close()
+	errStream.Close()
+	if res != nil {
+		syncSend.Send(&rpc.FilesystemImageBuildResponse{
+			Message: &rpc.FilesystemImageBuildResponse_Result{
+				Result: res,
+			},
+		})
+	}
+	return convertErrorToRPCStatus(err)
+}
+
+// FilesystemImageUpload uploads the filesystem image of the data folder of a sketch
+func (s *ArduinoCoreServerImpl) FilesystemImageUpload(req *rpc.FilesystemImageUploadRequest, stream rpc.ArduinoCoreService_FilesystemImageUploadServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.FilesystemImageUploadResponse{
+			Message: &rpc.FilesystemImageUploadResponse_OutStream{OutStream: data},
+		})
+	})
+	errStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.FilesystemImageUploadResponse{
+			Message: &rpc.FilesystemImageUploadResponse_ErrStream{ErrStream: data},
+		})
+	})
+	res, err := upload.FilesystemImageUpload(stream.Context(), req, outStream, errStream)
+	outStream.Close()
+	errStream.Close()
+	if res != nil {
+		syncSend.Send(&rpc.FilesystemImageUploadResponse{
+			Message: &rpc.FilesystemImageUploadResponse_Result{
+				Result: res,
+			},
+		})
+	}
+	return convertErrorToRPCStatus(err)
+}
+
+// GetCompilerFragment returns the compiler command of the sketch for the
+// language servers
+func (s *ArduinoCoreServerImpl) GetCompilerFragment(ctx context.Context, req *rpc.GetCompilerFragmentRequest) (*rpc.GetCompilerFragmentResponse, error) {
+	res, err := compile.GetCompilerFragment(ctx, req)
+	return res, convertErrorToRPCStatus(err)
+}
+
 // SupportedUserFields FIXMEDOC
 func (s *ArduinoCoreServerImpl) SupportedUserFields(ctx context.Context, req *rpc.SupportedUserFieldsRequest) (*rpc.SupportedUserFieldsResponse, error) {
 	res, err := upload.SupportedUserFields(ctx, req)
@@ -371,7 +613,7 @@ func (s *ArduinoCoreServerImpl) LibraryUninstall(req *rpc.LibraryUninstallReques
 // LibraryUpgradeAll FIXMEDOC
 func (s *ArduinoCoreServerImpl) LibraryUpgradeAll(req *rpc.LibraryUpgradeAllRequest, stream rpc.ArduinoCoreService_LibraryUpgradeAllServer) error {
 	syncSend := NewSynchronizedSend(stream.Send)
-	err := lib.LibraryUpgradeAll(req,
+	err := lib.LibraryUpgradeAll(stream.Context(), req,
 		func(p *rpc.DownloadProgress) { syncSend.Send(&rpc.LibraryUpgradeAllResponse{Progress: p}) },
 		func(p *rpc.TaskProgress) { syncSend.Send(&rpc.LibraryUpgradeAllResponse{TaskProgress: p}) },
 	)
@@ -396,6 +638,98 @@ func (s *ArduinoCoreServerImpl) LibraryList(ctx context.Context, req *rpc.Librar
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// LibraryUsage returns the usage of the installed libraries by the compilations
+func (s *ArduinoCoreServerImpl) LibraryUsage(ctx context.Context, req *rpc.LibraryUsageRequest) (*rpc.LibraryUsageResponse, error) {
+	resp, err := lib.LibraryUsage(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// LibraryListConflicts lists the headers provided by more than one library
+func (s *ArduinoCoreServerImpl) LibraryListConflicts(ctx context.Context, req *rpc.LibraryListConflictsRequest) (*rpc.LibraryListConflictsResponse, error) {
+	resp, err := lib.LibraryListConflicts(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// LibraryQuery searches the libraries in the libraries index and the installed ones
+func (s *ArduinoCoreServerImpl) LibraryQuery(ctx context.Context, req *rpc.LibraryQueryRequest) (*rpc.LibraryQueryResponse, error) {
+	resp, err := lib.LibraryQuery(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// LibraryResolveSymbol finds the libraries declaring a symbol
+func (s *ArduinoCoreServerImpl) LibraryResolveSymbol(ctx context.Context, req *rpc.LibraryResolveSymbolRequest) (*rpc.LibraryResolveSymbolResponse, error) {
+	resp, err := lib.LibraryResolveSymbol(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// LibraryPrecompile compiles an installed library into static archives
+func (s *ArduinoCoreServerImpl) LibraryPrecompile(req *rpc.LibraryPrecompileRequest, stream rpc.ArduinoCoreService_LibraryPrecompileServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.LibraryPrecompileResponse{
+			Message: &rpc.LibraryPrecompileResponse_OutStream{OutStream: data},
+		})
+	})
+	errStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.LibraryPrecompileResponse{
+			Message: &rpc.LibraryPrecompileResponse_ErrStream{ErrStream: data},
+		})
+	})
+	res, err := lib.LibraryPrecompile(stream.Context(), req, outStream, errStream)
+	outStream.Close()
+	errStream.Close()
+	if res != nil {
+		syncSend.Send(&rpc.LibraryPrecompileResponse{
+			Message: &rpc.LibraryPrecompileResponse_Result{
+				Result: res,
+			},
+		})
+	}
+	return convertErrorToRPCStatus(err)
+}
+
+// LibraryCheckExamples compiles every example of a library for a set of boards
+func (s *ArduinoCoreServerImpl) LibraryCheckExamples(req *rpc.LibraryCheckExamplesRequest, stream rpc.ArduinoCoreService_LibraryCheckExamplesServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.LibraryCheckExamplesResponse{
+			Message: &rpc.LibraryCheckExamplesResponse_OutStream{OutStream: data},
+		})
+	})
+	errStream := feedStreamTo(func(data []byte) {
+		syncSend.Send(&rpc.LibraryCheckExamplesResponse{
+			Message: &rpc.LibraryCheckExamplesResponse_ErrStream{ErrStream: data},
+		})
+	})
+	res, err := lib.LibraryCheckExamples(stream.Context(), req, outStream, errStream, func(check *rpc.LibraryExampleCheck) {
+		syncSend.Send(&rpc.LibraryCheckExamplesResponse{
+			Message: &rpc.LibraryCheckExamplesResponse_Check{Check: check},
+		})
+	})
+	outStream.Close()
+	errStream.Close()
+	if res != nil {
+		syncSend.Send(&rpc.LibraryCheckExamplesResponse{
+			Message: &rpc.LibraryCheckExamplesResponse_Result{
+				Result: res,
+			},
+		})
+	}
+	return convertErrorToRPCStatus(err)
+}
+
+// LibraryPropertiesGet reads the fields of the library.properties of a library folder
+func (s *ArduinoCoreServerImpl) LibraryPropertiesGet(ctx context.Context, req *rpc.LibraryPropertiesGetRequest) (*rpc.LibraryPropertiesGetResponse, error) {
+	resp, err := lib.LibraryPropertiesGet(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// LibraryPropertiesSet changes the fields of the library.properties of a library folder
+func (s *ArduinoCoreServerImpl) LibraryPropertiesSet(ctx context.Context, req *rpc.LibraryPropertiesSetRequest) (*rpc.LibraryPropertiesSetResponse, error) {
+	resp, err := lib.LibraryPropertiesSet(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // ArchiveSketch FIXMEDOC
 func (s *ArduinoCoreServerImpl) ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchRequest) (*rpc.ArchiveSketchResponse, error) {
 	resp, err := sketch.ArchiveSketch(ctx, req)
@@ -483,11 +817,21 @@ func (s *ArduinoCoreServerImpl) Monitor(stream rpc.ArduinoCoreService_MonitorSer
 	go func() {
 		defer cancel()
 		buff := make([]byte, 4096)
+		totalDropped := uint64(0)
 		for {
 			n, err := portProxy.Read(buff)
 			if errors.Is(err, io.EOF) {
 				break
 			}
+			var overrun *monitor.OverrunError
+			if errors.As(err, &overrun) {
+				totalDropped += uint64(overrun.Dropped)
+				syncSend.Send(&rpc.MonitorResponse{Overrun: &rpc.MonitorOverrun{
+					DroppedBytes:      uint64(overrun.Dropped),
+					TotalDroppedBytes: totalDropped,
+				}})
+				continue
+			}
 			if err != nil {
 				syncSend.Send(&rpc.MonitorResponse{Error: err.Error()})
 				break
@@ -0,0 +1,221 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands/monitor"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// PortIO opens a raw connection to a port. The first message of the stream
+// must contain the PortIOOpenRequest, the next ones the data to write and
+// the updates of the receive window.
+func (s *ArduinoCoreServerImpl) PortIO(stream rpc.ArduinoCoreService_PortIOServer) error {
+	syncSend := NewSynchronizedSend(stream.Send)
+
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	openReq := msg.GetOpenRequest()
+	if openReq == nil {
+		return convertErrorToRPCStatus(&arduino.InvalidArgumentError{Message: tr("First message must contain the open request")})
+	}
+
+	portProxy, _, err := monitor.Monitor(stream.Context(), &rpc.MonitorRequest{
+		Instance:          openReq.GetInstance(),
+		Port:              openReq.GetPort(),
+		Fqbn:              openReq.GetFqbn(),
+		PortConfiguration: openReq.GetPortConfiguration(),
+	})
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	defer portProxy.Close()
+
+	window := newRxWindow(openReq.GetRxWindow())
+	defer window.close()
+
+	if err := syncSend.Send(&rpc.PortIOResponse{Message: &rpc.PortIOResponse_Opened{Opened: true}}); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	result := make(chan error, 2)
+
+	// Data received from the port
+	go func() {
+		buff := make([]byte, 4096)
+		totalDropped := uint64(0)
+		for {
+			size, ok := window.take(len(buff))
+			if !ok {
+				result <- nil
+				return
+			}
+			n, err := portProxy.Read(buff[:size])
+			window.giveBack(size - n)
+			if errors.Is(err, io.EOF) {
+				result <- nil
+				return
+			}
+			var overrun *monitor.OverrunError
+			if errors.As(err, &overrun) {
+				totalDropped += uint64(overrun.Dropped)
+				overrunMsg := &rpc.MonitorOverrun{DroppedBytes: uint64(overrun.Dropped), TotalDroppedBytes: totalDropped}
+				if err := syncSend.Send(&rpc.PortIOResponse{Message: &rpc.PortIOResponse_Overrun{Overrun: overrunMsg}}); err != nil {
+					result <- err
+					return
+				}
+				continue
+			}
+			if err != nil {
+				result <- err
+				return
+			}
+			if n == 0 {
+				continue
+			}
+			data := make([]byte, n)
+			copy(data, buff[:n])
+			if err := syncSend.Send(&rpc.PortIOResponse{Message: &rpc.PortIOResponse_RxData{RxData: data}}); err != nil {
+				result <- err
+				return
+			}
+		}
+	}()
+
+	// Requests of the client
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				result <- nil
+				return
+			}
+			if err != nil {
+				result <- err
+				return
+			}
+			switch {
+			case msg.GetOpenRequest() != nil:
+				result <- convertErrorToRPCStatus(&arduino.InvalidArgumentError{Message: tr("The port is already open")})
+				return
+			case msg.GetRxWindowUpdate() > 0:
+				window.extend(msg.GetRxWindowUpdate())
+			case msg.GetPortConfiguration() != nil:
+				for _, setting := range msg.GetPortConfiguration().GetSettings() {
+					if err := portProxy.Config(setting.GetSettingId(), setting.GetValue()); err != nil {
+						result <- convertErrorToRPCStatus(&arduino.InvalidArgumentError{Message: tr("Error setting %s", setting.GetSettingId()), Cause: err})
+						return
+					}
+				}
+				if err := syncSend.Send(&rpc.PortIOResponse{Message: &rpc.PortIOResponse_AppliedConfiguration{AppliedConfiguration: msg.GetPortConfiguration()}}); err != nil {
+					result <- err
+					return
+				}
+			default:
+				tx := msg.GetTxData()
+				written := len(tx)
+				for len(tx) > 0 {
+					n, err := portProxy.Write(tx)
+					if err != nil {
+						result <- err
+						return
+					}
+					tx = tx[n:]
+				}
+				if err := syncSend.Send(&rpc.PortIOResponse{Message: &rpc.PortIOResponse_TxWritten{TxWritten: uint32(written)}}); err != nil {
+					result <- err
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// rxWindow is the number of bytes that the client of PortIO is ready to
+// receive, the data is read from the port only when the window is open.
+type rxWindow struct {
+	lock      sync.Mutex
+	cond      *sync.Cond
+	size      int
+	unlimited bool
+	closed    bool
+}
+
+func newRxWindow(size uint32) *rxWindow {
+	w := &rxWindow{size: int(size), unlimited: size == 0}
+	w.cond = sync.NewCond(&w.lock)
+	return w
+}
+
+// take waits for the window to be open and reserves up to max bytes of it,
+// it returns false if the window has been closed.
+func (w *rxWindow) take(max int) (int, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.unlimited {
+		return max, !w.closed
+	}
+	for w.size == 0 && !w.closed {
+		w.cond.Wait()
+	}
+	if w.closed {
+		return 0, false
+	}
+	n := min(w.size, max)
+	w.size -= n
+	return n, true
+}
+
+// giveBack returns the reserved bytes that have not been used
+func (w *rxWindow) giveBack(n int) {
+	w.extend(uint32(n))
+}
+
+func (w *rxWindow) extend(n uint32) {
+	if n == 0 {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if !w.unlimited {
+		w.size += int(n)
+	}
+	w.cond.Broadcast()
+}
+
+func (w *rxWindow) close() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.closed = true
+	w.cond.Broadcast()
+}
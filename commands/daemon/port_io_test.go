@@ -0,0 +1,66 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRxWindow(t *testing.T) {
+	w := newRxWindow(10)
+	n, ok := w.take(4096)
+	require.True(t, ok)
+	require.Equal(t, 10, n)
+	w.giveBack(4)
+
+	n, ok = w.take(2)
+	require.True(t, ok)
+	require.Equal(t, 2, n)
+	n, ok = w.take(4096)
+	require.True(t, ok)
+	require.Equal(t, 2, n)
+
+	// The window is exhausted until it's extended
+	taken := make(chan int)
+	go func() {
+		n, _ := w.take(4096)
+		taken <- n
+	}()
+	select {
+	case <-taken:
+		require.FailNow(t, "data taken from an exhausted window")
+	case <-time.After(50 * time.Millisecond):
+	}
+	w.extend(100)
+	require.Equal(t, 100, <-taken)
+
+	go func() {
+		_, ok := w.take(4096)
+		require.False(t, ok)
+		close(taken)
+	}()
+	w.close()
+	<-taken
+
+	// A zero window disables the flow control
+	w = newRxWindow(0)
+	n, ok = w.take(4096)
+	require.True(t, ok)
+	require.Equal(t, 4096, n)
+}
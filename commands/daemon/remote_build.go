@@ -0,0 +1,150 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/compile"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/codeclysm/extract/v3"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// compileArchive builds the sketch sent in the sketch_archive of the request:
+// the sketch is extracted in a temporary folder and built with a temporary
+// instance, initialized with the requested profile. The artifacts are sent
+// back before the final response.
+func compileArchive(req *rpc.CompileRequest, stream rpc.ArduinoCoreService_CompileServer) error {
+	ctx := stream.Context()
+	tmp, err := paths.MkTempDir("", "arduino-remote-build")
+	if err != nil {
+		return &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+
+	sketchPath, err := extractSketchArchive(ctx, req.GetSketchArchive(), tmp.Join("sketch"))
+	if err != nil {
+		return &arduino.InvalidArgumentError{Message: tr("Invalid sketch archive"), Cause: err}
+	}
+
+	inst, profile, err := initBuildInstance(ctx, sketchPath, req.GetProfile())
+	if err != nil {
+		return err
+	}
+	defer commands.Destroy(context.Background(), &rpc.DestroyRequest{Instance: inst})
+
+	exportDir := tmp.Join("export")
+	buildReq := proto.Clone(req).(*rpc.CompileRequest)
+	buildReq.Instance = inst
+	buildReq.SketchPath = sketchPath.String()
+	buildReq.SketchArchive = nil
+	buildReq.ExportDir = exportDir.String()
+	if buildReq.GetFqbn() == "" {
+		buildReq.Fqbn = profile.GetFqbn()
+	}
+
+	syncSend := NewSynchronizedSend(stream.Send)
+	outStream := feedStreamTo(func(data []byte) { syncSend.Send(&rpc.CompileResponse{OutStream: data}) })
+	errStream := feedStreamTo(func(data []byte) { syncSend.Send(&rpc.CompileResponse{ErrStream: data}) })
+	compileResp, compileErr := compile.Compile(ctx, buildReq, outStream, errStream,
+		func(p *rpc.TaskProgress) { syncSend.Send(&rpc.CompileResponse{Progress: p}) })
+	outStream.Close()
+	errStream.Close()
+	if compileErr != nil {
+		if compileResp != nil {
+			syncSend.Send(compileResp)
+		}
+		return compileErr
+	}
+
+	artifacts, err := exportDir.ReadDir()
+	if err != nil && !req.GetCreateCompilationDatabaseOnly() {
+		return &arduino.PermissionDeniedError{Message: tr("Error reading build directory"), Cause: err}
+	}
+	artifacts.FilterOutDirs()
+	for _, artifact := range artifacts {
+		data, err := artifact.ReadFile()
+		if err != nil {
+			return &arduino.PermissionDeniedError{Message: tr("Error reading build artifact %s", artifact.Base()), Cause: err}
+		}
+		if err := syncSend.Send(&rpc.CompileResponse{Artifact: &rpc.BuildArtifact{Name: artifact.Base(), Data: data}}); err != nil {
+			return err
+		}
+	}
+	return syncSend.Send(compileResp)
+}
+
+// extractSketchArchive extracts the zip archive of the sketch in the given
+// folder and returns the path of the sketch. The archive may contain the
+// sketch folder or directly the sketch files.
+func extractSketchArchive(ctx context.Context, archive []byte, dir *paths.Path) (*paths.Path, error) {
+	if err := extract.Zip(ctx, bytes.NewReader(archive), dir.String(), nil); err != nil {
+		return nil, err
+	}
+	entries, err := dir.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 1 && entries[0].IsDir() {
+		return entries[0], nil
+	}
+	if len(entries) == 0 {
+		return nil, errors.New(tr("the archive is empty"))
+	}
+	return dir, nil
+}
+
+// initBuildInstance creates and initializes an instance for the build of the
+// sketch, with the platforms and libraries of the profile if set.
+func initBuildInstance(ctx context.Context, sketchPath *paths.Path, profileName string) (*rpc.Instance, *rpc.Profile, error) {
+	res, err := commands.Create(&rpc.CreateRequest{}, "remote-build")
+	if err != nil {
+		return nil, nil, err
+	}
+	var profile *rpc.Profile
+	var initErr error
+	err = commands.Init(ctx, &rpc.InitRequest{
+		Instance:   res.GetInstance(),
+		SketchPath: sketchPath.String(),
+		Profile:    profileName,
+	}, func(r *rpc.InitResponse) {
+		if p := r.GetProfile(); p != nil {
+			profile = p
+		}
+		if st := r.GetError(); st != nil {
+			logrus.Warnf("Error initializing remote build instance: %s", st.GetMessage())
+			if profileName != "" && initErr == nil {
+				initErr = fmt.Errorf("%s", st.GetMessage())
+			}
+		}
+	})
+	if err == nil {
+		err = initErr
+	}
+	if err != nil {
+		commands.Destroy(context.Background(), &rpc.DestroyRequest{Instance: res.GetInstance()})
+		return nil, nil, &arduino.InitFailedError{Cause: err}
+	}
+	return res.GetInstance(), profile, nil
+}
@@ -0,0 +1,61 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func zipFiles(t *testing.T, files ...string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range files {
+		f, err := w.Create(file)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("void setup() {}\nvoid loop() {}\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestExtractSketchArchive(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	// The archive of ArchiveSketch contains the sketch folder
+	sketchPath, err := extractSketchArchive(context.Background(), zipFiles(t, "Blink/Blink.ino", "Blink/src/utils.h"), tmp.Join("a"))
+	require.NoError(t, err)
+	require.Equal(t, tmp.Join("a", "Blink").String(), sketchPath.String())
+	require.True(t, sketchPath.Join("src", "utils.h").Exist())
+
+	// The sketch files may also be at the top level
+	sketchPath, err = extractSketchArchive(context.Background(), zipFiles(t, "b.ino", "utils.h"), tmp.Join("b"))
+	require.NoError(t, err)
+	require.Equal(t, tmp.Join("b").String(), sketchPath.String())
+
+	_, err = extractSketchArchive(context.Background(), zipFiles(t), tmp.Join("c"))
+	require.Error(t, err)
+	_, err = extractSketchArchive(context.Background(), []byte("not a zip"), tmp.Join("d"))
+	require.Error(t, err)
+}
@@ -0,0 +1,609 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// User is a user allowed to use a multi-user daemon
+type User struct {
+	Name string `yaml:"name"`
+	// TokenSHA256 is the hex encoded SHA-256 hash of the access token of the
+	// user, the token is sent by the clients in the `authorization` metadata
+	// as `Bearer <token>`
+	TokenSHA256 string `yaml:"token_sha256"`
+	// Admin users can change the settings of the daemon and use any instance
+	Admin bool `yaml:"admin"`
+	// RequestsPerMinute limits the calls made by the user, 0 means unlimited
+	RequestsPerMinute int `yaml:"requests_per_minute"`
+	// BuildQuotaMB limits the disk space used by the build outputs of the
+	// user, 0 means unlimited
+	BuildQuotaMB int64 `yaml:"build_quota_mb"`
+	// Ports are the patterns (e.g. `/dev/ttyACM*`) of the addresses of the
+	// ports that the user can see and use when the daemon runs as a remote
+	// upload agent
+	Ports []string `yaml:"ports"`
+}
+
+// Sessions authenticates the users of a multi-user daemon and confines the
+// users that are not administrators: each user can use only the instances it
+// created, builds in its own directory, within its rate limit and disk quota.
+type Sessions struct {
+	buildsDir *paths.Path
+	users     map[string]*session
+	agent     bool
+	mux       sync.Mutex
+	owners    map[int32]*session
+}
+
+type session struct {
+	user    *User
+	limiter *rateLimiter
+}
+
+// LoadSessions reads the users allowed to use the daemon from the given YAML
+// file. The build outputs of each user are kept in a subdirectory of
+// buildsDir named after the user.
+func LoadSessions(usersFile, buildsDir *paths.Path) (*Sessions, error) {
+	data, err := usersFile.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Users []*User `yaml:"users"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf(tr("invalid users file %[1]s: %[2]v"), usersFile, err)
+	}
+	if len(file.Users) == 0 {
+		return nil, fmt.Errorf(tr("no users defined in %s"), usersFile)
+	}
+
+	s := &Sessions{
+		buildsDir: buildsDir,
+		users:     map[string]*session{},
+		owners:    map[int32]*session{},
+	}
+	names := map[string]bool{}
+	for _, user := range file.Users {
+		if user.Name == "" || strings.ContainsAny(user.Name, `/\`) || user.Name == "." || user.Name == ".." {
+			return nil, fmt.Errorf(tr("invalid user name '%s'"), user.Name)
+		}
+		if names[user.Name] {
+			return nil, fmt.Errorf(tr("user %s defined more than once"), user.Name)
+		}
+		names[user.Name] = true
+		hash := strings.ToLower(user.TokenSHA256)
+		if decoded, err := hex.DecodeString(hash); err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf(tr("invalid token hash for user %s"), user.Name)
+		}
+		if _, ok := s.users[hash]; ok {
+			return nil, fmt.Errorf(tr("user %s has the same token of another user"), user.Name)
+		}
+		for _, pattern := range user.Ports {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf(tr("invalid port pattern '%[1]s' for user %[2]s"), pattern, user.Name)
+			}
+		}
+		s.users[hash] = &session{user: user, limiter: newRateLimiter(user.RequestsPerMinute)}
+	}
+	return s, nil
+}
+
+// SetAgentMode exposes the ports of the machine running the daemon to the
+// users, each user can see and use the ports matching its Ports patterns.
+// Otherwise the ports can be used only by the administrators.
+func (s *Sessions) SetAgentMode(agent bool) {
+	s.agent = agent
+}
+
+// HashToken returns the hash of the token to be written in the users file
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// UnaryInterceptor authenticates and authorizes the unary calls
+func (s *Sessions) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isPublicMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	sess, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(sess, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	if err == nil {
+		s.track(sess, req, resp)
+	}
+	if r, ok := resp.(*rpc.BoardListResponse); ok {
+		r.Ports = s.allowedPorts(sess, r.GetPorts())
+	}
+	return resp, err
+}
+
+// StreamInterceptor authenticates the streaming calls and authorizes each
+// message received
+func (s *Sessions) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isPublicMethod(info.FullMethod) {
+		return handler(srv, stream)
+	}
+	sess, err := s.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedStream{ServerStream: stream, sessions: s, session: sess, method: info.FullMethod})
+}
+
+// isPublicMethod returns true for the health checks, that can be performed
+// without authentication
+func isPublicMethod(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/")
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	sessions *Sessions
+	session  *session
+	method   string
+}
+
+func (a *authorizedStream) RecvMsg(m interface{}) error {
+	if err := a.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return a.sessions.authorize(a.session, a.method, m)
+}
+
+// SendMsg hides the events of the ports that the user can't use
+func (a *authorizedStream) SendMsg(m interface{}) error {
+	if r, ok := m.(*rpc.BoardListWatchResponse); ok && r.GetPort() != nil {
+		if !a.sessions.portAllowed(a.session, r.GetPort().GetPort()) {
+			return nil
+		}
+	}
+	return a.ServerStream.SendMsg(m)
+}
+
+// authenticate returns the session of the user owning the token sent with
+// the call, the call is counted in the rate limit of the user.
+func (s *Sessions) authenticate(ctx context.Context) (*session, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, auth := range md.Get("authorization") {
+		token, found := strings.CutPrefix(auth, "Bearer ")
+		if !found {
+			continue
+		}
+		sess, ok := s.users[HashToken(token)]
+		if !ok {
+			break
+		}
+		if !sess.limiter.Allow() {
+			return nil, status.Errorf(codes.ResourceExhausted, tr("Rate limit exceeded for user %s", sess.user.Name))
+		}
+		return sess, nil
+	}
+	return nil, status.Error(codes.Unauthenticated, tr("Missing or invalid access token"))
+}
+
+// The services of the daemon, the methods are their full names
+const (
+	coreService     = "/cc.arduino.cli.commands.v1.ArduinoCoreService/"
+	settingsService = "/cc.arduino.cli.settings.v1.SettingsService/"
+)
+
+// userMethods are the methods that can be called by the users that are not
+// administrators. The methods installing, upgrading or removing the
+// platforms and the libraries, updating the indexes or changing the files of
+// the installed platforms and libraries are left out, since they're shared
+// by all the users. Debug is left out too, since the debugger runs arbitrary
+// commands sent by the client.
+var userMethods = map[string]bool{
+	coreService + "Create":                            true,
+	coreService + "Init":                              true,
+	coreService + "Destroy":                           true,
+	coreService + "Version":                           true,
+	coreService + "NegotiateApiVersion":               true,
+	coreService + "EnvironmentReport":                 true,
+	coreService + "NewSketch":                         true,
+	coreService + "LoadSketch":                        true,
+	coreService + "ArchiveSketch":                     true,
+	coreService + "SetSketchDefaults":                 true,
+	coreService + "ImportPlatformIOProject":           true,
+	coreService + "MoveSketch":                        true,
+	coreService + "ConvertSketchToCpp":                true,
+	coreService + "BoardDetails":                      true,
+	coreService + "BoardOptions":                      true,
+	coreService + "BoardSymbols":                      true,
+	coreService + "BoardList":                         true,
+	coreService + "BoardListAll":                      true,
+	coreService + "BoardSearch":                       true,
+	coreService + "BoardListWatch":                    true,
+	coreService + "BoardEEPROMRead":                   true,
+	coreService + "BoardEEPROMWrite":                  true,
+	coreService + "BoardNVSRead":                      true,
+	coreService + "Compile":                           true,
+	coreService + "PrecompileCore":                    true,
+	coreService + "GetCompilerFragment":               true,
+	coreService + "UpgradePlan":                       true,
+	coreService + "Upload":                            true,
+	coreService + "UploadUsingProgrammer":             true,
+	coreService + "FilesystemImageBuild":              true,
+	coreService + "FilesystemImageUpload":             true,
+	coreService + "SupportedUserFields":               true,
+	coreService + "ListProgrammersAvailableForUpload": true,
+	coreService + "BurnBootloader":                    true,
+	coreService + "PlatformSearch":                    true,
+	coreService + "PlatformPreload":                   true,
+	coreService + "LibraryResolveDependencies":        true,
+	coreService + "LibrarySearch":                     true,
+	coreService + "LibraryList":                       true,
+	coreService + "LibraryUsage":                      true,
+	coreService + "LibraryListConflicts":              true,
+	coreService + "LibraryQuery":                      true,
+	coreService + "LibraryResolveSymbol":              true,
+	coreService + "LibraryCheckExamples":              true,
+	coreService + "LibraryPropertiesGet":              true,
+	coreService + "LibraryPropertiesSet":              true,
+	coreService + "Monitor":                           true,
+	coreService + "EnumerateMonitorPortSettings":      true,
+	coreService + "PortIO":                            true,
+	coreService + "GetDebugConfig":                    true,
+	settingsService + "GetAll":                        true,
+	settingsService + "GetValue":                      true,
+}
+
+// authorize checks that the request can be performed by the user: only
+// the admins can call the methods missing from userMethods, the instances
+// can be used only by the user that created them, the ports only by the
+// users allowed to and the files and the build outputs are confined in the
+// user directory, within the disk quota.
+func (s *Sessions) authorize(sess *session, method string, req interface{}) error {
+	if !sess.user.Admin && !userMethods[method] {
+		if strings.HasPrefix(method, settingsService) {
+			return status.Error(codes.PermissionDenied, tr("Only the administrators can change the settings"))
+		}
+		return status.Errorf(codes.PermissionDenied, tr("Only the administrators can call %s", method))
+	}
+
+	// The requests opening a port are wrapped in the first message
+	switch r := req.(type) {
+	case *rpc.PortIORequest:
+		if r.GetOpenRequest() != nil {
+			req = r.GetOpenRequest()
+		}
+	}
+
+	// The profiles and the build properties run the tools and install the
+	// platforms chosen by the user, from any package index
+	if !sess.user.Admin {
+		if err := checkUserRequest(req); err != nil {
+			return err
+		}
+	}
+
+	if r, ok := req.(interface{ GetInstance() *rpc.Instance }); ok && r.GetInstance() != nil && !sess.user.Admin {
+		s.mux.Lock()
+		owner := s.owners[r.GetInstance().GetId()]
+		s.mux.Unlock()
+		if owner != sess {
+			return status.Errorf(codes.PermissionDenied, tr("Instance %d belongs to another user", r.GetInstance().GetId()))
+		}
+	}
+
+	if r, ok := req.(interface{ GetPort() *rpc.Port }); ok && r.GetPort() != nil {
+		if !s.portAllowed(sess, r.GetPort()) {
+			return status.Errorf(codes.PermissionDenied, tr("Port %s can't be used by user %s", r.GetPort().GetAddress(), sess.user.Name))
+		}
+	}
+
+	userDir := s.buildsDir.Join(sess.user.Name)
+	switch r := req.(type) {
+	case *rpc.CompileRequest:
+		if r.GetBuildPath() == "" {
+			// Each sketch is built in its own directory, the archived
+			// sketches are told apart by their content
+			key := []byte(r.GetSketchPath())
+			if len(r.GetSketchArchive()) > 0 {
+				key = r.GetSketchArchive()
+			}
+			r.BuildPath = userDir.Join(fmt.Sprintf("%X", md5.Sum(key))).String()
+		}
+		if r.GetBuildCachePath() == "" && !sess.user.Admin {
+			r.BuildCachePath = userDir.Join("cache").String()
+		}
+		if quota := sess.user.BuildQuotaMB; quota > 0 {
+			if used := diskUsage(userDir); used >= quota*1024*1024 {
+				return status.Errorf(codes.ResourceExhausted, tr("Build outputs quota of %[1]d MB exceeded for user %[2]s", quota, sess.user.Name))
+			}
+		}
+	case *rpc.PrecompileCoreRequest:
+		if r.GetBuildCachePath() == "" && !sess.user.Admin {
+			r.BuildCachePath = userDir.Join("cache").String()
+		}
+	case *rpc.GetCompilerFragmentRequest:
+		if r.GetBuildPath() == "" {
+			key := r.GetSketchPath() + "\n" + r.GetFqbn() + "\n" + strings.Join(r.GetBuildProperties(), "\n")
+			r.BuildPath = userDir.Join("compiler-fragments", fmt.Sprintf("%X", md5.Sum([]byte(key)))).String()
+		}
+	case *rpc.NewSketchRequest:
+		if r.GetSketchDir() == "" && !sess.user.Admin {
+			r.SketchDir = userDir.String()
+		}
+	}
+
+	if !sess.user.Admin {
+		for _, p := range requestPaths(req) {
+			if err := checkPath(p, userDir); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkUserRequest rejects the options of the request that only the
+// administrators can use: the profiles, that install the platforms and the
+// libraries they list (from the package indexes they list), and the build
+// properties, that can override the recipes and the tools of a platform.
+func checkUserRequest(req interface{}) error {
+	switch r := req.(type) {
+	case *rpc.InitRequest:
+		if r.GetProfile() != "" {
+			return status.Error(codes.PermissionDenied, tr("Only the administrators can use a profile"))
+		}
+	case *rpc.CompileRequest:
+		// The profile is used by the builds of the sketch archives
+		if r.GetProfile() != "" {
+			return status.Error(codes.PermissionDenied, tr("Only the administrators can use a profile"))
+		}
+		if len(r.GetBuildProperties()) > 0 {
+			return status.Error(codes.PermissionDenied, tr("Only the administrators can set the build properties"))
+		}
+	case *rpc.GetCompilerFragmentRequest:
+		if len(r.GetBuildProperties()) > 0 {
+			return status.Error(codes.PermissionDenied, tr("Only the administrators can set the build properties"))
+		}
+	}
+	return nil
+}
+
+// requestPaths returns the paths of the files and the directories read or
+// written by the request. The empty paths are the ones not set, apart from
+// the library paths that default to the current directory of the daemon.
+func requestPaths(req interface{}) []string {
+	switch r := req.(type) {
+	case *rpc.InitRequest:
+		return []string{r.GetSketchPath()}
+	case *rpc.NewSketchRequest:
+		return []string{r.GetSketchDir()}
+	case *rpc.LoadSketchRequest:
+		return []string{r.GetSketchPath()}
+	case *rpc.ArchiveSketchRequest:
+		return []string{r.GetSketchPath(), r.GetArchivePath()}
+	case *rpc.SetSketchDefaultsRequest:
+		return []string{r.GetSketchPath()}
+	case *rpc.ImportPlatformIOProjectRequest:
+		return []string{r.GetSketchPath(), r.GetPlatformioIni()}
+	case *rpc.MoveSketchRequest:
+		return []string{r.GetSketchPath(), r.GetDestinationPath()}
+	case *rpc.ConvertSketchToCppRequest:
+		return []string{r.GetSketchPath()}
+	case *rpc.BoardNVSReadRequest:
+		return []string{r.GetSketchPath()}
+	case *rpc.CompileRequest:
+		res := []string{r.GetSketchPath(), r.GetBuildPath(), r.GetBuildCachePath(), r.GetExportDir(), r.GetKeysKeychain(), r.GetCompareWith()}
+		res = append(res, r.GetLibraries()...)
+		return append(res, r.GetLibrary()...)
+	case *rpc.PrecompileCoreRequest:
+		return []string{r.GetBuildCachePath()}
+	case *rpc.GetCompilerFragmentRequest:
+		return []string{r.GetSketchPath(), r.GetBuildPath()}
+	case *rpc.UpgradePlanRequest:
+		return []string{r.GetSketchPath()}
+	case *rpc.UploadRequest:
+		return []string{r.GetSketchPath(), r.GetImportFile(), r.GetImportDir()}
+	case *rpc.UploadUsingProgrammerRequest:
+		return []string{r.GetSketchPath(), r.GetImportFile(), r.GetImportDir()}
+	case *rpc.FilesystemImageBuildRequest:
+		return []string{r.GetSketchPath(), r.GetOutputPath()}
+	case *rpc.FilesystemImageUploadRequest:
+		return []string{r.GetSketchPath(), r.GetImagePath()}
+	case *rpc.GetDebugConfigRequest:
+		return []string{r.GetSketchPath(), r.GetImportDir()}
+	case *rpc.LibraryCheckExamplesRequest:
+		// The library is given by name or by path
+		if paths.New(r.GetLibrary()).IsDir() {
+			return []string{r.GetLibrary()}
+		}
+	case *rpc.LibraryPropertiesGetRequest:
+		return []string{libraryPath(r.GetLibraryPath())}
+	case *rpc.LibraryPropertiesSetRequest:
+		return []string{libraryPath(r.GetLibraryPath())}
+	}
+	return nil
+}
+
+func libraryPath(p string) string {
+	if p == "" {
+		return "."
+	}
+	return p
+}
+
+// checkPath checks that the path used by the user is inside the directory
+// of the user, only the administrators can use any path. The symlinks are
+// resolved before the check, so a link can't point outside the directory.
+func checkPath(p string, userDir *paths.Path) error {
+	if p == "" {
+		return nil
+	}
+	abs, err := resolveSymlinks(paths.New(p))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, tr("Invalid path %[1]s: %[2]v", p, err))
+	}
+	dir, err := resolveSymlinks(userDir)
+	if err != nil {
+		return status.Errorf(codes.Internal, tr("Invalid path %[1]s: %[2]v", userDir, err))
+	}
+	if inside, _ := abs.IsInsideDir(dir); !inside && !abs.EquivalentTo(dir) {
+		return status.Errorf(codes.PermissionDenied, tr("The path %[1]s must be inside %[2]s", p, userDir))
+	}
+	return nil
+}
+
+// resolveSymlinks returns the absolute path with the symlinks resolved. The
+// path may not exist yet: the symlinks of its deepest existing parent are
+// resolved and the missing part is appended. A dangling symlink is an error,
+// since it may point anywhere once its target is created.
+func resolveSymlinks(p *paths.Path) (*paths.Path, error) {
+	abs, err := p.Abs()
+	if err != nil {
+		return nil, err
+	}
+	missing := []string{}
+	for current := abs; ; current = current.Parent() {
+		resolved, err := filepath.EvalSymlinks(current.String())
+		if err == nil {
+			return paths.New(append([]string{resolved}, missing...)...), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if _, err := os.Lstat(current.String()); err == nil {
+			return nil, fmt.Errorf(tr("%s is a dangling symlink"), current)
+		}
+		if current.Parent().EqualsTo(current) {
+			return abs, nil
+		}
+		missing = append([]string{current.Base()}, missing...)
+	}
+}
+
+// portAllowed returns true if the user can use the port: the administrators
+// can use any port, the other users only the ones matching their patterns in
+// agent mode
+func (s *Sessions) portAllowed(sess *session, port *rpc.Port) bool {
+	if sess.user.Admin {
+		return true
+	}
+	if !s.agent {
+		return false
+	}
+	for _, pattern := range sess.user.Ports {
+		if match, _ := path.Match(pattern, port.GetAddress()); match {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedPorts returns the detected ports that the user can use
+func (s *Sessions) allowedPorts(sess *session, ports []*rpc.DetectedPort) []*rpc.DetectedPort {
+	res := []*rpc.DetectedPort{}
+	for _, port := range ports {
+		if s.portAllowed(sess, port.GetPort()) {
+			res = append(res, port)
+		}
+	}
+	return res
+}
+
+// track records the owner of the instances created and forgets the
+// destroyed ones
+func (s *Sessions) track(sess *session, req, resp interface{}) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	switch r := req.(type) {
+	case *rpc.CreateRequest:
+		if res, ok := resp.(*rpc.CreateResponse); ok {
+			s.owners[res.GetInstance().GetId()] = sess
+			logrus.WithField("user", sess.user.Name).Infof("Created instance %d", res.GetInstance().GetId())
+		}
+	case *rpc.DestroyRequest:
+		delete(s.owners, r.GetInstance().GetId())
+	}
+}
+
+// diskUsage returns the total size of the files in the directory
+func diskUsage(dir *paths.Path) int64 {
+	var size int64
+	_ = filepath.WalkDir(dir.String(), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info, err := d.Info(); err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// rateLimiter is a token bucket refilled continuously at the rate of the
+// given requests per minute, that can hold at most a minute of requests
+type rateLimiter struct {
+	perMinute int
+	mux       sync.Mutex
+	tokens    float64
+	last      time.Time
+}
+
+func newRateLimiter(perMinute int) *rateLimiter {
+	return &rateLimiter{perMinute: perMinute, tokens: float64(perMinute), last: time.Now()}
+}
+
+// Allow consumes a token, it returns false if none is available
+func (l *rateLimiter) Allow() bool {
+	if l.perMinute <= 0 {
+		return true
+	}
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	now := time.Now()
+	l.tokens = min(float64(l.perMinute), l.tokens+now.Sub(l.last).Minutes()*float64(l.perMinute))
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
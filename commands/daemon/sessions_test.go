@@ -0,0 +1,306 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func callUnary(s *Sessions, ctx context.Context, method string, req interface{}, resp interface{}) (interface{}, error) {
+	return s.UnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return resp, nil })
+}
+
+func TestLoadSessions(t *testing.T) {
+	_, err := LoadSessions(paths.New("testdata", "sessions", "duplicated.yaml"), paths.New("builds"))
+	require.ErrorContains(t, err, "user alice defined more than once")
+
+	_, err = LoadSessions(paths.New("testdata", "sessions", "missing.yaml"), paths.New("builds"))
+	require.Error(t, err)
+
+	s, err := LoadSessions(paths.New("testdata", "sessions", "users.yaml"), paths.New("builds"))
+	require.NoError(t, err)
+	require.Len(t, s.users, 3)
+	require.Equal(t, "alice", s.users[HashToken("alice-token")].user.Name)
+}
+
+func TestSessionsAuthentication(t *testing.T) {
+	s, err := LoadSessions(paths.New("testdata", "sessions", "users.yaml"), paths.New(t.TempDir()))
+	require.NoError(t, err)
+
+	_, err = callUnary(s, context.Background(), "/cc.arduino.cli.commands.v1.ArduinoCoreService/Version", &rpc.VersionRequest{}, nil)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = callUnary(s, withToken("wrong-token"), "/cc.arduino.cli.commands.v1.ArduinoCoreService/Version", &rpc.VersionRequest{}, nil)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = callUnary(s, context.Background(), "/grpc.health.v1.Health/Check", nil, nil)
+	require.NoError(t, err)
+
+	// alice can make 2 requests per minute
+	_, err = callUnary(s, withToken("alice-token"), "/cc.arduino.cli.commands.v1.ArduinoCoreService/Version", &rpc.VersionRequest{}, nil)
+	require.NoError(t, err)
+	_, err = callUnary(s, withToken("alice-token"), "/cc.arduino.cli.commands.v1.ArduinoCoreService/Version", &rpc.VersionRequest{}, nil)
+	require.NoError(t, err)
+	_, err = callUnary(s, withToken("alice-token"), "/cc.arduino.cli.commands.v1.ArduinoCoreService/Version", &rpc.VersionRequest{}, nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Only the admins can change the settings
+	_, err = callUnary(s, withToken("bob-token"), "/cc.arduino.cli.settings.v1.SettingsService/GetAll", nil, nil)
+	require.NoError(t, err)
+	_, err = callUnary(s, withToken("bob-token"), "/cc.arduino.cli.settings.v1.SettingsService/SetValue", nil, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = callUnary(s, withToken("admin-token"), "/cc.arduino.cli.settings.v1.SettingsService/SetValue", nil, nil)
+	require.NoError(t, err)
+}
+
+func TestSessionsInstancesIsolation(t *testing.T) {
+	s, err := LoadSessions(paths.New("testdata", "sessions", "users.yaml"), paths.New(t.TempDir()))
+	require.NoError(t, err)
+
+	create := "/cc.arduino.cli.commands.v1.ArduinoCoreService/Create"
+	_, err = callUnary(s, withToken("bob-token"), create, &rpc.CreateRequest{}, &rpc.CreateResponse{Instance: &rpc.Instance{Id: 7}})
+	require.NoError(t, err)
+
+	boardList := "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardList"
+	_, err = callUnary(s, withToken("bob-token"), boardList, &rpc.BoardListRequest{Instance: &rpc.Instance{Id: 7}}, nil)
+	require.NoError(t, err)
+	_, err = callUnary(s, withToken("alice-token"), boardList, &rpc.BoardListRequest{Instance: &rpc.Instance{Id: 7}}, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = callUnary(s, withToken("admin-token"), boardList, &rpc.BoardListRequest{Instance: &rpc.Instance{Id: 7}}, nil)
+	require.NoError(t, err)
+
+	// Once destroyed the instance is not owned anymore
+	_, err = callUnary(s, withToken("bob-token"), "/cc.arduino.cli.commands.v1.ArduinoCoreService/Destroy", &rpc.DestroyRequest{Instance: &rpc.Instance{Id: 7}}, nil)
+	require.NoError(t, err)
+	_, err = callUnary(s, withToken("bob-token"), boardList, &rpc.BoardListRequest{Instance: &rpc.Instance{Id: 7}}, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSessionsBuildOutputs(t *testing.T) {
+	buildsDir := paths.New(t.TempDir())
+	s, err := LoadSessions(paths.New("testdata", "sessions", "users.yaml"), buildsDir)
+	require.NoError(t, err)
+	bob := s.users[HashToken("bob-token")]
+	compile := "/cc.arduino.cli.commands.v1.ArduinoCoreService/Compile"
+
+	// The build path defaults to a directory of the user
+	sketchPath := buildsDir.Join("bob", "sketches", "Blink").String()
+	req := &rpc.CompileRequest{SketchPath: sketchPath}
+	require.NoError(t, s.authorize(bob, compile, req))
+	inside, err := paths.New(req.GetBuildPath()).IsInsideDir(buildsDir.Join("bob"))
+	require.NoError(t, err)
+	require.True(t, inside)
+
+	// The build path of another user can't be used
+	req = &rpc.CompileRequest{SketchPath: sketchPath, BuildPath: buildsDir.Join("alice", "Blink").String()}
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, compile, req)))
+
+	// Once the quota is exceeded the builds are refused
+	require.NoError(t, buildsDir.Join("bob", "Blink").MkdirAll())
+	require.NoError(t, buildsDir.Join("bob", "Blink", "Blink.ino.bin").WriteFile(make([]byte, 1024*1024)))
+	req = &rpc.CompileRequest{SketchPath: sketchPath}
+	require.Equal(t, codes.ResourceExhausted, status.Code(s.authorize(bob, compile, req)))
+}
+
+func TestSessionsPorts(t *testing.T) {
+	s, err := LoadSessions(paths.New("testdata", "sessions", "users.yaml"), paths.New(t.TempDir()))
+	require.NoError(t, err)
+	alice := s.users[HashToken("alice-token")]
+	bob := s.users[HashToken("bob-token")]
+	admin := s.users[HashToken("admin-token")]
+	upload := "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload"
+	acm0 := &rpc.Port{Address: "/dev/ttyACM0", Protocol: "serial"}
+	usb0 := &rpc.Port{Address: "/dev/ttyUSB0", Protocol: "serial"}
+
+	// Without the agent mode only the admins can use the ports
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, upload, &rpc.UploadRequest{Port: acm0})))
+	require.NoError(t, s.authorize(admin, upload, &rpc.UploadRequest{Port: acm0}))
+
+	s.SetAgentMode(true)
+	require.NoError(t, s.authorize(bob, upload, &rpc.UploadRequest{Port: acm0}))
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, upload, &rpc.UploadRequest{Port: usb0})))
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(alice, upload, &rpc.UploadRequest{Port: acm0})))
+	require.NoError(t, s.authorize(admin, upload, &rpc.UploadRequest{Port: usb0}))
+
+	// The port opened with PortIO is in the first message
+	portIO := "/cc.arduino.cli.commands.v1.ArduinoCoreService/PortIO"
+	open := func(port *rpc.Port) *rpc.PortIORequest {
+		return &rpc.PortIORequest{Message: &rpc.PortIORequest_OpenRequest{OpenRequest: &rpc.PortIOOpenRequest{Port: port}}}
+	}
+	require.NoError(t, s.authorize(bob, portIO, open(acm0)))
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, portIO, open(usb0))))
+	require.NoError(t, s.authorize(bob, portIO, &rpc.PortIORequest{Message: &rpc.PortIORequest_TxData{TxData: []byte("x")}}))
+
+	// The ports that can't be used are not listed
+	resp, err := callUnary(s, withToken("bob-token"), "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardList", &rpc.BoardListRequest{},
+		&rpc.BoardListResponse{Ports: []*rpc.DetectedPort{{Port: acm0}, {Port: usb0}}})
+	require.NoError(t, err)
+	require.Len(t, resp.(*rpc.BoardListResponse).GetPorts(), 1)
+	require.Equal(t, "/dev/ttyACM0", resp.(*rpc.BoardListResponse).GetPorts()[0].GetPort().GetAddress())
+}
+
+func TestSessionsAdminMethods(t *testing.T) {
+	s, err := LoadSessions(paths.New("testdata", "sessions", "users.yaml"), paths.New(t.TempDir()))
+	require.NoError(t, err)
+	bob := s.users[HashToken("bob-token")]
+	admin := s.users[HashToken("admin-token")]
+
+	// The platforms and libraries shared by all the users can be changed
+	// only by the administrators
+	for _, method := range []string{
+		"UpdateIndex", "UpdateLibrariesIndex",
+		"PlatformInstall", "PlatformDownload", "PlatformUninstall", "PlatformUpgrade", "UpgradeApply",
+		"LibraryDownload", "LibraryInstall", "LibraryUpgrade", "LibraryUpgradeAll", "LibraryUninstall",
+		"ZipLibraryInstall", "GitLibraryInstall",
+		"BoardDefine", "LibraryPrecompile", "Debug",
+	} {
+		require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+method, nil)), method)
+		require.NoError(t, s.authorize(admin, coreService+method, nil), method)
+	}
+	req := &rpc.LibraryInstallRequest{Name: "Servo", RunPostInstall: true}
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+"LibraryInstall", req)))
+
+	// Unknown methods are refused too
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+"NotExisting", nil)))
+	require.NoError(t, s.authorize(bob, coreService+"LibrarySearch", &rpc.LibrarySearchRequest{}))
+}
+
+func TestSessionsPaths(t *testing.T) {
+	buildsDir := paths.New(t.TempDir())
+	s, err := LoadSessions(paths.New("testdata", "sessions", "users.yaml"), buildsDir)
+	require.NoError(t, err)
+	bob := s.users[HashToken("bob-token")]
+	admin := s.users[HashToken("admin-token")]
+	inside := buildsDir.Join("bob", "Blink").String()
+	outside := buildsDir.Join("alice", "Blink").String()
+	escaping := buildsDir.Join("bob", "..", "alice", "Blink").String()
+
+	requests := map[string]func(p string) interface{}{
+		"Init":              func(p string) interface{} { return &rpc.InitRequest{SketchPath: p} },
+		"NewSketch":         func(p string) interface{} { return &rpc.NewSketchRequest{SketchName: "Blink", SketchDir: p} },
+		"LoadSketch":        func(p string) interface{} { return &rpc.LoadSketchRequest{SketchPath: p} },
+		"ArchiveSketch":     func(p string) interface{} { return &rpc.ArchiveSketchRequest{SketchPath: inside, ArchivePath: p} },
+		"SetSketchDefaults": func(p string) interface{} { return &rpc.SetSketchDefaultsRequest{SketchPath: p} },
+		"ImportPlatformIOProject": func(p string) interface{} {
+			return &rpc.ImportPlatformIOProjectRequest{SketchPath: inside, PlatformioIni: p}
+		},
+		"MoveSketch":            func(p string) interface{} { return &rpc.MoveSketchRequest{SketchPath: inside, DestinationPath: p} },
+		"ConvertSketchToCpp":    func(p string) interface{} { return &rpc.ConvertSketchToCppRequest{SketchPath: p} },
+		"BoardNVSRead":          func(p string) interface{} { return &rpc.BoardNVSReadRequest{SketchPath: p} },
+		"Compile":               func(p string) interface{} { return &rpc.CompileRequest{SketchPath: inside, ExportDir: p} },
+		"PrecompileCore":        func(p string) interface{} { return &rpc.PrecompileCoreRequest{BuildCachePath: p} },
+		"GetCompilerFragment":   func(p string) interface{} { return &rpc.GetCompilerFragmentRequest{SketchPath: p} },
+		"UpgradePlan":           func(p string) interface{} { return &rpc.UpgradePlanRequest{SketchPath: p} },
+		"Upload":                func(p string) interface{} { return &rpc.UploadRequest{ImportDir: p} },
+		"UploadUsingProgrammer": func(p string) interface{} { return &rpc.UploadUsingProgrammerRequest{ImportFile: p} },
+		"FilesystemImageBuild":  func(p string) interface{} { return &rpc.FilesystemImageBuildRequest{SketchPath: inside, OutputPath: p} },
+		"FilesystemImageUpload": func(p string) interface{} { return &rpc.FilesystemImageUploadRequest{ImagePath: p} },
+		"GetDebugConfig":        func(p string) interface{} { return &rpc.GetDebugConfigRequest{ImportDir: p} },
+		"LibraryPropertiesGet":  func(p string) interface{} { return &rpc.LibraryPropertiesGetRequest{LibraryPath: p} },
+		"LibraryPropertiesSet":  func(p string) interface{} { return &rpc.LibraryPropertiesSetRequest{LibraryPath: p} },
+	}
+	for method, request := range requests {
+		require.NoError(t, s.authorize(bob, coreService+method, request(inside)), method)
+		require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+method, request(outside))), method)
+		require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+method, request(escaping))), method)
+		require.NoError(t, s.authorize(admin, coreService+method, request(outside)), method)
+	}
+
+	// All the paths of a compilation are checked
+	compile := coreService + "Compile"
+	for _, req := range []*rpc.CompileRequest{
+		{SketchPath: outside},
+		{SketchPath: inside, BuildCachePath: outside},
+		{SketchPath: inside, KeysKeychain: outside},
+		{SketchPath: inside, CompareWith: outside},
+		{SketchPath: inside, Libraries: []string{outside}},
+		{SketchPath: inside, Library: []string{inside, outside}},
+	} {
+		require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, compile, req)), req.String())
+	}
+
+	// The paths that are not set default to the directory of the user
+	newSketch := &rpc.NewSketchRequest{SketchName: "Blink"}
+	require.NoError(t, s.authorize(bob, coreService+"NewSketch", newSketch))
+	require.Equal(t, buildsDir.Join("bob").String(), newSketch.GetSketchDir())
+	compileReq := &rpc.CompileRequest{SketchPath: inside}
+	require.NoError(t, s.authorize(bob, compile, compileReq))
+	require.Equal(t, buildsDir.Join("bob", "cache").String(), compileReq.GetBuildCachePath())
+
+	// The library is given by path only if it's a directory
+	checkExamples := coreService + "LibraryCheckExamples"
+	require.NoError(t, s.authorize(bob, checkExamples, &rpc.LibraryCheckExamplesRequest{Library: "Servo"}))
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, checkExamples, &rpc.LibraryCheckExamplesRequest{Library: t.TempDir()})))
+
+	// The library.properties in the current directory of the daemon
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+"LibraryPropertiesGet", &rpc.LibraryPropertiesGetRequest{})))
+	require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+"LibraryPropertiesSet", &rpc.LibraryPropertiesSetRequest{})))
+
+	// The symlinks can't point outside the directory of the user
+	if runtime.GOOS != "windows" {
+		require.NoError(t, buildsDir.Join("bob").MkdirAll())
+		require.NoError(t, buildsDir.Join("alice").MkdirAll())
+		link := buildsDir.Join("bob", "link")
+		require.NoError(t, os.Symlink(buildsDir.Join("alice").String(), link.String()))
+		require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+"LoadSketch", &rpc.LoadSketchRequest{SketchPath: link.String()})))
+		require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+"LoadSketch", &rpc.LoadSketchRequest{SketchPath: link.Join("Blink").String()})))
+		dangling := buildsDir.Join("bob", "dangling")
+		require.NoError(t, os.Symlink(buildsDir.Join("alice", "missing").String(), dangling.String()))
+		require.Equal(t, codes.InvalidArgument, status.Code(s.authorize(bob, coreService+"NewSketch", &rpc.NewSketchRequest{SketchName: "Blink", SketchDir: dangling.String()})))
+		require.NoError(t, os.Symlink(buildsDir.Join("bob", "Blink").String(), buildsDir.Join("bob", "inner").String()))
+		require.NoError(t, buildsDir.Join("bob", "Blink").MkdirAll())
+		require.NoError(t, s.authorize(bob, coreService+"LoadSketch", &rpc.LoadSketchRequest{SketchPath: buildsDir.Join("bob", "inner").String()}))
+	}
+}
+
+func TestSessionsUserOptions(t *testing.T) {
+	buildsDir := paths.New(t.TempDir())
+	s, err := LoadSessions(paths.New("testdata", "sessions", "users.yaml"), buildsDir)
+	require.NoError(t, err)
+	bob := s.users[HashToken("bob-token")]
+	admin := s.users[HashToken("admin-token")]
+	sketch := buildsDir.Join("bob", "Blink").String()
+
+	// The profiles and the build properties can be used only by the administrators
+	for _, test := range []struct {
+		method string
+		req    interface{}
+	}{
+		{"Init", &rpc.InitRequest{SketchPath: sketch, Profile: "uno"}},
+		{"Compile", &rpc.CompileRequest{SketchArchive: []byte("PK"), Profile: "uno"}},
+		{"Compile", &rpc.CompileRequest{SketchPath: sketch, BuildProperties: []string{"recipe.hooks.prebuild.1.pattern=sh"}}},
+		{"GetCompilerFragment", &rpc.GetCompilerFragmentRequest{SketchPath: sketch, BuildProperties: []string{"compiler.path=/tmp/"}}},
+	} {
+		require.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, coreService+test.method, test.req)), test.method)
+		require.NoError(t, s.authorize(admin, coreService+test.method, test.req), test.method)
+	}
+	require.NoError(t, s.authorize(bob, coreService+"Init", &rpc.InitRequest{SketchPath: sketch}))
+	require.NoError(t, s.authorize(bob, coreService+"Compile", &rpc.CompileRequest{SketchPath: sketch}))
+}
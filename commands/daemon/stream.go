@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/djherbis/buffer"
 	"github.com/djherbis/nio/v3"
 )
@@ -44,35 +45,96 @@ func (w *implWriteCloser) Close() error {
 // The user must call Close() on the returned io.WriteCloser to release all the resources.
 // If needed, the context can be used to detect when all the data has been processed after
 // closing the writer.
+// The data is buffered and batched as set by the daemon.stream_buffer_size,
+// daemon.stream_batch_size and daemon.stream_flush_interval settings, see batchStreamTo.
 func feedStreamTo(writer func(data []byte)) io.WriteCloser {
-	r, w := nio.Pipe(buffer.New(32 * 1024))
-	var wg sync.WaitGroup
-	wg.Add(1)
+	return batchStreamTo(writer,
+		configuration.Settings.GetInt("daemon.stream_buffer_size"),
+		configuration.Settings.GetInt("daemon.stream_batch_size"),
+		configuration.Settings.GetDuration("daemon.stream_flush_interval"))
+}
+
+// batchStreamTo is feedStreamTo with explicit buffering and batching parameters:
+// the writes block when bufferSize bytes are waiting to be processed. The data is
+// passed to the writer function when batchSize bytes are collected or when
+// flushInterval is elapsed since the first byte of the batch has been written,
+// to limit the number of outgoing gRPC messages without delaying the data.
+// If flushInterval is 0 the data is passed to the writer as soon as it's read.
+func batchStreamTo(writer func(data []byte), bufferSize, batchSize int, flushInterval time.Duration) io.WriteCloser {
+	if bufferSize <= 0 {
+		bufferSize = 32 * 1024
+	}
+	if batchSize <= 0 {
+		batchSize = 16384
+	}
+	r, w := nio.Pipe(buffer.New(int64(bufferSize)))
+	chunks := make(chan []byte)
+	consumed := make(chan struct{})
 	go func() {
-		defer wg.Done()
-		data := make([]byte, 16384)
+		defer close(chunks)
+		data := make([]byte, batchSize)
 		for {
-			if n, err := r.Read(data); err == nil {
-				writer(data[:n])
-
-				// Rate limit the number of outgoing gRPC messages
-				// (less messages with biggger data blocks)
-				if n < len(data) {
-					time.Sleep(50 * time.Millisecond)
-				}
-			} else {
+			n, err := r.Read(data)
+			if n > 0 {
+				// The buffer is reused once the chunk is copied in the batch
+				chunks <- data[:n]
+				<-consumed
+			}
+			if err != nil {
 				r.Close()
 				return
 			}
 		}
 	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var batch []byte
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		flush := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+			if len(batch) > 0 {
+				writer(batch)
+				batch = nil
+			}
+		}
+		for {
+			select {
+			case chunk, ok := <-chunks:
+				if !ok {
+					flush()
+					return
+				}
+				if batch == nil {
+					batch = make([]byte, 0, batchSize)
+				}
+				batch = append(batch, chunk...)
+				consumed <- struct{}{}
+				if len(batch) >= batchSize || flushInterval <= 0 {
+					flush()
+				} else if timer == nil {
+					timer = time.NewTimer(flushInterval)
+					timeout = timer.C
+				}
+			case <-timeout:
+				timer, timeout = nil, nil
+				flush()
+			}
+		}
+	}()
+
 	return &implWriteCloser{
 		write: w.Write,
 		close: func() error {
 			if err := w.Close(); err != nil {
 				return err
 			}
-			wg.Wait()
+			<-done
 			return nil
 		},
 	}
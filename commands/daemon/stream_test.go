@@ -0,0 +1,130 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// baud921600 is the number of bytes per second of a serial port at 921600
+// baud (8N1, 10 bits per byte).
+const baud921600 = 921600 / 10
+
+func TestBatchStreamTo(t *testing.T) {
+	var lock sync.Mutex
+	received := []byte{}
+	messages := 0
+	stream := batchStreamTo(func(data []byte) {
+		lock.Lock()
+		received = append(received, data...)
+		messages++
+		lock.Unlock()
+	}, 32*1024, 1024, 10*time.Millisecond)
+
+	// The data written in small chunks is batched
+	data := bytes.Repeat([]byte("0123456789abcdef"), 256)
+	for i := 0; i < len(data); i += 16 {
+		_, err := stream.Write(data[i : i+16])
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(received) == len(data)
+	}, time.Second, time.Millisecond)
+	lock.Lock()
+	require.Equal(t, data, received)
+	require.LessOrEqual(t, messages, 8)
+	lock.Unlock()
+
+	// A small write is sent after the flush interval
+	start := time.Now()
+	_, err := stream.Write([]byte("prompt> "))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return bytes.HasSuffix(received, []byte("prompt> "))
+	}, time.Second, time.Millisecond)
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+
+	// The pending data is sent on Close
+	_, err = stream.Write([]byte("bye"))
+	require.NoError(t, err)
+	require.NoError(t, stream.Close())
+	require.True(t, bytes.HasSuffix(received, []byte("bye")))
+}
+
+func TestBatchStreamToReusesTheReadBuffer(t *testing.T) {
+	// The batches passed to the writer are not changed by the following
+	// reads, even if the read buffer is reused
+	batches := [][]byte{}
+	stream := batchStreamTo(func(data []byte) { batches = append(batches, data) }, 1024, 16, 0)
+	for i := 0; i < 100; i++ {
+		_, err := stream.Write([]byte(fmt.Sprintf("line %02d\n", i)))
+		require.NoError(t, err)
+	}
+	require.NoError(t, stream.Close())
+	expected := ""
+	for i := 0; i < 100; i++ {
+		expected += fmt.Sprintf("line %02d\n", i)
+	}
+	require.Equal(t, expected, string(bytes.Join(batches, nil)))
+	for _, batch := range batches {
+		require.LessOrEqual(t, cap(batch), 32)
+	}
+}
+
+func TestBatchStreamToThroughput(t *testing.T) {
+	// One second of data received from a serial port at 921600 baud, in the
+	// small chunks returned by the reads of the port, must be streamed in
+	// less than a second.
+	total := 0
+	stream := batchStreamTo(func(data []byte) { total += len(data) }, 32*1024, 16384, 10*time.Millisecond)
+	chunk := make([]byte, 64)
+	start := time.Now()
+	for i := 0; i < baud921600/len(chunk); i++ {
+		_, err := stream.Write(chunk)
+		require.NoError(t, err)
+	}
+	require.NoError(t, stream.Close())
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+	require.Equal(t, baud921600/len(chunk)*len(chunk), total)
+}
+
+func BenchmarkFeedStreamTo(b *testing.B) {
+	for _, chunkSize := range []int{1, 64, 4096} {
+		b.Run(fmt.Sprintf("chunk%d", chunkSize), func(b *testing.B) {
+			stream := batchStreamTo(func(data []byte) {}, 32*1024, 16384, 10*time.Millisecond)
+			chunk := make([]byte, chunkSize)
+			b.SetBytes(int64(chunkSize))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				stream.Write(chunk)
+			}
+			stream.Close()
+			b.StopTimer()
+			bytesPerSecond := float64(b.N*chunkSize) / b.Elapsed().Seconds()
+			b.ReportMetric(bytesPerSecond/baud921600, "x921600baud")
+		})
+	}
+}
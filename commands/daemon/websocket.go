@@ -0,0 +1,434 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WebSocketMessage is a message exchanged with the clients of the WebSocket
+// bridge. The client starts a call sending the method and the request, the
+// daemon sends back the responses with the same ID and, when the call ends,
+// a message with Done or Error set. The messages sent by the client with the
+// ID of a running Monitor call are forwarded to the port.
+type WebSocketMessage struct {
+	ID       string          `json:"id"`
+	Method   string          `json:"method,omitempty"`
+	Request  json.RawMessage `json:"request,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+	// Cancel, sent by the client, stops the call
+	Cancel bool   `json:"cancel,omitempty"`
+	Done   bool   `json:"done,omitempty"`
+	Error  string `json:"error,omitempty"`
+	// Code is the gRPC status code of the error
+	Code string `json:"code,omitempty"`
+}
+
+// webSocketMethod starts a call of the ArduinoCoreService, it returns the
+// function to receive the responses and, for the client streams, the one to
+// send the next requests.
+type webSocketMethod struct {
+	newRequest func() proto.Message
+	start      func(ctx context.Context, client rpc.ArduinoCoreServiceClient, req proto.Message) (recv func() (proto.Message, error), send func(proto.Message) error, err error)
+}
+
+var webSocketMethods = map[string]*webSocketMethod{
+	"BoardList": {
+		newRequest: func() proto.Message { return &rpc.BoardListRequest{} },
+		start: func(ctx context.Context, client rpc.ArduinoCoreServiceClient, req proto.Message) (func() (proto.Message, error), func(proto.Message) error, error) {
+			resp, err := client.BoardList(ctx, req.(*rpc.BoardListRequest))
+			if err != nil {
+				return nil, nil, err
+			}
+			sent := false
+			return func() (proto.Message, error) {
+				if sent {
+					return nil, io.EOF
+				}
+				sent = true
+				return resp, nil
+			}, nil, nil
+		},
+	},
+	"BoardListWatch": {
+		newRequest: func() proto.Message { return &rpc.BoardListWatchRequest{} },
+		start: func(ctx context.Context, client rpc.ArduinoCoreServiceClient, req proto.Message) (func() (proto.Message, error), func(proto.Message) error, error) {
+			stream, err := client.BoardListWatch(ctx, req.(*rpc.BoardListWatchRequest))
+			if err != nil {
+				return nil, nil, err
+			}
+			return func() (proto.Message, error) { return stream.Recv() }, nil, nil
+		},
+	},
+	"Compile": {
+		newRequest: func() proto.Message { return &rpc.CompileRequest{} },
+		start: func(ctx context.Context, client rpc.ArduinoCoreServiceClient, req proto.Message) (func() (proto.Message, error), func(proto.Message) error, error) {
+			stream, err := client.Compile(ctx, req.(*rpc.CompileRequest))
+			if err != nil {
+				return nil, nil, err
+			}
+			return func() (proto.Message, error) { return stream.Recv() }, nil, nil
+		},
+	},
+	"Upload": {
+		newRequest: func() proto.Message { return &rpc.UploadRequest{} },
+		start: func(ctx context.Context, client rpc.ArduinoCoreServiceClient, req proto.Message) (func() (proto.Message, error), func(proto.Message) error, error) {
+			stream, err := client.Upload(ctx, req.(*rpc.UploadRequest))
+			if err != nil {
+				return nil, nil, err
+			}
+			return func() (proto.Message, error) { return stream.Recv() }, nil, nil
+		},
+	},
+	"Monitor": {
+		newRequest: func() proto.Message { return &rpc.MonitorRequest{} },
+		start: func(ctx context.Context, client rpc.ArduinoCoreServiceClient, req proto.Message) (func() (proto.Message, error), func(proto.Message) error, error) {
+			stream, err := client.Monitor(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := stream.Send(req.(*rpc.MonitorRequest)); err != nil {
+				return nil, nil, err
+			}
+			recv := func() (proto.Message, error) { return stream.Recv() }
+			send := func(m proto.Message) error { return stream.Send(m.(*rpc.MonitorRequest)) }
+			return recv, send, nil
+		},
+	},
+}
+
+// WebSocketBridge exposes the board list watch, compile, upload and monitor
+// calls of the daemon to the browsers through a WebSocket, with JSON
+// messages. The calls are forwarded to the gRPC service of the daemon
+// through the given connection, with the token passed in the `token` query
+// parameter, so they're authenticated as the gRPC ones.
+type WebSocketBridge struct {
+	conn           *grpc.ClientConn
+	allowedOrigins []string
+}
+
+// NewWebSocketBridge returns a bridge to the gRPC service reachable with the
+// given connection. The connections are accepted only from the pages served
+// by the allowed origins (e.g. `https://editor.example.com`) or, if none is
+// set, from the pages served by localhost.
+func NewWebSocketBridge(conn *grpc.ClientConn, allowedOrigins []string) *WebSocketBridge {
+	return &WebSocketBridge{conn: conn, allowedOrigins: allowedOrigins}
+}
+
+// Handler returns the HTTP handler of the WebSocket endpoint
+func (b *WebSocketBridge) Handler() http.Handler {
+	return websocket.Server{
+		Handshake: func(config *websocket.Config, req *http.Request) error {
+			if !b.originAllowed(req.Header.Get("Origin")) {
+				return fmt.Errorf("origin %s not allowed", req.Header.Get("Origin"))
+			}
+			return nil
+		},
+		Handler: b.serve,
+	}
+}
+
+// originAllowed checks the origin of the page opening the WebSocket, to
+// prevent any website visited by the user from using the daemon
+func (b *WebSocketBridge) originAllowed(origin string) bool {
+	if origin == "" {
+		// Not a browser
+		return true
+	}
+	if len(b.allowedOrigins) > 0 {
+		return originMatches(origin, b.allowedOrigins)
+	}
+	return isLoopbackOrigin(origin)
+}
+
+// originMatches returns true if the origin matches one of the allowed ones,
+// which may contain wildcards (e.g. `https://*.example.com`)
+func originMatches(origin string, allowedOrigins []string) bool {
+	origin = strings.ToLower(origin)
+	for _, allowed := range allowedOrigins {
+		allowed = strings.ToLower(strings.TrimSuffix(allowed, "/"))
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+		if matched, _ := path.Match(allowed, origin); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// isLoopbackOrigin returns true if the origin is served by this machine
+func isLoopbackOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if u.Hostname() == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(u.Hostname())
+	return ip != nil && ip.IsLoopback()
+}
+
+// webSocketSession is a WebSocket connection with its calls
+type webSocketSession struct {
+	ws       *websocket.Conn
+	client   rpc.ArduinoCoreServiceClient
+	ctx      context.Context
+	sendMux  sync.Mutex
+	callsMux sync.Mutex
+	calls    map[string]*webSocketCall
+	instMux  sync.Mutex
+	instance *rpc.Instance
+}
+
+type webSocketCall struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	method *webSocketMethod
+	// started is closed when the call has been started, send is set only for
+	// the client streams
+	started chan struct{}
+	send    func(proto.Message) error
+}
+
+func (b *WebSocketBridge) serve(ws *websocket.Conn) {
+	defer ws.Close()
+	ws.PayloadType = websocket.TextFrame
+	ctx, cancel := context.WithCancel(ws.Request().Context())
+	defer cancel()
+	if token := ws.Request().URL.Query().Get("token"); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	sess := &webSocketSession{
+		ws:     ws,
+		client: rpc.NewArduinoCoreServiceClient(b.conn),
+		ctx:    ctx,
+		calls:  map[string]*webSocketCall{},
+	}
+	defer sess.destroyInstance()
+
+	for {
+		var msg WebSocketMessage
+		if err := websocket.JSON.Receive(ws, &msg); err != nil {
+			if !errors.Is(err, io.EOF) {
+				logrus.WithError(err).Info("WebSocket connection closed")
+			}
+			return
+		}
+		sess.handle(&msg)
+	}
+}
+
+// handle starts a new call, forwards the message to a running call or
+// cancels it
+func (s *webSocketSession) handle(msg *WebSocketMessage) {
+	s.callsMux.Lock()
+	call := s.calls[msg.ID]
+	s.callsMux.Unlock()
+
+	if call != nil {
+		if msg.Cancel {
+			call.cancel()
+			return
+		}
+		// The requests are forwarded in order, once the call is started
+		select {
+		case <-call.started:
+		case <-call.ctx.Done():
+			return
+		}
+		if call.send == nil {
+			s.sendError(msg.ID, errors.New(tr("A call with ID %s is already running", msg.ID)))
+			return
+		}
+		req := call.method.newRequest()
+		if err := protojson.Unmarshal(msg.Request, req); err != nil {
+			s.sendError(msg.ID, err)
+			return
+		}
+		if err := call.send(req); err != nil {
+			s.sendError(msg.ID, err)
+		}
+		return
+	}
+	if msg.Cancel {
+		return
+	}
+
+	method, ok := webSocketMethods[msg.Method]
+	if !ok {
+		s.sendError(msg.ID, errors.New(tr("Unknown method %s", msg.Method)))
+		return
+	}
+	req := method.newRequest()
+	if len(msg.Request) > 0 {
+		if err := protojson.Unmarshal(msg.Request, req); err != nil {
+			s.sendError(msg.ID, err)
+			return
+		}
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	call = &webSocketCall{ctx: ctx, cancel: cancel, method: method, started: make(chan struct{})}
+	s.callsMux.Lock()
+	s.calls[msg.ID] = call
+	s.callsMux.Unlock()
+	go s.run(msg.ID, call, req)
+}
+
+// run performs the call and sends back the responses
+func (s *webSocketSession) run(id string, call *webSocketCall, req proto.Message) {
+	ctx := call.ctx
+	defer func() {
+		call.cancel()
+		s.callsMux.Lock()
+		delete(s.calls, id)
+		s.callsMux.Unlock()
+	}()
+
+	if err := s.setInstance(req); err != nil {
+		s.sendError(id, err)
+		return
+	}
+	recv, send, err := call.method.start(ctx, s.client, req)
+	if err != nil {
+		s.sendError(id, err)
+		return
+	}
+	call.send = send
+	close(call.started)
+
+	for {
+		resp, err := recv()
+		if errors.Is(err, io.EOF) {
+			s.send(&WebSocketMessage{ID: id, Done: true})
+			return
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				// Canceled by the client
+				s.send(&WebSocketMessage{ID: id, Done: true})
+				return
+			}
+			s.sendError(id, err)
+			return
+		}
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+		if err != nil {
+			s.sendError(id, err)
+			return
+		}
+		if err := s.send(&WebSocketMessage{ID: id, Response: data}); err != nil {
+			return
+		}
+	}
+}
+
+// setInstance sets the instance of the WebSocket connection in the request,
+// if the client didn't set one. The instance is created the first time it's
+// needed and destroyed when the connection is closed.
+func (s *webSocketSession) setInstance(req proto.Message) error {
+	m := req.ProtoReflect()
+	field := m.Descriptor().Fields().ByName("instance")
+	if field == nil || m.Has(field) {
+		return nil
+	}
+
+	s.instMux.Lock()
+	defer s.instMux.Unlock()
+	if s.instance == nil {
+		inst, err := initInstance(s.ctx, s.client)
+		if err != nil {
+			return err
+		}
+		s.instance = inst
+	}
+	m.Set(field, protoreflect.ValueOfMessage(proto.Clone(s.instance).ProtoReflect()))
+	return nil
+}
+
+// initInstance creates and initializes an instance through the gRPC service
+func initInstance(ctx context.Context, client rpc.ArduinoCoreServiceClient) (*rpc.Instance, error) {
+	res, err := client.Create(ctx, &rpc.CreateRequest{})
+	if err != nil {
+		return nil, err
+	}
+	stream, err := client.Init(ctx, &rpc.InitRequest{Instance: res.GetInstance()})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return res.GetInstance(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if st := resp.GetError(); st != nil {
+			logrus.Warnf("Error initializing instance: %s", st.GetMessage())
+		}
+	}
+}
+
+func (s *webSocketSession) destroyInstance() {
+	s.instMux.Lock()
+	defer s.instMux.Unlock()
+	if s.instance == nil {
+		return
+	}
+	// The context of the connection is already canceled
+	md, _ := metadata.FromOutgoingContext(s.ctx)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	if _, err := s.client.Destroy(ctx, &rpc.DestroyRequest{Instance: s.instance}); err != nil {
+		logrus.WithError(err).Warn("Error destroying WebSocket instance")
+	}
+}
+
+func (s *webSocketSession) send(msg *WebSocketMessage) error {
+	s.sendMux.Lock()
+	defer s.sendMux.Unlock()
+	return websocket.JSON.Send(s.ws, msg)
+}
+
+func (s *webSocketSession) sendError(id string, err error) {
+	msg := &WebSocketMessage{ID: id, Error: err.Error()}
+	if st, ok := status.FromError(err); ok {
+		msg.Error = st.Message()
+		msg.Code = st.Code().String()
+	}
+	s.send(msg)
+}
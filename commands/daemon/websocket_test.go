@@ -0,0 +1,146 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeCoreService answers to the calls used by the WebSocket bridge
+type fakeCoreService struct {
+	rpc.UnimplementedArduinoCoreServiceServer
+}
+
+func (f *fakeCoreService) Create(ctx context.Context, req *rpc.CreateRequest) (*rpc.CreateResponse, error) {
+	return &rpc.CreateResponse{Instance: &rpc.Instance{Id: 42}}, nil
+}
+
+func (f *fakeCoreService) Init(req *rpc.InitRequest, stream rpc.ArduinoCoreService_InitServer) error {
+	return nil
+}
+
+func (f *fakeCoreService) Destroy(ctx context.Context, req *rpc.DestroyRequest) (*rpc.DestroyResponse, error) {
+	return &rpc.DestroyResponse{}, nil
+}
+
+func (f *fakeCoreService) BoardList(ctx context.Context, req *rpc.BoardListRequest) (*rpc.BoardListResponse, error) {
+	// The address of the port tells the instance used
+	address := fmt.Sprintf("instance-%d", req.GetInstance().GetId())
+	return &rpc.BoardListResponse{Ports: []*rpc.DetectedPort{{Port: &rpc.Port{Address: address}}}}, nil
+}
+
+func (f *fakeCoreService) Monitor(stream rpc.ArduinoCoreService_MonitorServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		if len(req.GetTxData()) > 0 {
+			if err := stream.Send(&rpc.MonitorResponse{RxData: req.GetTxData()}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func startWebSocketTestServer(t *testing.T) string {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	rpc.RegisterArduinoCoreServiceServer(s, &fakeCoreService{})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	httpServer := httptest.NewServer(NewWebSocketBridge(conn, nil).Handler())
+	t.Cleanup(httpServer.Close)
+	return "ws" + strings.TrimPrefix(httpServer.URL, "http")
+}
+
+func TestWebSocketBridge(t *testing.T) {
+	url := startWebSocketTestServer(t)
+	ws, err := websocket.Dial(url, "", "http://localhost:3000")
+	require.NoError(t, err)
+	defer ws.Close()
+
+	receive := func() *WebSocketMessage {
+		var msg WebSocketMessage
+		require.NoError(t, websocket.JSON.Receive(ws, &msg))
+		return &msg
+	}
+
+	// The instance of the connection is used if not set
+	require.NoError(t, websocket.JSON.Send(ws, &WebSocketMessage{ID: "1", Method: "BoardList"}))
+	msg := receive()
+	require.Equal(t, "1", msg.ID)
+	var resp struct {
+		Ports []struct {
+			Port struct {
+				Address string `json:"address"`
+			} `json:"port"`
+		} `json:"ports"`
+	}
+	require.NoError(t, json.Unmarshal(msg.Response, &resp))
+	require.Equal(t, "instance-42", resp.Ports[0].Port.Address)
+	require.True(t, receive().Done)
+
+	require.NoError(t, websocket.JSON.Send(ws, &WebSocketMessage{ID: "2", Method: "Unknown"}))
+	msg = receive()
+	require.Equal(t, "2", msg.ID)
+	require.Contains(t, msg.Error, "Unknown method")
+
+	// The messages with the ID of the monitor are sent to the port
+	require.NoError(t, websocket.JSON.Send(ws, &WebSocketMessage{ID: "m", Method: "Monitor", Request: json.RawMessage(`{"port":{"address":"/dev/ttyACM0"}}`)}))
+	require.NoError(t, websocket.JSON.Send(ws, &WebSocketMessage{ID: "m", Request: json.RawMessage(`{"tx_data":"aGVsbG8="}`)}))
+	msg = receive()
+	require.Equal(t, "m", msg.ID)
+	require.JSONEq(t, `{"rx_data":"aGVsbG8="}`, string(msg.Response))
+	require.NoError(t, websocket.JSON.Send(ws, &WebSocketMessage{ID: "m", Cancel: true}))
+	msg = receive()
+	require.Equal(t, "m", msg.ID)
+	require.True(t, msg.Done)
+}
+
+func TestWebSocketBridgeOrigin(t *testing.T) {
+	url := startWebSocketTestServer(t)
+	_, err := websocket.Dial(url, "", "https://evil.example.com")
+	require.Error(t, err)
+
+	b := NewWebSocketBridge(nil, nil)
+	require.True(t, b.originAllowed(""))
+	require.True(t, b.originAllowed("http://localhost:8080"))
+	require.True(t, b.originAllowed("http://127.0.0.1"))
+	require.False(t, b.originAllowed("https://evil.example.com"))
+
+	b = NewWebSocketBridge(nil, []string{"https://editor.example.com/"})
+	require.True(t, b.originAllowed("https://editor.example.com"))
+	require.False(t, b.originAllowed("http://localhost:8080"))
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
@@ -51,7 +52,7 @@ func getDebugProperties(req *rpc.GetDebugConfigRequest, pme *packagemanager.Expl
 		return nil, &arduino.MissingSketchPathError{}
 	}
 	sketchPath := paths.New(req.GetSketchPath())
-	sk, err := sketch.New(sketchPath)
+	sk, err := sketch.NewWithSymlinksPolicy(sketchPath, configuration.SketchSymlinksPolicy(configuration.Settings))
 	if err != nil {
 		return nil, &arduino.CantOpenSketchError{Cause: err}
 	}
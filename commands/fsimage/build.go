@@ -0,0 +1,171 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package fsimage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/fsimage"
+	"github.com/arduino/arduino-cli/arduino/partitions"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+var tr = i18n.Tr
+
+// Build packs the data folder of a sketch in a filesystem image, using the
+// tool provided by the platform of the board.
+func Build(ctx context.Context, req *rpc.FilesystemImageBuildRequest, outStream, errStream io.Writer) (*rpc.FilesystemImage, error) {
+	sk, err := LoadSketch(req.GetSketchPath())
+	if err != nil {
+		return nil, err
+	}
+
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+
+	image, buildProperties, err := Resolve(pme, sk, req.GetFqbn(), req.GetFsType())
+	if err != nil {
+		return nil, err
+	}
+	imagePath := DefaultImagePath(sk, image)
+	if req.GetOutputPath() != "" {
+		imagePath = paths.New(req.GetOutputPath())
+	}
+	if err := BuildImage(ctx, sk, image, buildProperties, imagePath, pme.GetEnvVarsForSpawnedProcess(), req.GetVerbose(), outStream, errStream); err != nil {
+		return nil, err
+	}
+	return ImageToRPC(image, imagePath), nil
+}
+
+// LoadSketch opens the sketch at the given path, checking that it has a
+// data folder to pack in the filesystem image.
+func LoadSketch(sketchPath string) (*sketch.Sketch, error) {
+	if sketchPath == "" {
+		return nil, &arduino.MissingSketchPathError{}
+	}
+	sk, err := sketch.NewWithSymlinksPolicy(paths.New(sketchPath), configuration.SketchSymlinksPolicy(configuration.Settings))
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+	return sk, nil
+}
+
+// Resolve returns the layout of the filesystem image of the sketch for the
+// given board (or the board attached to the sketch if empty), together with
+// the build properties of the board.
+func Resolve(pme *packagemanager.Explorer, sk *sketch.Sketch, fqbnIn, fsType string) (*fsimage.Image, *properties.Map, error) {
+	if fqbnIn == "" {
+		fqbnIn = sk.GetDefaultFQBN()
+	}
+	if fqbnIn == "" && pme.GetProfile() != nil {
+		fqbnIn = pme.GetProfile().FQBN
+	}
+	if fqbnIn == "" {
+		return nil, nil, &arduino.MissingFQBNError{}
+	}
+	fqbn, err := cores.ParseFQBN(fqbnIn)
+	if err != nil {
+		return nil, nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+	_, _, _, buildProperties, _, err := pme.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, nil, &arduino.UnknownFQBNError{Cause: err}
+	}
+	buildProperties.Set("build.fqbn", fqbn.String())
+
+	partitionsFile := sk.GetPartitionsFile()
+	if partitionsFile == nil {
+		partitionsFile = partitions.BoardDefaultFile(buildProperties)
+	}
+	image, err := fsimage.Resolve(buildProperties, partitionsFile, fsType)
+	if err != nil {
+		return nil, nil, &arduino.InvalidArgumentError{Message: tr("Cannot determine the filesystem image layout"), Cause: err}
+	}
+	return image, buildProperties, nil
+}
+
+// DefaultImagePath returns the path where the filesystem image of the sketch
+// is saved by default, in the build directory of the sketch.
+func DefaultImagePath(sk *sketch.Sketch, image *fsimage.Image) *paths.Path {
+	return sk.DefaultBuildPath().Join(fmt.Sprintf("%s.%s.bin", sk.Name, image.Type))
+}
+
+// BuildImage runs the recipe of the platform that packs the data folder of the
+// sketch in the filesystem image.
+func BuildImage(ctx context.Context, sk *sketch.Sketch, image *fsimage.Image, buildProperties *properties.Map, imagePath *paths.Path, toolEnv []string, verbose bool, outStream, errStream io.Writer) error {
+	dataPath := sk.FullPath.Join("data")
+	if !dataPath.IsDir() {
+		return &arduino.NotFoundError{Message: tr("The sketch has no data folder to pack in the filesystem image")}
+	}
+	if err := imagePath.Parent().MkdirAll(); err != nil {
+		return &arduino.PermissionDeniedError{Message: tr("Cannot create build directory"), Cause: err}
+	}
+
+	props := buildProperties.Clone()
+	props.Merge(image.Properties(dataPath, imagePath))
+	recipe := props.Get("recipe.fsimage." + image.Type + ".pattern")
+	cmdLine := props.ExpandPropsInString(recipe)
+	cmdArgs, err := properties.SplitQuotedString(cmdLine, `"'`, false)
+	if err != nil {
+		return &arduino.InvalidPlatformPropertyError{Property: "recipe.fsimage." + image.Type + ".pattern", Value: recipe}
+	}
+
+	logrus.WithField("phase", "fsimage").Tracef("Executing filesystem image tool: %s", cmdLine)
+	if verbose {
+		fmt.Fprintln(outStream, cmdLine)
+	}
+	cmd, err := executils.NewProcess(toolEnv, cmdArgs...)
+	if err != nil {
+		return &arduino.FailedFilesystemImageError{Message: tr("Cannot execute filesystem image tool"), Cause: err}
+	}
+	cmd.RedirectStdoutTo(outStream)
+	cmd.RedirectStderrTo(errStream)
+	if err := cmd.RunWithinContext(ctx); err != nil {
+		// Don't leave a partially written image behind
+		imagePath.Remove()
+		return &arduino.FailedFilesystemImageError{Message: tr("Error building filesystem image"), Cause: err}
+	}
+	if !imagePath.Exist() {
+		return &arduino.FailedFilesystemImageError{Message: tr("The filesystem image tool didn't create %s", imagePath)}
+	}
+	return nil
+}
+
+// ImageToRPC converts the image layout in its gRPC representation
+func ImageToRPC(image *fsimage.Image, imagePath *paths.Path) *rpc.FilesystemImage {
+	return &rpc.FilesystemImage{
+		ImagePath: imagePath.String(),
+		FsType:    image.Type,
+		Offset:    image.Offset,
+		Size:      image.Size,
+	}
+}
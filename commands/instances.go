@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/advisories"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
@@ -45,15 +46,15 @@ import (
 
 var tr = i18n.Tr
 
-func installTool(pm *packagemanager.PackageManager, tool *cores.ToolRelease, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+func installTool(ctx context.Context, pm *packagemanager.PackageManager, tool *cores.ToolRelease, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
 	pme, release := pm.NewExplorer()
 	defer release()
 	taskCB(&rpc.TaskProgress{Name: tr("Downloading missing tool %s", tool)})
-	if err := pme.DownloadToolRelease(tool, nil, downloadCB); err != nil {
+	if err := pme.DownloadToolRelease(ctx, tool, nil, downloadCB); err != nil {
 		return fmt.Errorf(tr("downloading %[1]s tool: %[2]s"), tool, err)
 	}
 	taskCB(&rpc.TaskProgress{Completed: true})
-	if err := pme.InstallTool(tool, taskCB, true); err != nil {
+	if err := pme.InstallTool(ctx, tool, taskCB, true); err != nil {
 		return fmt.Errorf(tr("installing %[1]s tool: %[2]s"), tool, err)
 	}
 	return nil
@@ -73,7 +74,7 @@ func Create(req *rpc.CreateRequest, extraUserAgent ...string) (*rpc.CreateRespon
 // All responses are sent through responseCallback, can be nil to ignore all responses.
 // Failures don't stop the loading process, in case of loading failure the Platform or library
 // is simply skipped and an error gRPC status is sent to responseCallback.
-func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) error {
+func Init(ctx context.Context, req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) error {
 	if responseCallback == nil {
 		responseCallback = func(r *rpc.InitResponse) {}
 	}
@@ -85,6 +86,11 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 	if !instances.IsValid(instance) {
 		return &arduino.InvalidInstanceError{}
 	}
+	releaseResources, err := instances.AcquireWriteWait(ctx, instance, "Init", instances.Platforms, instances.Libraries)
+	if err != nil {
+		return err
+	}
+	defer releaseResources()
 
 	// Setup callback functions
 	if responseCallback == nil {
@@ -119,7 +125,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 	// Try to extract profile if specified
 	var profile *sketch.Profile
 	if req.GetProfile() != "" {
-		sk, err := sketch.New(paths.New(req.GetSketchPath()))
+		sk, err := sketch.NewWithSymlinksPolicy(paths.New(req.GetSketchPath()), configuration.SketchSymlinksPolicy(configuration.Settings))
 		if err != nil {
 			return &arduino.InvalidArgumentError{Cause: err}
 		}
@@ -156,7 +162,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 			allPackageIndexUrls = append(allPackageIndexUrls, URL)
 		}
 	}
-	if err := firstUpdate(context.Background(), req.GetInstance(), downloadCallback, allPackageIndexUrls); err != nil {
+	if err := firstUpdate(ctx, req.GetInstance(), downloadCallback, allPackageIndexUrls); err != nil {
 		e := &arduino.InitFailedError{
 			Code:   codes.InvalidArgument,
 			Cause:  err,
@@ -172,6 +178,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 		// If this is not done the information of the uninstall core is kept in memory,
 		// even if it should not.
 		pmb, commitPackageManager := instances.GetPackageManager(instance).NewBuilder()
+		pmb.SetDeferPlatformsLoading(req.GetLazyPlatformsLoading())
 
 		// Load packages index
 		for _, URL := range allPackageIndexUrls {
@@ -211,7 +218,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 			}
 		} else {
 			// Load platforms from profile
-			errs := pmb.LoadHardwareForProfile(
+			errs := pmb.LoadHardwareForProfile(ctx,
 				profile, true, downloadCallback, taskCallback,
 			)
 			for _, err := range errs {
@@ -245,7 +252,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 		// Install builtin tools if necessary
 		if len(builtinToolsToInstall) > 0 {
 			for _, toolRelease := range builtinToolsToInstall {
-				if err := installTool(pmb.Build(), toolRelease, downloadCallback, taskCallback); err != nil {
+				if err := installTool(ctx, pmb.Build(), toolRelease, downloadCallback, taskCallback); err != nil {
 					e := &arduino.InitFailedError{
 						Code:   codes.Internal,
 						Cause:  err,
@@ -266,7 +273,10 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 		commitPackageManager()
 	}
 
-	pme, release := instances.GetPackageManagerExplorer(instance)
+	// The platforms loading may have been deferred: the discoveries required by
+	// the deferred platforms will be loaded together with them, while for the
+	// libraries only the platforms installation directories are needed.
+	pme, release := instances.GetPackageManager(instance).NewPartialExplorer()
 	defer release()
 
 	for _, err := range pme.LoadDiscoveries() {
@@ -279,6 +289,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 		pme.IndexDir,
 		pme.DownloadDir,
 	)
+	lm.SetSymlinksPolicy(configuration.LibrariesSymlinksPolicy(configuration.Settings))
 	_ = instances.SetLibraryManager(instance, lm) // should never fail
 
 	// Load libraries
@@ -296,9 +307,10 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 	}
 
 	if profile == nil {
-		// Add directories of libraries bundled with IDE
-		if bundledLibsDir := configuration.IDEBuiltinLibrariesDir(configuration.Settings); bundledLibsDir != nil {
-			lm.AddLibrariesDir(bundledLibsDir, libraries.IDEBuiltIn)
+		// Add directories of libraries bundled with IDE and of the system
+		// libraries, they're read-only and have the lowest priority
+		for _, builtinLibsDir := range configuration.BuiltinLibrariesDirectories(configuration.Settings) {
+			lm.AddLibrariesDir(builtinLibsDir, libraries.IDEBuiltIn)
 		}
 
 		// Add libraries directory from config file
@@ -323,7 +335,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 					responseError(err.ToRPCStatus())
 					continue
 				}
-				if err := libRelease.Resource.Download(lm.DownloadsDir, nil, libRelease.String(), downloadCallback, ""); err != nil {
+				if err := libRelease.Resource.Download(ctx, lm.DownloadsDir, nil, libRelease.String(), downloadCallback, ""); err != nil {
 					taskCallback(&rpc.TaskProgress{Name: tr("Error downloading library %s", libraryRef)})
 					e := &arduino.FailedLibraryInstallError{Cause: err}
 					responseError(e.ToRPCStatus())
@@ -333,7 +345,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 
 				// Install library
 				taskCallback(&rpc.TaskProgress{Name: tr("Installing library %s", libraryRef)})
-				if err := libRelease.Resource.Install(lm.DownloadsDir, libRoot, libDir); err != nil {
+				if err := libRelease.Resource.Install(ctx, lm.DownloadsDir, libRoot, libDir); err != nil {
 					taskCallback(&rpc.TaskProgress{Name: tr("Error installing library %s", libraryRef)})
 					e := &arduino.FailedLibraryInstallError{Cause: err}
 					responseError(e.ToRPCStatus())
@@ -342,7 +354,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 				taskCallback(&rpc.TaskProgress{Completed: true})
 			}
 
-			lm.AddLibrariesDir(libRoot, libraries.User)
+			lm.AddLibrariesDir(libRoot, libraries.Profile)
 		}
 	}
 
@@ -390,7 +402,7 @@ func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexRequ
 		URL:                          librariesmanager.LibraryIndexWithSignatureArchiveURL,
 		EnforceSignatureVerification: true,
 	}
-	if err := indexResource.Download(lm.IndexFile.Parent(), downloadCB); err != nil {
+	if err := indexResource.Download(ctx, lm.IndexFile.Parent(), downloadCB); err != nil {
 		return err
 	}
 
@@ -442,17 +454,65 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB rp
 			indexResource.SignatureURL, _ = url.Parse(u) // should not fail because we already parsed it
 			indexResource.SignatureURL.Path += ".sig"
 		}
-		if err := indexResource.Download(indexpath, downloadCB); err != nil {
+		if err := indexResource.Download(ctx, indexpath, downloadCB); err != nil {
 			failed = true
 		}
 	}
 
+	if !updateAdvisories(ctx, downloadCB) {
+		failed = true
+	}
+
 	if failed {
 		return &arduino.FailedDownloadError{Message: tr("Some indexes could not be updated.")}
 	}
 	return nil
 }
 
+// updateAdvisories downloads the advisories feeds of the configuration, it
+// returns false if some feeds could not be updated.
+func updateAdvisories(ctx context.Context, downloadCB rpc.DownloadProgressCB) bool {
+	advisoriesDir := configuration.AdvisoriesDir(configuration.Settings)
+	res := true
+	for _, u := range configuration.Settings.GetStringSlice("advisories.urls") {
+		URL, err := utils.URLParse(u)
+		if err != nil {
+			downloadCB.Start(u, tr("Downloading advisories: %s", u))
+			downloadCB.End(false, fmt.Sprintf("%s: %v", tr("Unable to parse URL"), err))
+			res = false
+			continue
+		}
+
+		if URL.Scheme == "file" {
+			downloadCB.Start(u, tr("Downloading advisories: %s", filepath.Base(URL.Path)))
+			if _, err := advisories.LoadFile(paths.New(URL.Path)); err != nil {
+				downloadCB.End(false, err.Error())
+				res = false
+			} else {
+				downloadCB.End(true, "")
+			}
+			continue
+		}
+
+		feedResource := resources.IndexResource{URL: URL}
+		if err := feedResource.Download(ctx, advisoriesDir, downloadCB); err != nil {
+			res = false
+			continue
+		}
+		file, err := advisories.FeedFile(u, advisoriesDir)
+		if err == nil {
+			_, err = advisories.LoadFile(file)
+		}
+		if err != nil {
+			logrus.WithError(err).Warn("Invalid advisories feed")
+			downloadCB.Start(u, tr("Downloading advisories: %s", u))
+			downloadCB.End(false, err.Error())
+			res = false
+		}
+	}
+	return res
+}
+
 // firstUpdate downloads libraries and packages indexes if they don't exist.
 // This ideally is only executed the first time the CLI is run.
 func firstUpdate(ctx context.Context, instance *rpc.Instance, downloadCb func(msg *rpc.DownloadProgress), externalPackageIndexes []*url.URL) error {
@@ -20,6 +20,10 @@ var tr = i18n.Tr
 type coreInstance struct {
 	pm *packagemanager.PackageManager
 	lm *librariesmanager.LibrariesManager
+
+	locksMux sync.Mutex // Protects locks and released
+	locks    [2]*resourceLock
+	released chan struct{} // Closed when the locks are released, if someone is waiting for them
 }
 
 // instances contains all the running Arduino Core Services instances
@@ -54,8 +58,8 @@ func GetPackageManagerExplorer(req *rpc.Instance) (explorer *packagemanager.Expl
 // GetLibraryManager returns the library manager for the given instance.
 func GetLibraryManager(inst *rpc.Instance) *librariesmanager.LibrariesManager {
 	instancesMux.Lock()
+	defer instancesMux.Unlock()
 	i := instances[inst.GetId()]
-	instancesMux.Unlock()
 	if i == nil {
 		return nil
 	}
@@ -65,8 +69,8 @@ func GetLibraryManager(inst *rpc.Instance) *librariesmanager.LibrariesManager {
 // SetLibraryManager sets the library manager for the given instance.
 func SetLibraryManager(inst *rpc.Instance, lm *librariesmanager.LibrariesManager) bool {
 	instancesMux.Lock()
+	defer instancesMux.Unlock()
 	i := instances[inst.GetId()]
-	instancesMux.Unlock()
 	if i == nil {
 		return false
 	}
@@ -76,7 +80,9 @@ func SetLibraryManager(inst *rpc.Instance, lm *librariesmanager.LibrariesManager
 
 // Create a new *rpc.Instance ready to be initialized, supporting directories are also created.
 func Create(extraUserAgent ...string) (*rpc.Instance, error) {
-	instance := &coreInstance{}
+	instance := &coreInstance{
+		locks: [2]*resourceLock{Platforms: {}, Libraries: {}},
+	}
 
 	// Setup downloads directory
 	downloadsDir := configuration.DownloadsDir(configuration.Settings)
@@ -0,0 +1,179 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package instances
+
+import (
+	"context"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Resource is a part of the installation managed by an instance, that the
+// operations acquire to run concurrently without interfering with each other:
+//   - Compile reads the platforms and the libraries
+//   - Upload, UploadUsingProgrammer and BurnBootloader read the platforms
+//   - PlatformInstall, PlatformUpgrade and PlatformUninstall modify the platforms
+//   - LibraryInstall, ZipLibraryInstall, GitLibraryInstall and LibraryUninstall
+//     (and the library upgrades, done through LibraryInstall) modify the libraries
+//   - Init and UpgradeApply modify both the platforms and the libraries
+//
+// Any number of operations can read a resource at the same time, while an
+// operation that modifies a resource needs it exclusively. Init waits for the
+// resources to be released, the other operations fail if they are in use. The
+// operations not listed above don't acquire any resource and can always run.
+type Resource int
+
+const (
+	// Platforms are the installed platforms and tools
+	Platforms Resource = iota
+	// Libraries are the installed libraries
+	Libraries
+)
+
+func (r Resource) String() string {
+	switch r {
+	case Platforms:
+		return tr("platforms")
+	case Libraries:
+		return tr("libraries")
+	}
+	return ""
+}
+
+// resourceLock is a read/write lock that is acquired without waiting and
+// keeps track of the operations holding it, to report them in the conflict
+// errors. It must be used with the locksMux of the instance held.
+type resourceLock struct {
+	readers map[string]int
+	writer  string
+}
+
+// holders returns the operations holding the lock that conflict with the
+// requested mode.
+func (l *resourceLock) holders(write bool) []string {
+	if l.writer != "" {
+		return []string{l.writer}
+	}
+	if !write {
+		return nil
+	}
+	res := []string{}
+	for op := range l.readers {
+		res = append(res, op)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func (l *resourceLock) lock(operation string, write bool) {
+	if write {
+		l.writer = operation
+		return
+	}
+	if l.readers == nil {
+		l.readers = map[string]int{}
+	}
+	l.readers[operation]++
+}
+
+func (l *resourceLock) unlock(operation string, write bool) {
+	if write {
+		l.writer = ""
+		return
+	}
+	if l.readers[operation]--; l.readers[operation] == 0 {
+		delete(l.readers, operation)
+	}
+}
+
+// AcquireRead acquires the given resources of the instance in read mode for
+// the given operation. The resources are acquired without waiting: if one of
+// them is being modified by another operation an arduino.InstanceBusyError is
+// returned and none of them is acquired. The returned function must be called
+// to release the resources when the operation is completed.
+func AcquireRead(inst *rpc.Instance, operation string, resources ...Resource) (release func(), err error) {
+	return acquire(inst, operation, false, resources)
+}
+
+// AcquireWrite acquires exclusively the given resources of the instance for
+// the given operation, that is going to modify them. The resources are
+// acquired without waiting: if one of them is in use by another operation an
+// arduino.InstanceBusyError is returned and none of them is acquired. The
+// returned function must be called to release the resources when the
+// operation is completed.
+func AcquireWrite(inst *rpc.Instance, operation string, resources ...Resource) (release func(), err error) {
+	return acquire(inst, operation, true, resources)
+}
+
+// AcquireWriteWait is like AcquireWrite, but if the resources are in use it
+// waits for the operations holding them to release them instead of failing.
+// If the context is canceled while waiting, the context error is returned.
+func AcquireWriteWait(ctx context.Context, inst *rpc.Instance, operation string, resources ...Resource) (release func(), err error) {
+	for {
+		release, released, err := tryAcquire(inst, operation, true, resources)
+		if _, busy := err.(*arduino.InstanceBusyError); !busy {
+			return release, err
+		}
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func acquire(inst *rpc.Instance, operation string, write bool, resources []Resource) (func(), error) {
+	release, _, err := tryAcquire(inst, operation, write, resources)
+	return release, err
+}
+
+// tryAcquire acquires the resources without waiting. If they are in use, it
+// returns a channel that is closed when some of them are released.
+func tryAcquire(inst *rpc.Instance, operation string, write bool, resources []Resource) (func(), <-chan struct{}, error) {
+	instancesMux.Lock()
+	i := instances[inst.GetId()]
+	instancesMux.Unlock()
+	if i == nil {
+		return nil, nil, &arduino.InvalidInstanceError{}
+	}
+
+	i.locksMux.Lock()
+	defer i.locksMux.Unlock()
+	for _, resource := range resources {
+		if holders := i.locks[resource].holders(write); len(holders) > 0 {
+			if i.released == nil {
+				i.released = make(chan struct{})
+			}
+			return nil, i.released, &arduino.InstanceBusyError{Operation: operation, Resource: resource.String(), HeldBy: holders}
+		}
+	}
+	for _, resource := range resources {
+		i.locks[resource].lock(operation, write)
+	}
+	return func() {
+		i.locksMux.Lock()
+		defer i.locksMux.Unlock()
+		for _, resource := range resources {
+			i.locks[resource].unlock(operation, write)
+		}
+		if i.released != nil {
+			close(i.released)
+			i.released = nil
+		}
+	}, nil, nil
+}
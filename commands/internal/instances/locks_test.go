@@ -0,0 +1,116 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package instances
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInstance(t *testing.T) *rpc.Instance {
+	instancesMux.Lock()
+	defer instancesMux.Unlock()
+	id := instancesCount
+	instances[id] = &coreInstance{locks: [2]*resourceLock{Platforms: {}, Libraries: {}}}
+	instancesCount++
+	t.Cleanup(func() { Delete(&rpc.Instance{Id: id}) })
+	return &rpc.Instance{Id: id}
+}
+
+func TestAcquireResources(t *testing.T) {
+	inst := newTestInstance(t)
+
+	// Multiple readers can share the resources
+	releaseCompile, err := AcquireRead(inst, "Compile", Platforms, Libraries)
+	require.NoError(t, err)
+	releaseUpload, err := AcquireRead(inst, "Upload", Platforms)
+	require.NoError(t, err)
+
+	// Writers conflict with the readers
+	_, err = AcquireWrite(inst, "PlatformInstall", Platforms)
+	var busyErr *arduino.InstanceBusyError
+	require.ErrorAs(t, err, &busyErr)
+	require.Equal(t, []string{"Compile", "Upload"}, busyErr.HeldBy)
+	require.Equal(t, "PlatformInstall", busyErr.Operation)
+
+	releaseCompile()
+	_, err = AcquireWrite(inst, "LibraryInstall", Libraries)
+	require.NoError(t, err)
+
+	// Readers conflict with the writers, and a failed acquisition doesn't
+	// keep any of the requested resources
+	_, err = AcquireRead(inst, "Compile", Platforms, Libraries)
+	require.ErrorAs(t, err, &busyErr)
+	require.Equal(t, []string{"LibraryInstall"}, busyErr.HeldBy)
+	releaseUpload()
+	releasePlatforms, err := AcquireWrite(inst, "PlatformUpgrade", Platforms)
+	require.NoError(t, err)
+	releasePlatforms()
+
+	// Invalid instance
+	_, err = AcquireRead(&rpc.Instance{Id: -1}, "Compile", Platforms)
+	var invalidErr *arduino.InvalidInstanceError
+	require.ErrorAs(t, err, &invalidErr)
+}
+
+func TestAcquireWriteWait(t *testing.T) {
+	inst := newTestInstance(t)
+
+	releaseCompile, err := AcquireRead(inst, "Compile", Platforms, Libraries)
+	require.NoError(t, err)
+	releaseInstall, err := AcquireWrite(inst, "PlatformInstall", Platforms)
+	require.Error(t, err)
+	require.Nil(t, releaseInstall)
+
+	// Init waits for all the resources to be released
+	acquired := make(chan func())
+	go func() {
+		release, err := AcquireWriteWait(context.Background(), inst, "Init", Platforms, Libraries)
+		require.NoError(t, err)
+		acquired <- release
+	}()
+	select {
+	case <-acquired:
+		require.FailNow(t, "Init acquired the resources in use")
+	case <-time.After(100 * time.Millisecond):
+	}
+	releaseCompile()
+	select {
+	case releaseInit := <-acquired:
+		releaseInit()
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "Init didn't acquire the released resources")
+	}
+
+	// The wait stops when the context is canceled
+	releaseCompile, err = AcquireRead(inst, "Compile", Platforms)
+	require.NoError(t, err)
+	defer releaseCompile()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = AcquireWriteWait(ctx, inst, "Init", Platforms, Libraries)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Invalid instance
+	_, err = AcquireWriteWait(context.Background(), &rpc.Instance{Id: -1}, "Init", Platforms)
+	var invalidErr *arduino.InvalidInstanceError
+	require.ErrorAs(t, err, &invalidErr)
+}
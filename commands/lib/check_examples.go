@@ -0,0 +1,218 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// checkExamplesAnnotation matches the comments of the examples selecting the
+// boards to compile them for, e.g.:
+//
+//	// check-examples: include arduino:samd:*
+//	// check-examples: exclude arduino:avr:uno arduino:avr:nano
+var checkExamplesAnnotation = regexp.MustCompile(`^\s*//\s*check-examples:\s*(include|exclude)\s+(.*)$`)
+
+// compilerWarning matches the warnings in the output of gcc
+var compilerWarning = regexp.MustCompile(`(?m)\bwarning: `)
+
+// examplesFilter selects the boards an example is compiled for: if any
+// include pattern is set only the matching boards are used, the ones
+// matching an exclude pattern are always skipped.
+type examplesFilter struct {
+	include []string
+	exclude []string
+}
+
+// loadExamplesFilter reads the check-examples annotations in the main file
+// of the example.
+func loadExamplesFilter(example *paths.Path) (*examplesFilter, error) {
+	filter := &examplesFilter{}
+	sk, err := sketch.New(example)
+	if err != nil {
+		// The compilation will report the problem
+		return filter, nil
+	}
+	source, err := sk.MainFile.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(source), "\n") {
+		match := checkExamplesAnnotation.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if match == nil {
+			continue
+		}
+		patterns := strings.FieldsFunc(match[2], func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+		if match[1] == "include" {
+			filter.include = append(filter.include, patterns...)
+		} else {
+			filter.exclude = append(filter.exclude, patterns...)
+		}
+	}
+	return filter, nil
+}
+
+// Match returns true if the example must be compiled for the board.
+func (f *examplesFilter) Match(fqbn string) bool {
+	matchAny := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if match, _ := path.Match(pattern, fqbn); match {
+				return true
+			}
+		}
+		return false
+	}
+	if len(f.include) > 0 && !matchAny(f.include) {
+		return false
+	}
+	return !matchAny(f.exclude)
+}
+
+// LibraryCheckExamples compiles every example of a library for each of the
+// requested boards. The result of each compilation is sent to checkCB as
+// soon as it completes, the failed compilations don't stop the check.
+func LibraryCheckExamples(ctx context.Context, req *rpc.LibraryCheckExamplesRequest, outStream, errStream io.Writer, checkCB func(*rpc.LibraryExampleCheck)) (*rpc.LibraryCheckExamplesResult, error) {
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	if len(req.GetFqbns()) == 0 {
+		return nil, &arduino.MissingFQBNError{}
+	}
+
+	var lib *libraries.Library
+	if libDir := paths.New(req.GetLibrary()); libDir != nil && libDir.IsDir() {
+		l, err := libraries.Load(libDir, libraries.Unmanaged)
+		if err != nil {
+			return nil, &arduino.InvalidLibraryError{Cause: err}
+		}
+		lib = l
+	} else {
+		libs := lm.Libraries[req.GetLibrary()]
+		if len(libs) == 0 {
+			return nil, &arduino.LibraryNotFoundError{Library: req.GetLibrary()}
+		}
+		if len(libs) > 1 {
+			libsDir := paths.NewPathList()
+			for _, lib := range libs {
+				libsDir.Add(lib.InstallDir)
+			}
+			return nil, &arduino.MultipleLibraryInstallDetected{
+				LibName: libs[0].Name,
+				LibsDir: libsDir,
+				Message: tr("Pass the path of the library to check instead of its name."),
+			}
+		}
+		lib = libs[0]
+	}
+	examples := lib.Examples.Clone()
+	examples.Sort()
+	if len(examples) == 0 {
+		return nil, &arduino.InvalidArgumentError{Message: tr("The library %s has no examples", lib.Name)}
+	}
+
+	tmp, err := paths.MkTempDir("", "arduino-library-check-examples")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+
+	res := &rpc.LibraryCheckExamplesResult{
+		Library: lib.Name,
+		Fqbns:   req.GetFqbns(),
+	}
+	compileOut := io.Discard
+	if req.GetVerbose() {
+		compileOut = outStream
+	}
+	for i, example := range examples {
+		exampleName := example.String()
+		if rel, err := lib.InstallDir.RelTo(example); err == nil {
+			exampleName = filepath.ToSlash(rel.String())
+		}
+		res.Examples = append(res.Examples, exampleName)
+		filter, err := loadExamplesFilter(example)
+		if err != nil {
+			return res, &arduino.CantOpenSketchError{Cause: err}
+		}
+
+		for j, fqbn := range req.GetFqbns() {
+			if err := ctx.Err(); err != nil {
+				return res, err
+			}
+			check := &rpc.LibraryExampleCheck{
+				Example: exampleName,
+				Fqbn:    fqbn,
+			}
+			if !filter.Match(fqbn) {
+				check.Status = rpc.LibraryExampleCheckStatus_LIBRARY_EXAMPLE_CHECK_STATUS_SKIPPED
+			} else {
+				fmt.Fprintln(outStream, tr("Compiling example %[1]s for %[2]s...", check.GetExample(), fqbn))
+				logrus.Infof("Compiling example %s of library %s for %s", check.GetExample(), lib.Name, fqbn)
+
+				buildPath := tmp.Join(fmt.Sprintf("%d-%d", i, j))
+				compileErr := &bytes.Buffer{}
+				var compileErrStream io.Writer = compileErr
+				if req.GetVerbose() {
+					compileErrStream = io.MultiWriter(compileErr, errStream)
+				}
+				_, err := compile.Compile(ctx, &rpc.CompileRequest{
+					Instance:   req.GetInstance(),
+					Fqbn:       fqbn,
+					SketchPath: example.String(),
+					BuildPath:  buildPath.String(),
+					Library:    []string{lib.InstallDir.String()},
+					Warnings:   req.GetWarnings(),
+					Verbose:    req.GetVerbose(),
+				}, compileOut, compileErrStream, nil)
+				check.Warnings = int32(len(compilerWarning.FindAllIndex(compileErr.Bytes(), -1)))
+				if err != nil {
+					if ctx.Err() != nil {
+						return res, ctx.Err()
+					}
+					check.Status = rpc.LibraryExampleCheckStatus_LIBRARY_EXAMPLE_CHECK_STATUS_FAILED
+					check.Output = compileErr.String()
+					if check.Output == "" {
+						check.Output = err.Error()
+					}
+					res.Failed++
+				}
+				buildPath.RemoveAll()
+			}
+			res.Checks = append(res.Checks, check)
+			if checkCB != nil {
+				checkCB(check)
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,49 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExamplesFilter(t *testing.T) {
+	lib, err := libraries.Load(paths.New("testdata", "check_examples", "MyLib"), libraries.Unmanaged)
+	require.NoError(t, err)
+	require.Len(t, lib.Examples, 2)
+
+	basic, err := loadExamplesFilter(lib.InstallDir.Join("examples", "Basic"))
+	require.NoError(t, err)
+	require.True(t, basic.Match("arduino:avr:uno"))
+	require.True(t, basic.Match("arduino:samd:mkr1000"))
+	require.False(t, basic.Match("arduino:avr:nano"))
+
+	samdOnly, err := loadExamplesFilter(lib.InstallDir.Join("examples", "Advanced", "SamdOnly"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"arduino:samd:*"}, samdOnly.include)
+	require.Equal(t, []string{"arduino:samd:mkr1000", "arduino:samd:mkrzero"}, samdOnly.exclude)
+	require.True(t, samdOnly.Match("arduino:samd:nano_33_iot"))
+	require.False(t, samdOnly.Match("arduino:samd:mkr1000"))
+	require.False(t, samdOnly.Match("arduino:avr:uno"))
+
+	// The folders that are not sketches have no annotations
+	notASketch, err := loadExamplesFilter(lib.InstallDir.Join("src"))
+	require.NoError(t, err)
+	require.True(t, notASketch.Match("arduino:avr:uno"))
+}
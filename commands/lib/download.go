@@ -47,14 +47,14 @@ func LibraryDownload(ctx context.Context, req *rpc.LibraryDownloadRequest, downl
 		return nil, err
 	}
 
-	if err := downloadLibrary(lm, lib, downloadCB, func(*rpc.TaskProgress) {}, "download"); err != nil {
+	if err := downloadLibrary(ctx, lm, lib, downloadCB, func(*rpc.TaskProgress) {}, "download"); err != nil {
 		return nil, err
 	}
 
 	return &rpc.LibraryDownloadResponse{}, nil
 }
 
-func downloadLibrary(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release,
+func downloadLibrary(ctx context.Context, lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release,
 	downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB, queryParameter string) error {
 
 	taskCB(&rpc.TaskProgress{Name: tr("Downloading %s", libRelease)})
@@ -62,7 +62,7 @@ func downloadLibrary(lm *librariesmanager.LibrariesManager, libRelease *librarie
 	if err != nil {
 		return &arduino.FailedDownloadError{Message: tr("Can't download library"), Cause: err}
 	}
-	if err := libRelease.Resource.Download(lm.DownloadsDir, config, libRelease.String(), downloadCB, queryParameter); err != nil {
+	if err := libRelease.Resource.Download(ctx, lm.DownloadsDir, config, libRelease.String(), downloadCB, queryParameter); err != nil {
 		return &arduino.FailedDownloadError{Message: tr("Can't download library"), Cause: err}
 	}
 	taskCB(&rpc.TaskProgress{Completed: true})
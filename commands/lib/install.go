@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/libraries"
@@ -26,6 +27,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
@@ -33,6 +35,20 @@ import (
 
 // LibraryInstall resolves the library dependencies, then downloads and installs the libraries into the install location.
 func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+	if err := libraryInstall(ctx, req, downloadCB, taskCB); err != nil {
+		return err
+	}
+	return commands.Init(ctx, &rpc.InitRequest{Instance: req.Instance}, nil)
+}
+
+// libraryInstall is the implementation of the library installer
+func libraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+	releaseResources, err := instances.AcquireWrite(req.GetInstance(), "LibraryInstall", instances.Libraries)
+	if err != nil {
+		return err
+	}
+	defer releaseResources()
+
 	lm := instances.GetLibraryManager(req.GetInstance())
 	if lm == nil {
 		return &arduino.InvalidInstanceError{}
@@ -47,9 +63,10 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest, downloa
 		}
 	} else {
 		res, err := LibraryResolveDependencies(ctx, &rpc.LibraryResolveDependenciesRequest{
-			Instance: req.Instance,
-			Name:     req.Name,
-			Version:  req.Version,
+			Instance:   req.Instance,
+			Name:       req.Name,
+			Version:    req.Version,
+			Resolution: req.GetResolution(),
 		})
 		if err != nil {
 			return err
@@ -87,6 +104,13 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest, downloa
 			taskCB(&rpc.TaskProgress{Message: tr("Already installed %s", libRelease), Completed: true})
 			continue
 		}
+		if replaced := installTask.ReplacedLib; replaced != nil && libRelease.GetName() != req.Name {
+			// Do not replace the dependencies installed from git or zip with the ones of the index
+			if source := replaced.InstallSource; source == libraries.GitSource || source == libraries.ZipSource {
+				taskCB(&rpc.TaskProgress{Message: tr("Keeping %[1]s installed from %[2]s", replaced, source), Completed: true})
+				continue
+			}
+		}
 
 		if req.GetNoOverwrite() {
 			if installTask.ReplacedLib != nil {
@@ -108,33 +132,77 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest, downloa
 				downloadReason += "-builtin"
 			}
 		}
-		if err := downloadLibrary(lm, libRelease, downloadCB, taskCB, downloadReason); err != nil {
+		if err := downloadLibrary(ctx, lm, libRelease, downloadCB, taskCB, downloadReason); err != nil {
 			return err
 		}
-		if err := installLibrary(lm, libRelease, installTask, taskCB); err != nil {
+		if err := installLibrary(ctx, lm, libRelease, installTask, taskCB); err != nil {
 			return err
 		}
+		runPostInstallHook(ctx, lm, installTask, installLocation, req.GetRunPostInstall(), taskCB)
 	}
 
-	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
-		return err
+	return nil
+}
+
+// runPostInstallHook runs the post-install hook of the installed library, if it declares one
+// and the user consented to run it, otherwise it tells how to run it.
+func runPostInstallHook(ctx context.Context, lm *librariesmanager.LibrariesManager, installTask *librariesmanager.LibraryInstallPlan, installLocation libraries.LibraryLocation, run bool, taskCB rpc.TaskProgressCB) {
+	lib, err := libraries.Load(installTask.TargetPath, installLocation)
+	if err != nil {
+		logrus.WithError(err).Warn("Loading installed library")
+		return
+	}
+	hook, err := lib.PostInstallHook()
+	if err != nil {
+		taskCB(&rpc.TaskProgress{Message: tr("WARNING the post-install hook of %[1]s is not valid: %[2]s", lib, err), Completed: true})
+		return
+	}
+	if hook == nil {
+		return
+	}
+	description := hook.Description
+	if description == "" {
+		description = hook.Script.Base()
+	}
+	if len(hook.Permissions) > 0 {
+		description += " (" + tr("requires: %s", strings.Join(hook.Permissions, ", ")) + ")"
+	}
+	if !run {
+		taskCB(&rpc.TaskProgress{Message: tr("Library %[1]s declares a post-install hook that has not been run: %[2]s. Approve it in the library.post_install_libraries setting and install it with --run-post-install to run it.", lib, description), Completed: true})
+		return
 	}
 
-	return nil
+	logrus.WithField("library", lib).Info("Running post-install hook")
+	taskCB(&rpc.TaskProgress{Message: tr("Running the post-install hook of %[1]s: %[2]s", lib, description)})
+	allowedLibraries := configuration.Settings.GetStringSlice("library.post_install_libraries")
+	allowedPermissions := configuration.Settings.GetStringSlice("library.post_install_permissions")
+	stdout, stderr, err := lm.RunPostInstallHook(ctx, lib, hook, allowedLibraries, allowedPermissions)
+	if len(stdout) > 0 {
+		taskCB(&rpc.TaskProgress{Message: string(stdout), Completed: true})
+	}
+	if len(stderr) > 0 {
+		taskCB(&rpc.TaskProgress{Message: string(stderr), Completed: true})
+	}
+	if err != nil {
+		taskCB(&rpc.TaskProgress{Message: tr("WARNING cannot run the post-install hook of %[1]s: %[2]s", lib, err), Completed: true})
+	}
 }
 
-func installLibrary(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release, installTask *librariesmanager.LibraryInstallPlan, taskCB rpc.TaskProgressCB) error {
+func installLibrary(ctx context.Context, lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release, installTask *librariesmanager.LibraryInstallPlan, taskCB rpc.TaskProgressCB) error {
 	taskCB(&rpc.TaskProgress{Name: tr("Installing %s", libRelease)})
 	logrus.WithField("library", libRelease).Info("Installing library")
 
 	if libReplaced := installTask.ReplacedLib; libReplaced != nil {
+		if modified, _, _ := librariesmanager.ModifiedFiles(libReplaced); len(modified) > 0 {
+			taskCB(&rpc.TaskProgress{Message: tr("WARNING the local changes to the files of %[1]s will be lost: %[2]s", libReplaced, strings.Join(modified, ", "))})
+		}
 		taskCB(&rpc.TaskProgress{Message: tr("Replacing %[1]s with %[2]s", libReplaced, libRelease)})
 		if err := lm.Uninstall(libReplaced); err != nil {
 			return &arduino.FailedLibraryInstallError{
 				Cause: fmt.Errorf("%s: %s", tr("could not remove old library"), err)}
 		}
 	}
-	if err := lm.Install(libRelease, installTask.TargetPath); err != nil {
+	if err := lm.Install(ctx, libRelease, installTask.TargetPath); err != nil {
 		return &arduino.FailedLibraryInstallError{Cause: err}
 	}
 
@@ -144,6 +212,12 @@ func installLibrary(lm *librariesmanager.LibrariesManager, libRelease *libraries
 
 // ZipLibraryInstall FIXMEDOC
 func ZipLibraryInstall(ctx context.Context, req *rpc.ZipLibraryInstallRequest, taskCB rpc.TaskProgressCB) error {
+	releaseResources, err := instances.AcquireWrite(req.GetInstance(), "ZipLibraryInstall", instances.Libraries)
+	if err != nil {
+		return err
+	}
+	defer releaseResources()
+
 	lm := instances.GetLibraryManager(req.GetInstance())
 	if err := lm.InstallZipLib(ctx, paths.New(req.Path), req.Overwrite); err != nil {
 		return &arduino.FailedLibraryInstallError{Cause: err}
@@ -154,6 +228,12 @@ func ZipLibraryInstall(ctx context.Context, req *rpc.ZipLibraryInstallRequest, t
 
 // GitLibraryInstall FIXMEDOC
 func GitLibraryInstall(ctx context.Context, req *rpc.GitLibraryInstallRequest, taskCB rpc.TaskProgressCB) error {
+	releaseResources, err := instances.AcquireWrite(req.GetInstance(), "GitLibraryInstall", instances.Libraries)
+	if err != nil {
+		return err
+	}
+	defer releaseResources()
+
 	lm := instances.GetLibraryManager(req.GetInstance())
 	if err := lm.InstallGitLib(req.Url, req.Overwrite); err != nil {
 		return &arduino.FailedLibraryInstallError{Cause: err}
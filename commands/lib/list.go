@@ -17,7 +17,9 @@ package lib
 
 import (
 	"context"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -25,7 +27,9 @@ import (
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesusage"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
 
@@ -36,21 +40,32 @@ type installedLib struct {
 
 // LibraryList FIXMEDOC
 func LibraryList(ctx context.Context, req *rpc.LibraryListRequest) (*rpc.LibraryListResponse, error) {
-	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
-	if pme == nil {
-		return nil, &arduino.InvalidInstanceError{}
-	}
-	defer release()
-
 	lm := instances.GetLibraryManager(req.GetInstance())
 	if lm == nil {
 		return nil, &arduino.InvalidInstanceError{}
 	}
 
 	nameFilter := strings.ToLower(req.GetName())
+	var locationsFilter []libraries.LibraryLocation
+	for _, location := range req.GetLocations() {
+		if _, ok := rpc.LibraryLocation_name[int32(location)]; !ok {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid library location: %d", location)}
+		}
+		locationsFilter = append(locationsFilter, libraries.FromRPCLibraryLocation(location))
+	}
+	// The built-in libraries are listed if their location is requested
+	all := req.GetAll() || len(locationsFilter) > 0
 
 	var allLibs []*installedLib
 	if fqbnString := req.GetFqbn(); fqbnString != "" {
+		// The package manager is needed only to resolve the FQBN, avoid taking
+		// it otherwise since it may trigger the loading of deferred platforms.
+		pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+		if pme == nil {
+			return nil, &arduino.InvalidInstanceError{}
+		}
+		defer release()
+
 		allLibs = listLibraries(lm, req.GetUpdatable(), true)
 		fqbn, err := cores.ParseFQBN(req.GetFqbn())
 		if err != nil {
@@ -91,25 +106,58 @@ func LibraryList(ctx context.Context, req *rpc.LibraryListRequest) (*rpc.Library
 			allLibs = append(allLibs, lib)
 		}
 	} else {
-		allLibs = listLibraries(lm, req.GetUpdatable(), req.GetAll())
+		allLibs = listLibraries(lm, req.GetUpdatable(), all)
+	}
+
+	var usageDB *librariesusage.Database
+	if req.GetUnusedSinceSeconds() > 0 {
+		db, err := librariesusage.Load(configuration.LibrariesUsageFile(configuration.Settings))
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading the usage of the libraries"), Cause: err}
+		}
+		usageDB = db
 	}
+	unusedThreshold := time.Now().Add(-time.Duration(req.GetUnusedSinceSeconds()) * time.Second)
 
 	installedLibs := []*rpc.InstalledLibrary{}
 	for _, lib := range allLibs {
 		if nameFilter != "" && strings.ToLower(lib.Library.Name) != nameFilter {
 			continue
 		}
+		if len(locationsFilter) > 0 && !slices.Contains(locationsFilter, lib.Library.Location) {
+			continue
+		}
 		var release *rpc.LibraryRelease
 		if lib.Available != nil {
 			release = lib.Available.ToRPCLibraryRelease()
 		}
+		var modifiedFiles []string
+		if req.GetModified() {
+			modified, hasManifest, err := librariesmanager.ModifiedFiles(lib.Library)
+			if err != nil {
+				return nil, &arduino.PermissionDeniedError{Message: tr("Error checking the files of library %s", lib.Library.Name), Cause: err}
+			}
+			if !hasManifest || len(modified) == 0 {
+				continue
+			}
+			modifiedFiles = modified
+		}
+		var usage *rpc.LibraryUsage
+		if usageDB != nil {
+			if usageDB.LastActivity(lib.Library).After(unusedThreshold) {
+				continue
+			}
+			usage = libraryUsageToRPC(usageDB, lib.Library)
+		}
 		rpcLib, err := lib.Library.ToRPCLibrary()
 		if err != nil {
 			return nil, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", lib.Library.Name), Cause: err}
 		}
 		installedLibs = append(installedLibs, &rpc.InstalledLibrary{
-			Library: rpcLib,
-			Release: release,
+			Library:       rpcLib,
+			Release:       release,
+			ModifiedFiles: modifiedFiles,
+			Usage:         usage,
 		})
 	}
 
@@ -123,7 +171,7 @@ func listLibraries(lm *librariesmanager.LibrariesManager, updatable bool, all bo
 	for _, libAlternatives := range lm.Libraries {
 		for _, lib := range libAlternatives {
 			if !all {
-				if lib.Location != libraries.User {
+				if lib.Location != libraries.User && lib.Location != libraries.Profile {
 					continue
 				}
 			}
@@ -0,0 +1,106 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// LibraryListConflicts lists the headers provided by more than one installed
+// library, reporting the library that the builder would select for each one
+// and the libraries shadowed by it.
+func LibraryListConflicts(ctx context.Context, req *rpc.LibraryListConflictsRequest) (*rpc.LibraryListConflictsResponse, error) {
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	arch := ""
+	var boardPlatform, refBoardPlatform *cores.PlatformRelease
+	if req.GetFqbn() != "" {
+		pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+		if pme == nil {
+			return nil, &arduino.InvalidInstanceError{}
+		}
+		defer release()
+
+		fqbn, err := cores.ParseFQBN(req.GetFqbn())
+		if err != nil {
+			return nil, &arduino.InvalidFQBNError{Cause: err}
+		}
+		_, boardPlatform, _, _, refBoardPlatform, err = pme.ResolveFQBN(fqbn)
+		if err != nil {
+			return nil, &arduino.UnknownFQBNError{Cause: err}
+		}
+		arch = fqbn.PlatformArch
+	}
+	return listConflicts(lm, arch, boardPlatform, refBoardPlatform)
+}
+
+// listConflicts resolves the ambiguous headers with the same resolver used by
+// the builder, scanning the libraries bundled with the given platforms.
+func listConflicts(lm *librariesmanager.LibrariesManager, arch string, platforms ...*cores.PlatformRelease) (*rpc.LibraryListConflictsResponse, error) {
+	resolver := librariesresolver.NewCppResolver()
+	if err := resolver.ScanIDEBuiltinLibraries(lm); err != nil {
+		return nil, &arduino.InvalidLibraryError{Cause: err}
+	}
+	if err := resolver.ScanUserAndUnmanagedLibraries(lm); err != nil {
+		return nil, &arduino.InvalidLibraryError{Cause: err}
+	}
+	scanned := map[*cores.PlatformRelease]bool{}
+	for _, platform := range platforms {
+		if platform == nil || scanned[platform] {
+			continue
+		}
+		scanned[platform] = true
+		if err := resolver.ScanPlatformLibraries(lm, platform); err != nil {
+			return nil, &arduino.InvalidLibraryError{Cause: err}
+		}
+	}
+
+	res := []*rpc.LibraryConflict{}
+	for _, header := range resolver.AmbiguousHeaders() {
+		selected, reason := resolver.ResolveForWithReason(header, arch)
+		rpcSelected, err := selected.ToRPCLibrary()
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", selected.Name), Cause: err}
+		}
+		conflict := &rpc.LibraryConflict{
+			Header:          header,
+			Selected:        rpcSelected,
+			SelectionReason: reason,
+		}
+		for _, lib := range resolver.AlternativesFor(header) {
+			if lib == selected {
+				continue
+			}
+			rpcLib, err := lib.ToRPCLibrary()
+			if err != nil {
+				return nil, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", lib.Name), Cause: err}
+			}
+			conflict.Shadowed = append(conflict.Shadowed, rpcLib)
+		}
+		res = append(res, conflict)
+	}
+	return &rpc.LibraryListConflictsResponse{Conflicts: res}, nil
+}
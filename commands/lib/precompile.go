@@ -0,0 +1,159 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+var invalidArchiveNameChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// LibraryPrecompile compiles an installed library, for each of the requested
+// boards, into a static archive placed in the src/<mcu> folder of the library,
+// where the builder looks for the precompiled libraries.
+func LibraryPrecompile(ctx context.Context, req *rpc.LibraryPrecompileRequest, outStream, errStream io.Writer) (*rpc.LibraryPrecompileResult, error) {
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	if len(req.GetFqbns()) == 0 {
+		return nil, &arduino.MissingFQBNError{}
+	}
+
+	libs := lm.FindByReference(&librariesindex.Reference{Name: req.GetName()}, libraries.User)
+	if len(libs) == 0 {
+		return nil, &arduino.LibraryNotFoundError{Library: req.GetName()}
+	}
+	if len(libs) > 1 {
+		libsDir := paths.NewPathList()
+		for _, lib := range libs {
+			libsDir.Add(lib.InstallDir)
+		}
+		return nil, &arduino.MultipleLibraryInstallDetected{
+			LibName: libs[0].Name,
+			LibsDir: libsDir,
+			Message: tr("Remove the duplicates before precompiling the library."),
+		}
+	}
+	lib := libs[0]
+
+	headers := lib.DeclaredHeaders()
+	if len(headers) == 0 {
+		var err error
+		if headers, err = lib.SourceHeaders(); err != nil {
+			return nil, &arduino.InvalidLibraryError{Cause: err}
+		}
+	}
+
+	// Compile a sketch including the library to get its object files
+	tmp, err := paths.MkTempDir("", "arduino-library-precompile")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+	sketchPath := tmp.Join("precompile")
+	if err := sketchPath.MkdirAll(); err != nil {
+		return nil, &arduino.CantCreateSketchError{Cause: err}
+	}
+	sketchSource := ""
+	for _, header := range headers {
+		sketchSource += "#include <" + header + ">\n"
+	}
+	sketchSource += "void setup() {}\nvoid loop() {}\n"
+	if err := sketchPath.Join("precompile.ino").WriteFile([]byte(sketchSource)); err != nil {
+		return nil, &arduino.CantCreateSketchError{Cause: err}
+	}
+
+	compileOut := io.Discard
+	if req.GetVerbose() {
+		compileOut = outStream
+	}
+	archiveName := "lib" + invalidArchiveNameChars.ReplaceAllString(lib.DirName, "_") + ".a"
+	res := &rpc.LibraryPrecompileResult{}
+	for i, fqbn := range req.GetFqbns() {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		fmt.Fprintln(outStream, tr("Precompiling library %[1]s for %[2]s...", lib.Name, fqbn))
+		logrus.Infof("Precompiling library %s for %s", lib.Name, fqbn)
+
+		buildPath := tmp.Join("build", fmt.Sprint(i))
+		compileRes, err := compile.Compile(ctx, &rpc.CompileRequest{
+			Instance:                   req.GetInstance(),
+			Fqbn:                       fqbn,
+			SketchPath:                 sketchPath.String(),
+			BuildPath:                  buildPath.String(),
+			Library:                    []string{lib.InstallDir.String()},
+			Verbose:                    req.GetVerbose(),
+			DoNotExpandBuildProperties: true,
+		}, compileOut, errStream, nil)
+		if err != nil {
+			return res, &arduino.CompileFailedError{Message: tr("Error compiling library %[1]s for %[2]s", lib.Name, fqbn), Cause: err}
+		}
+		buildProperties, err := properties.LoadFromSlice(compileRes.GetBuildProperties())
+		if err != nil {
+			return res, &arduino.CompileFailedError{Message: tr("Error compiling library %[1]s for %[2]s", lib.Name, fqbn), Cause: err}
+		}
+
+		objectFiles, err := buildPath.Join("libraries", lib.InstallDir.Base()).ReadDirRecursive()
+		if err == nil {
+			objectFiles.FilterSuffix(".o")
+		}
+		if len(objectFiles) == 0 {
+			return res, &arduino.CompileFailedError{
+				Message: tr("No object files produced for library %[1]s on %[2]s: the library may already be precompiled for this board", lib.Name, fqbn),
+			}
+		}
+
+		archive := builder.PrecompiledLibraryFolder(lib, buildProperties).Join(archiveName)
+		if archive.Exist() {
+			if !req.GetOverwrite() {
+				return res, &arduino.InvalidArgumentError{Message: tr("Archive %s already exists, use overwrite to replace it", archive)}
+			}
+			if err := archive.Remove(); err != nil {
+				return res, &arduino.PermissionDeniedError{Message: tr("Cannot remove the old archive"), Cause: err}
+			}
+		}
+		if err := archive.Parent().MkdirAll(); err != nil {
+			return res, &arduino.PermissionDeniedError{Message: tr("Cannot create the archive folder"), Cause: err}
+		}
+		if err := builder.ArchiveObjectFiles(buildProperties, archive, objectFiles, compileOut, errStream, req.GetVerbose()); err != nil {
+			return res, &arduino.CompileFailedError{Message: tr("Error creating archive %s", archive), Cause: err}
+		}
+		fmt.Fprintln(outStream, tr("Created %s", archive))
+		res.Archives = append(res.Archives, archive.String())
+	}
+
+	if !lib.Precompiled {
+		fmt.Fprintln(outStream, tr("To use the archives add %[1]s (or %[2]s to also compile the sources) to the library.properties of %[3]s.",
+			"precompiled=true", "precompiled=full", lib.Name))
+	}
+	return res, nil
+}
@@ -0,0 +1,160 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries/libraryproperties"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// loadLibraryProperties reads the library.properties of the library folder
+func loadLibraryProperties(libraryPath string) (*paths.Path, *libraryproperties.File, error) {
+	if libraryPath == "" {
+		return nil, nil, &arduino.InvalidArgumentError{Message: tr("Missing library path")}
+	}
+	file := paths.New(libraryPath).Join("library.properties")
+	if !file.Exist() {
+		return nil, nil, &arduino.NotFoundError{Message: tr("Library properties file not found: %s", file)}
+	}
+	props, err := libraryproperties.Load(file)
+	if err != nil {
+		return nil, nil, &arduino.PermissionDeniedError{Message: tr("Error reading %s", file), Cause: err}
+	}
+	return file, props, nil
+}
+
+func toRPCLibraryProperties(fields []*libraryproperties.Field) []*rpc.LibraryProperty {
+	res := []*rpc.LibraryProperty{}
+	for _, field := range fields {
+		res = append(res, &rpc.LibraryProperty{Name: field.Name, Value: field.Value})
+	}
+	return res
+}
+
+// LibraryPropertiesGet returns the fields of the library.properties of a
+// library folder.
+func LibraryPropertiesGet(ctx context.Context, req *rpc.LibraryPropertiesGetRequest) (*rpc.LibraryPropertiesGetResponse, error) {
+	_, props, err := loadLibraryProperties(req.GetLibraryPath())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetNames()) == 0 {
+		return &rpc.LibraryPropertiesGetResponse{Properties: toRPCLibraryProperties(props.Fields())}, nil
+	}
+	res := []*rpc.LibraryProperty{}
+	for _, name := range req.GetNames() {
+		value, ok := props.Get(name)
+		if !ok {
+			return nil, &arduino.NotFoundError{Message: tr("Field %s not found in library.properties", name)}
+		}
+		res = append(res, &rpc.LibraryProperty{Name: name, Value: value})
+	}
+	return &rpc.LibraryPropertiesGetResponse{Properties: res}, nil
+}
+
+// LibraryPropertiesSet changes the fields of the library.properties of a
+// library folder: the fields are removed, then set, then the architectures
+// are changed and finally the version is bumped. The file is written only if
+// all the changes are valid.
+func LibraryPropertiesSet(ctx context.Context, req *rpc.LibraryPropertiesSetRequest) (*rpc.LibraryPropertiesSetResponse, error) {
+	file, props, err := loadLibraryProperties(req.GetLibraryPath())
+	if err != nil {
+		return nil, err
+	}
+	before := props.Fields()
+
+	for _, name := range req.GetUnset() {
+		if _, err := props.Unset(name); err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Cannot remove field %s", name), Cause: err}
+		}
+	}
+	for _, prop := range req.GetProperties() {
+		if err := props.Set(prop.GetName(), prop.GetValue()); err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Cannot set field %s", prop.GetName()), Cause: err}
+		}
+	}
+
+	if len(req.GetAddArchitectures()) > 0 || len(req.GetRemoveArchitectures()) > 0 {
+		archs, ok := props.Get("architectures")
+		if !ok {
+			archs = "*"
+		}
+		if len(req.GetAddArchitectures()) > 0 {
+			if archs, err = libraryproperties.AddArchitectures(archs, req.GetAddArchitectures()); err != nil {
+				return nil, &arduino.InvalidArgumentError{Message: tr("Cannot change the architectures"), Cause: err}
+			}
+		}
+		if len(req.GetRemoveArchitectures()) > 0 {
+			if archs, err = libraryproperties.RemoveArchitectures(archs, req.GetRemoveArchitectures()); err != nil {
+				return nil, &arduino.InvalidArgumentError{Message: tr("Cannot change the architectures"), Cause: err}
+			}
+		}
+		if err := props.Set("architectures", archs); err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Cannot change the architectures"), Cause: err}
+		}
+	}
+
+	if bump := req.GetBumpVersion(); bump != rpc.LibraryVersionBump_LIBRARY_VERSION_BUMP_NONE {
+		for _, prop := range req.GetProperties() {
+			if prop.GetName() == "version" {
+				return nil, &arduino.InvalidArgumentError{Message: tr("Cannot set and bump the version at the same time")}
+			}
+		}
+		part := map[rpc.LibraryVersionBump]string{
+			rpc.LibraryVersionBump_LIBRARY_VERSION_BUMP_MAJOR: libraryproperties.Major,
+			rpc.LibraryVersionBump_LIBRARY_VERSION_BUMP_MINOR: libraryproperties.Minor,
+			rpc.LibraryVersionBump_LIBRARY_VERSION_BUMP_PATCH: libraryproperties.Patch,
+		}[bump]
+		version, _ := props.Get("version")
+		newVersion, err := libraryproperties.BumpVersion(version, part)
+		if err != nil {
+			return nil, &arduino.InvalidVersionError{Cause: err}
+		}
+		if err := props.Set("version", newVersion); err != nil {
+			return nil, &arduino.InvalidVersionError{Cause: err}
+		}
+	}
+
+	after := props.Fields()
+	res := &rpc.LibraryPropertiesSetResponse{Properties: toRPCLibraryProperties(after)}
+	beforeValues := map[string]string{}
+	for _, field := range before {
+		beforeValues[field.Name] = field.Value
+	}
+	afterValues := map[string]string{}
+	for _, field := range after {
+		afterValues[field.Name] = field.Value
+		if value, ok := beforeValues[field.Name]; !ok || value != field.Value {
+			res.Changed = append(res.Changed, &rpc.LibraryProperty{Name: field.Name, Value: field.Value})
+		}
+	}
+	for _, field := range before {
+		if _, ok := afterValues[field.Name]; !ok {
+			res.Changed = append(res.Changed, &rpc.LibraryProperty{Name: field.Name})
+		}
+	}
+
+	if !req.GetDryRun() && len(res.Changed) > 0 {
+		if err := props.Save(file); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error writing %s", file), Cause: err}
+		}
+	}
+	return res, nil
+}
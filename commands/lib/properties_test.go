@@ -0,0 +1,84 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryProperties(t *testing.T) {
+	libDir := paths.New(t.TempDir())
+	require.NoError(t, paths.New("testdata", "check_examples", "MyLib", "library.properties").CopyTo(libDir.Join("library.properties")))
+	original, err := libDir.Join("library.properties").ReadFile()
+	require.NoError(t, err)
+
+	get := func(names ...string) []*rpc.LibraryProperty {
+		res, err := LibraryPropertiesGet(context.Background(), &rpc.LibraryPropertiesGetRequest{LibraryPath: libDir.String(), Names: names})
+		require.NoError(t, err)
+		return res.GetProperties()
+	}
+	require.Len(t, get(), 9)
+	require.Equal(t, "1.0.0", get("version")[0].GetValue())
+	_, err = LibraryPropertiesGet(context.Background(), &rpc.LibraryPropertiesGetRequest{LibraryPath: libDir.String(), Names: []string{"depends"}})
+	require.ErrorAs(t, err, new(*arduino.NotFoundError))
+
+	req := &rpc.LibraryPropertiesSetRequest{
+		LibraryPath:      libDir.String(),
+		Properties:       []*rpc.LibraryProperty{{Name: "depends", Value: "Servo"}},
+		Unset:            []string{"paragraph"},
+		BumpVersion:      rpc.LibraryVersionBump_LIBRARY_VERSION_BUMP_MINOR,
+		AddArchitectures: []string{"avr"},
+		DryRun:           true,
+	}
+	_, err = LibraryPropertiesSet(context.Background(), req)
+	require.ErrorContains(t, err, "the library already supports all the architectures")
+
+	req.AddArchitectures = nil
+	res, err := LibraryPropertiesSet(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, []*rpc.LibraryProperty{
+		{Name: "version", Value: "1.1.0"},
+		{Name: "depends", Value: "Servo"},
+		{Name: "paragraph"},
+	}, res.GetChanged())
+	// The dry run doesn't change the file
+	data, err := libDir.Join("library.properties").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, original, data)
+
+	req.DryRun = false
+	_, err = LibraryPropertiesSet(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", get("version")[0].GetValue())
+	require.Equal(t, "Servo", get("depends")[0].GetValue())
+
+	// Invalid changes are not written
+	_, err = LibraryPropertiesSet(context.Background(), &rpc.LibraryPropertiesSetRequest{
+		LibraryPath: libDir.String(),
+		Properties:  []*rpc.LibraryProperty{{Name: "sentence", Value: "Changed"}, {Name: "url", Value: "arduino.cc"}},
+	})
+	require.ErrorAs(t, err, new(*arduino.InvalidArgumentError))
+	require.Equal(t, "A library to test the check of the examples.", get("sentence")[0].GetValue())
+
+	_, err = LibraryPropertiesGet(context.Background(), &rpc.LibraryPropertiesGetRequest{LibraryPath: libDir.Join("missing").String()})
+	require.ErrorAs(t, err, new(*arduino.NotFoundError))
+}
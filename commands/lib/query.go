@@ -0,0 +1,191 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// LibraryQuery searches the libraries in the libraries index and the installed
+// libraries, merging the index data with the installation state of each library.
+func LibraryQuery(ctx context.Context, req *rpc.LibraryQueryRequest) (*rpc.LibraryQueryResponse, error) {
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	return queryLibraries(req, lm)
+}
+
+func queryLibraries(req *rpc.LibraryQueryRequest, lm *librariesmanager.LibrariesManager) (*rpc.LibraryQueryResponse, error) {
+	query := req.GetSearchArgs()
+	matcher := MatcherFromQueryString(query)
+	if req.GetFuzzy() {
+		matcher = FuzzyMatcherFromQueryString(query)
+	}
+
+	// Group the installed libraries by name, a library not in the index is
+	// matched against its library.properties. When searching for a header the
+	// headers found in the installed libraries are searched too.
+	includes := includeTargetsFromQueryString(query)
+	installed := map[string][]*libraries.Library{}
+	headers := map[string][]string{}
+	for _, libAlternatives := range lm.Libraries {
+		for _, lib := range libAlternatives {
+			if !req.GetIncludeBuiltin() && lib.Location != libraries.User && lib.Location != libraries.Profile {
+				continue
+			}
+			installedLib := installedLibraryAsIndexLibrary(lib)
+			if indexLib := lm.Index.FindIndexedLibrary(lib); indexLib != nil {
+				if !matcher(indexLib) && (len(includes) == 0 || !matcher(installedLib)) {
+					continue
+				}
+			} else if !matcher(installedLib) {
+				continue
+			}
+			installed[lib.Name] = append(installed[lib.Name], lib)
+			headers[lib.Name] = append(headers[lib.Name], installedLib.Latest.ProvidesIncludes...)
+		}
+	}
+
+	names := map[string]bool{}
+	for name := range installed {
+		names[name] = true
+	}
+	if len(includes) > 0 {
+		for _, lib := range lm.Index.FindLibrariesProvidingHeader(includes[0]) {
+			if matcher(lib) {
+				names[lib.Name] = true
+			}
+		}
+	} else {
+		for name, lib := range lm.Index.Libraries {
+			if matcher(lib) {
+				names[name] = true
+			}
+		}
+	}
+	for name := range names {
+		if indexLib, ok := lm.Index.Libraries[name]; ok {
+			headers[name] = append(headers[name], indexLib.Latest.ProvidesIncludes...)
+		}
+	}
+
+	matches := []string{}
+	for name := range names {
+		_, indexed := lm.Index.Libraries[name]
+		switch req.GetFilter() {
+		case rpc.LibraryQueryFilter_LIBRARY_QUERY_FILTER_INSTALLED:
+			if len(installed[name]) == 0 {
+				continue
+			}
+		case rpc.LibraryQueryFilter_LIBRARY_QUERY_FILTER_NOT_INSTALLED:
+			if len(installed[name]) > 0 || !indexed {
+				continue
+			}
+		case rpc.LibraryQueryFilter_LIBRARY_QUERY_FILTER_UPDATABLE:
+			updatable := false
+			for _, lib := range installed[name] {
+				if lib.Location == libraries.User && lm.Index.FindLibraryUpdate(lib) != nil {
+					updatable = true
+				}
+			}
+			if !updatable {
+				continue
+			}
+		}
+		matches = append(matches, name)
+	}
+
+	// Sort by name, but bubble up the libraries that best resolve the searched
+	// headers and the exact matches
+	sort.Slice(matches, func(i, j int) bool {
+		if len(includes) > 0 {
+			rankI := includeMatchRank(matches[i], headers[matches[i]], includes)
+			rankJ := includeMatchRank(matches[j], headers[matches[j]], includes)
+			if rankI != rankJ {
+				return rankI < rankJ
+			}
+		}
+		equalsI := strings.EqualFold(matches[i], query)
+		equalsJ := strings.EqualFold(matches[j], query)
+		if equalsI != equalsJ {
+			return equalsI
+		}
+		return matches[i] < matches[j]
+	})
+
+	// only the requested page of results is converted
+	res := []*rpc.QueriedLibrary{}
+	for _, name := range utils.Paginate(matches, req.GetOffset(), req.GetLimit()) {
+		queried := &rpc.QueriedLibrary{Name: name}
+		if indexLib, ok := lm.Index.Libraries[name]; ok {
+			queried.IndexLibrary = indexLibraryToRPCSearchLibrary(indexLib, req.GetOmitReleasesDetails())
+		}
+		for _, lib := range installed[name] {
+			rpcLib, err := lib.ToRPCLibrary()
+			if err != nil {
+				return nil, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", lib.Name), Cause: err}
+			}
+			installedLib := &rpc.InstalledLibrary{Library: rpcLib}
+			update := lm.Index.FindLibraryUpdate(lib)
+			if update != nil {
+				installedLib.Release = update.ToRPCLibraryRelease()
+			}
+			if lib.Location == libraries.User {
+				queried.InstalledVersion = lib.Version.String()
+				queried.UpdateAvailable = update != nil
+			}
+			queried.Installed = append(queried.Installed, installedLib)
+		}
+		res = append(res, queried)
+	}
+	return &rpc.LibraryQueryResponse{Libraries: res, Total: uint32(len(matches))}, nil
+}
+
+// installedLibraryAsIndexLibrary returns an index library with a single release
+// made of the library.properties and the headers of the installed library, so
+// that it can be matched with the search queries.
+func installedLibraryAsIndexLibrary(lib *libraries.Library) *librariesindex.Library {
+	release := &librariesindex.Release{
+		Author:        lib.Author,
+		Version:       lib.Version,
+		Maintainer:    lib.Maintainer,
+		Sentence:      lib.Sentence,
+		Paragraph:     lib.Paragraph,
+		Website:       lib.Website,
+		Category:      lib.Category,
+		Architectures: lib.Architectures,
+		Types:         lib.Types,
+		License:       lib.License,
+	}
+	// If the "includes" property is missing the headers are searched in the
+	// library source folder
+	release.ProvidesIncludes = lib.DeclaredHeaders()
+	if len(release.ProvidesIncludes) == 0 {
+		release.ProvidesIncludes, _ = lib.SourceHeaders()
+	}
+	return &librariesindex.Library{Name: lib.Name, Latest: release}
+}
@@ -0,0 +1,92 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryLibraries(t *testing.T) {
+	lm := librariesmanager.NewLibraryManager(fullIndexPath, nil)
+	lm.LoadIndex()
+
+	install := func(name, version, sentence string, location libraries.LibraryLocation) {
+		dir := paths.New(t.TempDir(), name)
+		require.NoError(t, dir.Join("src").MkdirAll())
+		require.NoError(t, dir.Join("src", name+".h").WriteFile(nil))
+		require.NoError(t, dir.Join("library.properties").WriteFile([]byte(
+			"name="+name+"\nversion="+version+"\nsentence="+sentence+"\n")))
+		lib, err := libraries.Load(dir, location)
+		require.NoError(t, err)
+		lm.Libraries[name] = append(lm.Libraries[name], lib)
+	}
+	install("Servo", "1.0.0", "Controls servo motors", libraries.User)
+	install("Servo", "1.2.1", "Controls servo motors", libraries.IDEBuiltIn)
+	install("PrivateServoHelper", "0.1.0", "Private servo utilities", libraries.User)
+
+	query := func(req *rpc.LibraryQueryRequest) map[string]*rpc.QueriedLibrary {
+		resp, err := queryLibraries(req, lm)
+		require.NoError(t, err)
+		res := map[string]*rpc.QueriedLibrary{}
+		for _, lib := range resp.GetLibraries() {
+			res[lib.GetName()] = lib
+		}
+		return res
+	}
+
+	res := query(&rpc.LibraryQueryRequest{SearchArgs: "servo", OmitReleasesDetails: true})
+	require.Contains(t, res, "Servo")
+	require.Contains(t, res, "PrivateServoHelper")
+	servo := res["Servo"]
+	require.NotNil(t, servo.GetIndexLibrary())
+	require.Empty(t, servo.GetIndexLibrary().GetReleases())
+	require.Len(t, servo.GetInstalled(), 1)
+	require.Equal(t, "1.0.0", servo.GetInstalledVersion())
+	require.True(t, servo.GetUpdateAvailable())
+	require.Equal(t, servo.GetIndexLibrary().GetLatest().GetVersion(), servo.GetInstalled()[0].GetRelease().GetVersion())
+	private := res["PrivateServoHelper"]
+	require.Nil(t, private.GetIndexLibrary())
+	require.Equal(t, "0.1.0", private.GetInstalledVersion())
+	require.False(t, private.GetUpdateAvailable())
+
+	// The exact match is the first result
+	resp, err := queryLibraries(&rpc.LibraryQueryRequest{SearchArgs: "servo"}, lm)
+	require.NoError(t, err)
+	require.Equal(t, "Servo", resp.GetLibraries()[0].GetName())
+
+	res = query(&rpc.LibraryQueryRequest{SearchArgs: "servo", IncludeBuiltin: true})
+	require.Len(t, res["Servo"].GetInstalled(), 2)
+	require.Equal(t, "1.0.0", res["Servo"].GetInstalledVersion())
+
+	res = query(&rpc.LibraryQueryRequest{SearchArgs: "servo", Filter: rpc.LibraryQueryFilter_LIBRARY_QUERY_FILTER_INSTALLED})
+	require.Len(t, res, 2)
+	res = query(&rpc.LibraryQueryRequest{SearchArgs: "servo", Filter: rpc.LibraryQueryFilter_LIBRARY_QUERY_FILTER_UPDATABLE})
+	require.Len(t, res, 1)
+	require.Contains(t, res, "Servo")
+	res = query(&rpc.LibraryQueryRequest{SearchArgs: "servo", Filter: rpc.LibraryQueryFilter_LIBRARY_QUERY_FILTER_NOT_INSTALLED})
+	require.NotContains(t, res, "Servo")
+	require.NotContains(t, res, "PrivateServoHelper")
+	for _, lib := range res {
+		require.Empty(t, lib.GetInstalled())
+		require.NotNil(t, lib.GetIndexLibrary())
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
@@ -41,15 +42,21 @@ func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDepe
 
 	// Extract all installed libraries
 	installedLibs := map[string]*libraries.Library{}
+	installedLibsList := []*libraries.Library{}
 	for _, lib := range listLibraries(lm, false, false) {
 		installedLibs[lib.Library.Name] = lib.Library
+		installedLibsList = append(installedLibsList, lib.Library)
 	}
 
 	// Resolve all dependencies...
-	deps := lm.Index.ResolveDependencies(reqLibRelease)
+	strategy := librariesindex.ResolutionLatest
+	if req.GetResolution() == rpc.LibraryResolutionStrategy_LIBRARY_RESOLUTION_STRATEGY_MINIMAL {
+		strategy = librariesindex.ResolutionMinimal
+	}
+	deps, err := lm.Index.ResolveDependenciesWithStrategy(reqLibRelease, installedLibsList, strategy)
 
 	// If no solution has been found
-	if len(deps) == 0 {
+	if err != nil {
 		// Check if there is a problem with the first level deps
 		for _, directDep := range reqLibRelease.GetDependencies() {
 			if _, ok := lm.Index.Libraries[directDep.GetName()]; !ok {
@@ -59,6 +66,10 @@ func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDepe
 		}
 
 		// Otherwise there is no possible solution, the depends field has an invalid formula
+		// or the installed libraries require incompatible versions
+		if !errors.Is(err, librariesindex.ErrNoSolution) {
+			return nil, &arduino.LibraryDependenciesResolutionFailedError{Cause: err}
+		}
 		return nil, &arduino.LibraryDependenciesResolutionFailedError{}
 	}
 
@@ -0,0 +1,257 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/symbols"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// LibraryResolveSymbol searches the declarations of a symbol in the public
+// headers of the installed libraries and, if requested, of the library
+// archives in the downloads cache.
+func LibraryResolveSymbol(ctx context.Context, req *rpc.LibraryResolveSymbolRequest) (*rpc.LibraryResolveSymbolResponse, error) {
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	return resolveSymbol(req, lm)
+}
+
+func resolveSymbol(req *rpc.LibraryResolveSymbolRequest, lm *librariesmanager.LibrariesManager) (*rpc.LibraryResolveSymbolResponse, error) {
+	symbol := req.GetSymbol()
+	if !symbols.IsIdentifier(symbol) {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid symbol '%s'", symbol)}
+	}
+
+	res := []*rpc.LibrarySymbolDeclaration{}
+	for _, libAlternatives := range lm.Libraries {
+		for _, lib := range libAlternatives {
+			res = append(res, resolveSymbolInInstalledLibrary(lib, symbol)...)
+		}
+	}
+	if req.GetIncludeDownloaded() {
+		res = append(res, resolveSymbolInDownloadedLibraries(lm, symbol)...)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].GetLibrary() != res[j].GetLibrary() {
+			return res[i].GetLibrary() < res[j].GetLibrary()
+		}
+		if res[i].GetPath() != res[j].GetPath() {
+			return res[i].GetPath() < res[j].GetPath()
+		}
+		if res[i].GetHeader() != res[j].GetHeader() {
+			return res[i].GetHeader() < res[j].GetHeader()
+		}
+		return res[i].GetLine() < res[j].GetLine()
+	})
+	return &rpc.LibraryResolveSymbolResponse{Declarations: res}, nil
+}
+
+// resolveSymbolInInstalledLibrary returns the declarations of the symbol in
+// the public headers of the library, that are the headers in the source
+// folder of the library.
+func resolveSymbolInInstalledLibrary(lib *libraries.Library, symbol string) []*rpc.LibrarySymbolDeclaration {
+	headers, err := lib.SourceHeaders()
+	if err != nil {
+		logrus.WithError(err).Warnf("Error reading the headers of library %s", lib.Name)
+		return nil
+	}
+	version := ""
+	if lib.Version != nil {
+		version = lib.Version.String()
+	}
+	res := []*rpc.LibrarySymbolDeclaration{}
+	for _, header := range headers {
+		headerPath := lib.SourceDir.Join(header)
+		source, err := headerPath.ReadFile()
+		if err != nil {
+			logrus.WithError(err).Warnf("Error reading header %s", headerPath)
+			continue
+		}
+		relHeader := header
+		if rel, err := headerPath.RelFrom(lib.InstallDir); err == nil {
+			relHeader = filepath.ToSlash(rel.String())
+		}
+		for _, decl := range matchingDeclarations(source, symbol) {
+			res = append(res, &rpc.LibrarySymbolDeclaration{
+				Library:       lib.Name,
+				Version:       version,
+				Location:      lib.Location.ToRPCLibraryLocation(),
+				Path:          lib.InstallDir.String(),
+				Header:        relHeader,
+				Line:          uint32(decl.Line),
+				Kind:          toRPCSymbolKind(decl.Kind),
+				QualifiedName: decl.QualifiedName(),
+			})
+		}
+	}
+	return res
+}
+
+// resolveSymbolInDownloadedLibraries returns the declarations of the symbol
+// in the public headers of the library archives in the downloads cache.
+func resolveSymbolInDownloadedLibraries(lm *librariesmanager.LibrariesManager, symbol string) []*rpc.LibrarySymbolDeclaration {
+	if lm.DownloadsDir == nil || lm.Index == nil {
+		return nil
+	}
+	archives := map[string]*librariesindex.Release{}
+	for _, lib := range lm.Index.Libraries {
+		for _, release := range lib.Releases {
+			if release.Resource != nil {
+				archives[path.Join(release.Resource.CachePath, release.Resource.ArchiveFileName)] = release
+			}
+		}
+	}
+
+	// The archives of the installed libraries are already searched
+	installed := map[string]bool{}
+	for _, libAlternatives := range lm.Libraries {
+		for _, lib := range libAlternatives {
+			if lib.Version != nil {
+				installed[lib.Name+"@"+lib.Version.String()] = true
+			}
+		}
+	}
+
+	res := []*rpc.LibrarySymbolDeclaration{}
+	files, err := lm.DownloadsDir.Join("libraries").ReadDir()
+	if err != nil {
+		return res
+	}
+	files.FilterSuffix(".zip")
+	for _, file := range files {
+		release, ok := archives[path.Join("libraries", file.Base())]
+		if !ok || installed[release.String()] {
+			continue
+		}
+		decls, err := resolveSymbolInArchive(file.String(), symbol)
+		if err != nil {
+			logrus.WithError(err).Warnf("Error reading library archive %s", file)
+			continue
+		}
+		for _, decl := range decls {
+			decl.Library = release.Library.Name
+			decl.Version = release.Version.String()
+			decl.Downloaded = true
+			decl.Path = file.String()
+			res = append(res, decl)
+		}
+	}
+	return res
+}
+
+// resolveSymbolInArchive returns the declarations of the symbol in the public
+// headers of a library archive: the headers in the src folder for the
+// libraries with the recursive layout, otherwise the headers in the root
+// folder of the library.
+func resolveSymbolInArchive(archive string, symbol string) ([]*rpc.LibrarySymbolDeclaration, error) {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	// The library is in the only top-level folder of the archive
+	root := ""
+	if len(zr.File) > 0 {
+		if idx := strings.Index(zr.File[0].Name, "/"); idx != -1 {
+			root = zr.File[0].Name[:idx+1]
+		}
+	}
+	sourceDir := root
+	for _, file := range zr.File {
+		if strings.HasPrefix(file.Name, root+"src/") {
+			sourceDir = root + "src/"
+			break
+		}
+	}
+
+	res := []*rpc.LibrarySymbolDeclaration{}
+	for _, file := range zr.File {
+		name := strings.TrimPrefix(file.Name, sourceDir)
+		if !strings.HasPrefix(file.Name, sourceDir) || strings.Contains(name, "/") || !globals.HeaderFilesValidExtensions[path.Ext(name)] {
+			continue
+		}
+		r, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		source, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range matchingDeclarations(source, symbol) {
+			res = append(res, &rpc.LibrarySymbolDeclaration{
+				Header:        strings.TrimPrefix(file.Name, root),
+				Line:          uint32(decl.Line),
+				Kind:          toRPCSymbolKind(decl.Kind),
+				QualifiedName: decl.QualifiedName(),
+			})
+		}
+	}
+	return res, nil
+}
+
+func matchingDeclarations(source []byte, symbol string) []*symbols.Declaration {
+	// Avoid scanning the headers not containing the symbol at all
+	name := symbol
+	if idx := strings.LastIndex(symbol, "::"); idx != -1 {
+		name = symbol[idx+2:]
+	}
+	if !bytes.Contains(source, []byte(name)) {
+		return nil
+	}
+	res := []*symbols.Declaration{}
+	for _, decl := range symbols.Scan(source) {
+		if decl.Matches(symbol) {
+			res = append(res, decl)
+		}
+	}
+	return res
+}
+
+func toRPCSymbolKind(kind symbols.Kind) rpc.LibrarySymbolKind {
+	switch kind {
+	case symbols.Type:
+		return rpc.LibrarySymbolKind_LIBRARY_SYMBOL_KIND_TYPE
+	case symbols.Function:
+		return rpc.LibrarySymbolKind_LIBRARY_SYMBOL_KIND_FUNCTION
+	case symbols.Variable:
+		return rpc.LibrarySymbolKind_LIBRARY_SYMBOL_KIND_VARIABLE
+	case symbols.Macro:
+		return rpc.LibrarySymbolKind_LIBRARY_SYMBOL_KIND_MACRO
+	}
+	return rpc.LibrarySymbolKind_LIBRARY_SYMBOL_KIND_CLASS
+}
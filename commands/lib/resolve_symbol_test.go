@@ -0,0 +1,91 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"archive/zip"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSymbol(t *testing.T) {
+	downloadsDir := paths.New(t.TempDir())
+	lm := librariesmanager.NewLibraryManager(fullIndexPath, downloadsDir)
+	lm.LoadIndex()
+
+	install := func(name, version string, headers map[string]string) {
+		dir := paths.New(t.TempDir(), name)
+		require.NoError(t, dir.MkdirAll())
+		require.NoError(t, dir.Join("library.properties").WriteFile([]byte("name="+name+"\nversion="+version+"\n")))
+		for header, source := range headers {
+			require.NoError(t, dir.Join(header).Parent().MkdirAll())
+			require.NoError(t, dir.Join(header).WriteFile([]byte(source)))
+		}
+		lib, err := libraries.Load(dir, libraries.User)
+		require.NoError(t, err)
+		lm.Libraries[name] = append(lm.Libraries[name], lib)
+	}
+	install("MySD", "1.0.0", map[string]string{
+		"src/MySD.h":          "namespace SDLib {\nclass File {};\n}\nextern SDLib::SDClass SD;\n",
+		"src/utility/SdFat.h": "class SdFat {};\n",
+	})
+	install("OtherSD", "2.0.0", map[string]string{
+		"OtherSD.h": "#define SD_CS 4\nclass SDClass {};\nextern SDClass SD;\n",
+	})
+
+	// A downloaded archive of a library in the index
+	require.NoError(t, downloadsDir.Join("libraries").MkdirAll())
+	f, err := downloadsDir.Join("libraries", "XMC_Servo-1.0.1.zip").Create()
+	require.NoError(t, err)
+	zw := zip.NewWriter(f)
+	w, err := zw.Create("XMC_Servo-1.0.1/src/XMC_Servo.h")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("int SD_CS(void);\n"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+
+	resolve := func(symbol string, includeDownloaded bool) []string {
+		resp, err := resolveSymbol(&rpc.LibraryResolveSymbolRequest{Symbol: symbol, IncludeDownloaded: includeDownloaded}, lm)
+		require.NoError(t, err)
+		res := []string{}
+		for _, decl := range resp.GetDeclarations() {
+			res = append(res, decl.GetLibrary()+" "+decl.GetHeader()+" "+decl.GetKind().String()+" "+decl.GetQualifiedName())
+		}
+		return res
+	}
+
+	require.Equal(t, []string{
+		"MySD src/MySD.h LIBRARY_SYMBOL_KIND_VARIABLE SD",
+		"OtherSD OtherSD.h LIBRARY_SYMBOL_KIND_VARIABLE SD",
+	}, resolve("SD", false))
+	require.Equal(t, []string{"MySD src/MySD.h LIBRARY_SYMBOL_KIND_CLASS SDLib::File"}, resolve("SDLib::File", false))
+	// Only the public headers are searched
+	require.Empty(t, resolve("SdFat", false))
+	require.Equal(t, []string{"OtherSD OtherSD.h LIBRARY_SYMBOL_KIND_MACRO SD_CS"}, resolve("SD_CS", false))
+	require.Equal(t, []string{
+		"OtherSD OtherSD.h LIBRARY_SYMBOL_KIND_MACRO SD_CS",
+		"XMC_Servo src/XMC_Servo.h LIBRARY_SYMBOL_KIND_FUNCTION SD_CS",
+	}, resolve("SD_CS", true))
+
+	_, err = resolveSymbol(&rpc.LibraryResolveSymbolRequest{Symbol: "SD.h"}, lm)
+	require.Error(t, err)
+}
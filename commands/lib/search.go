@@ -23,6 +23,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	semver "go.bug.st/relaxed-semver"
@@ -38,30 +39,61 @@ func LibrarySearch(ctx context.Context, req *rpc.LibrarySearchRequest) (*rpc.Lib
 }
 
 func searchLibrary(req *rpc.LibrarySearchRequest, lm *librariesmanager.LibrariesManager) *rpc.LibrarySearchResponse {
-	res := []*rpc.SearchedLibrary{}
+	matches := []*librariesindex.Library{}
 	query := req.GetSearchArgs()
 	matcher := MatcherFromQueryString(query)
+	if req.GetFuzzy() {
+		matcher = FuzzyMatcherFromQueryString(query)
+	}
 
-	for _, lib := range lm.Index.Libraries {
-		if matcher(lib) {
-			res = append(res, indexLibraryToRPCSearchLibrary(lib, req.GetOmitReleasesDetails()))
+	// When searching for a header only the libraries providing it are
+	// candidates, they are looked up in the headers index
+	includes := includeTargetsFromQueryString(query)
+	if len(includes) > 0 {
+		for _, lib := range lm.Index.FindLibrariesProvidingHeader(includes[0]) {
+			if matcher(lib) {
+				matches = append(matches, lib)
+			}
+		}
+	} else {
+		for _, lib := range lm.Index.Libraries {
+			if matcher(lib) {
+				matches = append(matches, lib)
+			}
 		}
 	}
 
 	// get a sorted slice of results
-	sort.Slice(res, func(i, j int) bool {
+	sort.Slice(matches, func(i, j int) bool {
+		// Bubble up the libraries that best resolve the searched headers
+		if len(includes) > 0 {
+			rankI := includeMatchRank(matches[i].Name, matches[i].Latest.ProvidesIncludes, includes)
+			rankJ := includeMatchRank(matches[j].Name, matches[j].Latest.ProvidesIncludes, includes)
+			if rankI != rankJ {
+				return rankI < rankJ
+			}
+		}
 		// Sort by name, but bubble up exact matches
-		equalsI := strings.EqualFold(res[i].Name, query)
-		equalsJ := strings.EqualFold(res[j].Name, query)
+		equalsI := strings.EqualFold(matches[i].Name, query)
+		equalsJ := strings.EqualFold(matches[j].Name, query)
 		if equalsI && !equalsJ {
 			return true
 		} else if !equalsI && equalsJ {
 			return false
 		}
-		return res[i].Name < res[j].Name
+		return matches[i].Name < matches[j].Name
 	})
 
-	return &rpc.LibrarySearchResponse{Libraries: res, Status: rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_SUCCESS}
+	// only the requested page of results is converted
+	res := []*rpc.SearchedLibrary{}
+	for _, lib := range utils.Paginate(matches, req.GetOffset(), req.GetLimit()) {
+		res = append(res, indexLibraryToRPCSearchLibrary(lib, req.GetOmitReleasesDetails()))
+	}
+	return &rpc.LibrarySearchResponse{
+		Libraries: res,
+		Status:    rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_SUCCESS,
+		Total:     uint32(len(matches)),
+	}
 }
 
 // indexLibraryToRPCSearchLibrary converts a librariindex.Library to rpc.SearchLibrary
@@ -16,6 +16,7 @@
 package lib
 
 import (
+	"path"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
@@ -25,6 +26,16 @@ import (
 // matcherTokensFromQueryString parses the query string into tokens of interest
 // for the qualifier-value pattern matching.
 func matcherTokensFromQueryString(query string) []string {
+	tokens := queryTokens(query)
+	for i, token := range tokens {
+		tokens[i] = strings.ToLower(token)
+	}
+	return tokens
+}
+
+// queryTokens splits the query string into tokens, honoring quotes and
+// escapes, preserving the case of the tokens.
+func queryTokens(query string) []string {
 	escaped := false
 	quoted := false
 	tokens := []string{}
@@ -45,7 +56,7 @@ func matcherTokensFromQueryString(query string) []string {
 				sb.WriteRune(r)
 			}
 		} else if !quoted && r == ' ' {
-			tokens = append(tokens, strings.ToLower(sb.String()))
+			tokens = append(tokens, sb.String())
 			sb.Reset()
 		} else {
 			sb.WriteRune(r)
@@ -53,7 +64,7 @@ func matcherTokensFromQueryString(query string) []string {
 		escaped = false
 	}
 	if sb.Len() > 0 {
-		tokens = append(tokens, strings.ToLower(sb.String()))
+		tokens = append(tokens, sb.String())
 	}
 
 	return tokens
@@ -97,13 +108,24 @@ var qualifiers map[string]func(lib *librariesindex.Library) string = map[string]
 // MatcherFromQueryString returns a closure that takes a library as a
 // parameter and returns true if the library matches the query.
 func MatcherFromQueryString(query string) func(*librariesindex.Library) bool {
+	return matcherFromQueryString(query, utils.Match)
+}
+
+// FuzzyMatcherFromQueryString works like MatcherFromQueryString, but the
+// search terms tolerate typos (see utils.MatchFuzzy). The terms with the
+// equals separator still require an exact match.
+func FuzzyMatcherFromQueryString(query string) func(*librariesindex.Library) bool {
+	return matcherFromQueryString(query, utils.MatchFuzzy)
+}
+
+func matcherFromQueryString(query string, match func(string, []string) bool) func(*librariesindex.Library) bool {
 	// A qv-query is one using <qualifier>[:=]<value> syntax.
 	qvQuery := strings.Contains(query, ":") || strings.Contains(query, "=")
 
 	if !qvQuery {
 		queryTerms := utils.SearchTermsFromQueryString(query)
 		return func(lib *librariesindex.Library) bool {
-			return utils.Match(defaultLibraryMatchExtractor(lib), queryTerms)
+			return match(defaultLibraryMatchExtractor(lib), queryTerms)
 		}
 	}
 
@@ -114,10 +136,15 @@ func MatcherFromQueryString(query string) func(*librariesindex.Library) bool {
 		for _, term := range queryTerms {
 			if sepIdx := strings.IndexAny(term, ":="); sepIdx != -1 {
 				qualifier, separator, target := term[:sepIdx], term[sepIdx], term[sepIdx+1:]
+				if qualifier == "include" {
+					// The included headers are always resolved exactly
+					matched = (matched && lib.Latest != nil && providesHeader(lib.Latest.ProvidesIncludes, target))
+					continue
+				}
 				if extractor, ok := qualifiers[qualifier]; ok {
 					switch separator {
 					case ':':
-						matched = (matched && utils.Match(extractor(lib), []string{target}))
+						matched = (matched && match(extractor(lib), []string{target}))
 						continue
 					case '=':
 						matched = (matched && strings.ToLower(extractor(lib)) == target)
@@ -128,8 +155,61 @@ func MatcherFromQueryString(query string) func(*librariesindex.Library) bool {
 			// We perform the usual match in the following cases:
 			// 1. Unknown qualifier names revert to basic search terms.
 			// 2. Terms that do not use qv-syntax.
-			matched = (matched && utils.Match(defaultLibraryMatchExtractor(lib), []string{term}))
+			matched = (matched && match(defaultLibraryMatchExtractor(lib), []string{term}))
 		}
 		return matched
 	}
 }
+
+// includeTargetsFromQueryString returns the headers searched with the include
+// qualifier in the query, e.g. "SD.h" for "include:SD.h", preserving their case.
+func includeTargetsFromQueryString(query string) []string {
+	if !strings.Contains(query, ":") && !strings.Contains(query, "=") {
+		return nil
+	}
+	res := []string{}
+	for _, token := range queryTokens(query) {
+		if sepIdx := strings.IndexAny(token, ":="); sepIdx != -1 && strings.EqualFold(token[:sepIdx], "include") {
+			res = append(res, token[sepIdx+1:])
+		}
+	}
+	return res
+}
+
+// providesHeader returns true if one of the headers is the target header,
+// compared case-insensitively. A target without extension matches the headers
+// with the same name and any extension, e.g. "sd" matches "SD.h".
+func providesHeader(headers []string, target string) bool {
+	for _, header := range headers {
+		if strings.EqualFold(header, target) {
+			return true
+		}
+		if path.Ext(target) == "" && strings.EqualFold(strings.TrimSuffix(header, path.Ext(header)), target) {
+			return true
+		}
+	}
+	return false
+}
+
+// includeMatchRank ranks how well a library resolves the searched headers, the
+// lower the better: the libraries providing the headers with the exact same
+// case come first and, among those, the libraries named after the header
+// (e.g. SD for SD.h) are preferred.
+func includeMatchRank(name string, headers []string, includes []string) int {
+	rank := 0
+	for _, include := range includes {
+		exact := false
+		for _, header := range headers {
+			if header == include || (path.Ext(include) == "" && strings.TrimSuffix(header, path.Ext(header)) == include) {
+				exact = true
+			}
+		}
+		if !exact {
+			rank += 2
+		}
+		if !strings.EqualFold(name, strings.TrimSuffix(include, path.Ext(include))) {
+			rank++
+		}
+	}
+	return rank
+}
@@ -204,3 +204,76 @@ func TestSearchLibraryWithQualifiers(t *testing.T) {
 	require.Len(t, res, 1)
 	require.Equal(t, "Painless Mesh", res[0])
 }
+
+func TestSearchLibraryPagination(t *testing.T) {
+	lm := librariesmanager.NewLibraryManager(fullIndexPath, nil)
+	lm.LoadIndex()
+
+	all := searchLibrary(&rpc.LibrarySearchRequest{SearchArgs: "servo", OmitReleasesDetails: true}, lm)
+	require.Greater(t, len(all.GetLibraries()), 10)
+	require.EqualValues(t, len(all.GetLibraries()), all.GetTotal())
+
+	page := searchLibrary(&rpc.LibrarySearchRequest{SearchArgs: "servo", OmitReleasesDetails: true, Offset: 5, Limit: 3}, lm)
+	require.Equal(t, all.GetTotal(), page.GetTotal())
+	require.Len(t, page.GetLibraries(), 3)
+	for i, lib := range page.GetLibraries() {
+		require.Equal(t, all.GetLibraries()[5+i].GetName(), lib.GetName())
+	}
+
+	last := searchLibrary(&rpc.LibrarySearchRequest{SearchArgs: "servo", Offset: all.GetTotal() - 1, Limit: 3}, lm)
+	require.Len(t, last.GetLibraries(), 1)
+	require.Empty(t, searchLibrary(&rpc.LibrarySearchRequest{SearchArgs: "servo", Offset: all.GetTotal() + 10}, lm).GetLibraries())
+}
+
+func TestSearchLibraryFuzzy(t *testing.T) {
+	lm := librariesmanager.NewLibraryManager(fullIndexPath, nil)
+	lm.LoadIndex()
+
+	query := func(q string, fuzzy bool) []string {
+		libs := []string{}
+		for _, lib := range searchLibrary(&rpc.LibrarySearchRequest{SearchArgs: q, Fuzzy: fuzzy, OmitReleasesDetails: true}, lm).Libraries {
+			libs = append(libs, lib.Name)
+		}
+		return libs
+	}
+
+	require.Empty(t, query("Adafrut GFX", false))
+	require.Contains(t, query("Adafrut GFX", true), "Adafruit GFX Library")
+	require.Contains(t, query("name:Adafrut", true), "Adafruit GFX Library")
+	// The exact matches are found also with fuzzy matching
+	require.Subset(t, query("Adafruit GFX", true), query("Adafruit GFX", false))
+	// Short terms and exact qualifiers don't tolerate typos
+	require.Empty(t, query("name=\"Adafrut GFX Library\"", true))
+}
+
+func TestSearchLibraryInclude(t *testing.T) {
+	lm := librariesmanager.NewLibraryManager(fullIndexPath, nil)
+	lm.LoadIndex()
+
+	query := func(q string) []string {
+		libs := []string{}
+		for _, lib := range searchLibrary(&rpc.LibrarySearchRequest{SearchArgs: q, OmitReleasesDetails: true}, lm).Libraries {
+			libs = append(libs, lib.Name)
+		}
+		return libs
+	}
+
+	servo := []string{"InkyBoard", "SlowMotionServo", "XMC_Servo"}
+	require.Equal(t, servo, query("include:Servo.h"))
+	require.Equal(t, servo, query("include:servo"))
+	require.Equal(t, servo, query("include=SERVO.H"))
+	require.Equal(t, []string{"XMC_Servo"}, query("include:Servo.h name:xmc"))
+	// Unlike provides, the header must match exactly
+	require.Greater(t, len(query("provides:servo")), len(servo))
+	require.Empty(t, query("include:Serv.h"))
+}
+
+func TestIncludeMatchRank(t *testing.T) {
+	require.Equal(t, 0, includeMatchRank("SD", []string{"SD.h"}, []string{"SD.h"}))
+	require.Equal(t, 1, includeMatchRank("SdFat", []string{"SdFat.h", "SD.h"}, []string{"SD.h"}))
+	require.Equal(t, 2, includeMatchRank("sd", []string{"sd.h"}, []string{"SD.h"}))
+	require.Equal(t, 3, includeMatchRank("FatFs", []string{"sd.h"}, []string{"SD.h"}))
+	require.Equal(t, 0, includeMatchRank("SD", []string{"SD.h"}, []string{"SD"}))
+	require.Equal(t, []string{"SD.h"}, includeTargetsFromQueryString(`include:SD.h name:sd`))
+	require.Empty(t, includeTargetsFromQueryString("sd card"))
+}
@@ -0,0 +1,11 @@
+// This example uses the native USB port.
+//
+// check-examples: include arduino:samd:*
+// check-examples: exclude arduino:samd:mkr1000, arduino:samd:mkrzero
+#include <MyLib.h>
+
+void setup() {
+  SerialUSB.println(myLib());
+}
+
+void loop() {}
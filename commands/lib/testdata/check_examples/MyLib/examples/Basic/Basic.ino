@@ -0,0 +1,8 @@
+// check-examples: exclude arduino:avr:nano
+#include <MyLib.h>
+
+void setup() {
+  myLib();
+}
+
+void loop() {}
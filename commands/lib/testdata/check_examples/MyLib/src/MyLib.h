@@ -0,0 +1,3 @@
+#pragma once
+
+inline int myLib() { return 42; }
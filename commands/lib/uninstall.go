@@ -27,6 +27,12 @@ import (
 
 // LibraryUninstall FIXMEDOC
 func LibraryUninstall(ctx context.Context, req *rpc.LibraryUninstallRequest, taskCB rpc.TaskProgressCB) error {
+	releaseResources, err := instances.AcquireWrite(req.GetInstance(), "LibraryUninstall", instances.Libraries)
+	if err != nil {
+		return err
+	}
+	defer releaseResources()
+
 	lm := instances.GetLibraryManager(req.GetInstance())
 	ref, err := createLibIndexReference(lm, req)
 	if err != nil {
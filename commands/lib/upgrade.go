@@ -17,25 +17,35 @@ package lib
 
 import (
 	"context"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
 
 // LibraryUpgradeAll upgrades all the available libraries
-func LibraryUpgradeAll(req *rpc.LibraryUpgradeAllRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+func LibraryUpgradeAll(ctx context.Context, req *rpc.LibraryUpgradeAllRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
 	lm := instances.GetLibraryManager(req.GetInstance())
 	if lm == nil {
 		return &arduino.InvalidInstanceError{}
 	}
 
-	if err := upgrade(req.Instance, listLibraries(lm, true, false), downloadCB, taskCB); err != nil {
+	// The libraries installed from git are upgraded from their repository
+	libs := listLibraries(lm, true, false)
+	for _, lib := range listLibraries(lm, false, false) {
+		if lib.Library.InstallSource == libraries.GitSource && lib.Available == nil {
+			libs = append(libs, lib)
+		}
+	}
+	if err := upgrade(ctx, req.Instance, libs, downloadCB, taskCB); err != nil {
 		return err
 	}
 
-	if err := commands.Init(&rpc.InitRequest{Instance: req.GetInstance()}, nil); err != nil {
+	if err := commands.Init(ctx, &rpc.InitRequest{Instance: req.GetInstance()}, nil); err != nil {
 		return err
 	}
 
@@ -56,17 +66,47 @@ func LibraryUpgrade(ctx context.Context, req *rpc.LibraryUpgradeRequest, downloa
 		// library not installed...
 		return &arduino.LibraryNotFoundError{Library: name}
 	}
-	if lib.Available == nil {
+	if lib.Available == nil && lib.Library.InstallSource != libraries.GitSource {
 		taskCB(&rpc.TaskProgress{Message: tr("Library %s is already at the latest version", name), Completed: true})
 		return nil
 	}
 
 	// Install update
-	return upgrade(req.Instance, []*installedLib{lib}, downloadCB, taskCB)
+	if err := upgrade(ctx, req.Instance, []*installedLib{lib}, downloadCB, taskCB); err != nil {
+		return err
+	}
+	if lib.Library.InstallSource == libraries.GitSource {
+		// The git installs are not done through LibraryInstall, that reloads the libraries
+		return commands.Init(ctx, &rpc.InitRequest{Instance: req.GetInstance()}, nil)
+	}
+	return nil
 }
 
-func upgrade(instance *rpc.Instance, libs []*installedLib, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+// upgrade upgrades the libraries according to their install source: the
+// libraries installed from the index or copied by the user are upgraded to
+// the latest release of the index, the libraries installed from git are
+// installed again from their repository, and the libraries installed from
+// a zip archive are skipped.
+func upgrade(ctx context.Context, instance *rpc.Instance, libs []*installedLib, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
 	for _, lib := range libs {
+		switch lib.Library.InstallSource {
+		case libraries.ZipSource:
+			taskCB(&rpc.TaskProgress{Message: tr("Library %s has been installed from a zip archive, install the new archive to upgrade it", lib.Library), Completed: true})
+			continue
+		case libraries.GitSource:
+			taskCB(&rpc.TaskProgress{Name: tr("Upgrading %[1]s from %[2]s", lib.Library, lib.Library.InstallSourceURL)})
+			if modified, _, _ := librariesmanager.ModifiedFiles(lib.Library); len(modified) > 0 {
+				taskCB(&rpc.TaskProgress{Message: tr("WARNING the local changes to the files of %[1]s will be lost: %[2]s", lib.Library, strings.Join(modified, ", "))})
+			}
+			if err := GitLibraryInstall(ctx, &rpc.GitLibraryInstallRequest{
+				Instance:  instance,
+				Url:       lib.Library.InstallSourceURL,
+				Overwrite: true,
+			}, taskCB); err != nil {
+				return err
+			}
+			continue
+		}
 		libInstallReq := &rpc.LibraryInstallRequest{
 			Instance:    instance,
 			Name:        lib.Library.Name,
@@ -74,7 +114,7 @@ func upgrade(instance *rpc.Instance, libs []*installedLib, downloadCB rpc.Downlo
 			NoDeps:      false,
 			NoOverwrite: false,
 		}
-		err := LibraryInstall(context.Background(), libInstallReq, downloadCB, taskCB)
+		err := LibraryInstall(ctx, libInstallReq, downloadCB, taskCB)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesusage"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// LibraryUsage returns the usage of the installed libraries by the compilations
+func LibraryUsage(ctx context.Context, req *rpc.LibraryUsageRequest) (*rpc.LibraryUsageResponse, error) {
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	db, err := librariesusage.Load(configuration.LibrariesUsageFile(configuration.Settings))
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error reading the usage of the libraries"), Cause: err}
+	}
+
+	res := &rpc.LibraryUsageResponse{
+		Libraries:        []*rpc.InstalledLibrary{},
+		RecordingStarted: db.Created.Unix(),
+	}
+	for _, lib := range listLibraries(lm, false, req.GetAll()) {
+		rpcLib, err := lib.Library.ToRPCLibrary()
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", lib.Library.Name), Cause: err}
+		}
+		res.Libraries = append(res.Libraries, &rpc.InstalledLibrary{
+			Library: rpcLib,
+			Usage:   libraryUsageToRPC(db, lib.Library),
+		})
+	}
+	return res, nil
+}
+
+func libraryUsageToRPC(db *librariesusage.Database, lib *libraries.Library) *rpc.LibraryUsage {
+	res := &rpc.LibraryUsage{LastActivity: db.LastActivity(lib).Unix()}
+	if usage := db.Get(lib); usage != nil {
+		res.LastUsed = usage.LastUsed.Unix()
+		res.Compiles = uint32(usage.Compiles)
+	}
+	return res
+}
@@ -0,0 +1,125 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"io"
+	"sync"
+)
+
+// OverrunError is returned by the PortProxy reads, at the position of the
+// gap in the received data, when the data received from the port has been
+// dropped because it was not read fast enough and the receive buffer was full.
+// The reads following an OverrunError continue with the next data received.
+type OverrunError struct {
+	// Dropped is the number of bytes dropped
+	Dropped int
+}
+
+func (e *OverrunError) Error() string {
+	return tr("%d bytes received from the port have been dropped", e.Dropped)
+}
+
+// receiveBuffer continuously reads the data received from the port and keeps
+// up to capacity bytes of it, until they are read. The data received when
+// the buffer is full is dropped and reported with an OverrunError.
+type receiveBuffer struct {
+	rw       io.ReadWriter
+	mux      sync.Mutex
+	cond     *sync.Cond
+	entries  []*receiveBufferEntry
+	size     int
+	capacity int
+	err      error
+}
+
+// receiveBufferEntry is a block of received data or, if dropped is not 0, a
+// gap in the received data.
+type receiveBufferEntry struct {
+	data    []byte
+	dropped int
+}
+
+func newReceiveBuffer(rw io.ReadWriter, capacity int) *receiveBuffer {
+	b := &receiveBuffer{rw: rw, capacity: capacity}
+	b.cond = sync.NewCond(&b.mux)
+	go b.receive()
+	return b
+}
+
+func (b *receiveBuffer) receive() {
+	for {
+		buff := make([]byte, 4096)
+		n, err := b.rw.Read(buff)
+		b.mux.Lock()
+		if n > 0 {
+			b.push(buff[:n])
+		}
+		if err != nil {
+			b.err = err
+		}
+		b.cond.Broadcast()
+		b.mux.Unlock()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// push adds the data to the buffer, dropping what doesn't fit
+func (b *receiveBuffer) push(data []byte) {
+	if free := b.capacity - b.size; free > 0 {
+		accepted := data[:min(free, len(data))]
+		b.entries = append(b.entries, &receiveBufferEntry{data: accepted})
+		b.size += len(accepted)
+		data = data[len(accepted):]
+	}
+	if len(data) == 0 {
+		return
+	}
+	if last := len(b.entries) - 1; last >= 0 && b.entries[last].dropped > 0 {
+		b.entries[last].dropped += len(data)
+	} else {
+		b.entries = append(b.entries, &receiveBufferEntry{dropped: len(data)})
+	}
+}
+
+func (b *receiveBuffer) Read(buff []byte) (int, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	for len(b.entries) == 0 && b.err == nil {
+		b.cond.Wait()
+	}
+	if len(b.entries) == 0 {
+		return 0, b.err
+	}
+	entry := b.entries[0]
+	if entry.dropped > 0 {
+		b.entries = b.entries[1:]
+		return 0, &OverrunError{Dropped: entry.dropped}
+	}
+	n := copy(buff, entry.data)
+	entry.data = entry.data[n:]
+	b.size -= n
+	if len(entry.data) == 0 {
+		b.entries = b.entries[1:]
+	}
+	return n, nil
+}
+
+func (b *receiveBuffer) Write(buff []byte) (int, error) {
+	return b.rw.Write(buff)
+}
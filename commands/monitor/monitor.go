@@ -24,7 +24,9 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	pluggableMonitor "github.com/arduino/arduino-cli/arduino/monitor"
+	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-properties-orderedmap"
@@ -33,7 +35,10 @@ import (
 
 var tr = i18n.Tr
 
-// PortProxy is an io.ReadWriteCloser that maps into the monitor port of the board
+// PortProxy is an io.ReadWriteCloser that maps into the monitor port of the board.
+// The data received from the port is kept in a buffer, of the size set by the
+// monitor.buffer_size setting, until it's read: if the buffer is full the data
+// is dropped and the Read returns an *OverrunError at the position of the gap.
 type PortProxy struct {
 	rw               io.ReadWriter
 	changeSettingsCB func(setting, value string) error
@@ -99,14 +104,28 @@ func Monitor(ctx context.Context, req *rpc.MonitorRequest) (*PortProxy, *pluggab
 	monIO, err := m.Open(req.GetPort().GetAddress(), req.GetPort().GetProtocol())
 	if err != nil {
 		m.Quit()
-		return nil, nil, &arduino.FailedMonitorError{Cause: err}
+		err = &arduino.FailedMonitorError{Cause: err}
+		return nil, nil, commands.WrapPortBusyError(req.GetPort().GetAddress(), req.GetPort().GetProtocol(), err)
 	}
 
 	logrus.Infof("Port %s successfully opened", req.GetPort().GetAddress())
+	s := &session{
+		monitor:  m,
+		address:  req.GetPort().GetAddress(),
+		protocol: req.GetPort().GetProtocol(),
+		rw:       newPausableReadWriter(monIO),
+	}
+	addSession(s)
+	var rw io.ReadWriter = s.rw
+	if bufferSize := configuration.Settings.GetInt("monitor.buffer_size"); bufferSize > 0 {
+		rw = newReceiveBuffer(s.rw, bufferSize)
+	}
 	return &PortProxy{
-		rw:               monIO,
+		rw:               rw,
 		changeSettingsCB: m.Configure,
 		closeCB: func() error {
+			removeSession(s)
+			s.rw.Close()
 			m.Close()
 			return m.Quit()
 		},
@@ -0,0 +1,196 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	pluggableMonitor "github.com/arduino/arduino-cli/arduino/monitor"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// reopenAttempts and reopenDelay control how the paused monitor sessions are
+// reopened: after an upload the port may take a while to reappear.
+var (
+	reopenAttempts = 20
+	reopenDelay    = 250 * time.Millisecond
+)
+
+// session is a monitor opened on a port, the session may be paused to
+// temporarily release the port (for example during an upload).
+type session struct {
+	monitor  *pluggableMonitor.PluggableMonitor
+	address  string
+	protocol string
+	rw       *pausableReadWriter
+}
+
+var (
+	sessions     = map[*session]bool{}
+	sessionsLock sync.Mutex
+)
+
+func addSession(s *session) {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+	sessions[s] = true
+}
+
+func removeSession(s *session) {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+	delete(sessions, s)
+}
+
+// PauseSessions closes the port of all the monitor sessions opened on the given
+// port, without terminating the sessions. The clients reading from or writing
+// to the paused sessions are blocked until the sessions are resumed.
+// The returned function resumes the paused sessions, reopening them on the
+// updated port if not nil (the port may change after an upload).
+func PauseSessions(port *rpc.Port) (resume func(updatedPort *rpc.Port)) {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+
+	paused := []*session{}
+	for s := range sessions {
+		if s.address != port.GetAddress() || s.protocol != port.GetProtocol() {
+			continue
+		}
+		logrus.Infof("Pausing monitor on port %s", s.address)
+		s.rw.pause()
+		if err := s.monitor.Close(); err != nil {
+			logrus.WithError(err).Warnf("Error closing monitor on port %s", s.address)
+		}
+		paused = append(paused, s)
+	}
+
+	return func(updatedPort *rpc.Port) {
+		for _, s := range paused {
+			if updatedPort.GetAddress() != "" {
+				s.address = updatedPort.GetAddress()
+			}
+			go s.resume()
+		}
+	}
+}
+
+// resume reopens the port of a paused session
+func (s *session) resume() {
+	for attempt := 1; ; attempt++ {
+		rw, err := s.monitor.Open(s.address, s.protocol)
+		if err == nil {
+			logrus.Infof("Monitor on port %s resumed", s.address)
+			s.rw.resume(rw)
+			return
+		}
+		if attempt == reopenAttempts {
+			logrus.WithError(err).Errorf("Could not resume monitor on port %s", s.address)
+			s.rw.Close()
+			return
+		}
+		time.Sleep(reopenDelay)
+	}
+}
+
+// pausableReadWriter is an io.ReadWriter that forwards to an underlying
+// io.ReadWriter that may be paused and replaced while in use.
+type pausableReadWriter struct {
+	mux    sync.Mutex
+	cond   *sync.Cond
+	rw     io.ReadWriter // nil while paused
+	closed bool
+}
+
+func newPausableReadWriter(rw io.ReadWriter) *pausableReadWriter {
+	res := &pausableReadWriter{rw: rw}
+	res.cond = sync.NewCond(&res.mux)
+	return res
+}
+
+// current waits until the underlying io.ReadWriter is available and returns it,
+// nil is returned if the pausableReadWriter has been closed.
+func (p *pausableReadWriter) current() io.ReadWriter {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	for p.rw == nil && !p.closed {
+		p.cond.Wait()
+	}
+	if p.closed {
+		return nil
+	}
+	return p.rw
+}
+
+// replaced returns true if the given io.ReadWriter is no more the current one
+func (p *pausableReadWriter) replaced(rw io.ReadWriter) bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.rw != rw && !p.closed
+}
+
+func (p *pausableReadWriter) Read(buff []byte) (int, error) {
+	for {
+		rw := p.current()
+		if rw == nil {
+			return 0, io.EOF
+		}
+		n, err := rw.Read(buff)
+		if err != nil && n == 0 && p.replaced(rw) {
+			// The port has been paused while reading, wait for it to be resumed
+			continue
+		}
+		return n, err
+	}
+}
+
+func (p *pausableReadWriter) Write(buff []byte) (int, error) {
+	for {
+		rw := p.current()
+		if rw == nil {
+			return 0, io.ErrClosedPipe
+		}
+		n, err := rw.Write(buff)
+		if err != nil && n == 0 && p.replaced(rw) {
+			// The port has been paused while writing, wait for it to be resumed
+			continue
+		}
+		return n, err
+	}
+}
+
+func (p *pausableReadWriter) pause() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.rw = nil
+}
+
+func (p *pausableReadWriter) resume(rw io.ReadWriter) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.rw = rw
+	p.cond.Broadcast()
+}
+
+// Close unblocks the pending and future reads and writes
+func (p *pausableReadWriter) Close() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.closed = true
+	p.cond.Broadcast()
+}
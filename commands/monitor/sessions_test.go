@@ -0,0 +1,120 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPausableReadWriter(t *testing.T) {
+	port1, board1 := net.Pipe()
+	rw := newPausableReadWriter(port1)
+
+	go board1.Write([]byte("hello"))
+	buff := make([]byte, 10)
+	n, err := rw.Read(buff)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(buff[:n]))
+
+	// Pause while a read is pending: the read must wait for the resume
+	type readResult struct {
+		data string
+		err  error
+	}
+	read := make(chan readResult)
+	go func() {
+		n, err := rw.Read(buff)
+		read <- readResult{string(buff[:n]), err}
+	}()
+	time.Sleep(50 * time.Millisecond)
+	rw.pause()
+	port1.Close()
+	select {
+	case <-read:
+		require.FailNow(t, "read must be blocked while paused")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	port2, board2 := net.Pipe()
+	rw.resume(port2)
+	go board2.Write([]byte("world"))
+	res := <-read
+	require.NoError(t, res.err)
+	require.Equal(t, "world", res.data)
+
+	// Closing unblocks the paused readers
+	rw.pause()
+	go func() {
+		n, err := rw.Read(buff)
+		read <- readResult{string(buff[:n]), err}
+	}()
+	rw.Close()
+	res = <-read
+	require.ErrorIs(t, res.err, io.EOF)
+}
+
+func TestReceiveBuffer(t *testing.T) {
+	port, board := net.Pipe()
+	rb := newReceiveBuffer(port, 10)
+
+	// The data exceeding the capacity is dropped and reported as an overrun
+	// at the position of the gap
+	_, err := board.Write([]byte("0123456"))
+	require.NoError(t, err)
+	_, err = board.Write([]byte("789abcdef"))
+	require.NoError(t, err)
+	_, err = board.Write([]byte("ghi"))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		rb.mux.Lock()
+		defer rb.mux.Unlock()
+		return len(rb.entries) == 3
+	}, time.Second, time.Millisecond)
+
+	buff := make([]byte, 20)
+	n, err := rb.Read(buff)
+	require.NoError(t, err)
+	require.Equal(t, "0123456", string(buff[:n]))
+	n, err = rb.Read(buff)
+	require.NoError(t, err)
+	require.Equal(t, "789", string(buff[:n]))
+	_, err = rb.Read(buff)
+	var overrun *OverrunError
+	require.ErrorAs(t, err, &overrun)
+	require.Equal(t, 9, overrun.Dropped)
+
+	// The data is received again once read
+	_, err = board.Write([]byte("jkl"))
+	require.NoError(t, err)
+	n, err = rb.Read(buff)
+	require.NoError(t, err)
+	require.Equal(t, "jkl", string(buff[:n]))
+
+	go func() {
+		tx := make([]byte, 2)
+		io.ReadFull(board, tx)
+		board.Close()
+	}()
+	_, err = rb.Write([]byte("tx"))
+	require.NoError(t, err)
+	_, err = rb.Read(buff)
+	require.ErrorIs(t, err, io.EOF)
+}
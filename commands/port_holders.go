@@ -0,0 +1,58 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/serialutils"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// FindPortHolders returns the processes, other than the current one, that
+// are keeping open the given serial port. If the port is not a serial port or
+// the detection fails, an empty list is returned.
+func FindPortHolders(address, protocol string) []*rpc.PortHolder {
+	if protocol != "serial" || address == "" {
+		return nil
+	}
+	holders, err := serialutils.FindPortHolders(address)
+	if err != nil {
+		logrus.WithError(err).Warnf("Could not detect the processes using port %s", address)
+		return nil
+	}
+	res := []*rpc.PortHolder{}
+	for _, holder := range holders {
+		if holder.PID == os.Getpid() {
+			continue
+		}
+		res = append(res, &rpc.PortHolder{Pid: uint32(holder.PID), Name: holder.Name})
+	}
+	return res
+}
+
+// WrapPortBusyError checks if the given port is kept open by other processes
+// and, in that case, returns an arduino.PortBusyError wrapping err, otherwise
+// err is returned unchanged.
+func WrapPortBusyError(address, protocol string, err error) error {
+	holders := FindPortHolders(address, protocol)
+	if len(holders) == 0 {
+		return err
+	}
+	return &arduino.PortBusyError{Port: address, Holders: holders, Cause: err}
+}
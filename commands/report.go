@@ -0,0 +1,106 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/envreport"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/version"
+	"github.com/arduino/go-paths-helper"
+)
+
+// EnvironmentReport builds the inventory of the installed platforms, tools
+// and libraries, with the hashes of their files, and sets its checksum.
+func EnvironmentReport(ctx context.Context, req *rpc.EnvironmentReportRequest) (*rpc.EnvironmentReportResponse, error) {
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	hostname, _ := os.Hostname()
+	report := &rpc.EnvironmentReport{
+		CliVersion: version.VersionInfo.VersionString,
+		Os:         runtime.GOOS + "/" + runtime.GOARCH,
+		Hostname:   hostname,
+		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	newItem := func(id, version, location string, dir *paths.Path) (*rpc.EnvironmentReportItem, error) {
+		hash, err := envreport.HashDir(dir)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Cannot read %s", dir), Cause: err}
+		}
+		return &rpc.EnvironmentReportItem{Id: id, Version: version, Location: location, Hash: hash}, nil
+	}
+
+	for _, platformRelease := range pme.InstalledPlatformReleases() {
+		location := "user"
+		if platformRelease.IsIDEBundled {
+			location = "ide"
+		} else if pme.IsManagedPlatformRelease(platformRelease) {
+			location = "managed"
+		}
+		item, err := newItem(platformRelease.Platform.String(), platformRelease.Version.String(), location, platformRelease.InstallDir)
+		if err != nil {
+			return nil, err
+		}
+		report.Platforms = append(report.Platforms, item)
+	}
+	for _, tool := range pme.GetAllInstalledToolsReleases() {
+		location := "user"
+		if pme.IsManagedToolRelease(tool) {
+			location = "managed"
+		}
+		item, err := newItem(tool.Tool.Package.Name+":"+tool.Tool.Name, tool.Version.String(), location, tool.InstallDir)
+		if err != nil {
+			return nil, err
+		}
+		report.Tools = append(report.Tools, item)
+	}
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives {
+			location := lib.Location.String()
+			if lib.ContainerPlatform != nil {
+				location += ":" + lib.ContainerPlatform.Platform.String()
+			}
+			version := ""
+			if lib.Version != nil {
+				version = lib.Version.String()
+			}
+			item, err := newItem(lib.Name, version, location, lib.InstallDir)
+			if err != nil {
+				return nil, err
+			}
+			report.Libraries = append(report.Libraries, item)
+		}
+	}
+
+	if err := envreport.Sort(report); err != nil {
+		return nil, err
+	}
+	return &rpc.EnvironmentReportResponse{Report: report}, nil
+}
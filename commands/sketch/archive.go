@@ -24,6 +24,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
@@ -41,7 +42,7 @@ func ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchRequest) (*rpc.Arc
 		sketchPath = paths.New(".")
 	}
 
-	s, err := sketch.New(sketchPath)
+	s, err := sketch.NewWithSymlinksPolicy(sketchPath, configuration.SketchSymlinksPolicy(configuration.Settings))
 	if err != nil {
 		return nil, &arduino.CantOpenSketchError{Cause: err}
 	}
@@ -0,0 +1,112 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+var (
+	lineDirectiveRegexp = regexp.MustCompile(`(?m)^[ \t]*#[ \t]*line[ \t].*\n`)
+	quotedIncludeRegexp = regexp.MustCompile(`(?m)^([ \t]*#[ \t]*include[ \t]*")([^"]+)(")`)
+)
+
+// ConvertSketchToCpp merges the .ino files of the sketch and adds the missing
+// prototypes, like the builder does before compiling, and saves the result as a
+// C++ source file in the src folder of the sketch. The .ino files are removed,
+// except the main file that is left empty.
+func ConvertSketchToCpp(ctx context.Context, req *rpc.ConvertSketchToCppRequest) (*rpc.ConvertSketchToCppResponse, error) {
+	sk, err := sketch.NewWithSymlinksPolicy(paths.New(req.GetSketchPath()), configuration.SketchSymlinksPolicy(configuration.Settings))
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	cppFile := sk.FullPath.Join("src", sk.Name+".cpp")
+	if cppFile.Exist() {
+		return nil, &arduino.CantUpdateSketchError{Cause: fmt.Errorf(tr("file %s already exists"), cppFile)}
+	}
+
+	preprocessed := &bytes.Buffer{}
+	if _, err := compile.Compile(ctx, &rpc.CompileRequest{
+		Instance:   req.GetInstance(),
+		Fqbn:       req.GetFqbn(),
+		SketchPath: sk.FullPath.String(),
+		Preprocess: true,
+		Quiet:      true,
+	}, preprocessed, io.Discard, nil); err != nil {
+		return nil, err
+	}
+
+	source := preprocessedSketchToCpp(preprocessed.String(), sk.FullPath, cppFile.Parent())
+
+	res := &rpc.ConvertSketchToCppResponse{
+		CppFile:  cppFile.String(),
+		Source:   source,
+		MainFile: sk.MainFile.String(),
+	}
+	for _, file := range sk.OtherSketchFiles {
+		res.RemovedFiles = append(res.RemovedFiles, file.String())
+	}
+	if req.GetDryRun() {
+		return res, nil
+	}
+
+	if err := cppFile.Parent().MkdirAll(); err != nil {
+		return nil, &arduino.CantUpdateSketchError{Cause: err}
+	}
+	if err := cppFile.WriteFile([]byte(source)); err != nil {
+		return nil, &arduino.CantUpdateSketchError{Cause: err}
+	}
+	mainFileContent := "// " + tr("The source code of this sketch is in %s", "src/"+cppFile.Base()) + "\n"
+	if err := sk.MainFile.WriteFile([]byte(mainFileContent)); err != nil {
+		return nil, &arduino.CantUpdateSketchError{Cause: err}
+	}
+	for _, file := range sk.OtherSketchFiles {
+		if err := file.Remove(); err != nil {
+			return nil, &arduino.CantUpdateSketchError{Cause: err}
+		}
+	}
+	return res, nil
+}
+
+// preprocessedSketchToCpp converts the preprocessed sketch to a C++ source file
+// to be saved in the given folder: the #line directives, pointing to the .ino
+// files, are removed and the includes relative to the sketch folder are updated.
+func preprocessedSketchToCpp(preprocessed string, sketchDir, cppDir *paths.Path) string {
+	source := lineDirectiveRegexp.ReplaceAllString(preprocessed, "")
+	return quotedIncludeRegexp.ReplaceAllStringFunc(source, func(include string) string {
+		match := quotedIncludeRegexp.FindStringSubmatch(include)
+		header := match[2]
+		if !cppDir.Join(header).Exist() && sketchDir.Join(header).Exist() {
+			if rel, err := cppDir.RelTo(sketchDir.Join(header)); err == nil {
+				header = filepath.ToSlash(rel.String())
+			}
+		}
+		return match[1] + header + match[3]
+	})
+}
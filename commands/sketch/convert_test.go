@@ -0,0 +1,51 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreprocessedSketchToCpp(t *testing.T) {
+	sketchDir := paths.New(t.TempDir())
+	require.NoError(t, sketchDir.Join("src").MkdirAll())
+	require.NoError(t, sketchDir.Join("config.h").WriteFile([]byte("#define VAL 1\n")))
+	require.NoError(t, sketchDir.Join("src", "util.h").WriteFile([]byte("#pragma once\n")))
+
+	preprocessed := "#include <Arduino.h>\n" +
+		"#line 1 \"" + sketchDir.Join("Sketch.ino").String() + "\"\n" +
+		"#include \"config.h\"\n" +
+		"#include \"src/util.h\"\n" +
+		"#include \"missing.h\"\n" +
+		"#line 5 \"" + sketchDir.Join("Sketch.ino").String() + "\"\n" +
+		"void helper();\n" +
+		"#line 5 \"" + sketchDir.Join("Sketch.ino").String() + "\"\n" +
+		"void setup() {\n  helper();\n}\n" +
+		"#line 1 \"" + sketchDir.Join("other.ino").String() + "\"\n" +
+		"void helper() {\n}\n"
+	require.Equal(t,
+		"#include <Arduino.h>\n"+
+			"#include \"../config.h\"\n"+
+			"#include \"util.h\"\n"+
+			"#include \"missing.h\"\n"+
+			"void helper();\n"+
+			"void setup() {\n  helper();\n}\n"+
+			"void helper() {\n}\n",
+		preprocessedSketchToCpp(preprocessed, sketchDir, sketchDir.Join("src")))
+}
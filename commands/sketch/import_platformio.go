@@ -0,0 +1,332 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/platformio"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// ImportPlatformIOProject imports the environments of a PlatformIO project file
+// (platformio.ini) as profiles of the sketch project file. The versions of the
+// platforms and of the libraries are resolved using the installed platforms
+// and the package and library indexes.
+func ImportPlatformIOProject(ctx context.Context, req *rpc.ImportPlatformIOProjectRequest) (*rpc.ImportPlatformIOProjectResponse, error) {
+	sk, err := sketch.NewWithSymlinksPolicy(paths.New(req.GetSketchPath()), configuration.SketchSymlinksPolicy(configuration.Settings))
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+	iniFile := sk.FullPath.Join("platformio.ini")
+	if req.GetPlatformioIni() != "" {
+		iniFile = paths.New(req.GetPlatformioIni())
+	}
+	project, err := platformio.Load(iniFile)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid PlatformIO project file %s", iniFile), Cause: err}
+	}
+
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	importer := &platformIOImporter{
+		pme:       pme,
+		libIndex:  lm.Index,
+		project:   sk.Project,
+		overwrite: req.GetOverwrite(),
+		res:       &rpc.ImportPlatformIOProjectResponse{ProjectFile: sk.GetProjectPath().String()},
+	}
+	defaultEnvs := project.DefaultEnvironments()
+	for _, env := range project.Environments() {
+		isDefault := len(importer.res.Profiles) == 0
+		if len(defaultEnvs) > 0 {
+			isDefault = env.Name == defaultEnvs[0]
+		}
+		importer.importEnvironment(env, isDefault)
+	}
+	importer.res.DefaultProfile = sk.Project.DefaultProfile
+
+	if !req.GetDryRun() && len(importer.res.Profiles) > 0 {
+		if err := sk.SaveProject(); err != nil {
+			return nil, &arduino.CantUpdateSketchError{Cause: err}
+		}
+	}
+	return importer.res, nil
+}
+
+type platformIOImporter struct {
+	pme       *packagemanager.Explorer
+	libIndex  *librariesindex.Index
+	project   *sketch.Project
+	overwrite bool
+	res       *rpc.ImportPlatformIOProjectResponse
+}
+
+// importedOptions are the PlatformIO options converted by the importer
+var importedOptions = map[string]bool{
+	"platform":    true,
+	"board":       true,
+	"framework":   true,
+	"lib_deps":    true,
+	"build_flags": true,
+	"build_type":  true,
+	"upload_port": true,
+}
+
+func (i *platformIOImporter) warn(env *platformio.Environment, option, message string) {
+	i.res.Warnings = append(i.res.Warnings, &rpc.ImportWarning{Environment: env.Name, Option: option, Message: message})
+}
+
+func (i *platformIOImporter) importEnvironment(env *platformio.Environment, isDefault bool) {
+	if !env.IsArduinoFramework() {
+		i.warn(env, "framework", tr("only the environments using the arduino framework can be imported"))
+		return
+	}
+	fqbnString, ok := env.FQBN()
+	if !ok {
+		i.warn(env, "board", tr("no known Arduino board for the board %[1]s of the platform %[2]s", env.Get("board"), env.Platform()))
+		return
+	}
+	if existing := i.getProfile(env.Name); existing != nil && !i.overwrite {
+		i.warn(env, "", tr("the sketch project file already has a profile named %s", env.Name))
+		return
+	}
+	fqbn, err := cores.ParseFQBN(fqbnString)
+	if err != nil {
+		i.warn(env, "board", err.Error())
+		return
+	}
+	platform := i.resolvePlatform(env, fqbn)
+	if platform == nil {
+		return
+	}
+
+	profile := &sketch.Profile{
+		Name:      env.Name,
+		Notes:     tr("imported from the %s environment of platformio.ini", env.Name),
+		FQBN:      fqbnString,
+		Platforms: sketch.ProfileRequiredPlatforms{platform},
+		Libraries: sketch.ProfileRequiredLibraries{},
+	}
+	imported := &rpc.ImportedProfile{Name: env.Name, Fqbn: fqbnString, Platforms: []string{platform.String()}}
+	for _, item := range env.GetList("lib_deps") {
+		if library := i.resolveLibrary(env, platformio.ParseLibraryDependency(item)); library != nil {
+			profile.Libraries = append(profile.Libraries, library)
+			imported.Libraries = append(imported.Libraries, library.String())
+		}
+	}
+	if configuration := i.importBuildFlags(env); configuration != nil {
+		imported.Configuration = configuration.Name
+	}
+
+	if port := env.Get("upload_port"); port != "" {
+		if isDefault && (i.project.DefaultPort == "" || i.overwrite) {
+			i.project.DefaultPort = port
+		} else {
+			i.warn(env, "upload_port", tr("only the upload port of the default environment is imported as default_port"))
+		}
+	}
+	for _, option := range env.Keys() {
+		if !importedOptions[option] {
+			i.warn(env, option, tr("no equivalent in the sketch project file"))
+		}
+	}
+
+	i.setProfile(profile)
+	if isDefault && (i.project.DefaultProfile == "" || i.overwrite) {
+		i.project.DefaultProfile = env.Name
+	}
+	i.res.Profiles = append(i.res.Profiles, imported)
+}
+
+func (i *platformIOImporter) getProfile(name string) *sketch.Profile {
+	for _, profile := range i.project.Profiles {
+		if profile.Name == name {
+			return profile
+		}
+	}
+	return nil
+}
+
+func (i *platformIOImporter) setProfile(profile *sketch.Profile) {
+	for idx, existing := range i.project.Profiles {
+		if existing.Name == profile.Name {
+			i.project.Profiles[idx] = profile
+			return
+		}
+	}
+	i.project.Profiles = append(i.project.Profiles, profile)
+}
+
+// resolvePlatform returns the reference to the installed release of the
+// platform of the board, or to the latest release available in the index.
+func (i *platformIOImporter) resolvePlatform(env *platformio.Environment, fqbn *cores.FQBN) *sketch.ProfilePlatformReference {
+	platformID := fqbn.Package + ":" + fqbn.PlatformArch
+	platform := i.pme.FindPlatform(&packagemanager.PlatformReference{Package: fqbn.Package, PlatformArchitecture: fqbn.PlatformArch})
+	var release *cores.PlatformRelease
+	if platform != nil {
+		release = i.pme.GetInstalledPlatformRelease(platform)
+		if release == nil {
+			release = platform.GetLatestRelease()
+		}
+	}
+	if release == nil {
+		msg := tr("platform %s not found, run `core update-index`", platformID)
+		if indexURL := platformio.PackageIndexURL(fqbn.Package); indexURL != "" {
+			msg = tr("platform %[1]s not found, add %[2]s to board_manager.additional_urls and run `core update-index`", platformID, indexURL)
+		}
+		i.warn(env, "platform", msg)
+		return nil
+	}
+	if strings.Contains(env.Get("platform"), "@") || strings.Contains(env.Get("platform"), "#") {
+		i.warn(env, "platform", tr("the PlatformIO platform version is not the version of the Arduino platform, using %s", release))
+	}
+
+	res := &sketch.ProfilePlatformReference{
+		Packager:     fqbn.Package,
+		Architecture: fqbn.PlatformArch,
+		Version:      release.Version,
+	}
+	if indexURL := platformio.PackageIndexURL(fqbn.Package); indexURL != "" {
+		res.PlatformIndexURL, _ = url.Parse(indexURL)
+	}
+	return res
+}
+
+// resolveLibrary returns the reference to the greatest release of the library
+// index matching the dependency.
+func (i *platformIOImporter) resolveLibrary(env *platformio.Environment, dep *platformio.LibraryDependency) *sketch.ProfileLibraryReference {
+	if dep.URL != "" {
+		i.warn(env, "lib_deps", tr("%s: the libraries from URLs or local paths can't be added to a profile", dep))
+		return nil
+	}
+	library := i.libIndex.Libraries[dep.Name]
+	if library == nil {
+		for name, candidate := range i.libIndex.Libraries {
+			if strings.EqualFold(name, dep.Name) {
+				library = candidate
+				break
+			}
+		}
+	}
+	if library == nil {
+		i.warn(env, "lib_deps", tr("%s: library not found in the library index, not needed if bundled with the platform", dep))
+		return nil
+	}
+	constraint, err := dep.Constraint()
+	if err != nil {
+		i.warn(env, "lib_deps", tr("%[1]s: invalid version requirements: %[2]s", dep, err))
+		return nil
+	}
+	versions := library.Versions()
+	for idx := len(versions) - 1; idx >= 0; idx-- {
+		version := versions[idx]
+		if !constraint.Match(version) {
+			continue
+		}
+		if _, exact := dep.ExactVersion(); !exact {
+			i.warn(env, "lib_deps", tr("%[1]s: pinned to version %[2]s", dep, version))
+		}
+		return &sketch.ProfileLibraryReference{Library: library.Name, Version: version}
+	}
+	i.warn(env, "lib_deps", tr("%s: no release of the library matches the version requirements", dep))
+	return nil
+}
+
+// importBuildFlags converts the build_flags and build_type options into a
+// build configuration named after the environment.
+func (i *platformIOImporter) importBuildFlags(env *platformio.Environment) *sketch.BuildConfiguration {
+	configuration := &sketch.BuildConfiguration{Name: env.Name}
+	if env.Get("build_type") == "debug" {
+		configuration.Optimization = "debug"
+	}
+	cFlags, cppFlags := []string{}, []string{}
+	flags := strings.Fields(env.Get("build_flags"))
+	for idx := 0; idx < len(flags); idx++ {
+		flag := flags[idx]
+		switch {
+		case strings.Contains(flag, "${"):
+			i.warn(env, "build_flags", tr("%s: unresolved reference", flag))
+		case flag == "-D" && idx+1 < len(flags):
+			idx++
+			i.addDefine(env, configuration, flags[idx])
+		case strings.HasPrefix(flag, "-D"):
+			i.addDefine(env, configuration, flag[2:])
+		case strings.HasPrefix(flag, "-O"):
+			configuration.Optimization = flag
+		case strings.HasPrefix(flag, "-std=") && strings.Contains(flag, "++"):
+			cppFlags = append(cppFlags, flag)
+		default:
+			cFlags = append(cFlags, flag)
+			cppFlags = append(cppFlags, flag)
+		}
+	}
+	if len(cFlags) > 0 {
+		configuration.BuildProperties = append(configuration.BuildProperties, "compiler.c.extra_flags="+strings.Join(cFlags, " "))
+	}
+	if len(cppFlags) > 0 {
+		configuration.BuildProperties = append(configuration.BuildProperties, "compiler.cpp.extra_flags="+strings.Join(cppFlags, " "))
+	}
+	if env.Get("build_unflags") != "" {
+		i.warn(env, "build_unflags", tr("the flags of the platform can't be removed, change the recipes in a platform.local.txt"))
+	}
+	if configuration.Optimization == "" && len(configuration.Defines) == 0 && len(configuration.BuildProperties) == 0 {
+		return nil
+	}
+	if err := configuration.Validate(); err != nil {
+		i.warn(env, "build_flags", err.Error())
+		return nil
+	}
+
+	for idx, existing := range i.project.Configurations {
+		if existing.Name == configuration.Name {
+			if !i.overwrite {
+				i.warn(env, "build_flags", tr("the sketch project file already has a build configuration named %s", env.Name))
+				return nil
+			}
+			i.project.Configurations[idx] = configuration
+			return configuration
+		}
+	}
+	i.project.Configurations = append(i.project.Configurations, configuration)
+	return configuration
+}
+
+func (i *platformIOImporter) addDefine(env *platformio.Environment, configuration *sketch.BuildConfiguration, define string) {
+	if define == "" || strings.ContainsAny(define, " \t\"'\\") {
+		i.warn(env, "build_flags", tr("%s: the defines with quotes or spaces can't be imported", "-D"+define))
+		return
+	}
+	configuration.Defines = append(configuration.Defines, define)
+}
@@ -0,0 +1,121 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportPlatformIOProject(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	dataDir := tmp.Join("data")
+	downloadDir := tmp.Join("staging")
+	t.Setenv("ARDUINO_DATA_DIR", dataDir.String())
+	t.Setenv("ARDUINO_DOWNLOADS_DIR", downloadDir.String())
+	require.NoError(t, dataDir.MkdirAll())
+	require.NoError(t, downloadDir.MkdirAll())
+	testdata := paths.New("testdata", "platformio")
+	require.NoError(t, testdata.Join("package_index.json").CopyTo(dataDir.Join("package_index.json")))
+	require.NoError(t, testdata.Join("library_index.json").CopyTo(dataDir.Join("library_index.json")))
+	sketchDir := tmp.Join("sketch_pio")
+	require.NoError(t, testdata.Join("sketch_pio").CopyDirTo(sketchDir))
+
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init(tmp.Join("arduino-cli.yaml").String())
+	inst := instance.CreateAndInit()
+	require.NotNil(t, inst)
+
+	// Dry run
+	res, err := ImportPlatformIOProject(context.Background(), &rpc.ImportPlatformIOProjectRequest{
+		Instance:   inst,
+		SketchPath: sketchDir.String(),
+		DryRun:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.GetProfiles(), 1)
+	require.NoFileExists(t, sketchDir.Join("sketch.yaml").String())
+
+	res, err = ImportPlatformIOProject(context.Background(), &rpc.ImportPlatformIOProjectRequest{
+		Instance:   inst,
+		SketchPath: sketchDir.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*rpc.ImportedProfile{{
+		Name:          "uno",
+		Fqbn:          "arduino:avr:uno",
+		Platforms:     []string{"arduino:avr@1.8.6"},
+		Libraries:     []string{"ArduinoJson@6.21.5", "PubSubClient@2.7.0"},
+		Configuration: "uno",
+	}}, res.GetProfiles())
+	require.Equal(t, "uno", res.GetDefaultProfile())
+
+	warnings := map[string][]string{}
+	for _, warning := range res.GetWarnings() {
+		warnings[warning.GetEnvironment()] = append(warnings[warning.GetEnvironment()], warning.GetOption())
+	}
+	require.Equal(t, map[string][]string{
+		"uno":    {"lib_deps", "lib_deps", "lib_deps", "monitor_speed"},
+		"esp32":  {"platform"},
+		"native": {"framework"},
+	}, warnings)
+
+	project, err := sketch.LoadProjectFile(sketchDir.Join("sketch.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "uno", project.DefaultProfile)
+	require.Equal(t, "/dev/ttyACM0", project.DefaultPort)
+	require.Len(t, project.Profiles, 1)
+	require.Equal(t, "arduino:avr:uno", project.Profiles[0].FQBN)
+	require.Equal(t, "arduino:avr@1.8.6", project.Profiles[0].Platforms[0].String())
+	require.Len(t, project.Profiles[0].Libraries, 2)
+	require.Equal(t, &sketch.BuildConfiguration{
+		Name:            "uno",
+		Optimization:    "-O2",
+		Defines:         []string{"DEBUG_LEVEL=2"},
+		BuildProperties: []string{"compiler.cpp.extra_flags=-std=gnu++17"},
+	}, project.Configurations.Get("uno"))
+
+	// The existing profiles are not replaced without overwrite
+	res, err = ImportPlatformIOProject(context.Background(), &rpc.ImportPlatformIOProjectRequest{
+		Instance:   inst,
+		SketchPath: sketchDir.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.GetProfiles())
+
+	res, err = ImportPlatformIOProject(context.Background(), &rpc.ImportPlatformIOProjectRequest{
+		Instance:   inst,
+		SketchPath: sketchDir.String(),
+		Overwrite:  true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.GetProfiles(), 1)
+
+	_, err = ImportPlatformIOProject(context.Background(), &rpc.ImportPlatformIOProjectRequest{
+		Instance:      inst,
+		SketchPath:    sketchDir.String(),
+		PlatformioIni: tmp.Join("missing.ini").String(),
+	})
+	require.Error(t, err)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
 )
@@ -27,7 +28,7 @@ import (
 // LoadSketch collects and returns all files composing a sketch
 func LoadSketch(ctx context.Context, req *rpc.LoadSketchRequest) (*rpc.LoadSketchResponse, error) {
 	// TODO: This should be a ToRpc function for the Sketch struct
-	sk, err := sketch.New(paths.New(req.SketchPath))
+	sk, err := sketch.NewWithSymlinksPolicy(paths.New(req.SketchPath), configuration.SketchSymlinksPolicy(configuration.Settings))
 	if err != nil {
 		return nil, &arduino.CantOpenSketchError{Cause: err}
 	}
@@ -64,6 +65,42 @@ func LoadSketch(ctx context.Context, req *rpc.LoadSketchRequest) (*rpc.LoadSketc
 		defaultProfileResp.Fqbn = defaultProfile.FQBN
 	}
 
+	resolvedProfileResp := &rpc.SketchProfile{}
+	resolvedProfile := defaultProfile
+	if req.GetProfile() != "" {
+		resolvedProfile = sk.GetProfile(req.GetProfile())
+		if resolvedProfile == nil {
+			return nil, &arduino.UnknownProfileError{Profile: req.GetProfile()}
+		}
+	}
+	if resolvedProfile != nil {
+		resolvedProfileResp = profileToRPC(resolvedProfile)
+	}
+
+	files := []*rpc.SketchFile{sketchFileToRPC(sk, sk.MainFile, rpc.SketchFileType_SKETCH_FILE_TYPE_MAIN)}
+	for _, file := range sk.OtherSketchFiles {
+		files = append(files, sketchFileToRPC(sk, file, rpc.SketchFileType_SKETCH_FILE_TYPE_SKETCH))
+	}
+	for _, file := range sk.AdditionalFiles {
+		fileType := rpc.SketchFileType_SKETCH_FILE_TYPE_ADDITIONAL
+		if sk.IsCompiledFile(file) {
+			fileType = rpc.SketchFileType_SKETCH_FILE_TYPE_SOURCE
+		} else if inData, _ := file.IsInsideDir(sk.FullPath.Join("data")); inData {
+			fileType = rpc.SketchFileType_SKETCH_FILE_TYPE_DATA
+		}
+		files = append(files, sketchFileToRPC(sk, file, fileType))
+	}
+
+	includes, err := sk.Includes()
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	partitions := ""
+	if file := sk.GetPartitionsFile(); file != nil {
+		partitions = file.String()
+	}
+
 	return &rpc.LoadSketchResponse{
 		MainFile:         sk.MainFile.String(),
 		LocationPath:     sk.FullPath.String(),
@@ -75,5 +112,50 @@ func LoadSketch(ctx context.Context, req *rpc.LoadSketchRequest) (*rpc.LoadSketc
 		DefaultProtocol:  defaultProtocol,
 		Profiles:         profiles,
 		DefaultProfile:   defaultProfileResp,
+		Partitions:       partitions,
+		Files:            files,
+		ResolvedProfile:  resolvedProfileResp,
+		Includes:         includes,
 	}, nil
 }
+
+func sketchFileToRPC(sk *sketch.Sketch, file *paths.Path, fileType rpc.SketchFileType) *rpc.SketchFile {
+	relativePath := file.String()
+	if rel, err := sk.FullPath.RelTo(file); err == nil {
+		relativePath = rel.String()
+	}
+	return &rpc.SketchFile{
+		Path:         file.String(),
+		RelativePath: relativePath,
+		Type:         fileType,
+	}
+}
+
+func profileToRPC(profile *sketch.Profile) *rpc.SketchProfile {
+	res := &rpc.SketchProfile{
+		Name:  profile.Name,
+		Fqbn:  profile.FQBN,
+		Notes: profile.Notes,
+	}
+	for _, platform := range profile.Platforms {
+		rpcPlatform := &rpc.SketchProfilePlatform{
+			Packager:     platform.Packager,
+			Architecture: platform.Architecture,
+		}
+		if platform.Version != nil {
+			rpcPlatform.Version = platform.Version.String()
+		}
+		if platform.PlatformIndexURL != nil {
+			rpcPlatform.PlatformIndexUrl = platform.PlatformIndexURL.String()
+		}
+		res.Platforms = append(res.Platforms, rpcPlatform)
+	}
+	for _, library := range profile.Libraries {
+		rpcLibrary := &rpc.SketchProfileLibrary{Name: library.Library}
+		if library.Version != nil {
+			rpcLibrary.Version = library.Version.String()
+		}
+		res.Libraries = append(res.Libraries, rpcLibrary)
+	}
+	return res
+}
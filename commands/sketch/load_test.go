@@ -17,6 +17,7 @@ package sketch
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -30,4 +31,50 @@ func TestLoadSketchProfiles(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, loadResp.GetProfiles(), 2)
 	require.Equal(t, loadResp.GetDefaultProfile().GetName(), "nanorp")
+	require.Equal(t, "nanorp", loadResp.GetResolvedProfile().GetName())
+	require.Len(t, loadResp.GetResolvedProfile().GetLibraries(), 3)
+
+	loadResp, err = LoadSketch(context.Background(), &commands.LoadSketchRequest{
+		SketchPath: "./testdata/sketch_with_profile",
+		Profile:    "another_profile_name",
+	})
+	require.NoError(t, err)
+	resolvedProfile := loadResp.GetResolvedProfile()
+	require.Equal(t, "another_profile_name", resolvedProfile.GetName())
+	require.Equal(t, "testing the limit of the AVR platform, may be unstable", resolvedProfile.GetNotes())
+	require.Len(t, resolvedProfile.GetPlatforms(), 1)
+	require.Equal(t, "arduino", resolvedProfile.GetPlatforms()[0].GetPackager())
+	require.Equal(t, "avr", resolvedProfile.GetPlatforms()[0].GetArchitecture())
+	require.Equal(t, "1.8.4", resolvedProfile.GetPlatforms()[0].GetVersion())
+	require.Equal(t, "VitconMQTT", resolvedProfile.GetLibraries()[0].GetName())
+	require.Equal(t, "1.0.1", resolvedProfile.GetLibraries()[0].GetVersion())
+
+	_, err = LoadSketch(context.Background(), &commands.LoadSketchRequest{
+		SketchPath: "./testdata/sketch_with_profile",
+		Profile:    "nonexistent",
+	})
+	require.Error(t, err)
+}
+
+func TestLoadSketchFiles(t *testing.T) {
+	loadResp, err := LoadSketch(context.Background(), &commands.LoadSketchRequest{
+		SketchPath: "./testdata/sketch_with_files",
+	})
+	require.NoError(t, err)
+
+	files := map[string]commands.SketchFileType{}
+	for _, file := range loadResp.GetFiles() {
+		files[filepath.ToSlash(file.GetRelativePath())] = file.GetType()
+	}
+	require.Equal(t, map[string]commands.SketchFileType{
+		"sketch_with_files.ino": commands.SketchFileType_SKETCH_FILE_TYPE_MAIN,
+		"other.ino":             commands.SketchFileType_SKETCH_FILE_TYPE_SKETCH,
+		"helper.h":              commands.SketchFileType_SKETCH_FILE_TYPE_SOURCE,
+		"helper.cpp":            commands.SketchFileType_SKETCH_FILE_TYPE_SOURCE,
+		"src/util/util.h":       commands.SketchFileType_SKETCH_FILE_TYPE_SOURCE,
+		"data/config.json":      commands.SketchFileType_SKETCH_FILE_TYPE_DATA,
+		"docs/notes.md":         commands.SketchFileType_SKETCH_FILE_TYPE_ADDITIONAL,
+	}, files)
+
+	require.Equal(t, []string{"SPI.h", "Servo.h", "WiFi.h", "Wire.h"}, loadResp.GetIncludes())
 }
@@ -0,0 +1,178 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// MoveSketch renames or moves a sketch to the given destination folder. The main
+// file and the other files in the sketch root folder named after the sketch are
+// renamed to match the new name, the includes of the renamed files and the paths
+// to the old sketch location in the project file are updated.
+func MoveSketch(ctx context.Context, req *rpc.MoveSketchRequest) (*rpc.MoveSketchResponse, error) {
+	symlinksPolicy := configuration.SketchSymlinksPolicy(configuration.Settings)
+	sk, err := sketch.NewWithSymlinksPolicy(paths.New(req.GetSketchPath()), symlinksPolicy)
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	if req.GetDestinationPath() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing destination path")}
+	}
+	destination, err := paths.New(req.GetDestinationPath()).Abs()
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid destination path"), Cause: err}
+	}
+	oldName := sk.Name
+	oldPath := sk.FullPath
+	newName := destination.Base()
+	if err := validateSketchName(newName); err != nil {
+		return nil, &arduino.CantUpdateSketchError{Cause: err}
+	}
+	if destination.String() == oldPath.String() {
+		return nil, &arduino.CantUpdateSketchError{Cause: fmt.Errorf(tr("the sketch is already in %s"), destination)}
+	}
+	// On case-insensitive filesystems the destination exists if only the case of the name changes
+	if destination.Exist() && !strings.EqualFold(destination.String(), oldPath.String()) {
+		return nil, &arduino.CantUpdateSketchError{Cause: fmt.Errorf(tr("destination %s already exists"), destination)}
+	}
+	if inside, _ := destination.IsInsideDir(oldPath); inside {
+		return nil, &arduino.CantUpdateSketchError{Cause: fmt.Errorf(tr("cannot move the sketch inside itself"))}
+	}
+
+	// Collect the files named after the sketch, they must be renamed too
+	renamed := map[string]string{}
+	for _, file := range append(paths.PathList{sk.MainFile}, sk.RootFolderFiles...) {
+		if strings.TrimSuffix(file.Base(), file.Ext()) != oldName {
+			continue
+		}
+		newBase := newName + file.Ext()
+		if oldPath.Join(newBase).Exist() && !strings.EqualFold(newBase, file.Base()) {
+			return nil, &arduino.CantUpdateSketchError{Cause: fmt.Errorf(tr("the sketch already contains a file named %s"), newBase)}
+		}
+		renamed[file.Base()] = newBase
+	}
+
+	if err := destination.Parent().MkdirAll(); err != nil {
+		return nil, &arduino.CantUpdateSketchError{Cause: err}
+	}
+	if err := utils.Move(oldPath, destination); err != nil {
+		return nil, &arduino.CantUpdateSketchError{Cause: err}
+	}
+
+	updated := paths.PathList{}
+	for oldBase, newBase := range renamed {
+		if oldBase == newBase {
+			continue
+		}
+		if err := destination.Join(oldBase).Rename(destination.Join(newBase)); err != nil {
+			return nil, &arduino.CantUpdateSketchError{Cause: err}
+		}
+		updated.Add(destination.Join(newBase))
+	}
+
+	sk, err = sketch.NewWithSymlinksPolicy(destination, symlinksPolicy)
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	// Fix the includes of the renamed files, like #include "OldName.h" or
+	// #include "../OldName.h" from a subfolder. Only the paths leading to the
+	// sketch root folder are changed, the headers in other folders (like the
+	// ones of a library with the same name of the sketch) are left untouched.
+	includes := []string{}
+	for oldBase, newBase := range renamed {
+		if oldBase != newBase {
+			includes = append(includes, regexp.QuoteMeta(oldBase))
+		}
+	}
+	if len(includes) > 0 {
+		sort.Strings(includes)
+		files := append(paths.PathList{sk.MainFile}, sk.OtherSketchFiles...)
+		files.AddAll(sk.AdditionalFiles)
+		for _, file := range files {
+			rel, err := file.Parent().RelTo(destination)
+			if err != nil {
+				return nil, &arduino.CantUpdateSketchError{Cause: err}
+			}
+			prefix := ""
+			if rel.String() != "." {
+				prefix = filepath.ToSlash(rel.String()) + "/"
+			}
+			includeRegexp := regexp.MustCompile(`(#\s*include\s*"` + regexp.QuoteMeta(prefix) + `)(` + strings.Join(includes, "|") + `)(")`)
+			changed, err := replaceInFile(file, includeRegexp, func(match []string) string {
+				return match[1] + renamed[match[2]] + match[3]
+			})
+			if err != nil {
+				return nil, &arduino.CantUpdateSketchError{Cause: err}
+			}
+			if changed {
+				updated.AddIfMissing(file)
+			}
+		}
+	}
+
+	// Fix the paths to the old location in the project file
+	if projectFile := sk.GetProjectPath(); projectFile.Exist() {
+		locationRegexp := regexp.MustCompile(`(?m)` + regexp.QuoteMeta(oldPath.String()) + `([/\\"'\s]|$)`)
+		changed, err := replaceInFile(projectFile, locationRegexp, func(match []string) string {
+			return destination.String() + match[1]
+		})
+		if err != nil {
+			return nil, &arduino.CantUpdateSketchError{Cause: err}
+		}
+		if changed {
+			updated.Add(projectFile)
+		}
+	}
+
+	sort.Sort(&updated)
+	return &rpc.MoveSketchResponse{
+		MainFile:     sk.MainFile.String(),
+		LocationPath: sk.FullPath.String(),
+		UpdatedFiles: updated.AsStrings(),
+	}, nil
+}
+
+// replaceInFile replaces the matches of the regexp in the given file with the
+// result of replacement, called with the match and its submatches. It returns
+// true if the file has been changed.
+func replaceInFile(file *paths.Path, re *regexp.Regexp, replacement func(match []string) string) (bool, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return false, err
+	}
+	newData := re.ReplaceAllFunc(data, func(match []byte) []byte {
+		return []byte(replacement(re.FindStringSubmatch(string(match))))
+	})
+	if string(newData) == string(data) {
+		return false, nil
+	}
+	return true, file.WriteFile(newData)
+}
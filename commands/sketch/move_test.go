@@ -0,0 +1,102 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveSketch(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	sketchDir := tmp.Join("OldName")
+	require.NoError(t, sketchDir.Join("src").MkdirAll())
+	require.NoError(t, sketchDir.Join("OldName.ino").WriteFile([]byte("#include \"OldName.h\"\n#include \"OldNameUtils.h\"\n")))
+	require.NoError(t, sketchDir.Join("OldName.h").WriteFile([]byte("#pragma once\n")))
+	require.NoError(t, sketchDir.Join("OldNameUtils.h").WriteFile([]byte("#pragma once\n")))
+	require.NoError(t, sketchDir.Join("src", "file.cpp").WriteFile([]byte("#include \"../OldName.h\"\n")))
+	require.NoError(t, sketchDir.Join("sketch.yaml").WriteFile([]byte("partitions: "+sketchDir.Join("parts.csv").String()+"\n")))
+
+	destination := tmp.Join("moved", "NewName")
+	res, err := MoveSketch(context.Background(), &commands.MoveSketchRequest{
+		SketchPath:      sketchDir.String(),
+		DestinationPath: destination.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, destination.Join("NewName.ino").String(), res.GetMainFile())
+	require.Equal(t, destination.String(), res.GetLocationPath())
+	require.NoDirExists(t, sketchDir.String())
+	require.FileExists(t, destination.Join("NewName.h").String())
+	require.FileExists(t, destination.Join("OldNameUtils.h").String())
+
+	data, err := destination.Join("NewName.ino").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "#include \"NewName.h\"\n#include \"OldNameUtils.h\"\n", string(data))
+	data, err = destination.Join("src", "file.cpp").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "#include \"../NewName.h\"\n", string(data))
+	data, err = destination.Join("sketch.yaml").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "partitions: "+destination.Join("parts.csv").String()+"\n", string(data))
+	require.ElementsMatch(t, []string{
+		destination.Join("NewName.ino").String(),
+		destination.Join("NewName.h").String(),
+		destination.Join("src", "file.cpp").String(),
+		destination.Join("sketch.yaml").String(),
+	}, res.GetUpdatedFiles())
+
+	// Existing destination
+	require.NoError(t, tmp.Join("Existing").MkdirAll())
+	_, err = MoveSketch(context.Background(), &commands.MoveSketchRequest{
+		SketchPath:      destination.String(),
+		DestinationPath: tmp.Join("Existing").String(),
+	})
+	require.Error(t, err)
+
+	// Invalid name
+	_, err = MoveSketch(context.Background(), &commands.MoveSketchRequest{
+		SketchPath:      destination.String(),
+		DestinationPath: tmp.Join("invalid name").String(),
+	})
+	require.Error(t, err)
+	require.DirExists(t, destination.String())
+}
+
+func TestMoveSketchWithLibraryNamedAsTheSketch(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	sketchDir := tmp.Join("Servo")
+	require.NoError(t, sketchDir.Join("src", "Servo").MkdirAll())
+	main := "#include \"Servo.h\"\n#include <Servo.h>\n#include \"src/Servo/Servo.h\"\n"
+	require.NoError(t, sketchDir.Join("Servo.ino").WriteFile([]byte(main)))
+	require.NoError(t, sketchDir.Join("src", "Servo", "Servo.h").WriteFile([]byte("#pragma once\n")))
+
+	// The headers of the library are not renamed, so the includes are kept
+	destination := tmp.Join("MyServo")
+	res, err := MoveSketch(context.Background(), &commands.MoveSketchRequest{
+		SketchPath:      sketchDir.String(),
+		DestinationPath: destination.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{destination.Join("MyServo.ino").String()}, res.GetUpdatedFiles())
+	data, err := destination.Join("MyServo.ino").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, main, string(data))
+	require.FileExists(t, destination.Join("src", "Servo", "Servo.h").String())
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
@@ -39,9 +40,6 @@ void loop() {
 var sketchNameMaxLength = 63
 var sketchNameValidationRegex = regexp.MustCompile(`^[0-9a-zA-Z_](?:[0-9a-zA-Z_\.-]*[0-9a-zA-Z_-]|)$`)
 
-var invalidNames = []string{"CON", "PRN", "AUX", "NUL", "COM0", "COM1", "COM2", "COM3", "COM4", "COM5",
-	"COM6", "COM7", "COM8", "COM9", "LPT0", "LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9"}
-
 // NewSketch creates a new sketch via gRPC
 func NewSketch(ctx context.Context, req *rpc.NewSketchRequest) (*rpc.NewSketchResponse, error) {
 	var sketchesDir string
@@ -52,7 +50,7 @@ func NewSketch(ctx context.Context, req *rpc.NewSketchRequest) (*rpc.NewSketchRe
 	}
 
 	if err := validateSketchName(req.SketchName); err != nil {
-		return nil, err
+		return nil, &arduino.CantCreateSketchError{Cause: err}
 	}
 
 	sketchDirPath := paths.New(sketchesDir).Join(req.SketchName)
@@ -75,21 +73,19 @@ func NewSketch(ctx context.Context, req *rpc.NewSketchRequest) (*rpc.NewSketchRe
 
 func validateSketchName(name string) error {
 	if name == "" {
-		return &arduino.CantCreateSketchError{Cause: errors.New(tr("sketch name cannot be empty"))}
+		return errors.New(tr("sketch name cannot be empty"))
 	}
 	if len(name) > sketchNameMaxLength {
-		return &arduino.CantCreateSketchError{Cause: errors.New(tr("sketch name too long (%[1]d characters). Maximum allowed length is %[2]d",
+		return errors.New(tr("sketch name too long (%[1]d characters). Maximum allowed length is %[2]d",
 			len(name),
-			sketchNameMaxLength))}
+			sketchNameMaxLength))
 	}
 	if !sketchNameValidationRegex.MatchString(name) {
-		return &arduino.CantCreateSketchError{Cause: errors.New(tr(`invalid sketch name "%[1]s": the first character must be alphanumeric or "_", the following ones can also contain "-" and ".". The last one cannot be ".".`,
-			name))}
+		return errors.New(tr(`invalid sketch name "%[1]s": the first character must be alphanumeric or "_", the following ones can also contain "-" and ".". The last one cannot be ".".`,
+			name))
 	}
-	for _, invalid := range invalidNames {
-		if name == invalid {
-			return &arduino.CantCreateSketchError{Cause: errors.New(tr(`sketch name cannot be the reserved name "%[1]s"`, invalid))}
-		}
+	if utils.IsReservedName(name) {
+		return errors.New(tr(`sketch name cannot be the reserved name "%[1]s"`, name))
 	}
 	return nil
 }
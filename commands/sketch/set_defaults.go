@@ -20,6 +20,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
 )
@@ -27,7 +28,7 @@ import (
 // SetSketchDefaults updates the sketch project file (sketch.yaml) with the given defaults
 // for the values `default_fqbn`, `default_port`, and `default_protocol`.
 func SetSketchDefaults(ctx context.Context, req *rpc.SetSketchDefaultsRequest) (*rpc.SetSketchDefaultsResponse, error) {
-	sk, err := sketch.New(paths.New(req.SketchPath))
+	sk, err := sketch.NewWithSymlinksPolicy(paths.New(req.SketchPath), configuration.SketchSymlinksPolicy(configuration.Settings))
 	if err != nil {
 		return nil, &arduino.CantOpenSketchError{Cause: err}
 	}
@@ -52,6 +53,20 @@ func SetSketchDefaults(ctx context.Context, req *rpc.SetSketchDefaultsRequest) (
 		res.DefaultPortAddress = newAddress
 		res.DefaultPortProtocol = newProtocol
 	}
+	if req.GetResetPartitions() {
+		if err := sk.SetPartitionsFile(nil); err != nil {
+			return nil, &arduino.CantUpdateSketchError{Cause: err}
+		}
+	} else if partitions := req.GetPartitions(); partitions != "" {
+		file, err := paths.New(partitions).Abs()
+		if err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid partition table path"), Cause: err}
+		}
+		if err := sk.SetPartitionsFile(file); err != nil {
+			return nil, &arduino.CantUpdateSketchError{Cause: err}
+		}
+	}
+	res.Partitions = sk.Project.Partitions
 
 	return res, nil
 }
@@ -0,0 +1,5 @@
+#include "helper.h"
+#include <Wire.h>
+
+void helper() {
+}
@@ -0,0 +1,2 @@
+#include <WiFi.h>
+#include <Servo.h>
@@ -0,0 +1,9 @@
+#include <Servo.h>
+#include "helper.h"
+#include "src/util/util.h"
+
+void setup() {
+}
+
+void loop() {
+}
@@ -0,0 +1,294 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/httpclient"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// platformUpgrade is a platform upgrade resolved from a plan
+type platformUpgrade struct {
+	installed *cores.PlatformRelease
+	target    *cores.PlatformRelease
+	tools     []*cores.ToolRelease
+	oldTools  []*cores.ToolRelease
+}
+
+// libraryUpgrade is a library upgrade or install resolved from a plan
+type libraryUpgrade struct {
+	release *librariesindex.Release
+	task    *librariesmanager.LibraryInstallPlan
+}
+
+// UpgradeApply applies an upgrade plan. All the archives are downloaded
+// before touching the installation; then the new platforms, tools and
+// libraries are installed and, if any of them fails, the previous state is
+// restored. The replaced platform releases and the tools no longer required
+// are removed only after everything has been installed.
+func UpgradeApply(ctx context.Context, req *rpc.UpgradeApplyRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+	if err := applyPlan(ctx, req, downloadCB, taskCB); err != nil {
+		return err
+	}
+	return commands.Init(ctx, &rpc.InitRequest{Instance: req.GetInstance()}, nil)
+}
+
+func applyPlan(ctx context.Context, req *rpc.UpgradeApplyRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+	releaseResources, err := instances.AcquireWrite(req.GetInstance(), "UpgradeApply", instances.Platforms, instances.Libraries)
+	if err != nil {
+		return err
+	}
+	defer releaseResources()
+
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return &arduino.InvalidInstanceError{}
+	}
+	defer release()
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return &arduino.InvalidInstanceError{}
+	}
+
+	platforms, err := resolvePlatformUpgrades(pme, req.GetPlan().GetPlatforms())
+	if err != nil {
+		return err
+	}
+	libs, err := resolveLibraryUpgrades(lm, req.GetPlan().GetLibraries())
+	if err != nil {
+		return err
+	}
+	toolsToInstall := []*cores.ToolRelease{}
+	for _, platform := range platforms {
+		for _, tool := range platform.tools {
+			if !tool.IsInstalled() && !slices.Contains(toolsToInstall, tool) {
+				toolsToInstall = append(toolsToInstall, tool)
+			}
+		}
+	}
+
+	// Download everything before changing the installation
+	taskCB(&rpc.TaskProgress{Name: tr("Downloading packages")})
+	for _, tool := range toolsToInstall {
+		if err := pme.DownloadToolRelease(ctx, tool, nil, downloadCB); err != nil {
+			return err
+		}
+	}
+	for _, platform := range platforms {
+		if err := pme.DownloadPlatformRelease(ctx, platform.target, nil, downloadCB); err != nil {
+			return err
+		}
+	}
+	if len(libs) > 0 {
+		config, err := httpclient.GetDownloaderConfig()
+		if err != nil {
+			return &arduino.FailedDownloadError{Message: tr("Can't download library"), Cause: err}
+		}
+		for _, lib := range libs {
+			reason := "upgrade"
+			if lib.task.ReplacedLib == nil {
+				reason = "depends"
+			}
+			if err := lib.release.Resource.Download(ctx, lm.DownloadsDir, config, lib.release.String(), downloadCB, reason); err != nil {
+				return &arduino.FailedDownloadError{Message: tr("Can't download library"), Cause: err}
+			}
+		}
+	}
+	taskCB(&rpc.TaskProgress{Completed: true})
+
+	// Install, keeping track of how to undo each step
+	rollback := []func(){}
+	backups := paths.PathList{}
+	fail := func(err error) error {
+		logrus.WithError(err).Error("Upgrade failed, restoring the previous installation")
+		taskCB(&rpc.TaskProgress{Message: tr("Upgrade failed, restoring the previous installation")})
+		for i := len(rollback) - 1; i >= 0; i-- {
+			rollback[i]()
+		}
+		return err
+	}
+	for _, tool := range toolsToInstall {
+		tool := tool
+		if err := pme.InstallTool(ctx, tool, taskCB, req.GetSkipPostInstall()); err != nil {
+			return fail(err)
+		}
+		rollback = append(rollback, func() { pme.UninstallTool(tool, taskCB, true) })
+	}
+	for _, platform := range platforms {
+		platform := platform
+		taskCB(&rpc.TaskProgress{Name: tr("Replacing platform %[1]s with %[2]s", platform.installed, platform.target)})
+		if err := pme.InstallPlatform(ctx, platform.target); err != nil {
+			return fail(&arduino.FailedInstallError{Message: tr("Cannot install platform"), Cause: err})
+		}
+		rollback = append(rollback, func() { pme.UninstallPlatform(platform.target, taskCB, true) })
+	}
+	for _, lib := range libs {
+		lib := lib
+		taskCB(&rpc.TaskProgress{Name: tr("Installing %s", lib.release)})
+		if replaced := lib.task.ReplacedLib; replaced != nil {
+			if modified, _, _ := librariesmanager.ModifiedFiles(replaced); len(modified) > 0 {
+				taskCB(&rpc.TaskProgress{Message: tr("WARNING the local changes to the files of %[1]s will be lost: %[2]s", replaced, strings.Join(modified, ", "))})
+			}
+			taskCB(&rpc.TaskProgress{Message: tr("Replacing %[1]s with %[2]s", replaced, lib.release)})
+			backup, err := paths.MkTempDir(replaced.InstallDir.Parent().String(), ".upgrade-")
+			if err != nil {
+				return fail(&arduino.FailedLibraryInstallError{Cause: err})
+			}
+			backups.Add(backup)
+			backupDir := backup.Join(replaced.InstallDir.Base())
+			if err := replaced.InstallDir.Rename(backupDir); err != nil {
+				return fail(&arduino.FailedLibraryInstallError{
+					Cause: fmt.Errorf("%s: %s", tr("could not remove old library"), err)})
+			}
+			rollback = append(rollback, func() {
+				lib.task.TargetPath.RemoveAll()
+				backupDir.Rename(replaced.InstallDir)
+				backup.RemoveAll()
+			})
+		} else {
+			rollback = append(rollback, func() { lib.task.TargetPath.RemoveAll() })
+		}
+		if err := lm.Install(ctx, lib.release, lib.task.TargetPath); err != nil {
+			return fail(&arduino.FailedLibraryInstallError{Cause: err})
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("Installed %s", lib.release), Completed: true})
+	}
+
+	// Everything is installed: drop the replaced libraries, platforms and tools
+	for _, backup := range backups {
+		backup.RemoveAll()
+	}
+	var uninstallErr error
+	for _, platform := range platforms {
+		if err := pme.UninstallPlatform(platform.installed, taskCB, req.GetSkipPreUninstall()); err != nil {
+			uninstallErr = err
+			continue
+		}
+		for _, tool := range platform.oldTools {
+			if tool.IsInstalled() && !pme.IsToolRequired(tool) {
+				taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s, tool is no more required", tool)})
+				pme.UninstallTool(tool, taskCB, req.GetSkipPreUninstall())
+			}
+		}
+	}
+	for _, platform := range platforms {
+		if req.GetSkipPostInstall() {
+			taskCB(&rpc.TaskProgress{Message: tr("Skipping platform configuration.")})
+		} else {
+			taskCB(&rpc.TaskProgress{Message: tr("Configuring platform.")})
+			stdout, stderr, err := pme.RunPreOrPostScript(platform.target.InstallDir, "post_install")
+			if len(stdout) > 0 {
+				taskCB(&rpc.TaskProgress{Message: string(stdout)})
+			}
+			if len(stderr) > 0 {
+				taskCB(&rpc.TaskProgress{Message: string(stderr)})
+			}
+			if err != nil {
+				taskCB(&rpc.TaskProgress{Message: tr("WARNING cannot configure platform: %s", err)})
+			}
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("Platform %s installed", platform.target), Completed: true})
+	}
+	return uninstallErr
+}
+
+// resolvePlatformUpgrades finds the releases and tools of the platform
+// upgrades of a plan, checking that the plan matches the installation.
+func resolvePlatformUpgrades(pme *packagemanager.Explorer, items []*rpc.UpgradePlanItem) ([]*platformUpgrade, error) {
+	res := []*platformUpgrade{}
+	for _, item := range items {
+		if item.GetVersion() == "" {
+			continue
+		}
+		packager, arch, ok := strings.Cut(item.GetName(), ":")
+		if !ok {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid platform ID: %s", item.GetName())}
+		}
+		version, err := semver.Parse(item.GetVersion())
+		if err != nil {
+			return nil, &arduino.InvalidVersionError{Cause: err}
+		}
+		ref := &packagemanager.PlatformReference{Package: packager, PlatformArchitecture: arch}
+		platform := pme.FindPlatform(ref)
+		if platform == nil {
+			return nil, &arduino.PlatformNotFoundError{Platform: item.GetName()}
+		}
+		installed := pme.GetInstalledPlatformRelease(platform)
+		if installed == nil || installed.Version.String() != item.GetInstalledVersion() {
+			return nil, &arduino.InvalidArgumentError{Message: tr("The upgrade plan is outdated: %[1]s %[2]s is not installed", item.GetName(), item.GetInstalledVersion())}
+		}
+		ref.PlatformVersion = version
+		target, tools, err := pme.FindPlatformReleaseDependencies(ref)
+		if err != nil {
+			return nil, &arduino.PlatformNotFoundError{Platform: ref.String(), Cause: err}
+		}
+		ref.PlatformVersion = installed.Version
+		_, oldTools, err := pme.FindPlatformReleaseDependencies(ref)
+		if err != nil {
+			return nil, &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", ref), Cause: err}
+		}
+		res = append(res, &platformUpgrade{installed: installed, target: target, tools: tools, oldTools: oldTools})
+	}
+	return res, nil
+}
+
+// resolveLibraryUpgrades finds the releases and the install locations of the
+// library upgrades of a plan, checking that the plan matches the installation.
+func resolveLibraryUpgrades(lm *librariesmanager.LibrariesManager, items []*rpc.UpgradePlanItem) ([]*libraryUpgrade, error) {
+	res := []*libraryUpgrade{}
+	for _, item := range items {
+		if item.GetVersion() == "" {
+			continue
+		}
+		version, err := semver.Parse(item.GetVersion())
+		if err != nil {
+			return nil, &arduino.InvalidVersionError{Cause: err}
+		}
+		release := lm.Index.FindRelease(&librariesindex.Reference{Name: item.GetName(), Version: version})
+		if release == nil {
+			return nil, &arduino.LibraryNotFoundError{Library: item.GetName() + "@" + item.GetVersion()}
+		}
+		task, err := lm.InstallPrerequisiteCheck(item.GetName(), version, libraries.User)
+		if err != nil {
+			return nil, err
+		}
+		installedVersion := ""
+		if task.ReplacedLib != nil && task.ReplacedLib.Version != nil {
+			installedVersion = task.ReplacedLib.Version.String()
+		}
+		if installedVersion != item.GetInstalledVersion() {
+			return nil, &arduino.InvalidArgumentError{Message: tr("The upgrade plan is outdated: %[1]s %[2]s is not installed", item.GetName(), item.GetInstalledVersion())}
+		}
+		res = append(res, &libraryUpgrade{release: release, task: task})
+	}
+	return res, nil
+}
@@ -0,0 +1,284 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upgrade
+
+import (
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// installedLibrary is a library found in one of the libraries directories
+type installedLibrary struct {
+	Name    string
+	Version *semver.Version
+	// Upgradable is true for the libraries installed in the user directory,
+	// not from git or zip, the only ones the plan may replace.
+	Upgradable bool
+}
+
+// pin is a version required by a sketch profile
+type pin struct {
+	Version *semver.Version
+	Profile string
+}
+
+// libraryPlan is the planned state of a library during the resolution
+type libraryPlan struct {
+	name      string
+	installed *semver.Version
+	// keepable is true if the library is installed and the plan may leave it
+	// untouched.
+	keepable bool
+	// upgradable is false for the installed libraries that the plan can't
+	// replace.
+	upgradable bool
+	// releases are the candidate releases, newest first.
+	releases []*librariesindex.Release
+	// choice is the index of the planned release, len(releases) means that
+	// the installed version is kept (or, for a new dependency, that no
+	// release is suitable).
+	choice int
+	reason string
+}
+
+// planned returns the release planned for the library, nil if the installed
+// version is kept or no release is suitable.
+func (l *libraryPlan) planned() *librariesindex.Release {
+	if l.choice < len(l.releases) {
+		return l.releases[l.choice]
+	}
+	return nil
+}
+
+// version returns the version of the library after the upgrade
+func (l *libraryPlan) version() *semver.Version {
+	if r := l.planned(); r != nil {
+		return r.Version
+	}
+	if l.keepable {
+		return l.installed
+	}
+	return nil
+}
+
+// matches returns true if the library after the upgrade satisfies the constraint
+func (l *libraryPlan) matches(constraint semver.Constraint) bool {
+	v := l.version()
+	if v == nil {
+		return false
+	}
+	return constraint == nil || constraint.Match(v)
+}
+
+// lower moves the choice to the newest release, older than the current
+// choice, that satisfies the constraint. It returns false if there is none.
+func (l *libraryPlan) lower(constraint semver.Constraint) bool {
+	for i := l.choice + 1; i <= len(l.releases); i++ {
+		save := l.choice
+		l.choice = i
+		if l.matches(constraint) {
+			return true
+		}
+		l.choice = save
+	}
+	return false
+}
+
+// dependencies returns the dependencies of the library after the upgrade
+func (l *libraryPlan) dependencies(idx *librariesindex.Index) []semver.Dependency {
+	if r := l.planned(); r != nil {
+		return r.GetDependencies()
+	}
+	if l.keepable && l.installed != nil {
+		if r := idx.FindRelease(&librariesindex.Reference{Name: l.name, Version: l.installed}); r != nil {
+			return r.GetDependencies()
+		}
+	}
+	return nil
+}
+
+// planLibraries computes the upgrade of the installed libraries. Every
+// library is moved to the newest release allowed by its pins such that the
+// resulting set satisfies the dependencies declared by all its members:
+// a library is lowered when the release required by one of its dependents
+// is older, and a dependent is lowered when no release of its dependency
+// fits. Every step only lowers a choice, so the resolution always ends.
+// Missing dependencies are added to the plan as new libraries.
+func planLibraries(idx *librariesindex.Index, installed []*installedLibrary, pins map[string][]*pin) []*rpc.UpgradePlanItem {
+	// A library in the user directory takes precedence over the others
+	installedByName := map[string]*installedLibrary{}
+	for _, lib := range installed {
+		if existing, ok := installedByName[lib.Name]; !ok || !existing.Upgradable {
+			installedByName[lib.Name] = lib
+		}
+	}
+
+	plans := map[string]*libraryPlan{}
+	names := []string{}
+	addPlan := func(name string, lib *installedLibrary) {
+		plan := &libraryPlan{name: name, upgradable: true}
+		if lib != nil {
+			plan.installed = lib.Version
+			plan.keepable = true
+			plan.upgradable = lib.Upgradable
+		}
+		if plan.upgradable {
+			plan.releases, plan.reason = candidateReleases(idx.Libraries[name], plan.installed, pins[name])
+		}
+		plans[name] = plan
+		names = append(names, name)
+		sort.Strings(names)
+	}
+	for name, lib := range installedByName {
+		addPlan(name, lib)
+	}
+
+	// step performs a single change to the plan, it returns false when all
+	// the dependencies are satisfied or can't be satisfied anymore.
+	step := func() bool {
+		for _, name := range names {
+			plan := plans[name]
+			if plan.version() == nil {
+				continue
+			}
+			for _, dep := range plan.dependencies(idx) {
+				depPlan, ok := plans[dep.GetName()]
+				if !ok {
+					addPlan(dep.GetName(), nil)
+					return true
+				}
+				if depPlan.matches(dep.GetConstraint()) {
+					continue
+				}
+				if depPlan.lower(dep.GetConstraint()) {
+					depPlan.reason = tr("%[1]s requires %[2]s", releaseName(plan), dependencyString(dep))
+					return true
+				}
+				if plan.choice < len(plan.releases) {
+					plan.choice++
+					plan.reason = tr("requires %s", dependencyString(dep))
+					return true
+				}
+				// The conflict is already present between the installed
+				// libraries and the plan can't solve it
+			}
+		}
+		return false
+	}
+	for step() {
+	}
+
+	// Only keep the new dependencies still required after the resolution
+	required := map[string]bool{}
+	for queue := names; len(queue) > 0; {
+		var next []string
+		for _, name := range queue {
+			plan := plans[name]
+			if !plan.keepable && !required[name] {
+				continue
+			}
+			for _, dep := range plan.dependencies(idx) {
+				if !required[dep.GetName()] {
+					required[dep.GetName()] = true
+					next = append(next, dep.GetName())
+				}
+			}
+		}
+		queue = next
+	}
+
+	res := []*rpc.UpgradePlanItem{}
+	for _, name := range names {
+		plan := plans[name]
+		indexLib := idx.Libraries[name]
+		if !plan.upgradable || indexLib == nil || indexLib.Latest == nil {
+			continue
+		}
+		latest := indexLib.Latest.Version
+		item := &rpc.UpgradePlanItem{Name: name, LatestVersion: latest.String()}
+		if plan.keepable {
+			if plan.installed != nil && !latest.GreaterThan(plan.installed) {
+				continue
+			}
+			if plan.installed != nil {
+				item.InstalledVersion = plan.installed.String()
+			}
+		} else if !required[name] || plan.planned() == nil {
+			continue
+		}
+		if r := plan.planned(); r != nil {
+			item.Version = r.Version.String()
+		}
+		if item.GetVersion() != item.GetLatestVersion() {
+			item.Reason = plan.reason
+		}
+		res = append(res, item)
+	}
+	return res
+}
+
+// candidateReleases returns the releases that may replace the installed
+// version, newest first, together with the reason of the restriction if
+// the pins exclude some of them.
+func candidateReleases(indexLib *librariesindex.Library, installed *semver.Version, pins []*pin) ([]*librariesindex.Release, string) {
+	if indexLib == nil {
+		return []*librariesindex.Release{}, ""
+	}
+	res := []*librariesindex.Release{}
+	reason := ""
+	for _, release := range indexLib.Releases {
+		if installed != nil && !release.Version.GreaterThan(installed) {
+			continue
+		}
+		if len(pins) > 0 && !pinned(release.Version, pins) {
+			reason = pinsReason(pins)
+			continue
+		}
+		res = append(res, release)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Version.GreaterThan(res[j].Version) })
+	return res, reason
+}
+
+// pinned returns true if one of the pins requires the version
+func pinned(version *semver.Version, pins []*pin) bool {
+	for _, p := range pins {
+		if p.Version.Equal(version) {
+			return true
+		}
+	}
+	return false
+}
+
+// pinsReason describes the pins of an item
+func pinsReason(pins []*pin) string {
+	p := pins[0]
+	return tr("pinned to %[1]s by profile %[2]s", p.Version, p.Profile)
+}
+
+func releaseName(plan *libraryPlan) string {
+	return plan.name + "@" + plan.version().String()
+}
+
+func dependencyString(dep semver.Dependency) string {
+	if dep.GetConstraint() == nil || dep.GetConstraint().String() == "" {
+		return dep.GetName()
+	}
+	return dep.GetName() + " " + dep.GetConstraint().String()
+}
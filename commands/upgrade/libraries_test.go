@@ -0,0 +1,110 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upgrade
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// testIndex builds an index from a map of "name@version" to the dependencies
+// of the release, in the library.properties format.
+func testIndex(t *testing.T, releases map[string][]string) *librariesindex.Index {
+	idx := &librariesindex.Index{Libraries: map[string]*librariesindex.Library{}}
+	for ref, deps := range releases {
+		name, version, _ := strings.Cut(ref, "@")
+		lib := idx.Libraries[name]
+		if lib == nil {
+			lib = &librariesindex.Library{Name: name, Releases: map[semver.NormalizedString]*librariesindex.Release{}, Index: idx}
+			idx.Libraries[name] = lib
+		}
+		release := &librariesindex.Release{Version: semver.MustParse(version), Library: lib}
+		for _, dep := range deps {
+			depName, constraint, _ := strings.Cut(dep, "@")
+			c, err := semver.ParseConstraint(constraint)
+			require.NoError(t, err)
+			release.Dependencies = append(release.Dependencies, &librariesindex.Dependency{Name: depName, VersionConstraint: c})
+		}
+		lib.Releases[release.Version.NormalizedString()] = release
+		if lib.Latest == nil || release.Version.GreaterThan(lib.Latest.Version) {
+			lib.Latest = release
+		}
+	}
+	return idx
+}
+
+func installedLibs(refs ...string) []*installedLibrary {
+	res := []*installedLibrary{}
+	for _, ref := range refs {
+		name, version, _ := strings.Cut(ref, "@")
+		res = append(res, &installedLibrary{Name: name, Version: semver.MustParse(version), Upgradable: true})
+	}
+	return res
+}
+
+func TestPlanLibraries(t *testing.T) {
+	idx := testIndex(t, map[string][]string{
+		"A@1.0.0": {"B@>=1.0.0"},
+		"A@1.5.0": {"B@>=1.0.0"},
+		"A@2.0.0": {"B@>=2.0.0", "C"},
+		"B@1.0.0": nil,
+		"B@1.5.0": nil,
+		"B@2.0.0": nil,
+		"B@3.0.0": nil,
+		"C@1.0.0": nil,
+		"C@1.1.0": nil,
+		"D@1.0.0": {"B@<3.0.0"},
+	})
+
+	t.Run("LatestReleases", func(t *testing.T) {
+		plan := planLibraries(idx, installedLibs("A@1.0.0", "B@1.0.0"), nil)
+		require.Equal(t, []*rpc.UpgradePlanItem{
+			{Name: "A", InstalledVersion: "1.0.0", LatestVersion: "2.0.0", Version: "2.0.0"},
+			{Name: "B", InstalledVersion: "1.0.0", LatestVersion: "3.0.0", Version: "3.0.0"},
+			{Name: "C", LatestVersion: "1.1.0", Version: "1.1.0"},
+		}, plan)
+	})
+
+	t.Run("DependencyLowered", func(t *testing.T) {
+		plan := planLibraries(idx, installedLibs("B@1.0.0", "D@1.0.0"), nil)
+		require.Equal(t, []*rpc.UpgradePlanItem{
+			{Name: "B", InstalledVersion: "1.0.0", LatestVersion: "3.0.0", Version: "2.0.0", Reason: "D@1.0.0 requires B <3.0.0"},
+		}, plan)
+	})
+
+	t.Run("PinnedDependency", func(t *testing.T) {
+		pins := map[string][]*pin{"B": {{Version: semver.MustParse("1.0.0"), Profile: "uno"}}}
+		plan := planLibraries(idx, installedLibs("A@1.0.0", "B@1.0.0"), pins)
+		require.Equal(t, []*rpc.UpgradePlanItem{
+			{Name: "A", InstalledVersion: "1.0.0", LatestVersion: "2.0.0", Version: "1.5.0", Reason: "requires B >=2.0.0"},
+			{Name: "B", InstalledVersion: "1.0.0", LatestVersion: "3.0.0", Reason: "pinned to 1.0.0 by profile uno"},
+		}, plan)
+	})
+
+	t.Run("NotUpgradable", func(t *testing.T) {
+		installed := installedLibs("A@1.0.0")
+		installed = append(installed, &installedLibrary{Name: "B", Version: semver.MustParse("1.0.0")})
+		plan := planLibraries(idx, installed, nil)
+		require.Equal(t, []*rpc.UpgradePlanItem{
+			{Name: "A", InstalledVersion: "1.0.0", LatestVersion: "2.0.0", Version: "1.5.0", Reason: "requires B >=2.0.0"},
+		}, plan)
+	})
+}
@@ -0,0 +1,163 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upgrade
+
+import (
+	"context"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+var tr = i18n.Tr
+
+// UpgradePlan computes the upgrade of all the installed platforms and
+// libraries. The versions pinned by the profiles of the sketch, if given,
+// are held.
+func UpgradePlan(ctx context.Context, req *rpc.UpgradePlanRequest) (*rpc.UpgradePlanResponse, error) {
+	platformPins, libraryPins, err := profilePins(req.GetSketchPath())
+	if err != nil {
+		return nil, err
+	}
+
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+	lm := instances.GetLibraryManager(req.GetInstance())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	installed := []*installedLibrary{}
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives {
+			installed = append(installed, &installedLibrary{
+				Name:       lib.Name,
+				Version:    lib.Version,
+				Upgradable: lib.Location == libraries.User && lib.InstallSource != libraries.GitSource && lib.InstallSource != libraries.ZipSource,
+			})
+		}
+	}
+
+	return &rpc.UpgradePlanResponse{
+		Plan: &rpc.UpgradePlan{
+			Platforms: planPlatforms(pme, platformPins, configuration.PinnedPlatforms(configuration.Settings)),
+			Libraries: planLibraries(lm.Index, installed, libraryPins),
+		},
+	}, nil
+}
+
+// planPlatforms computes the upgrade of the installed platforms: each one is
+// moved to its latest release, unless a pin or a missing tool prevents it.
+// The platforms are not moved past the versions they are held at.
+func planPlatforms(pme *packagemanager.Explorer, pins map[string][]*pin, held map[string]*semver.Version) []*rpc.UpgradePlanItem {
+	res := []*rpc.UpgradePlanItem{}
+	for _, targetPackage := range pme.GetPackages() {
+		for _, platform := range targetPackage.Platforms {
+			installed := pme.GetInstalledPlatformRelease(platform)
+			if installed == nil || !pme.IsManagedPlatformRelease(installed) {
+				continue
+			}
+			latest := platform.GetLatestRelease()
+			if latest == nil || !latest.Version.GreaterThan(installed.Version) {
+				continue
+			}
+			item := &rpc.UpgradePlanItem{
+				Name:             platform.String(),
+				InstalledVersion: installed.Version.String(),
+				LatestVersion:    latest.Version.String(),
+			}
+			res = append(res, item)
+
+			target := latest
+			if platformPins := pins[platform.String()]; len(platformPins) > 0 {
+				target = nil
+				for _, release := range platform.GetAllReleases() {
+					if release.Version.GreaterThan(installed.Version) && pinned(release.Version, platformPins) &&
+						(target == nil || release.Version.GreaterThan(target.Version)) {
+						target = release
+					}
+				}
+				if target != latest {
+					item.Reason = pinsReason(platformPins)
+				}
+				if target == nil {
+					continue
+				}
+			}
+			if heldVersion := held[platform.String()]; heldVersion != nil && target.Version.GreaterThan(heldVersion) {
+				target = nil
+				for _, release := range platform.GetAllReleases() {
+					if release.Version.GreaterThan(installed.Version) && !release.Version.GreaterThan(heldVersion) &&
+						(target == nil || release.Version.GreaterThan(target.Version)) {
+						target = release
+					}
+				}
+				item.Reason = tr("pinned to %s with core pin", heldVersion)
+				if target == nil {
+					continue
+				}
+			}
+			_, _, err := pme.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{
+				Package:              targetPackage.Name,
+				PlatformArchitecture: platform.Architecture,
+				PlatformVersion:      target.Version,
+			})
+			if err != nil {
+				item.Reason = tr("missing tool dependencies of %[1]s: %[2]s", target, err)
+				continue
+			}
+			item.Version = target.Version.String()
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].GetName() < res[j].GetName() })
+	return res
+}
+
+// profilePins returns the versions of the platforms and libraries required by
+// the profiles of a sketch, indexed by platform ID and library name.
+func profilePins(sketchPath string) (map[string][]*pin, map[string][]*pin, error) {
+	platformPins := map[string][]*pin{}
+	libraryPins := map[string][]*pin{}
+	if sketchPath == "" {
+		return platformPins, libraryPins, nil
+	}
+	sk, err := sketch.New(paths.New(sketchPath))
+	if err != nil {
+		return nil, nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+	for _, profile := range sk.Project.Profiles {
+		for _, platform := range profile.Platforms {
+			id := platform.Packager + ":" + platform.Architecture
+			platformPins[id] = append(platformPins[id], &pin{Version: platform.Version, Profile: profile.Name})
+		}
+		for _, lib := range profile.Libraries {
+			libraryPins[lib.Library] = append(libraryPins[lib.Library], &pin{Version: lib.Version, Profile: profile.Name})
+		}
+	}
+	return platformPins, libraryPins, nil
+}
@@ -0,0 +1,151 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/publish"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// saveImportArtifacts saves the build artifacts sent with the upload request
+// in a temporary folder, that must be removed by the caller. The names of the
+// artifacts must be plain file names.
+func saveImportArtifacts(artifacts []*rpc.BuildArtifact) (*paths.Path, error) {
+	for _, artifact := range artifacts {
+		name := artifact.GetName()
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\:`) {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid build artifact name: %s", name)}
+		}
+	}
+	dir, err := paths.MkTempDir("", "arduino-upload-artifacts")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	for _, artifact := range artifacts {
+		if err := dir.Join(artifact.GetName()).WriteFile(artifact.GetData()); err != nil {
+			dir.RemoveAll()
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error saving build artifact %s", artifact.GetName()), Cause: err}
+		}
+	}
+	return dir, nil
+}
+
+// ImportedBuildFQBN returns the FQBN recorded in the manifest of the build
+// artifacts selected with importFile or importDir, or an empty string if the
+// manifest is not available.
+func ImportedBuildFQBN(importFile, importDir string) string {
+	var manifestFile *paths.Path
+	if importFile != "" {
+		manifestFile = paths.New(importFile)
+		if !isBuildManifest(manifestFile) {
+			return ""
+		}
+	} else if importDir != "" {
+		manifestFile = findBuildManifest(paths.New(importDir))
+	}
+	if manifestFile == nil {
+		return ""
+	}
+	manifest, err := publish.LoadManifest(manifestFile)
+	if err != nil {
+		return ""
+	}
+	return manifest.FQBN
+}
+
+// isBuildManifest returns true if the file is a build manifest written by
+// `compile --export-binaries`.
+func isBuildManifest(file *paths.Path) bool {
+	return strings.HasSuffix(file.Base(), publish.ManifestFileSuffix)
+}
+
+// findBuildManifest returns the build manifest in dir, or nil if dir doesn't
+// contain exactly one manifest.
+func findBuildManifest(dir *paths.Path) *paths.Path {
+	files, err := dir.ReadDir()
+	if err != nil {
+		return nil
+	}
+	files.FilterOutDirs()
+	var manifestFile *paths.Path
+	for _, file := range files {
+		if !isBuildManifest(file) {
+			continue
+		}
+		if manifestFile != nil {
+			return nil
+		}
+		manifestFile = file
+	}
+	return manifestFile
+}
+
+// boardBuildPath returns the directory with the build artifacts for the
+// board, when buildPath contains the builds for several boards as exported
+// by `compile --export-binaries` in the `build` folder of the sketch (for
+// example `build/arduino.avr.uno`). Otherwise buildPath is returned.
+func boardBuildPath(buildPath *paths.Path, fqbn *cores.FQBN) *paths.Path {
+	if fqbn == nil {
+		return buildPath
+	}
+	boardPath := buildPath.Join(strings.ReplaceAll(fqbn.StringWithoutConfig(), ":", "."))
+	if boardPath.IsDir() {
+		return boardPath
+	}
+	return buildPath
+}
+
+// buildPathFromManifest returns the build path and the project name of the
+// artifacts listed in the build manifest, checking that they have been
+// compiled for the board.
+func buildPathFromManifest(manifestFile *paths.Path, fqbn *cores.FQBN) (*paths.Path, string, error) {
+	manifest, err := publish.LoadManifest(manifestFile)
+	if err != nil {
+		return nil, "", fmt.Errorf(tr("reading build manifest %[1]s: %[2]s"), manifestFile, err)
+	}
+	if fqbn != nil {
+		if buildFQBN, err := cores.ParseFQBN(manifest.FQBN); err == nil && buildFQBN.StringWithoutConfig() != fqbn.StringWithoutConfig() {
+			return nil, "", fmt.Errorf(tr("the build artifacts in %[1]s are compiled for %[2]s, not for %[3]s"), manifestFile.Parent(), buildFQBN.StringWithoutConfig(), fqbn.StringWithoutConfig())
+		}
+	}
+	return manifestFile.Parent(), strings.TrimSuffix(manifestFile.Base(), publish.ManifestFileSuffix), nil
+}
+
+// artifactProjectName returns the project name of a build artifact, for
+// example `Blink.ino` for `Blink.ino.hex` and for variants like
+// `Blink.ino.merged.bin` or `Blink.ino.with_bootloader.hex`, or an empty
+// string if the file is not a build artifact.
+func artifactProjectName(file *paths.Path) string {
+	name := file.Base()
+	for {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+		ext := filepath.Ext(name)
+		if ext == "" {
+			return ""
+		}
+		if globals.MainFileValidExtensions[ext] {
+			return name
+		}
+	}
+}
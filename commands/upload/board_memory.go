@@ -0,0 +1,231 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/nvs"
+	"github.com/arduino/arduino-cli/arduino/partitions"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/commands/monitor"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// EEPROMRead reads the content of the EEPROM of a board with the
+// `upload.eeprom.read.pattern` recipe of the upload tool.
+func EEPROMRead(ctx context.Context, req *rpc.BoardEEPROMReadRequest, outStream, errStream io.Writer) (*rpc.BoardEEPROMReadResult, error) {
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+
+	tmp, err := paths.MkTempDir("", "arduino-eeprom")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+	file := tmp.Join("eeprom.bin")
+
+	props := properties.NewMap()
+	props.SetPath("eeprom.file", file)
+	action := &toolAction{
+		Recipe:         "eeprom.read",
+		Properties:     props,
+		FailureMessage: tr("Failed reading EEPROM"),
+	}
+	if err := runBoardToolAction(ctx, pme, req.GetFqbn(), req.GetPort(), req.GetVerbose(), action, outStream, errStream); err != nil {
+		return nil, err
+	}
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, &arduino.FailedUploadError{Message: tr("Failed reading EEPROM"), Cause: err}
+	}
+	return &rpc.BoardEEPROMReadResult{Data: data}, nil
+}
+
+// EEPROMWrite writes the content of the EEPROM of a board with the
+// `upload.eeprom.write.pattern` recipe of the upload tool.
+func EEPROMWrite(ctx context.Context, req *rpc.BoardEEPROMWriteRequest, outStream, errStream io.Writer) (*rpc.BoardEEPROMWriteResult, error) {
+	if len(req.GetData()) == 0 {
+		return nil, &arduino.InvalidArgumentError{Message: tr("No data to write in the EEPROM")}
+	}
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+
+	tmp, err := paths.MkTempDir("", "arduino-eeprom")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+	file := tmp.Join("eeprom.bin")
+	if err := file.WriteFile(req.GetData()); err != nil {
+		return nil, &arduino.TempFileCreationFailedError{Cause: err}
+	}
+
+	props := properties.NewMap()
+	props.SetPath("eeprom.file", file)
+	action := &toolAction{
+		Recipe:         "eeprom.write",
+		Properties:     props,
+		FailureMessage: tr("Failed writing EEPROM"),
+	}
+	if err := runBoardToolAction(ctx, pme, req.GetFqbn(), req.GetPort(), req.GetVerbose(), action, outStream, errStream); err != nil {
+		return nil, err
+	}
+	return &rpc.BoardEEPROMWriteResult{}, nil
+}
+
+// NVSRead reads the NVS partition of a board with the
+// `upload.flash.read.pattern` recipe of the upload tool and parses it.
+func NVSRead(ctx context.Context, req *rpc.BoardNVSReadRequest, outStream, errStream io.Writer) (*rpc.BoardNVSReadResult, error) {
+	data := req.GetData()
+	if len(data) == 0 {
+		var err error
+		if data, err = readNVSPartition(ctx, req, outStream, errStream); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := nvs.Parse(data)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid NVS partition"), Cause: err}
+	}
+	res := &rpc.BoardNVSReadResult{Data: data}
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, &rpc.NVSEntry{
+			Namespace: entry.Namespace,
+			Key:       entry.Key,
+			Type:      entry.Type,
+			Value:     entry.Value,
+		})
+	}
+	return res, nil
+}
+
+func readNVSPartition(ctx context.Context, req *rpc.BoardNVSReadRequest, outStream, errStream io.Writer) ([]byte, error) {
+	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer release()
+
+	fqbn, err := cores.ParseFQBN(req.GetFqbn())
+	if err != nil {
+		return nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+	_, _, _, buildProperties, _, err := pme.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, &arduino.UnknownFQBNError{Cause: err}
+	}
+
+	// Locate the NVS partition
+	var partitionsFile *paths.Path
+	if req.GetSketchPath() != "" {
+		sk, err := sketch.NewWithSymlinksPolicy(paths.New(req.GetSketchPath()), configuration.SketchSymlinksPolicy(configuration.Settings))
+		if err != nil {
+			return nil, &arduino.CantOpenSketchError{Cause: err}
+		}
+		partitionsFile = sk.GetPartitionsFile()
+	}
+	if partitionsFile == nil {
+		partitionsFile = partitions.BoardDefaultFile(buildProperties)
+	}
+	if partitionsFile == nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("The board %s has no partition table", fqbn)}
+	}
+	table, err := partitions.Load(partitionsFile)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Error reading partition table %s", partitionsFile), Cause: err}
+	}
+	name := req.GetPartition()
+	if name == "" {
+		name = "nvs"
+	}
+	partition := table.FindByName(name)
+	if partition == nil || partition.Type != "data" || partition.SubType != "nvs" {
+		return nil, &arduino.NotFoundError{Message: tr("NVS partition %[1]s not found in %[2]s", name, partitionsFile)}
+	}
+
+	tmp, err := paths.MkTempDir("", "arduino-nvs")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+	file := tmp.Join("nvs.bin")
+
+	props := properties.NewMap()
+	props.Set("flash.offset", fmt.Sprintf("0x%X", partition.Offset))
+	props.Set("flash.size", fmt.Sprintf("0x%X", partition.Size))
+	props.SetPath("flash.file", file)
+	action := &toolAction{
+		Recipe:         "flash.read",
+		Properties:     props,
+		FailureMessage: tr("Failed reading NVS partition"),
+	}
+	if err := runBoardToolAction(ctx, pme, req.GetFqbn(), req.GetPort(), req.GetVerbose(), action, outStream, errStream); err != nil {
+		return nil, err
+	}
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, &arduino.FailedUploadError{Message: tr("Failed reading NVS partition"), Cause: err}
+	}
+	return data, nil
+}
+
+// runBoardToolAction runs an action of the upload tool of the board on the
+// given port, pausing the monitors opened on it.
+func runBoardToolAction(ctx context.Context, pme *packagemanager.Explorer, fqbn string, port *rpc.Port, verbose bool, action *toolAction, outStream, errStream io.Writer) error {
+	var updatedPort *rpc.Port
+	resumeMonitors := monitor.PauseSessions(port)
+	defer func() { resumeMonitors(updatedPort) }()
+
+	var err error
+	updatedPort, err = runProgramAction(
+		ctx, pme,
+		nil, // sketch
+		"",  // importFile
+		"",  // importDir
+		fqbn,
+		port,
+		"", // programmer
+		verbose,
+		false, // verify
+		false, // burnBootloader
+		outStream,
+		errStream,
+		false,               // dryRun
+		map[string]string{}, // User fields
+		nil,                 // Reset properties
+		nil,                 // Upload options
+		false,               // skipBootloaderCheck
+		action,
+	)
+	return err
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/commands/monitor"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 )
@@ -33,14 +34,26 @@ func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderRequest, outStre
 		WithField("programmer", req.GetProgrammer()).
 		Trace("BurnBootloader started", req.GetFqbn())
 
+	releaseResources, err := instances.AcquireRead(req.GetInstance(), "BurnBootloader", instances.Platforms)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseResources()
+
 	pme, release := instances.GetPackageManagerExplorer(req.GetInstance())
 	if pme == nil {
 		return nil, &arduino.InvalidInstanceError{}
 	}
 	defer release()
 
-	_, err := runProgramAction(
-		pme,
+	// Release the port if a monitor is using it
+	if !req.GetDryRun() {
+		resumeMonitors := monitor.PauseSessions(req.GetPort())
+		defer resumeMonitors(nil)
+	}
+
+	_, err = runProgramAction(
+		ctx, pme,
 		nil, // sketch
 		"",  // importFile
 		"",  // importDir
@@ -54,6 +67,10 @@ func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderRequest, outStre
 		errStream,
 		req.GetDryRun(),
 		map[string]string{}, // User fields
+		nil,                 // Reset properties
+		nil,                 // Upload options
+		false,               // skipBootloaderCheck
+		nil,                 // Tool action
 	)
 	if err != nil {
 		return nil, err
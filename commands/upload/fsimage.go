@@ -0,0 +1,131 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"io"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	fslayout "github.com/arduino/arduino-cli/arduino/fsimage"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/fsimage"
+	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/commands/monitor"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// FilesystemImageUpload flashes the filesystem image of the data folder of a
+// sketch in the partition of the board reserved to the filesystem, building
+// the image first unless an already built image is given.
+func FilesystemImageUpload(ctx context.Context, req *rpc.FilesystemImageUploadRequest, outStream, errStream io.Writer) (*rpc.FilesystemImageUploadResult, error) {
+	logrus.Tracef("Filesystem image upload %s on %s started", req.GetSketchPath(), req.GetFqbn())
+
+	sk, err := fsimage.LoadSketch(req.GetSketchPath())
+	if err != nil {
+		return nil, err
+	}
+
+	pme, pmeRelease := instances.GetPackageManagerExplorer(req.GetInstance())
+	if pme == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	defer pmeRelease()
+
+	image, buildProperties, imagePath, err := prepareFilesystemImage(ctx, pme, sk, req.GetFqbn(), req.GetFsType(), req.GetImagePath(), req.GetVerbose(), outStream, errStream)
+	if err != nil {
+		return nil, err
+	}
+
+	var updatedPort *rpc.Port
+
+	// Release the port if a monitor is using it
+	if !req.GetDryRun() {
+		resumeMonitors := monitor.PauseSessions(req.GetPort())
+		defer func() { resumeMonitors(updatedPort) }()
+	}
+
+	updatedPort, err = uploadFilesystemImage(ctx, pme, sk, image, buildProperties, imagePath, req.GetPort(), req.GetVerbose(), req.GetDryRun(), outStream, errStream)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.FilesystemImageUploadResult{
+		Image:             fsimage.ImageToRPC(image, imagePath),
+		UpdatedUploadPort: updatedPort,
+	}, nil
+}
+
+// prepareFilesystemImage resolves the layout of the filesystem image of the
+// sketch for the given board and builds the image, unless the path of an
+// already built image is given. It returns the image layout, the build
+// properties of the board and the path of the image.
+func prepareFilesystemImage(ctx context.Context, pme *packagemanager.Explorer, sk *sketch.Sketch, fqbn, fsType, imagePathIn string, verbose bool, outStream, errStream io.Writer) (*fslayout.Image, *properties.Map, *paths.Path, error) {
+	image, buildProperties, err := fsimage.Resolve(pme, sk, fqbn, fsType)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var imagePath *paths.Path
+	if imagePathIn != "" {
+		imagePath = paths.New(imagePathIn)
+		if !imagePath.Exist() {
+			return nil, nil, nil, &arduino.NotFoundError{Message: tr("Filesystem image %s not found", imagePath)}
+		}
+	} else {
+		imagePath = fsimage.DefaultImagePath(sk, image)
+		if err := fsimage.BuildImage(ctx, sk, image, buildProperties, imagePath, pme.GetEnvVarsForSpawnedProcess(), verbose, outStream, errStream); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if size, err := imagePath.Stat(); err == nil && uint64(size.Size()) > image.Size {
+		return nil, nil, nil, &arduino.InvalidArgumentError{Message: tr("The filesystem image is bigger than the partition (%[1]d > %[2]d bytes)", size.Size(), image.Size)}
+	}
+	return image, buildProperties, imagePath, nil
+}
+
+// uploadFilesystemImage flashes the filesystem image in the partition of the
+// board reserved to the filesystem, it returns the port where the board
+// reconnects after the upload.
+func uploadFilesystemImage(ctx context.Context, pme *packagemanager.Explorer, sk *sketch.Sketch, image *fslayout.Image, buildProperties *properties.Map, imagePath *paths.Path, port *rpc.Port, verbose, dryRun bool, outStream, errStream io.Writer) (*rpc.Port, error) {
+	return runProgramAction(
+		ctx, pme,
+		sk,
+		"", // importFile
+		"", // importDir
+		buildProperties.Get("build.fqbn"),
+		port,
+		"", // programmer
+		verbose,
+		false, // verify
+		false, // burnBootloader
+		outStream,
+		errStream,
+		dryRun,
+		map[string]string{}, // User fields
+		nil,                 // Reset properties
+		nil,                 // Upload options
+		false,               // skipBootloaderCheck
+		&toolAction{
+			Recipe:         "fsimage",
+			Properties:     image.Properties(nil, imagePath),
+			FailureMessage: tr("Failed uploading filesystem image"),
+		},
+	)
+}
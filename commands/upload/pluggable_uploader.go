@@ -0,0 +1,144 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/arduino/arduino-cli/arduino/uploader"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// findPluggableUploader returns the pluggable uploader that implements the
+// upload tool, or nil if the tool is run through the recipes of the platform.
+func findPluggableUploader(pme *packagemanager.Explorer, platform *cores.PlatformRelease, toolID string, props *properties.Map, toolEnv []string) (*uploader.PluggableUploader, error) {
+	if platform == nil {
+		return nil, nil
+	}
+	if dep, ok := platform.Uploaders[toolID]; ok {
+		tool := pme.FindUploaderDependency(dep)
+		if tool == nil {
+			return nil, &arduino.NotFoundError{Message: tr("Pluggable uploader %s not found", dep)}
+		}
+		return uploader.New(dep.Name, toolEnv, tool.InstallDir.Join(dep.Name).String()), nil
+	}
+	if recipe, ok := platform.UploadersDevRecipes[toolID]; ok {
+		cmdArgs, err := properties.SplitQuotedString(props.ExpandPropsInString(recipe), `"'`, false)
+		if err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid recipe in platform.txt"), Cause: err}
+		}
+		return uploader.New(fmt.Sprintf("%s-%s", platform, toolID), toolEnv, cmdArgs...), nil
+	}
+	return nil, nil
+}
+
+// pluggableUploaderSession runs the actions of an upload with a pluggable
+// uploader, starting it at the first action.
+type pluggableUploaderSession struct {
+	uploader    *uploader.PluggableUploader
+	started     bool
+	updatedPort *discovery.Port
+}
+
+// run sends the action to the uploader. The properties of the action (e.g.,
+// `upload.*` for the `upload` action) and the additional actionProperties
+// are sent expanded.
+func (s *pluggableUploaderSession) run(ctx context.Context, action, fqbn string, port *discovery.Port, props, actionProperties *properties.Map, outStream, errStream io.Writer, verbose, verify, dryRun bool) error {
+	prefix := action
+	if !slices.Contains([]string{"upload", "program", "erase", "bootloader"}, action) {
+		// Additional actions of the upload tool share its properties
+		prefix = "upload"
+	}
+	req := &uploader.Request{
+		Action:      action,
+		FQBN:        fqbn,
+		BuildPath:   props.Get("build.path"),
+		ProjectName: props.Get("build.project_name"),
+		Verbose:     verbose,
+		Verify:      verify,
+		Properties:  map[string]string{},
+	}
+	if port.Address != "" {
+		req.Port = &uploader.Port{
+			Address:       port.Address,
+			Label:         port.AddressLabel,
+			Protocol:      port.Protocol,
+			ProtocolLabel: port.ProtocolLabel,
+		}
+		if port.Properties != nil {
+			req.Port.Properties = port.Properties.AsMap()
+		}
+	}
+	for key, value := range props.SubTree(prefix).AsMap() {
+		req.Properties[prefix+"."+key] = props.ExpandPropsInString(value)
+	}
+	if actionProperties != nil {
+		for _, key := range actionProperties.Keys() {
+			req.Properties[key] = props.ExpandPropsInString(props.Get(key))
+		}
+	}
+
+	logrus.WithField("phase", "upload").WithField("uploader", s.uploader).Tracef("Running %s action", action)
+	if verbose {
+		data, _ := json.Marshal(req)
+		fmt.Fprintln(outStream, s.uploader.String(), "UPLOAD", string(data))
+	}
+	if dryRun {
+		return nil
+	}
+	if !s.started {
+		if err := s.uploader.Run(ctx); err != nil {
+			return fmt.Errorf(tr("cannot execute upload tool: %s"), err)
+		}
+		s.started = true
+	}
+	updated, err := s.uploader.Upload(req, outStream, errStream)
+	if err != nil {
+		if ctx.Err() != nil {
+			// The uploader has been killed because the upload was canceled
+			return context.Cause(ctx)
+		}
+		return err
+	}
+	if updated != nil {
+		s.updatedPort = &discovery.Port{
+			Address:       updated.Address,
+			AddressLabel:  updated.Label,
+			Protocol:      updated.Protocol,
+			ProtocolLabel: updated.ProtocolLabel,
+			Properties:    properties.NewFromHashmap(updated.Properties),
+		}
+	}
+	return nil
+}
+
+// close terminates the uploader if it has been started
+func (s *pluggableUploaderSession) close() {
+	if s.started {
+		if err := s.uploader.Quit(); err != nil {
+			logrus.WithError(err).Warn("Error terminating the pluggable uploader")
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/arduino/arduino-cli/arduino/recovery"
+	"github.com/arduino/arduino-cli/executils"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// recoveryTarget is a board found in bootloader mode while its usual upload
+// port is absent. The sketch is uploaded either through the port exposed by
+// the bootloader (a serial port or a DFU device) or by copying it to the
+// drive of a UF2 bootloader.
+type recoveryTarget struct {
+	port     *discovery.Port
+	uf2Drive *recovery.UF2Drive
+}
+
+func (t *recoveryTarget) String() string {
+	if t.uf2Drive != nil {
+		return tr("UF2 drive %s", t.uf2Drive)
+	}
+	return fmt.Sprintf("%s (%s)", t.port.Address, t.port.Protocol)
+}
+
+// recoveryPortTimeout is the time given to the discoveries to report the
+// upload port or the port of the bootloader, they may be still starting up.
+var recoveryPortTimeout = 2 * time.Second
+
+// findRecoveryTarget looks for the board in bootloader mode when the port
+// selected by the user is not connected. The bootloaders to look for are
+// declared by the board through the `upload.recovery.*` properties, there is
+// no default: the boards that don't declare them are never recovered.
+func findRecoveryTarget(ctx context.Context, pme *packagemanager.Explorer, userPort *discovery.Port, props *properties.Map, toolEnv []string) *recoveryTarget {
+	recoveryProps := props.SubTree("upload.recovery")
+	if recoveryProps.Size() == 0 {
+		return nil
+	}
+
+	// Ports exposed by the bootloader and reported by the discoveries (for
+	// example the serial port of a bootloader waiting for bossac)
+	portIDs := recoveryProps.ExtractSubIndexSets("port")
+	dm := pme.DiscoveryManager()
+	deadline := time.Now().Add(recoveryPortTimeout)
+	for {
+		ports := dm.List()
+		for _, p := range ports {
+			if userPort.Address != "" && p.Address == userPort.Address && p.Protocol == userPort.Protocol {
+				return nil
+			}
+		}
+		for _, idProps := range portIDs {
+			for _, p := range ports {
+				if p.Properties != nil && matchesProperties(p.Properties, idProps) {
+					return &recoveryTarget{port: p}
+				}
+			}
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	// Devices in DFU mode
+	if dfuIDs := recoveryProps.ExtractSubIndexSets("dfu"); len(dfuIDs) > 0 {
+		for _, dev := range listDFUDevices(ctx, props, toolEnv) {
+			for _, id := range dfuIDs {
+				if strings.EqualFold(id.Get("vid"), dev.VID) && strings.EqualFold(id.Get("pid"), dev.PID) {
+					return &recoveryTarget{port: dfuPort(dev)}
+				}
+			}
+		}
+	}
+
+	// Drives of UF2 bootloaders
+	if boardID, ok := recoveryProps.GetOk("uf2.board_id"); ok {
+		for _, drive := range recovery.FindUF2Drives() {
+			if drive.BoardID == boardID {
+				return &recoveryTarget{uf2Drive: drive}
+			}
+		}
+	}
+	return nil
+}
+
+// matchesProperties returns true if all the properties in query have the
+// same value in props
+func matchesProperties(props, query *properties.Map) bool {
+	for k, v := range query.AsMap() {
+		if !strings.EqualFold(props.Get(k), v) {
+			return false
+		}
+	}
+	return true
+}
+
+// listDFUDevices returns the devices in DFU mode listed by the dfu-util tool
+// of the platform, if installed.
+func listDFUDevices(ctx context.Context, props *properties.Map, toolEnv []string) []*recovery.DFUDevice {
+	toolDir, ok := props.GetOk("runtime.tools.dfu-util.path")
+	if !ok {
+		logrus.Debug("dfu-util not installed, skipping detection of DFU devices")
+		return nil
+	}
+	cmd, err := executils.NewProcessFromPath(toolEnv, paths.New(toolDir).Join("dfu-util"), "-l")
+	if err != nil {
+		logrus.WithError(err).Debug("Cannot run dfu-util")
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	stdout, _, err := cmd.RunAndCaptureOutput(ctx)
+	if err != nil {
+		logrus.WithError(err).Debug("Error listing DFU devices")
+	}
+	return recovery.ParseDFUList(stdout)
+}
+
+// dfuPort returns the port used to upload to a device in DFU mode
+func dfuPort(dev *recovery.DFUDevice) *discovery.Port {
+	portProps := properties.NewMap()
+	portProps.Set("vid", dev.VID)
+	portProps.Set("pid", dev.PID)
+	portProps.Set("serial", dev.Serial)
+	return &discovery.Port{
+		Address:       dev.Address(),
+		AddressLabel:  dev.Address(),
+		Protocol:      "dfu",
+		ProtocolLabel: "DFU",
+		HardwareID:    dev.Serial,
+		Properties:    portProps,
+	}
+}
+
+// flashUF2 copies the UF2 firmware of the sketch to the drive of the bootloader
+func flashUF2(drive *recovery.UF2Drive, props *properties.Map, outStream io.Writer, verbose, dryRun bool) error {
+	firmware := paths.New(props.Get("build.path")).Join(props.Get("build.project_name") + ".uf2")
+	if file, ok := props.GetOk("upload.recovery.uf2.file"); ok {
+		firmware = paths.New(props.ExpandPropsInString(file))
+	}
+	if !firmware.Exist() {
+		return &arduino.NotFoundError{Message: tr("UF2 firmware not found in %s", firmware)}
+	}
+	if verbose {
+		outStream.Write([]byte(fmt.Sprintln(tr("Copying %[1]s to %[2]s", firmware, drive.Path))))
+	}
+	if dryRun {
+		return nil
+	}
+	return drive.Flash(firmware)
+}
@@ -19,7 +19,8 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
 	"time"
 
@@ -27,10 +28,17 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/discovery"
+	fslayout "github.com/arduino/arduino-cli/arduino/fsimage"
 	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/recovery"
 	"github.com/arduino/arduino-cli/arduino/serialutils"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/arduino/uploadprogress"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/fsimage"
 	"github.com/arduino/arduino-cli/commands/internal/instances"
+	"github.com/arduino/arduino-cli/commands/monitor"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/i18n"
 	f "github.com/arduino/arduino-cli/internal/algorithms"
@@ -39,6 +47,7 @@ import (
 	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
 var tr = i18n.Tr
@@ -126,17 +135,70 @@ func getUserFields(toolID string, platformRelease *cores.PlatformRelease) []*rpc
 }
 
 // Upload FIXMEDOC
-func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, errStream io.Writer) (*rpc.UploadResult, error) {
+// The progress of the upload, parsed from the output of the upload tool, is
+// reported to progressCB if not nil.
+func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, errStream io.Writer, progressCB rpc.TaskProgressCB) (_ *rpc.UploadResult, err error) {
 	logrus.Tracef("Upload %s on %s started", req.GetSketchPath(), req.GetFqbn())
 
+	// The artifacts sent with the request are uploaded as an imported build
+	if artifacts := req.GetImportArtifacts(); len(artifacts) > 0 {
+		if req.GetSketchPath() != "" || req.GetImportFile() != "" || req.GetImportDir() != "" || req.GetWithFs() {
+			return nil, &arduino.InvalidArgumentError{Message: tr("The build artifacts can't be sent together with a sketch or a build directory")}
+		}
+		importDir, err := saveImportArtifacts(artifacts)
+		if err != nil {
+			return nil, err
+		}
+		defer importDir.RemoveAll()
+		req = proto.Clone(req).(*rpc.UploadRequest)
+		req.ImportDir = importDir.String()
+		req.ImportArtifacts = nil
+	}
+
+	// When the filesystem image is uploaded too, the first half of the progress
+	// is the sketch upload and the second half the filesystem image upload
+	uploadingFilesystemImage := false
+	var reportProgress func(p *uploadprogress.Progress)
+	if progressCB != nil {
+		reportProgress = func(p *uploadprogress.Progress) {
+			percent, completed := p.Percent, p.Completed
+			if req.GetWithFs() {
+				percent /= 2
+				if uploadingFilesystemImage {
+					percent += 50
+				} else {
+					completed = false
+				}
+			}
+			progressCB(&rpc.TaskProgress{Name: p.Stage, Percent: percent, Completed: completed})
+		}
+	}
+	// The output of each upload is parsed from scratch, to not lose the
+	// progress of the second upload if it's the same last reported
+	toolOutStream, toolErrStream := outStream, errStream
+	progressStreams := func() (io.Writer, io.Writer) {
+		if reportProgress == nil {
+			return toolOutStream, toolErrStream
+		}
+		return uploadprogress.NewWriter(toolOutStream, uploadprogress.Parsers(), reportProgress),
+			uploadprogress.NewWriter(toolErrStream, uploadprogress.Parsers(), reportProgress)
+	}
+	outStream, errStream = progressStreams()
+
 	// TODO: make a generic function to extract sketch from request
 	// and remove duplication in commands/compile.go
 	sketchPath := paths.New(req.GetSketchPath())
-	sk, err := sketch.New(sketchPath)
+	sk, err := sketch.NewWithSymlinksPolicy(sketchPath, configuration.SketchSymlinksPolicy(configuration.Settings))
 	if err != nil && req.GetImportDir() == "" && req.GetImportFile() == "" {
 		return nil, &arduino.CantOpenSketchError{Cause: err}
 	}
 
+	releaseResources, err := instances.AcquireRead(req.GetInstance(), "Upload", instances.Platforms)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseResources()
+
 	pme, pmeRelease := instances.GetPackageManagerExplorer(req.GetInstance())
 	if pme == nil {
 		return nil, &arduino.InvalidInstanceError{}
@@ -147,9 +209,35 @@ func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, er
 	if fqbn == "" && pme.GetProfile() != nil {
 		fqbn = pme.GetProfile().FQBN
 	}
+	if fqbn == "" {
+		fqbn = ImportedBuildFQBN(req.GetImportFile(), req.GetImportDir())
+	}
+
+	// The filesystem image is built before the upload, to not leave the board
+	// with only the sketch updated if the image can't be built
+	var fsImage *fslayout.Image
+	var fsBuildProperties *properties.Map
+	var fsImagePath *paths.Path
+	if req.GetWithFs() {
+		if sk == nil {
+			return nil, &arduino.MissingSketchPathError{}
+		}
+		fsImage, fsBuildProperties, fsImagePath, err = prepareFilesystemImage(ctx, pme, sk, fqbn, req.GetFsType(), "", req.GetVerbose(), outStream, errStream)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var updatedPort *rpc.Port
 
-	updatedPort, err := runProgramAction(
-		pme,
+	// Release the port if a monitor is using it
+	if !req.GetDryRun() {
+		resumeMonitors := monitor.PauseSessions(req.GetPort())
+		defer func() { resumeMonitors(updatedPort) }()
+	}
+
+	updatedPort, err = runProgramAction(
+		ctx, pme,
 		sk,
 		req.GetImportFile(),
 		req.GetImportDir(),
@@ -163,18 +251,41 @@ func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, er
 		errStream,
 		req.GetDryRun(),
 		req.GetUserFields(),
+		req.GetResetProperties(),
+		req.GetUploadOptions(),
+		req.GetSkipBootloaderCheck(),
+		nil, // toolAction
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &rpc.UploadResult{
+	result := &rpc.UploadResult{
 		UpdatedUploadPort: updatedPort,
-	}, nil
+	}
+	if req.GetWithFs() {
+		uploadingFilesystemImage = true
+		fsOutStream, fsErrStream := progressStreams()
+		// The board may have been reconnected on another port after the sketch upload
+		port := req.GetPort()
+		if updatedPort != nil {
+			port = updatedPort
+		}
+		fsUpdatedPort, err := uploadFilesystemImage(ctx, pme, sk, fsImage, fsBuildProperties, fsImagePath, port, req.GetVerbose(), req.GetDryRun(), fsOutStream, fsErrStream)
+		if err != nil {
+			return nil, err
+		}
+		if fsUpdatedPort != nil {
+			updatedPort = fsUpdatedPort
+			result.UpdatedUploadPort = fsUpdatedPort
+		}
+		result.FilesystemImage = fsimage.ImageToRPC(fsImage, fsImagePath)
+	}
+	return result, nil
 }
 
 // UsingProgrammer FIXMEDOC
-func UsingProgrammer(ctx context.Context, req *rpc.UploadUsingProgrammerRequest, outStream io.Writer, errStream io.Writer) error {
+func UsingProgrammer(ctx context.Context, req *rpc.UploadUsingProgrammerRequest, outStream io.Writer, errStream io.Writer, progressCB rpc.TaskProgressCB) error {
 	logrus.Tracef("Upload using programmer %s on %s started", req.GetSketchPath(), req.GetFqbn())
 
 	if req.GetProgrammer() == "" {
@@ -191,17 +302,37 @@ func UsingProgrammer(ctx context.Context, req *rpc.UploadUsingProgrammerRequest,
 		Verbose:    req.GetVerbose(),
 		Verify:     req.GetVerify(),
 		UserFields: req.GetUserFields(),
-	}, outStream, errStream)
+	}, outStream, errStream, progressCB)
 	return err
 }
 
-func runProgramAction(pme *packagemanager.Explorer,
+// toolAction is an operation on the board, other than the sketch upload,
+// performed with a dedicated recipe of the upload tool of the board (for
+// example flashing a filesystem image or reading the EEPROM).
+type toolAction struct {
+	// Recipe is the name of the recipe of the upload tool, `upload.<Recipe>.pattern`
+	Recipe string
+	// Properties are the additional properties used by the recipe
+	Properties *properties.Map
+	// FailureMessage is the message of the error returned if the recipe fails
+	FailureMessage string
+}
+
+func (a *toolAction) recipeID() string {
+	return "upload." + a.Recipe + ".pattern"
+}
+
+func runProgramAction(ctx context.Context, pme *packagemanager.Explorer,
 	sk *sketch.Sketch,
 	importFile, importDir, fqbnIn string, userPort *rpc.Port,
 	programmerID string,
 	verbose, verify, burnBootloader bool,
 	outStream, errStream io.Writer,
 	dryRun bool, userFields map[string]string,
+	resetProperties map[string]string,
+	uploadOptions map[string]string,
+	skipBootloaderCheck bool,
+	toolAction *toolAction,
 ) (*rpc.Port, error) {
 	port := discovery.PortFromRPCPort(userPort)
 	if port == nil || (port.Address == "" && port.Protocol == "") {
@@ -258,6 +389,29 @@ func runProgramAction(pme *packagemanager.Explorer,
 	if programmer != nil {
 		props.Merge(programmer.Properties)
 	}
+
+	// A board stuck in bootloader mode can't be reached through its usual
+	// port: look for its bootloader and upload through it
+	var stuckBoard *recoveryTarget
+	if programmer == nil && !burnBootloader && toolAction == nil {
+		stuckBoard = findRecoveryTarget(ctx, pme, port, props, pme.GetEnvVarsForSpawnedProcess())
+		if stuckBoard != nil {
+			outStream.Write([]byte(fmt.Sprintln(tr("Board in bootloader mode found on %s, performing a recovery upload", stuckBoard))))
+			if stuckBoard.port != nil {
+				port = stuckBoard.port
+			}
+		}
+	}
+
+	// Boards exposed as UF2 drives are uploaded by copying the firmware to
+	// the drive, unless the platform provides a tool for them
+	var uf2Drive *recovery.UF2Drive
+	if stuckBoard != nil {
+		uf2Drive = stuckBoard.uf2Drive
+	} else if port.Protocol == "uf2" && programmer == nil && !burnBootloader && toolAction == nil && !props.ContainsKey("upload.tool.uf2") {
+		uf2Drive = &recovery.UF2Drive{Path: paths.New(port.Address)}
+	}
+
 	action := "upload"
 	if burnBootloader {
 		action = "bootloader"
@@ -321,6 +475,41 @@ func runProgramAction(pme *packagemanager.Explorer,
 		uploadProperties.Set(fmt.Sprintf("%s.field.%s", action, name), value)
 	}
 
+	// Apply the user overrides of the board reset sequence
+	for name, value := range resetProperties {
+		if !slices.Contains(serialutils.ResetProperties, name) {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid reset property: %s", name)}
+		}
+		uploadProperties.Set(name, value)
+	}
+
+	// Apply the options of the upload tool requested by the user
+	if err := applyUploadOptions(uploadProperties, action, uploadToolID, uploadOptions); err != nil {
+		return nil, err
+	}
+
+	// Upload tools implemented by a pluggable uploader receive the actions
+	// through the uploader protocol instead of running the recipes
+	toolEnv := pme.GetEnvVarsForSpawnedProcess()
+	pluggableUploader, err := findPluggableUploader(pme, uploadToolPlatform, uploadToolID, uploadProperties, toolEnv)
+	if err != nil {
+		return nil, err
+	}
+	var uploaderSession *pluggableUploaderSession
+	if pluggableUploader != nil {
+		uploaderSession = &pluggableUploaderSession{uploader: pluggableUploader}
+		defer uploaderSession.close()
+	}
+
+	// When running an action other than the sketch upload the upload tool
+	// must provide the recipe for it
+	if toolAction != nil {
+		if uploaderSession == nil && !uploadProperties.ContainsKey(toolAction.recipeID()) {
+			return nil, &arduino.MissingPlatformPropertyError{Property: fmt.Sprintf("tools.%s.%s", uploadToolID, toolAction.recipeID())}
+		}
+		uploadProperties.Merge(toolAction.Properties)
+	}
+
 	if !uploadProperties.ContainsKey("upload.protocol") && programmer == nil {
 		return nil, &arduino.ProgrammerRequiredForUploadError{}
 	}
@@ -367,7 +556,7 @@ func runProgramAction(pme *packagemanager.Explorer,
 		uploadProperties.Set("bootloader.verify", uploadProperties.Get("bootloader.params.noverify"))
 	}
 
-	if !burnBootloader {
+	if !burnBootloader && toolAction == nil {
 		importPath, sketchName, err := determineBuildPathAndSketchName(importFile, importDir, sk, fqbn)
 		if err != nil {
 			return nil, &arduino.NotFoundError{Message: tr("Error finding build artifacts"), Cause: err}
@@ -380,6 +569,13 @@ func runProgramAction(pme *packagemanager.Explorer,
 		}
 		uploadProperties.SetPath("build.path", importPath)
 		uploadProperties.Set("build.project_name", sketchName)
+
+		// Let the upload recipe flash the partition table selected for the sketch
+		if sk != nil {
+			if partitionsFile := sk.GetPartitionsFile(); partitionsFile != nil {
+				uploadProperties.SetPath("build.partitions_file", partitionsFile)
+			}
+		}
 	}
 
 	// This context is kept alive for the entire duration of the upload
@@ -414,17 +610,27 @@ func runProgramAction(pme *packagemanager.Explorer,
 			uploadProperties.Get("upload.tool.serial") == uploadProperties.Get("upload.tool.default"))
 
 	// If not using programmer perform some action required
-	// to set the board in bootloader mode
+	// to set the board in bootloader mode (unless it's already there)
 	actualPort := port.Clone()
-	if programmer == nil && !burnBootloader && (port.Protocol == "serial" || forcedSerialPortWait) {
+	if programmer == nil && !burnBootloader && stuckBoard == nil && (port.Protocol == "serial" || forcedSerialPortWait) {
 		// Perform reset via 1200bps touch if requested and wait for upload port also if requested.
-		touch := uploadProperties.GetBoolean("upload.use_1200bps_touch")
-		wait := false
+		resetSequence, err := serialutils.ResetSequenceFromProperties(uploadProperties, runtime.GOOS)
+		if err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid board reset sequence"), Cause: err}
+		}
+		touch := resetSequence.Touch
 		portToTouch := ""
 		if touch {
 			portToTouch = port.Address
+		} else {
 			// Waits for upload port only if a 1200bps touch is done
-			wait = uploadProperties.GetBoolean("upload.wait_for_upload_port")
+			resetSequence.WaitForUploadPort = false
+		}
+
+		logrus.WithField("phase", "board reset").Infof("Reset sequence: %s", resetSequence)
+		if verbose && touch && resetSequence.IsCustomized(runtime.GOOS) {
+			// Show the customized reset sequences to ease troubleshooting
+			outStream.Write([]byte(fmt.Sprintln(tr("Reset sequence: %s", resetSequence))))
 		}
 
 		// if touch is requested but port is not specified, print a warning
@@ -434,9 +640,9 @@ func runProgramAction(pme *packagemanager.Explorer,
 
 		cb := &serialutils.ResetProgressCallbacks{
 			TouchingPort: func(portAddress string) {
-				logrus.WithField("phase", "board reset").Infof("Performing 1200-bps touch reset on serial port %s", portAddress)
+				logrus.WithField("phase", "board reset").Infof("Performing %d-bps touch reset on serial port %s", resetSequence.TouchBaudrate, portAddress)
 				if verbose {
-					outStream.Write([]byte(fmt.Sprintln(tr("Performing 1200-bps touch reset on serial port %s", portAddress))))
+					outStream.Write([]byte(fmt.Sprintln(tr("Performing %[1]d-bps touch reset on serial port %[2]s", resetSequence.TouchBaudrate, portAddress))))
 				}
 			},
 			WaitingForNewSerial: func() {
@@ -464,8 +670,11 @@ func runProgramAction(pme *packagemanager.Explorer,
 			},
 		}
 
-		if newPortAddress, err := serialutils.Reset(portToTouch, wait, cb, dryRun); err != nil {
+		if newPortAddress, err := serialutils.ResetWithSequence(portToTouch, resetSequence, cb, dryRun); err != nil {
 			errStream.Write([]byte(fmt.Sprintln(tr("Cannot perform port reset: %s", err))))
+			if busyErr := commands.WrapPortBusyError(portToTouch, port.Protocol, nil); busyErr != nil {
+				errStream.Write([]byte(fmt.Sprintln(busyErr)))
+			}
 		} else {
 			if newPortAddress != "" {
 				actualPort.Address = newPortAddress
@@ -495,28 +704,80 @@ func runProgramAction(pme *packagemanager.Explorer,
 		}
 	}
 
+	// The upload tools are killed if they don't complete within the upload.timeout setting
+	if timeout := configuration.UploadTimeout(configuration.Settings); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, &arduino.TimeoutError{
+			Operation: tr("Upload"),
+			Timeout:   timeout,
+			Setting:   "upload.timeout",
+		})
+		defer cancel()
+	}
+
 	// Run recipes for upload
-	toolEnv := pme.GetEnvVarsForSpawnedProcess()
+	run := func(action, recipeID string) error {
+		var err error
+		if uploaderSession != nil {
+			var actionProperties *properties.Map
+			if toolAction != nil {
+				actionProperties = toolAction.Properties
+			}
+			err = uploaderSession.run(ctx, action, fqbn.String(), actualPort, uploadProperties, actionProperties, outStream, errStream, verbose, verify, dryRun)
+		} else {
+			err = runTool(ctx, recipeID, uploadProperties, outStream, errStream, verbose, dryRun, toolEnv)
+		}
+		var timeoutErr *arduino.TimeoutError
+		if err != nil && errors.As(context.Cause(ctx), &timeoutErr) {
+			return timeoutErr
+		}
+		return err
+	}
 	if burnBootloader {
-		if err := runTool("erase.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+		if err := run("erase", "erase.pattern"); err != nil {
 			return nil, &arduino.FailedUploadError{Message: tr("Failed chip erase"), Cause: err}
 		}
-		if err := runTool("bootloader.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+		if err := run("bootloader", "bootloader.pattern"); err != nil {
 			return nil, &arduino.FailedUploadError{Message: tr("Failed to burn bootloader"), Cause: err}
 		}
+	} else if toolAction != nil {
+		if err := run(toolAction.Recipe, toolAction.recipeID()); err != nil {
+			err = &arduino.FailedUploadError{Message: toolAction.FailureMessage, Cause: err}
+			return nil, commands.WrapPortBusyError(actualPort.Address, actualPort.Protocol, err)
+		}
 	} else if programmer != nil {
-		if err := runTool("program.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
-			return nil, &arduino.FailedUploadError{Message: tr("Failed programming"), Cause: err}
+		if err := run("program", "program.pattern"); err != nil {
+			err = &arduino.FailedUploadError{Message: tr("Failed programming"), Cause: err}
+			return nil, commands.WrapPortBusyError(actualPort.Address, actualPort.Protocol, err)
 		}
-	} else {
-		if err := runTool("upload.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+	} else if uf2Drive != nil {
+		if err := flashUF2(uf2Drive, uploadProperties, outStream, verbose, dryRun); err != nil {
 			return nil, &arduino.FailedUploadError{Message: tr("Failed uploading"), Cause: err}
 		}
+	} else {
+		if uploaderSession == nil && !skipBootloaderCheck && uploadProperties.ContainsKey("upload.bootloader_check.pattern") {
+			if err := checkBootloader(ctx, uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+				var timeoutErr *arduino.TimeoutError
+				if errors.As(context.Cause(ctx), &timeoutErr) {
+					return nil, &arduino.FailedUploadError{Message: tr("Failed uploading"), Cause: timeoutErr}
+				}
+				return nil, commands.WrapPortBusyError(actualPort.Address, actualPort.Protocol, &arduino.NoBootloaderDetectedError{Port: actualPort.Address, Cause: err})
+			}
+		}
+		if err := run("upload", "upload.pattern"); err != nil {
+			err = &arduino.FailedUploadError{Message: tr("Failed uploading"), Cause: err}
+			return nil, commands.WrapPortBusyError(actualPort.Address, actualPort.Protocol, err)
+		}
 	}
 
 	uploadCompleted()
 	logrus.Tracef("Upload successful")
 
+	if uploaderSession != nil && uploaderSession.updatedPort != nil {
+		// The uploader knows where the board is after the upload
+		return uploaderSession.updatedPort.ToRPC(), nil
+	}
+
 	updatedPort := updatedUploadPort.Await()
 	if updatedPort == nil {
 		// If the algorithms can not detect the new port, fallback to the user-provided port.
@@ -627,7 +888,7 @@ func detectUploadPort(
 	}
 }
 
-func runTool(recipeID string, props *properties.Map, outStream, errStream io.Writer, verbose bool, dryRun bool, toolEnv []string) error {
+func runTool(ctx context.Context, recipeID string, props *properties.Map, outStream, errStream io.Writer, verbose bool, dryRun bool, toolEnv []string) error {
 	recipe, ok := props.GetOk(recipeID)
 	if !ok {
 		return fmt.Errorf(tr("recipe not found '%s'"), recipeID)
@@ -660,7 +921,7 @@ func runTool(recipeID string, props *properties.Map, outStream, errStream io.Wri
 	cmd.RedirectStdoutTo(outStream)
 	cmd.RedirectStderrTo(errStream)
 
-	if err := cmd.Start(); err != nil {
+	if err := cmd.StartWithinContext(ctx); err != nil {
 		return fmt.Errorf(tr("cannot execute upload tool: %s"), err)
 	}
 
@@ -671,6 +932,20 @@ func runTool(recipeID string, props *properties.Map, outStream, errStream io.Wri
 	return nil
 }
 
+// checkBootloader runs the `upload.bootloader_check.pattern` recipe of the upload
+// tool to verify that a bootloader answers on the upload port. The output of the
+// probe is shown only in verbose mode.
+func checkBootloader(ctx context.Context, props *properties.Map, outStream, errStream io.Writer, verbose bool, dryRun bool, toolEnv []string) error {
+	logrus.WithField("phase", "upload").Info("Checking bootloader")
+	if verbose {
+		outStream.Write([]byte(fmt.Sprintln(tr("Checking for a bootloader on the upload port..."))))
+	} else {
+		outStream = io.Discard
+		errStream = io.Discard
+	}
+	return runTool(ctx, "upload.bootloader_check.pattern", props, outStream, errStream, verbose, dryRun, toolEnv)
+}
+
 func determineBuildPathAndSketchName(importFile, importDir string, sk *sketch.Sketch, fqbn *cores.FQBN) (*paths.Path, string, error) {
 	// In general, compiling a sketch will produce a set of files that are
 	// named as the sketch but have different extensions, for example Sketch.ino
@@ -699,6 +974,10 @@ func determineBuildPathAndSketchName(importFile, importDir string, sk *sketch.Sk
 		if !importFilePath.Exist() {
 			return nil, "", fmt.Errorf(tr("binary file not found in %s"), importFilePath)
 		}
+		// The manifest written by `compile --export-binaries` names the artifacts
+		if isBuildManifest(importFilePath) {
+			return buildPathFromManifest(importFilePath, fqbn)
+		}
 		return importFilePath.Parent(), strings.TrimSuffix(importFilePath.Base(), importFilePath.Ext()), nil
 	}
 
@@ -709,7 +988,13 @@ func determineBuildPathAndSketchName(importFile, importDir string, sk *sketch.Sk
 		// try to determine the sketch name by applying some euristics to the build folder.
 		// - "build.path" as importDir
 		// - "build.project_name" after trying to autodetect it from the build folder.
-		buildPath := paths.New(importDir)
+		// If importDir contains the builds for several boards the one for the
+		// FQBN is selected and, if available, the project name is taken from the
+		// build manifest.
+		buildPath := boardBuildPath(paths.New(importDir), fqbn)
+		if manifestFile := findBuildManifest(buildPath); manifestFile != nil {
+			return buildPathFromManifest(manifestFile, fqbn)
+		}
 		sketchName, err := detectSketchNameFromBuildPath(buildPath)
 		if err != nil {
 			return nil, "", errors.Errorf(tr("autodetect build artifact: %s"), err)
@@ -747,14 +1032,12 @@ func detectSketchNameFromBuildPath(buildPath *paths.Path) (string, error) {
 	candidateName := ""
 	var candidateFile *paths.Path
 	for _, file := range files {
-		// Build artifacts are usually names as "Blink.ino.hex" or "Blink.ino.bin".
+		// Build artifacts are usually names as "Blink.ino.hex" or "Blink.ino.bin",
+		// or variants like "Blink.ino.with_bootloader.bin" or "Blink.ino.merged.bin".
 		// Extract the "Blink.ino" part
-		name := strings.TrimSuffix(file.Base(), file.Ext())
-
-		// Sometimes we may have particular files like:
-		// Blink.ino.with_bootloader.bin
-		if !globals.MainFileValidExtensions[filepath.Ext(name)] {
-			// just ignore those files
+		name := artifactProjectName(file)
+		if name == "" {
+			// just ignore the other files
 			continue
 		}
 
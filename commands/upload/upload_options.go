@@ -0,0 +1,58 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	properties "github.com/arduino/go-properties-orderedmap"
+)
+
+// applyUploadOptions sets the properties overridden by the upload options
+// requested by the user. The upload tool declares the options it supports
+// with:
+//
+//	tools.TOOL_ID.ACTION.option.OPTION_NAME=OPTION_LABEL
+//	tools.TOOL_ID.ACTION.option.OPTION_NAME.property=PROPERTY_NAME
+//	tools.TOOL_ID.ACTION.option.OPTION_NAME.value.VALUE=PROPERTY_VALUE
+//
+// When the values are declared the option accepts only one of them and the
+// property is set to the corresponding PROPERTY_VALUE, otherwise the value of
+// the option is used as is.
+func applyUploadOptions(uploadProperties *properties.Map, action, toolID string, uploadOptions map[string]string) error {
+	options := uploadProperties.SubTree(action + ".option")
+	for name, value := range uploadOptions {
+		option := options.SubTree(name)
+		property, ok := option.GetOk("property")
+		if !ok {
+			return &arduino.InvalidArgumentError{Message: tr("The upload tool %[1]s doesn't support the upload option %[2]s", toolID, name)}
+		}
+		values := option.SubTree("value")
+		if values.Size() > 0 {
+			propertyValue, ok := values.GetOk(value)
+			if !ok {
+				allowed := values.Keys()
+				sort.Strings(allowed)
+				return &arduino.InvalidArgumentError{Message: tr("Invalid value %[1]s for the upload option %[2]s, must be one of: %[3]s", value, name, strings.Join(allowed, ", "))}
+			}
+			value = propertyValue
+		}
+		uploadProperties.Set(property, value)
+	}
+	return nil
+}
@@ -17,13 +17,18 @@ package upload
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/fsimage"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
 	properties "github.com/arduino/go-properties-orderedmap"
@@ -106,6 +111,12 @@ func TestDetermineBuildPathAndSketchName(t *testing.T) {
 		{"", "testdata/firmware", nil, fqbn, "testdata/firmware", "firmware.ino"},
 		// 17: importFile among multiple firmwares
 		{"testdata/firmware/another_firmware.ino.bin", "", nil, fqbn, "testdata/firmware", "another_firmware.ino"},
+		// 18: importPath containing the exported builds of several boards, the one for the fqbn is selected
+		{"", "testdata/exported", nil, fqbn, "testdata/exported/arduino.samd.mkr1000", "Blink.ino"},
+		// 19: importFile is the build manifest, project_name is taken from it
+		{"testdata/exported/arduino.samd.mkr1000/Blink.ino.manifest.json", "", nil, fqbn, "testdata/exported/arduino.samd.mkr1000", "Blink.ino"},
+		// 20: error: the build manifest is for another board
+		{"testdata/exported/arduino.avr.uno/Blink.ino.manifest.json", "", nil, fqbn, "<nil>", ""},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("SubTest%02d", i), func(t *testing.T) {
@@ -185,6 +196,7 @@ func TestUploadPropertiesComposition(t *testing.T) {
 		outStream := &bytes.Buffer{}
 		errStream := &bytes.Buffer{}
 		_, err := runProgramAction(
+			context.Background(),
 			pme,
 			nil,                     // sketch
 			"",                      // importFile
@@ -199,6 +211,10 @@ func TestUploadPropertiesComposition(t *testing.T) {
 			errStream,
 			false,
 			map[string]string{},
+			nil,
+			nil,
+			false,
+			nil,
 		)
 		verboseVerifyOutput := "verbose verify"
 		if !verboseVerify {
@@ -225,6 +241,164 @@ func TestUploadPropertiesComposition(t *testing.T) {
 	}
 }
 
+func TestFilesystemImageUploadProperties(t *testing.T) {
+	pmb := packagemanager.NewBuilder(nil, nil, nil, nil, "test")
+	errs := pmb.LoadHardwareFromDirectory(paths.New("testdata", "hardware"))
+	require.Len(t, errs, 0)
+	pm := pmb.Build()
+	pme, release := pm.NewExplorer()
+	defer release()
+
+	image := &fsimage.Image{Type: "littlefs", Offset: 0x290000, Size: 0x160000, BlockSize: 4096, PageSize: 256}
+	imageProperties := image.Properties(nil, paths.New("image.bin"))
+	upload := func(fqbn string) (string, error) {
+		outStream := &bytes.Buffer{}
+		_, err := runProgramAction(
+			context.Background(),
+			pme,
+			nil, // sketch
+			"",  // importFile
+			"",  // importDir
+			fqbn,
+			&rpc.Port{Address: "port", Protocol: "serial"},
+			"",    // programmer
+			false, // verbose
+			false, // verify
+			false, // burnBootloader
+			outStream,
+			&bytes.Buffer{},
+			false,
+			map[string]string{},
+			nil,
+			nil,
+			false,
+			&toolAction{Recipe: "fsimage", Properties: imageProperties},
+		)
+		return strings.ReplaceAll(outStream.String(), "\r", ""), err
+	}
+
+	out, err := upload("alice:avr:board1")
+	require.NoError(t, err)
+	require.Contains(t, out, "FSIMAGE conf-board1 protocol port -bspeed 0x290000 image.bin\n")
+
+	// The upload tool of board2 can't flash filesystem images
+	_, err = upload("alice:avr:board2")
+	require.Error(t, err)
+}
+
+func TestUploadBootloaderCheck(t *testing.T) {
+	pmb := packagemanager.NewBuilder(nil, nil, nil, nil, "test")
+	errs := pmb.LoadHardwareFromDirectory(paths.New("testdata", "hardware"))
+	require.Len(t, errs, 0)
+	pm := pmb.Build()
+	pme, release := pm.NewExplorer()
+	defer release()
+
+	buildPath := paths.New("testdata", "build_path_1")
+	upload := func(fqbn string, verbose, skipBootloaderCheck bool) (string, error) {
+		outStream := &bytes.Buffer{}
+		_, err := runProgramAction(
+			context.Background(),
+			pme,
+			nil,                // sketch
+			"",                 // importFile
+			buildPath.String(), // importDir
+			fqbn,
+			&rpc.Port{Address: "port", Protocol: "serial"},
+			"",      // programmer
+			verbose, // verbose
+			false,   // verify
+			false,   // burnBootloader
+			outStream,
+			&bytes.Buffer{},
+			false,
+			map[string]string{},
+			nil,
+			nil,
+			skipBootloaderCheck,
+			nil,
+		)
+		return strings.ReplaceAll(outStream.String(), "\r", ""), err
+	}
+
+	// The output of the probe is shown only in verbose mode
+	out, err := upload("alice:avr:board3", false, false)
+	require.NoError(t, err)
+	require.NotContains(t, out, "CHECK")
+	require.Contains(t, out, "UPLOAD conf-board3 protocol port -bspeed")
+
+	out, err = upload("alice:avr:board3", true, false)
+	require.NoError(t, err)
+	require.Contains(t, out, "CHECK conf-board3 protocol port -bspeed\n")
+	require.Less(t, strings.Index(out, "CHECK"), strings.Index(out, "UPLOAD"))
+
+	// A failed probe stops the upload
+	out, err = upload("alice:avr:board4", false, false)
+	var noBootloaderErr *arduino.NoBootloaderDetectedError
+	require.ErrorAs(t, err, &noBootloaderErr)
+	require.Equal(t, "port", noBootloaderErr.Port)
+	require.NotContains(t, out, "UPLOAD")
+
+	// ...unless the probe is skipped
+	out, err = upload("alice:avr:board4", false, true)
+	require.NoError(t, err)
+	require.Contains(t, out, "UPLOAD conf-board4 protocol port -bspeed")
+}
+
+func TestUploadOptions(t *testing.T) {
+	pmb := packagemanager.NewBuilder(nil, nil, nil, nil, "test")
+	errs := pmb.LoadHardwareFromDirectory(paths.New("testdata", "hardware"))
+	require.Len(t, errs, 0)
+	pm := pmb.Build()
+	pme, release := pm.NewExplorer()
+	defer release()
+
+	buildPath := paths.New("testdata", "build_path_1")
+	upload := func(fqbn string, uploadOptions map[string]string) (string, error) {
+		outStream := &bytes.Buffer{}
+		_, err := runProgramAction(
+			context.Background(),
+			pme,
+			nil,                // sketch
+			"",                 // importFile
+			buildPath.String(), // importDir
+			fqbn,
+			&rpc.Port{Address: "port", Protocol: "serial"},
+			"",    // programmer
+			false, // verbose
+			false, // verify
+			false, // burnBootloader
+			outStream,
+			&bytes.Buffer{},
+			false,
+			map[string]string{},
+			nil,
+			uploadOptions,
+			false,
+			nil,
+		)
+		return strings.ReplaceAll(outStream.String(), "\r", ""), err
+	}
+
+	out, err := upload("alice:avr:board5", nil)
+	require.NoError(t, err)
+	require.Contains(t, out, "UPLOAD conf-board5 protocol port -b921600 --flash_mode qio")
+
+	out, err = upload("alice:avr:board5", map[string]string{"speed": "115200", "no_stub": "true", "flash_mode": "dio"})
+	require.NoError(t, err)
+	require.Contains(t, out, "UPLOAD conf-board5 protocol port -b115200 --no-stub --flash_mode dio")
+
+	// The values of the option are validated
+	_, err = upload("alice:avr:board5", map[string]string{"flash_mode": "qout"})
+	require.ErrorContains(t, err, "must be one of: dio, qio")
+
+	// The option must be supported by the upload tool of the board
+	_, err = upload("alice:avr:board5", map[string]string{"flash_freq": "80m"})
+	require.ErrorContains(t, err, "doesn't support the upload option flash_freq")
+	_, err = upload("alice:avr:board3", map[string]string{"speed": "115200"})
+	require.ErrorContains(t, err, "doesn't support the upload option speed")
+}
+
 func TestGetToolId(t *testing.T) {
 	props, err := properties.LoadFromBytes([]byte(`
 bootloader.tool=avrdude
@@ -349,3 +523,91 @@ func TestOverrideProtocolProperties(t *testing.T) {
 	require.Equal(t, res.Get("upload.unrelated_property"), "ok")
 
 }
+
+func TestSaveImportArtifacts(t *testing.T) {
+	dir, err := saveImportArtifacts([]*rpc.BuildArtifact{
+		{Name: "Blink.ino.hex", Data: []byte("hex")},
+		{Name: "Blink.ino.elf", Data: []byte("elf")},
+	})
+	require.NoError(t, err)
+	defer dir.RemoveAll()
+	data, err := dir.Join("Blink.ino.hex").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "hex", string(data))
+	require.True(t, dir.Join("Blink.ino.elf").Exist())
+
+	for _, name := range []string{"", "..", "../Blink.ino.hex", "sub/Blink.ino.hex"} {
+		_, err := saveImportArtifacts([]*rpc.BuildArtifact{{Name: name}})
+		require.Error(t, err, name)
+	}
+}
+
+func TestUploadWithFilesystemImage(t *testing.T) {
+	dataDir := paths.New(t.TempDir())
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.data", dataDir.String())
+	configuration.Settings.Set("directories.downloads", dataDir.Join("staging").String())
+	configuration.Settings.Set("directories.user", dataDir.Join("user").String())
+	require.NoError(t, paths.New("testdata", "hardware").CopyDirTo(dataDir.Join("user", "hardware")))
+	createRes, err := commands.Create(&rpc.CreateRequest{})
+	require.NoError(t, err)
+	inst := createRes.GetInstance()
+	require.NoError(t, commands.Init(context.Background(), &rpc.InitRequest{Instance: inst}, nil))
+	defer commands.Destroy(context.Background(), &rpc.DestroyRequest{Instance: inst})
+
+	sketchPath := dataDir.Join("user", "Sketch")
+	require.NoError(t, sketchPath.Join("data").MkdirAll())
+	require.NoError(t, sketchPath.Join("Sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, sketchPath.Join("data", "config.txt").WriteFile([]byte("config")))
+	sk, err := sketch.New(sketchPath)
+	require.NoError(t, err)
+	// The image is built with echo, that doesn't write it
+	imagePath := sk.DefaultBuildPath().Join("Sketch.littlefs.bin")
+	require.NoError(t, imagePath.Parent().MkdirAll())
+	defer sk.DefaultBuildPath().RemoveAll()
+	require.NoError(t, imagePath.WriteFile([]byte("image")))
+
+	importDir, err := paths.New("testdata", "build_path_1").Abs()
+	require.NoError(t, err)
+	req := &rpc.UploadRequest{
+		Instance:   inst,
+		Fqbn:       "alice:avr:board6",
+		SketchPath: sketchPath.String(),
+		ImportDir:  importDir.String(),
+		Port:       &rpc.Port{Address: "port", Protocol: "serial"},
+		WithFs:     true,
+	}
+	outStream := &bytes.Buffer{}
+	progress := []*rpc.TaskProgress{}
+	res, err := Upload(context.Background(), req, outStream, &bytes.Buffer{}, func(p *rpc.TaskProgress) {
+		progress = append(progress, p)
+	})
+	require.NoError(t, err)
+
+	// The image is built before the sketch upload, and uploaded after it
+	out := strings.ReplaceAll(outStream.String(), "\r", "")
+	build := strings.Index(out, "MKFS")
+	uploadSketch := strings.Index(out, "Wrote 1024 bytes at 0x10000")
+	uploadImage := strings.Index(out, "Wrote 1441792 bytes at 0x290000")
+	require.True(t, build >= 0 && uploadSketch > build && uploadImage > uploadSketch, out)
+	require.Contains(t, out, sketchPath.Join("data").String())
+	require.Contains(t, out, imagePath.String())
+
+	// The progress of the two uploads is combined
+	require.Equal(t, []*rpc.TaskProgress{
+		{Name: "Writing", Percent: 50, Completed: false},
+		{Name: "Writing", Percent: 100, Completed: true},
+	}, progress)
+
+	require.Equal(t, "littlefs", res.GetFilesystemImage().GetFsType())
+	require.Equal(t, uint64(0x290000), res.GetFilesystemImage().GetOffset())
+	require.Equal(t, imagePath.String(), res.GetFilesystemImage().GetImagePath())
+
+	// A sketch is required to upload the filesystem image
+	req.SketchPath = ""
+	_, err = Upload(context.Background(), req, &bytes.Buffer{}, &bytes.Buffer{}, nil)
+	var missingSketchErr *arduino.MissingSketchPathError
+	require.ErrorAs(t, err, &missingSketchErr)
+}
@@ -47,7 +47,7 @@ func Init(configFile string) *viper.Viper {
 		settings.SetConfigName(strings.TrimSuffix(configFilePath.Base(), configFilePath.Ext()))
 		settings.AddConfigPath(configFilePath.Parent().String())
 	} else {
-		configDir := settings.GetString("directories.Data")
+		configDir := ConfigDir(settings).String()
 		// Get default data path if none was provided
 		if configDir == "" {
 			configDir = getDefaultArduinoDataDir()
@@ -76,6 +76,9 @@ func BindFlags(cmd *cobra.Command, settings *viper.Viper) {
 	settings.BindPFlag("logging.format", cmd.Flag("log-format"))
 	settings.BindPFlag("board_manager.additional_urls", cmd.Flag("additional-urls"))
 	settings.BindPFlag("output.no_color", cmd.Flag("no-color"))
+	settings.BindPFlag("cli.locale", cmd.Flag("locale"))
+	settings.BindPFlag("cli.non_interactive", cmd.Flag("non-interactive"))
+	settings.BindPFlag("cli.non_interactive_answer", cmd.Flag("non-interactive-answer"))
 }
 
 // getDefaultArduinoDataDir returns the full path to the default arduino folder
@@ -128,11 +131,49 @@ func getDefaultUserDir() string {
 	}
 }
 
+// GetDefaultArduinoDataDir returns the full path to the default arduino folder,
+// that is also the default data folder of the Arduino IDE
+func GetDefaultArduinoDataDir() string {
+	return getDefaultArduinoDataDir()
+}
+
 // GetDefaultBuiltinLibrariesDir returns the full path to the default builtin libraries dir
 func GetDefaultBuiltinLibrariesDir() string {
 	return filepath.Join(getDefaultArduinoDataDir(), "libraries")
 }
 
+// Locale returns the locale of the messages set with the argument '--locale'
+// (if specified) or with the cli.locale setting, the locale setting is used
+// as a fallback. The arguments must be checked since the locale is needed
+// before parsing them.
+func Locale(settings *viper.Viper, args []string) string {
+	for i, arg := range args {
+		if arg == "--locale" && len(args) > i+1 {
+			return args[i+1]
+		}
+		if locale, ok := strings.CutPrefix(arg, "--locale="); ok {
+			return locale
+		}
+	}
+	if locale := settings.GetString("cli.locale"); locale != "" {
+		return locale
+	}
+	return settings.GetString("locale")
+}
+
+// WriteConfig writes the settings to the config file in use, or to a new
+// config file in the config folder if there is none.
+func WriteConfig(settings *viper.Viper) error {
+	if settings.ConfigFileUsed() != "" {
+		return settings.WriteConfig()
+	}
+	configDir := ConfigDir(settings)
+	if err := configDir.MkdirAll(); err != nil {
+		return err
+	}
+	return settings.WriteConfigAs(configDir.Join("arduino-cli.yaml").String())
+}
+
 // FindConfigFileInArgs returns the config file path using the
 // argument '--config-file' (if specified) or looking in the current working dir
 func FindConfigFileInArgs(args []string) string {
@@ -19,8 +19,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -59,6 +63,92 @@ func TestInit(t *testing.T) {
 	require.Equal(t, ":9090", settings.GetString("metrics.addr"))
 }
 
+func TestInitWithRoot(t *testing.T) {
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+	t.Setenv("ARDUINO_ROOT", tmp)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmp, "data"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmp, "data", "arduino-cli.yaml"), []byte("logging:\n  level: debug\n"), 0644))
+
+	settings := Init("")
+	require.Equal(t, filepath.Join(tmp, "data"), settings.GetString("directories.Data"))
+	require.Equal(t, filepath.Join(tmp, "data", "staging"), settings.GetString("directories.Downloads"))
+	require.Equal(t, filepath.Join(tmp, "user"), settings.GetString("directories.User"))
+	require.Equal(t, "debug", settings.GetString("logging.level"))
+}
+
+func TestInitWithRootWithoutHome(t *testing.T) {
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+	t.Setenv("ARDUINO_ROOT", tmp)
+	t.Setenv("HOME", "")
+	t.Setenv("USERPROFILE", "")
+	t.Setenv("home", "")
+
+	// The home directory is not needed, no warning is printed
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+	settings := Init("")
+	require.Equal(t, filepath.Join(tmp, "data"), settings.GetString("directories.Data"))
+	require.Equal(t, filepath.Join(tmp, "user"), settings.GetString("directories.User"))
+	for _, entry := range hook.AllEntries() {
+		require.NotEqual(t, logrus.WarnLevel, entry.Level, entry.Message)
+	}
+}
+
+func TestInitWithXDGLayout(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("XDG environment variables are used only on Linux")
+	}
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(tmp, "share"))
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(tmp, "cache"))
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "config"))
+
+	// The legacy layout is used until the configuration file is in the XDG config folder
+	require.Equal(t, LegacyLayout, DirectoriesLayout())
+	require.NoError(t, os.MkdirAll(filepath.Join(tmp, "config", "arduino-cli"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmp, "config", "arduino-cli", "arduino-cli.yaml"), []byte("logging:\n  level: debug\n"), 0644))
+	require.Equal(t, XDGLayout, DirectoriesLayout())
+
+	settings := Init("")
+	require.Equal(t, filepath.Join(tmp, "share", "arduino-cli"), settings.GetString("directories.Data"))
+	require.Equal(t, filepath.Join(tmp, "cache", "arduino-cli", "staging"), settings.GetString("directories.Downloads"))
+	require.Equal(t, filepath.Join(tmp, "config", "arduino-cli"), ConfigDir(settings).String())
+	require.Equal(t, "debug", settings.GetString("logging.level"))
+
+	t.Setenv("ARDUINO_DIRECTORIES_LAYOUT", LegacyLayout)
+	require.Equal(t, LegacyLayout, DirectoriesLayout())
+}
+
+func TestSystemDirectories(t *testing.T) {
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+	for _, dir := range []string{"system/packages", "system/libraries", "data/packages", "user/hardware"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(tmp, dir), 0755))
+	}
+
+	settings := Init(filepath.Join(tmp, "arduino-cli.yaml"))
+	settings.Set("directories.Data", filepath.Join(tmp, "data"))
+	settings.Set("directories.User", filepath.Join(tmp, "user"))
+	require.Nil(t, SystemDir(settings))
+	require.Equal(t, paths.NewPathList(
+		filepath.Join(tmp, "data", "packages"),
+		filepath.Join(tmp, "user", "hardware"),
+	), HardwareDirectories(settings))
+	require.Empty(t, BuiltinLibrariesDirectories(settings))
+
+	// The system packages are loaded first so the user ones take precedence
+	settings.Set("directories.system", filepath.Join(tmp, "system"))
+	require.Equal(t, paths.NewPathList(
+		filepath.Join(tmp, "system", "packages"),
+		filepath.Join(tmp, "data", "packages"),
+		filepath.Join(tmp, "user", "hardware"),
+	), HardwareDirectories(settings))
+	require.Equal(t, paths.NewPathList(filepath.Join(tmp, "system", "libraries")), BuiltinLibrariesDirectories(settings))
+}
+
 func TestFindConfigFile(t *testing.T) {
 	configFile := FindConfigFileInArgs([]string{"--config-file"})
 	require.Equal(t, "", configFile)
@@ -72,3 +162,17 @@ func TestFindConfigFile(t *testing.T) {
 	configFile = FindConfigFileInArgs([]string{})
 	require.Equal(t, "", configFile)
 }
+
+func TestLocale(t *testing.T) {
+	settings := Init("")
+	require.Equal(t, "", Locale(settings, []string{"arduino-cli", "version"}))
+
+	settings.Set("locale", "de")
+	require.Equal(t, "de", Locale(settings, []string{"arduino-cli", "version"}))
+
+	settings.Set("cli.locale", "it_IT")
+	require.Equal(t, "it_IT", Locale(settings, []string{"arduino-cli", "version"}))
+
+	require.Equal(t, "fr", Locale(settings, []string{"arduino-cli", "--locale", "fr", "version"}))
+	require.Equal(t, "ja", Locale(settings, []string{"arduino-cli", "version", "--locale=ja"}))
+}
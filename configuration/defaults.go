@@ -16,6 +16,7 @@
 package configuration
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -31,27 +32,81 @@ func SetDefaults(settings *viper.Viper) {
 
 	// Libraries
 	settings.SetDefault("library.enable_unsafe_install", false)
+	settings.SetDefault("library.post_install_libraries", []string{})
+	settings.SetDefault("library.post_install_permissions", []string{})
 
 	// Boards Manager
 	settings.SetDefault("board_manager.additional_urls", []string{})
+	settings.SetDefault("board_manager.pinned_platforms", []string{})
+
+	// Advisories
+	settings.SetDefault("advisories.urls", []string{})
 
 	// arduino directories
-	settings.SetDefault("directories.Data", getDefaultArduinoDataDir())
-	settings.SetDefault("directories.Downloads", filepath.Join(getDefaultArduinoDataDir(), "staging"))
-	settings.SetDefault("directories.User", getDefaultUserDir())
+	layout := DirectoriesLayout()
+	var dataDir, downloadsDir, userDir string
+	if root := os.Getenv("ARDUINO_ROOT"); root != "" {
+		// All the state of the CLI (configuration file included) is
+		// relocated inside the root directory, the home directory of the
+		// user is not needed
+		dataDir, userDir = filepath.Join(root, "data"), filepath.Join(root, "user")
+		downloadsDir = filepath.Join(dataDir, "staging")
+	} else if layout == XDGLayout {
+		dataDir, downloadsDir = GetXDGDataDir(), filepath.Join(GetXDGCacheDir(), "staging")
+		userDir = getDefaultUserDir()
+	} else {
+		dataDir, userDir = getDefaultArduinoDataDir(), getDefaultUserDir()
+		downloadsDir = filepath.Join(dataDir, "staging")
+	}
+	settings.SetDefault("directories.layout", layout)
+	settings.SetDefault("directories.Data", dataDir)
+	settings.SetDefault("directories.Downloads", downloadsDir)
+	settings.SetDefault("directories.User", userDir)
+	settings.SetDefault("directories.system", "")
 
 	// Sketch compilation
 	settings.SetDefault("sketch.always_export_binaries", false)
+	settings.SetDefault("sketch.symlinks", "follow")
+	settings.SetDefault("library.symlinks", "follow")
 	settings.SetDefault("build_cache.ttl", time.Hour*24*30)
 	settings.SetDefault("build_cache.compilations_before_purge", 10)
+	settings.SetDefault("build.use_ccache", false)
+	settings.SetDefault("build.compile_wrapper", "")
+	settings.SetDefault("build.tool_timeout", time.Duration(0))
+
+	// upload settings
+	settings.SetDefault("upload.timeout", time.Duration(0))
+
+	// arduino cloud
+	settings.SetDefault("cloud.api_url", "https://api2.arduino.cc")
+	settings.SetDefault("cloud.client_id", "")
+	settings.SetDefault("cloud.client_secret", "")
+	settings.SetDefault("cloud.organization_id", "")
+
+	// cli settings
+	settings.SetDefault("cli.use_daemon", false)
+	settings.SetDefault("cli.non_interactive", false)
+	settings.SetDefault("cli.non_interactive_answer", "no")
 
 	// daemon settings
 	settings.SetDefault("daemon.port", "50051")
+	settings.SetDefault("daemon.stream_batch_size", 16384)
+	settings.SetDefault("daemon.stream_buffer_size", 32*1024)
+	settings.SetDefault("daemon.stream_flush_interval", 10*time.Millisecond)
+
+	// remote build settings
+	settings.SetDefault("remote.address", "")
+	settings.SetDefault("remote.token", "")
+	settings.SetDefault("remote.tls", false)
+	settings.SetDefault("remote.build", false)
 
 	// metrics settings
 	settings.SetDefault("metrics.enabled", true)
 	settings.SetDefault("metrics.addr", ":9090")
 
+	// monitor settings
+	settings.SetDefault("monitor.buffer_size", 1024*1024)
+
 	// output settings
 	settings.SetDefault("output.no_color", false)
 
@@ -16,6 +16,8 @@
 package configuration
 
 import (
+	"os"
+
 	"github.com/arduino/go-paths-helper"
 	"github.com/spf13/viper"
 )
@@ -24,8 +26,14 @@ import (
 func HardwareDirectories(settings *viper.Viper) paths.PathList {
 	res := paths.PathList{}
 
+	// The system packages are loaded first, so the ones installed by the user
+	// take precedence
+	if systemPackagesDir := SystemPackagesDir(settings); systemPackagesDir != nil && systemPackagesDir.IsDir() {
+		res.Add(systemPackagesDir)
+	}
+
 	if settings.IsSet("directories.Data") {
-		packagesDir := PackagesDir(Settings)
+		packagesDir := PackagesDir(settings)
 		if packagesDir.IsDir() {
 			res.Add(packagesDir)
 		}
@@ -50,7 +58,47 @@ func BuiltinToolsDirectories(settings *viper.Viper) paths.PathList {
 // IDEBuiltinLibrariesDir returns the IDE-bundled libraries path. Usually
 // this directory is present in the Arduino IDE.
 func IDEBuiltinLibrariesDir(settings *viper.Viper) *paths.Path {
-	return paths.New(Settings.GetString("directories.builtin.Libraries"))
+	return paths.New(settings.GetString("directories.builtin.Libraries"))
+}
+
+// BuiltinLibrariesDirectories returns all the paths that may contain
+// libraries available to all the platforms with the lowest priority: the
+// IDE-bundled libraries and the libraries of the system directory.
+func BuiltinLibrariesDirectories(settings *viper.Viper) paths.PathList {
+	res := paths.PathList{}
+	if dir := IDEBuiltinLibrariesDir(settings); dir != nil {
+		res.Add(dir)
+	}
+	if dir := SystemLibrariesDir(settings); dir != nil && dir.IsDir() {
+		res.Add(dir)
+	}
+	return res
+}
+
+// SystemDir returns the full path to the read-only system directory shared
+// by all the users, or nil if not set. It has the same layout of the data
+// directory, with the platforms and the tools in the packages folder, and
+// contains the libraries in the libraries folder.
+func SystemDir(settings *viper.Viper) *paths.Path {
+	return paths.New(settings.GetString("directories.system"))
+}
+
+// SystemPackagesDir returns the full path to the packages folder of the
+// system directory, or nil if the system directory is not set
+func SystemPackagesDir(settings *viper.Viper) *paths.Path {
+	if systemDir := SystemDir(settings); systemDir != nil {
+		return systemDir.Join("packages")
+	}
+	return nil
+}
+
+// SystemLibrariesDir returns the full path to the libraries folder of the
+// system directory, or nil if the system directory is not set
+func SystemLibrariesDir(settings *viper.Viper) *paths.Path {
+	if systemDir := SystemDir(settings); systemDir != nil {
+		return systemDir.Join("libraries")
+	}
+	return nil
 }
 
 // LibrariesDir returns the full path to the user directory containing
@@ -76,6 +124,30 @@ func DataDir(settings *viper.Viper) *paths.Path {
 	return paths.New(settings.GetString("directories.Data"))
 }
 
+// ConfigDir returns the full path to the folder where the configuration
+// file is looked for: the XDG config folder in the XDG layout, the data
+// folder otherwise
+func ConfigDir(settings *viper.Viper) *paths.Path {
+	if settings.GetString("directories.layout") == XDGLayout && os.Getenv("ARDUINO_ROOT") == "" {
+		if configDir := GetXDGConfigDir(); configDir != "" {
+			return paths.New(configDir)
+		}
+	}
+	return DataDir(settings)
+}
+
+// AdvisoriesDir returns the full path to the directory of the downloaded
+// advisories feeds
+func AdvisoriesDir(settings *viper.Viper) *paths.Path {
+	return DataDir(settings).Join("advisories")
+}
+
+// LibrariesUsageFile returns the full path to the file recording the usage
+// of the installed libraries by the compilations
+func LibrariesUsageFile(settings *viper.Viper) *paths.Path {
+	return DataDir(settings).Join("library_usage.json")
+}
+
 // DownloadsDir returns the full path to the download cache directory
 func DownloadsDir(settings *viper.Viper) *paths.Path {
 	return paths.New(settings.GetString("directories.Downloads"))
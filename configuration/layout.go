@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+const (
+	// LegacyLayout keeps the data, the downloads and the configuration file
+	// in the Arduino15 folder shared with the Arduino IDE
+	LegacyLayout = "legacy"
+	// XDGLayout follows the XDG base directory specification on Linux (and
+	// the equivalent conventions on macOS and Windows), keeping the data,
+	// the downloads and the configuration file in separate folders
+	XDGLayout = "xdg"
+)
+
+// DirectoriesLayout returns the layout of the default directories. It's the
+// one set with the ARDUINO_DIRECTORIES_LAYOUT environment variable, if any,
+// otherwise it's XDGLayout if a configuration file is present in the XDG
+// config folder and LegacyLayout in any other case.
+func DirectoriesLayout() string {
+	if layout := os.Getenv("ARDUINO_DIRECTORIES_LAYOUT"); layout != "" {
+		return layout
+	}
+	if configDir := GetXDGConfigDir(); configDir != "" {
+		if _, err := os.Stat(filepath.Join(configDir, "arduino-cli.yaml")); err == nil {
+			return XDGLayout
+		}
+	}
+	return LegacyLayout
+}
+
+// GetXDGDataDir returns the data folder of the XDG layout: $XDG_DATA_HOME
+// (by default ~/.local/share) on Linux, ~/Library/Application Support on
+// macOS and %LocalAppData% on Windows. It returns an empty string if the
+// folder can't be determined.
+func GetXDGDataDir() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return GetXDGConfigDir()
+	case "windows":
+		return GetXDGCacheDir()
+	}
+	if dir := os.Getenv("XDG_DATA_HOME"); filepath.IsAbs(dir) {
+		return filepath.Join(dir, "arduino-cli")
+	}
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(userHomeDir, ".local", "share", "arduino-cli")
+}
+
+// GetXDGCacheDir returns the cache folder of the XDG layout: $XDG_CACHE_HOME
+// (by default ~/.cache) on Linux, ~/Library/Caches on macOS and
+// %LocalAppData% on Windows. It returns an empty string if the folder can't
+// be determined.
+func GetXDGCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "arduino-cli")
+}
+
+// GetXDGConfigDir returns the configuration folder of the XDG layout:
+// $XDG_CONFIG_HOME (by default ~/.config) on Linux, ~/Library/Application
+// Support on macOS and %AppData% on Windows. It returns an empty string if
+// the folder can't be determined.
+func GetXDGConfigDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "arduino-cli")
+}
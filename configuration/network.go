@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"os"
 	"runtime"
+	"sort"
 
 	"github.com/arduino/arduino-cli/version"
 	"github.com/spf13/viper"
@@ -49,12 +50,17 @@ func UserAgent(settings *viper.Viper) string {
 		extendedUA)
 }
 
-// NetworkProxy returns the proxy configuration (mainly used by HTTP clients)
+// NetworkProxyDirect is the value of network.proxy that disables the proxies,
+// including the ones configured in the system
+const NetworkProxyDirect = "direct"
+
+// NetworkProxy returns the proxy configuration (mainly used by HTTP clients),
+// nil if the proxy is not set or is NetworkProxyDirect
 func NetworkProxy(settings *viper.Viper) (*url.URL, error) {
 	if settings == nil || !settings.IsSet("network.proxy") {
 		return nil, nil
 	}
-	if proxyConfig := settings.GetString("network.proxy"); proxyConfig == "" {
+	if proxyConfig := settings.GetString("network.proxy"); proxyConfig == "" || proxyConfig == NetworkProxyDirect {
 		// empty configuration
 		// this workaround must be here until viper can UnSet properties:
 		// https://github.com/spf13/viper/pull/519
@@ -65,3 +71,47 @@ func NetworkProxy(settings *viper.Viper) (*url.URL, error) {
 		return proxy, nil
 	}
 }
+
+// NetworkProxyDisabled returns true if network.proxy is NetworkProxyDirect
+func NetworkProxyDisabled(settings *viper.Viper) bool {
+	return settings != nil && settings.GetString("network.proxy") == NetworkProxyDirect
+}
+
+// NetworkNoProxy returns the hosts that must be reached without proxy
+func NetworkNoProxy(settings *viper.Viper) []string {
+	if settings == nil {
+		return nil
+	}
+	return settings.GetStringSlice("network.no_proxy")
+}
+
+// NetworkMirror lists the mirrors of the files whose URL starts with URL, the
+// URL prefix is replaced with the mirror to obtain the alternative URLs
+type NetworkMirror struct {
+	URL     string   `mapstructure:"url"`
+	Mirrors []string `mapstructure:"mirrors"`
+}
+
+// NetworkMirrors returns the mirrors configured in network.mirrors, sorted by name
+func NetworkMirrors(settings *viper.Viper) ([]*NetworkMirror, error) {
+	if settings == nil || !settings.IsSet("network.mirrors") {
+		return nil, nil
+	}
+	mirrors := map[string]*NetworkMirror{}
+	if err := settings.UnmarshalKey("network.mirrors", &mirrors); err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for name := range mirrors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	res := []*NetworkMirror{}
+	for _, name := range names {
+		if mirrors[name].URL == "" {
+			return nil, fmt.Errorf(tr("missing url of the mirror %s"), name)
+		}
+		res = append(res, mirrors[name])
+	}
+	return res, nil
+}
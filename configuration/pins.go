@@ -0,0 +1,56 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// ParsePlatformPin parses a pin of the board_manager.pinned_platforms
+// setting, in the form PACKAGER:ARCH@VERSION
+func ParsePlatformPin(pin string) (string, *semver.Version, error) {
+	id, version, hasVersion := strings.Cut(pin, "@")
+	packager, arch, hasArch := strings.Cut(id, ":")
+	if !hasVersion || !hasArch || packager == "" || arch == "" || strings.Contains(arch, ":") {
+		return "", nil, errors.New(tr("invalid platform pin %s, the format must be PACKAGER:ARCH@VERSION", pin))
+	}
+	v, err := semver.Parse(version)
+	if err != nil {
+		return "", nil, errors.New(tr("invalid version in platform pin %[1]s: %[2]s", pin, err))
+	}
+	return id, v, nil
+}
+
+// PinnedPlatforms returns the versions the platforms are pinned to, indexed
+// by platform ID. The upgrades don't move the platforms past these versions.
+// The invalid pins are ignored.
+func PinnedPlatforms(settings *viper.Viper) map[string]*semver.Version {
+	res := map[string]*semver.Version{}
+	for _, pin := range settings.GetStringSlice("board_manager.pinned_platforms") {
+		id, version, err := ParsePlatformPin(pin)
+		if err != nil {
+			logrus.WithError(err).Warn("Invalid board_manager.pinned_platforms setting")
+			continue
+		}
+		res[id] = version
+	}
+	return res
+}
@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlatformPin(t *testing.T) {
+	id, version, err := ParsePlatformPin("arduino:avr@1.8.6")
+	require.NoError(t, err)
+	require.Equal(t, "arduino:avr", id)
+	require.Equal(t, "1.8.6", version.String())
+
+	for _, pin := range []string{"arduino:avr", "arduino@1.8.6", ":avr@1.8.6", "arduino:@1.8.6", "a:b:c@1.0.0"} {
+		_, _, err := ParsePlatformPin(pin)
+		require.Error(t, err, pin)
+	}
+}
+
+func TestPinnedPlatforms(t *testing.T) {
+	settings := viper.New()
+	settings.Set("board_manager.pinned_platforms", []string{"arduino:avr@1.8.6", "invalid", "esp32:esp32@2.0.14"})
+	pins := PinnedPlatforms(settings)
+	require.Len(t, pins, 2)
+	require.Equal(t, "1.8.6", pins["arduino:avr"].String())
+	require.Equal(t, "2.0.14", pins["esp32:esp32"].String())
+}
@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// SketchSymlinksPolicy returns how the symlinks inside the sketch folders must be handled
+func SketchSymlinksPolicy(settings *viper.Viper) utils.SymlinksPolicy {
+	return symlinksPolicy(settings, "sketch.symlinks")
+}
+
+// LibrariesSymlinksPolicy returns how the symlinks inside the libraries directories must be handled
+func LibrariesSymlinksPolicy(settings *viper.Viper) utils.SymlinksPolicy {
+	return symlinksPolicy(settings, "library.symlinks")
+}
+
+func symlinksPolicy(settings *viper.Viper, key string) utils.SymlinksPolicy {
+	if settings == nil {
+		return utils.SymlinksFollow
+	}
+	policy, err := utils.ParseSymlinksPolicy(settings.GetString(key))
+	if err != nil {
+		logrus.WithError(err).Warnf("Invalid %s setting, symlinks will be followed", key)
+		return utils.SymlinksFollow
+	}
+	return policy
+}
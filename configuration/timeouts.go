@@ -0,0 +1,50 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// defaultNetworkRequestTimeout is used when network.request_timeout is not set
+const defaultNetworkRequestTimeout = time.Minute
+
+// NetworkRequestTimeout returns the max time to wait for a server to respond or
+// to send more data (mainly used by HTTP clients), 0 means no limit.
+func NetworkRequestTimeout(settings *viper.Viper) time.Duration {
+	if settings == nil || !settings.IsSet("network.request_timeout") {
+		return defaultNetworkRequestTimeout
+	}
+	return settings.GetDuration("network.request_timeout")
+}
+
+// BuildToolTimeout returns the max time a tool of the build can run, 0 means no limit.
+func BuildToolTimeout(settings *viper.Viper) time.Duration {
+	if settings == nil {
+		return 0
+	}
+	return settings.GetDuration("build.tool_timeout")
+}
+
+// UploadTimeout returns the max time an upload can take, 0 means no limit.
+func UploadTimeout(settings *viper.Viper) time.Duration {
+	if settings == nil {
+		return 0
+	}
+	return settings.GetDuration("upload.timeout")
+}
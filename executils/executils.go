@@ -74,3 +74,10 @@ func call(stack []*exec.Cmd, pipes []*io.PipeWriter) (err error) {
 func TellCommandNotToSpawnShell(cmd *exec.Cmd) {
 	tellCommandNotToSpawnShell(cmd)
 }
+
+// TellCommandToStartDetached makes the specified Cmd start in a new session
+// (or in a new process group on Windows), so it is not terminated together
+// with the parent process, for example when the user hits CTRL-C.
+func TellCommandToStartDetached(cmd *exec.Cmd) {
+	tellCommandToStartDetached(cmd)
+}
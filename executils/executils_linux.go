@@ -15,7 +15,37 @@
 
 package executils
 
-import "os/exec"
+import (
+	"os/exec"
+	"syscall"
+)
 
 func tellCommandNotToSpawnShell(_ *exec.Cmd) {
 }
+
+func tellCommandToStartDetached(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setsid = true
+}
+
+func tellCommandToStartNewProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	// A process started in a new session is already the leader of its own
+	// process group
+	if !cmd.SysProcAttr.Setsid {
+		cmd.SysProcAttr.Setpgid = true
+	}
+}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	pid := cmd.Process.Pid
+	if pgid, err := syscall.Getpgid(pid); err != nil || pgid != pid {
+		// Not the leader of a process group, kill only the process
+		return cmd.Process.Kill()
+	}
+	return syscall.Kill(-pid, syscall.SIGKILL)
+}
@@ -17,9 +17,36 @@ package executils
 
 import (
 	"os/exec"
+	"strconv"
 	"syscall"
 )
 
 func tellCommandNotToSpawnShell(oscmd *exec.Cmd) {
 	oscmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true}
 }
+
+func tellCommandToStartDetached(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	// CREATE_NEW_PROCESS_GROUP | DETACHED_PROCESS
+	cmd.SysProcAttr.CreationFlags |= 0x00000200 | 0x00000008
+}
+
+func tellCommandToStartNewProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	// CREATE_NEW_PROCESS_GROUP
+	cmd.SysProcAttr.CreationFlags |= 0x00000200
+}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	// taskkill terminates the whole tree of processes spawned by the process
+	kill := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid))
+	tellCommandNotToSpawnShell(kill)
+	if err := kill.Run(); err != nil {
+		return cmd.Process.Kill()
+	}
+	return nil
+}
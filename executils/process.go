@@ -29,6 +29,10 @@ import (
 // Process is representation of an external process run
 type Process struct {
 	cmd *exec.Cmd
+
+	// ctx and completed are set by StartWithinContext
+	ctx       context.Context
+	completed chan struct{}
 }
 
 // NewProcess creates a command with the provided command line arguments
@@ -61,6 +65,12 @@ func NewProcessFromPath(extraEnv []string, executable *paths.Path, args ...strin
 	return NewProcess(extraEnv, processArgs...)
 }
 
+// SetDetached makes the process start detached from the parent process, so that
+// it is not terminated together with the parent (see TellCommandToStartDetached).
+func (p *Process) SetDetached() {
+	TellCommandToStartDetached(p.cmd)
+}
+
 // RedirectStdoutTo will redirect the process' stdout to the specified
 // writer. Any previous redirection will be overwritten.
 func (p *Process) RedirectStdoutTo(out io.Writer) {
@@ -112,11 +122,44 @@ func (p *Process) Start() error {
 	return p.cmd.Start()
 }
 
+// StartWithinContext starts the specified command in a new process group. If the
+// given context is canceled before the process terminates, the whole process
+// group (the process and all the processes it spawned) is killed. Wait must be
+// called to wait for the process termination and release its resources.
+func (p *Process) StartWithinContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tellCommandToStartNewProcessGroup(p.cmd)
+	if err := p.Start(); err != nil {
+		return err
+	}
+	p.ctx = ctx
+	p.completed = make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			p.KillProcessGroup()
+		case <-p.completed:
+		}
+	}()
+	return nil
+}
+
 // Wait waits for the command to exit and waits for any copying to stdin or copying
-// from stdout or stderr to complete.
+// from stdout or stderr to complete. If the process has been started with
+// StartWithinContext and has been killed because the context was canceled, the
+// cause of the cancellation is returned (see context.Cause).
 func (p *Process) Wait() error {
 	// TODO: make some helpers to retrieve exit codes out of *ExitError.
-	return p.cmd.Wait()
+	err := p.cmd.Wait()
+	if p.completed != nil {
+		close(p.completed)
+		if err != nil && p.ctx.Err() != nil {
+			return context.Cause(p.ctx)
+		}
+	}
+	return err
 }
 
 // Signal sends a signal to the Process. Sending Interrupt on Windows is not implemented.
@@ -131,6 +174,13 @@ func (p *Process) Kill() error {
 	return p.cmd.Process.Kill()
 }
 
+// KillProcessGroup kills the process and all the processes it spawned. The
+// process must have been started with StartWithinContext (or RunWithinContext),
+// otherwise only the process itself is killed.
+func (p *Process) KillProcessGroup() error {
+	return killProcessGroup(p.cmd)
+}
+
 // SetDir sets the working directory of the command. If Dir is the empty string, Run
 // runs the command in the calling process's current directory.
 func (p *Process) SetDir(dir string) {
@@ -164,20 +214,12 @@ func (p *Process) SetEnvironment(values []string) {
 }
 
 // RunWithinContext starts the specified command and waits for it to complete. If the given context
-// is canceled before the normal process termination, the process is killed.
+// is canceled before the normal process termination, the process and all the processes it spawned
+// are killed (see StartWithinContext).
 func (p *Process) RunWithinContext(ctx context.Context) error {
-	if err := p.Start(); err != nil {
+	if err := p.StartWithinContext(ctx); err != nil {
 		return err
 	}
-	completed := make(chan struct{})
-	defer close(completed)
-	go func() {
-		select {
-		case <-ctx.Done():
-			p.Kill()
-		case <-completed:
-		}
-	}()
 	return p.Wait()
 }
 
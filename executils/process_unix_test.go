@@ -0,0 +1,74 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+//go:build !windows
+
+package executils
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessWithinContextKillsSpawnedProcesses(t *testing.T) {
+	// The shell spawns a child process and prints its PID
+	process, err := NewProcess(nil, "sh", "-c", "sleep 10 & echo $!; wait")
+	require.NoError(t, err)
+	stdout, err := process.StdoutPipe()
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, process.StartWithinContext(ctx))
+	line, err := bufio.NewReader(stdout).ReadString('\n')
+	require.NoError(t, err)
+	childPID, err := strconv.Atoi(strings.TrimSpace(line))
+	require.NoError(t, err)
+
+	cancel()
+	require.ErrorIs(t, process.Wait(), context.Canceled)
+	require.Eventually(t, func() bool {
+		// The child is either gone or a zombie waiting for init to reap it
+		return syscall.Kill(childPID, 0) != nil || isZombie(childPID)
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestProcessWithinContextReturnsCancellationCause(t *testing.T) {
+	process, err := NewProcess(nil, "sleep", "10")
+	require.NoError(t, err)
+	cause := errors.New("tool timed out")
+	ctx, cancel := context.WithTimeoutCause(context.Background(), 100*time.Millisecond, cause)
+	defer cancel()
+	require.ErrorIs(t, process.RunWithinContext(ctx), cause)
+}
+
+// isZombie returns true if the process has terminated but has not been reaped
+// yet, this is detected only on systems with procfs.
+func isZombie(pid int) bool {
+	stat, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return false
+	}
+	// The state follows the command name, that is enclosed in parentheses
+	fields := strings.Fields(string(stat[strings.LastIndex(string(stat), ")")+1:]))
+	return len(fields) > 0 && fields[0] == "Z"
+}
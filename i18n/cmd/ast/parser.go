@@ -16,6 +16,7 @@
 package ast
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/parser"
@@ -60,15 +61,7 @@ func doFile(fset *token.FileSet, file *ast.File, catalog *po.MessageCatalog) {
 		}
 
 		pos := fset.Position(funcCall.Pos())
-		firstArg, ok := funcCall.Args[0].(*ast.BasicLit)
-		if !ok {
-			fmt.Fprintf(os.Stderr, "%s:%d\n", pos.Filename, pos.Line)
-			fmt.Fprintln(os.Stderr, "argument to i18n.Tr must be a literal string")
-			return true
-		}
-
-		msg, err := strconv.Unquote(firstArg.Value)
-
+		msg, err := literalString(funcCall.Args[0])
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "%s:%d\n", pos.Filename, pos.Line)
 			fmt.Fprintln(os.Stderr, err.Error())
@@ -81,6 +74,34 @@ func doFile(fset *token.FileSet, file *ast.File, catalog *po.MessageCatalog) {
 	})
 }
 
+// literalString returns the value of a literal string, or of a
+// concatenation of literal strings split across multiple lines.
+func literalString(expr ast.Expr) (string, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			break
+		}
+		return strconv.Unquote(e.Value)
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			break
+		}
+		x, err := literalString(e.X)
+		if err != nil {
+			return "", err
+		}
+		y, err := literalString(e.Y)
+		if err != nil {
+			return "", err
+		}
+		return x + y, nil
+	case *ast.ParenExpr:
+		return literalString(e.X)
+	}
+	return "", errors.New("argument to i18n.Tr must be a literal string")
+}
+
 func functionName(callExpr *ast.CallExpr) string {
 
 	if iden, ok := callExpr.Fun.(*ast.Ident); ok {
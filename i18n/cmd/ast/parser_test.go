@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package ast
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/arduino/arduino-cli/i18n/cmd/po"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcatenatedMessages(t *testing.T) {
+	src := `package test
+
+func f() {
+	tr("single message")
+	i18n.Tr("a long message " +
+		"split across " + ` + "`lines`" + `)
+	tr(msg)
+}
+`
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, "test.go", src, parser.AllErrors)
+	require.NoError(t, err)
+	catalog := po.MessageCatalog{}
+	doFile(fset, node, &catalog)
+
+	require.Equal(t, []string{"a long message split across lines", "single message"}, catalog.SortedKeys())
+}
@@ -19,13 +19,16 @@ package i18n
 // 1. Locale specified via the function call
 // 2. OS Locale
 // 3. en (default)
+// The OS Locale is not used if a locale is specified, even if not supported.
 func Init(configLocale string) {
-	locales := supportedLocales()
+	locales := SupportedLocales()
 	if configLocale != "" {
 		if locale := findMatchingLocale(configLocale, locales); locale != "" {
 			setLocale(locale)
 			return
 		}
+		setLocale("en")
+		return
 	}
 
 	if osLocale := getLocaleIdentifierFromOS(); osLocale != "" {
@@ -39,7 +42,7 @@ func Init(configLocale string) {
 }
 
 // Tr returns msg translated to the selected locale
-// the msg argument must be a literal string
+// the msg argument must be a literal string, or a concatenation of literal strings
 func Tr(msg string, args ...interface{}) string {
 	return po.Get(msg, args...)
 }
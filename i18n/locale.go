@@ -31,14 +31,17 @@ func init() {
 	po = new(gotext.Po)
 }
 
-func supportedLocales() []string {
+// SupportedLocales returns the locales with a translation of the messages
+func SupportedLocales() []string {
 	var locales []string
 	files, err := contents.ReadDir("data")
 	if err != nil {
 		panic("Error reading embedded i18n data: " + err.Error())
 	}
 	for _, file := range files {
-		locales = append(locales, strings.TrimSuffix(file.Name(), ".po"))
+		if locale, ok := strings.CutSuffix(file.Name(), ".po"); ok {
+			locales = append(locales, locale)
+		}
 	}
 	return locales
 }
@@ -59,6 +62,10 @@ func findMatchingLanguage(language string, supportedLocales []string) string {
 }
 
 func findMatchingLocale(locale string, supportedLocales []string) string {
+	// Accept also the BCP 47 format (e.g. pt-BR) and drop the encoding
+	// (e.g. it_IT.UTF-8)
+	locale = strings.ReplaceAll(locale, "-", "_")
+	locale, _, _ = strings.Cut(locale, ".")
 	for _, suportedLocale := range supportedLocales {
 		if locale == suportedLocale {
 			return suportedLocale
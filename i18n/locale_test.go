@@ -35,4 +35,13 @@ func TestLocaleMatch(t *testing.T) {
 	require.Equal(t, "pt_BR", findMatchingLocale("pt_PT", supportedLocales), "Language match with country")
 	require.Equal(t, "", findMatchingLocale("es", supportedLocales), "Multiple languages match")
 	require.Equal(t, "", findMatchingLocale("zn_CH", supportedLocales), "Not supported")
+	require.Equal(t, "pt_BR", findMatchingLocale("pt-BR", supportedLocales), "BCP 47 format")
+	require.Equal(t, "it_IT", findMatchingLocale("it_IT.UTF-8", supportedLocales), "With encoding")
+}
+
+func TestSupportedLocales(t *testing.T) {
+	locales := SupportedLocales()
+	require.Contains(t, locales, "en")
+	require.Contains(t, locales, "it_IT")
+	require.NotContains(t, locales, "README.md")
 }
@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/remote"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `agent` command
+func NewCommand() *cobra.Command {
+	agentCommand := &cobra.Command{
+		Use:   "agent",
+		Short: tr("Commands to use the boards attached to a remote upload agent."),
+		Long: tr("Commands to list, upload to and monitor the boards attached to another machine, running `daemon --agent`. " +
+			"The agent is reached at the address set in the remote.address setting, authenticated with the remote.token setting."),
+		Example: "  " + os.Args[0] + " agent list\n" +
+			"  " + os.Args[0] + " agent upload -p /dev/ttyACM0 -b arduino:avr:uno MySketch\n" +
+			"  " + os.Args[0] + " agent monitor -p /dev/ttyACM0 -c baudrate=115200",
+	}
+
+	agentCommand.AddCommand(initListCommand())
+	agentCommand.AddCommand(initUploadCommand())
+	agentCommand.AddCommand(initMonitorCommand())
+
+	return agentCommand
+}
+
+// connect connects to the remote agent and initializes an instance on it
+func connect(ctx context.Context) *remote.Client {
+	client, err := remote.Connect(ctx)
+	if err != nil {
+		feedback.Fatal(tr("Error connecting to the remote agent: %v", err), feedback.ErrNetwork)
+	}
+	if err := client.InitInstance(ctx); err != nil {
+		client.Close()
+		feedback.Fatal(tr("Error initializing the remote agent: %v", err), feedback.ErrGeneric)
+	}
+	return client
+}
+
+// findPort returns the port of the agent with the given address and, if
+// set, protocol
+func findPort(ctx context.Context, client *remote.Client, address, protocol string) (*rpc.DetectedPort, error) {
+	ports, err := client.BoardList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, port := range ports {
+		if port.GetPort().GetAddress() == address && (protocol == "" || port.GetPort().GetProtocol() == protocol) {
+			return port, nil
+		}
+	}
+	return nil, errors.New(tr("port %s not found on the remote agent, or not allowed", address))
+}
@@ -0,0 +1,90 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package agent
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initListCommand() *cobra.Command {
+	listCommand := &cobra.Command{
+		Use:     "list",
+		Short:   tr("List the ports of the remote agent."),
+		Long:    tr("List the ports detected by the remote agent that the user is allowed to use, with the boards attached."),
+		Example: "  " + os.Args[0] + " agent list",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runListCommand()
+		},
+	}
+	return listCommand
+}
+
+func runListCommand() {
+	logrus.Info("Executing `arduino-cli agent list`")
+
+	ctx := context.Background()
+	client := connect(ctx)
+	defer client.Close()
+
+	ports, err := client.BoardList(ctx)
+	if err != nil {
+		feedback.Fatal(tr("Error detecting the boards of the remote agent: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&listResult{Ports: ports})
+}
+
+type listResult struct {
+	Ports []*rpc.DetectedPort `json:"ports"`
+}
+
+func (r *listResult) Data() interface{} {
+	return r
+}
+
+func (r *listResult) String() string {
+	if len(r.Ports) == 0 {
+		return tr("No boards found.")
+	}
+	sort.Slice(r.Ports, func(i, j int) bool {
+		x, y := r.Ports[i].GetPort(), r.Ports[j].GetPort()
+		return x.GetProtocol() < y.GetProtocol() ||
+			(x.GetProtocol() == y.GetProtocol() && x.GetAddress() < y.GetAddress())
+	})
+
+	t := table.New()
+	t.SetHeader(tr("Port"), tr("Protocol"), tr("Board Name"), tr("FQBN"))
+	for _, detectedPort := range r.Ports {
+		port := detectedPort.GetPort()
+		boards := detectedPort.GetMatchingBoards()
+		if len(boards) == 0 {
+			t.AddRow(port.GetAddress(), port.GetProtocol(), tr("Unknown"), "")
+			continue
+		}
+		for _, board := range boards {
+			t.AddRow(port.GetAddress(), port.GetProtocol(), board.GetName(), board.GetFqbn())
+		}
+	}
+	return t.Render()
+}
@@ -0,0 +1,112 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initMonitorCommand() *cobra.Command {
+	var fqbn, address, protocol string
+	var configs []string
+	var quiet bool
+	monitorCommand := &cobra.Command{
+		Use:   "monitor",
+		Short: tr("Open a communication port of the remote agent."),
+		Long:  tr("Open a communication port of the remote agent, the data is tunneled through the connection to the agent."),
+		Example: "  " + os.Args[0] + " agent monitor -p /dev/ttyACM0\n" +
+			"  " + os.Args[0] + " agent monitor -p /dev/ttyACM0 -c baudrate=115200",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runMonitorCommand(fqbn, address, protocol, configs, quiet)
+		},
+	}
+	monitorCommand.Flags().StringVarP(&fqbn, "fqbn", "b", "", tr("Fully Qualified Board Name, e.g.: arduino:avr:uno"))
+	monitorCommand.Flags().StringVarP(&address, "port", "p", "", tr("Address of the port of the remote agent, e.g.: /dev/ttyACM0"))
+	monitorCommand.Flags().StringVarP(&protocol, "protocol", "l", "", tr("Protocol of the port, e.g.: serial"))
+	monitorCommand.Flags().StringSliceVarP(&configs, "config", "c", []string{}, tr("Configure communication port settings. The format is <ID>=<value>[,<ID>=<value>]..."))
+	monitorCommand.Flags().BoolVarP(&quiet, "quiet", "q", false, tr("Run in silent mode, show only monitor input and output."))
+	monitorCommand.MarkFlagRequired("port")
+	return monitorCommand
+}
+
+func runMonitorCommand(fqbn, address, protocol string, configs []string, quiet bool) {
+	logrus.Info("Executing `arduino-cli agent monitor`")
+
+	portConfiguration := &rpc.MonitorPortConfiguration{}
+	for _, config := range configs {
+		id, value, found := strings.Cut(config, "=")
+		if !found || id == "" {
+			feedback.Fatal(tr("Invalid port setting: %s", config), feedback.ErrBadArgument)
+		}
+		portConfiguration.Settings = append(portConfiguration.Settings, &rpc.MonitorPortSetting{SettingId: id, Value: value})
+	}
+
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+	client := connect(ctx)
+	defer client.Close()
+
+	port, err := findPort(ctx, client, address, protocol)
+	if err != nil {
+		feedback.FatalError(err, feedback.ErrBadArgument)
+	}
+	portStream, err := client.OpenPort(ctx, &rpc.PortIOOpenRequest{
+		Port:              port.GetPort(),
+		Fqbn:              fqbn,
+		PortConfiguration: portConfiguration,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error opening the port of the remote agent: %v", err), feedback.ErrGeneric)
+	}
+	defer portStream.Close()
+
+	if !quiet {
+		feedback.Print(tr("Connected to %s on the remote agent! Press CTRL-C to exit.", address))
+	}
+	ttyIn, ttyOut, err := feedback.InteractiveStreams()
+	if err != nil {
+		feedback.FatalError(err, feedback.ErrGeneric)
+	}
+	go func() {
+		if _, err := io.Copy(ttyOut, portStream); err != nil && !errors.Is(err, io.EOF) && !quiet {
+			feedback.Print(tr("Port closed: %v", err))
+		}
+		cancel()
+	}()
+	go func() {
+		if _, err := io.Copy(portStream, ttyIn); err != nil && !errors.Is(err, io.EOF) && !quiet {
+			feedback.Print(tr("Port closed: %v", err))
+		}
+		cancel()
+	}()
+
+	// Wait for port closed
+	<-ctx.Done()
+	if portStream.Dropped > 0 && !quiet {
+		feedback.Warning(tr("%d bytes received from the port have been dropped by the remote agent", portStream.Dropped))
+	}
+}
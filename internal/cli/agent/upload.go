@@ -0,0 +1,145 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package agent
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initUploadCommand() *cobra.Command {
+	var fqbn, address, protocol, inputDir string
+	var verify, verbose bool
+	uploadCommand := &cobra.Command{
+		Use:   "upload [" + tr("sketchPath") + "]",
+		Short: tr("Upload a sketch to a board attached to the remote agent."),
+		Long: tr("Upload the build artifacts of a sketch, built locally with `compile --export-binaries` or `compile --output-dir`, " +
+			"to a board attached to the remote agent."),
+		Example: "  " + os.Args[0] + " agent upload -p /dev/ttyACM0 -b arduino:avr:uno MySketch\n" +
+			"  " + os.Args[0] + " agent upload -p /dev/ttyACM0 --input-dir build/",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			sketchArg := ""
+			if len(args) > 0 {
+				sketchArg = args[0]
+			}
+			runUploadCommand(sketchArg, fqbn, address, protocol, inputDir, verify, verbose)
+		},
+	}
+	uploadCommand.Flags().StringVarP(&fqbn, "fqbn", "b", "", tr("Fully Qualified Board Name, e.g.: arduino:avr:uno"))
+	uploadCommand.Flags().StringVarP(&address, "port", "p", "", tr("Address of the port of the remote agent, e.g.: /dev/ttyACM0"))
+	uploadCommand.Flags().StringVarP(&protocol, "protocol", "l", "", tr("Protocol of the port, e.g.: serial"))
+	uploadCommand.Flags().StringVar(&inputDir, "input-dir", "", tr("Directory containing the build artifacts to upload."))
+	uploadCommand.Flags().BoolVarP(&verify, "verify", "t", false, tr("Verify uploaded binary after the upload."))
+	uploadCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	uploadCommand.MarkFlagRequired("port")
+	return uploadCommand
+}
+
+func runUploadCommand(sketchArg, fqbn, address, protocol, inputDir string, verify, verbose bool) {
+	logrus.Info("Executing `arduino-cli agent upload`")
+
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	var sketchPath *paths.Path
+	if inputDir == "" {
+		sketchPath = arguments.InitSketchPath(sketchArg, true)
+		sk, err := sketch.LoadSketch(ctx, &rpc.LoadSketchRequest{SketchPath: sketchPath.String()})
+		if err != nil {
+			feedback.FatalError(err, feedback.ErrGeneric)
+		}
+		if fqbn == "" {
+			fqbn = sk.GetDefaultFqbn()
+		}
+	}
+
+	client := connect(ctx)
+	defer client.Close()
+
+	port, err := findPort(ctx, client, address, protocol)
+	if err != nil {
+		feedback.FatalError(err, feedback.ErrBadArgument)
+	}
+	// The board detected on the port is used if the FQBN is not set
+	if fqbn == "" {
+		if boards := port.GetMatchingBoards(); len(boards) == 1 {
+			fqbn = boards[0].GetFqbn()
+		}
+	}
+
+	artifactsDir := paths.New(inputDir)
+	if artifactsDir == nil {
+		if fqbn == "" {
+			feedback.Fatal(tr("Missing FQBN (Fully Qualified Board Name)"), feedback.ErrBadArgument)
+		}
+		artifactsDir = sketchPath.Join("build", exportDirName(fqbn))
+	}
+
+	stdOut, stdErr, stdIOResult := feedback.OutputStreams()
+	res, err := client.Upload(ctx, &rpc.UploadRequest{
+		Fqbn:    fqbn,
+		Port:    port.GetPort(),
+		Verbose: verbose,
+		Verify:  verify,
+	}, artifactsDir, stdOut, stdErr)
+	if err != nil {
+		feedback.Fatal(tr("Error during Upload: %v", err), feedback.ErrGeneric)
+	}
+	io := stdIOResult()
+	feedback.PrintResult(&uploadResult{
+		Stdout:            io.Stdout,
+		Stderr:            io.Stderr,
+		UpdatedUploadPort: res.GetUpdatedUploadPort(),
+	})
+}
+
+// exportDirName returns the name of the folder where `compile --export-binaries`
+// saves the artifacts for the board
+func exportDirName(fqbn string) string {
+	if parsed, err := cores.ParseFQBN(fqbn); err == nil {
+		fqbn = parsed.StringWithoutConfig()
+	}
+	return strings.ReplaceAll(fqbn, ":", ".")
+}
+
+type uploadResult struct {
+	Stdout            string    `json:"stdout"`
+	Stderr            string    `json:"stderr"`
+	UpdatedUploadPort *rpc.Port `json:"updated_upload_port,omitempty"`
+}
+
+func (r *uploadResult) Data() interface{} {
+	return r
+}
+
+func (r *uploadResult) String() string {
+	if r.UpdatedUploadPort == nil {
+		return ""
+	}
+	return tr("New upload port: %[1]s (%[2]s)", r.UpdatedUploadPort.GetAddress(), r.UpdatedUploadPort.GetProtocol())
+}
@@ -0,0 +1,91 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"errors"
+
+	"github.com/arduino/arduino-cli/arduino/advisories"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/spf13/cobra"
+)
+
+// AdvisoriesFlag contains the --fail-on-advisory flag of the commands that
+// check the advisories of the libraries and platforms.
+type AdvisoriesFlag struct {
+	failOnAdvisory bool
+}
+
+// AddToCommand adds the flag to the command
+func (a *AdvisoriesFlag) AddToCommand(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&a.failOnAdvisory, "fail-on-advisory", false,
+		tr("Exit with an error if a library or a platform is affected by a security or deprecation advisory."))
+}
+
+// Check returns an error if the --fail-on-advisory flag is set and there are
+// advisories. A nil AdvisoriesFlag never fails.
+func (a *AdvisoriesFlag) Check(advisories []*rpc.Advisory) error {
+	if a == nil || !a.failOnAdvisory || len(advisories) == 0 {
+		return nil
+	}
+	return errors.New(tr("%d advisories affect the libraries and platforms in use", len(advisories)))
+}
+
+// Warn prints a warning for each advisory, then it works like Check.
+func (a *AdvisoriesFlag) Warn(advisories []*rpc.Advisory) error {
+	for _, advisory := range advisories {
+		feedback.Warning(AdvisoryWarning(advisory))
+	}
+	return a.Check(advisories)
+}
+
+// AdvisoryWarning returns the warning message of the advisory
+func AdvisoryWarning(advisory *rpc.Advisory) string {
+	subject := advisory.GetLibrary()
+	if subject == "" {
+		subject = advisory.GetPlatform()
+	}
+	if advisory.GetVersion() != "" {
+		subject += "@" + advisory.GetVersion()
+	}
+	kind := advisory.GetType()
+	if advisory.GetSeverity() != "" {
+		kind += " (" + advisory.GetSeverity() + ")"
+	}
+	msg := tr("Warning: %[1]s is affected by the %[2]s advisory %[3]s: %[4]s", subject, kind, advisory.GetId(), advisory.GetSummary())
+	if advisory.GetFixedIn() != "" {
+		msg += ", " + tr("fixed in %s", advisory.GetFixedIn())
+	}
+	if advisory.GetUrl() != "" {
+		msg += " " + advisory.GetUrl()
+	}
+	return msg
+}
+
+// LoadAdvisories loads the advisories feeds of the configuration, it prints
+// a warning if they can't be loaded.
+func LoadAdvisories() *advisories.Feed {
+	feed, err := advisories.Load(
+		configuration.Settings.GetStringSlice("advisories.urls"),
+		configuration.AdvisoriesDir(configuration.Settings))
+	if err != nil {
+		feedback.Warning(tr("Warning: cannot check the advisories: %v", err))
+		return nil
+	}
+	return feed
+}
@@ -0,0 +1,52 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"github.com/arduino/arduino-cli/arduino/github"
+	"github.com/arduino/arduino-cli/arduino/httpclient"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/go-paths-helper"
+)
+
+// DownloadGitHubReleaseAsset downloads to dir the asset of the GitHub release
+// referenced by arg (`owner/repo[@tag][#asset]`), selected by one of the
+// extensions if not named in the reference, and verifies its checksum.
+func DownloadGitHubReleaseAsset(arg string, dir *paths.Path, extensions ...string) (*github.ReleaseReference, *paths.Path) {
+	ref, err := github.ParseReleaseReference(arg)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+	}
+	httpClient, err := httpclient.New()
+	if err != nil {
+		feedback.Fatal(tr("Error creating HTTP client: %v", err), feedback.ErrGeneric)
+	}
+	client := github.NewClient(httpClient, github.APIURL)
+	release, err := client.GetRelease(ref)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrNetwork)
+	}
+	asset, err := release.FindAsset(ref, extensions...)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+	}
+	feedback.Print(tr("Downloading %[1]s from release %[2]s of %[3]s", asset.Name, release.TagName, ref.Owner+"/"+ref.Repo))
+	file := dir.Join(asset.Name)
+	if err := client.DownloadAsset(release, asset, file); err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrNetwork)
+	}
+	return ref, file
+}
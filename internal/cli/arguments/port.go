@@ -18,6 +18,7 @@ package arguments
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
@@ -36,13 +37,29 @@ type Port struct {
 	address  string
 	protocol string
 	timeout  DiscoveryTimeout
+	lister   PortLister
+}
+
+// PortLister returns the ports detected within the given timeout. It replaces
+// the discoveries of the CLI process when the commands run through the
+// background daemon.
+type PortLister func(timeout time.Duration) ([]*rpc.DetectedPort, error)
+
+// SetPortLister makes the port and the board be detected with the given
+// PortLister instead of the discoveries of the given instance.
+func (p *Port) SetPortLister(lister PortLister) {
+	p.lister = lister
 }
 
 // AddToCommand adds the flags used to set port and protocol to the specified Command
 func (p *Port) AddToCommand(cmd *cobra.Command) {
-	cmd.Flags().StringVarP(&p.address, "port", "p", "", tr("Upload port address, e.g.: COM3 or /dev/ttyACM2"))
+	cmd.Flags().StringVarP(&p.address, "port", "p", "", tr("Upload port address, e.g.: COM3 or /dev/ttyACM2, serial number of the board, e.g.: serial:SN12345678, or port alias"))
 	cmd.RegisterFlagCompletionFunc("port", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		return f.Map(GetAvailablePorts(), (*rpc.Port).GetAddress), cobra.ShellCompDirectiveDefault
+		res := f.Map(GetAvailablePorts(), (*rpc.Port).GetAddress)
+		for alias := range portAliases() {
+			res = append(res, alias)
+		}
+		return res, cobra.ShellCompDirectiveDefault
 	})
 	cmd.Flags().StringVarP(&p.protocol, "protocol", "l", "", tr("Upload port protocol, e.g: serial"))
 	cmd.RegisterFlagCompletionFunc("protocol", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -54,20 +71,28 @@ func (p *Port) AddToCommand(cmd *cobra.Command) {
 // GetPortAddressAndProtocol returns only the port address and the port protocol
 // without any other port metadata obtained from the discoveries.
 // This method allows will bypass the discoveries if:
-// - a nil instance is passed: in this case the plain port and protocol arguments are returned (even if empty)
-// - a protocol is specified: in this case the discoveries are not needed to autodetect the protocol.
+//   - a nil instance is passed: in this case the plain port and protocol arguments are returned (even if empty)
+//   - a protocol is specified: in this case the discoveries are not needed to autodetect the protocol,
+//     unless the port is selected through the serial number or the hardware ID of the board.
 func (p *Port) GetPortAddressAndProtocol(instance *rpc.Instance, defaultAddress, defaultProtocol string) (string, string, error) {
-	if p.protocol != "" || instance == nil {
-		return p.address, p.protocol, nil
-	}
-
-	port, err := p.GetPort(instance, defaultAddress, defaultProtocol)
+	port, err := p.GetPortOrAddress(instance, defaultAddress, defaultProtocol)
 	if err != nil {
 		return "", "", err
 	}
 	return port.Address, port.Protocol, nil
 }
 
+// GetPortOrAddress returns the Port obtained by parsing command line arguments.
+// The port metadata is obtained using the pluggable discoveries, unless they are
+// bypassed for the same reasons explained in GetPortAddressAndProtocol: in this
+// case the returned Port contains only the address and the protocol.
+func (p *Port) GetPortOrAddress(instance *rpc.Instance, defaultAddress, defaultProtocol string) (*rpc.Port, error) {
+	if (p.protocol != "" && !isPortSelector(p.address)) || (instance == nil && p.lister == nil) {
+		return &rpc.Port{Address: p.address, Protocol: p.protocol}, nil
+	}
+	return p.GetPort(instance, defaultAddress, defaultProtocol)
+}
+
 // GetPort returns the Port obtained by parsing command line arguments.
 // The extra metadata for the ports is obtained using the pluggable discoveries.
 func (p *Port) GetPort(instance *rpc.Instance, defaultAddress, defaultProtocol string) (*rpc.Port, error) {
@@ -89,6 +114,20 @@ func (p *Port) GetPort(instance *rpc.Instance, defaultAddress, defaultProtocol s
 	}
 	logrus.WithField("port", address).Tracef("Upload port")
 
+	if p.lister != nil {
+		detectedPorts, err := p.lister(p.timeout.Get())
+		if err != nil {
+			return nil, err
+		}
+		for _, detectedPort := range detectedPorts {
+			port := detectedPort.GetPort()
+			if (protocol == "" || protocol == port.GetProtocol()) && portMatches(port, address) {
+				return port, nil
+			}
+		}
+		return portNotFound(address, protocol)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	watcher, err := board.Watch(ctx, &rpc.BoardListWatchRequest{Instance: instance})
@@ -104,23 +143,31 @@ func (p *Port) GetPort(instance *rpc.Instance, defaultAddress, defaultProtocol s
 				continue
 			}
 			port := portEvent.GetPort().GetPort()
-			if (protocol == "" || protocol == port.GetProtocol()) && address == port.GetAddress() {
+			if (protocol == "" || protocol == port.GetProtocol()) && portMatches(port, address) {
 				return port, nil
 			}
 
 		case <-deadline:
-			// No matching port found
-			if protocol == "" {
-				return &rpc.Port{
-					Address:  address,
-					Protocol: "serial",
-				}, nil
-			}
-			return nil, fmt.Errorf(tr("port not found: %[1]s %[2]s"), address, protocol)
+			return portNotFound(address, protocol)
 		}
 	}
 }
 
+// portNotFound returns the port to use when no detected port matches the
+// address: a plain address is assumed to be a serial port.
+func portNotFound(address, protocol string) (*rpc.Port, error) {
+	if isPortSelector(address) {
+		return nil, fmt.Errorf(tr("port not found: %[1]s"), address)
+	}
+	if protocol == "" {
+		return &rpc.Port{
+			Address:  address,
+			Protocol: "serial",
+		}, nil
+	}
+	return nil, fmt.Errorf(tr("port not found: %[1]s %[2]s"), address, protocol)
+}
+
 // GetSearchTimeout returns the timeout
 func (p *Port) GetSearchTimeout() time.Duration {
 	return p.timeout.Get()
@@ -130,16 +177,22 @@ func (p *Port) GetSearchTimeout() time.Duration {
 // discovered Port object together with the FQBN. If the port does not match
 // exactly 1 board,
 func (p *Port) DetectFQBN(inst *rpc.Instance) (string, *rpc.Port) {
-	detectedPorts, _, err := board.List(&rpc.BoardListRequest{
-		Instance: inst,
-		Timeout:  p.timeout.Get().Milliseconds(),
-	})
+	var detectedPorts []*rpc.DetectedPort
+	var err error
+	if p.lister != nil {
+		detectedPorts, err = p.lister(p.timeout.Get())
+	} else {
+		detectedPorts, _, err = board.List(&rpc.BoardListRequest{
+			Instance: inst,
+			Timeout:  p.timeout.Get().Milliseconds(),
+		})
+	}
 	if err != nil {
 		feedback.Fatal(tr("Error during FQBN detection: %v", err), feedback.ErrGeneric)
 	}
 	for _, detectedPort := range detectedPorts {
 		port := detectedPort.GetPort()
-		if p.address != port.GetAddress() {
+		if !portMatches(port, p.address) {
 			continue
 		}
 		if p.protocol != "" && p.protocol != port.GetProtocol() {
@@ -156,6 +209,37 @@ func (p *Port) DetectFQBN(inst *rpc.Instance) (string, *rpc.Port) {
 	return "", nil
 }
 
+// isPortSelector returns true if the address selects the port through the
+// serial number (serial:SN12345678) or the hardware ID (id:XXXX) of the board,
+// or through a port alias of the configuration, that stay the same when the
+// port is enumerated with a different address.
+func isPortSelector(address string) bool {
+	return strings.HasPrefix(address, "serial:") || strings.HasPrefix(address, "id:") || lookupPortAlias(address) != nil
+}
+
+// portMatches returns true if the port is selected by the given address
+func portMatches(port *rpc.Port, address string) bool {
+	if alias := lookupPortAlias(address); alias != nil {
+		return alias.matches(port)
+	}
+	if serialNumber, ok := strings.CutPrefix(address, "serial:"); ok {
+		return serialNumber != "" && strings.EqualFold(portSerialNumber(port), serialNumber)
+	}
+	if hardwareID, ok := strings.CutPrefix(address, "id:"); ok {
+		return hardwareID != "" && port.GetHardwareId() == hardwareID
+	}
+	return port.GetAddress() == address
+}
+
+// portSerialNumber returns the USB serial number of the board connected to
+// the port, falling back to the hardware ID if the discovery doesn't report it
+func portSerialNumber(port *rpc.Port) string {
+	if serialNumber, ok := port.GetProperties()["serialNumber"]; ok {
+		return serialNumber
+	}
+	return port.GetHardwareId()
+}
+
 // IsPortFlagSet returns true if the port address is provided
 func (p *Port) IsPortFlagSet() bool {
 	return p.address != ""
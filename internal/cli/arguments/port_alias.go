@@ -0,0 +1,72 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"strings"
+
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// portAlias is a name, defined in the `ports.aliases` setting, that selects
+// the port through the identifiers of the board connected to it. The empty
+// fields match any port.
+type portAlias struct {
+	Protocol     string `mapstructure:"protocol"`
+	VID          string `mapstructure:"vid"`
+	PID          string `mapstructure:"pid"`
+	SerialNumber string `mapstructure:"serial_number"`
+	HardwareID   string `mapstructure:"hardware_id"`
+}
+
+// portAliases returns the port aliases of the configuration, the names are
+// lowercase.
+func portAliases() map[string]*portAlias {
+	aliases := map[string]*portAlias{}
+	if err := configuration.Settings.UnmarshalKey("ports.aliases", &aliases); err != nil {
+		feedback.Fatal(tr("Invalid port aliases in the configuration: %v", err), feedback.ErrBadArgument)
+	}
+	return aliases
+}
+
+// lookupPortAlias returns the port alias with the given name, or nil if there
+// is no alias with that name.
+func lookupPortAlias(name string) *portAlias {
+	alias, ok := portAliases()[strings.ToLower(name)]
+	if !ok {
+		return nil
+	}
+	if alias.VID == "" && alias.PID == "" && alias.SerialNumber == "" && alias.HardwareID == "" {
+		feedback.Fatal(tr("The port alias %s must set at least one of vid, pid, serial_number or hardware_id", name), feedback.ErrBadArgument)
+	}
+	return alias
+}
+
+// matches returns true if the board connected to the port has the identifiers
+// of the alias
+func (a *portAlias) matches(port *rpc.Port) bool {
+	check := func(value, portValue string) bool {
+		return value == "" || strings.EqualFold(value, portValue)
+	}
+	props := port.GetProperties()
+	return check(a.Protocol, port.GetProtocol()) &&
+		check(a.VID, props["vid"]) &&
+		check(a.PID, props["pid"]) &&
+		check(a.SerialNumber, portSerialNumber(port)) &&
+		check(a.HardwareID, port.GetHardwareId())
+}
@@ -0,0 +1,124 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortMatches(t *testing.T) {
+	serialPort := &rpc.Port{
+		Address:    "/dev/ttyACM0",
+		Protocol:   "serial",
+		Properties: map[string]string{"serialNumber": "SN12345678", "vid": "0x2341"},
+		HardwareId: "SN12345678",
+	}
+	networkPort := &rpc.Port{
+		Address:    "192.168.1.10",
+		Protocol:   "network",
+		HardwareId: "a8:03:2a:11:22:33",
+	}
+
+	require.True(t, portMatches(serialPort, "/dev/ttyACM0"))
+	require.False(t, portMatches(serialPort, "/dev/ttyACM1"))
+	require.True(t, portMatches(serialPort, "serial:SN12345678"))
+	require.True(t, portMatches(serialPort, "serial:sn12345678"))
+	require.False(t, portMatches(serialPort, "serial:SN00000000"))
+	require.False(t, portMatches(serialPort, "serial:"))
+	require.True(t, portMatches(serialPort, "id:SN12345678"))
+
+	require.True(t, portMatches(networkPort, "id:a8:03:2a:11:22:33"))
+	require.False(t, portMatches(networkPort, "id:"))
+	// The hardware ID is used when the port has no serial number property
+	require.True(t, portMatches(networkPort, "serial:A8:03:2A:11:22:33"))
+	require.False(t, portMatches(networkPort, "serial:SN12345678"))
+
+	require.True(t, isPortSelector("serial:SN12345678"))
+	require.True(t, isPortSelector("id:1234"))
+	require.False(t, isPortSelector("/dev/ttyACM0"))
+	require.False(t, isPortSelector("fe80::1"))
+}
+
+func TestPortAliases(t *testing.T) {
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("ports.aliases", map[string]interface{}{
+		"bench-left": map[string]interface{}{
+			"vid":           "0x2341",
+			"serial_number": "sn12345678",
+		},
+		"bench-right": map[string]interface{}{
+			"protocol":    "serial",
+			"hardware_id": "SN00000000",
+		},
+	})
+
+	port := &rpc.Port{
+		Address:    "/dev/ttyACM0",
+		Protocol:   "serial",
+		Properties: map[string]string{"serialNumber": "SN12345678", "vid": "0x2341", "pid": "0x0043"},
+		HardwareId: "SN12345678",
+	}
+	require.True(t, isPortSelector("bench-left"))
+	require.True(t, isPortSelector("Bench-Right"))
+	require.False(t, isPortSelector("bench-middle"))
+	require.True(t, portMatches(port, "bench-left"))
+	require.True(t, portMatches(port, "BENCH-LEFT"))
+	require.False(t, portMatches(port, "bench-right"))
+	require.False(t, portMatches(port, "bench-middle"))
+}
+
+func TestPortLister(t *testing.T) {
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init("")
+
+	detected := []*rpc.DetectedPort{{
+		Port: &rpc.Port{
+			Address:    "/dev/ttyACM0",
+			Protocol:   "serial",
+			Properties: map[string]string{"serialNumber": "SN12345678"},
+		},
+		MatchingBoards: []*rpc.BoardListItem{{Name: "Arduino Uno", Fqbn: "arduino:avr:uno"}},
+	}}
+	lister := func(timeout time.Duration) ([]*rpc.DetectedPort, error) { return detected, nil }
+
+	// The ports are detected through the lister, without an instance
+	p := &Port{address: "serial:SN12345678"}
+	p.SetPortLister(lister)
+	port, err := p.GetPortOrAddress(nil, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "/dev/ttyACM0", port.GetAddress())
+	fqbn, port := p.DetectFQBN(nil)
+	require.Equal(t, "arduino:avr:uno", fqbn)
+	require.Equal(t, "/dev/ttyACM0", port.GetAddress())
+
+	// A plain address not detected is assumed to be a serial port
+	p = &Port{address: "/dev/ttyUSB0"}
+	p.SetPortLister(lister)
+	port, err = p.GetPort(nil, "", "")
+	require.NoError(t, err)
+	require.Equal(t, &rpc.Port{Address: "/dev/ttyUSB0", Protocol: "serial"}, port)
+
+	p = &Port{address: "serial:SN00000000"}
+	p.SetPortLister(lister)
+	_, err = p.GetPort(nil, "", "")
+	require.ErrorContains(t, err, "port not found: serial:SN00000000")
+}
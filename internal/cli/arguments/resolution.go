@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/spf13/cobra"
+)
+
+// ResolutionFlag contains the --resolution flag of the commands that resolve
+// the dependencies of a library.
+type ResolutionFlag struct {
+	resolution string
+}
+
+// AddToCommand adds the flag to the command
+func (r *ResolutionFlag) AddToCommand(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&r.resolution, "resolution", "latest",
+		tr("The strategy used to choose the version of the dependencies: latest or minimal (keep the installed versions if possible, otherwise pick the oldest ones)."))
+	cmd.RegisterFlagCompletionFunc("resolution", cobra.FixedCompletions([]string{"latest", "minimal"}, cobra.ShellCompDirectiveDefault))
+}
+
+// ToRPC returns the strategy selected with the flag, it exits with an error if
+// the flag has an invalid value.
+func (r *ResolutionFlag) ToRPC() rpc.LibraryResolutionStrategy {
+	switch r.resolution {
+	case "latest":
+		return rpc.LibraryResolutionStrategy_LIBRARY_RESOLUTION_STRATEGY_LATEST
+	case "minimal":
+		return rpc.LibraryResolutionStrategy_LIBRARY_RESOLUTION_STRATEGY_MINIMAL
+	}
+	feedback.Fatal(tr("Invalid value for --resolution: %s, must be one of latest or minimal", r.resolution), feedback.ErrBadArgument)
+	return rpc.LibraryResolutionStrategy_LIBRARY_RESOLUTION_STRATEGY_LATEST
+}
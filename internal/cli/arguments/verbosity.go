@@ -0,0 +1,145 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/spf13/cobra"
+)
+
+// maxVerbosity is the highest level of detail of the verbose output
+const maxVerbosity = 3
+
+// UploadPhase is the phase of the verbose output of the upload
+const UploadPhase = "upload"
+
+// VerbosePhases are the phases that can be selected with --verbose=PHASE,...
+var VerbosePhases = append(slices.Clone(builder.Phases), UploadPhase)
+
+// Verbosity represents the --verbose flag. The flag can be repeated (-v, -vv,
+// -vvv) to increase the level of detail of the verbose output, or given a
+// list of phases (--verbose=link,upload) to print the verbose output only
+// during them.
+type Verbosity struct {
+	level  int
+	phases []string
+}
+
+// AddToCommand adds the --verbose flag to the specified command.
+func (v *Verbosity) AddToCommand(cmd *cobra.Command) {
+	cmd.Flags().VarP(v, "verbose", "v",
+		tr("Optional, turns on verbose mode. Repeat it (-vv, -vvv) for more details, or use --verbose=%s to turn it on only in the given phases (%s).",
+			"`phases`", strings.Join(VerbosePhases, ", ")))
+	cmd.Flags().Lookup("verbose").NoOptDefVal = "+1"
+	cmd.RegisterFlagCompletionFunc("verbose", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		prefix := toComplete[:strings.LastIndex(toComplete, ",")+1]
+		res := []string{}
+		for _, phase := range VerbosePhases {
+			res = append(res, prefix+phase)
+		}
+		return res, cobra.ShellCompDirectiveNoSpace
+	})
+}
+
+// String implements the pflag.Value interface.
+func (v *Verbosity) String() string {
+	if len(v.phases) > 0 {
+		return strings.Join(v.phases, ",")
+	}
+	if v.level == 0 {
+		return ""
+	}
+	return strconv.Itoa(v.level)
+}
+
+// Set implements the pflag.Value interface.
+func (v *Verbosity) Set(value string) error {
+	switch value {
+	case "+1":
+		// -v, repeated for each v of -vv and -vvv
+		v.level = min(v.level+1, maxVerbosity)
+		return nil
+	case "true":
+		v.level = max(v.level, 1)
+		return nil
+	case "false":
+		v.level = 0
+		v.phases = nil
+		return nil
+	}
+	if level, err := strconv.Atoi(value); err == nil {
+		if level < 0 || level > maxVerbosity {
+			return fmt.Errorf(tr("invalid verbosity level %[1]d, it must be between 0 and %[2]d", level, maxVerbosity))
+		}
+		v.level = level
+		return nil
+	}
+	for _, phase := range strings.Split(value, ",") {
+		phase = strings.TrimSpace(phase)
+		if !slices.Contains(VerbosePhases, phase) {
+			return fmt.Errorf(tr("invalid phase '%[1]s', valid phases are: %[2]s", phase, strings.Join(VerbosePhases, ", ")))
+		}
+		if !slices.Contains(v.phases, phase) {
+			v.phases = append(v.phases, phase)
+		}
+	}
+	return nil
+}
+
+// Type implements the pflag.Value interface. The "count" type hides the
+// internal default value in the help of the flag.
+func (v *Verbosity) Type() string {
+	return "count"
+}
+
+// Level returns the level of detail of the verbose output, 0 if it's off.
+func (v *Verbosity) Level() int {
+	if v.level == 0 && len(v.phases) > 0 {
+		return 1
+	}
+	return v.level
+}
+
+// Phases returns the phases of the build (the upload excluded) where the
+// verbose output is printed, all of them if empty.
+func (v *Verbosity) Phases() []string {
+	res := []string{}
+	for _, phase := range v.phases {
+		if phase != UploadPhase {
+			res = append(res, phase)
+		}
+	}
+	return res
+}
+
+// BuildVerbose returns true if the verbose output of the build is on, in all
+// or in some of its phases.
+func (v *Verbosity) BuildVerbose() bool {
+	if len(v.phases) == 0 {
+		return v.level > 0
+	}
+	return slices.ContainsFunc(v.phases, func(phase string) bool { return phase != UploadPhase })
+}
+
+// UploadVerbose returns true if the verbose output of the upload is on.
+func (v *Verbosity) UploadVerbose() bool {
+	return v.Level() > 0 && (len(v.phases) == 0 || slices.Contains(v.phases, UploadPhase))
+}
@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerbosity(t *testing.T) {
+	parse := func(args ...string) (*Verbosity, error) {
+		v := &Verbosity{}
+		cmd := &cobra.Command{}
+		v.AddToCommand(cmd)
+		return v, cmd.ParseFlags(args)
+	}
+
+	v, err := parse()
+	require.NoError(t, err)
+	require.Equal(t, 0, v.Level())
+	require.False(t, v.BuildVerbose())
+	require.False(t, v.UploadVerbose())
+
+	v, err = parse("-v")
+	require.NoError(t, err)
+	require.Equal(t, 1, v.Level())
+	require.True(t, v.BuildVerbose())
+	require.True(t, v.UploadVerbose())
+	require.Empty(t, v.Phases())
+
+	v, err = parse("-vvvv")
+	require.NoError(t, err)
+	require.Equal(t, 3, v.Level())
+
+	v, err = parse("--verbose=link,upload")
+	require.NoError(t, err)
+	require.Equal(t, 1, v.Level())
+	require.Equal(t, []string{"link"}, v.Phases())
+	require.True(t, v.BuildVerbose())
+	require.True(t, v.UploadVerbose())
+
+	v, err = parse("-vv", "--verbose=upload")
+	require.NoError(t, err)
+	require.Equal(t, 2, v.Level())
+	require.False(t, v.BuildVerbose())
+	require.True(t, v.UploadVerbose())
+
+	_, err = parse("--verbose=linking")
+	require.Error(t, err)
+	_, err = parse("--verbose=4")
+	require.Error(t, err)
+}
@@ -35,10 +35,16 @@ func NewCommand() *cobra.Command {
 	}
 
 	boardCommand.AddCommand(initAttachCommand())
+	boardCommand.AddCommand(initDefineCommand())
 	boardCommand.AddCommand(initDetailsCommand())
+	boardCommand.AddCommand(initEEPROMCommand())
 	boardCommand.AddCommand(initListCommand())
 	boardCommand.AddCommand(initListAllCommand())
+	boardCommand.AddCommand(initNVSCommand())
+	boardCommand.AddCommand(initOptionsCommand())
 	boardCommand.AddCommand(initSearchCommand())
+	boardCommand.AddCommand(initSymbolsCommand())
+	boardCommand.AddCommand(initTestCommand())
 
 	return boardCommand
 }
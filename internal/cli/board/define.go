@@ -0,0 +1,111 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initDefineCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var name string
+	var clock string
+	var variant string
+	var setProperties []string
+	var dryRun bool
+	defineCommand := &cobra.Command{
+		Use:   fmt.Sprintf("define <%s> -b <%s>", tr("BOARD_ID"), tr("FQBN")),
+		Short: tr("Defines a custom board cloning an existing one."),
+		Long: tr("Defines a custom board in the boards.local.txt file of the platform, cloning an existing board.") + "\n" +
+			tr("The configuration options set in the FQBN are fixed in the new board, the other options are kept as menus. The new definition is validated before being written."),
+		Example: "  " + os.Args[0] + " board define nano_8mhz -b arduino:avr:nano:cpu=atmega328 --name \"Nano 8MHz\" --clock 8000000L\n" +
+			"  " + os.Args[0] + " board define myboard -b arduino:avr:uno --variant myvariant --set upload.speed=57600 --dry-run",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runDefineCommand(args[0], fqbn.String(), name, clock, variant, setProperties, dryRun)
+		},
+	}
+	fqbn.AddToCommand(defineCommand)
+	defineCommand.MarkFlagRequired("fqbn")
+	defineCommand.Flags().StringVar(&name, "name", "", tr("Name of the new board, if not set the name of the cloned board is used."))
+	defineCommand.Flags().StringVar(&clock, "clock", "", tr("Clock frequency of the new board, sets the build.f_cpu property, e.g.: 8000000L"))
+	defineCommand.Flags().StringVar(&variant, "variant", "", tr("Variant of the new board, sets the build.variant property."))
+	defineCommand.Flags().StringArrayVar(&setProperties, "set", []string{}, tr("Sets a property of the new board, in the form key=value. Can be used multiple times."))
+	defineCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Validates and prints the definition of the new board without writing it."))
+	return defineCommand
+}
+
+func runDefineCommand(boardID, fqbn, name, clock, variant string, setProperties []string, dryRun bool) {
+	inst := instance.CreateAndInit()
+
+	logrus.Info("Executing `arduino-cli board define`")
+
+	props := map[string]string{}
+	for _, prop := range setProperties {
+		key, value, ok := strings.Cut(prop, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			feedback.Fatal(tr("Invalid property '%s': must be in the form key=value", prop), feedback.ErrBadArgument)
+		}
+		props[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if clock != "" {
+		props["build.f_cpu"] = clock
+	}
+	if variant != "" {
+		props["build.variant"] = variant
+	}
+
+	res, err := board.Define(context.Background(), &rpc.BoardDefineRequest{
+		Instance:   inst,
+		Fqbn:       fqbn,
+		BoardId:    boardID,
+		Name:       name,
+		Properties: props,
+		DryRun:     dryRun,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error defining board: %v", err), feedback.ErrGeneric)
+	}
+
+	feedback.PrintResult(defineResult{res: res, dryRun: dryRun})
+}
+
+type defineResult struct {
+	res    *rpc.BoardDefineResponse
+	dryRun bool
+}
+
+func (dr defineResult) Data() interface{} {
+	return dr.res
+}
+
+func (dr defineResult) String() string {
+	if dr.dryRun {
+		return strings.TrimSuffix(dr.res.GetDefinition(), "\n")
+	}
+	return tr("Board %[1]s added to %[2]s", dr.res.GetFqbn(), dr.res.GetBoardsLocalTxtPath())
+}
@@ -0,0 +1,197 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands/upload"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initEEPROMCommand() *cobra.Command {
+	eepromCommand := &cobra.Command{
+		Use:   "eeprom",
+		Short: tr("Reads and writes the EEPROM of a board."),
+		Long:  tr("Reads and writes the EEPROM of a board using the upload tool of its platform."),
+		Example: "  " + os.Args[0] + " board eeprom dump -b arduino:avr:uno -p /dev/ttyACM0\n" +
+			"  " + os.Args[0] + " board eeprom write -b arduino:avr:uno -p /dev/ttyACM0 eeprom.bin",
+	}
+	eepromCommand.AddCommand(initEEPROMDumpCommand())
+	eepromCommand.AddCommand(initEEPROMWriteCommand())
+	return eepromCommand
+}
+
+func initEEPROMDumpCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var port arguments.Port
+	var outputFile string
+	var verbose bool
+	dumpCommand := &cobra.Command{
+		Use:   "dump",
+		Short: tr("Reads the content of the EEPROM of a board."),
+		Long:  tr("Reads the content of the EEPROM of a board and prints it in hexadecimal notation or saves it to a file."),
+		Example: "  " + os.Args[0] + " board eeprom dump -b arduino:avr:uno -p /dev/ttyACM0\n" +
+			"  " + os.Args[0] + " board eeprom dump -b arduino:avr:uno -p /dev/ttyACM0 --output eeprom.bin",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runEEPROMDumpCommand(&fqbn, &port, outputFile, verbose)
+		},
+	}
+	fqbn.AddToCommand(dumpCommand)
+	port.AddToCommand(dumpCommand)
+	dumpCommand.Flags().StringVarP(&outputFile, "output", "o", "", tr("Save the content of the EEPROM to this file."))
+	dumpCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return dumpCommand
+}
+
+func runEEPROMDumpCommand(fqbnArg *arguments.Fqbn, portArgs *arguments.Port, outputFile string, verbose bool) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli board eeprom dump`")
+
+	fqbn, port := arguments.CalculateFQBNAndPort(portArgs, fqbnArg, inst, "", "", "")
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	stdOut, stdErr, _ := feedback.OutputStreams()
+	res, err := upload.EEPROMRead(ctx, &rpc.BoardEEPROMReadRequest{
+		Instance: inst,
+		Fqbn:     fqbn,
+		Port:     port,
+		Verbose:  verbose,
+	}, stdOut, stdErr)
+	if err != nil {
+		feedback.FatalError(err, feedback.ErrGeneric)
+	}
+	if outputFile != "" {
+		if err := paths.New(outputFile).WriteFile(res.GetData()); err != nil {
+			feedback.Fatal(tr("Error saving EEPROM content: %v", err), feedback.ErrGeneric)
+		}
+	}
+	feedback.PrintResult(&eepromDumpResult{
+		Content:    hex.EncodeToString(res.GetData()),
+		Size:       len(res.GetData()),
+		OutputFile: outputFile,
+		raw:        res.GetData(),
+	})
+}
+
+type eepromDumpResult struct {
+	Content    string `json:"data"`
+	Size       int    `json:"size"`
+	OutputFile string `json:"output_file,omitempty"`
+	raw        []byte
+}
+
+func (r *eepromDumpResult) Data() interface{} {
+	return r
+}
+
+func (r *eepromDumpResult) String() string {
+	if r.OutputFile != "" {
+		return tr("Saved %[1]d bytes of EEPROM to %[2]s", r.Size, r.OutputFile)
+	}
+	return strings.TrimSuffix(hex.Dump(r.raw), "\n")
+}
+
+func initEEPROMWriteCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var port arguments.Port
+	var hexData string
+	var verbose bool
+	writeCommand := &cobra.Command{
+		Use:   "write [FILE]",
+		Short: tr("Writes the content of the EEPROM of a board."),
+		Long:  tr("Writes the content of a binary file, or the given hexadecimal data, in the EEPROM of a board starting from its first byte."),
+		Example: "  " + os.Args[0] + " board eeprom write -b arduino:avr:uno -p /dev/ttyACM0 eeprom.bin\n" +
+			"  " + os.Args[0] + " board eeprom write -b arduino:avr:uno -p /dev/ttyACM0 --hex 0102a0ff",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			file := ""
+			if len(args) > 0 {
+				file = args[0]
+			}
+			runEEPROMWriteCommand(&fqbn, &port, file, hexData, verbose)
+		},
+	}
+	fqbn.AddToCommand(writeCommand)
+	port.AddToCommand(writeCommand)
+	writeCommand.Flags().StringVar(&hexData, "hex", "", tr("The data to write, in hexadecimal notation."))
+	writeCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return writeCommand
+}
+
+func runEEPROMWriteCommand(fqbnArg *arguments.Fqbn, portArgs *arguments.Port, file, hexData string, verbose bool) {
+	var data []byte
+	var err error
+	switch {
+	case file != "" && hexData != "":
+		feedback.Fatal(tr("Can't use both a file and the --hex flag"), feedback.ErrBadArgument)
+	case file != "":
+		data, err = paths.New(file).ReadFile()
+		if err != nil {
+			feedback.Fatal(tr("Error reading %[1]s: %[2]v", file, err), feedback.ErrBadArgument)
+		}
+	case hexData != "":
+		data, err = hex.DecodeString(strings.ReplaceAll(hexData, " ", ""))
+		if err != nil {
+			feedback.Fatal(tr("Invalid hexadecimal data: %v", err), feedback.ErrBadArgument)
+		}
+	default:
+		feedback.Fatal(tr("Missing the data to write: specify a file or use the --hex flag"), feedback.ErrBadArgument)
+	}
+
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli board eeprom write`")
+
+	fqbn, port := arguments.CalculateFQBNAndPort(portArgs, fqbnArg, inst, "", "", "")
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	stdOut, stdErr, _ := feedback.OutputStreams()
+	if _, err := upload.EEPROMWrite(ctx, &rpc.BoardEEPROMWriteRequest{
+		Instance: inst,
+		Fqbn:     fqbn,
+		Port:     port,
+		Data:     data,
+		Verbose:  verbose,
+	}, stdOut, stdErr); err != nil {
+		feedback.FatalError(err, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&eepromWriteResult{Size: len(data)})
+}
+
+type eepromWriteResult struct {
+	Size int `json:"size"`
+}
+
+func (r *eepromWriteResult) Data() interface{} {
+	return r
+}
+
+func (r *eepromWriteResult) String() string {
+	return tr("Written %d bytes of EEPROM", r.Size)
+}
@@ -21,10 +21,13 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/internal/cli/arguments"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
 	"github.com/arduino/arduino-cli/internal/cli/instance"
@@ -37,6 +40,7 @@ import (
 func initListCommand() *cobra.Command {
 	var timeoutArg arguments.DiscoveryTimeout
 	var watch bool
+	var installMissing bool
 	var fqbn arguments.Fqbn
 	listCommand := &cobra.Command{
 		Use:     "list",
@@ -45,18 +49,20 @@ func initListCommand() *cobra.Command {
 		Example: "  " + os.Args[0] + " board list --discovery-timeout 10s",
 		Args:    cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
-			runListCommand(watch, timeoutArg.Get().Milliseconds(), fqbn.String())
+			runListCommand(watch, installMissing, timeoutArg.Get().Milliseconds(), fqbn.String())
 		},
 	}
 
 	timeoutArg.AddToCommand(listCommand)
 	fqbn.AddToCommand(listCommand)
 	listCommand.Flags().BoolVarP(&watch, "watch", "w", false, tr("Command keeps running and prints list of connected boards whenever there is a change."))
+	listCommand.Flags().BoolVar(&installMissing, "install-missing", false, tr("Install the platforms of the detected boards that are not installed, asking for confirmation."))
+	listCommand.MarkFlagsMutuallyExclusive("watch", "install-missing")
 	return listCommand
 }
 
 // runListCommand detects and lists the connected arduino boards
-func runListCommand(watch bool, timeout int64, fqbn string) {
+func runListCommand(watch, installMissing bool, timeout int64, fqbn string) {
 	inst := instance.CreateAndInit()
 
 	logrus.Info("Executing `arduino-cli board list`")
@@ -73,7 +79,7 @@ func runListCommand(watch bool, timeout int64, fqbn string) {
 	})
 	var invalidFQBNErr *arduino.InvalidFQBNError
 	if errors.As(err, &invalidFQBNErr) {
-		feedback.Fatal(tr(err.Error()), feedback.ErrBadArgument)
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
 	}
 	if err != nil {
 		feedback.Warning(tr("Error detecting boards: %v", err))
@@ -82,6 +88,76 @@ func runListCommand(watch bool, timeout int64, fqbn string) {
 		feedback.Warning(tr("Error starting discovery: %v", err))
 	}
 	feedback.PrintResult(result{ports})
+
+	if installMissing {
+		installMissingPlatforms(inst, ports)
+	}
+}
+
+// installMissingPlatforms installs the platforms of the detected boards that
+// are not installed, adding their package index if needed.
+func installMissingPlatforms(inst *rpc.Instance, ports []*rpc.DetectedPort) {
+	for _, missing := range missingPlatforms(ports) {
+		if feedback.IsNonInteractive() || (feedback.GetFormat() == feedback.Text && feedback.IsTerminal()) {
+			install, err := feedback.Confirm(tr("Install platform %s?", missing.GetId()))
+			if err != nil {
+				feedback.Fatal(tr("Error reading the answer: %v", err), feedback.ErrGeneric)
+			}
+			if !install {
+				continue
+			}
+		}
+
+		if !missing.GetIndexed() {
+			if missing.GetIndexUrl() == "" {
+				feedback.Warning(tr("Platform %s is not available in the package indexes", missing.GetId()))
+				continue
+			}
+			// Load the package index providing the platform, it's downloaded
+			// during the initialization of the instance
+			urls := configuration.Settings.GetStringSlice("board_manager.additional_urls")
+			configuration.Settings.Set("board_manager.additional_urls", append(urls, missing.GetIndexUrl()))
+			instance.Init(inst)
+		}
+
+		packager, architecture, _ := strings.Cut(missing.GetId(), ":")
+		_, err := core.PlatformInstall(context.Background(), &rpc.PlatformInstallRequest{
+			Instance:        inst,
+			PlatformPackage: packager,
+			Architecture:    architecture,
+		}, feedback.ProgressBar(), feedback.TaskProgress())
+		if err != nil {
+			feedback.Fatal(tr("Error during install: %v", err), feedback.ErrGeneric)
+		}
+		if !missing.GetIndexed() {
+			feedback.Warning(tr("Add %s to board_manager.additional_urls to get the updates of the platform", missing.GetIndexUrl()))
+		}
+	}
+}
+
+// missingPlatforms returns the platforms of the detected boards that are not
+// installed, without duplicates.
+func missingPlatforms(ports []*rpc.DetectedPort) []*rpc.BoardMissingPlatform {
+	res := []*rpc.BoardMissingPlatform{}
+	seen := map[string]bool{}
+	for _, port := range ports {
+		for _, board := range port.GetMatchingBoards() {
+			if missing := board.GetMissingPlatform(); missing != nil && !seen[missing.GetId()] {
+				seen[missing.GetId()] = true
+				res = append(res, missing)
+			}
+		}
+	}
+	return res
+}
+
+// installCommand returns the command line that installs the missing platform
+func installCommand(missing *rpc.BoardMissingPlatform) string {
+	cmd := "arduino-cli core install " + missing.GetId()
+	if !missing.GetIndexed() && missing.GetIndexUrl() != "" {
+		cmd += " --additional-urls " + missing.GetIndexUrl()
+	}
+	return cmd
 }
 
 func watchList(inst *rpc.Instance) {
@@ -113,8 +189,40 @@ type result struct {
 	ports []*rpc.DetectedPort
 }
 
+// detectedPortResult adds the command to install the missing platforms to the
+// JSON output of a detected port
+type detectedPortResult struct {
+	MatchingBoards []*boardListItemResult `json:"matching_boards,omitempty"`
+	Port           *rpc.Port              `json:"port,omitempty"`
+}
+
+type boardListItemResult struct {
+	*rpc.BoardListItem
+	MissingPlatform *missingPlatformResult `json:"missing_platform,omitempty"`
+}
+
+type missingPlatformResult struct {
+	*rpc.BoardMissingPlatform
+	InstallCommand string `json:"install_command"`
+}
+
 func (dr result) Data() interface{} {
-	return dr.ports
+	res := []*detectedPortResult{}
+	for _, port := range dr.ports {
+		portResult := &detectedPortResult{Port: port.GetPort()}
+		for _, board := range port.GetMatchingBoards() {
+			boardResult := &boardListItemResult{BoardListItem: board}
+			if missing := board.GetMissingPlatform(); missing != nil {
+				boardResult.MissingPlatform = &missingPlatformResult{
+					BoardMissingPlatform: missing,
+					InstallCommand:       installCommand(missing),
+				}
+			}
+			portResult.MatchingBoards = append(portResult.MatchingBoards, boardResult)
+		}
+		res = append(res, portResult)
+	}
+	return res
 }
 
 func (dr result) String() string {
@@ -167,7 +275,11 @@ func (dr result) String() string {
 			t.AddRow(address, protocol, protocolLabel, board, fqbn, coreName)
 		}
 	}
-	return t.Render()
+	res := t.Render()
+	for _, missing := range missingPlatforms(dr.ports) {
+		res += "\n" + tr("Platform %[1]s is not installed, install it with: %[2]s", missing.GetId(), installCommand(missing))
+	}
+	return res
 }
 
 type watchEvent struct {
@@ -0,0 +1,149 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/commands/upload"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initNVSCommand() *cobra.Command {
+	nvsCommand := &cobra.Command{
+		Use:     "nvs",
+		Short:   tr("Reads the NVS partition of ESP32-class boards."),
+		Long:    tr("Reads and parses the Non-Volatile Storage partition of ESP32-class boards."),
+		Example: "  " + os.Args[0] + " board nvs dump -b esp32:esp32:esp32 -p /dev/ttyUSB0",
+	}
+	nvsCommand.AddCommand(initNVSDumpCommand())
+	return nvsCommand
+}
+
+func initNVSDumpCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var port arguments.Port
+	var partition string
+	var inputFile string
+	var outputFile string
+	var verbose bool
+	dumpCommand := &cobra.Command{
+		Use:   "dump [SKETCH_PATH]",
+		Short: tr("Prints the key-value pairs stored in the NVS partition of a board."),
+		Long: tr("Reads the NVS partition of a board and prints the key-value pairs stored in it. The partition is located using the partition table selected for the sketch, if given, or the partition table of the board.") + "\n" +
+			tr("The content of a NVS partition previously saved to a file can be parsed with the --input-file flag."),
+		Example: "  " + os.Args[0] + " board nvs dump -b esp32:esp32:esp32 -p /dev/ttyUSB0\n" +
+			"  " + os.Args[0] + " board nvs dump -b esp32:esp32:esp32 -p /dev/ttyUSB0 MySketch --format json\n" +
+			"  " + os.Args[0] + " board nvs dump --input-file nvs.bin",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			sketchPath := ""
+			if len(args) > 0 {
+				sketchPath = args[0]
+			}
+			runNVSDumpCommand(&fqbn, &port, sketchPath, partition, inputFile, outputFile, verbose)
+		},
+	}
+	fqbn.AddToCommand(dumpCommand)
+	port.AddToCommand(dumpCommand)
+	dumpCommand.Flags().StringVar(&partition, "partition", "nvs", tr("Name of the NVS partition."))
+	dumpCommand.Flags().StringVarP(&inputFile, "input-file", "i", "", tr("Parse the content of a NVS partition saved to this file instead of reading the board."))
+	dumpCommand.Flags().StringVarP(&outputFile, "output", "o", "", tr("Save the raw content of the NVS partition to this file."))
+	dumpCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return dumpCommand
+}
+
+func runNVSDumpCommand(fqbnArg *arguments.Fqbn, portArgs *arguments.Port, sketchPath, partition, inputFile, outputFile string, verbose bool) {
+	logrus.Info("Executing `arduino-cli board nvs dump`")
+
+	req := &rpc.BoardNVSReadRequest{
+		SketchPath: sketchPath,
+		Partition:  partition,
+		Verbose:    verbose,
+	}
+	if inputFile != "" {
+		data, err := paths.New(inputFile).ReadFile()
+		if err != nil {
+			feedback.Fatal(tr("Error reading %[1]s: %[2]v", inputFile, err), feedback.ErrBadArgument)
+		}
+		req.Data = data
+	} else {
+		inst := instance.CreateAndInit()
+		req.Instance = inst
+		req.Fqbn, req.Port = arguments.CalculateFQBNAndPort(portArgs, fqbnArg, inst, "", "", "")
+	}
+
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	stdOut, stdErr, _ := feedback.OutputStreams()
+	res, err := upload.NVSRead(ctx, req, stdOut, stdErr)
+	if err != nil {
+		feedback.FatalError(err, feedback.ErrGeneric)
+	}
+	if outputFile != "" {
+		if err := paths.New(outputFile).WriteFile(res.GetData()); err != nil {
+			feedback.Fatal(tr("Error saving NVS partition: %v", err), feedback.ErrGeneric)
+		}
+	}
+
+	entries := []*nvsEntry{}
+	for _, entry := range res.GetEntries() {
+		entries = append(entries, &nvsEntry{
+			Namespace: entry.GetNamespace(),
+			Key:       entry.GetKey(),
+			Type:      entry.GetType(),
+			Value:     entry.GetValue(),
+		})
+	}
+	feedback.PrintResult(&nvsDumpResult{Entries: entries})
+}
+
+type nvsEntry struct {
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+}
+
+type nvsDumpResult struct {
+	Entries []*nvsEntry `json:"entries"`
+}
+
+func (r *nvsDumpResult) Data() interface{} {
+	return r
+}
+
+func (r *nvsDumpResult) String() string {
+	if len(r.Entries) == 0 {
+		return tr("The NVS partition is empty")
+	}
+	t := table.New()
+	t.SetHeader(tr("Namespace"), tr("Key"), tr("Type"), tr("Value"))
+	for _, entry := range r.Entries {
+		t.AddRow(entry.Namespace, entry.Key, entry.Type, entry.Value)
+	}
+	return t.Render()
+}
@@ -0,0 +1,109 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initOptionsCommand() *cobra.Command {
+	optionsCommand := &cobra.Command{
+		Use:   fmt.Sprintf("options <%s>", tr("FQBN")),
+		Short: tr("Lists the custom configuration options of a board."),
+		Long:  tr("Lists all the custom configuration options of a board with all their possible values, the default values and the values selected in the given FQBN."),
+		Example: "  " + os.Args[0] + " board options arduino:avr:nano\n" +
+			"  " + os.Args[0] + " board options arduino:avr:nano:cpu=atmega168 --format json",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runOptionsCommand(args[0])
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return arguments.GetInstalledBoards(), cobra.ShellCompDirectiveDefault
+		},
+	}
+	return optionsCommand
+}
+
+func runOptionsCommand(fqbn string) {
+	inst := instance.CreateAndInit()
+
+	logrus.Info("Executing `arduino-cli board options`")
+
+	res, err := board.Options(context.Background(), &rpc.BoardOptionsRequest{
+		Instance: inst,
+		Fqbn:     fqbn,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error getting board options: %v", err), feedback.ErrGeneric)
+	}
+
+	feedback.PrintResult(optionsResult{options: res})
+}
+
+type optionsResult struct {
+	options *rpc.BoardOptionsResponse
+}
+
+func (or optionsResult) Data() interface{} {
+	return or.options
+}
+
+func (or optionsResult) String() string {
+	options := or.options
+	if len(options.GetConfigOptions()) == 0 {
+		return tr("The board %s has no custom configuration options.", options.GetFqbn())
+	}
+
+	t := table.New()
+	t.AddRow(tr("Option"), tr("Value"), tr("Label"), "", "")
+	for _, option := range options.GetConfigOptions() {
+		t.AddRow()
+		t.AddRow(option.GetOption(), "", table.NewCell(option.GetOptionLabel(), color.New(color.FgGreen)), "", "")
+		for _, value := range option.GetValues() {
+			isDefault := ""
+			if value.GetIsDefault() {
+				isDefault = tr("default")
+			}
+			selected := ""
+			if value.GetSelected() {
+				selected = "✔"
+			}
+			t.AddRow("", value.GetValue(), value.GetValueLabel(), isDefault, selected)
+		}
+	}
+
+	out := fmt.Sprintf("%s %s (%s)\n", tr("Board:"), options.GetName(), options.GetFqbn())
+	out += t.Render() + "\n"
+	out += fmt.Sprintf("%s %s\n", tr("Default FQBN:"), options.GetDefaultFqbn())
+	out += fmt.Sprintf("%s %s\n", tr("Selected FQBN:"), options.GetSelectedFqbn())
+	out += fmt.Sprintf("%s %d\n", tr("Combinations:"), options.GetCombinations())
+	return out
+}
@@ -31,6 +31,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	searchConnectivity []string
+	searchMCU          string
+	searchInstalled    bool
+	searchNotInstalled bool
+)
+
 func initSearchCommand() *cobra.Command {
 	var searchCommand = &cobra.Command{
 		Use:   fmt.Sprintf("search [%s]", tr("boardname")),
@@ -38,11 +45,18 @@ func initSearchCommand() *cobra.Command {
 		Long:  tr(`Search for a board in the Boards Manager using the specified keywords.`),
 		Example: "" +
 			"  " + os.Args[0] + " board search\n" +
-			"  " + os.Args[0] + " board search zero",
+			"  " + os.Args[0] + " board search zero\n" +
+			"  " + os.Args[0] + " board search --has wifi,ble --installed\n" +
+			"  " + os.Args[0] + " board search --mcu esp32",
 		Args: cobra.ArbitraryArgs,
 		Run:  runSearchCommand,
 	}
 	searchCommand.Flags().BoolVarP(&showHiddenBoard, "show-hidden", "a", false, tr("Show also boards marked as 'hidden' in the platform"))
+	searchCommand.Flags().StringSliceVar(&searchConnectivity, "has", []string{}, tr("Show only the boards with all the given connectivity capabilities, e.g.: wifi,ble"))
+	searchCommand.Flags().StringVar(&searchMCU, "mcu", "", tr("Show only the boards with a microcontroller of the given family, e.g.: esp32"))
+	searchCommand.Flags().BoolVar(&searchInstalled, "installed", false, tr("Show only the boards of the installed platforms"))
+	searchCommand.Flags().BoolVar(&searchNotInstalled, "not-installed", false, tr("Show only the boards of the platforms that are not installed"))
+	searchCommand.MarkFlagsMutuallyExclusive("installed", "not-installed")
 	return searchCommand
 }
 
@@ -55,6 +69,10 @@ func runSearchCommand(cmd *cobra.Command, args []string) {
 		Instance:            inst,
 		SearchArgs:          strings.Join(args, " "),
 		IncludeHiddenBoards: showHiddenBoard,
+		Connectivity:        searchConnectivity,
+		Mcu:                 searchMCU,
+		InstalledOnly:       searchInstalled,
+		NotInstalledOnly:    searchNotInstalled,
 	})
 	if err != nil {
 		feedback.Fatal(tr("Error searching boards: %v", err), feedback.ErrGeneric)
@@ -0,0 +1,122 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// symbolKinds are the values accepted by the --kind flag
+var symbolKinds = map[string]rpc.BoardSymbolKind{
+	"pin":     rpc.BoardSymbolKind_BOARD_SYMBOL_KIND_PIN,
+	"macro":   rpc.BoardSymbolKind_BOARD_SYMBOL_KIND_MACRO,
+	"feature": rpc.BoardSymbolKind_BOARD_SYMBOL_KIND_FEATURE,
+}
+
+func initSymbolsCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var kinds []string
+	symbolsCommand := &cobra.Command{
+		Use:   "symbols -b <" + tr("FQBN") + ">",
+		Short: tr("Lists the constants defined for a board."),
+		Long:  tr("Lists the constants defined for a board by its build properties and by the headers of its variant and core: the names of the pins, the macros and the features of the board."),
+		Example: "  " + os.Args[0] + " board symbols -b arduino:avr:uno\n" +
+			"  " + os.Args[0] + " board symbols -b arduino:avr:uno --kind pin --format json",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runSymbolsCommand(fqbn.String(), kinds)
+		},
+	}
+	fqbn.AddToCommand(symbolsCommand)
+	symbolsCommand.MarkFlagRequired("fqbn")
+	symbolsCommand.Flags().StringSliceVar(&kinds, "kind", []string{}, tr("Show only the constants of the given kinds: pin, macro, feature."))
+	return symbolsCommand
+}
+
+func runSymbolsCommand(fqbn string, kinds []string) {
+	inst := instance.CreateAndInit()
+
+	logrus.Info("Executing `arduino-cli board symbols`")
+
+	filter := map[rpc.BoardSymbolKind]bool{}
+	for _, kind := range kinds {
+		k, ok := symbolKinds[strings.ToLower(kind)]
+		if !ok {
+			feedback.Fatal(tr("Invalid kind '%s', expected one of: pin, macro, feature", kind), feedback.ErrBadArgument)
+		}
+		filter[k] = true
+	}
+
+	res, err := board.Symbols(context.Background(), &rpc.BoardSymbolsRequest{
+		Instance: inst,
+		Fqbn:     fqbn,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error getting board symbols: %v", err), feedback.ErrGeneric)
+	}
+	if len(filter) > 0 {
+		symbols := []*rpc.BoardSymbol{}
+		for _, symbol := range res.GetSymbols() {
+			if filter[symbol.GetKind()] {
+				symbols = append(symbols, symbol)
+			}
+		}
+		res.Symbols = symbols
+	}
+
+	feedback.PrintResult(symbolsResult{res: res})
+}
+
+type symbolsResult struct {
+	res *rpc.BoardSymbolsResponse
+}
+
+func (sr symbolsResult) Data() interface{} {
+	return sr.res
+}
+
+func (sr symbolsResult) String() string {
+	if len(sr.res.GetSymbols()) == 0 {
+		return tr("No symbols found.")
+	}
+	t := table.New()
+	t.SetHeader(tr("Name"), tr("Value"), tr("Kind"))
+	for _, symbol := range sr.res.GetSymbols() {
+		value := symbol.GetValue()
+		if resolved := symbol.GetResolvedValue(); !strings.Contains(value, resolved) {
+			value += " (" + resolved + ")"
+		}
+		kind := ""
+		for name, k := range symbolKinds {
+			if k == symbol.GetKind() {
+				kind = name
+			}
+		}
+		t.AddRow(symbol.GetName(), value, kind)
+	}
+	return t.Render()
+}
@@ -0,0 +1,306 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/commands/monitor"
+	"github.com/arduino/arduino-cli/commands/upload"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+// boardTestSketch is the sketch uploaded by `board test`: it prints the READY
+// line until it receives a '?' on the serial port, then it answers with the
+// PONG line, so both directions of the serial connection are checked.
+const boardTestSketch = `void setup() {
+  Serial.begin(%[2]d);
+}
+
+void loop() {
+  Serial.println("READY %[1]s");
+  delay(250);
+  while (Serial.available() > 0) {
+    if (Serial.read() == '?') {
+      Serial.println("PONG %[1]s");
+    }
+  }
+}
+`
+
+func initTestCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var port arguments.Port
+	var baudrate int
+	var timeout time.Duration
+	var verbose bool
+	testCommand := &cobra.Command{
+		Use:   "test",
+		Short: tr("Checks that a board can be programmed and talks on the serial port."),
+		Long: tr("Compiles a minimal sketch for the board, uploads it and waits for the sketch to answer a handshake on the serial port. " +
+			"The outcome of each step is reported, to check the board, the toolchain and the cable with one command."),
+		Example: "  " + os.Args[0] + " board test -b arduino:avr:uno -p /dev/ttyACM0",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runTestCommand(&fqbn, &port, baudrate, timeout, verbose)
+		},
+	}
+	fqbn.AddToCommand(testCommand)
+	port.AddToCommand(testCommand)
+	testCommand.Flags().IntVar(&baudrate, "baudrate", 9600, tr("The baud rate of the serial handshake."))
+	testCommand.Flags().DurationVar(&timeout, "timeout", 10*time.Second, tr("Max time to wait for the serial handshake."))
+	testCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return testCommand
+}
+
+func runTestCommand(fqbnArg *arguments.Fqbn, portArgs *arguments.Port, baudrate int, timeout time.Duration, verbose bool) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli board test`")
+
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	fqbn, port := arguments.CalculateFQBNAndPort(portArgs, fqbnArg, inst, "", "", "")
+	res := &boardTestResult{Fqbn: fqbn, Port: port.GetAddress()}
+
+	token := make([]byte, 4)
+	if _, err := rand.Read(token); err != nil {
+		feedback.Fatal(tr("Error generating the board test sketch: %v", err), feedback.ErrGeneric)
+	}
+	tmp, err := paths.MkTempDir("", "arduino-cli-board-test")
+	if err != nil {
+		feedback.Fatal(tr("Error creating the board test sketch: %v", err), feedback.ErrGeneric)
+	}
+	defer tmp.RemoveAll()
+	// The Fatal functions terminate the process without running the defers
+	feedback.OnExit(func() { tmp.RemoveAll() })
+	sketchPath := tmp.Join("BoardTest")
+	if err := sketchPath.MkdirAll(); err != nil {
+		feedback.Fatal(tr("Error creating the board test sketch: %v", err), feedback.ErrGeneric)
+	}
+	sketch := fmt.Sprintf(boardTestSketch, hex.EncodeToString(token), baudrate)
+	if err := sketchPath.Join("BoardTest.ino").WriteFile([]byte(sketch)); err != nil {
+		feedback.Fatal(tr("Error creating the board test sketch: %v", err), feedback.ErrGeneric)
+	}
+
+	// The output of the tools is shown in verbose mode, otherwise it's
+	// attached to the step that failed.
+	runStep := func(name string, run func(out, err io.Writer) error) bool {
+		step := &boardTestStep{Name: name}
+		res.Steps = append(res.Steps, step)
+		var output bytes.Buffer
+		out, errOut := io.Writer(&output), io.Writer(&output)
+		if verbose {
+			out, errOut, _ = feedback.OutputStreams()
+		}
+		start := time.Now()
+		err := run(out, errOut)
+		step.Duration = time.Since(start).Round(time.Millisecond).String()
+		if err != nil {
+			step.Error = err.Error()
+			step.Output = strings.TrimSpace(output.String())
+			res.Error = tr("Board test failed at the %[1]s step: %[2]s", name, step.Error)
+			return false
+		}
+		step.Passed = true
+		return true
+	}
+
+	var buildPath string
+	passed := runStep("compile", func(out, errOut io.Writer) error {
+		compileRes, err := compile.Compile(ctx, &rpc.CompileRequest{
+			Instance:   inst,
+			Fqbn:       fqbn,
+			SketchPath: sketchPath.String(),
+			BuildPath:  tmp.Join("build").String(),
+			Verbose:    verbose,
+		}, out, errOut, nil)
+		buildPath = compileRes.GetBuildPath()
+		return err
+	})
+	passed = passed && runStep("upload", func(out, errOut io.Writer) error {
+		uploadRes, err := upload.Upload(ctx, &rpc.UploadRequest{
+			Instance:   inst,
+			Fqbn:       fqbn,
+			SketchPath: sketchPath.String(),
+			Port:       port,
+			Verbose:    verbose,
+			ImportDir:  buildPath,
+		}, out, errOut, nil)
+		if updatedPort := uploadRes.GetUpdatedUploadPort(); updatedPort != nil {
+			port = updatedPort
+		}
+		return err
+	})
+	passed = passed && runStep("serial", func(out, errOut io.Writer) error {
+		return serialHandshake(ctx, inst, port, fqbn, baudrate, hex.EncodeToString(token), timeout)
+	})
+
+	res.Passed = passed
+	if !passed {
+		feedback.FatalResult(res, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(res)
+}
+
+// serialHandshake waits for the READY line of the test sketch, sends the
+// request and waits for the PONG answer.
+func serialHandshake(ctx context.Context, inst *rpc.Instance, port *rpc.Port, fqbn string, baudrate int, token string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	// Boards with native USB may take a while to enumerate the port again
+	// after the upload.
+	var portProxy *monitor.PortProxy
+	for {
+		var err error
+		portProxy, _, err = monitor.Monitor(ctx, &rpc.MonitorRequest{
+			Instance: inst,
+			Port:     port,
+			Fqbn:     fqbn,
+			PortConfiguration: &rpc.MonitorPortConfiguration{
+				Settings: []*rpc.MonitorPortSetting{{SettingId: "baudrate", Value: fmt.Sprint(baudrate)}},
+			},
+		})
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+	defer portProxy.Close()
+	return handshake(ctx, portProxy, token, deadline)
+}
+
+// handshake waits for the READY line of the test sketch on the port, sends
+// the request and waits for the PONG answer until the deadline or until the
+// context is canceled. The port must be closed afterwards to stop reading
+// from it.
+func handshake(ctx context.Context, port io.ReadWriter, token string, deadline time.Time) error {
+	done := make(chan struct{})
+	defer close(done)
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(port)
+		for scanner.Scan() {
+			select {
+			case lines <- strings.TrimSpace(scanner.Text()):
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	ready := false
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				return errors.New(tr("the serial port has been closed"))
+			}
+			switch line {
+			case "READY " + token:
+				ready = true
+				if _, err := port.Write([]byte("?")); err != nil {
+					return err
+				}
+			case "PONG " + token:
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			if ready {
+				return errors.New(tr("the board doesn't answer on the serial port"))
+			}
+			return errors.New(tr("no data received from the board on the serial port"))
+		}
+	}
+}
+
+type boardTestResult struct {
+	Fqbn   string           `json:"fqbn"`
+	Port   string           `json:"port"`
+	Passed bool             `json:"passed"`
+	Steps  []*boardTestStep `json:"steps"`
+	Error  string           `json:"error,omitempty"`
+}
+
+type boardTestStep struct {
+	Name     string `json:"name"`
+	Passed   bool   `json:"passed"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+	Output   string `json:"output,omitempty"`
+}
+
+func (r *boardTestResult) Data() interface{} {
+	return r
+}
+
+// ErrorString implements feedback.ErrorResult
+func (r *boardTestResult) ErrorString() string {
+	return r.Error
+}
+
+func (r *boardTestResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Step"), tr("Result"), tr("Time"))
+	for _, step := range r.Steps {
+		result := tr("passed")
+		if !step.Passed {
+			result = tr("failed")
+		}
+		t.AddRow(step.Name, result, step.Duration)
+	}
+	out := t.Render()
+	for _, step := range r.Steps {
+		if step.Output != "" {
+			out += "\n" + step.Output + "\n"
+		}
+	}
+	if r.Passed {
+		out += "\n" + tr("Board test passed: %[1]s on %[2]s is working", r.Fqbn, r.Port)
+	}
+	return strings.TrimSuffix(out, "\n")
+}
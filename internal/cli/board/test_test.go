@@ -0,0 +1,124 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBoard runs the board test sketch on the other side of a pipe
+type fakeBoard struct {
+	*io.PipeReader
+	out    *io.PipeWriter
+	answer bool
+	token  string
+}
+
+func newFakeBoard(token string, answer bool) *fakeBoard {
+	r, w := io.Pipe()
+	board := &fakeBoard{PipeReader: r, out: w, answer: answer, token: token}
+	go func() {
+		for {
+			if _, err := w.Write([]byte("READY " + token + "\r\n")); err != nil {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	return board
+}
+
+func (b *fakeBoard) Write(data []byte) (int, error) {
+	if b.answer && bytes.Contains(data, []byte("?")) {
+		go b.out.Write([]byte("PONG " + b.token + "\r\n"))
+	}
+	return len(data), nil
+}
+
+type readWriter struct {
+	io.Reader
+	io.Writer
+}
+
+func TestHandshake(t *testing.T) {
+	board := newFakeBoard("c0ffee", true)
+	require.NoError(t, handshake(context.Background(), board, "c0ffee", time.Now().Add(5*time.Second)))
+	board.Close()
+
+	// The sketch is running but doesn't read from the serial port
+	board = newFakeBoard("c0ffee", false)
+	err := handshake(context.Background(), board, "c0ffee", time.Now().Add(100*time.Millisecond))
+	require.ErrorContains(t, err, "doesn't answer")
+	board.Close()
+
+	// Another sketch is running
+	board = newFakeBoard("123456", true)
+	err = handshake(context.Background(), board, "c0ffee", time.Now().Add(100*time.Millisecond))
+	require.ErrorContains(t, err, "no data received")
+	board.Close()
+
+	port := &readWriter{Reader: strings.NewReader("garbage\n"), Writer: io.Discard}
+	err = handshake(context.Background(), port, "c0ffee", time.Now().Add(5*time.Second))
+	require.ErrorContains(t, err, "closed")
+
+	// The handshake is interrupted
+	board = newFakeBoard("c0ffee", false)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	err = handshake(ctx, board, "c0ffee", time.Now().Add(5*time.Second))
+	require.ErrorIs(t, err, context.Canceled)
+	board.Close()
+}
+
+func TestHandshakeStopsReadingThePort(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 10; i++ {
+		board := newFakeBoard("c0ffee", false)
+		require.Error(t, handshake(context.Background(), board, "c0ffee", time.Now().Add(20*time.Millisecond)))
+		board.Close()
+	}
+	require.Eventually(t, func() bool {
+		return runtime.NumGoroutine() <= goroutines
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBoardTestResult(t *testing.T) {
+	res := &boardTestResult{
+		Fqbn: "arduino:avr:uno",
+		Port: "/dev/ttyACM0",
+		Steps: []*boardTestStep{
+			{Name: "compile", Passed: true, Duration: "1s"},
+			{Name: "upload", Duration: "2s", Error: "exit status 1", Output: "avrdude: not in sync"},
+		},
+		Error: "Board test failed at the upload step: exit status 1",
+	}
+	out := res.String()
+	require.Contains(t, out, "avrdude: not in sync")
+	require.NotContains(t, out, "Board test passed")
+	require.Equal(t, res.Error, res.ErrorString())
+
+	res.Steps = res.Steps[:1]
+	res.Passed = true
+	require.Contains(t, res.String(), "Board test passed: arduino:avr:uno on /dev/ttyACM0 is working")
+}
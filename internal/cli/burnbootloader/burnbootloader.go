@@ -27,6 +27,7 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 var (
@@ -62,6 +63,9 @@ func NewCommand() *cobra.Command {
 }
 
 func runBootloaderCommand(command *cobra.Command, args []string) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
 	instance := instance.CreateAndInit()
 
 	logrus.Info("Executing `arduino-cli burn-bootloader`")
@@ -73,7 +77,7 @@ func runBootloaderCommand(command *cobra.Command, args []string) {
 	}
 
 	stdOut, stdErr, res := feedback.OutputStreams()
-	if _, err := upload.BurnBootloader(context.Background(), &rpc.BurnBootloaderRequest{
+	if _, err := upload.BurnBootloader(ctx, &rpc.BurnBootloaderRequest{
 		Instance:   instance,
 		Fqbn:       fqbn.String(),
 		Port:       discoveryPort,
@@ -23,20 +23,28 @@ import (
 
 	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/agent"
 	"github.com/arduino/arduino-cli/internal/cli/board"
 	"github.com/arduino/arduino-cli/internal/cli/burnbootloader"
 	"github.com/arduino/arduino-cli/internal/cli/cache"
+	"github.com/arduino/arduino-cli/internal/cli/cloud"
 	"github.com/arduino/arduino-cli/internal/cli/compile"
 	"github.com/arduino/arduino-cli/internal/cli/completion"
 	"github.com/arduino/arduino-cli/internal/cli/config"
 	"github.com/arduino/arduino-cli/internal/cli/core"
 	"github.com/arduino/arduino-cli/internal/cli/daemon"
 	"github.com/arduino/arduino-cli/internal/cli/debug"
+	"github.com/arduino/arduino-cli/internal/cli/env"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/fsimage"
 	"github.com/arduino/arduino-cli/internal/cli/generatedocs"
+	"github.com/arduino/arduino-cli/internal/cli/install"
 	"github.com/arduino/arduino-cli/internal/cli/lib"
+	"github.com/arduino/arduino-cli/internal/cli/migrate"
 	"github.com/arduino/arduino-cli/internal/cli/monitor"
 	"github.com/arduino/arduino-cli/internal/cli/outdated"
+	"github.com/arduino/arduino-cli/internal/cli/partition"
+	"github.com/arduino/arduino-cli/internal/cli/report"
 	"github.com/arduino/arduino-cli/internal/cli/sketch"
 	"github.com/arduino/arduino-cli/internal/cli/update"
 	"github.com/arduino/arduino-cli/internal/cli/updater"
@@ -83,22 +91,30 @@ func NewCommand() *cobra.Command {
 
 // this is here only for testing
 func createCliCommandTree(cmd *cobra.Command) {
+	cmd.AddCommand(agent.NewCommand())
 	cmd.AddCommand(board.NewCommand())
 	cmd.AddCommand(cache.NewCommand())
+	cmd.AddCommand(cloud.NewCommand())
 	cmd.AddCommand(compile.NewCommand())
 	cmd.AddCommand(completion.NewCommand())
 	cmd.AddCommand(config.NewCommand())
 	cmd.AddCommand(core.NewCommand())
 	cmd.AddCommand(daemon.NewCommand())
+	cmd.AddCommand(fsimage.NewCommand())
+	cmd.AddCommand(install.NewCommand())
 	cmd.AddCommand(generatedocs.NewCommand())
 	cmd.AddCommand(lib.NewCommand())
+	cmd.AddCommand(migrate.NewCommand())
 	cmd.AddCommand(monitor.NewCommand())
 	cmd.AddCommand(outdated.NewCommand())
+	cmd.AddCommand(partition.NewCommand())
+	cmd.AddCommand(report.NewCommand())
 	cmd.AddCommand(sketch.NewCommand())
 	cmd.AddCommand(update.NewCommand())
 	cmd.AddCommand(upgrade.NewCommand())
 	cmd.AddCommand(upload.NewCommand())
 	cmd.AddCommand(debug.NewCommand())
+	cmd.AddCommand(env.NewCommand())
 	cmd.AddCommand(burnbootloader.NewCommand())
 	cmd.AddCommand(version.NewCommand())
 	cmd.AddCommand(feedback.NewCommand())
@@ -125,6 +141,18 @@ func createCliCommandTree(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVar(&configFile, "config-file", "", tr("The custom config file (if not specified the default will be used)."))
 	cmd.PersistentFlags().StringSlice("additional-urls", []string{}, tr("Comma-separated list of additional URLs for the Boards Manager."))
 	cmd.PersistentFlags().Bool("no-color", false, "Disable colored output.")
+	cmd.PersistentFlags().String("locale", "", tr("The language of the messages, e.g. %s. If not specified the language of the system is used.", "it_IT"))
+	cmd.RegisterFlagCompletionFunc("locale", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return i18n.SupportedLocales(), cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.PersistentFlags().Bool("non-interactive", false, tr("Disable all the prompts, the questions are answered as set with --non-interactive-answer."))
+	validAnswers := []string{"yes", "no"}
+	cmd.PersistentFlags().String("non-interactive-answer", "no", tr("The answer given to the questions in non interactive mode, can be: %s", strings.Join(validAnswers, ", ")))
+	cmd.RegisterFlagCompletionFunc("non-interactive-answer", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return validAnswers, cobra.ShellCompDirectiveDefault
+	})
+	cmd.PersistentFlags().StringVar(&profileCPUFile, "profile-cpu", "", tr("Write a CPU profile (in pprof format) of the command execution to the specified file."))
+	cmd.PersistentFlags().StringVar(&profileMemFile, "profile-mem", "", tr("Write a memory profile (in pprof format) at the end of the command execution to the specified file."))
 	configuration.BindFlags(cmd, configuration.Settings)
 }
 
@@ -156,9 +184,29 @@ func preRun(cmd *cobra.Command, args []string) {
 	// https://no-color.org/
 	color.NoColor = configuration.Settings.GetBool("output.no_color") || os.Getenv("NO_COLOR") != ""
 
-	// Set default feedback output to colorable
-	feedback.SetOut(colorable.NewColorableStdout())
-	feedback.SetErr(colorable.NewColorableStderr())
+	if color.NoColor {
+		// The tools run by the CLI (compilers, uploaders...) inherit the
+		// environment, and their output is stripped of the colors they
+		// may add anyway
+		os.Setenv("NO_COLOR", "1")
+		feedback.SetOut(feedback.NewANSIStripWriter(os.Stdout))
+		feedback.SetErr(feedback.NewANSIStripWriter(os.Stderr))
+	} else {
+		// Set default feedback output to colorable
+		feedback.SetOut(colorable.NewColorableStdout())
+		feedback.SetErr(colorable.NewColorableStderr())
+	}
+
+	if configuration.Settings.GetBool("cli.non_interactive") {
+		answer := strings.ToLower(configuration.Settings.GetString("cli.non_interactive_answer"))
+		if answer != "yes" && answer != "no" {
+			feedback.Fatal(tr("Invalid option for --non-interactive-answer: %s", answer), feedback.ErrBadArgument)
+		}
+		// The post-install scripts are skipped and the update check is
+		// disabled as when the CLI is not run from a console
+		configuration.IsInteractive = false
+		feedback.SetNonInteractive(answer == "yes")
+	}
 
 	updaterMessageChan = make(chan *semver.Version)
 	go func() {
@@ -219,6 +267,9 @@ func preRun(cmd *cobra.Command, args []string) {
 		logrus.SetLevel(lvl)
 	}
 
+	// start profiling, if requested
+	startProfiling()
+
 	//
 	// Prepare the Feedback system
 	//
@@ -258,4 +309,5 @@ func postRun(cmd *cobra.Command, args []string) {
 		// Notify the user a new version is available
 		updater.NotifyNewVersionIsAvailable(latestVersion.String())
 	}
+	feedback.RunExitHooks()
 }
@@ -0,0 +1,63 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cloud
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/cloud"
+	"github.com/arduino/arduino-cli/arduino/httpclient"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `cloud` command
+func NewCommand() *cobra.Command {
+	cloudCommand := &cobra.Command{
+		Use:   "cloud",
+		Short: tr("Arduino Cloud commands."),
+		Long: tr("Commands to provision devices and bind sketches to the things of the Arduino Cloud. " +
+			"They require an API key of the Arduino Cloud set in the cloud.client_id and cloud.client_secret settings."),
+		Example: "  " + os.Args[0] + " cloud device list",
+	}
+
+	cloudCommand.AddCommand(initDeviceCommand())
+	cloudCommand.AddCommand(initThingCommand())
+
+	return cloudCommand
+}
+
+// newClient returns a client of the Arduino Cloud API authenticated with the
+// API key of the settings
+func newClient() *cloud.Client {
+	clientID := configuration.Settings.GetString("cloud.client_id")
+	clientSecret := configuration.Settings.GetString("cloud.client_secret")
+	if clientID == "" || clientSecret == "" {
+		feedback.Fatal(tr("The Arduino Cloud API key is not set, create one in the Arduino Cloud and set the %[1]s and %[2]s settings.", "cloud.client_id", "cloud.client_secret"), feedback.ErrBadArgument)
+	}
+	httpClient, err := httpclient.New()
+	if err != nil {
+		feedback.Fatal(tr("Could not connect via HTTP: %v", err), feedback.ErrNetwork)
+	}
+	return cloud.NewClient(httpClient,
+		configuration.Settings.GetString("cloud.api_url"),
+		clientID, clientSecret,
+		configuration.Settings.GetString("cloud.organization_id"))
+}
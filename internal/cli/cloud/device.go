@@ -0,0 +1,221 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cloud
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/cloud"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initDeviceCommand() *cobra.Command {
+	deviceCommand := &cobra.Command{
+		Use:     "device",
+		Short:   tr("Arduino Cloud devices commands."),
+		Long:    tr("Commands to provision and list the devices of the Arduino Cloud."),
+		Example: "  " + os.Args[0] + " cloud device list",
+	}
+	deviceCommand.AddCommand(initDeviceCreateCommand())
+	deviceCommand.AddCommand(initDeviceListCommand())
+	return deviceCommand
+}
+
+func initDeviceCreateCommand() *cobra.Command {
+	var fqbnArg arguments.Fqbn
+	var serial, deviceType, csr, certificate string
+	createCommand := &cobra.Command{
+		Use:   fmt.Sprintf("create %s", tr("NAME")),
+		Short: tr("Provisions a new device in the Arduino Cloud."),
+		Long: tr("Registers a new device in the Arduino Cloud. " +
+			"The boards with a crypto element are authenticated with a certificate: pass the certificate signing request generated on the board by the provisioning sketch with --csr, the signed certificate must then be stored in the crypto element. " +
+			"The other boards are authenticated with a secret key, generated by this command and kept on this computer to be added to the sketches bound to the device at compile time."),
+		Example: "  " + os.Args[0] + " cloud device create MyESP32 -b esp32:esp32:esp32\n" +
+			"  " + os.Args[0] + " cloud device create MyMKR -b arduino:samd:mkrwifi1010 --serial 1A2B3C4D --csr board.csr --certificate board.pem",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runDeviceCreateCommand(args[0], fqbnArg.String(), serial, deviceType, csr, certificate)
+		},
+	}
+	fqbnArg.AddToCommand(createCommand)
+	createCommand.Flags().StringVar(&serial, "serial", "", tr("The serial number of the board."))
+	createCommand.Flags().StringVar(&deviceType, "type", "", tr("The type of device, by default it's the one of the board."))
+	createCommand.Flags().StringVar(&csr, "csr", "", tr("The certificate signing request (PEM) generated by the crypto element of the board."))
+	createCommand.Flags().StringVar(&certificate, "certificate", "", tr("Save the signed certificate (PEM) in this file."))
+	return createCommand
+}
+
+func runDeviceCreateCommand(name, fqbn, serial, deviceType, csrFile, certificateFile string) {
+	logrus.Info("Executing `arduino-cli cloud device create`")
+
+	if fqbn == "" {
+		feedback.Fatal(tr("Missing FQBN (Fully Qualified Board Name)"), feedback.ErrBadArgument)
+	}
+	boardType, useCertificate := cloud.DeviceType(fqbn)
+	if deviceType == "" {
+		deviceType = boardType
+	}
+	var csr []byte
+	if csrFile != "" {
+		data, err := paths.New(csrFile).ReadFile()
+		if err != nil {
+			feedback.Fatal(tr("Error reading the certificate signing request: %v", err), feedback.ErrBadArgument)
+		}
+		csr = data
+		useCertificate = true
+	} else if useCertificate {
+		feedback.Fatal(tr("The board %s has a crypto element: generate a certificate signing request with the provisioning sketch and pass it with --csr.", fqbn), feedback.ErrBadArgument)
+	}
+
+	var keys *cloud.DeviceKeys
+	if !useCertificate {
+		var err error
+		if keys, err = cloud.LoadDeviceKeys(configuration.DataDir(configuration.Settings)); err != nil {
+			feedback.Fatal(err.Error(), feedback.ErrGeneric)
+		}
+	}
+
+	client := newClient()
+	device, err := client.CreateDevice(name, serial, deviceType, fqbn)
+	if err != nil {
+		feedback.Fatal(tr("Error creating the device: %v", err), feedback.ErrGeneric)
+	}
+	// Do not leave half provisioned devices in the cloud
+	fail := func(msg string) {
+		if err := client.DeleteDevice(device.ID); err != nil {
+			logrus.WithError(err).Warn("Error removing the device")
+		}
+		feedback.Fatal(msg, feedback.ErrGeneric)
+	}
+
+	res := &deviceCreateResult{Device: device}
+	if useCertificate {
+		cert, err := client.CreateCertificate(device.ID, string(csr))
+		if err != nil {
+			fail(tr("Error signing the certificate of the device: %v", err))
+		}
+		res.Certificate = cert
+		if certificateFile != "" {
+			if err := paths.New(certificateFile).WriteFile([]byte(cert.PEM)); err != nil {
+				fail(tr("Error saving the certificate: %v", err))
+			}
+			res.CertificateFile = certificateFile
+		}
+	} else {
+		key, err := cloud.GenerateDeviceKey()
+		if err != nil {
+			fail(tr("Error generating the device key: %v", err))
+		}
+		if err := client.SetDeviceKey(device.ID, key); err != nil {
+			fail(tr("Error setting the device key: %v", err))
+		}
+		keys.Set(device.ID, key)
+		if err := keys.Save(); err != nil {
+			fail(tr("Error saving the device key: %v", err))
+		}
+		res.DeviceKey = key
+	}
+	feedback.PrintResult(res)
+}
+
+type deviceCreateResult struct {
+	Device          *cloud.Device      `json:"device"`
+	DeviceKey       string             `json:"device_key,omitempty"`
+	Certificate     *cloud.Certificate `json:"certificate,omitempty"`
+	CertificateFile string             `json:"certificate_file,omitempty"`
+}
+
+func (r *deviceCreateResult) Data() interface{} {
+	return r
+}
+
+func (r *deviceCreateResult) String() string {
+	res := tr("Device %[1]s created with ID %[2]s.", r.Device.Name, r.Device.ID)
+	if r.DeviceKey != "" {
+		res += "\n" + tr("Device key: %s", r.DeviceKey)
+		res += "\n" + tr("The key is added to the sketches bound to the device when they are compiled.")
+	}
+	if r.Certificate != nil {
+		if r.CertificateFile != "" {
+			res += "\n" + tr("Certificate saved in %s", r.CertificateFile)
+		} else {
+			res += "\n\n" + r.Certificate.PEM
+		}
+		compressed := r.Certificate.Compressed
+		t := table.New()
+		t.AddRow(tr("Serial"), compressed.Serial)
+		t.AddRow(tr("Authority key identifier"), compressed.AuthorityKeyIdentifier)
+		t.AddRow(tr("Not before"), compressed.NotBefore)
+		t.AddRow(tr("Not after"), compressed.NotAfter)
+		t.AddRow(tr("Signature"), compressed.SignatureAsn1X+compressed.SignatureAsn1Y)
+		res += "\n" + tr("Store the certificate in the crypto element of the board with the provisioning sketch:") + "\n" + t.Render()
+	}
+	return res
+}
+
+func initDeviceListCommand() *cobra.Command {
+	listCommand := &cobra.Command{
+		Use:     "list",
+		Short:   tr("Lists the devices of the Arduino Cloud."),
+		Long:    tr("Lists the devices of the Arduino Cloud."),
+		Example: "  " + os.Args[0] + " cloud device list",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			logrus.Info("Executing `arduino-cli cloud device list`")
+			devices, err := newClient().ListDevices()
+			if err != nil {
+				feedback.Fatal(tr("Error listing the devices: %v", err), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(&deviceListResult{Devices: devices})
+		},
+	}
+	return listCommand
+}
+
+type deviceListResult struct {
+	Devices []*cloud.Device `json:"devices"`
+}
+
+func (r *deviceListResult) Data() interface{} {
+	return r
+}
+
+func (r *deviceListResult) String() string {
+	if len(r.Devices) == 0 {
+		return tr("No devices found.")
+	}
+	titleColor := color.New(color.FgHiGreen)
+	nameColor := color.New(color.FgHiYellow)
+	t := table.New()
+	t.SetHeader(
+		table.NewCell(tr("Name"), titleColor),
+		table.NewCell(tr("ID"), titleColor),
+		table.NewCell(tr("Type"), titleColor),
+		table.NewCell(tr("FQBN"), titleColor),
+		table.NewCell(tr("Thing"), titleColor))
+	for _, device := range r.Devices {
+		t.AddRow(table.NewCell(device.Name, nameColor), device.ID, device.Type, device.FQBN, device.ThingID)
+	}
+	return t.Render()
+}
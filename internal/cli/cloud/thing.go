@@ -0,0 +1,153 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cloud
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/cloud"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initThingCommand() *cobra.Command {
+	thingCommand := &cobra.Command{
+		Use:     "thing",
+		Short:   tr("Arduino Cloud things commands."),
+		Long:    tr("Commands to list the things of the Arduino Cloud and bind them to devices and sketches."),
+		Example: "  " + os.Args[0] + " cloud thing list",
+	}
+	thingCommand.AddCommand(initThingListCommand())
+	thingCommand.AddCommand(initThingBindCommand())
+	return thingCommand
+}
+
+func initThingListCommand() *cobra.Command {
+	listCommand := &cobra.Command{
+		Use:     "list",
+		Short:   tr("Lists the things of the Arduino Cloud."),
+		Long:    tr("Lists the things of the Arduino Cloud."),
+		Example: "  " + os.Args[0] + " cloud thing list",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			logrus.Info("Executing `arduino-cli cloud thing list`")
+			things, err := newClient().ListThings()
+			if err != nil {
+				feedback.Fatal(tr("Error listing the things: %v", err), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(&thingListResult{Things: things})
+		},
+	}
+	return listCommand
+}
+
+type thingListResult struct {
+	Things []*cloud.Thing `json:"things"`
+}
+
+func (r *thingListResult) Data() interface{} {
+	return r
+}
+
+func (r *thingListResult) String() string {
+	if len(r.Things) == 0 {
+		return tr("No things found.")
+	}
+	titleColor := color.New(color.FgHiGreen)
+	nameColor := color.New(color.FgHiYellow)
+	t := table.New()
+	t.SetHeader(
+		table.NewCell(tr("Name"), titleColor),
+		table.NewCell(tr("ID"), titleColor),
+		table.NewCell(tr("Device"), titleColor))
+	for _, thing := range r.Things {
+		t.AddRow(table.NewCell(thing.Name, nameColor), thing.ID, thing.DeviceID)
+	}
+	return t.Render()
+}
+
+func initThingBindCommand() *cobra.Command {
+	var deviceID string
+	bindCommand := &cobra.Command{
+		Use:   fmt.Sprintf("bind %s [%s]", tr("THING_ID"), tr("SKETCH_PATH")),
+		Short: tr("Binds a thing of the Arduino Cloud to a sketch and a device."),
+		Long: tr("Binds a thing of the Arduino Cloud to a sketch, saving the thing and its device in the sketch project file. " +
+			"When the sketch is compiled the ARDUINO_CLOUD_THING_ID, ARDUINO_CLOUD_DEVICE_ID and, if the device has been provisioned from this computer, ARDUINO_CLOUD_DEVICE_KEY defines are added to the build. " +
+			"With --device-id the device is also bound to the thing in the cloud."),
+		Example: "  " + os.Args[0] + " cloud thing bind 5fa3c1c4-7c35-4b2e-8d0c-2a4f1f6f0b61 ~/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " cloud thing bind 5fa3c1c4-7c35-4b2e-8d0c-2a4f1f6f0b61 --device-id 8d1f2f0e-4a2b-4c55-9a0e-7e6b0d3c2f11",
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			path := ""
+			if len(args) > 1 {
+				path = args[1]
+			}
+			runThingBindCommand(args[0], path, deviceID)
+		},
+	}
+	bindCommand.Flags().StringVar(&deviceID, "device-id", "", tr("The ID of the device to bind to the thing, by default the one already bound to it."))
+	return bindCommand
+}
+
+func runThingBindCommand(thingID, path, deviceID string) {
+	logrus.Info("Executing `arduino-cli cloud thing bind`")
+
+	sketchPath := arguments.InitSketchPath(path, true)
+	sk, err := sketch.New(sketchPath)
+	if err != nil {
+		feedback.Fatal(tr("Error opening sketch: %v", err), feedback.ErrBadArgument)
+	}
+
+	client := newClient()
+	var thing *cloud.Thing
+	if deviceID != "" {
+		thing, err = client.BindThing(thingID, deviceID)
+	} else {
+		thing, err = client.GetThing(thingID)
+	}
+	if err != nil {
+		feedback.Fatal(tr("Error binding the thing: %v", err), feedback.ErrGeneric)
+	}
+
+	sk.Project.Cloud = &sketch.ProjectCloudSettings{ThingID: thing.ID, DeviceID: thing.DeviceID}
+	if err := sk.SaveProject(); err != nil {
+		feedback.Fatal(tr("Error saving the sketch project file: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&thingBindResult{Thing: thing, Sketch: sk.FullPath.String()})
+}
+
+type thingBindResult struct {
+	Thing  *cloud.Thing `json:"thing"`
+	Sketch string       `json:"sketch"`
+}
+
+func (r *thingBindResult) Data() interface{} {
+	return r
+}
+
+func (r *thingBindResult) String() string {
+	res := tr("Sketch %[1]s bound to the thing %[2]s.", r.Sketch, r.Thing.Name)
+	if r.Thing.DeviceID == "" {
+		res += "\n" + tr("No device is bound to the thing, use --device-id to bind one.")
+	}
+	return res
+}
@@ -22,9 +22,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/commands/compile"
 	"github.com/arduino/arduino-cli/commands/core"
 	"github.com/arduino/arduino-cli/commands/sketch"
@@ -32,8 +36,10 @@ import (
 	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/daemonclient"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
 	"github.com/arduino/arduino-cli/internal/cli/instance"
+	"github.com/arduino/arduino-cli/internal/cli/remote"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/arduino-cli/table"
 	"github.com/arduino/arduino-cli/version"
@@ -41,6 +47,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 var (
@@ -55,8 +62,8 @@ var (
 	signKey                 string                   // The name of the custom signing key to use to sign a binary during the compile process. Used only by the platforms that supports it
 	encryptKey              string                   // The name of the custom encryption key to use to encrypt a binary during the compile process. Used only by the platforms that supports it
 	warnings                string                   // Used to tell gcc which warning level to use.
-	verbose                 bool                     // Turns on verbose mode.
-	quiet                   bool                     // Suppresses almost every output.
+	verbosity               arguments.Verbosity      // Level and phases of the verbose output.
+	quiet                   bool                     // Prints only the warnings, the errors and the size summary.
 	uploadAfterCompile      bool                     // Upload the binary after the compilation.
 	portArgs                arguments.Port           // Upload port, e.g.: COM10 or /dev/ttyACM0.
 	verify                  bool                     // Upload, verify uploaded binary after the upload.
@@ -67,6 +74,15 @@ var (
 	compilationDatabaseOnly bool                     // Only create compilation database without actually compiling
 	sourceOverrides         string                   // Path to a .json file that contains a set of replacements of the sketch source code.
 	dumpProfile             bool                     // Create and print a profile configuration from the build
+	reportTimings           bool                     // Report the time spent in each phase of the build
+	compareWith             string                   // The ELF or map file of a previous build to compare the sizes with
+	reportUnused            bool                     // Report the libraries, objects and sketch functions not linked in the executable
+	memoryLayout            bool                     // Report the placement of the sections and symbols in memory
+	buildConfiguration      string                   // The build configuration of the sketch project file to use
+	exportBuildSystem       string                   // Generate a standalone build system (cmake or make) reproducing the build
+	publishTo               []string                 // Publishers or URLs where the build artifacts are uploaded
+	advisoriesFlag          arguments.AdvisoriesFlag // Fail if the build uses libraries or platforms affected by advisories
+	failOnIncompatibleLibs  bool                     // Fail if the build uses libraries not declaring the board architecture
 	// library and libraries sound similar but they're actually different.
 	// library expects a path to the root folder of one single library.
 	// libraries expects a path to a directory containing multiple libraries, similarly to the <directories.user>/libraries path.
@@ -93,6 +109,7 @@ func NewCommand() *cobra.Command {
 
 	fqbnArg.AddToCommand(compileCommand)
 	profileArg.AddToCommand(compileCommand)
+	advisoriesFlag.AddToCommand(compileCommand)
 	compileCommand.Flags().BoolVar(&dumpProfile, "dump-profile", false, tr("Create and print a profile configuration from the build."))
 	showPropertiesArg.AddToCommand(compileCommand)
 	compileCommand.Flags().BoolVar(&preprocess, "preprocess", false, tr("Print preprocessed code to stdout instead of compiling."))
@@ -112,8 +129,8 @@ func NewCommand() *cobra.Command {
 		tr("The name of the custom encryption key to use to encrypt a binary during the compile process. Used only by the platforms that support it."))
 	compileCommand.Flags().StringVar(&warnings, "warnings", "none",
 		tr(`Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).`, "none, default, more, all"))
-	compileCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
-	compileCommand.Flags().BoolVar(&quiet, "quiet", false, tr("Optional, suppresses almost every output."))
+	verbosity.AddToCommand(compileCommand)
+	compileCommand.Flags().BoolVar(&quiet, "quiet", false, tr("Optional, prints only the warnings, the errors and the size summary."))
 	compileCommand.Flags().BoolVarP(&uploadAfterCompile, "upload", "u", false, tr("Upload the binary after the compilation."))
 	portArgs.AddToCommand(compileCommand)
 	compileCommand.Flags().BoolVarP(&verify, "verify", "t", false, tr("Verify uploaded binary after the upload."))
@@ -125,6 +142,27 @@ func NewCommand() *cobra.Command {
 	programmer.AddToCommand(compileCommand)
 	compileCommand.Flags().BoolVar(&compilationDatabaseOnly, "only-compilation-database", false, tr("Just produce the compilation database, without actually compiling. All build commands are skipped except pre* hooks."))
 	compileCommand.Flags().BoolVar(&clean, "clean", false, tr("Optional, cleanup the build folder and do not use any cached build."))
+	compileCommand.Flags().BoolVar(&reportTimings, "timings", false, tr("Report the time spent in each phase of the build and in the compilation of each file."))
+	compileCommand.Flags().StringVar(&compareWith, "compare-with", "", tr("Compare the sections and symbols sizes with the ELF or map file of a previous build."))
+	compileCommand.Flags().BoolVar(&reportUnused, "report-unused", false, tr("Report the libraries and the object files that contributed nothing to the executable and the sketch functions removed by the linker."))
+	compileCommand.Flags().BoolVar(&failOnIncompatibleLibs, "fail-on-incompatible-libs", false, tr("Fail if the sketch uses libraries not declaring the architecture of the board, unless they are listed in accept_incompatible in the sketch project file."))
+	compileCommand.Flags().StringVar(&buildConfiguration, "config", "", tr("The build configuration of the sketch project file to use (e.g. debug or release)."))
+	compileCommand.Flags().BoolVar(&memoryLayout, "memory-layout", false, tr("Report the placement of the sections and of the symbols in the memory of the board, use --format json to get the address and size of each symbol."))
+	compileCommand.Flags().StringVar(&exportBuildSystem, "export-build-system", "",
+		tr("Generate a standalone build system reproducing the build, to integrate it in other projects or IDEs. Can be: %s", strings.Join(builder.BuildSystems(), ", ")))
+	compileCommand.RegisterFlagCompletionFunc("export-build-system", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return builder.BuildSystems(), cobra.ShellCompDirectiveDefault
+	})
+	compileCommand.Flags().StringArrayVar(&publishTo, "publish-to", []string{},
+		tr("Upload the build artifacts and the build manifest to the publisher with this name or to this URL (http, https or s3://bucket/key), can be used multiple times."))
+	compileCommand.RegisterFlagCompletionFunc("publish-to", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		publishers := []string{}
+		for name := range configuration.Settings.GetStringMap("publishers") {
+			publishers = append(publishers, name)
+		}
+		sort.Strings(publishers)
+		return publishers, cobra.ShellCompDirectiveNoFileComp
+	})
 	// We must use the following syntax for this flag since it's also bound to settings.
 	// This must be done because the value is set when the binding is accessed from viper. Accessing from cobra would only
 	// read the value if the flag is set explicitly by the user.
@@ -134,6 +172,9 @@ func NewCommand() *cobra.Command {
 	compileCommand.Flags().BoolVar(&skipLibrariesDiscovery, "skip-libraries-discovery", false, "Skip libraries discovery. This flag is provided only for use in language server and other, very specific, use cases. Do not use for normal compiles")
 	compileCommand.Flag("skip-libraries-discovery").Hidden = true
 	configuration.Settings.BindPFlag("sketch.always_export_binaries", compileCommand.Flags().Lookup("export-binaries"))
+	compileCommand.Flags().Bool("remote", false, tr("Build the sketch on the remote daemon set in the remote.address setting and save the artifacts locally."))
+	configuration.Settings.BindPFlag("remote.build", compileCommand.Flags().Lookup("remote"))
+	compileCommand.MarkFlagsMutuallyExclusive("verbose", "quiet")
 
 	compileCommand.Flags().MarkDeprecated("build-properties", tr("please use --build-property instead."))
 
@@ -143,6 +184,9 @@ func NewCommand() *cobra.Command {
 func runCompileCommand(cmd *cobra.Command, args []string) {
 	logrus.Info("Executing `arduino-cli compile`")
 
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
 	if profileArg.Get() != "" {
 		if len(libraries) > 0 {
 			feedback.Fatal(tr("You cannot use the %s flag while compiling with a profile.", "--libraries"), feedback.ErrBadArgument)
@@ -166,18 +210,50 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 
 	var inst *rpc.Instance
 	var profile *rpc.Profile
+	var fqbn string
+	var port *rpc.Port
+	var remoteProfile string
+
+	remoteClient := connectToRemote(ctx, cmd)
+	var daemonClient *daemonclient.Client
+	if remoteClient == nil {
+		daemonClient = connectToDaemon(ctx)
+	}
+	if remoteClient != nil {
+		defer remoteClient.Close()
+		fqbn, remoteProfile = remoteBuildTarget(sk)
+	} else if daemonClient != nil {
+		defer daemonClient.Close()
+		profileName := profileArg.Get()
+		if profileName == "" {
+			profileName = sk.GetDefaultProfile().GetName()
+		}
+		if profileName != "" {
+			profile = instance.InitDaemonWithProfile(daemonClient, profileName, sketchPath)
+		}
+
+		if fqbnArg.String() == "" {
+			fqbnArg.Set(profile.GetFqbn())
+		}
 
-	if profileArg.Get() == "" {
-		inst, profile = instance.CreateAndInitWithProfile(sk.GetDefaultProfile().GetName(), sketchPath)
+		// The ports are detected by the daemon
+		portArgs.SetPortLister(func(timeout time.Duration) ([]*rpc.DetectedPort, error) {
+			return daemonClient.BoardList(ctx, timeout)
+		})
+		fqbn, port = arguments.CalculateFQBNAndPort(&portArgs, &fqbnArg, nil, sk.GetDefaultFqbn(), sk.GetDefaultPort(), sk.GetDefaultProtocol())
 	} else {
-		inst, profile = instance.CreateAndInitWithProfile(profileArg.Get(), sketchPath)
-	}
+		if profileArg.Get() == "" {
+			inst, profile = instance.CreateAndInitWithProfile(sk.GetDefaultProfile().GetName(), sketchPath)
+		} else {
+			inst, profile = instance.CreateAndInitWithProfile(profileArg.Get(), sketchPath)
+		}
 
-	if fqbnArg.String() == "" {
-		fqbnArg.Set(profile.GetFqbn())
-	}
+		if fqbnArg.String() == "" {
+			fqbnArg.Set(profile.GetFqbn())
+		}
 
-	fqbn, port := arguments.CalculateFQBNAndPort(&portArgs, &fqbnArg, inst, sk.GetDefaultFqbn(), sk.GetDefaultPort(), sk.GetDefaultProtocol())
+		fqbn, port = arguments.CalculateFQBNAndPort(&portArgs, &fqbnArg, inst, sk.GetDefaultFqbn(), sk.GetDefaultPort(), sk.GetDefaultProtocol())
+	}
 
 	if keysKeychain != "" || signKey != "" || encryptKey != "" {
 		arguments.CheckFlagsMandatory(cmd, "keys-keychain", "sign-key", "encrypt-key")
@@ -219,6 +295,13 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		libraryAbs = append(libraryAbs, libPath.String())
 	}
 
+	// The warnings level of the build configuration of the sketch project file
+	// is used if the flag is not set
+	warningsLevel := warnings
+	if !cmd.Flags().Changed("warnings") {
+		warningsLevel = ""
+	}
+
 	compileRequest := &rpc.CompileRequest{
 		Instance:                      inst,
 		Fqbn:                          fqbn,
@@ -228,8 +311,10 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		BuildCachePath:                buildCachePath,
 		BuildPath:                     buildPath,
 		BuildProperties:               buildProperties,
-		Warnings:                      warnings,
-		Verbose:                       verbose,
+		Warnings:                      warningsLevel,
+		Verbose:                       verbosity.BuildVerbose(),
+		VerbosityLevel:                int32(verbosity.Level()),
+		VerbosePhases:                 verbosity.Phases(),
 		Quiet:                         quiet,
 		ExportDir:                     exportDir,
 		Libraries:                     libraries,
@@ -243,16 +328,43 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		EncryptKey:                    encryptKey,
 		SkipLibrariesDiscovery:        skipLibrariesDiscovery,
 		DoNotExpandBuildProperties:    showProperties == arguments.ShowPropertiesUnexpanded,
+		ReportTimings:                 reportTimings,
+		CompareWith:                   compareWith,
+		ReportUnusedCode:              reportUnused,
+		ReportMemoryLayout:            memoryLayout,
+		Configuration:                 buildConfiguration,
+		ExportBuildSystem:             exportBuildSystem,
+		PublishTo:                     publishTo,
+		FailOnIncompatibleLibraries:   failOnIncompatibleLibs,
+		Profile:                       remoteProfile,
+	}
+	var compileRes *rpc.CompileResponse
+	var compileError error
+	if remoteClient != nil {
+		compileRes, compileError = remoteClient.Compile(ctx, compileRequest, remoteExportDir(sketchPath, fqbn), stdOut, stdErr)
+	} else if daemonClient != nil {
+		compileRes, compileError = daemonClient.Compile(ctx, compileRequest, stdOut, stdErr)
+	} else {
+		compileRes, compileError = compile.Compile(ctx, compileRequest, stdOut, stdErr, nil)
+	}
+	if compileError == nil {
+		compileError = advisoriesFlag.Warn(compileRes.GetAdvisories())
 	}
-	compileRes, compileError := compile.Compile(context.Background(), compileRequest, stdOut, stdErr, nil)
 
 	var uploadRes *rpc.UploadResult
 	if compileError == nil && uploadAfterCompile {
-		userFieldRes, err := upload.SupportedUserFields(context.Background(), &rpc.SupportedUserFieldsRequest{
+		userFieldsReq := &rpc.SupportedUserFieldsRequest{
 			Instance: inst,
 			Fqbn:     fqbn,
 			Protocol: port.Protocol,
-		})
+		}
+		var userFieldRes *rpc.SupportedUserFieldsResponse
+		var err error
+		if daemonClient != nil {
+			userFieldRes, err = daemonClient.SupportedUserFields(ctx, userFieldsReq)
+		} else {
+			userFieldRes, err = upload.SupportedUserFields(context.Background(), userFieldsReq)
+		}
 		if err != nil {
 			feedback.Fatal(tr("Error during Upload: %v", err), feedback.ErrGeneric)
 		}
@@ -272,18 +384,29 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 			Fqbn:       fqbn,
 			SketchPath: sketchPath.String(),
 			Port:       port,
-			Verbose:    verbose,
+			Verbose:    verbosity.UploadVerbose(),
 			Verify:     verify,
-			ImportDir:  buildPath,
+			ImportDir:  compileRes.GetBuildPath(),
 			Programmer: programmer.String(),
 			UserFields: fields,
 		}
 
-		if res, err := upload.Upload(context.Background(), uploadRequest, stdOut, stdErr); err != nil {
-			feedback.Fatal(tr("Error during Upload: %v", err), feedback.ErrGeneric)
+		var progressCB rpc.TaskProgressCB
+		uploadOut, uploadErr, progressDone := stdOut, stdErr, func() {}
+		if !verbosity.UploadVerbose() {
+			uploadOut, uploadErr, progressCB, progressDone = feedback.UploadProgress(stdOut, stdErr)
+		}
+		var res *rpc.UploadResult
+		if daemonClient != nil {
+			res, err = daemonClient.Upload(ctx, uploadRequest, uploadOut, uploadErr, progressCB)
 		} else {
-			uploadRes = res
+			res, err = upload.Upload(ctx, uploadRequest, uploadOut, uploadErr, progressCB)
 		}
+		progressDone()
+		if err != nil {
+			feedback.Fatal(tr("Error during Upload: %v", err), feedback.ErrGeneric)
+		}
+		uploadRes = res
 	}
 
 	profileOut := ""
@@ -348,6 +471,7 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		Success:            compileError == nil,
 		showPropertiesMode: showProperties,
 		hideStats:          preprocess,
+		quiet:              quiet,
 	}
 
 	if compileError != nil {
@@ -362,7 +486,7 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 				panic(tr("Platform ID is not correct"))
 			}
 
-			if profileArg.String() == "" {
+			if profileArg.String() == "" && inst != nil {
 				res.Error += fmt.Sprintln()
 
 				if platform, err := core.PlatformSearch(&rpc.PlatformSearchRequest{
@@ -384,6 +508,79 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 	feedback.PrintResult(res)
 }
 
+// connectToDaemon returns a connection to the background daemon if the
+// cli.use_daemon setting is enabled, otherwise it returns nil and the
+// compilation (and the upload) runs in-process.
+func connectToDaemon(ctx context.Context) *daemonclient.Client {
+	if !configuration.Settings.GetBool("cli.use_daemon") {
+		return nil
+	}
+	client, err := daemonclient.Connect(ctx)
+	if err != nil {
+		feedback.Warning(tr("Could not connect to the background daemon, compiling without it: %v", err))
+		return nil
+	}
+	return client
+}
+
+// connectToRemote returns a connection to the remote daemon if the build
+// must be offloaded to it (with the --remote flag or the remote.build
+// setting), otherwise it returns nil.
+func connectToRemote(ctx context.Context, cmd *cobra.Command) *remote.Client {
+	if !configuration.Settings.GetBool("remote.build") {
+		return nil
+	}
+	// The remote daemon can't access the local files and boards
+	for _, flag := range []string{"upload", "library", "libraries", "build-path", "build-cache-path", "keys-keychain",
+		"compare-with", "source-override", "export-build-system", "publish-to"} {
+		if cmd.Flags().Changed(flag) {
+			feedback.Fatal(tr("You cannot use the %s flag while building remotely.", "--"+flag), feedback.ErrBadArgument)
+		}
+	}
+	client, err := remote.Connect(ctx)
+	if err != nil {
+		feedback.Fatal(tr("Error connecting to the remote daemon: %v", err), feedback.ErrNetwork)
+	}
+	return client
+}
+
+// remoteBuildTarget returns the FQBN and the profile used to build the sketch
+// remotely, the FQBN may be empty if it's taken from the profile.
+func remoteBuildTarget(sk *rpc.LoadSketchResponse) (string, string) {
+	profileName := profileArg.Get()
+	if profileName == "" {
+		profileName = sk.GetDefaultProfile().GetName()
+	}
+	fqbn := fqbnArg.String()
+	if fqbn == "" && profileName != "" {
+		for _, p := range sk.GetProfiles() {
+			if p.GetName() == profileName {
+				fqbn = p.GetFqbn()
+			}
+		}
+	}
+	if fqbn == "" {
+		fqbn = sk.GetDefaultFqbn()
+	}
+	if fqbn == "" && profileName == "" {
+		feedback.Fatal(tr("Missing FQBN (Fully Qualified Board Name)"), feedback.ErrBadArgument)
+	}
+	return fqbn, profileName
+}
+
+// remoteExportDir returns the directory where the artifacts of the remote
+// build are saved: the --output-dir or the build folder of the sketch.
+func remoteExportDir(sketchPath *paths.Path, fqbn string) *paths.Path {
+	if exportDir != "" {
+		return paths.New(exportDir)
+	}
+	fqbnSuffix := strings.ReplaceAll(fqbn, ":", ".")
+	if parsed, err := cores.ParseFQBN(fqbn); err == nil {
+		fqbnSuffix = strings.ReplaceAll(parsed.StringWithoutConfig(), ":", ".")
+	}
+	return sketchPath.Join("build", fqbnSuffix)
+}
+
 type compileResult struct {
 	CompilerOut   string               `json:"compiler_out"`
 	CompilerErr   string               `json:"compiler_err"`
@@ -395,6 +592,7 @@ type compileResult struct {
 
 	showPropertiesMode arguments.ShowPropertiesMode
 	hideStats          bool
+	quiet              bool
 }
 
 func (r *compileResult) Data() interface{} {
@@ -419,7 +617,7 @@ func (r *compileResult) String() string {
 	if r.CompilerOut != "" || r.CompilerErr != "" {
 		res += fmt.Sprintln()
 	}
-	if len(build.GetUsedLibraries()) > 0 {
+	if len(build.GetUsedLibraries()) > 0 && !r.quiet {
 		libraries := table.New()
 		libraries.SetHeader(
 			table.NewCell(tr("Used library"), titleColor),
@@ -434,7 +632,7 @@ func (r *compileResult) String() string {
 		res += fmt.Sprintln(libraries.Render())
 	}
 
-	if boardPlatform := build.GetBoardPlatform(); boardPlatform != nil {
+	if boardPlatform := build.GetBoardPlatform(); boardPlatform != nil && !r.quiet {
 		platforms := table.New()
 		platforms.SetHeader(
 			table.NewCell(tr("Used platform"), titleColor),
@@ -454,12 +652,216 @@ func (r *compileResult) String() string {
 		}
 		res += fmt.Sprintln(platforms.Render())
 	}
+	if timings := build.GetTimings(); timings != nil {
+		res += fmt.Sprintln(renderTimings(timings))
+	}
+	if sizeDiff := build.GetSizeDiff(); sizeDiff != nil {
+		res += fmt.Sprintln(renderSizeDiff(sizeDiff))
+	}
+	if unusedCode := build.GetUnusedCode(); unusedCode != nil {
+		res += fmt.Sprintln(renderUnusedCode(unusedCode))
+	}
+	if memoryLayout := build.GetMemoryLayout(); memoryLayout != nil {
+		res += fmt.Sprintln(renderMemoryLayout(memoryLayout))
+	}
+	if buildSystemPath := build.GetBuildSystemPath(); buildSystemPath != "" {
+		res += fmt.Sprintln(tr("Build system exported to: %s", buildSystemPath))
+	}
+	if published := build.GetPublishedArtifacts(); len(published) > 0 {
+		artifacts := table.New()
+		artifacts.SetHeader(
+			table.NewCell(tr("Published artifact"), titleColor),
+			table.NewCell(tr("URL"), titleColor))
+		for _, artifact := range published {
+			artifacts.AddRow(
+				table.NewCell(artifact.GetFile(), nameColor),
+				artifact.GetUrl())
+		}
+		res += fmt.Sprintln(artifacts.Render())
+	}
 	if r.ProfileOut != "" {
 		res += fmt.Sprintln(r.ProfileOut)
 	}
 	return strings.TrimRight(res, fmt.Sprintln())
 }
 
+// renderTimings returns the build phases and the compiled files timings as tables,
+// the files are sorted from the slowest to the fastest.
+func renderTimings(timings *rpc.BuildTimings) string {
+	titleColor := color.New(color.FgHiGreen)
+	nameColor := color.New(color.FgHiYellow)
+	pathColor := color.New(color.FgHiBlack)
+	formatDuration := func(ms int64) string {
+		return (time.Duration(ms) * time.Millisecond).String()
+	}
+
+	phases := table.New()
+	phases.SetHeader(
+		table.NewCell(tr("Build phase"), titleColor),
+		table.NewCell(tr("Time"), titleColor))
+	for _, phase := range timings.GetPhases() {
+		phases.AddRow(
+			table.NewCell(phase.GetName(), nameColor),
+			formatDuration(phase.GetDurationMs()))
+	}
+	res := fmt.Sprintln(phases.Render())
+
+	files := append([]*rpc.BuildFileTiming{}, timings.GetFiles()...)
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].GetDurationMs() > files[j].GetDurationMs()
+	})
+	filesTable := table.New()
+	filesTable.SetHeader(
+		table.NewCell(tr("Compiled file"), titleColor),
+		table.NewCell(tr("Time"), titleColor))
+	for _, file := range files {
+		duration := formatDuration(file.GetDurationMs())
+		if file.GetUpToDate() {
+			duration += " " + tr("(up to date)")
+		}
+		filesTable.AddRow(
+			table.NewCell(file.GetPath(), pathColor),
+			duration)
+	}
+	res += filesTable.Render()
+
+	if ccache := timings.GetCcache(); ccache != nil {
+		ccacheTable := table.New()
+		ccacheTable.SetHeader(
+			table.NewCell(tr("ccache"), titleColor),
+			table.NewCell(tr("Compilations"), titleColor))
+		ccacheTable.AddRow(table.NewCell(tr("Hits"), nameColor), fmt.Sprint(ccache.GetHits()))
+		ccacheTable.AddRow(table.NewCell(tr("Misses"), nameColor), fmt.Sprint(ccache.GetMisses()))
+		ccacheTable.AddRow(table.NewCell(tr("Uncacheable"), nameColor), fmt.Sprint(ccache.GetUncacheable()))
+		res += fmt.Sprintln()
+		res += ccacheTable.Render()
+	}
+	return res
+}
+
+// renderSizeDiff returns the changes of the sections and symbols sizes as tables,
+// the symbols that grew are highlighted.
+func renderSizeDiff(sizeDiff *rpc.SizeDiff) string {
+	titleColor := color.New(color.FgHiGreen)
+	nameColor := color.New(color.FgHiYellow)
+	grewColor := color.New(color.FgHiRed)
+	shrankColor := color.New(color.FgHiGreen)
+	formatDelta := func(change *rpc.SizeChange) *table.Cell {
+		delta := change.GetNewSize() - change.GetOldSize()
+		switch {
+		case delta > 0:
+			return table.NewCell(fmt.Sprintf("+%d", delta), grewColor)
+		case delta < 0:
+			return table.NewCell(fmt.Sprintf("%d", delta), shrankColor)
+		default:
+			return table.NewCell("0", nil)
+		}
+	}
+
+	res := fmt.Sprintln(tr("Sizes compared with %s", sizeDiff.GetComparedWith()))
+	sections := table.New()
+	sections.SetHeader(
+		table.NewCell(tr("Section"), titleColor),
+		table.NewCell(tr("Before"), titleColor),
+		table.NewCell(tr("After"), titleColor),
+		table.NewCell(tr("Change"), titleColor))
+	var oldTotal, newTotal int64
+	for _, section := range sizeDiff.GetSections() {
+		sections.AddRow(
+			table.NewCell(section.GetName(), nameColor),
+			fmt.Sprint(section.GetOldSize()),
+			fmt.Sprint(section.GetNewSize()),
+			formatDelta(section))
+		oldTotal += section.GetOldSize()
+		newTotal += section.GetNewSize()
+	}
+	sections.AddRow(
+		tr("Total"),
+		fmt.Sprint(oldTotal),
+		fmt.Sprint(newTotal),
+		formatDelta(&rpc.SizeChange{OldSize: oldTotal, NewSize: newTotal}))
+	res += fmt.Sprintln(sections.Render())
+
+	if len(sizeDiff.GetSymbols()) == 0 {
+		res += tr("No symbol changed size.")
+		return res
+	}
+	symbols := table.New()
+	symbols.SetHeader(
+		table.NewCell(tr("Symbol"), titleColor),
+		table.NewCell(tr("Before"), titleColor),
+		table.NewCell(tr("After"), titleColor),
+		table.NewCell(tr("Change"), titleColor))
+	for _, symbol := range sizeDiff.GetSymbols() {
+		name := table.NewCell(symbol.GetName(), nameColor)
+		if symbol.GetNewSize() > symbol.GetOldSize() {
+			name = table.NewCell(symbol.GetName(), grewColor)
+		}
+		symbols.AddRow(
+			name,
+			fmt.Sprint(symbol.GetOldSize()),
+			fmt.Sprint(symbol.GetNewSize()),
+			formatDelta(symbol))
+	}
+	res += symbols.Render()
+	return res
+}
+
+// renderUnusedCode returns the libraries, the object files and the sketch
+// functions that didn't end up in the executable as tables.
+func renderUnusedCode(unusedCode *rpc.UnusedCodeReport) string {
+	if len(unusedCode.GetUnusedLibraries()) == 0 &&
+		len(unusedCode.GetUnusedObjects()) == 0 &&
+		len(unusedCode.GetEliminatedFunctions()) == 0 {
+		return tr("All the compiled code contributed to the executable.")
+	}
+
+	titleColor := color.New(color.FgHiGreen)
+	nameColor := color.New(color.FgHiYellow)
+	pathColor := color.New(color.FgHiBlack)
+	res := ""
+	addTable := func(title string, c *color.Color, rows []string) {
+		if len(rows) == 0 {
+			return
+		}
+		t := table.New()
+		t.SetHeader(table.NewCell(title, titleColor))
+		for _, row := range rows {
+			t.AddRow(table.NewCell(row, c))
+		}
+		res += fmt.Sprintln(t.Render())
+	}
+	addTable(tr("Unused library"), nameColor, unusedCode.GetUnusedLibraries())
+	addTable(tr("Unused object file"), pathColor, unusedCode.GetUnusedObjects())
+	addTable(tr("Sketch function removed by the linker"), nameColor, unusedCode.GetEliminatedFunctions())
+	return strings.TrimRight(res, fmt.Sprintln())
+}
+
+// renderMemoryLayout returns the sections of the executable as a table, with
+// the address, the size and the number of symbols of each section.
+func renderMemoryLayout(memoryLayout *rpc.MemoryLayout) string {
+	titleColor := color.New(color.FgHiGreen)
+	nameColor := color.New(color.FgHiYellow)
+	pathColor := color.New(color.FgHiBlack)
+
+	res := fmt.Sprintln(tr("Memory layout read from %s", pathColor.Sprint(memoryLayout.GetSource())))
+	sections := table.New()
+	sections.SetHeader(
+		table.NewCell(tr("Section"), titleColor),
+		table.NewCell(tr("Address"), titleColor),
+		table.NewCell(tr("Size"), titleColor),
+		table.NewCell(tr("Symbols"), titleColor))
+	for _, section := range memoryLayout.GetSections() {
+		sections.AddRow(
+			table.NewCell(section.GetName(), nameColor),
+			fmt.Sprintf("0x%08x", section.GetAddress()),
+			fmt.Sprint(section.GetSize()),
+			fmt.Sprint(len(section.GetSymbols())))
+	}
+	res += sections.Render()
+	return res
+}
+
 func (r *compileResult) ErrorString() string {
 	return r.Error
 }
@@ -40,7 +40,7 @@ func initInitCommand() *cobra.Command {
 	initCommand := &cobra.Command{
 		Use:   "init",
 		Short: tr("Writes current configuration to a configuration file."),
-		Long:  tr("Creates or updates the configuration file in the data directory or custom directory with the current configuration settings."),
+		Long:  tr("Creates or updates the configuration file in the data directory (the config directory in the XDG layout) or custom directory with the current configuration settings."),
 		Example: "" +
 			"  # " + tr("Writes current configuration to the configuration file in the data directory.") + "\n" +
 			"  " + os.Args[0] + " config init\n" +
@@ -74,7 +74,7 @@ func runInitCommand(cmd *cobra.Command, args []string) {
 
 		absPath = configFileAbsPath.Parent()
 	case destDir == "":
-		destDir = configuration.Settings.GetString("directories.Data")
+		destDir = configuration.ConfigDir(configuration.Settings).String()
 		fallthrough
 	default:
 		absPath, err = paths.New(destDir).Abs()
@@ -66,6 +66,14 @@ func runSetCommand(cmd *cobra.Command, args []string) {
 		if err != nil {
 			feedback.Fatal(tr("error parsing value: %v", err), feedback.ErrGeneric)
 		}
+	case reflect.Map:
+		feedback.Fatal(tr("The key %v can't be set from the command line, edit the configuration file instead", key), feedback.ErrBadArgument)
+	case reflect.Int:
+		var err error
+		value, err = strconv.Atoi(args[1])
+		if err != nil {
+			feedback.Fatal(tr("error parsing value: %v", err), feedback.ErrGeneric)
+		}
 	}
 
 	configuration.Settings.Set(key, value)
@@ -23,25 +23,59 @@ import (
 )
 
 var validMap = map[string]reflect.Kind{
-	"board_manager.additional_urls": reflect.Slice,
-	"daemon.port":                   reflect.String,
-	"directories.data":              reflect.String,
-	"directories.downloads":         reflect.String,
-	"directories.user":              reflect.String,
-	"directories.builtin.tools":     reflect.String,
-	"directories.builtin.libraries": reflect.String,
-	"library.enable_unsafe_install": reflect.Bool,
-	"locale":                        reflect.String,
-	"logging.file":                  reflect.String,
-	"logging.format":                reflect.String,
-	"logging.level":                 reflect.String,
-	"sketch.always_export_binaries": reflect.Bool,
-	"metrics.addr":                  reflect.String,
-	"metrics.enabled":               reflect.Bool,
-	"network.proxy":                 reflect.String,
-	"network.user_agent_ext":        reflect.String,
-	"output.no_color":               reflect.Bool,
-	"updater.enable_notification":   reflect.Bool,
+	"advisories.urls":                  reflect.Slice,
+	"board_manager.additional_urls":    reflect.Slice,
+	"board_manager.pinned_platforms":   reflect.Slice,
+	"build.use_ccache":                 reflect.Bool,
+	"build.compile_wrapper":            reflect.String,
+	"build.tool_timeout":               reflect.String,
+	"cli.locale":                       reflect.String,
+	"cli.non_interactive":              reflect.Bool,
+	"cli.non_interactive_answer":       reflect.String,
+	"cli.use_daemon":                   reflect.Bool,
+	"cloud.api_url":                    reflect.String,
+	"cloud.client_id":                  reflect.String,
+	"cloud.client_secret":              reflect.String,
+	"cloud.organization_id":            reflect.String,
+	"daemon.port":                      reflect.String,
+	"daemon.stream_batch_size":         reflect.Int,
+	"daemon.stream_buffer_size":        reflect.Int,
+	"daemon.stream_flush_interval":     reflect.String,
+	"directories.data":                 reflect.String,
+	"directories.downloads":            reflect.String,
+	"directories.layout":               reflect.String,
+	"directories.user":                 reflect.String,
+	"directories.system":               reflect.String,
+	"directories.builtin.tools":        reflect.String,
+	"directories.builtin.libraries":    reflect.String,
+	"library.enable_unsafe_install":    reflect.Bool,
+	"library.post_install_libraries":   reflect.Slice,
+	"library.post_install_permissions": reflect.Slice,
+	"library.symlinks":                 reflect.String,
+	"locale":                           reflect.String,
+	"logging.file":                     reflect.String,
+	"logging.format":                   reflect.String,
+	"logging.level":                    reflect.String,
+	"remote.address":                   reflect.String,
+	"remote.build":                     reflect.Bool,
+	"remote.tls":                       reflect.Bool,
+	"remote.token":                     reflect.String,
+	"sketch.always_export_binaries":    reflect.Bool,
+	"sketch.symlinks":                  reflect.String,
+	"metrics.addr":                     reflect.String,
+	"metrics.enabled":                  reflect.Bool,
+	"monitor.buffer_size":              reflect.Int,
+	"monitor.highlight":                reflect.Map,
+	"network.mirrors":                  reflect.Map,
+	"network.no_proxy":                 reflect.Slice,
+	"network.proxy":                    reflect.String,
+	"network.request_timeout":          reflect.String,
+	"network.user_agent_ext":           reflect.String,
+	"output.no_color":                  reflect.Bool,
+	"ports.aliases":                    reflect.Map,
+	"publishers":                       reflect.Map,
+	"updater.enable_notification":      reflect.Bool,
+	"upload.timeout":                   reflect.String,
 }
 
 func typeOf(key string) (reflect.Kind, error) {
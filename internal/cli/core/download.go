@@ -27,6 +27,7 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 func initDownloadCommand() *cobra.Command {
@@ -47,6 +48,9 @@ func initDownloadCommand() *cobra.Command {
 }
 
 func runDownloadCommand(cmd *cobra.Command, args []string) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
 	inst := instance.CreateAndInit()
 
 	logrus.Info("Executing `arduino-cli core download`")
@@ -63,7 +67,7 @@ func runDownloadCommand(cmd *cobra.Command, args []string) {
 			Architecture:    platformRef.Architecture,
 			Version:         platformRef.Version,
 		}
-		_, err := core.PlatformDownload(context.Background(), platformDownloadreq, feedback.ProgressBar())
+		_, err := core.PlatformDownload(ctx, platformDownloadreq, feedback.ProgressBar())
 		if err != nil {
 			feedback.Fatal(tr("Error downloading %[1]s: %[2]v", args[i], err), feedback.ErrNetwork)
 		}
@@ -19,19 +19,27 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/github"
 	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/internal/cli/arguments"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
 	"github.com/arduino/arduino-cli/internal/cli/instance"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+	semver "go.bug.st/relaxed-semver"
 )
 
 func initInstallCommand() *cobra.Command {
 	var noOverwrite bool
 	var scriptFlags arguments.PrePostScriptsFlags
+	var advisoriesFlag arguments.AdvisoriesFlag
 	installCommand := &cobra.Command{
 		Use:   fmt.Sprintf("install %s:%s[@%s]...", tr("PACKAGER"), tr("ARCH"), tr("VERSION")),
 		Short: tr("Installs one or more cores and corresponding tool dependencies."),
@@ -39,27 +47,48 @@ func initInstallCommand() *cobra.Command {
 		Example: "  # " + tr("download the latest version of Arduino SAMD core.") + "\n" +
 			"  " + os.Args[0] + " core install arduino:samd\n\n" +
 			"  # " + tr("download a specific version (in this case 1.6.9).") + "\n" +
-			"  " + os.Args[0] + " core install arduino:samd@1.6.9",
+			"  " + os.Args[0] + " core install arduino:samd@1.6.9\n\n" +
+			"  # " + tr("install the platform archive of a GitHub release in the sketchbook hardware folder.") + "\n" +
+			"  " + os.Args[0] + " core install owner/MyCore@v1.0.0",
 		Args: cobra.MinimumNArgs(1),
 		PreRun: func(cmd *cobra.Command, args []string) {
 			arguments.CheckFlagsConflicts(cmd, "run-post-install", "skip-post-install")
 		},
 		Run: func(cmd *cobra.Command, args []string) {
-			runInstallCommand(args, scriptFlags, noOverwrite)
+			runInstallCommand(args, scriptFlags, noOverwrite, &advisoriesFlag)
 		},
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return arguments.GetInstallableCores(), cobra.ShellCompDirectiveDefault
 		},
 	}
 	scriptFlags.AddToCommand(installCommand)
+	advisoriesFlag.AddToCommand(installCommand)
 	installCommand.Flags().BoolVar(&noOverwrite, "no-overwrite", false, tr("Do not overwrite already installed platforms."))
 	return installCommand
 }
 
-func runInstallCommand(args []string, scriptFlags arguments.PrePostScriptsFlags, noOverwrite bool) {
-	inst := instance.CreateAndInit()
+func runInstallCommand(args []string, scriptFlags arguments.PrePostScriptsFlags, noOverwrite bool, advisoriesFlag *arguments.AdvisoriesFlag) {
 	logrus.Info("Executing `arduino-cli core install`")
 
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	// Platforms from GitHub releases are installed in the sketchbook hardware folder
+	platformArgs := []string{}
+	for _, arg := range args {
+		if github.IsReleaseReference(arg) {
+			installGitHubPlatform(arg, noOverwrite)
+		} else {
+			platformArgs = append(platformArgs, arg)
+		}
+	}
+	if len(platformArgs) == 0 {
+		return
+	}
+	args = platformArgs
+
+	inst := instance.CreateAndInit()
+
 	platformsRefs, err := arguments.ParseReferences(args)
 	if err != nil {
 		feedback.Fatal(tr("Invalid argument passed: %v", err), feedback.ErrBadArgument)
@@ -75,9 +104,47 @@ func runInstallCommand(args []string, scriptFlags arguments.PrePostScriptsFlags,
 			NoOverwrite:      noOverwrite,
 			SkipPreUninstall: scriptFlags.DetectSkipPreUninstallValue(),
 		}
-		_, err := core.PlatformInstall(context.Background(), platformInstallRequest, feedback.ProgressBar(), feedback.TaskProgress())
+		_, err := core.PlatformInstall(ctx, platformInstallRequest, feedback.ProgressBar(), feedback.TaskProgress())
 		if err != nil {
 			feedback.Fatal(tr("Error during install: %v", err), feedback.ErrGeneric)
 		}
 	}
+
+	// Check the advisories of the installed versions
+	feed := arguments.LoadAdvisories()
+	advisories := []*rpc.Advisory{}
+	for _, platform := range GetList(inst, false, false) {
+		for _, platformRef := range platformsRefs {
+			id := platformRef.PackageName + ":" + platformRef.Architecture
+			if strings.EqualFold(platform.GetMetadata().GetId(), id) {
+				version, _ := semver.Parse(platform.GetInstalledVersion())
+				advisories = append(advisories, feed.Platform(platform.GetMetadata().GetId(), version)...)
+			}
+		}
+	}
+	if err := advisoriesFlag.Warn(advisories); err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+	}
+}
+
+// installGitHubPlatform installs the platform archive of the GitHub release
+// referenced by arg in the hardware folder of the sketchbook.
+func installGitHubPlatform(arg string, noOverwrite bool) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	tmpDir, err := paths.MkTempDir("", "github-platform-")
+	if err != nil {
+		feedback.Fatal(tr("Cannot create temp dir: %v", err), feedback.ErrGeneric)
+	}
+	defer tmpDir.RemoveAll()
+
+	feedback.Print(tr("Platforms from GitHub releases are not verified by the Boards Manager, install them at your own risk."))
+	ref, archive := arguments.DownloadGitHubReleaseAsset(arg, tmpDir, ".zip", ".tar.gz", ".tgz", ".tar.bz2", ".tar.xz")
+	hardwareDir := paths.New(configuration.Settings.GetString("directories.User")).Join("hardware")
+	installDir, err := packagemanager.InstallPlatformArchive(ctx, archive, hardwareDir, ref.Owner, ref.Repo, !noOverwrite)
+	if err != nil {
+		feedback.Fatal(tr("Error installing %s: %v", arg, err), feedback.ErrGeneric)
+	}
+	feedback.Print(tr("Platform %[1]s installed in %[2]s", arg, installDir))
 }
@@ -83,6 +83,10 @@ func GetList(inst *rpc.Instance, all bool, updatableOnly bool) []*rpc.PlatformSu
 		if updatableOnly && platform.InstalledVersion == platform.LatestVersion {
 			continue
 		}
+		if updatableOnly && platform.InstalledVersion == platform.PinnedVersion {
+			// Held at the installed version
+			continue
+		}
 		result = append(result, platform)
 	}
 	return result
@@ -125,6 +129,9 @@ func (ir coreListResult) String() string {
 		if platform.Deprecated {
 			name = fmt.Sprintf("[%s] %s", tr("DEPRECATED"), name)
 		}
+		if platform.PinnedVersion != nil {
+			name = fmt.Sprintf("[%s] %s", tr("PINNED %s", platform.PinnedVersion), name)
+		}
 		t.AddRow(platform.Id, platform.InstalledVersion, platform.LatestVersion, name)
 	}
 
@@ -0,0 +1,163 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initPinCommand() *cobra.Command {
+	pinCommand := &cobra.Command{
+		Use:     fmt.Sprintf("pin %s:%s[@%s] ...", tr("PACKAGER"), tr("ARCH"), tr("VERSION")),
+		Aliases: []string{"hold"},
+		Short:   tr("Pins one or more cores to a version."),
+		Long: tr(`Pins one or more cores to a version: core upgrade and upgrade don't move the
+pinned cores past that version. If the version is not specified the core is
+pinned to the installed version. The pins are saved in the
+board_manager.pinned_platforms setting of the configuration file.`),
+		Example: "" +
+			"  " + os.Args[0] + " core pin arduino:avr@1.8.6\n" +
+			"  " + os.Args[0] + " core hold arduino:samd",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runPinCommand(args)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return arguments.GetUninstallableCores(), cobra.ShellCompDirectiveDefault
+		},
+	}
+	return pinCommand
+}
+
+func runPinCommand(args []string) {
+	logrus.Info("Executing `arduino-cli core pin`")
+
+	platformsRefs, err := arguments.ParseReferences(args)
+	if err != nil {
+		feedback.Fatal(tr("Invalid argument passed: %v", err), feedback.ErrBadArgument)
+	}
+
+	// The cores without version are pinned to the installed version
+	installedVersions := map[string]string{}
+	for _, platformRef := range platformsRefs {
+		if platformRef.Version == "" {
+			inst := instance.CreateAndInit()
+			for _, platform := range GetList(inst, false, false) {
+				installedVersions[platform.GetMetadata().GetId()] = platform.GetInstalledVersion()
+			}
+			break
+		}
+	}
+
+	pins := configuration.Settings.GetStringSlice("board_manager.pinned_platforms")
+	for _, platformRef := range platformsRefs {
+		id := platformRef.PackageName + ":" + platformRef.Architecture
+		version := platformRef.Version
+		if version == "" {
+			version = installedVersions[id]
+			if version == "" {
+				feedback.Fatal(tr("Platform %s is not installed, specify the version to pin it to", id), feedback.ErrBadArgument)
+			}
+		}
+		pin := id + "@" + version
+		if _, _, err := configuration.ParsePlatformPin(pin); err != nil {
+			feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+		}
+		pins = append(removePlatformPins(pins, id), pin)
+		feedback.Print(tr("Platform %[1]s pinned to %[2]s", id, version))
+	}
+	sort.Strings(pins)
+	writePlatformPins(pins)
+}
+
+func initUnpinCommand() *cobra.Command {
+	unpinCommand := &cobra.Command{
+		Use:     fmt.Sprintf("unpin %s:%s ...", tr("PACKAGER"), tr("ARCH")),
+		Aliases: []string{"unhold"},
+		Short:   tr("Removes the pins of one or more cores."),
+		Long:    tr("Removes the pins of one or more cores, letting core upgrade and upgrade move them to the latest version."),
+		Example: "  " + os.Args[0] + " core unpin arduino:avr",
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runUnpinCommand(args)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			ids := []string{}
+			for id := range configuration.PinnedPlatforms(configuration.Settings) {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			return ids, cobra.ShellCompDirectiveDefault
+		},
+	}
+	return unpinCommand
+}
+
+func runUnpinCommand(args []string) {
+	logrus.Info("Executing `arduino-cli core unpin`")
+
+	pins := configuration.Settings.GetStringSlice("board_manager.pinned_platforms")
+	pinned := configuration.PinnedPlatforms(configuration.Settings)
+	for _, id := range args {
+		// The platforms may have been removed from the indexes since they
+		// have been pinned: match the pins without resolving the platforms
+		if strings.Contains(id, "@") {
+			feedback.Fatal(tr("Invalid parameter %s: version not allowed", id), feedback.ErrBadArgument)
+		}
+		found := false
+		for pinnedID := range pinned {
+			if strings.EqualFold(pinnedID, id) {
+				pins = removePlatformPins(pins, pinnedID)
+				feedback.Print(tr("Platform %s unpinned", pinnedID))
+				found = true
+			}
+		}
+		if !found {
+			feedback.Warning(tr("Platform %s is not pinned", id))
+		}
+	}
+	writePlatformPins(pins)
+}
+
+// removePlatformPins returns the pins without the ones of the platform with
+// the given ID
+func removePlatformPins(pins []string, id string) []string {
+	res := []string{}
+	for _, pin := range pins {
+		if pinID, _, err := configuration.ParsePlatformPin(pin); err == nil && pinID == id {
+			continue
+		}
+		res = append(res, pin)
+	}
+	return res
+}
+
+func writePlatformPins(pins []string) {
+	configuration.Settings.Set("board_manager.pinned_platforms", pins)
+	if err := configuration.WriteConfig(configuration.Settings); err != nil {
+		feedback.Fatal(tr("Writing config file: %v", err), feedback.ErrGeneric)
+	}
+}
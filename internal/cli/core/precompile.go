@@ -0,0 +1,96 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initPrecompileCommand() *cobra.Command {
+	var allBoardOptions bool
+	var optimizeForDebug bool
+	var buildCachePath string
+	var verbose bool
+	precompileCommand := &cobra.Command{
+		Use:   "precompile FQBN...",
+		Short: tr("Pre-builds the core of one or more boards."),
+		Long:  tr("Pre-builds the core of one or more boards and stores it in the build cache, to speed up the first compilation of a sketch."),
+		Example: "  " + os.Args[0] + " core precompile arduino:avr:uno\n" +
+			"  " + os.Args[0] + " core precompile arduino:avr:nano --all-board-options",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runPrecompileCommand(args, allBoardOptions, optimizeForDebug, buildCachePath, verbose)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return arguments.GetInstalledBoards(), cobra.ShellCompDirectiveDefault
+		},
+	}
+	precompileCommand.Flags().BoolVar(&allBoardOptions, "all-board-options", false, tr("Precompile the core for all the combinations of the board options not set in the FQBN."))
+	precompileCommand.Flags().BoolVar(&optimizeForDebug, "optimize-for-debug", false, tr("Precompile the core with the debug optimization flags."))
+	precompileCommand.Flags().StringVar(&buildCachePath, "build-cache-path", "", tr("Builds of 'core.a' are saved into this path to be cached and reused."))
+	precompileCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return precompileCommand
+}
+
+func runPrecompileCommand(args []string, allBoardOptions, optimizeForDebug bool, buildCachePath string, verbose bool) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli core precompile`")
+
+	stdOut, stdErr, _ := feedback.OutputStreams()
+	res, err := compile.PrecompileCore(ctx, &rpc.PrecompileCoreRequest{
+		Instance:         inst,
+		Fqbns:            args,
+		AllBoardOptions:  allBoardOptions,
+		OptimizeForDebug: optimizeForDebug,
+		BuildCachePath:   buildCachePath,
+		Verbose:          verbose,
+	}, stdOut, stdErr)
+	if err != nil {
+		feedback.Fatal(tr("Error during core precompilation: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&precompileResult{PrecompiledFQBNs: res.GetPrecompiledFqbns()})
+}
+
+type precompileResult struct {
+	PrecompiledFQBNs []string `json:"precompiled_fqbns"`
+}
+
+// Data implements Result interface
+func (r *precompileResult) Data() interface{} {
+	return r
+}
+
+// String implements Result interface
+func (r *precompileResult) String() string {
+	if len(r.PrecompiledFQBNs) == 0 {
+		return ""
+	}
+	return tr("Core precompiled for:") + "\n  " + strings.Join(r.PrecompiledFQBNs, "\n  ")
+}
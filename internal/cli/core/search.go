@@ -39,6 +39,8 @@ import (
 
 var (
 	allVersions bool
+	limit       uint32
+	fuzzy       bool
 )
 
 func initSearchCommand() *cobra.Command {
@@ -51,6 +53,8 @@ func initSearchCommand() *cobra.Command {
 		Run:     runSearchCommand,
 	}
 	searchCommand.Flags().BoolVarP(&allVersions, "all", "a", false, tr("Show all available core versions."))
+	searchCommand.Flags().Uint32Var(&limit, "limit", 0, tr("Show at most this number of platforms, 0 shows all the matching platforms."))
+	searchCommand.Flags().BoolVar(&fuzzy, "fuzzy", false, tr("Tolerate typos in the search terms."))
 
 	return searchCommand
 }
@@ -76,23 +80,26 @@ func runSearchCommand(cmd *cobra.Command, args []string) {
 		Instance:    inst,
 		SearchArgs:  arguments,
 		AllVersions: allVersions,
+		Limit:       limit,
+		Fuzzy:       fuzzy,
 	})
 	if err != nil {
 		feedback.Fatal(tr("Error searching for platforms: %v", err), feedback.ErrGeneric)
 	}
 
 	coreslist := resp.GetSearchOutput()
-	feedback.PrintResult(newSearchResult(coreslist))
+	feedback.PrintResult(newSearchResult(coreslist, resp.GetTotal()))
 }
 
 // output from this command requires special formatting, let's create a dedicated
 // feedback.Result implementation
 type searchResults struct {
 	platforms []*result.Platform
+	total     uint32
 }
 
-func newSearchResult(in []*rpc.PlatformSummary) *searchResults {
-	res := &searchResults{}
+func newSearchResult(in []*rpc.PlatformSummary, total uint32) *searchResults {
+	res := &searchResults{total: total}
 	for _, platformSummary := range in {
 		res.platforms = append(res.platforms, result.NewPlatformResult(platformSummary))
 	}
@@ -119,7 +126,11 @@ func (sr searchResults) String() string {
 				t.AddRow(platform.Id, version, name)
 			}
 		}
-		return t.Render()
+		out := t.Render()
+		if more := int(sr.total) - len(sr.platforms); more > 0 {
+			out += tr("%d more platforms match the search, increase --limit to show them.", more) + "\n"
+		}
+		return out
 	}
 	return tr("No platforms matching your search.")
 }
@@ -25,6 +25,7 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 func initUpdateIndexCommand() *cobra.Command {
@@ -47,7 +48,10 @@ func runUpdateIndexCommand(cmd *cobra.Command, args []string) {
 
 // UpdateIndex updates the index of platforms.
 func UpdateIndex(inst *rpc.Instance) {
-	err := commands.UpdateIndex(context.Background(), &rpc.UpdateIndexRequest{Instance: inst}, feedback.ProgressBar())
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	err := commands.UpdateIndex(ctx, &rpc.UpdateIndexRequest{Instance: inst}, feedback.ProgressBar())
 	if err != nil {
 		feedback.FatalError(err, feedback.ErrGeneric)
 	}
@@ -29,6 +29,7 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 func initUpgradeCommand() *cobra.Command {
@@ -58,6 +59,9 @@ func runUpgradeCommand(args []string, skipPostInstall bool, skipPreUninstall boo
 
 // Upgrade upgrades one or all installed platforms to the latest version.
 func Upgrade(inst *rpc.Instance, args []string, skipPostInstall bool, skipPreUninstall bool) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
 	// if no platform was passed, upgrade allthethings
 	if len(args) == 0 {
 		platforms, err := core.PlatformSearch(&rpc.PlatformSearchRequest{
@@ -123,11 +127,12 @@ func Upgrade(inst *rpc.Instance, args []string, skipPostInstall bool, skipPreUni
 			SkipPostInstall:  skipPostInstall,
 			SkipPreUninstall: skipPreUninstall,
 		}
-		response, err := core.PlatformUpgrade(context.Background(), r, feedback.ProgressBar(), feedback.TaskProgress())
+		response, err := core.PlatformUpgrade(ctx, r, feedback.ProgressBar(), feedback.TaskProgress())
 		warningMissingIndex(response)
 		if err != nil {
 			var alreadyAtLatestVersionErr *arduino.PlatformAlreadyAtTheLatestVersionError
-			if errors.As(err, &alreadyAtLatestVersionErr) {
+			var pinnedErr *arduino.PlatformPinnedError
+			if errors.As(err, &alreadyAtLatestVersionErr) || errors.As(err, &pinnedErr) {
 				feedback.Warning(err.Error())
 				continue
 			}
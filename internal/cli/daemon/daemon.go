@@ -16,18 +16,26 @@
 package daemon
 
 import (
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/pprof"
 	"os"
 	"strings"
+	"sync"
 	"syscall"
 
+	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/daemon"
 	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/daemonclient"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/remote"
 	srv_commands "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	srv_settings "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/settings/v1"
 	"github.com/arduino/arduino-cli/version"
@@ -35,14 +43,34 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	grpc_reflection "google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 var (
-	tr           = i18n.Tr
-	daemonize    bool
-	debug        bool
-	debugFile    string
-	debugFilters []string
+	tr                 = i18n.Tr
+	daemonize          bool
+	debug              bool
+	debugFile          string
+	debugFilters       []string
+	pprofAddress       string
+	infoFile           string
+	apiCompat          []string
+	reflection         bool
+	healthCheck        bool
+	listenIP           string
+	usersFile          string
+	tlsCertFile        string
+	tlsKeyFile         string
+	agent              bool
+	wsAddress          string
+	wsOrigins          []string
+	createAgentAddress string
+	createAgentOrigins []string
 )
 
 // NewCommand created a new `daemon` command
@@ -61,6 +89,24 @@ func NewCommand() *cobra.Command {
 	daemonCommand.Flags().BoolVar(&debug, "debug", false, tr("Enable debug logging of gRPC calls"))
 	daemonCommand.Flags().StringVar(&debugFile, "debug-file", "", tr("Append debug logging to the specified file"))
 	daemonCommand.Flags().StringSliceVar(&debugFilters, "debug-filter", []string{}, tr("Display only the provided gRPC calls"))
+	daemonCommand.Flags().StringVar(&pprofAddress, "pprof-address", "", tr("Serve the pprof profiling data via HTTP on the specified address (for example localhost:6060)"))
+	daemonCommand.Flags().StringSliceVar(&apiCompat, "api-compat", []string{}, tr("Serve also the specified previous versions of the gRPC API, through compatibility adapters"))
+	daemonCommand.RegisterFlagCompletionFunc("api-compat", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return daemon.SupportedAPIVersions(), cobra.ShellCompDirectiveNoFileComp
+	})
+	daemonCommand.Flags().BoolVar(&reflection, "grpc-reflection", false, tr("Enable the gRPC server reflection service, to inspect the daemon with tools like grpcurl"))
+	daemonCommand.Flags().BoolVar(&healthCheck, "grpc-health", false, tr("Enable the standard gRPC health checking service (grpc.health.v1)"))
+	daemonCommand.Flags().StringVar(&listenIP, "ip", "127.0.0.1", tr("The IP address the daemon will listen to"))
+	daemonCommand.Flags().StringVar(&usersFile, "users-file", "", tr("Serve multiple users, authenticated with the access tokens listed in the specified file"))
+	daemonCommand.Flags().StringVar(&tlsCertFile, "tls-cert-file", "", tr("The TLS certificate file, to serve the gRPC API over TLS"))
+	daemonCommand.Flags().StringVar(&tlsKeyFile, "tls-key-file", "", tr("The TLS private key file, to serve the gRPC API over TLS"))
+	daemonCommand.Flags().BoolVar(&agent, "agent", false, tr("Run as a remote upload agent, exposing the ports of this machine to the users allowed in the users file"))
+	daemonCommand.Flags().StringVar(&wsAddress, "websocket-address", "", tr("Serve the WebSocket bridge for the browser-based IDEs on the specified address (for example localhost:50052)"))
+	daemonCommand.Flags().StringVar(&createAgentAddress, "create-agent-address", "", tr("Serve an API compatible with the Arduino Create Agent on the specified address (for example localhost:8991)"))
+	daemonCommand.Flags().StringSliceVar(&createAgentOrigins, "create-agent-allowed-origins", []string{"https://*.arduino.cc"}, tr("The origins of the web pages allowed to use the Create Agent API, in addition to the pages served by localhost"))
+	daemonCommand.Flags().StringSliceVar(&wsOrigins, "websocket-allowed-origins", []string{}, tr("The origins of the web pages allowed to use the WebSocket bridge, by default only the pages served by localhost"))
+	daemonCommand.Flags().StringVar(&infoFile, "info-file", "", tr("Keep an initialized instance and write the connection info in the specified file"))
+	daemonCommand.Flags().MarkHidden("info-file")
 	return daemonCommand
 }
 
@@ -71,12 +117,60 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 	configuration.Settings.SetDefault("directories.builtin.Libraries", configuration.GetDefaultBuiltinLibrariesDir())
 
 	port := configuration.Settings.GetString("daemon.port")
-	gRPCOptions := []grpc.ServerOption{}
+	// The sketches built remotely are sent as an archive in the request
+	gRPCOptions := []grpc.ServerOption{grpc.MaxRecvMsgSize(remote.MaxMessageSize)}
 	if debugFile != "" {
 		if !debug {
 			feedback.Fatal(tr("The flag --debug-file must be used with --debug."), feedback.ErrBadArgument)
 		}
 	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{}
+	streamInterceptors := []grpc.StreamServerInterceptor{}
+	token := ""
+	if infoFile != "" {
+		if usersFile != "" {
+			feedback.Fatal(tr("The flag --info-file can't be used with --users-file."), feedback.ErrBadArgument)
+		}
+		// The background daemon accepts only the calls of the user that can
+		// read the info file
+		t, err := daemonclient.NewToken()
+		if err != nil {
+			feedback.Fatal(tr("Error generating the access token: %v", err), feedback.ErrGeneric)
+		}
+		token = t
+		unaryTokenInterceptor, streamTokenInterceptor := daemonclient.TokenInterceptors(token)
+		unaryInterceptors = append(unaryInterceptors, unaryTokenInterceptor)
+		streamInterceptors = append(streamInterceptors, streamTokenInterceptor)
+	}
+	if usersFile != "" {
+		if createAgentAddress != "" {
+			// The Create Agent API has no authentication
+			feedback.Fatal(tr("The flag --create-agent-address can't be used with --users-file."), feedback.ErrBadArgument)
+		}
+		buildsDir := configuration.DataDir(configuration.Settings).Join("daemon", "builds")
+		sessions, err := daemon.LoadSessions(paths.New(usersFile), buildsDir)
+		if err != nil {
+			feedback.Fatal(tr("Error reading users file: %v", err), feedback.ErrBadArgument)
+		}
+		sessions.SetAgentMode(agent)
+		// The users are authenticated before anything else
+		unaryInterceptors = append(unaryInterceptors, sessions.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, sessions.StreamInterceptor)
+	} else if agent {
+		feedback.Fatal(tr("The flag --agent must be used with --users-file."), feedback.ErrBadArgument)
+	} else if infoFile == "" && listenIP != "127.0.0.1" && listenIP != "localhost" {
+		feedback.Warning(tr("The daemon is reachable from other machines without authentication, use --users-file to require it."))
+	}
+	if (tlsCertFile == "") != (tlsKeyFile == "") {
+		feedback.Fatal(tr("The flags --tls-cert-file and --tls-key-file must be used together."), feedback.ErrBadArgument)
+	}
+	if tlsCertFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(tlsCertFile, tlsKeyFile)
+		if err != nil {
+			feedback.Fatal(tr("Error loading TLS certificate: %v", err), feedback.ErrBadArgument)
+		}
+		gRPCOptions = append(gRPCOptions, grpc.Creds(creds))
+	}
 	if debug {
 		if debugFile != "" {
 			outFile := paths.New(debugFile)
@@ -93,29 +187,47 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 				debugStdOut = out
 			}
 		}
-		gRPCOptions = append(gRPCOptions,
-			grpc.UnaryInterceptor(unaryLoggerInterceptor),
-			grpc.StreamInterceptor(streamLoggerInterceptor),
-		)
+		unaryInterceptors = append(unaryInterceptors, unaryLoggerInterceptor)
+		streamInterceptors = append(streamInterceptors, streamLoggerInterceptor)
 	}
+	gRPCOptions = append(gRPCOptions,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	s := grpc.NewServer(gRPCOptions...)
 	// Set specific user-agent for the daemon
 	configuration.Settings.Set("network.user_agent_ext", "daemon")
 
 	// register the commands service
-	srv_commands.RegisterArduinoCoreServiceServer(s, &daemon.ArduinoCoreServerImpl{
+	coreServer := &daemon.ArduinoCoreServerImpl{
 		VersionString: version.VersionInfo.VersionString,
-	})
+	}
+	srv_commands.RegisterArduinoCoreServiceServer(s, coreServer)
+	if err := daemon.RegisterAPICompat(s, coreServer, apiCompat); err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+	}
 
 	// Register the settings service
 	srv_settings.RegisterSettingsServiceServer(s, &daemon.SettingsService{})
 
+	registerInspectionServices(s, reflection, healthCheck)
+
+	if pprofAddress != "" {
+		startPprofServer(pprofAddress)
+	}
+	if wsAddress != "" {
+		startWebSocketBridge(wsAddress, s, tlsCertFile != "")
+	}
+	if createAgentAddress != "" {
+		startCreateAgent(createAgentAddress, s, tlsCertFile != "")
+	}
+
 	if !daemonize {
 		// When parent process ends terminate also the daemon
 		go feedback.ExitWhenParentProcessEnds()
 	}
 
-	ip := "127.0.0.1"
+	ip := listenIP
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", ip, port))
 	if err != nil {
 		// Invalid port, such as "Foo"
@@ -150,6 +262,10 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 		port = split[1]
 	}
 
+	if infoFile != "" {
+		writeInfoFile(paths.New(infoFile), ip+":"+port, token)
+	}
+
 	feedback.PrintResult(daemonResult{
 		IP:   ip,
 		Port: port,
@@ -160,6 +276,146 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 	}
 }
 
+// writeInfoFile creates and initializes an instance that is kept warm for the
+// CLI commands and writes the info needed to connect to the daemon.
+func writeInfoFile(file *paths.Path, address, token string) {
+	// The fingerprint must be computed before the initialization, this way any
+	// change happening during the initialization triggers a new one.
+	fingerprint := daemonclient.InstallationFingerprint()
+	res, err := commands.Create(&srv_commands.CreateRequest{}, "daemon")
+	if err != nil {
+		feedback.Fatal(tr("Create instance error: %v", err), feedback.ErrGeneric)
+	}
+	err = commands.Init(context.Background(), &srv_commands.InitRequest{Instance: res.GetInstance()}, func(r *srv_commands.InitResponse) {
+		if st := r.GetError(); st != nil {
+			logrus.Warnf("Error initializing instance: %s", st.GetMessage())
+		}
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error initializing instance: %v", err), feedback.ErrGeneric)
+	}
+	info := &daemonclient.Info{
+		Address:     address,
+		PID:         os.Getpid(),
+		Version:     version.VersionInfo.VersionString,
+		ConfigFile:  configuration.Settings.ConfigFileUsed(),
+		Instance:    res.GetInstance().GetId(),
+		Fingerprint: fingerprint,
+		Token:       token,
+	}
+	if err := daemonclient.WriteInfo(file, info); err != nil {
+		feedback.Fatal(tr("Error writing daemon info file: %v", err), feedback.ErrGeneric)
+	}
+}
+
+var (
+	inProcessOnce sync.Once
+	inProcessConn *grpc.ClientConn
+)
+
+// inProcessConnection returns a connection to the gRPC server that doesn't
+// leave the process, used by the HTTP bridges.
+func inProcessConnection(s *grpc.Server, useTLS bool) *grpc.ClientConn {
+	inProcessOnce.Do(func() {
+		lis := bufconn.Listen(1024 * 1024)
+		go s.Serve(lis)
+		creds := insecure.NewCredentials()
+		if useTLS {
+			// The connection doesn't leave the process
+			creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+		}
+		conn, err := grpc.Dial("bufconn",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+			grpc.WithTransportCredentials(creds),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(remote.MaxMessageSize), grpc.MaxCallSendMsgSize(remote.MaxMessageSize)))
+		if err != nil {
+			feedback.Fatal(tr("Error connecting to the gRPC server: %v", err), feedback.ErrGeneric)
+		}
+		inProcessConn = conn
+	})
+	return inProcessConn
+}
+
+// startWebSocketBridge starts an HTTP server exposing the WebSocket bridge
+// under /ws on the given address. The bridge calls the gRPC server through an
+// in-process connection.
+func startWebSocketBridge(address string, s *grpc.Server, useTLS bool) {
+	conn := inProcessConnection(s, useTLS)
+	mux := http.NewServeMux()
+	mux.Handle("/ws", daemon.NewWebSocketBridge(conn, wsOrigins).Handler())
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		feedback.Fatal(tr("Failed to listen for WebSocket connections on %[1]s: %[2]v", address, err), feedback.ErrFailedToListenToTCPPort)
+	}
+	logrus.Infof("Serving the WebSocket bridge on ws://%s/ws", lis.Addr())
+	go func() {
+		if err := http.Serve(lis, mux); err != nil {
+			logrus.WithError(err).Error("WebSocket bridge stopped")
+		}
+	}()
+}
+
+// startCreateAgent starts an HTTP server emulating the Arduino Create Agent
+// on the given address. The agent calls the gRPC server through an in-process
+// connection.
+func startCreateAgent(address string, s *grpc.Server, useTLS bool) {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		feedback.Fatal(tr("Failed to listen for Create Agent connections on %[1]s: %[2]v", address, err), feedback.ErrFailedToListenToTCPPort)
+	}
+	go func() {
+		createAgent, err := daemon.NewCreateAgent(context.Background(), inProcessConnection(s, useTLS), createAgentOrigins)
+		if err != nil {
+			logrus.WithError(err).Error("Error starting the Create Agent")
+			lis.Close()
+			return
+		}
+		defer createAgent.Close()
+		logrus.Infof("Serving the Create Agent API on http://%s", lis.Addr())
+		if err := http.Serve(lis, createAgent.Handler()); err != nil {
+			logrus.WithError(err).Error("Create Agent stopped")
+		}
+	}()
+}
+
+// registerInspectionServices registers the gRPC server reflection and the
+// health checking services, if enabled.
+func registerInspectionServices(s *grpc.Server, reflection, healthCheck bool) {
+	if reflection {
+		grpc_reflection.Register(s)
+	}
+	if healthCheck {
+		healthServer := health.NewServer()
+		healthServer.SetServingStatus(srv_commands.ArduinoCoreService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+		healthServer.SetServingStatus(srv_settings.SettingsService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+		healthpb.RegisterHealthServer(s, healthServer)
+	}
+}
+
+// startPprofServer starts an HTTP server exposing the pprof profiling
+// endpoints under /debug/pprof/ on the given address, and returns the address
+// it's listening on.
+func startPprofServer(address string) net.Addr {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		feedback.Fatal(tr("Failed to listen for pprof connections on %[1]s: %[2]v", address, err), feedback.ErrFailedToListenToTCPPort)
+	}
+	logrus.Infof("Serving pprof profiling data on http://%s/debug/pprof/", lis.Addr())
+	go func() {
+		if err := http.Serve(lis, mux); err != nil {
+			logrus.WithError(err).Error("pprof server stopped")
+		}
+	}()
+	return lis.Addr()
+}
+
 type daemonResult struct {
 	IP   string
 	Port string
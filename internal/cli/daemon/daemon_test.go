@@ -0,0 +1,119 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/arduino/arduino-cli/commands/daemon"
+	srv_settings "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/settings/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestPprofServer(t *testing.T) {
+	addr := startPprofServer("127.0.0.1:0")
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/debug/pprof/", addr))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "goroutine")
+
+	resp, err = http.Get(fmt.Sprintf("http://%s/debug/pprof/heap", addr))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// newInspectionTestServer starts a gRPC server with the settings service and
+// the requested inspection services, and returns a connection to it.
+func newInspectionTestServer(t *testing.T, reflection, healthCheck bool) *grpc.ClientConn {
+	s := grpc.NewServer()
+	srv_settings.RegisterSettingsServiceServer(s, &daemon.SettingsService{})
+	registerInspectionServices(s, reflection, healthCheck)
+	lis := bufconn.Listen(1024 * 1024)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestInspectionServices(t *testing.T) {
+	ctx := context.Background()
+	conn := newInspectionTestServer(t, true, true)
+
+	// Health checks
+	health := healthpb.NewHealthClient(conn)
+	resp, err := health.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	resp, err = health.Check(ctx, &healthpb.HealthCheckRequest{Service: srv_settings.SettingsService_ServiceDesc.ServiceName})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	_, err = health.Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown.Service"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Reflection
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	reflectionResp, err := stream.Recv()
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseSend())
+	services := []string{}
+	for _, service := range reflectionResp.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	require.Contains(t, services, srv_settings.SettingsService_ServiceDesc.ServiceName)
+	require.Contains(t, services, healthpb.Health_ServiceDesc.ServiceName)
+}
+
+func TestInspectionServicesDisabled(t *testing.T) {
+	ctx := context.Background()
+	conn := newInspectionTestServer(t, false, false)
+
+	_, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,498 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package daemonclient allows the CLI commands to run through a background
+// daemon that keeps the indexes and the installed platforms and libraries
+// loaded between invocations. The daemon is started automatically the first
+// time it's needed and it's reused by the following CLI invocations. The
+// daemon accepts only the calls carrying the access token it writes, with
+// its address, in an info file readable only by the user.
+package daemonclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/version"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var tr = i18n.Tr
+
+// startupTimeout is the maximum time to wait for an autostarted daemon to be ready
+const startupTimeout = 30 * time.Second
+
+// Info contains the information needed to connect to a running background
+// daemon. It is written by the daemon in the file returned by InfoFile.
+type Info struct {
+	Address    string `json:"address"`
+	PID        int    `json:"pid"`
+	Version    string `json:"version"`
+	ConfigFile string `json:"config_file"`
+	// Instance is the ID of the instance kept initialized by the daemon
+	Instance int32 `json:"instance"`
+	// Fingerprint identifies the state of the installed platforms and
+	// libraries at the time the instance was last initialized
+	Fingerprint string `json:"fingerprint"`
+	// Token is the access token required by the daemon
+	Token string `json:"token"`
+}
+
+// InfoFile returns the path of the file containing the Info of the background daemon.
+func InfoFile() *paths.Path {
+	return configuration.DataDir(configuration.Settings).Join("daemon.json")
+}
+
+// WriteInfo atomically writes the given Info in the given file. The file
+// contains the access token of the daemon, so it's readable only by the user.
+func WriteInfo(file *paths.Path, info *Info) error {
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := file.Parent().Join(file.Base() + ".tmp")
+	// A leftover temporary file would keep its permissions
+	_ = tmp.Remove()
+	if err := os.WriteFile(tmp.String(), data, 0600); err != nil {
+		return err
+	}
+	return tmp.Rename(file)
+}
+
+// NewToken returns a new random access token for the daemon.
+func NewToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// TokenInterceptors return the interceptors of a daemon that refuse the calls
+// not carrying the given access token in the `authorization` metadata.
+func TokenInterceptors(token string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	check := func(ctx context.Context) error {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, auth := range md.Get("authorization") {
+			if subtle.ConstantTimeCompare([]byte(auth), []byte("Bearer "+token)) == 1 {
+				return nil
+			}
+		}
+		return status.Error(codes.Unauthenticated, tr("Missing or invalid access token"))
+	}
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := check(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := check(stream.Context()); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+	return unary, stream
+}
+
+// tokenCredentials sends the access token of the daemon with each call
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity returns false, the daemon listens only on the
+// loopback interface
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func readInfo(file *paths.Path) (*Info, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var info Info
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// Client is a connection to the background daemon.
+type Client struct {
+	conn     *grpc.ClientConn
+	service  rpc.ArduinoCoreServiceClient
+	instance *rpc.Instance
+	// profileInstance is the instance created for a profile, destroyed when
+	// the client is closed
+	profileInstance *rpc.Instance
+}
+
+// Connect connects to the background daemon, starting it if it's not
+// already running. The instance kept by the daemon is re-initialized if the
+// installed platforms or libraries have changed since the last initialization.
+func Connect(ctx context.Context) (*Client, error) {
+	infoFile := InfoFile()
+	configFile := configuration.Settings.ConfigFileUsed()
+
+	client, info, err := connectToRunningDaemon(ctx, infoFile, configFile)
+	if err != nil {
+		logrus.WithError(err).Info("Background daemon not available, starting a new one")
+		feedback.Warning(tr("Starting the background daemon, it can be disabled with the cli.use_daemon setting."))
+		if err := startDaemon(infoFile, configFile); err != nil {
+			return nil, err
+		}
+		if client, info, err = connectToRunningDaemon(ctx, infoFile, configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	// Re-initialize the instance if something has been installed or removed
+	fingerprint := InstallationFingerprint()
+	if info.Fingerprint != fingerprint {
+		logrus.Info("Installation changed, re-initializing background daemon instance")
+		if err := client.initInstance(ctx); err != nil {
+			client.Close()
+			return nil, err
+		}
+		info.Fingerprint = fingerprint
+		if err := WriteInfo(infoFile, info); err != nil {
+			logrus.WithError(err).Warn("Error updating background daemon info")
+		}
+	}
+	return client, nil
+}
+
+// connectToRunningDaemon connects to the daemon described in the info file, if
+// the daemon is not reachable or it's not compatible with the current CLI an
+// error is returned.
+func connectToRunningDaemon(ctx context.Context, infoFile *paths.Path, configFile string) (*Client, *Info, error) {
+	info, err := readInfo(infoFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.Version != version.VersionInfo.VersionString {
+		return nil, nil, errors.New(tr("the background daemon has a different version: %s", info.Version))
+	}
+	if info.ConfigFile != configFile {
+		return nil, nil, errors.New(tr("the background daemon uses a different configuration file: %s", info.ConfigFile))
+	}
+	if info.Token == "" {
+		return nil, nil, errors.New(tr("the background daemon doesn't require an access token"))
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, info.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(tokenCredentials(info.Token)),
+		grpc.WithBlock())
+	if err != nil {
+		return nil, nil, err
+	}
+	return &Client{
+		conn:     conn,
+		service:  rpc.NewArduinoCoreServiceClient(conn),
+		instance: &rpc.Instance{Id: info.Instance},
+	}, info, nil
+}
+
+// startDaemon starts a new detached daemon and waits until it's ready.
+func startDaemon(infoFile *paths.Path, configFile string) error {
+	// Remove stale info so we can detect when the new daemon is ready
+	_ = infoFile.Remove()
+
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	args := []string{executable, "daemon", "--daemonize", "--port", "0", "--info-file", infoFile.String()}
+	if configFile != "" {
+		args = append(args, "--config-file", configFile)
+	}
+	proc, err := executils.NewProcess(nil, args...)
+	if err != nil {
+		return err
+	}
+	proc.SetDetached()
+	if err := proc.Start(); err != nil {
+		return fmt.Errorf("%s: %w", tr("starting background daemon"), err)
+	}
+
+	deadline := time.Now().Add(startupTimeout)
+	for time.Now().Before(deadline) {
+		if infoFile.Exist() {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	_ = proc.Kill()
+	return errors.New(tr("timeout waiting for the background daemon to start"))
+}
+
+// InstallationFingerprint returns a string that changes every time a platform, a tool,
+// a library or an index is installed, updated or removed.
+func InstallationFingerprint() string {
+	dirs := paths.PathList{configuration.LibrariesDir(configuration.Settings)}
+	// The data folder changes also when the daemon info file is written,
+	// only the indexes in it are checked
+	if files, err := configuration.DataDir(configuration.Settings).ReadDir(); err == nil {
+		files.FilterOutDirs()
+		for _, file := range files {
+			if strings.HasSuffix(file.Base(), "index.json") {
+				dirs.Add(file)
+			}
+		}
+	}
+	packagesDir := configuration.PackagesDir(configuration.Settings)
+	dirs.Add(packagesDir)
+	if packagers, err := packagesDir.ReadDir(); err == nil {
+		packagers.FilterDirs()
+		for _, packager := range packagers {
+			dirs.Add(packager)
+			for _, sub := range []string{"hardware", "tools"} {
+				dirs.Add(packager.Join(sub))
+				if entries, err := packager.Join(sub).ReadDir(); err == nil {
+					entries.FilterDirs()
+					dirs.AddAll(entries)
+				}
+			}
+		}
+	}
+	if configFile := configuration.Settings.ConfigFileUsed(); configFile != "" {
+		dirs.Add(paths.New(configFile))
+	}
+
+	res := ""
+	for _, dir := range dirs {
+		if stat, err := dir.Stat(); err == nil {
+			res += fmt.Sprintf("%s:%d;", dir, stat.ModTime().UnixNano())
+		}
+	}
+	return res
+}
+
+func (c *Client) initInstance(ctx context.Context) error {
+	stream, err := c.service.Init(ctx, &rpc.InitRequest{Instance: c.instance})
+	if err != nil {
+		return convertError(err)
+	}
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return convertError(err)
+		}
+		if st := res.GetError(); st != nil {
+			logrus.Warnf("Error initializing background daemon instance: %s", st.GetMessage())
+		}
+	}
+}
+
+// Close closes the connection to the daemon, the daemon keeps running.
+func (c *Client) Close() error {
+	if c.profileInstance != nil {
+		_, _ = c.service.Destroy(context.Background(), &rpc.DestroyRequest{Instance: c.profileInstance})
+	}
+	return c.conn.Close()
+}
+
+// UseProfile makes the client use a new instance of the daemon initialized
+// with the given profile of the sketch, instead of the instance kept by the
+// daemon. The platforms and the libraries of the profile are installed if
+// missing, the responses of the initialization are sent to responseCB.
+func (c *Client) UseProfile(ctx context.Context, sketchPath *paths.Path, profile string, responseCB func(*rpc.InitResponse)) error {
+	res, err := c.service.Create(ctx, &rpc.CreateRequest{})
+	if err != nil {
+		return convertError(err)
+	}
+	c.profileInstance = res.GetInstance()
+	c.instance = c.profileInstance
+	stream, err := c.service.Init(ctx, &rpc.InitRequest{Instance: c.instance, SketchPath: abs(sketchPath.String()), Profile: profile})
+	if err != nil {
+		return convertError(err)
+	}
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return convertError(err)
+		}
+		responseCB(msg)
+	}
+}
+
+// BoardList returns the ports detected by the daemon within the given timeout.
+func (c *Client) BoardList(ctx context.Context, timeout time.Duration) ([]*rpc.DetectedPort, error) {
+	res, err := c.service.BoardList(ctx, &rpc.BoardListRequest{Instance: c.instance, Timeout: timeout.Milliseconds()})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return res.GetPorts(), nil
+}
+
+// SupportedUserFields returns the fields that must be filled to upload with
+// the given request, through the daemon.
+func (c *Client) SupportedUserFields(ctx context.Context, req *rpc.SupportedUserFieldsRequest) (*rpc.SupportedUserFieldsResponse, error) {
+	req = proto.Clone(req).(*rpc.SupportedUserFieldsRequest)
+	req.Instance = c.instance
+	res, err := c.service.SupportedUserFields(ctx, req)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return res, nil
+}
+
+// Upload runs the given upload request through the daemon, the output of the
+// upload tool is streamed to outStream and errStream and the parsed progress,
+// if any, to progressCB.
+func (c *Client) Upload(ctx context.Context, req *rpc.UploadRequest, outStream, errStream io.Writer, progressCB rpc.TaskProgressCB) (*rpc.UploadResult, error) {
+	req = absolutizeUploadRequestPaths(req)
+	req.Instance = c.instance
+	stream, err := c.service.Upload(ctx, req)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	res := &rpc.UploadResult{}
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+		if err != nil {
+			return nil, convertError(err)
+		}
+		switch {
+		case len(msg.GetOutStream()) > 0:
+			outStream.Write(msg.GetOutStream())
+		case len(msg.GetErrStream()) > 0:
+			errStream.Write(msg.GetErrStream())
+		case msg.GetProgress() != nil:
+			if progressCB != nil {
+				progressCB(msg.GetProgress())
+			}
+		case msg.GetResult() != nil:
+			res = msg.GetResult()
+		}
+	}
+}
+
+// Compile runs the given compile request through the daemon, the output of the
+// compilation is streamed to outStream and errStream.
+func (c *Client) Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream io.Writer) (*rpc.CompileResponse, error) {
+	req = absolutizeCompileRequestPaths(req)
+	req.Instance = c.instance
+	stream, err := c.service.Compile(ctx, req)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	res := &rpc.CompileResponse{}
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+		if err != nil {
+			return res, convertError(err)
+		}
+		switch {
+		case len(msg.GetOutStream()) > 0:
+			outStream.Write(msg.GetOutStream())
+		case len(msg.GetErrStream()) > 0:
+			errStream.Write(msg.GetErrStream())
+		case msg.GetProgress() != nil:
+			// progress is not reported by the CLI
+		default:
+			res = msg
+		}
+	}
+}
+
+// absolutizeCompileRequestPaths returns a copy of the request with all the paths
+// converted to absolute paths, since the daemon runs in a different working directory.
+func absolutizeCompileRequestPaths(req *rpc.CompileRequest) *rpc.CompileRequest {
+	absAll := func(list []string) []string {
+		res := []string{}
+		for _, p := range list {
+			res = append(res, abs(p))
+		}
+		return res
+	}
+	res := proto.Clone(req).(*rpc.CompileRequest)
+	res.SketchPath = abs(res.GetSketchPath())
+	res.BuildPath = abs(res.GetBuildPath())
+	res.BuildCachePath = abs(res.GetBuildCachePath())
+	res.ExportDir = abs(res.GetExportDir())
+	res.KeysKeychain = abs(res.GetKeysKeychain())
+	res.CompareWith = abs(res.GetCompareWith())
+	res.Libraries = absAll(res.GetLibraries())
+	res.Library = absAll(res.GetLibrary())
+	return res
+}
+
+// absolutizeUploadRequestPaths returns a copy of the request with all the paths
+// converted to absolute paths, since the daemon runs in a different working directory.
+func absolutizeUploadRequestPaths(req *rpc.UploadRequest) *rpc.UploadRequest {
+	res := proto.Clone(req).(*rpc.UploadRequest)
+	res.SketchPath = abs(res.GetSketchPath())
+	res.ImportFile = abs(res.GetImportFile())
+	res.ImportDir = abs(res.GetImportDir())
+	return res
+}
+
+func abs(p string) string {
+	if p == "" {
+		return p
+	}
+	if a, err := paths.New(p).Abs(); err == nil {
+		return a.String()
+	}
+	return p
+}
+
+// convertError extracts the error message from a gRPC status error.
+func convertError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return errors.New(st.Message())
+	}
+	return err
+}
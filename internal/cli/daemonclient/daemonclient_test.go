@@ -0,0 +1,345 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemonclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/version"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// TestMain runs the test binary as a fake background daemon when it's
+// started by startDaemon
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == "daemon" {
+		runFakeDaemon(os.Args[2:])
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// fakeToken is the access token required by the fake daemons
+const fakeToken = "1234"
+
+// fakeService is a daemon that can initialize and destroy the instances,
+// list the ports and upload
+type fakeService struct {
+	rpc.UnimplementedArduinoCoreServiceServer
+	inits     int
+	profile   string
+	destroyed []int32
+	upload    *rpc.UploadRequest
+}
+
+func (s *fakeService) Create(ctx context.Context, req *rpc.CreateRequest) (*rpc.CreateResponse, error) {
+	return &rpc.CreateResponse{Instance: &rpc.Instance{Id: 2}}, nil
+}
+
+func (s *fakeService) Init(req *rpc.InitRequest, stream rpc.ArduinoCoreService_InitServer) error {
+	s.inits++
+	if req.GetProfile() != "" {
+		s.profile = req.GetProfile()
+		stream.Send(&rpc.InitResponse{Message: &rpc.InitResponse_Profile{Profile: &rpc.Profile{Name: req.GetProfile(), Fqbn: "arduino:avr:uno"}}})
+	}
+	return nil
+}
+
+func (s *fakeService) Destroy(ctx context.Context, req *rpc.DestroyRequest) (*rpc.DestroyResponse, error) {
+	s.destroyed = append(s.destroyed, req.GetInstance().GetId())
+	return &rpc.DestroyResponse{}, nil
+}
+
+func (s *fakeService) BoardList(ctx context.Context, req *rpc.BoardListRequest) (*rpc.BoardListResponse, error) {
+	port := &rpc.Port{Address: "/dev/ttyACM0", Protocol: "serial"}
+	return &rpc.BoardListResponse{Ports: []*rpc.DetectedPort{{Port: port}}}, nil
+}
+
+func (s *fakeService) Upload(req *rpc.UploadRequest, stream rpc.ArduinoCoreService_UploadServer) error {
+	s.upload = req
+	stream.Send(&rpc.UploadResponse{Message: &rpc.UploadResponse_OutStream{OutStream: []byte("Writing | 100%")}})
+	stream.Send(&rpc.UploadResponse{Message: &rpc.UploadResponse_Progress{Progress: &rpc.TaskProgress{Percent: 100, Completed: true}}})
+	stream.Send(&rpc.UploadResponse{Message: &rpc.UploadResponse_Result{Result: &rpc.UploadResult{UpdatedUploadPort: &rpc.Port{Address: "/dev/ttyACM1"}}}})
+	return nil
+}
+
+func newFakeServer(token string) *grpc.Server {
+	unary, stream := TokenInterceptors(token)
+	return grpc.NewServer(grpc.UnaryInterceptor(unary), grpc.StreamInterceptor(stream))
+}
+
+func startFakeService(t *testing.T) (string, *fakeService) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := newFakeServer(fakeToken)
+	service := &fakeService{}
+	rpc.RegisterArduinoCoreServiceServer(server, service)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String(), service
+}
+
+func runFakeDaemon(args []string) {
+	var infoFile, configFile string
+	for i := 0; i+1 < len(args); i++ {
+		switch args[i] {
+		case "--info-file":
+			infoFile = args[i+1]
+		case "--config-file":
+			configFile = args[i+1]
+		}
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		os.Exit(1)
+	}
+	server := newFakeServer(fakeToken)
+	rpc.RegisterArduinoCoreServiceServer(server, &fakeService{})
+	// The daemon is detached, it must not outlive the tests
+	time.AfterFunc(time.Minute, server.Stop)
+	info := &Info{
+		Address:    lis.Addr().String(),
+		PID:        os.Getpid(),
+		Version:    version.VersionInfo.VersionString,
+		ConfigFile: configFile,
+		Instance:   1,
+		Token:      fakeToken,
+	}
+	if err := WriteInfo(paths.New(infoFile), info); err != nil {
+		os.Exit(1)
+	}
+	server.Serve(lis)
+}
+
+func setupSettings(t *testing.T) *paths.Path {
+	dataDir := paths.New(t.TempDir())
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.data", dataDir.String())
+	configuration.Settings.Set("directories.user", dataDir.Join("user").String())
+	return dataDir
+}
+
+func TestAbsolutizeCompileRequestPaths(t *testing.T) {
+	abs := func(p string) string {
+		a, err := paths.New(p).Abs()
+		require.NoError(t, err)
+		return a.String()
+	}
+	req := &rpc.CompileRequest{
+		Fqbn:           "arduino:avr:uno",
+		SketchPath:     "Blink",
+		BuildPath:      "build",
+		BuildCachePath: "cache",
+		ExportDir:      "export",
+		KeysKeychain:   "keys",
+		CompareWith:    "old/Blink.ino.elf",
+		Libraries:      []string{"libs"},
+		Library:        []string{"libs/MyLib", "/abs/OtherLib"},
+		SourceOverride: map[string]string{"Blink.ino": "void setup() {}"},
+	}
+	res := absolutizeCompileRequestPaths(req)
+	require.Equal(t, abs("Blink"), res.GetSketchPath())
+	require.Equal(t, abs("build"), res.GetBuildPath())
+	require.Equal(t, abs("cache"), res.GetBuildCachePath())
+	require.Equal(t, abs("export"), res.GetExportDir())
+	require.Equal(t, abs("keys"), res.GetKeysKeychain())
+	require.Equal(t, abs("old/Blink.ino.elf"), res.GetCompareWith())
+	require.Equal(t, []string{abs("libs")}, res.GetLibraries())
+	require.Equal(t, []string{abs("libs/MyLib"), abs("/abs/OtherLib")}, res.GetLibrary())
+	require.Equal(t, "arduino:avr:uno", res.GetFqbn())
+	require.Equal(t, req.GetSourceOverride(), res.GetSourceOverride())
+
+	// The request is not changed and the empty paths are kept empty
+	require.Equal(t, "Blink", req.GetSketchPath())
+	res = absolutizeCompileRequestPaths(&rpc.CompileRequest{SketchPath: "Blink"})
+	require.Empty(t, res.GetBuildPath())
+	require.Empty(t, res.GetCompareWith())
+}
+
+func TestConnectToRunningDaemon(t *testing.T) {
+	dataDir := setupSettings(t)
+	infoFile := dataDir.Join("daemon.json")
+	require.Equal(t, infoFile, InfoFile())
+	address, _ := startFakeService(t)
+	ctx := context.Background()
+
+	_, _, err := connectToRunningDaemon(ctx, infoFile, "")
+	require.Error(t, err)
+
+	// The daemon must have the same version and configuration file
+	info := &Info{Address: address, Version: "0.0.0-other", Instance: 3}
+	require.NoError(t, WriteInfo(infoFile, info))
+	_, _, err = connectToRunningDaemon(ctx, infoFile, "")
+	require.ErrorContains(t, err, "different version")
+
+	info.Version = version.VersionInfo.VersionString
+	require.NoError(t, WriteInfo(infoFile, info))
+	_, _, err = connectToRunningDaemon(ctx, infoFile, "/other/arduino-cli.yaml")
+	require.ErrorContains(t, err, "different configuration file")
+
+	// The daemon must require an access token
+	_, _, err = connectToRunningDaemon(ctx, infoFile, "")
+	require.ErrorContains(t, err, "doesn't require an access token")
+
+	// The calls with a wrong token are refused
+	info.Token = "wrong"
+	require.NoError(t, WriteInfo(infoFile, info))
+	client, _, err := connectToRunningDaemon(ctx, infoFile, "")
+	require.NoError(t, err)
+	_, err = client.BoardList(ctx, time.Second)
+	require.ErrorContains(t, err, "Missing or invalid access token")
+	client.Close()
+
+	info.Token = fakeToken
+	require.NoError(t, WriteInfo(infoFile, info))
+	if runtime.GOOS != "windows" {
+		stat, err := infoFile.Stat()
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+	}
+
+	client, res, err := connectToRunningDaemon(ctx, infoFile, "")
+	require.NoError(t, err)
+	defer client.Close()
+	_, err = client.BoardList(ctx, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), client.instance.GetId())
+	require.Equal(t, info, res)
+
+	// A daemon that is not running anymore
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	info.Address = lis.Addr().String()
+	require.NoError(t, lis.Close())
+	require.NoError(t, WriteInfo(infoFile, info))
+	_, _, err = connectToRunningDaemon(ctx, infoFile, "")
+	require.Error(t, err)
+}
+
+func TestConnectReinitializesTheInstance(t *testing.T) {
+	dataDir := setupSettings(t)
+	require.NoError(t, dataDir.Join("package_index.json").WriteFile([]byte("{}")))
+	address, service := startFakeService(t)
+	info := &Info{Address: address, Version: version.VersionInfo.VersionString, Instance: 1, Token: fakeToken}
+	require.NoError(t, WriteInfo(dataDir.Join("daemon.json"), info))
+
+	client, err := Connect(context.Background())
+	require.NoError(t, err)
+	client.Close()
+	require.Equal(t, 1, service.inits)
+	info, err = readInfo(dataDir.Join("daemon.json"))
+	require.NoError(t, err)
+	require.Equal(t, InstallationFingerprint(), info.Fingerprint)
+
+	// Nothing changed, the instance is reused as is
+	client, err = Connect(context.Background())
+	require.NoError(t, err)
+	client.Close()
+	require.Equal(t, 1, service.inits)
+
+	// The index has been updated
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(dataDir.Join("package_index.json").String(), later, later))
+	client, err = Connect(context.Background())
+	require.NoError(t, err)
+	client.Close()
+	require.Equal(t, 2, service.inits)
+}
+
+func TestConnectAutostart(t *testing.T) {
+	dataDir := setupSettings(t)
+	infoFile := dataDir.Join("daemon.json")
+
+	// A stale info file is replaced by the one of the new daemon
+	require.NoError(t, WriteInfo(infoFile, &Info{Address: "127.0.0.1:1", Version: version.VersionInfo.VersionString, Token: fakeToken}))
+	client, err := Connect(context.Background())
+	require.NoError(t, err)
+	client.Close()
+	info, err := readInfo(infoFile)
+	require.NoError(t, err)
+	require.NotEqual(t, "127.0.0.1:1", info.Address)
+	require.NotEqual(t, os.Getpid(), info.PID)
+	if proc, err := os.FindProcess(info.PID); err == nil {
+		defer proc.Kill()
+	}
+
+	// The running daemon is reused
+	client, err = Connect(context.Background())
+	require.NoError(t, err)
+	client.Close()
+	again, err := readInfo(infoFile)
+	require.NoError(t, err)
+	require.Equal(t, info.PID, again.PID)
+}
+
+func TestClientUploadAndProfile(t *testing.T) {
+	dataDir := setupSettings(t)
+	address, service := startFakeService(t)
+	info := &Info{Address: address, Version: version.VersionInfo.VersionString, Instance: 1, Token: fakeToken}
+	require.NoError(t, WriteInfo(dataDir.Join("daemon.json"), info))
+	ctx := context.Background()
+
+	client, err := Connect(ctx)
+	require.NoError(t, err)
+
+	// The ports are detected by the daemon
+	ports, err := client.BoardList(ctx, time.Second)
+	require.NoError(t, err)
+	require.Len(t, ports, 1)
+	require.Equal(t, "/dev/ttyACM0", ports[0].GetPort().GetAddress())
+
+	// The profile is loaded in a new instance of the daemon
+	var profile *rpc.Profile
+	err = client.UseProfile(ctx, paths.New("Blink"), "uno", func(r *rpc.InitResponse) {
+		if p := r.GetProfile(); p != nil {
+			profile = p
+		}
+	})
+	require.NoError(t, err)
+	require.Equal(t, "uno", service.profile)
+	require.Equal(t, "arduino:avr:uno", profile.GetFqbn())
+
+	// The upload runs with the instance of the profile
+	out := &bytes.Buffer{}
+	var progress []*rpc.TaskProgress
+	res, err := client.Upload(ctx, &rpc.UploadRequest{Fqbn: "arduino:avr:uno", SketchPath: "Blink", ImportDir: "build"}, out, io.Discard,
+		func(p *rpc.TaskProgress) { progress = append(progress, p) })
+	require.NoError(t, err)
+	require.Equal(t, "/dev/ttyACM1", res.GetUpdatedUploadPort().GetAddress())
+	require.Equal(t, "Writing | 100%", out.String())
+	require.Len(t, progress, 1)
+	require.Equal(t, int32(2), service.upload.GetInstance().GetId())
+	sketchAbs, err := paths.New("Blink").Abs()
+	require.NoError(t, err)
+	require.Equal(t, sketchAbs.String(), service.upload.GetSketchPath())
+
+	// The instance of the profile is destroyed when the client is closed
+	client.Close()
+	require.Equal(t, []int32{2}, service.destroyed)
+}
@@ -0,0 +1,76 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initApplyCommand() *cobra.Command {
+	var requirementsFile string
+	var prune bool
+	var scriptFlags arguments.PrePostScriptsFlags
+	applyCommand := &cobra.Command{
+		Use:   "apply",
+		Short: tr("Converges the installed cores and libraries to the requirements file."),
+		Long: tr(`Converges the installed cores and libraries to the requirements file:
+the missing ones are installed and the ones installed at a version other
+than the required one are replaced. With --prune the cores and libraries not
+listed in the requirements file are removed too, except the dependencies of
+the listed libraries.`),
+		Example: "" +
+			"  " + os.Args[0] + " env apply -r requirements.yaml\n" +
+			"  " + os.Args[0] + " env apply -r requirements.yaml --prune",
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			arguments.CheckFlagsConflicts(cmd, "run-post-install", "skip-post-install")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			runApplyCommand(requirementsFile, prune, scriptFlags)
+		},
+	}
+	AddRequirementsFlag(applyCommand, &requirementsFile)
+	applyCommand.Flags().BoolVar(&prune, "prune", false, tr("Remove the cores and libraries not listed in the requirements file."))
+	scriptFlags.AddToCommand(applyCommand)
+	return applyCommand
+}
+
+func runApplyCommand(requirementsFile string, prune bool, scriptFlags arguments.PrePostScriptsFlags) {
+	logrus.Info("Executing `arduino-cli env apply`")
+
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	reqs := LoadRequirements(requirementsFile)
+	inst := instance.CreateAndInit()
+	changes := Plan(ctx, inst, reqs, prune)
+	Apply(ctx, inst, changes, scriptFlags)
+	feedback.PrintResult(NewAppliedChangesResult(changes))
+}
+
+// AddRequirementsFlag adds the flag selecting the requirements file
+func AddRequirementsFlag(cmd *cobra.Command, requirementsFile *string) {
+	cmd.Flags().StringVarP(requirementsFile, "requirements", "r", "", tr("The requirements file listing the cores and libraries to install."))
+	cmd.MarkFlagRequired("requirements")
+}
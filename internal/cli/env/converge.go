@@ -0,0 +1,189 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/requirements"
+	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	clicore "github.com/arduino/arduino-cli/internal/cli/core"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	clilib "github.com/arduino/arduino-cli/internal/cli/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// LoadRequirements reads the requirements file and adds its additional URLs
+// to the ones of the configuration. The additional URLs are needed only for
+// this run, they're not saved in the configuration file.
+func LoadRequirements(requirementsFile string) *requirements.Requirements {
+	reqs, err := requirements.Load(paths.New(requirementsFile))
+	if err != nil {
+		feedback.Fatal(tr("Error reading requirements file: %v", err), feedback.ErrBadArgument)
+	}
+	urls := configuration.Settings.GetStringSlice("board_manager.additional_urls")
+	for _, url := range reqs.AdditionalURLs {
+		if !slices.Contains(urls, url) {
+			urls = append(urls, url)
+		}
+	}
+	configuration.Settings.Set("board_manager.additional_urls", urls)
+	return reqs
+}
+
+// Plan returns the changes needed to converge the installed cores and
+// libraries to the requirements. If prune is true the cores and the libraries
+// installed by the user that are not required are removed, the dependencies
+// of the required libraries are kept.
+func Plan(ctx context.Context, inst *rpc.Instance, reqs *requirements.Requirements, prune bool) []*requirements.Change {
+	installedPlatforms := []*requirements.Installed{}
+	for _, platform := range clicore.GetList(inst, false, false) {
+		if version, err := semver.Parse(platform.GetInstalledVersion()); err == nil {
+			installedPlatforms = append(installedPlatforms, &requirements.Installed{Name: platform.GetMetadata().GetId(), Version: version})
+		}
+	}
+
+	libs, err := lib.LibraryList(ctx, &rpc.LibraryListRequest{Instance: inst})
+	if err != nil {
+		feedback.Fatal(tr("Error listing libraries: %v", err), feedback.ErrGeneric)
+	}
+	installedLibraries := []*requirements.Installed{}
+	for _, installedLib := range libs.GetInstalledLibraries() {
+		version, _ := semver.Parse(installedLib.GetLibrary().GetVersion())
+		installedLibraries = append(installedLibraries, &requirements.Installed{Name: installedLib.GetLibrary().GetName(), Version: version})
+	}
+
+	var dependencies []string
+	if prune {
+		dependencies = libraryDependencies(ctx, inst, reqs)
+	}
+	return reqs.Diff(installedPlatforms, installedLibraries, dependencies, prune)
+}
+
+// libraryDependencies returns the names of the libraries needed by the
+// required libraries, as listed in the libraries index.
+func libraryDependencies(ctx context.Context, inst *rpc.Instance, reqs *requirements.Requirements) []string {
+	res := []string{}
+	for _, library := range reqs.Libraries {
+		deps, err := lib.LibraryResolveDependencies(ctx, &rpc.LibraryResolveDependenciesRequest{
+			Instance: inst,
+			Name:     library.Name,
+			Version:  versionString(library.Version),
+		})
+		if err != nil {
+			// The library is not in the index, it has no known dependencies
+			continue
+		}
+		for _, dep := range deps.GetDependencies() {
+			res = append(res, dep.GetName())
+		}
+	}
+	return res
+}
+
+// Apply performs the changes on the installation. The indexes are updated
+// only if a core or a library must be installed.
+func Apply(ctx context.Context, inst *rpc.Instance, changes []*requirements.Change, scriptFlags arguments.PrePostScriptsFlags) {
+	installsPlatforms, installsLibraries := false, false
+	for _, change := range changes {
+		if change.Action == requirements.Install || change.Action == requirements.Replace {
+			installsPlatforms = installsPlatforms || change.Kind == requirements.PlatformKind
+			installsLibraries = installsLibraries || change.Kind == requirements.LibraryKind
+		}
+	}
+	if installsPlatforms {
+		clicore.UpdateIndex(inst)
+	}
+	if installsLibraries {
+		clilib.UpdateIndex(inst)
+	}
+	if installsPlatforms || installsLibraries {
+		instance.Init(inst)
+	}
+
+	// The removals are performed first to not remove the dependencies of the
+	// installed platforms and libraries
+	for _, change := range changes {
+		if change.Action == requirements.Remove {
+			applyChange(ctx, inst, change, scriptFlags)
+		}
+	}
+	for _, change := range changes {
+		if change.Action == requirements.Install || change.Action == requirements.Replace {
+			applyChange(ctx, inst, change, scriptFlags)
+		}
+	}
+}
+
+func applyChange(ctx context.Context, inst *rpc.Instance, change *requirements.Change, scriptFlags arguments.PrePostScriptsFlags) {
+	var err error
+	switch change.Kind {
+	case requirements.PlatformKind:
+		packageName, architecture, _ := strings.Cut(change.Name, ":")
+		if change.Action == requirements.Remove {
+			_, err = core.PlatformUninstall(ctx, &rpc.PlatformUninstallRequest{
+				Instance:         inst,
+				PlatformPackage:  packageName,
+				Architecture:     architecture,
+				SkipPreUninstall: scriptFlags.DetectSkipPreUninstallValue(),
+			}, feedback.NewTaskProgressCB())
+		} else {
+			_, err = core.PlatformInstall(ctx, &rpc.PlatformInstallRequest{
+				Instance:         inst,
+				PlatformPackage:  packageName,
+				Architecture:     architecture,
+				Version:          versionString(change.Version),
+				SkipPostInstall:  scriptFlags.DetectSkipPostInstallValue(),
+				SkipPreUninstall: scriptFlags.DetectSkipPreUninstallValue(),
+			}, feedback.ProgressBar(), feedback.TaskProgress())
+		}
+	case requirements.LibraryKind:
+		if change.Action == requirements.Remove {
+			err = lib.LibraryUninstall(ctx, &rpc.LibraryUninstallRequest{
+				Instance: inst,
+				Name:     change.Name,
+			}, feedback.TaskProgress())
+		} else {
+			err = lib.LibraryInstall(ctx, &rpc.LibraryInstallRequest{
+				Instance:        inst,
+				Name:            change.Name,
+				Version:         versionString(change.Version),
+				InstallLocation: rpc.LibraryInstallLocation_LIBRARY_INSTALL_LOCATION_USER,
+			}, feedback.ProgressBar(), feedback.TaskProgress())
+		}
+	}
+	if err != nil {
+		if change.Action == requirements.Remove {
+			feedback.Fatal(tr("Error removing %[1]s: %[2]v", change.Name, err), feedback.ErrGeneric)
+		}
+		feedback.Fatal(tr("Error installing %[1]s: %[2]v", change.Name, err), feedback.ErrGeneric)
+	}
+}
+
+func versionString(version *semver.Version) string {
+	if version == nil {
+		return ""
+	}
+	return version.String()
+}
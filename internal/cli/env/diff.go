@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/requirements"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initDiffCommand() *cobra.Command {
+	var requirementsFile string
+	var prune bool
+	var exitCode bool
+	diffCommand := &cobra.Command{
+		Use:   "diff",
+		Short: tr("Shows the changes env apply would make to the installed cores and libraries."),
+		Long:  tr("Shows the changes env apply would make to the installed cores and libraries, without making them."),
+		Example: "" +
+			"  " + os.Args[0] + " env diff -r requirements.yaml --prune\n" +
+			"  " + os.Args[0] + " env diff -r requirements.yaml --exit-code",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runDiffCommand(requirementsFile, prune, exitCode)
+		},
+	}
+	AddRequirementsFlag(diffCommand, &requirementsFile)
+	diffCommand.Flags().BoolVar(&prune, "prune", false, tr("Show also the cores and libraries not listed in the requirements file that would be removed."))
+	diffCommand.Flags().BoolVar(&exitCode, "exit-code", false, tr("Exit with an error status if the installation differs from the requirements file."))
+	return diffCommand
+}
+
+func runDiffCommand(requirementsFile string, prune, exitCode bool) {
+	logrus.Info("Executing `arduino-cli env diff`")
+
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	reqs := LoadRequirements(requirementsFile)
+	inst := instance.CreateAndInit()
+	changes := Plan(ctx, inst, reqs, prune)
+	res := &ChangesResult{Changes: newChangeResults(changes)}
+	if exitCode && requirements.HasChanges(changes) {
+		res.Error = tr("The installed cores and libraries differ from the requirements file")
+		feedback.FatalResult(res, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(res)
+}
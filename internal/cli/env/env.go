@@ -0,0 +1,45 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `env` command
+func NewCommand() *cobra.Command {
+	envCommand := &cobra.Command{
+		Use:   "env",
+		Short: tr("Declarative management of the installed cores and libraries."),
+		Long: tr(`Declarative management of the installed cores and libraries.
+The environment is declared in a requirements file, the same used by the
+install command, listing the additional Boards Manager URLs, the cores and
+the libraries that must be installed.`),
+		Example: "" +
+			"  " + os.Args[0] + " env diff -r requirements.yaml --prune\n" +
+			"  " + os.Args[0] + " env apply -r requirements.yaml --prune",
+	}
+
+	envCommand.AddCommand(initApplyCommand())
+	envCommand.AddCommand(initDiffCommand())
+
+	return envCommand
+}
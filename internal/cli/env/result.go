@@ -0,0 +1,102 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"github.com/arduino/arduino-cli/arduino/requirements"
+	"github.com/arduino/arduino-cli/table"
+)
+
+// ChangeResult is a change of the installed cores and libraries
+type ChangeResult struct {
+	Action           string `json:"action"`
+	Kind             string `json:"kind"`
+	Name             string `json:"name"`
+	InstalledVersion string `json:"installed_version,omitempty"`
+	Version          string `json:"version,omitempty"`
+}
+
+// NewAppliedChangesResult returns the result of the changes applied
+func NewAppliedChangesResult(changes []*requirements.Change) *ChangesResult {
+	return &ChangesResult{Changes: newChangeResults(changes), applied: true}
+}
+
+func newChangeResults(changes []*requirements.Change) []*ChangeResult {
+	res := []*ChangeResult{}
+	for _, change := range changes {
+		res = append(res, &ChangeResult{
+			Action:           string(change.Action),
+			Kind:             string(change.Kind),
+			Name:             change.Name,
+			InstalledVersion: versionString(change.InstalledVersion),
+			Version:          versionString(change.Version),
+		})
+	}
+	return res
+}
+
+// ChangesResult is the output of the changes planned or applied
+type ChangesResult struct {
+	Changes []*ChangeResult `json:"changes"`
+	Error   string          `json:"error,omitempty"`
+	applied bool
+}
+
+// Data implements feedback.Result
+func (r *ChangesResult) Data() interface{} {
+	return r
+}
+
+// ErrorString implements feedback.ErrorResult
+func (r *ChangesResult) ErrorString() string {
+	return r.Error
+}
+
+func (r *ChangesResult) String() string {
+	if len(r.Changes) == 0 {
+		return tr("The requirements file is empty.")
+	}
+	t := table.New()
+	t.SetHeader(tr("Name"), tr("Type"), tr("Installed"), tr("Required"), tr("Action"))
+	for _, change := range r.Changes {
+		required := change.Version
+		if required == "" && change.Action != string(requirements.Remove) {
+			required = tr("any")
+		}
+		action := ""
+		switch requirements.Action(change.Action) {
+		case requirements.Keep:
+			action = tr("up to date")
+		case requirements.Install:
+			action = tr("install")
+			if r.applied {
+				action = tr("installed")
+			}
+		case requirements.Replace:
+			action = tr("replace")
+			if r.applied {
+				action = tr("replaced")
+			}
+		case requirements.Remove:
+			action = tr("remove")
+			if r.applied {
+				action = tr("removed")
+			}
+		}
+		t.AddRow(change.Name, change.Kind, change.InstalledVersion, required, action)
+	}
+	return t.Render()
+}
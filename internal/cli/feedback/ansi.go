@@ -0,0 +1,96 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package feedback
+
+import (
+	"io"
+)
+
+// ansiStripWriter removes the ANSI/VT100 escape sequences (colors, cursor
+// movements, window titles...) from the data written to it. The state is kept
+// between the writes, so the sequences split across them are removed too.
+type ansiStripWriter struct {
+	writer io.Writer
+	state  ansiState
+}
+
+type ansiState int
+
+const (
+	ansiText         ansiState = iota
+	ansiEscape                 // after ESC
+	ansiIntermediate           // after ESC and an intermediate byte, e.g. ESC ( B
+	ansiCSI                    // Control Sequence Introducer: ESC [ params final
+	ansiString                 // OSC, DCS, PM, APC: ESC ] ... BEL or ESC \
+	ansiStringEscape           // ESC inside a string sequence
+)
+
+// NewANSIStripWriter returns a writer that forwards the data to writer with
+// all the ANSI escape sequences removed.
+func NewANSIStripWriter(writer io.Writer) io.Writer {
+	return &ansiStripWriter{writer: writer}
+}
+
+func (a *ansiStripWriter) Write(p []byte) (int, error) {
+	out := make([]byte, 0, len(p))
+	for _, b := range p {
+		switch a.state {
+		case ansiText:
+			if b == 0x1b {
+				a.state = ansiEscape
+			} else {
+				out = append(out, b)
+			}
+		case ansiEscape:
+			switch {
+			case b == '[':
+				a.state = ansiCSI
+			case b == ']' || b == 'P' || b == '^' || b == '_':
+				a.state = ansiString
+			case b >= 0x20 && b <= 0x2f:
+				a.state = ansiIntermediate
+			case b == 0x1b:
+				// A new sequence starts
+			default:
+				a.state = ansiText
+			}
+		case ansiIntermediate:
+			if b < 0x20 || b > 0x2f {
+				a.state = ansiText
+			}
+		case ansiCSI:
+			if b >= 0x40 && b <= 0x7e {
+				a.state = ansiText
+			}
+		case ansiString:
+			if b == 0x07 {
+				a.state = ansiText
+			} else if b == 0x1b {
+				a.state = ansiStringEscape
+			}
+		case ansiStringEscape:
+			if b == '\\' {
+				a.state = ansiText
+			} else if b != 0x1b {
+				a.state = ansiString
+			}
+		}
+	}
+	if _, err := a.writer.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
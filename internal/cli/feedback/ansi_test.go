@@ -0,0 +1,42 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package feedback
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestANSIStripWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writer := NewANSIStripWriter(buf)
+
+	n, err := writer.Write([]byte("\x1b[1;31mERROR\x1b[0m boot failed\r\n"))
+	require.NoError(t, err)
+	require.Equal(t, 30, n)
+	require.Equal(t, "ERROR boot failed\r\n", buf.String())
+
+	// Sequences split across the writes
+	buf.Reset()
+	writer.Write([]byte("\x1b"))
+	writer.Write([]byte("[32"))
+	writer.Write([]byte("mOK\x1b]0;title"))
+	writer.Write([]byte("\x07 done\x1b]8;;url\x1b"))
+	writer.Write([]byte("\\link\x1b(B\x1b7.\x1b[2K"))
+	require.Equal(t, "OK donelink.", buf.String())
+}
@@ -139,8 +139,10 @@ func SetFormat(f OutputFormat) {
 		feedbackOut = io.MultiWriter(bufferOut, stdOut)
 		feedbackErr = io.MultiWriter(bufferErr, stdErr)
 	} else {
-		feedbackOut = bufferOut
-		feedbackErr = bufferErr
+		// The output is embedded in a machine readable result, the
+		// escape sequences of the colored text must not end up in it
+		feedbackOut = NewANSIStripWriter(bufferOut)
+		feedbackErr = NewANSIStripWriter(bufferErr)
 		bufferWarnings = nil
 	}
 }
@@ -170,6 +172,30 @@ func Warning(msg string) {
 	logrus.Warning(msg)
 }
 
+// exitHooks are the functions called before terminating the process with exit.
+var exitHooks []func()
+
+// OnExit registers a function that is called before the process is terminated
+// by one of the Fatal functions. Hooks are called in reverse order of registration.
+func OnExit(hook func()) {
+	exitHooks = append(exitHooks, hook)
+}
+
+// RunExitHooks calls the registered exit hooks, each hook is called only once.
+func RunExitHooks() {
+	for len(exitHooks) > 0 {
+		hook := exitHooks[len(exitHooks)-1]
+		exitHooks = exitHooks[:len(exitHooks)-1]
+		hook()
+	}
+}
+
+// exit runs the exit hooks and terminates the process with status exitCode.
+func exit(exitCode ExitCode) {
+	RunExitHooks()
+	os.Exit(int(exitCode))
+}
+
 // FatalError outputs the error and exits with status exitCode.
 func FatalError(err error, exitCode ExitCode) {
 	Fatal(err.Error(), exitCode)
@@ -178,14 +204,14 @@ func FatalError(err error, exitCode ExitCode) {
 // FatalResult outputs the result and exits with status exitCode.
 func FatalResult(res ErrorResult, exitCode ExitCode) {
 	PrintResult(res)
-	os.Exit(int(exitCode))
+	exit(exitCode)
 }
 
 // Fatal outputs the errorMsg and exits with status exitCode.
 func Fatal(errorMsg string, exitCode ExitCode) {
 	if format == Text {
 		fmt.Fprintln(stdErr, errorMsg)
-		os.Exit(int(exitCode))
+		exit(exitCode)
 	}
 
 	type FatalError struct {
@@ -210,7 +236,7 @@ func Fatal(errorMsg string, exitCode ExitCode) {
 		panic("unknown output format")
 	}
 	fmt.Fprintln(stdErr, string(d))
-	os.Exit(int(exitCode))
+	exit(exitCode)
 }
 
 func augment(data interface{}) interface{} {
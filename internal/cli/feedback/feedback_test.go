@@ -106,6 +106,22 @@ func TestJsonOutputOnCustomStreams(t *testing.T) {
 	require.Equal(t, myErr.String(), "")
 }
 
+func TestExitHooks(t *testing.T) {
+	calls := []string{}
+	OnExit(func() { calls = append(calls, "first") })
+	OnExit(func() {
+		calls = append(calls, "second")
+		// Hooks registered while running the hooks are called too
+		OnExit(func() { calls = append(calls, "third") })
+	})
+
+	// The hooks are called in reverse order of registration, only once
+	RunExitHooks()
+	require.Equal(t, []string{"second", "third", "first"}, calls)
+	RunExitHooks()
+	require.Equal(t, []string{"second", "third", "first"}, calls)
+}
+
 type testResult struct {
 	Success bool                 `json:"success"`
 	Output  *OutputStreamsResult `json:"output,omitempty"`
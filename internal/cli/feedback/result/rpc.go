@@ -29,6 +29,11 @@ func NewPlatformResult(in *rpc.PlatformSummary) *Platform {
 	}
 	releases.SortKeys((*semver.Version).CompareTo)
 
+	var pinnedVersion *semver.Version
+	if in.PinnedVersion != "" {
+		pinnedVersion = semver.MustParse(in.PinnedVersion)
+	}
+
 	return &Platform{
 		Id:                in.Metadata.Id,
 		Maintainer:        in.Metadata.Maintainer,
@@ -40,6 +45,7 @@ func NewPlatformResult(in *rpc.PlatformSummary) *Platform {
 		Releases:          releases,
 		InstalledVersion:  semver.MustParse(in.InstalledVersion),
 		LatestVersion:     semver.MustParse(in.LatestVersion),
+		PinnedVersion:     pinnedVersion,
 	}
 }
 
@@ -57,6 +63,7 @@ type Platform struct {
 
 	InstalledVersion *semver.Version `json:"installed_version,omitempty"`
 	LatestVersion    *semver.Version `json:"latest_version,omitempty"`
+	PinnedVersion    *semver.Version `json:"pinned_version,omitempty"`
 }
 
 // GetLatestRelease returns the latest relase of this platform or nil if none available.
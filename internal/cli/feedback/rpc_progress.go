@@ -16,10 +16,14 @@
 package feedback
 
 import (
+	"io"
+	"os"
 	"sync"
 
+	"github.com/arduino/arduino-cli/arduino/uploadprogress"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/cmaglie/pb"
+	"golang.org/x/term"
 )
 
 // ProgressBar returns a DownloadProgressCB that prints a progress bar.
@@ -98,3 +102,51 @@ func NewTaskProgressCB() func(curr *rpc.TaskProgress) {
 		}
 	}
 }
+
+// UploadProgress returns the streams and the callback to show the progress of
+// an upload with a progress bar, the lines of the tool output reporting the
+// progress are removed from the streams. The progress bar is shown only on a
+// terminal in text format, otherwise the streams are returned unchanged and
+// the callback is nil. The returned function must be called after the upload.
+func UploadProgress(stdOut, stdErr io.Writer) (io.Writer, io.Writer, rpc.TaskProgressCB, func()) {
+	if format != Text || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return stdOut, stdErr, nil, func() {}
+	}
+	out := uploadprogress.NewFilterWriter(stdOut, uploadprogress.Parsers())
+	err := uploadprogress.NewFilterWriter(stdErr, uploadprogress.Parsers())
+
+	var mux sync.Mutex
+	var bar *pb.ProgressBar
+	var stage string
+	progressCB := func(curr *rpc.TaskProgress) {
+		mux.Lock()
+		defer mux.Unlock()
+		if bar != nil && curr.GetName() != stage {
+			bar.Finish()
+			bar = nil
+		}
+		if bar == nil {
+			stage = curr.GetName()
+			bar = pb.New(100)
+			bar.Prefix(stage)
+			bar.ShowCounters = false
+			bar.Start()
+		}
+		bar.Set(int(curr.GetPercent()))
+		if curr.GetCompleted() {
+			bar.Finish()
+			bar = nil
+		}
+	}
+	done := func() {
+		mux.Lock()
+		if bar != nil {
+			bar.Finish()
+			bar = nil
+		}
+		mux.Unlock()
+		out.Close()
+		err.Close()
+	}
+	return out, err, progressCB, done
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"golang.org/x/term"
 )
@@ -73,12 +74,48 @@ func IsTerminal() bool {
 	return term.IsTerminal(int(os.Stdin.Fd()))
 }
 
+var nonInteractive bool
+var nonInteractiveAnswer bool
+
+// SetNonInteractive disables all the prompts: the yes/no questions get the
+// given answer without asking and the input of the user fields is refused.
+func SetNonInteractive(answer bool) {
+	nonInteractive = true
+	nonInteractiveAnswer = answer
+}
+
+// IsNonInteractive returns true if the prompts have been disabled with
+// SetNonInteractive
+func IsNonInteractive() bool {
+	return nonInteractive
+}
+
+// Confirm asks the user a yes/no question. In non interactive mode the answer
+// is the one given to SetNonInteractive and it's printed after the question.
+func Confirm(prompt string) (bool, error) {
+	if nonInteractive {
+		answer := tr("no")
+		if nonInteractiveAnswer {
+			answer = tr("yes")
+		}
+		if format == Text {
+			fmt.Fprintf(stdOut, "%s [y/N]: %s\n", prompt, answer)
+		}
+		return nonInteractiveAnswer, nil
+	}
+	answer, err := InputUserField(prompt+" [y/N]", false)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(strings.TrimSpace(answer), "y"), nil
+}
+
 // InputUserField prompts the user to input the provided user field.
 func InputUserField(prompt string, secret bool) (string, error) {
 	if format != Text {
 		return "", errors.New(tr("user input not supported for the '%s' output format", format))
 	}
-	if !IsTerminal() {
+	if nonInteractive || !IsTerminal() {
 		return "", errors.New(tr("user input not supported in non interactive mode"))
 	}
 
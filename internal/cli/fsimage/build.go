@@ -0,0 +1,97 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package fsimage
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/commands/fsimage"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initBuildCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var fsType string
+	var outputPath string
+	var verbose bool
+	buildCommand := &cobra.Command{
+		Use:   "build [SKETCH_PATH]",
+		Short: tr("Builds the filesystem image of the data folder of a sketch."),
+		Long:  tr("Packs the data folder of a sketch in a filesystem image, using the tool and the partition layout of the board."),
+		Example: "  " + os.Args[0] + " fsimage build MySketch -b esp32:esp32:esp32\n" +
+			"  " + os.Args[0] + " fsimage build MySketch -b esp32:esp32:esp32 --fs-type fatfs --output image.bin",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+			runBuildCommand(path, &fqbn, fsType, outputPath, verbose)
+		},
+	}
+	fqbn.AddToCommand(buildCommand)
+	addFsTypeFlag(buildCommand, &fsType)
+	buildCommand.Flags().StringVarP(&outputPath, "output", "o", "", tr("Path of the image to build, by default it's saved in the build directory of the sketch."))
+	buildCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return buildCommand
+}
+
+func addFsTypeFlag(cmd *cobra.Command, fsType *string) {
+	cmd.Flags().StringVar(fsType, "fs-type", "", tr("Filesystem type: littlefs, spiffs or fatfs. If not set the board default is used."))
+	cmd.RegisterFlagCompletionFunc("fs-type", cobra.FixedCompletions([]string{"littlefs", "spiffs", "fatfs"}, cobra.ShellCompDirectiveDefault))
+}
+
+func runBuildCommand(path string, fqbn *arguments.Fqbn, fsType, outputPath string, verbose bool) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli fsimage build`")
+
+	sketchPath := arguments.InitSketchPath(path, true)
+	stdOut, stdErr, _ := feedback.OutputStreams()
+	res, err := fsimage.Build(ctx, &rpc.FilesystemImageBuildRequest{
+		Instance:   inst,
+		Fqbn:       fqbn.String(),
+		SketchPath: sketchPath.String(),
+		FsType:     fsType,
+		OutputPath: outputPath,
+		Verbose:    verbose,
+	}, stdOut, stdErr)
+	if err != nil {
+		feedback.Fatal(tr("Error building filesystem image: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&buildResult{Image: newFsImageResult(res)})
+}
+
+type buildResult struct {
+	Image *fsImageResult `json:"image"`
+}
+
+func (r *buildResult) Data() interface{} {
+	return r
+}
+
+func (r *buildResult) String() string {
+	return tr("Built %s", r.Image)
+}
@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package fsimage
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `fsimage` command
+func NewCommand() *cobra.Command {
+	fsimageCommand := &cobra.Command{
+		Use:   "fsimage",
+		Short: tr("Arduino CLI filesystem image commands."),
+		Long:  tr("Build and upload the filesystem image (LittleFS, SPIFFS or FatFS) of the data folder of a sketch."),
+		Example: "  " + os.Args[0] + " fsimage build MySketch -b esp32:esp32:esp32\n" +
+			"  " + os.Args[0] + " fsimage upload MySketch -b esp32:esp32:esp32 -p /dev/ttyUSB0",
+	}
+
+	fsimageCommand.AddCommand(initBuildCommand())
+	fsimageCommand.AddCommand(initUploadCommand())
+
+	return fsimageCommand
+}
+
+// fsImageResult describes a filesystem image
+type fsImageResult struct {
+	ImagePath string `json:"image_path"`
+	FsType    string `json:"fs_type"`
+	Offset    uint64 `json:"offset"`
+	Size      uint64 `json:"size"`
+}
+
+func newFsImageResult(image *rpc.FilesystemImage) *fsImageResult {
+	return &fsImageResult{
+		ImagePath: image.GetImagePath(),
+		FsType:    image.GetFsType(),
+		Offset:    image.GetOffset(),
+		Size:      image.GetSize(),
+	}
+}
+
+func (r *fsImageResult) String() string {
+	return tr("%[1]s image %[2]s (%[3]d bytes at offset %[4]s)", r.FsType, r.ImagePath, r.Size, fmt.Sprintf("0x%X", r.Offset))
+}
@@ -0,0 +1,110 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package fsimage
+
+import (
+	"context"
+	"os"
+
+	sk "github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/commands/upload"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initUploadCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var port arguments.Port
+	var fsType string
+	var importFile string
+	var verbose bool
+	var dryRun bool
+	uploadCommand := &cobra.Command{
+		Use:   "upload [SKETCH_PATH]",
+		Short: tr("Uploads the filesystem image of the data folder of a sketch."),
+		Long:  tr("Builds the filesystem image of the data folder of a sketch and flashes it in the partition of the board reserved to the filesystem."),
+		Example: "  " + os.Args[0] + " fsimage upload MySketch -b esp32:esp32:esp32 -p /dev/ttyUSB0\n" +
+			"  " + os.Args[0] + " fsimage upload MySketch -b esp32:esp32:esp32 -p /dev/ttyUSB0 --input-file image.bin",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+			runUploadCommand(path, &fqbn, &port, fsType, importFile, verbose, dryRun)
+		},
+	}
+	fqbn.AddToCommand(uploadCommand)
+	port.AddToCommand(uploadCommand)
+	addFsTypeFlag(uploadCommand, &fsType)
+	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", tr("Already built filesystem image to upload."))
+	uploadCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	uploadCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Do not perform the actual upload, just log out actions"))
+	uploadCommand.Flags().MarkHidden("dry-run")
+	return uploadCommand
+}
+
+func runUploadCommand(path string, fqbnArg *arguments.Fqbn, portArgs *arguments.Port, fsType, importFile string, verbose, dryRun bool) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli fsimage upload`")
+
+	sketchPath := arguments.InitSketchPath(path, true)
+	sketch, err := sk.LoadSketch(context.Background(), &rpc.LoadSketchRequest{SketchPath: sketchPath.String()})
+	if err != nil {
+		feedback.Fatal(tr("Error loading sketch: %v", err), feedback.ErrGeneric)
+	}
+	fqbn, port := arguments.CalculateFQBNAndPort(portArgs, fqbnArg, inst, sketch.GetDefaultFqbn(), sketch.GetDefaultPort(), sketch.GetDefaultProtocol())
+
+	stdOut, stdErr, _ := feedback.OutputStreams()
+	res, err := upload.FilesystemImageUpload(ctx, &rpc.FilesystemImageUploadRequest{
+		Instance:   inst,
+		Fqbn:       fqbn,
+		SketchPath: sketchPath.String(),
+		Port:       port,
+		FsType:     fsType,
+		ImagePath:  importFile,
+		Verbose:    verbose,
+		DryRun:     dryRun,
+	}, stdOut, stdErr)
+	if err != nil {
+		feedback.FatalError(err, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&uploadResult{
+		Image:             newFsImageResult(res.GetImage()),
+		UpdatedUploadPort: res.GetUpdatedUploadPort().GetAddress(),
+	})
+}
+
+type uploadResult struct {
+	Image             *fsImageResult `json:"image"`
+	UpdatedUploadPort string         `json:"updated_upload_port,omitempty"`
+}
+
+func (r *uploadResult) Data() interface{} {
+	return r
+}
+
+func (r *uploadResult) String() string {
+	return tr("Uploaded %s", r.Image)
+}
@@ -0,0 +1,73 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package install
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/env"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+var tr = i18n.Tr
+
+// NewCommand creates a new `install` command
+func NewCommand() *cobra.Command {
+	var requirementsFile string
+	var scriptFlags arguments.PrePostScriptsFlags
+	installCommand := &cobra.Command{
+		Use:   "install",
+		Short: tr("Installs the cores and libraries listed in a requirements file."),
+		Long: tr(`Installs the cores and libraries listed in a requirements file, together
+with the additional Boards Manager URLs they need.
+The cores and libraries already installed at the required version are left
+untouched, the ones listed without a version are installed at the latest
+version only if they're missing, so running the command again does nothing.
+See also the env command to remove the cores and libraries not listed.`),
+		Example: "" +
+			"  " + os.Args[0] + " install -r requirements.yaml\n",
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			arguments.CheckFlagsConflicts(cmd, "run-post-install", "skip-post-install")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			runInstallCommand(requirementsFile, scriptFlags)
+		},
+	}
+	env.AddRequirementsFlag(installCommand, &requirementsFile)
+	scriptFlags.AddToCommand(installCommand)
+	return installCommand
+}
+
+func runInstallCommand(requirementsFile string, scriptFlags arguments.PrePostScriptsFlags) {
+	logrus.Info("Executing `arduino-cli install`")
+
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	reqs := env.LoadRequirements(requirementsFile)
+	inst := instance.CreateAndInit()
+	changes := env.Plan(ctx, inst, reqs, false)
+	env.Apply(ctx, inst, changes, scriptFlags)
+	feedback.PrintResult(env.NewAppliedChangesResult(changes))
+}
@@ -16,11 +16,15 @@
 package instance
 
 import (
+	"context"
+
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/daemonclient"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
+	"go.bug.st/cleanup"
 )
 
 var tr = i18n.Tr
@@ -34,6 +38,19 @@ func CreateAndInit() *rpc.Instance {
 	return inst
 }
 
+// CreateAndInitLazily returns a new initialized instance like CreateAndInit,
+// but the loading of the installed platforms is deferred until they're actually
+// needed. This speeds up the commands that seldom need platforms (for example
+// the library management commands).
+func CreateAndInitLazily() *rpc.Instance {
+	instance, err := create()
+	if err != nil {
+		feedback.Fatal(tr("Error creating instance: %v", err), feedback.ErrGeneric)
+	}
+	initInstance(&rpc.InitRequest{Instance: instance, LazyPlatformsLoading: true})
+	return instance
+}
+
 // CreateAndInitWithProfile returns a new initialized instance using the given profile of the given sketch.
 // If Create fails the CLI prints an error and exits since to execute further operations a valid Instance is mandatory.
 // If Init returns errors they're printed only.
@@ -68,36 +85,74 @@ func Init(instance *rpc.Instance) {
 // In case of loading failures return a list of errors for each platform or library that we failed to load.
 // Required Package and library indexes files are automatically downloaded.
 func InitWithProfile(instance *rpc.Instance, profileName string, sketchPath *paths.Path) *rpc.Profile {
-	downloadCallback := feedback.ProgressBar()
-	taskCallback := feedback.TaskProgress()
-
 	initReq := &rpc.InitRequest{Instance: instance}
 	if sketchPath != nil {
 		initReq.SketchPath = sketchPath.String()
 		initReq.Profile = profileName
 	}
-	var profile *rpc.Profile
-	err := commands.Init(initReq, func(res *rpc.InitResponse) {
-		if st := res.GetError(); st != nil {
-			feedback.Warning(tr("Error initializing instance: %v", st.Message))
-		}
+	return initInstance(initReq)
+}
 
-		if progress := res.GetInitProgress(); progress != nil {
-			if progress.DownloadProgress != nil {
-				downloadCallback(progress.DownloadProgress)
-			}
-			if progress.TaskProgress != nil {
-				taskCallback(progress.TaskProgress)
-			}
-		}
+// initInstance runs the given InitRequest reporting progress and errors to the user,
+// the selected profile (if any) is returned.
+func initInstance(initReq *rpc.InitRequest) *rpc.Profile {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
 
-		if p := res.GetProfile(); p != nil {
-			profile = p
-		}
-	})
+	feedbackCB := newInitFeedback()
+	err := commands.Init(ctx, initReq, feedbackCB.callback)
 	if err != nil {
 		feedback.Warning(tr("Error initializing instance: %v", err))
 	}
 
-	return profile
+	return feedbackCB.profile
+}
+
+// InitDaemonWithProfile makes the given client of the background daemon use an
+// instance initialized with the given profile of the given sketch, reporting
+// progress and errors to the user like InitWithProfile.
+func InitDaemonWithProfile(client *daemonclient.Client, profileName string, sketchPath *paths.Path) *rpc.Profile {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	feedbackCB := newInitFeedback()
+	if err := client.UseProfile(ctx, sketchPath, profileName, feedbackCB.callback); err != nil {
+		feedback.Fatal(tr("Error initializing instance: %v", err), feedback.ErrGeneric)
+	}
+
+	return feedbackCB.profile
+}
+
+// initFeedback reports the progress and the errors of an initialization to
+// the user and keeps the selected profile
+type initFeedback struct {
+	downloadCallback rpc.DownloadProgressCB
+	taskCallback     rpc.TaskProgressCB
+	profile          *rpc.Profile
+}
+
+func newInitFeedback() *initFeedback {
+	return &initFeedback{
+		downloadCallback: feedback.ProgressBar(),
+		taskCallback:     feedback.TaskProgress(),
+	}
+}
+
+func (f *initFeedback) callback(res *rpc.InitResponse) {
+	if st := res.GetError(); st != nil {
+		feedback.Warning(tr("Error initializing instance: %v", st.Message))
+	}
+
+	if progress := res.GetInitProgress(); progress != nil {
+		if progress.DownloadProgress != nil {
+			f.downloadCallback(progress.DownloadProgress)
+		}
+		if progress.TaskProgress != nil {
+			f.taskCallback(progress.TaskProgress)
+		}
+	}
+
+	if p := res.GetProfile(); p != nil {
+		f.profile = p
+	}
 }
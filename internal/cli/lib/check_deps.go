@@ -32,6 +32,7 @@ import (
 )
 
 func initDepsCommand() *cobra.Command {
+	var resolutionFlag arguments.ResolutionFlag
 	depsCommand := &cobra.Command{
 		Use:   fmt.Sprintf("deps %s[@%s]...", tr("LIBRARY"), tr("VERSION_NUMBER")),
 		Short: tr("Check dependencies status for the specified library."),
@@ -40,16 +41,19 @@ func initDepsCommand() *cobra.Command {
 			"  " + os.Args[0] + " lib deps AudioZero       # " + tr("for the latest version.") + "\n" +
 			"  " + os.Args[0] + " lib deps AudioZero@1.0.0 # " + tr("for the specific version."),
 		Args: cobra.ExactArgs(1),
-		Run:  runDepsCommand,
+		Run: func(cmd *cobra.Command, args []string) {
+			runDepsCommand(args, &resolutionFlag)
+		},
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return arguments.GetInstalledLibraries(), cobra.ShellCompDirectiveDefault
 		},
 	}
+	resolutionFlag.AddToCommand(depsCommand)
 	return depsCommand
 }
 
-func runDepsCommand(cmd *cobra.Command, args []string) {
-	instance := instance.CreateAndInit()
+func runDepsCommand(args []string, resolutionFlag *arguments.ResolutionFlag) {
+	instance := instance.CreateAndInitLazily()
 	logrus.Info("Executing `arduino-cli lib deps`")
 	libRef, err := ParseLibraryReferenceArgAndAdjustCase(instance, args[0])
 	if err != nil {
@@ -57,9 +61,10 @@ func runDepsCommand(cmd *cobra.Command, args []string) {
 	}
 
 	deps, err := lib.LibraryResolveDependencies(context.Background(), &rpc.LibraryResolveDependenciesRequest{
-		Instance: instance,
-		Name:     libRef.Name,
-		Version:  libRef.Version,
+		Instance:   instance,
+		Name:       libRef.Name,
+		Version:    libRef.Version,
+		Resolution: resolutionFlag.ToRPC(),
 	})
 	if err != nil {
 		feedback.Fatal(tr("Error resolving dependencies for %[1]s: %[2]s", libRef, err), feedback.ErrGeneric)
@@ -0,0 +1,172 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initCheckExamplesCommand() *cobra.Command {
+	var fqbns []string
+	var warnings string
+	var verbose bool
+	checkExamplesCommand := &cobra.Command{
+		Use:   fmt.Sprintf("check-examples %s", tr("LIBRARY_NAME|LIBRARY_PATH")),
+		Short: tr("Compiles the examples of a library for a set of boards."),
+		Long: tr("Compiles every example of an installed library, or of the library in the given folder, for each of the given boards and reports the results as a matrix.") + "\n\n" +
+			tr("The boards an example is compiled for can be selected with annotations in the main file of the example, the patterns are matched against the FQBNs:") + "\n\n" +
+			"  // check-examples: include arduino:samd:*\n" +
+			"  // check-examples: exclude arduino:avr:uno arduino:avr:nano",
+		Example: "  " + os.Args[0] + " lib check-examples MyLibrary --fqbn arduino:avr:uno --fqbn arduino:samd:mkr1000\n" +
+			"  " + os.Args[0] + " lib check-examples ./MyLibrary --fqbn arduino:avr:uno --warnings all",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runCheckExamplesCommand(args[0], fqbns, warnings, verbose)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return arguments.GetInstalledLibraries(), cobra.ShellCompDirectiveDefault
+		},
+	}
+	checkExamplesCommand.Flags().StringArrayVarP(&fqbns, "fqbn", "b", nil, tr("Fully Qualified Board Name of a board to compile the examples for, can be repeated."))
+	checkExamplesCommand.MarkFlagRequired("fqbn")
+	checkExamplesCommand.RegisterFlagCompletionFunc("fqbn", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return arguments.GetInstalledBoards(), cobra.ShellCompDirectiveDefault
+	})
+	checkExamplesCommand.Flags().StringVar(&warnings, "warnings", "none",
+		tr(`Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).`, "none, default, more, all"))
+	checkExamplesCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return checkExamplesCommand
+}
+
+func runCheckExamplesCommand(library string, fqbns []string, warnings string, verbose bool) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli lib check-examples`")
+
+	stdOut, stdErr, _ := feedback.OutputStreams()
+	res, err := lib.LibraryCheckExamples(ctx, &rpc.LibraryCheckExamplesRequest{
+		Instance: inst,
+		Library:  library,
+		Fqbns:    fqbns,
+		Warnings: warnings,
+		Verbose:  verbose,
+	}, stdOut, stdErr, func(check *rpc.LibraryExampleCheck) {
+		if check.GetStatus() == rpc.LibraryExampleCheckStatus_LIBRARY_EXAMPLE_CHECK_STATUS_FAILED && !verbose {
+			fmt.Fprintln(stdErr, strings.TrimRight(check.GetOutput(), "\n"))
+		}
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error checking the examples of %[1]s: %[2]v", library, err), feedback.ErrGeneric)
+	}
+
+	result := &checkExamplesResult{
+		Library:  res.GetLibrary(),
+		Examples: res.GetExamples(),
+		Fqbns:    res.GetFqbns(),
+		Failed:   int(res.GetFailed()),
+	}
+	for _, check := range res.GetChecks() {
+		result.Checks = append(result.Checks, &exampleCheck{
+			Example:  check.GetExample(),
+			Fqbn:     check.GetFqbn(),
+			Status:   strings.ToLower(strings.TrimPrefix(check.GetStatus().String(), "LIBRARY_EXAMPLE_CHECK_STATUS_")),
+			Warnings: int(check.GetWarnings()),
+			Output:   check.GetOutput(),
+		})
+	}
+	if result.Failed > 0 {
+		result.Error = tr("%[1]d of the compilations of the examples of %[2]s failed", result.Failed, result.Library)
+		feedback.FatalResult(result, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(result)
+}
+
+type checkExamplesResult struct {
+	Library  string          `json:"library"`
+	Examples []string        `json:"examples"`
+	Fqbns    []string        `json:"fqbns"`
+	Checks   []*exampleCheck `json:"checks"`
+	Failed   int             `json:"failed"`
+	Error    string          `json:"error,omitempty"`
+}
+
+type exampleCheck struct {
+	Example  string `json:"example"`
+	Fqbn     string `json:"fqbn"`
+	Status   string `json:"status"`
+	Warnings int    `json:"warnings,omitempty"`
+	Output   string `json:"output,omitempty"`
+}
+
+// Data implements Result interface
+func (r *checkExamplesResult) Data() interface{} {
+	return r
+}
+
+// ErrorString implements feedback.ErrorResult
+func (r *checkExamplesResult) ErrorString() string {
+	return r.Error
+}
+
+// String implements Result interface
+func (r *checkExamplesResult) String() string {
+	checks := map[string]*exampleCheck{}
+	for _, check := range r.Checks {
+		checks[check.Example+"\n"+check.Fqbn] = check
+	}
+	t := table.New()
+	header := []interface{}{tr("Example")}
+	for _, fqbn := range r.Fqbns {
+		header = append(header, fqbn)
+	}
+	t.SetHeader(header...)
+	for _, example := range r.Examples {
+		row := []interface{}{example}
+		for _, fqbn := range r.Fqbns {
+			check := checks[example+"\n"+fqbn]
+			switch {
+			case check == nil:
+				row = append(row, "")
+			case check.Status == "failed":
+				row = append(row, table.NewCell(tr("failed"), color.New(color.FgRed)))
+			case check.Status == "skipped":
+				row = append(row, tr("skipped"))
+			case check.Warnings > 0:
+				row = append(row, table.NewCell(tr("passed (%d warnings)", check.Warnings), color.New(color.FgYellow)))
+			default:
+				row = append(row, table.NewCell(tr("passed"), color.New(color.FgGreen)))
+			}
+		}
+		t.AddRow(row...)
+	}
+	return strings.TrimSuffix(t.Render(), "\n")
+}
@@ -54,7 +54,7 @@ func initExamplesCommand() *cobra.Command {
 }
 
 func runExamplesCommand(cmd *cobra.Command, args []string) {
-	instance := instance.CreateAndInit()
+	instance := instance.CreateAndInitLazily()
 	logrus.Info("Executing `arduino-cli lib examples`")
 
 	name := ""
@@ -21,6 +21,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/arduino/arduino-cli/arduino/github"
 	"github.com/arduino/arduino-cli/commands/lib"
 	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/internal/cli/arguments"
@@ -31,6 +32,7 @@ import (
 	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 	semver "go.bug.st/relaxed-semver"
 )
 
@@ -40,6 +42,9 @@ func initInstallCommand() *cobra.Command {
 	var gitURL bool
 	var zipPath bool
 	var useBuiltinLibrariesDir bool
+	var advisoriesFlag arguments.AdvisoriesFlag
+	var resolutionFlag arguments.ResolutionFlag
+	var runPostInstall bool
 	installCommand := &cobra.Command{
 		Use:   fmt.Sprintf("install %s[@%s]...", tr("LIBRARY"), tr("VERSION_NUMBER")),
 		Short: tr("Installs one or more specified libraries into the system."),
@@ -49,10 +54,11 @@ func initInstallCommand() *cobra.Command {
 			"  " + os.Args[0] + " lib install AudioZero@1.0.0 # " + tr("for the specific version.") + "\n" +
 			"  " + os.Args[0] + " lib install --git-url https://github.com/arduino-libraries/WiFi101.git https://github.com/arduino-libraries/ArduinoBLE.git\n" +
 			"  " + os.Args[0] + " lib install --git-url https://github.com/arduino-libraries/WiFi101.git#0.16.0 # " + tr("for the specific version.") + "\n" +
-			"  " + os.Args[0] + " lib install --zip-path /path/to/WiFi101.zip /path/to/ArduinoBLE.zip\n",
+			"  " + os.Args[0] + " lib install --zip-path /path/to/WiFi101.zip /path/to/ArduinoBLE.zip\n" +
+			"  " + os.Args[0] + " lib install owner/MyLibrary@v1.0.0 # " + tr("for the zip file of a GitHub release.") + "\n",
 		Args: cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			runInstallCommand(args, noDeps, noOverwrite, gitURL, zipPath, useBuiltinLibrariesDir)
+			runInstallCommand(args, noDeps, noOverwrite, gitURL, zipPath, useBuiltinLibrariesDir, runPostInstall, &advisoriesFlag, &resolutionFlag)
 		},
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return arguments.GetInstallableLibs(), cobra.ShellCompDirectiveDefault
@@ -63,22 +69,66 @@ func initInstallCommand() *cobra.Command {
 	installCommand.Flags().BoolVar(&gitURL, "git-url", false, tr("Enter git url for libraries hosted on repositories"))
 	installCommand.Flags().BoolVar(&zipPath, "zip-path", false, tr("Enter a path to zip file"))
 	installCommand.Flags().BoolVar(&useBuiltinLibrariesDir, "install-in-builtin-dir", false, tr("Install libraries in the IDE-Builtin directory"))
+	installCommand.Flags().BoolVar(&runPostInstall, "run-post-install", false, tr("Run the post-install hooks declared by the installed libraries, if the libraries are approved and the permissions they require are allowed."))
+	advisoriesFlag.AddToCommand(installCommand)
+	resolutionFlag.AddToCommand(installCommand)
 	return installCommand
 }
 
-func runInstallCommand(args []string, noDeps bool, noOverwrite bool, gitURL bool, zipPath bool, useBuiltinLibrariesDir bool) {
-	instance := instance.CreateAndInit()
+func runInstallCommand(args []string, noDeps bool, noOverwrite bool, gitURL bool, zipPath bool, useBuiltinLibrariesDir bool, runPostInstall bool, advisoriesFlag *arguments.AdvisoriesFlag, resolutionFlag *arguments.ResolutionFlag) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	instance := instance.CreateAndInitLazily()
 	logrus.Info("Executing `arduino-cli lib install`")
 
-	if zipPath || gitURL {
+	// Libraries from GitHub releases are downloaded and installed as zip files
+	githubReleases := []string{}
+	if !zipPath && !gitURL {
+		libArgs := []string{}
+		for _, arg := range args {
+			if github.IsReleaseReference(arg) {
+				githubReleases = append(githubReleases, arg)
+			} else {
+				libArgs = append(libArgs, arg)
+			}
+		}
+		args = libArgs
+	}
+
+	if len(githubReleases) > 0 {
 		if !configuration.Settings.GetBool("library.enable_unsafe_install") {
-			documentationURL := "https://arduino.github.io/arduino-cli/latest/configuration/#configuration-keys"
-			_, err := semver.Parse(version.VersionInfo.VersionString)
-			if err == nil {
-				split := strings.Split(version.VersionInfo.VersionString, ".")
-				documentationURL = fmt.Sprintf("https://arduino.github.io/arduino-cli/%s.%s/configuration/#configuration-keys", split[0], split[1])
+			feedback.Fatal(tr("Installing libraries from GitHub releases is disabled by default, for more information see: %v", unsafeInstallDocumentationURL()), feedback.ErrGeneric)
+		}
+		feedback.Print(tr("Libraries from GitHub releases are not verified by the Library Manager, install them at your own risk."))
+		if useBuiltinLibrariesDir {
+			feedback.Fatal(tr("Libraries from GitHub releases can't be installed with --install-in-builtin-dir"), feedback.ErrGeneric)
+		}
+
+		tmpDir, err := paths.MkTempDir("", "github-library-")
+		if err != nil {
+			feedback.Fatal(tr("Cannot create temp dir: %v", err), feedback.ErrGeneric)
+		}
+		defer tmpDir.RemoveAll()
+		for _, arg := range githubReleases {
+			_, archive := arguments.DownloadGitHubReleaseAsset(arg, tmpDir, ".zip")
+			err := lib.ZipLibraryInstall(ctx, &rpc.ZipLibraryInstallRequest{
+				Instance:  instance,
+				Path:      archive.String(),
+				Overwrite: !noOverwrite,
+			}, feedback.TaskProgress())
+			if err != nil {
+				feedback.Fatal(tr("Error installing %s: %v", arg, err), feedback.ErrGeneric)
 			}
-			feedback.Fatal(tr("--git-url and --zip-path are disabled by default, for more information see: %v", documentationURL), feedback.ErrGeneric)
+		}
+		if len(args) == 0 {
+			return
+		}
+	}
+
+	if zipPath || gitURL {
+		if !configuration.Settings.GetBool("library.enable_unsafe_install") {
+			feedback.Fatal(tr("--git-url and --zip-path are disabled by default, for more information see: %v", unsafeInstallDocumentationURL()), feedback.ErrGeneric)
 		}
 		feedback.Print(tr("--git-url and --zip-path flags allow installing untrusted files, use it at your own risk."))
 
@@ -89,7 +139,7 @@ func runInstallCommand(args []string, noDeps bool, noOverwrite bool, gitURL bool
 
 	if zipPath {
 		for _, path := range args {
-			err := lib.ZipLibraryInstall(context.Background(), &rpc.ZipLibraryInstallRequest{
+			err := lib.ZipLibraryInstall(ctx, &rpc.ZipLibraryInstallRequest{
 				Instance:  instance,
 				Path:      path,
 				Overwrite: !noOverwrite,
@@ -110,7 +160,7 @@ func runInstallCommand(args []string, noDeps bool, noOverwrite bool, gitURL bool
 				}
 				url = wd.String()
 			}
-			err := lib.GitLibraryInstall(context.Background(), &rpc.GitLibraryInstallRequest{
+			err := lib.GitLibraryInstall(ctx, &rpc.GitLibraryInstallRequest{
 				Instance:  instance,
 				Url:       url,
 				Overwrite: !noOverwrite,
@@ -139,10 +189,39 @@ func runInstallCommand(args []string, noDeps bool, noOverwrite bool, gitURL bool
 			NoDeps:          noDeps,
 			NoOverwrite:     noOverwrite,
 			InstallLocation: installLocation,
+			Resolution:      resolutionFlag.ToRPC(),
+			RunPostInstall:  runPostInstall,
 		}
-		err := lib.LibraryInstall(context.Background(), libraryInstallRequest, feedback.ProgressBar(), feedback.TaskProgress())
+		err := lib.LibraryInstall(ctx, libraryInstallRequest, feedback.ProgressBar(), feedback.TaskProgress())
 		if err != nil {
 			feedback.Fatal(tr("Error installing %s: %v", libRef.Name, err), feedback.ErrGeneric)
 		}
 	}
+
+	// Check the advisories of the installed versions
+	feed := arguments.LoadAdvisories()
+	advisories := []*rpc.Advisory{}
+	for _, installedLib := range GetList(instance, []string{}, false, false) {
+		for _, libRef := range libRefs {
+			if strings.EqualFold(installedLib.GetLibrary().GetName(), libRef.Name) {
+				version, _ := semver.Parse(installedLib.GetLibrary().GetVersion())
+				advisories = append(advisories, feed.Library(libRef.Name, version)...)
+			}
+		}
+	}
+	if err := advisoriesFlag.Warn(advisories); err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+	}
+}
+
+// unsafeInstallDocumentationURL returns the URL of the documentation of the
+// library.enable_unsafe_install setting for the running version.
+func unsafeInstallDocumentationURL() string {
+	documentationURL := "https://arduino.github.io/arduino-cli/latest/configuration/#configuration-keys"
+	_, err := semver.Parse(version.VersionInfo.VersionString)
+	if err == nil {
+		split := strings.Split(version.VersionInfo.VersionString, ".")
+		documentationURL = fmt.Sprintf("https://arduino.github.io/arduino-cli/%s.%s/configuration/#configuration-keys", split[0], split[1])
+	}
+	return documentationURL
 }
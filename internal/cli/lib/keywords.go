@@ -0,0 +1,218 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/keywords"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initKeywordsCommand() *cobra.Command {
+	keywordsCommand := &cobra.Command{
+		Use:   "keywords",
+		Short: tr("Manages the keywords.txt file of a library."),
+		Long:  tr("Generates and checks the keywords.txt file of a library, listing the symbols declared in its public headers to be highlighted by the editors."),
+		Example: "  " + os.Args[0] + " lib keywords generate ./MyLibrary\n" +
+			"  " + os.Args[0] + " lib keywords check ./MyLibrary",
+	}
+	keywordsCommand.AddCommand(initKeywordsGenerateCommand())
+	keywordsCommand.AddCommand(initKeywordsCheckCommand())
+	return keywordsCommand
+}
+
+func initKeywordsGenerateCommand() *cobra.Command {
+	var prune bool
+	var dryRun bool
+	generateCommand := &cobra.Command{
+		Use:   fmt.Sprintf("generate [%s]", tr("LIBRARY_PATH")),
+		Short: tr("Generates the keywords.txt file of a library."),
+		Long: tr("Generates the keywords.txt file of the library in the given folder (the current folder by default) from the classes, methods, functions, variables, enums and macros declared in its public headers.") + " " +
+			tr("If the file already exists the missing keywords are added, the existing ones keep their type and reference link."),
+		Example: "  " + os.Args[0] + " lib keywords generate ./MyLibrary\n" +
+			"  " + os.Args[0] + " lib keywords generate ./MyLibrary --prune",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runKeywordsGenerateCommand(libraryPathArg(args), prune, dryRun)
+		},
+	}
+	generateCommand.Flags().BoolVar(&prune, "prune", false, tr("Remove the keywords not matching any symbol of the library."))
+	generateCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Print the keywords.txt file without writing it."))
+	return generateCommand
+}
+
+func initKeywordsCheckCommand() *cobra.Command {
+	checkCommand := &cobra.Command{
+		Use:     fmt.Sprintf("check [%s]", tr("LIBRARY_PATH")),
+		Short:   tr("Checks the keywords.txt file of a library."),
+		Long:    tr("Checks that the keywords.txt file of the library in the given folder (the current folder by default) is well formed and that its keywords match the symbols declared in the public headers of the library."),
+		Example: "  " + os.Args[0] + " lib keywords check ./MyLibrary",
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runKeywordsCheckCommand(libraryPathArg(args))
+		},
+	}
+	return checkCommand
+}
+
+func libraryPathArg(args []string) *paths.Path {
+	if len(args) == 0 {
+		return paths.New(".")
+	}
+	return paths.New(args[0])
+}
+
+// loadLibraryKeywords returns the library in the folder, its keywords.txt
+// file, if any, and the keywords generated from its public headers
+func loadLibraryKeywords(libDir *paths.Path) (*libraries.Library, *keywords.File, []*keywords.Entry) {
+	if !libDir.IsDir() {
+		feedback.Fatal(tr("Library folder not found: %s", libDir), feedback.ErrBadArgument)
+	}
+	lib, err := libraries.Load(libDir, libraries.Unmanaged)
+	if err != nil {
+		feedback.Fatal(tr("Error loading library %[1]s: %[2]v", libDir, err), feedback.ErrBadArgument)
+	}
+	generated, err := keywords.FromLibrary(lib)
+	if err != nil {
+		feedback.Fatal(tr("Error reading the headers of library %[1]s: %[2]v", lib.Name, err), feedback.ErrGeneric)
+	}
+	file := &keywords.File{}
+	if keywordsFile := lib.InstallDir.Join("keywords.txt"); keywordsFile.Exist() {
+		if file, err = keywords.Load(keywordsFile); err != nil {
+			feedback.Fatal(tr("Error reading %[1]s: %[2]v", keywordsFile, err), feedback.ErrGeneric)
+		}
+	}
+	return lib, file, generated
+}
+
+func runKeywordsGenerateCommand(libDir *paths.Path, prune, dryRun bool) {
+	logrus.Info("Executing `arduino-cli lib keywords generate`")
+
+	lib, file, generated := loadLibraryKeywords(libDir)
+	entries := file.Merge(generated, prune)
+	content := keywords.Format(lib.Name, entries)
+
+	res := &keywordsGenerateResult{File: lib.InstallDir.Join("keywords.txt").String()}
+	inFile := map[string]bool{}
+	for _, entry := range file.Entries {
+		inFile[entry.Keyword] = true
+	}
+	kept := map[string]bool{}
+	for _, entry := range entries {
+		kept[entry.Keyword] = true
+		if !inFile[entry.Keyword] {
+			res.Added = append(res.Added, entry.Keyword)
+		}
+	}
+	for _, entry := range file.Entries {
+		if !kept[entry.Keyword] {
+			res.Removed = append(res.Removed, entry.Keyword)
+		}
+	}
+	if dryRun {
+		res.Content = string(content)
+	} else if err := paths.New(res.File).WriteFile(content); err != nil {
+		feedback.Fatal(tr("Error writing %[1]s: %[2]v", res.File, err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(res)
+}
+
+type keywordsGenerateResult struct {
+	File    string   `json:"file"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	Content string   `json:"content,omitempty"`
+}
+
+func (r *keywordsGenerateResult) Data() interface{} {
+	return r
+}
+
+func (r *keywordsGenerateResult) String() string {
+	if r.Content != "" {
+		return r.Content
+	}
+	return tr("%[1]s updated: %[2]d keywords added, %[3]d removed", r.File, len(r.Added), len(r.Removed))
+}
+
+func runKeywordsCheckCommand(libDir *paths.Path) {
+	logrus.Info("Executing `arduino-cli lib keywords check`")
+
+	lib, file, generated := loadLibraryKeywords(libDir)
+	keywordsFile := lib.InstallDir.Join("keywords.txt")
+	if !keywordsFile.Exist() {
+		feedback.Fatal(tr("The library %[1]s has no keywords.txt file, create it with %[2]s", lib.Name, "arduino-cli lib keywords generate"), feedback.ErrGeneric)
+	}
+	stale, missing := file.Check(generated)
+	problems := append(file.Problems, stale...)
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+	res := &keywordsCheckResult{File: keywordsFile.String()}
+	for _, problem := range problems {
+		res.Problems = append(res.Problems, &keywordsProblem{Line: problem.Line, Keyword: problem.Keyword, Message: problem.Message})
+	}
+	for _, entry := range missing {
+		res.Missing = append(res.Missing, entry.Keyword)
+	}
+	if len(res.Problems) > 0 {
+		res.Error = tr("%[1]d problems found in %[2]s", len(res.Problems), res.File)
+		feedback.FatalResult(res, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(res)
+}
+
+type keywordsCheckResult struct {
+	File     string             `json:"file"`
+	Problems []*keywordsProblem `json:"problems,omitempty"`
+	Missing  []string           `json:"missing,omitempty"`
+	Error    string             `json:"error,omitempty"`
+}
+
+type keywordsProblem struct {
+	Line    int    `json:"line"`
+	Keyword string `json:"keyword,omitempty"`
+	Message string `json:"message"`
+}
+
+func (r *keywordsCheckResult) Data() interface{} {
+	return r
+}
+
+// ErrorString implements feedback.ErrorResult
+func (r *keywordsCheckResult) ErrorString() string {
+	return r.Error
+}
+
+func (r *keywordsCheckResult) String() string {
+	out := ""
+	for _, problem := range r.Problems {
+		out += fmt.Sprintf("%s:%d: %s\n", r.File, problem.Line, problem.Message)
+	}
+	if len(r.Missing) > 0 {
+		out += tr("Symbols of the library missing from %[1]s: %[2]s", r.File, strings.Join(r.Missing, ", ")) + "\n"
+	}
+	if len(r.Problems) == 0 {
+		out += tr("%s is valid", r.File)
+	}
+	return strings.TrimSuffix(out, "\n")
+}
@@ -44,5 +44,10 @@ func NewCommand() *cobra.Command {
 	libCommand.AddCommand(initUpgradeCommand())
 	libCommand.AddCommand(initUpdateIndexCommand())
 	libCommand.AddCommand(initDepsCommand())
+	libCommand.AddCommand(initPrecompileCommand())
+	libCommand.AddCommand(initCheckExamplesCommand())
+	libCommand.AddCommand(initKeywordsCommand())
+	libCommand.AddCommand(initPropertiesCommand())
+	libCommand.AddCommand(initResolveSymbolCommand())
 	return libCommand
 }
@@ -17,11 +17,15 @@ package lib
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/commands/lib"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
 	"github.com/arduino/arduino-cli/internal/cli/instance"
@@ -31,9 +35,15 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// The locations of the libraries listed, set with the --location flag
+var listLocations []string
+
 func initListCommand() *cobra.Command {
 	var all bool
 	var updatable bool
+	var conflicts bool
+	var modified bool
+	var unusedSince string
 	listCommand := &cobra.Command{
 		Use:   fmt.Sprintf("list [%s]", tr("LIBNAME")),
 		Short: tr("Shows a list of installed libraries."),
@@ -41,18 +51,64 @@ func initListCommand() *cobra.Command {
 
 If the LIBNAME parameter is specified the listing is limited to that specific
 library. By default the libraries provided as built-in by platforms/core are
-not listed, they can be listed by adding the --all flag.`),
+not listed, they can be listed by adding the --all flag.
+
+With the --conflicts flag the command lists the headers provided by more than
+one library, showing the library selected when compiling and why. The libraries
+bundled with the platform of the board are checked only if --fqbn is given.
+
+With the --modified flag the command lists the libraries installed from the
+libraries index, git or zip whose files have been changed, added or removed
+since the installation: these changes are lost when the library is upgraded.
+
+The Source column shows where the libraries have been installed from: index,
+git, zip or local (copied by the user or installed by an older version). The
+libraries installed from git are upgraded from their repository, the ones
+installed from zip are not upgraded.
+
+With the --unused-since flag the command lists the libraries that have not been
+used by a compilation in the given period, for example 90d (days), 12w (weeks)
+or 48h. The usage is recorded by the compilations since this version of the CLI
+is used, the libraries never used are considered used when they have been
+installed.
+
+The Location column shows where the libraries are installed: user (the
+libraries folder of the sketchbook), profile (installed for the build profiles
+of the sketches), ide (bundled with the IDE), platform and ref-platform
+(bundled with the platform of the board or with the platform it references,
+shown between parentheses). With the --location flag the command lists only the
+libraries installed in the given locations, including the built-in ones.`),
 		Example: "  " + os.Args[0] + " lib list",
 		Args:    cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			instance := instance.CreateAndInit()
+			instance := instance.CreateAndInitLazily()
 			logrus.Info("Executing `arduino-cli lib list`")
+			if conflicts {
+				ListConflicts(instance, args)
+				return
+			}
+			if modified {
+				ListModified(instance, args, all)
+				return
+			}
+			if unusedSince != "" {
+				ListUnused(instance, args, all, unusedSince)
+				return
+			}
 			List(instance, args, all, updatable)
 		},
 	}
 	listCommand.Flags().BoolVar(&all, "all", false, tr("Include built-in libraries (from platforms and IDE) in listing."))
 	fqbn.AddToCommand(listCommand)
 	listCommand.Flags().BoolVar(&updatable, "updatable", false, tr("List updatable libraries."))
+	listCommand.Flags().BoolVar(&conflicts, "conflicts", false, tr("List the headers provided by more than one library."))
+	listCommand.Flags().BoolVar(&modified, "modified", false, tr("List the libraries whose files have been modified since the installation."))
+	listCommand.Flags().StringSliceVar(&listLocations, "location", []string{},
+		tr("List only the libraries installed in these locations: %s.", strings.Join(listableLocations, ", ")))
+	listCommand.RegisterFlagCompletionFunc("location", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return listableLocations, cobra.ShellCompDirectiveNoFileComp
+	})
+	listCommand.Flags().StringVar(&unusedSince, "unused-since", "", tr("List the libraries not used by a compilation in the given period (for example 90d)."))
 	return listCommand
 }
 
@@ -78,12 +134,22 @@ func GetList(
 		name = args[0]
 	}
 
+	locations := []rpc.LibraryLocation{}
+	for _, l := range listLocations {
+		location, err := libraries.ParseLibraryLocation(l)
+		if err != nil {
+			feedback.Fatal(tr("Invalid location %[1]s, use one of: %[2]s", l, strings.Join(listableLocations, ", ")), feedback.ErrBadArgument)
+		}
+		locations = append(locations, location.ToRPCLibraryLocation())
+	}
+
 	res, err := lib.LibraryList(context.Background(), &rpc.LibraryListRequest{
 		Instance:  instance,
 		All:       all,
 		Updatable: updatable,
 		Name:      name,
 		Fqbn:      fqbn.String(),
+		Locations: locations,
 	})
 	if err != nil {
 		feedback.Fatal(tr("Error listing libraries: %v", err), feedback.ErrGeneric)
@@ -133,10 +199,10 @@ func (ir installedResult) String() string {
 	})
 
 	t := table.New()
-	t.SetHeader(tr("Name"), tr("Installed"), tr("Available"), tr("Location"), tr("Description"))
+	t.SetHeader(tr("Name"), tr("Installed"), tr("Available"), tr("Location"), tr("Source"), tr("Description"))
 	t.SetColumnWidthMode(1, table.Average)
 	t.SetColumnWidthMode(2, table.Average)
-	t.SetColumnWidthMode(4, table.Average)
+	t.SetColumnWidthMode(5, table.Average)
 
 	lastName := ""
 	for _, libMeta := range ir.installedLibs {
@@ -148,10 +214,7 @@ func (ir installedResult) String() string {
 			lastName = name
 		}
 
-		location := lib.GetLocation().String()
-		if lib.ContainerPlatform != "" {
-			location = lib.GetContainerPlatform()
-		}
+		location := LocationLabel(lib)
 
 		available := ""
 		sentence := ""
@@ -168,8 +231,218 @@ func (ir installedResult) String() string {
 		} else if len(sentence) > 40 {
 			sentence = sentence[:37] + "..."
 		}
-		t.AddRow(name, lib.Version, available, location, sentence)
+		source := "-"
+		if lib.GetLocation() == rpc.LibraryLocation_LIBRARY_LOCATION_USER {
+			source = strings.ToLower(strings.TrimPrefix(lib.GetInstallSource().String(), "LIBRARY_INSTALL_SOURCE_"))
+		}
+		t.AddRow(name, lib.Version, available, location, source, sentence)
+	}
+
+	return t.Render()
+}
+
+// listableLocations are the names of the locations of the libraries loaded
+// by an instance
+var listableLocations = []string{"user", "profile", "ide", "platform", "ref-platform"}
+
+// LocationLabel returns the location of the library as shown in the tables,
+// followed by the platform providing the library for the ones bundled with
+// a platform.
+func LocationLabel(lib *rpc.Library) string {
+	location := libraries.FromRPCLibraryLocation(lib.GetLocation())
+	label := location.String()
+	if lib.GetContainerPlatform() != "" {
+		label += " (" + lib.GetContainerPlatform() + ")"
+	}
+	return label
+}
+
+// ListModified gets and prints the libraries installed by the CLI whose files
+// have been modified since the installation.
+func ListModified(instance *rpc.Instance, args []string, all bool) {
+	name := ""
+	if len(args) > 0 {
+		name = args[0]
+	}
+	res, err := lib.LibraryList(context.Background(), &rpc.LibraryListRequest{
+		Instance: instance,
+		All:      all,
+		Name:     name,
+		Modified: true,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error listing libraries: %v", err), feedback.ErrGeneric)
+	}
+	libs := res.GetInstalledLibraries()
+	if libs == nil {
+		libs = []*rpc.InstalledLibrary{}
+	}
+	feedback.PrintResult(modifiedResult{installedLibs: libs})
+}
+
+type modifiedResult struct {
+	installedLibs []*rpc.InstalledLibrary
+}
+
+func (mr modifiedResult) Data() interface{} {
+	return mr.installedLibs
+}
+
+func (mr modifiedResult) String() string {
+	if len(mr.installedLibs) == 0 {
+		return tr("No installed library has been modified.")
 	}
+	sort.Slice(mr.installedLibs, func(i, j int) bool {
+		return strings.ToLower(mr.installedLibs[i].Library.Name) < strings.ToLower(mr.installedLibs[j].Library.Name)
+	})
 
+	t := table.New()
+	t.SetHeader(tr("Name"), tr("Installed"), tr("Location"), tr("Modified files"))
+	t.SetColumnWidthMode(3, table.Average)
+	for _, libMeta := range mr.installedLibs {
+		lib := libMeta.GetLibrary()
+		t.AddRow(lib.GetName(), lib.GetVersion(), lib.GetLocation().String(), strings.Join(libMeta.GetModifiedFiles(), ", "))
+	}
 	return t.Render()
 }
+
+// ListUnused gets and prints the libraries that have not been used by a
+// compilation in the given period.
+func ListUnused(instance *rpc.Instance, args []string, all bool, period string) {
+	age, err := parsePeriod(period)
+	if err != nil {
+		feedback.Fatal(tr("Invalid value for --unused-since: %[1]s: %[2]s", period, err), feedback.ErrBadArgument)
+	}
+	name := ""
+	if len(args) > 0 {
+		name = args[0]
+	}
+	res, err := lib.LibraryList(context.Background(), &rpc.LibraryListRequest{
+		Instance:           instance,
+		All:                all,
+		Name:               name,
+		UnusedSinceSeconds: int64(age.Seconds()),
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error listing libraries: %v", err), feedback.ErrGeneric)
+	}
+	libs := res.GetInstalledLibraries()
+	if libs == nil {
+		libs = []*rpc.InstalledLibrary{}
+	}
+	feedback.PrintResult(unusedResult{installedLibs: libs})
+}
+
+// parsePeriod parses a period expressed in days (90d), weeks (12w) or with the
+// units of time.ParseDuration (48h).
+func parsePeriod(period string) (time.Duration, error) {
+	var unit time.Duration
+	switch {
+	case strings.HasSuffix(period, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(period, "w"):
+		unit = 7 * 24 * time.Hour
+	default:
+		d, err := time.ParseDuration(period)
+		if err == nil && d <= 0 {
+			err = errors.New(tr("the period must be positive"))
+		}
+		return d, err
+	}
+	n, err := strconv.Atoi(period[:len(period)-1])
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, errors.New(tr("the period must be positive"))
+	}
+	return time.Duration(n) * unit, nil
+}
+
+type unusedResult struct {
+	installedLibs []*rpc.InstalledLibrary
+}
+
+func (ur unusedResult) Data() interface{} {
+	return ur.installedLibs
+}
+
+func (ur unusedResult) String() string {
+	if len(ur.installedLibs) == 0 {
+		return tr("No unused libraries.")
+	}
+	sort.Slice(ur.installedLibs, func(i, j int) bool {
+		return strings.ToLower(ur.installedLibs[i].Library.Name) < strings.ToLower(ur.installedLibs[j].Library.Name)
+	})
+
+	t := table.New()
+	t.SetHeader(tr("Name"), tr("Installed"), tr("Location"), tr("Last used"), tr("Compilations"))
+	for _, libMeta := range ur.installedLibs {
+		lib := libMeta.GetLibrary()
+		lastUsed := tr("never")
+		if usage := libMeta.GetUsage(); usage.GetLastUsed() > 0 {
+			lastUsed = time.Unix(usage.GetLastUsed(), 0).Format(time.DateOnly)
+		}
+		t.AddRow(lib.GetName(), lib.GetVersion(), lib.GetLocation().String(), lastUsed, fmt.Sprint(libMeta.GetUsage().GetCompiles()))
+	}
+	return t.Render()
+}
+
+// ListConflicts gets and prints the headers provided by more than one library.
+// If a library name is given only the conflicts involving that library are
+// printed.
+func ListConflicts(instance *rpc.Instance, args []string) {
+	res, err := lib.LibraryListConflicts(context.Background(), &rpc.LibraryListConflictsRequest{
+		Instance: instance,
+		Fqbn:     fqbn.String(),
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error listing libraries: %v", err), feedback.ErrGeneric)
+	}
+
+	conflicts := []*rpc.LibraryConflict{}
+	for _, conflict := range res.GetConflicts() {
+		if len(args) > 0 {
+			involved := strings.EqualFold(conflict.GetSelected().GetName(), args[0])
+			for _, lib := range conflict.GetShadowed() {
+				involved = involved || strings.EqualFold(lib.GetName(), args[0])
+			}
+			if !involved {
+				continue
+			}
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	feedback.PrintResult(conflictsResult{conflicts: conflicts})
+}
+
+type conflictsResult struct {
+	conflicts []*rpc.LibraryConflict
+}
+
+func (cr conflictsResult) Data() interface{} {
+	return cr.conflicts
+}
+
+func (cr conflictsResult) String() string {
+	if len(cr.conflicts) == 0 {
+		return tr("No conflicts between the installed libraries.")
+	}
+	describe := func(lib *rpc.Library) string {
+		location := lib.GetLocation().String()
+		if lib.GetContainerPlatform() != "" {
+			location = lib.GetContainerPlatform()
+		}
+		return fmt.Sprintf("%s@%s (%s) %s", lib.GetName(), lib.GetVersion(), location, lib.GetInstallDir())
+	}
+	res := ""
+	for _, conflict := range cr.conflicts {
+		res += fmt.Sprintln(tr(`Multiple libraries were found for "%[1]s"`, conflict.GetHeader()))
+		res += fmt.Sprintln("  " + tr("Used: %[1]s", describe(conflict.GetSelected())))
+		for _, lib := range conflict.GetShadowed() {
+			res += fmt.Sprintln("  " + tr("Not used: %[1]s", describe(lib)))
+		}
+		res += fmt.Sprintln("  " + tr("Selection reason: %[1]s", conflict.GetSelectionReason()))
+	}
+	return strings.TrimSpace(res)
+}
@@ -0,0 +1,95 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
+)
+
+func initPrecompileCommand() *cobra.Command {
+	var fqbns []string
+	var overwrite bool
+	var verbose bool
+	precompileCommand := &cobra.Command{
+		Use:   fmt.Sprintf("precompile %s", tr("LIBRARY_NAME")),
+		Short: tr("Compiles a library into static archives."),
+		Long:  tr("Compiles an installed library into static archives, one for each board, placed in the src/<mcu> folder of the library to be used as precompiled library."),
+		Example: "  " + os.Args[0] + " lib precompile MyLibrary --fqbn arduino:samd:mkr1000\n" +
+			"  " + os.Args[0] + " lib precompile MyLibrary --fqbn arduino:samd:mkr1000 --fqbn arduino:mbed_nano:nano33ble",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runPrecompileCommand(args[0], fqbns, overwrite, verbose)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return arguments.GetUninstallableLibraries(), cobra.ShellCompDirectiveDefault
+		},
+	}
+	precompileCommand.Flags().StringArrayVarP(&fqbns, "fqbn", "b", nil, tr("Fully Qualified Board Name of a board to precompile the library for, can be repeated."))
+	precompileCommand.MarkFlagRequired("fqbn")
+	precompileCommand.RegisterFlagCompletionFunc("fqbn", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return arguments.GetInstalledBoards(), cobra.ShellCompDirectiveDefault
+	})
+	precompileCommand.Flags().BoolVar(&overwrite, "overwrite", false, tr("Overwrite the archives already present in the library."))
+	precompileCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return precompileCommand
+}
+
+func runPrecompileCommand(name string, fqbns []string, overwrite, verbose bool) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli lib precompile`")
+
+	stdOut, stdErr, _ := feedback.OutputStreams()
+	res, err := lib.LibraryPrecompile(ctx, &rpc.LibraryPrecompileRequest{
+		Instance:  inst,
+		Name:      name,
+		Fqbns:     fqbns,
+		Overwrite: overwrite,
+		Verbose:   verbose,
+	}, stdOut, stdErr)
+	if err != nil {
+		feedback.Fatal(tr("Error precompiling %[1]s: %[2]v", name, err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&precompileResult{Archives: res.GetArchives()})
+}
+
+type precompileResult struct {
+	Archives []string `json:"archives"`
+}
+
+// Data implements Result interface
+func (r *precompileResult) Data() interface{} {
+	return r
+}
+
+// String implements Result interface
+func (r *precompileResult) String() string {
+	// The created archives are already reported in the output of the command
+	return ""
+}
@@ -0,0 +1,200 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initPropertiesCommand() *cobra.Command {
+	propertiesCommand := &cobra.Command{
+		Use:   "properties",
+		Short: tr("Reads and changes the library.properties of a library."),
+		Long:  tr("Reads and changes the fields of the library.properties file of the library in the given folder, validating the new values."),
+		Example: "  " + os.Args[0] + " lib properties get ./MyLibrary version\n" +
+			"  " + os.Args[0] + " lib properties set ./MyLibrary --bump-version minor",
+	}
+	propertiesCommand.AddCommand(initPropertiesGetCommand())
+	propertiesCommand.AddCommand(initPropertiesSetCommand())
+	return propertiesCommand
+}
+
+func initPropertiesGetCommand() *cobra.Command {
+	getCommand := &cobra.Command{
+		Use:   fmt.Sprintf("get %s [%s...]", tr("LIBRARY_PATH"), tr("FIELD")),
+		Short: tr("Reads the fields of the library.properties of a library."),
+		Long:  tr("Prints the given fields of the library.properties of the library in the given folder, or all the fields if none is given."),
+		Example: "  " + os.Args[0] + " lib properties get ./MyLibrary\n" +
+			"  " + os.Args[0] + " lib properties get ./MyLibrary version",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runPropertiesGetCommand(args[0], args[1:])
+		},
+	}
+	return getCommand
+}
+
+func runPropertiesGetCommand(libraryPath string, names []string) {
+	logrus.Info("Executing `arduino-cli lib properties get`")
+	res, err := lib.LibraryPropertiesGet(context.Background(), &rpc.LibraryPropertiesGetRequest{
+		LibraryPath: libraryPath,
+		Names:       names,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error reading library properties: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&propertiesResult{Properties: toLibraryProperties(res.GetProperties()), onlyValue: len(names) == 1})
+}
+
+func initPropertiesSetCommand() *cobra.Command {
+	var unset []string
+	var bumpVersion string
+	var addArchitectures []string
+	var removeArchitectures []string
+	var dryRun bool
+	setCommand := &cobra.Command{
+		Use:   fmt.Sprintf("set %s [%s=%s...]", tr("LIBRARY_PATH"), tr("FIELD"), tr("VALUE")),
+		Short: tr("Changes the fields of the library.properties of a library."),
+		Long: tr("Changes the fields of the library.properties of the library in the given folder. The values are validated and the file is written only if all the changes are valid, keeping its comments and the order of its fields.") + "\n\n" +
+			tr("The fields are removed first, then set, then the architectures are changed and finally the version is bumped."),
+		Example: "  " + os.Args[0] + " lib properties set ./MyLibrary version=1.2.0 \"sentence=Blinks the leds.\"\n" +
+			"  " + os.Args[0] + " lib properties set ./MyLibrary --bump-version patch\n" +
+			"  " + os.Args[0] + " lib properties set ./MyLibrary --add-architecture samd --remove-architecture sam\n" +
+			"  " + os.Args[0] + " lib properties set ./MyLibrary --unset precompiled",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &rpc.LibraryPropertiesSetRequest{
+				LibraryPath:         args[0],
+				Unset:               unset,
+				AddArchitectures:    addArchitectures,
+				RemoveArchitectures: removeArchitectures,
+				DryRun:              dryRun,
+			}
+			for _, arg := range args[1:] {
+				name, value, ok := strings.Cut(arg, "=")
+				if !ok {
+					feedback.Fatal(tr("Invalid field %[1]s, must be in the form %[2]s", arg, "FIELD=VALUE"), feedback.ErrBadArgument)
+				}
+				req.Properties = append(req.Properties, &rpc.LibraryProperty{Name: strings.TrimSpace(name), Value: value})
+			}
+			switch bumpVersion {
+			case "":
+			case "major":
+				req.BumpVersion = rpc.LibraryVersionBump_LIBRARY_VERSION_BUMP_MAJOR
+			case "minor":
+				req.BumpVersion = rpc.LibraryVersionBump_LIBRARY_VERSION_BUMP_MINOR
+			case "patch":
+				req.BumpVersion = rpc.LibraryVersionBump_LIBRARY_VERSION_BUMP_PATCH
+			default:
+				feedback.Fatal(tr("Invalid version part %[1]s, must be one of: %[2]s", bumpVersion, "major, minor, patch"), feedback.ErrBadArgument)
+			}
+			runPropertiesSetCommand(req)
+		},
+	}
+	setCommand.Flags().StringArrayVar(&unset, "unset", nil, tr("Field to remove, can be repeated."))
+	setCommand.Flags().StringVar(&bumpVersion, "bump-version", "", tr("Increments the version, can be: %s.", "major, minor, patch"))
+	setCommand.RegisterFlagCompletionFunc("bump-version", cobra.FixedCompletions([]string{"major", "minor", "patch"}, cobra.ShellCompDirectiveDefault))
+	setCommand.Flags().StringArrayVar(&addArchitectures, "add-architecture", nil, tr("Architecture to add to the supported architectures, can be repeated."))
+	setCommand.Flags().StringArrayVar(&removeArchitectures, "remove-architecture", nil, tr("Architecture to remove from the supported architectures, can be repeated."))
+	setCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Validates the changes without writing the file."))
+	return setCommand
+}
+
+func runPropertiesSetCommand(req *rpc.LibraryPropertiesSetRequest) {
+	logrus.Info("Executing `arduino-cli lib properties set`")
+	res, err := lib.LibraryPropertiesSet(context.Background(), req)
+	if err != nil {
+		feedback.Fatal(tr("Error changing library properties: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&propertiesSetResult{
+		Properties: toLibraryProperties(res.GetProperties()),
+		Changed:    toLibraryProperties(res.GetChanged()),
+		DryRun:     req.GetDryRun(),
+	})
+}
+
+type libraryProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func toLibraryProperties(props []*rpc.LibraryProperty) []*libraryProperty {
+	res := []*libraryProperty{}
+	for _, prop := range props {
+		res = append(res, &libraryProperty{Name: prop.GetName(), Value: prop.GetValue()})
+	}
+	return res
+}
+
+type propertiesResult struct {
+	Properties []*libraryProperty `json:"properties"`
+	// onlyValue prints only the value of the field, to be used in scripts
+	onlyValue bool
+}
+
+func (r *propertiesResult) Data() interface{} {
+	return r
+}
+
+func (r *propertiesResult) String() string {
+	if r.onlyValue && len(r.Properties) == 1 {
+		return r.Properties[0].Value
+	}
+	t := table.New()
+	t.SetHeader(tr("Field"), tr("Value"))
+	for _, prop := range r.Properties {
+		t.AddRow(prop.Name, prop.Value)
+	}
+	return strings.TrimSuffix(t.Render(), "\n")
+}
+
+type propertiesSetResult struct {
+	Properties []*libraryProperty `json:"properties"`
+	Changed    []*libraryProperty `json:"changed"`
+	DryRun     bool               `json:"dry_run,omitempty"`
+}
+
+func (r *propertiesSetResult) Data() interface{} {
+	return r
+}
+
+func (r *propertiesSetResult) String() string {
+	if len(r.Changed) == 0 {
+		return tr("No changes")
+	}
+	out := ""
+	if r.DryRun {
+		out = tr("Changes to be applied:") + "\n"
+	}
+	for _, prop := range r.Changed {
+		if prop.Value == "" {
+			out += tr("%s removed", prop.Name) + "\n"
+		} else {
+			out += prop.Name + "=" + prop.Value + "\n"
+		}
+	}
+	return strings.TrimSuffix(out, "\n")
+}
@@ -0,0 +1,131 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initResolveSymbolCommand() *cobra.Command {
+	var includeDownloaded bool
+	resolveSymbolCommand := &cobra.Command{
+		Use:   fmt.Sprintf("resolve-symbol %s", tr("IDENTIFIER")),
+		Short: tr("Finds the libraries declaring a symbol."),
+		Long: tr(`Finds the libraries declaring a class, a function, a variable, a type or a macro in their public headers.
+
+Use it to find which library provides a symbol, or to debug the ambiguous includes and the duplicate definitions of the symbols declared by more than one library. The identifier may be qualified with its namespace.`),
+		Example: "  " + os.Args[0] + " lib resolve-symbol SD\n" +
+			"  " + os.Args[0] + " lib resolve-symbol SDLib::File\n" +
+			"  " + os.Args[0] + " lib resolve-symbol Adafruit_GFX --include-downloaded",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runResolveSymbolCommand(args[0], includeDownloaded)
+		},
+	}
+	resolveSymbolCommand.Flags().BoolVar(&includeDownloaded, "include-downloaded", false, tr("Search also the library archives in the downloads cache."))
+	return resolveSymbolCommand
+}
+
+func runResolveSymbolCommand(symbol string, includeDownloaded bool) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli lib resolve-symbol`")
+
+	res, err := lib.LibraryResolveSymbol(context.Background(), &rpc.LibraryResolveSymbolRequest{
+		Instance:          inst,
+		Symbol:            symbol,
+		IncludeDownloaded: includeDownloaded,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error resolving symbol %[1]s: %[2]v", symbol, err), feedback.ErrGeneric)
+	}
+
+	result := &resolveSymbolResult{Symbol: symbol, Declarations: []*symbolDeclaration{}}
+	for _, decl := range res.GetDeclarations() {
+		location := ""
+		if !decl.GetDownloaded() {
+			location = decl.GetLocation().String()
+		}
+		result.Declarations = append(result.Declarations, &symbolDeclaration{
+			Library:       decl.GetLibrary(),
+			Version:       decl.GetVersion(),
+			Downloaded:    decl.GetDownloaded(),
+			Location:      location,
+			Path:          decl.GetPath(),
+			Header:        decl.GetHeader(),
+			Line:          decl.GetLine(),
+			Kind:          strings.ToLower(strings.TrimPrefix(decl.GetKind().String(), "LIBRARY_SYMBOL_KIND_")),
+			QualifiedName: decl.GetQualifiedName(),
+		})
+	}
+	feedback.PrintResult(result)
+}
+
+type symbolDeclaration struct {
+	Library       string `json:"library"`
+	Version       string `json:"version,omitempty"`
+	Downloaded    bool   `json:"downloaded,omitempty"`
+	Location      string `json:"location,omitempty"`
+	Path          string `json:"path"`
+	Header        string `json:"header"`
+	Line          uint32 `json:"line"`
+	Kind          string `json:"kind"`
+	QualifiedName string `json:"qualified_name"`
+}
+
+type resolveSymbolResult struct {
+	Symbol       string               `json:"symbol"`
+	Declarations []*symbolDeclaration `json:"declarations"`
+}
+
+// Data implements Result interface
+func (r *resolveSymbolResult) Data() interface{} {
+	return r
+}
+
+// String implements Result interface
+func (r *resolveSymbolResult) String() string {
+	if len(r.Declarations) == 0 {
+		return tr("No library declares %s.", r.Symbol)
+	}
+
+	t := table.New()
+	t.SetHeader(tr("Library"), tr("Version"), tr("Location"), tr("Declaration"), tr("Kind"), tr("Symbol"))
+	libs := map[string]bool{}
+	for _, decl := range r.Declarations {
+		location := decl.Location
+		if decl.Downloaded {
+			location = tr("downloaded")
+		}
+		libs[decl.Path] = true
+		t.AddRow(decl.Library, decl.Version, location, fmt.Sprintf("%s:%d", decl.Header, decl.Line), decl.Kind, decl.QualifiedName)
+	}
+	res := t.Render()
+	if len(libs) > 1 {
+		res += "\n" + tr("%[1]s is declared by %[2]d libraries: including more than one of them may cause ambiguous includes or duplicate definitions.", r.Symbol, len(libs))
+	}
+	return res
+}
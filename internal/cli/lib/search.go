@@ -36,6 +36,8 @@ import (
 func initSearchCommand() *cobra.Command {
 	var namesOnly bool
 	var omitReleasesDetails bool
+	var limit uint32
+	var fuzzy bool
 	searchCommand := &cobra.Command{
 		Use:   fmt.Sprintf("search [%s ...]", tr("SEARCH_TERM")),
 		Short: tr("Searches for one or more libraries matching a query."),
@@ -81,6 +83,11 @@ In addition to the fields listed above, QV terms can use these qualifiers:
  - Types
  - Version
  - Website
+
+The 'include' qualifier finds the libraries providing a header, e.g.
+'include:SD.h'. Unlike the other qualifiers it always matches the whole header
+name (case-insensitive, the extension may be omitted) and the results are
+sorted to show first the libraries that best resolve the header.
 		`),
 		Example: "  " + os.Args[0] + " lib search audio                               # " + tr("basic search for \"audio\"") + "\n" +
 			"  " + os.Args[0] + " lib search name:buzzer                         # " + tr("libraries with \"buzzer\" in the Name field") + "\n" +
@@ -89,22 +96,25 @@ In addition to the fields listed above, QV terms can use these qualifiers:
 			"  " + os.Args[0] + " lib search author=Adafruit name:gfx            # " + tr("libraries authored only by Adafruit with \"gfx\" in their Name") + "\n" +
 			"  " + os.Args[0] + " lib search esp32 display maintainer=espressif  # " + tr("basic search for \"esp32\" and \"display\" limited to official Maintainer") + "\n" +
 			"  " + os.Args[0] + " lib search dependencies:IRremote               # " + tr("libraries that depend on at least \"IRremote\"") + "\n" +
-			"  " + os.Args[0] + " lib search dependencies=IRremote               # " + tr("libraries that depend only on \"IRremote\"") + "\n",
+			"  " + os.Args[0] + " lib search dependencies=IRremote               # " + tr("libraries that depend only on \"IRremote\"") + "\n" +
+			"  " + os.Args[0] + " lib search include:SD.h                        # " + tr("libraries providing the \"SD.h\" header") + "\n",
 		Args: cobra.ArbitraryArgs,
 		Run: func(cmd *cobra.Command, args []string) {
-			runSearchCommand(args, namesOnly, omitReleasesDetails)
+			runSearchCommand(args, namesOnly, omitReleasesDetails, limit, fuzzy)
 		},
 	}
 	searchCommand.Flags().BoolVar(&namesOnly, "names", false, tr("Show library names only."))
 	searchCommand.Flags().BoolVar(&omitReleasesDetails, "omit-releases-details", false, tr("Omit library details far all versions except the latest (produce a more compact JSON output)."))
+	searchCommand.Flags().Uint32Var(&limit, "limit", 0, tr("Show at most this number of libraries, 0 shows all the matching libraries."))
+	searchCommand.Flags().BoolVar(&fuzzy, "fuzzy", false, tr("Tolerate typos in the search terms."))
 	return searchCommand
 }
 
 // indexUpdateInterval specifies the time threshold over which indexes are updated
 const indexUpdateInterval = 60 * time.Minute
 
-func runSearchCommand(args []string, namesOnly bool, omitReleasesDetails bool) {
-	inst := instance.CreateAndInit()
+func runSearchCommand(args []string, namesOnly bool, omitReleasesDetails bool, limit uint32, fuzzy bool) {
+	inst := instance.CreateAndInitLazily()
 
 	logrus.Info("Executing `arduino-cli lib search`")
 
@@ -123,6 +133,8 @@ func runSearchCommand(args []string, namesOnly bool, omitReleasesDetails bool) {
 		Instance:            inst,
 		SearchArgs:          strings.Join(args, " "),
 		OmitReleasesDetails: omitReleasesDetails,
+		Limit:               limit,
+		Fuzzy:               fuzzy,
 	})
 	if err != nil {
 		feedback.Fatal(tr("Error searching for Libraries: %v", err), feedback.ErrGeneric)
@@ -220,6 +232,9 @@ func (res result) String() string {
 			out.WriteString(fmt.Sprintf("  "+tr("Dependencies: %s")+"\n", strings.Join(deps, ", ")))
 		}
 	}
+	if more := int(res.results.GetTotal()) - len(results); more > 0 {
+		out.WriteString(tr("%d more libraries match the search, increase --limit to show them.", more) + "\n")
+	}
 
 	return out.String()
 }
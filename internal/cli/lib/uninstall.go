@@ -45,7 +45,7 @@ func initUninstallCommand() *cobra.Command {
 }
 
 func runUninstallCommand(cmd *cobra.Command, args []string) {
-	instance := instance.CreateAndInit()
+	instance := instance.CreateAndInitLazily()
 	logrus.Info("Executing `arduino-cli lib uninstall`")
 
 	refs, err := ParseLibraryReferenceArgsAndAdjustCase(instance, args)
@@ -25,6 +25,7 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 func initUpdateIndexCommand() *cobra.Command {
@@ -40,14 +41,17 @@ func initUpdateIndexCommand() *cobra.Command {
 }
 
 func runUpdateIndexCommand(cmd *cobra.Command, args []string) {
-	inst := instance.CreateAndInit()
+	inst := instance.CreateAndInitLazily()
 	logrus.Info("Executing `arduino-cli lib update-index`")
 	UpdateIndex(inst)
 }
 
 // UpdateIndex updates the index of libraries.
 func UpdateIndex(inst *rpc.Instance) {
-	err := commands.UpdateLibrariesIndex(context.Background(), &rpc.UpdateLibrariesIndexRequest{
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
+	err := commands.UpdateLibrariesIndex(ctx, &rpc.UpdateLibrariesIndexRequest{
 		Instance: inst,
 	}, feedback.ProgressBar())
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 func initUpgradeCommand() *cobra.Command {
@@ -43,24 +44,27 @@ func initUpgradeCommand() *cobra.Command {
 }
 
 func runUpgradeCommand(cmd *cobra.Command, args []string) {
-	instance := instance.CreateAndInit()
+	instance := instance.CreateAndInitLazily()
 	logrus.Info("Executing `arduino-cli lib upgrade`")
 	Upgrade(instance, args)
 }
 
 // Upgrade upgrades the specified libraries
 func Upgrade(instance *rpc.Instance, libraries []string) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
 	var upgradeErr error
 	if len(libraries) == 0 {
 		req := &rpc.LibraryUpgradeAllRequest{Instance: instance}
-		upgradeErr = lib.LibraryUpgradeAll(req, feedback.ProgressBar(), feedback.TaskProgress())
+		upgradeErr = lib.LibraryUpgradeAll(ctx, req, feedback.ProgressBar(), feedback.TaskProgress())
 	} else {
 		for _, libName := range libraries {
 			req := &rpc.LibraryUpgradeRequest{
 				Instance: instance,
 				Name:     libName,
 			}
-			upgradeErr = lib.LibraryUpgrade(context.Background(), req, feedback.ProgressBar(), feedback.TaskProgress())
+			upgradeErr = lib.LibraryUpgrade(ctx, req, feedback.ProgressBar(), feedback.TaskProgress())
 			if upgradeErr != nil {
 				break
 			}
@@ -0,0 +1,171 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/ide15"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initFromIDE15Command() *cobra.Command {
+	var sketchbook string
+	var dryRun bool
+	fromIDE15Command := &cobra.Command{
+		Use:   fmt.Sprintf("from-ide15 [%s]", tr("IDE_DATA_DIR")),
+		Short: tr("Imports settings, libraries and platforms from the Arduino IDE 1.x."),
+		Long: tr("Imports the preferences, the board packages and the libraries and hardware folders of the sketchbook of an Arduino IDE 1.x (Java) installation. " +
+			"IDE_DATA_DIR is the folder containing the IDE preferences.txt, by default the standard Arduino15 folder. " +
+			"Libraries and platforms already present are never overwritten."),
+		Example: "  " + os.Args[0] + " migrate from-ide15\n" +
+			"  " + os.Args[0] + " migrate from-ide15 --dry-run\n" +
+			"  " + os.Args[0] + " migrate from-ide15 /mnt/old-home/.arduino15 --sketchbook /mnt/old-home/Arduino",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ideDataDir := configuration.GetDefaultArduinoDataDir()
+			if len(args) > 0 {
+				ideDataDir = args[0]
+			}
+			runFromIDE15Command(paths.New(ideDataDir), sketchbook, dryRun)
+		},
+	}
+	fromIDE15Command.Flags().StringVar(&sketchbook, "sketchbook", "", tr("The sketchbook folder of the IDE, overrides the one set in the IDE preferences."))
+	fromIDE15Command.Flags().BoolVar(&dryRun, "dry-run", false, tr("Show what would be migrated without changing anything."))
+	return fromIDE15Command
+}
+
+func runFromIDE15Command(ideDataDir *paths.Path, sketchbook string, dryRun bool) {
+	logrus.Info("Executing `arduino-cli migrate from-ide15`")
+
+	installation, err := ide15.Open(ideDataDir)
+	if err != nil {
+		feedback.Fatal(tr("Error opening the Arduino IDE installation: %v", err), feedback.ErrBadArgument)
+	}
+	sketchbookDir := installation.SketchbookDir()
+	if sketchbook != "" {
+		sketchbookDir = paths.New(sketchbook)
+	}
+
+	dataDir := configuration.DataDir(configuration.Settings)
+	userDir := paths.New(configuration.Settings.GetString("directories.User"))
+	report, err := installation.Migrate(dataDir, userDir, sketchbookDir, dryRun)
+	if err != nil {
+		feedback.Fatal(tr("Error migrating from the Arduino IDE: %v", err), feedback.ErrGeneric)
+	}
+
+	for _, setting := range report.Settings {
+		value := setting.Value
+		if values, ok := value.([]string); ok {
+			value = mergeStringSlices(configuration.Settings.GetStringSlice(setting.Key), values)
+		}
+		configuration.Settings.Set(setting.Key, value)
+	}
+	if len(report.Settings) > 0 && !dryRun {
+		if err := configuration.WriteConfig(configuration.Settings); err != nil {
+			feedback.Fatal(tr("Writing config file: %v", err), feedback.ErrGeneric)
+		}
+	}
+
+	feedback.PrintResult(&migrateResult{Report: report, DryRun: dryRun})
+}
+
+func mergeStringSlices(current, added []string) []string {
+	res := append([]string{}, current...)
+	for _, item := range added {
+		found := false
+		for _, existing := range res {
+			if existing == item {
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+type migrateResult struct {
+	*ide15.Report
+	DryRun bool `json:"dry_run"`
+}
+
+func (r *migrateResult) Data() interface{} {
+	return r
+}
+
+func (r *migrateResult) String() string {
+	titleColor := color.New(color.FgHiGreen)
+	nameColor := color.New(color.FgHiYellow)
+	pathColor := color.New(color.FgHiBlack)
+
+	res := ""
+	if len(r.Settings) > 0 {
+		settings := table.New()
+		settings.SetHeader(
+			table.NewCell(tr("Setting"), titleColor),
+			table.NewCell(tr("Value"), titleColor),
+			table.NewCell(tr("From preference"), titleColor))
+		for _, setting := range r.Settings {
+			value := fmt.Sprint(setting.Value)
+			if values, ok := setting.Value.([]string); ok {
+				value = strings.Join(values, " ")
+			}
+			settings.AddRow(table.NewCell(setting.Key, nameColor), value, table.NewCell(setting.Preference, pathColor))
+		}
+		res += fmt.Sprintln(settings.Render())
+	}
+	if len(r.Copied) > 0 {
+		copied := table.New()
+		copied.SetHeader(
+			table.NewCell(tr("Copied"), titleColor),
+			table.NewCell(tr("Name"), titleColor),
+			table.NewCell(tr("Destination"), titleColor))
+		for _, item := range r.Copied {
+			copied.AddRow(item.Kind, table.NewCell(item.Name, nameColor), table.NewCell(item.To, pathColor))
+		}
+		res += fmt.Sprintln(copied.Render())
+	}
+	if len(r.Skipped) > 0 {
+		skipped := table.New()
+		skipped.SetHeader(
+			table.NewCell(tr("Not migrated"), titleColor),
+			table.NewCell(tr("Name"), titleColor),
+			table.NewCell(tr("Reason"), titleColor))
+		for _, item := range r.Skipped {
+			skipped.AddRow(item.Kind, table.NewCell(item.Name, nameColor), item.Reason)
+		}
+		res += fmt.Sprintln(skipped.Render())
+	}
+	if res == "" {
+		return tr("Nothing to migrate.")
+	}
+	res = strings.TrimRight(res, "\n")
+	if r.DryRun {
+		res += "\n\n" + tr("Dry run, nothing was changed.")
+	}
+	return res
+}
@@ -0,0 +1,41 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package migrate
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `migrate` command
+func NewCommand() *cobra.Command {
+	migrateCommand := &cobra.Command{
+		Use:   "migrate",
+		Short: tr("Commands to migrate from other Arduino tools."),
+		Long:  tr("Commands to migrate settings, libraries and platforms from other Arduino tools, or to another layout of the directories."),
+		Example: "  " + os.Args[0] + " migrate from-ide15\n" +
+			"  " + os.Args[0] + " migrate to-xdg",
+	}
+
+	migrateCommand.AddCommand(initFromIDE15Command())
+	migrateCommand.AddCommand(initToXDGCommand())
+
+	return migrateCommand
+}
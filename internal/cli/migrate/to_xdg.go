@@ -0,0 +1,198 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func initToXDGCommand() *cobra.Command {
+	var dryRun bool
+	toXDGCommand := &cobra.Command{
+		Use:   "to-xdg",
+		Short: tr("Moves the data, the downloads and the configuration file to the XDG folders."),
+		Long: tr("Moves the content of the legacy Arduino15 data folder to the folders of the XDG layout: the downloads to the cache folder, " +
+			"the configuration file to the config folder and everything else to the data folder. " +
+			"The configuration file selects the XDG layout from then on, the entries already present in the destination are never overwritten."),
+		Example: "  " + os.Args[0] + " migrate to-xdg\n" +
+			"  " + os.Args[0] + " migrate to-xdg --dry-run",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runToXDGCommand(dryRun)
+		},
+	}
+	toXDGCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Show what would be moved without changing anything."))
+	return toXDGCommand
+}
+
+func runToXDGCommand(dryRun bool) {
+	logrus.Info("Executing `arduino-cli migrate to-xdg`")
+
+	if os.Getenv("ARDUINO_ROOT") != "" {
+		feedback.Fatal(tr("The directories are relocated with ARDUINO_ROOT, the XDG layout is not used"), feedback.ErrBadArgument)
+	}
+	dataDir := paths.New(configuration.GetXDGDataDir())
+	cacheDir := paths.New(configuration.GetXDGCacheDir())
+	configDir := paths.New(configuration.GetXDGConfigDir())
+	if dataDir == nil || cacheDir == nil || configDir == nil {
+		feedback.Fatal(tr("Unable to determine the XDG folders"), feedback.ErrGeneric)
+	}
+	legacyDir := paths.New(configuration.GetDefaultArduinoDataDir())
+	if !legacyDir.IsDir() {
+		feedback.Fatal(tr("Legacy data folder %s not found", legacyDir), feedback.ErrBadArgument)
+	}
+
+	res, err := migrateToXDG(legacyDir, dataDir, cacheDir, configDir, dryRun)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(res)
+}
+
+// migrateToXDG moves the entries of the legacy data folder to the XDG
+// folders, the entries already present in the destination are skipped. With
+// dryRun nothing is changed.
+func migrateToXDG(legacyDir, dataDir, cacheDir, configDir *paths.Path, dryRun bool) (*toXDGResult, error) {
+	entries, err := legacyDir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", tr("Error reading the legacy data folder"), err)
+	}
+	res := &toXDGResult{DryRun: dryRun}
+	for _, entry := range entries {
+		dest := dataDir.Join(entry.Base())
+		switch entry.Base() {
+		case "staging":
+			dest = cacheDir.Join("staging")
+		case "arduino-cli.yaml":
+			dest = configDir.Join("arduino-cli.yaml")
+		}
+		if dest.Exist() {
+			res.Skipped = append(res.Skipped, &movedEntry{From: entry.String(), To: dest.String()})
+			continue
+		}
+		res.Moved = append(res.Moved, &movedEntry{From: entry.String(), To: dest.String()})
+		if dryRun {
+			continue
+		}
+		if entry.Base() == "arduino-cli.yaml" {
+			err = moveConfigFile(entry, dest, legacyDir, dataDir, cacheDir)
+		} else {
+			err = moveEntry(entry, dest)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", tr("Error moving %s", entry), err)
+		}
+	}
+
+	// The XDG layout is selected by the presence of the configuration file
+	if configFile := configDir.Join("arduino-cli.yaml"); !dryRun && !configFile.Exist() {
+		settings := viper.New()
+		settings.Set("directories.layout", configuration.XDGLayout)
+		if err := configDir.MkdirAll(); err != nil {
+			return nil, fmt.Errorf("%s: %w", tr("Cannot create config file directory"), err)
+		}
+		if err := settings.WriteConfigAs(configFile.String()); err != nil {
+			return nil, fmt.Errorf("%s: %w", tr("Cannot create config file"), err)
+		}
+	}
+	return res, nil
+}
+
+// moveEntry renames the file or folder, falling back to a copy if the
+// destination is on another filesystem
+func moveEntry(from, to *paths.Path) error {
+	if err := to.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	return utils.Move(from, to)
+}
+
+// moveConfigFile moves the configuration file, the data and downloads
+// directories pointing to the legacy data folder are updated to the XDG
+// folders and the XDG layout is selected
+func moveConfigFile(from, to, legacyDir, dataDir, cacheDir *paths.Path) error {
+	settings := viper.New()
+	settings.SetConfigFile(from.String())
+	if err := settings.ReadInConfig(); err != nil {
+		return err
+	}
+	if dir := paths.New(settings.GetString("directories.data")); dir != nil && dir.EquivalentTo(legacyDir) {
+		settings.Set("directories.data", dataDir.String())
+	}
+	if dir := paths.New(settings.GetString("directories.downloads")); dir != nil && dir.EquivalentTo(legacyDir.Join("staging")) {
+		settings.Set("directories.downloads", cacheDir.Join("staging").String())
+	}
+	settings.Set("directories.layout", configuration.XDGLayout)
+	if err := to.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	if err := settings.WriteConfigAs(to.String()); err != nil {
+		return err
+	}
+	return from.Remove()
+}
+
+type movedEntry struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type toXDGResult struct {
+	Moved   []*movedEntry `json:"moved,omitempty"`
+	Skipped []*movedEntry `json:"skipped,omitempty"`
+	DryRun  bool          `json:"dry_run"`
+}
+
+func (r *toXDGResult) Data() interface{} {
+	return r
+}
+
+func (r *toXDGResult) String() string {
+	if len(r.Moved) == 0 && len(r.Skipped) == 0 {
+		return tr("Nothing to migrate.")
+	}
+	titleColor := color.New(color.FgHiGreen)
+	pathColor := color.New(color.FgHiBlack)
+
+	t := table.New()
+	t.SetHeader(
+		table.NewCell(tr("From"), titleColor),
+		table.NewCell(tr("To"), titleColor),
+		table.NewCell(tr("Status"), titleColor))
+	for _, entry := range r.Moved {
+		t.AddRow(entry.From, table.NewCell(entry.To, pathColor), tr("moved"))
+	}
+	for _, entry := range r.Skipped {
+		t.AddRow(entry.From, table.NewCell(entry.To, pathColor), tr("already present, not moved"))
+	}
+	res := strings.TrimRight(t.Render(), "\n")
+	if r.DryRun {
+		res += "\n\n" + tr("Dry run, nothing was changed.")
+	}
+	return res
+}
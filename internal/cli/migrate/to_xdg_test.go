@@ -0,0 +1,99 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateToXDG(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	legacyDir := tmp.Join("Arduino15")
+	dataDir := tmp.Join("data", "arduino-cli")
+	cacheDir := tmp.Join("cache", "arduino-cli")
+	configDir := tmp.Join("config", "arduino-cli")
+	require.NoError(t, legacyDir.Join("packages", "arduino").MkdirAll())
+	require.NoError(t, legacyDir.Join("staging", "packages").MkdirAll())
+	require.NoError(t, legacyDir.Join("package_index.json").WriteFile([]byte("{}")))
+	require.NoError(t, legacyDir.Join("arduino-cli.yaml").WriteFile([]byte(
+		"directories:\n  data: "+legacyDir.String()+"\n  downloads: "+legacyDir.Join("staging").String()+"\n")))
+	// Already present in the destination
+	require.NoError(t, legacyDir.Join("library_index.json").WriteFile([]byte("legacy")))
+	require.NoError(t, dataDir.MkdirAll())
+	require.NoError(t, dataDir.Join("library_index.json").WriteFile([]byte("xdg")))
+
+	// Nothing is changed in dry run
+	res, err := migrateToXDG(legacyDir, dataDir, cacheDir, configDir, true)
+	require.NoError(t, err)
+	require.True(t, res.DryRun)
+	require.Len(t, res.Moved, 4)
+	require.Len(t, res.Skipped, 1)
+	require.Equal(t, dataDir.Join("library_index.json").String(), res.Skipped[0].To)
+	require.DirExists(t, legacyDir.Join("packages", "arduino").String())
+	require.NoDirExists(t, cacheDir.String())
+	require.NoDirExists(t, configDir.String())
+
+	res, err = migrateToXDG(legacyDir, dataDir, cacheDir, configDir, false)
+	require.NoError(t, err)
+	require.Len(t, res.Moved, 4)
+	require.DirExists(t, dataDir.Join("packages", "arduino").String())
+	require.FileExists(t, dataDir.Join("package_index.json").String())
+	require.DirExists(t, cacheDir.Join("staging", "packages").String())
+	require.NoDirExists(t, legacyDir.Join("packages").String())
+	require.NoFileExists(t, legacyDir.Join("arduino-cli.yaml").String())
+	data, err := dataDir.Join("library_index.json").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "xdg", string(data))
+	require.FileExists(t, legacyDir.Join("library_index.json").String())
+
+	// The configuration file points to the XDG folders
+	settings := viper.New()
+	settings.SetConfigFile(configDir.Join("arduino-cli.yaml").String())
+	require.NoError(t, settings.ReadInConfig())
+	require.Equal(t, dataDir.String(), settings.GetString("directories.data"))
+	require.Equal(t, cacheDir.Join("staging").String(), settings.GetString("directories.downloads"))
+	require.Equal(t, configuration.XDGLayout, settings.GetString("directories.layout"))
+
+	// Running it again moves nothing
+	res, err = migrateToXDG(legacyDir, dataDir, cacheDir, configDir, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Moved)
+	require.Len(t, res.Skipped, 1)
+}
+
+func TestMigrateToXDGWithoutConfigFile(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	legacyDir := tmp.Join("Arduino15")
+	configDir := tmp.Join("config")
+	require.NoError(t, legacyDir.MkdirAll())
+
+	// The configuration file is created to select the XDG layout
+	res, err := migrateToXDG(legacyDir, tmp.Join("data"), tmp.Join("cache"), configDir, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Moved)
+	settings := viper.New()
+	settings.SetConfigFile(configDir.Join("arduino-cli.yaml").String())
+	require.NoError(t, settings.ReadInConfig())
+	require.Equal(t, configuration.XDGLayout, settings.GetString("directories.layout"))
+
+	_, err = migrateToXDG(tmp.Join("missing"), tmp.Join("data"), tmp.Join("cache"), configDir, false)
+	require.Error(t, err)
+}
@@ -0,0 +1,257 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// controlsEscapeChar is the key (CTRL-T) that starts a command of the
+// interactive controls.
+const controlsEscapeChar = 0x14
+
+// configurablePort is the port opened by the monitor, it can be written and
+// configured while the monitor is running.
+type configurablePort interface {
+	io.Writer
+	Config(setting, value string) error
+}
+
+// controls forwards the keys typed by the user to the port and runs the
+// commands of the interactive controls, introduced by CTRL-T, to change the
+// settings of the port, send a file or switch to the hexadecimal input.
+type controls struct {
+	in       *bufio.Reader
+	out      io.Writer
+	port     configurablePort
+	settings []*rpc.MonitorPortSettingDescriptor
+	values   map[string]string
+	hexInput bool
+	hexDigit []byte
+}
+
+func newControls(in io.Reader, out io.Writer, port configurablePort, settings []*rpc.MonitorPortSettingDescriptor, values map[string]string) *controls {
+	c := &controls{
+		in:       bufio.NewReader(in),
+		out:      out,
+		port:     port,
+		settings: settings,
+		values:   map[string]string{},
+	}
+	for _, setting := range settings {
+		c.values[setting.SettingId] = setting.Value
+	}
+	for id, value := range values {
+		c.values[id] = value
+	}
+	return c
+}
+
+// run processes the input until it's closed or the user quits the monitor,
+// in both cases io.EOF is returned.
+func (c *controls) run() error {
+	for {
+		b, err := c.in.ReadByte()
+		if err != nil {
+			return err
+		}
+		if b != controlsEscapeChar {
+			if err := c.send(b); err != nil {
+				return err
+			}
+			continue
+		}
+
+		cmd, err := c.in.ReadByte()
+		if err != nil {
+			return err
+		}
+		switch cmd {
+		case 'q', 'x':
+			c.message(tr("Exiting the monitor"))
+			return io.EOF
+		case controlsEscapeChar:
+			if _, err := c.port.Write([]byte{controlsEscapeChar}); err != nil {
+				return err
+			}
+		case 'b':
+			baudrate, err := c.readLine(tr("Baud rate: "))
+			if err != nil {
+				return err
+			}
+			if baudrate != "" {
+				c.configure("baudrate", baudrate)
+			}
+		case 'd':
+			c.toggle("dtr")
+		case 'r':
+			c.toggle("rts")
+		case 'f':
+			file, err := c.readLine(tr("File to send: "))
+			if err != nil {
+				return err
+			}
+			if file != "" {
+				if err := c.sendFile(paths.New(file)); err != nil {
+					return err
+				}
+			}
+		case 'h':
+			c.hexInput = !c.hexInput
+			c.hexDigit = nil
+			if c.hexInput {
+				c.message(tr("Hexadecimal input enabled"))
+			} else {
+				c.message(tr("Hexadecimal input disabled"))
+			}
+		case 's':
+			c.message(c.status())
+		default:
+			c.message(c.help())
+		}
+	}
+}
+
+// send writes the typed character to the port, in hexadecimal input mode the
+// bytes are sent when both their digits have been typed.
+func (c *controls) send(b byte) error {
+	if !c.hexInput {
+		_, err := c.port.Write([]byte{b})
+		return err
+	}
+	if !strings.ContainsRune("0123456789abcdefABCDEF", rune(b)) {
+		return nil
+	}
+	c.hexDigit = append(c.hexDigit, b)
+	if len(c.hexDigit) < 2 {
+		return nil
+	}
+	data, _ := hex.DecodeString(string(c.hexDigit))
+	c.hexDigit = nil
+	_, err := c.port.Write(data)
+	return err
+}
+
+func (c *controls) sendFile(file *paths.Path) error {
+	data, err := file.ReadFile()
+	if err != nil {
+		c.message(tr("Error reading %[1]s: %[2]v", file, err))
+		return nil
+	}
+	if _, err := c.port.Write(data); err != nil {
+		return err
+	}
+	c.message(tr("Sent %[1]d bytes from %[2]s", len(data), file))
+	return nil
+}
+
+// toggle switches the setting between its two values, as the on/off of the
+// dtr and rts lines.
+func (c *controls) toggle(id string) {
+	setting := findSetting(c.settings, id, "")
+	if setting == nil || len(setting.EnumValues) != 2 {
+		c.message(tr("The setting %s is not supported by the monitor of the port", id))
+		return
+	}
+	value := setting.EnumValues[0]
+	if strings.EqualFold(c.values[setting.SettingId], value) {
+		value = setting.EnumValues[1]
+	}
+	c.configure(setting.SettingId, value)
+}
+
+func (c *controls) configure(id, value string) {
+	setting := findSetting(c.settings, id, value)
+	if setting == nil {
+		if setting := findSetting(c.settings, id, ""); setting != nil {
+			c.message(tr("Invalid value for %[1]s: %[2]s, the valid values are: %[3]s", id, value, strings.Join(setting.EnumValues, ", ")))
+		} else {
+			c.message(tr("The setting %s is not supported by the monitor of the port", id))
+		}
+		return
+	}
+	if err := c.port.Config(setting.SettingId, value); err != nil {
+		c.message(tr("Error setting %[1]s: %[2]v", setting.SettingId, err))
+		return
+	}
+	c.values[setting.SettingId] = value
+	c.message(fmt.Sprintf("%s=%s", setting.SettingId, value))
+}
+
+// readLine reads a line typed by the user, echoing it since the terminal is in
+// raw mode. ESC cancels the input and returns an empty string.
+func (c *controls) readLine(prompt string) (string, error) {
+	fmt.Fprint(c.out, "\r\n"+prompt)
+	line := []byte{}
+	for {
+		b, err := c.in.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		switch b {
+		case '\r', '\n':
+			fmt.Fprint(c.out, "\r\n")
+			return strings.TrimSpace(string(line)), nil
+		case 0x1b: // ESC
+			fmt.Fprint(c.out, "\r\n")
+			return "", nil
+		case 0x08, 0x7f: // Backspace
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+				fmt.Fprint(c.out, "\b \b")
+			}
+		default:
+			line = append(line, b)
+			c.out.Write([]byte{b})
+		}
+	}
+}
+
+func (c *controls) message(msg string) {
+	fmt.Fprintf(c.out, "\r\n*** %s ***\r\n", msg)
+}
+
+func (c *controls) status() string {
+	status := []string{}
+	for _, setting := range c.settings {
+		status = append(status, fmt.Sprintf("%s=%s", setting.SettingId, c.values[setting.SettingId]))
+	}
+	if c.hexInput {
+		status = append(status, tr("hexadecimal input"))
+	}
+	return strings.Join(status, ", ")
+}
+
+func (c *controls) help() string {
+	return strings.Join([]string{
+		tr("Monitor controls, press CTRL-T followed by:"),
+		"  b  " + tr("change the baud rate"),
+		"  d  " + tr("toggle the DTR line"),
+		"  r  " + tr("toggle the RTS line"),
+		"  f  " + tr("send a file"),
+		"  h  " + tr("toggle the hexadecimal input"),
+		"  s  " + tr("show the port settings"),
+		"  q  " + tr("exit the monitor"),
+		"  CTRL-T  " + tr("send CTRL-T"),
+	}, "\r\n")
+}
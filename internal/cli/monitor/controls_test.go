@@ -0,0 +1,71 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePort struct {
+	bytes.Buffer
+	config []string
+}
+
+func (p *fakePort) Config(setting, value string) error {
+	p.config = append(p.config, setting+"="+value)
+	return nil
+}
+
+func TestControls(t *testing.T) {
+	settings := []*rpc.MonitorPortSettingDescriptor{
+		{SettingId: "baudrate", Value: "9600", EnumValues: []string{"9600", "115200"}},
+		{SettingId: "dtr", Value: "on", EnumValues: []string{"on", "off"}},
+	}
+	run := func(input string) (*fakePort, string) {
+		port := &fakePort{}
+		out := &bytes.Buffer{}
+		c := newControls(strings.NewReader(input), out, port, settings, map[string]string{"baudrate": "115200"})
+		require.ErrorIs(t, c.run(), io.EOF)
+		return port, out.String()
+	}
+
+	port, _ := run("hello\x14\x14")
+	require.Equal(t, "hello\x14", port.String())
+
+	port, out := run("\x14b9600\r\x14b1200\r\x14d\x14d\x14r\x14q")
+	require.Equal(t, []string{"baudrate=9600", "dtr=off", "dtr=on"}, port.config)
+	require.Contains(t, out, "Invalid value for baudrate: 1200")
+	require.Contains(t, out, "The setting rts is not supported")
+
+	port, _ = run("a\x14h01 fF\nz0\x14h\x14hA")
+	require.Equal(t, []byte{'a', 0x01, 0xff}, port.Bytes())
+
+	tmp, err := paths.MkTempDir("", "monitor_controls")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	file := tmp.Join("data.txt")
+	require.NoError(t, file.WriteFile([]byte("file content")))
+	port, out = run("\x14f" + file.String() + "\r\x14fmissing\x7f\x7f\x7f\x7f\x7f\x7f\x7f\x1b")
+	require.Equal(t, "file content", port.String())
+	require.Contains(t, out, "Sent 12 bytes")
+}
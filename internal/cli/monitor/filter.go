@@ -0,0 +1,194 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/fatih/color"
+)
+
+// partialLineTimeout is the time after which a line not yet terminated, as
+// a prompt, is written anyway to keep the monitor interactive.
+const partialLineTimeout = 200 * time.Millisecond
+
+// highlightRule colors the lines matching the pattern
+type highlightRule struct {
+	Pattern string `mapstructure:"pattern"`
+	Color   string `mapstructure:"color"`
+	regexp  *regexp.Regexp
+	color   *color.Color
+}
+
+// defaultHighlightRules colors the lines by the log level, in the common
+// formats "[ERROR] message", "error: message" and "E (1234) tag: message".
+var defaultHighlightRules = []*highlightRule{
+	{Pattern: `(?i)\b(error|err|fatal|panic|critical|crit)\b|^E[ (/:]`, Color: "red"},
+	{Pattern: `(?i)\b(warning|warn)\b|^W[ (/:]`, Color: "yellow"},
+	{Pattern: `(?i)\binfo\b|^I[ (/:]`, Color: "green"},
+	{Pattern: `(?i)\b(debug|trace|verbose)\b|^[DV][ (/:]`, Color: "cyan"},
+}
+
+var highlightColors = map[string]color.Attribute{
+	"black":   color.FgBlack,
+	"red":     color.FgRed,
+	"green":   color.FgGreen,
+	"yellow":  color.FgYellow,
+	"blue":    color.FgBlue,
+	"magenta": color.FgMagenta,
+	"cyan":    color.FgCyan,
+	"white":   color.FgWhite,
+	"bold":    color.Bold,
+	"faint":   color.Faint,
+}
+
+// compile checks the pattern and the color of the rule
+func (r *highlightRule) compile() error {
+	re, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return err
+	}
+	attrs := []color.Attribute{}
+	for _, name := range strings.Fields(strings.ReplaceAll(r.Color, ",", " ")) {
+		attr, ok := highlightColors[strings.ToLower(name)]
+		if !ok {
+			return errors.New(tr("invalid color %s", name))
+		}
+		attrs = append(attrs, attr)
+	}
+	r.regexp = re
+	r.color = color.New(attrs...)
+	return nil
+}
+
+// lineFilterWriter writes only the lines matching the include patterns, if
+// any, and not matching the exclude patterns, coloring them with the first
+// matching highlight rule. The lines are matched without their ANSI escape
+// sequences.
+type lineFilterWriter struct {
+	writer    io.Writer
+	include   []*regexp.Regexp
+	exclude   []*regexp.Regexp
+	highlight []*highlightRule
+
+	mutex sync.Mutex
+	line  []byte
+	timer *time.Timer
+	// The decision taken on the beginning of a line already written because
+	// it was not terminated in time, it's applied to the rest of the line.
+	partial      bool
+	partialShow  bool
+	partialColor *color.Color
+}
+
+func newLineFilterWriter(writer io.Writer, include, exclude []*regexp.Regexp, highlight []*highlightRule) *lineFilterWriter {
+	return &lineFilterWriter{
+		writer:    writer,
+		include:   include,
+		exclude:   exclude,
+		highlight: highlight,
+	}
+}
+
+func (f *lineFilterWriter) Write(p []byte) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.timer != nil {
+		f.timer.Stop()
+		f.timer = nil
+	}
+	f.line = append(f.line, p...)
+	for {
+		i := bytes.IndexByte(f.line, '\n')
+		if i == -1 {
+			break
+		}
+		line := f.line[:i+1]
+		f.line = f.line[i+1:]
+		if err := f.writeLine(line, true); err != nil {
+			return 0, err
+		}
+	}
+	if len(f.line) > 0 {
+		f.timer = time.AfterFunc(partialLineTimeout, f.flushPartialLine)
+	}
+	return len(p), nil
+}
+
+func (f *lineFilterWriter) flushPartialLine() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.line) == 0 {
+		return
+	}
+	line := f.line
+	f.line = nil
+	_ = f.writeLine(line, false)
+}
+
+// writeLine writes the line, or the part of it, if it passes the filters
+func (f *lineFilterWriter) writeLine(line []byte, complete bool) error {
+	show, c := f.partialShow, f.partialColor
+	if !f.partial {
+		show, c = f.match(line)
+	}
+	f.partial = !complete
+	f.partialShow, f.partialColor = show, c
+	if !show {
+		return nil
+	}
+	if c == nil {
+		_, err := f.writer.Write(line)
+		return err
+	}
+	text := bytes.TrimRight(line, "\r\n")
+	_, err := f.writer.Write([]byte(c.Sprint(string(text)) + string(line[len(text):])))
+	return err
+}
+
+func (f *lineFilterWriter) match(line []byte) (bool, *color.Color) {
+	stripped := &bytes.Buffer{}
+	feedback.NewANSIStripWriter(stripped).Write(line)
+	text := strings.TrimRight(stripped.String(), "\r\n")
+
+	matchAny := func(regexps []*regexp.Regexp) bool {
+		for _, re := range regexps {
+			if re.MatchString(text) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(f.include) > 0 && !matchAny(f.include) {
+		return false, nil
+	}
+	if matchAny(f.exclude) {
+		return false, nil
+	}
+	for _, rule := range f.highlight {
+		if rule.regexp.MatchString(text) {
+			return true, rule.color
+		}
+	}
+	return true, nil
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -46,14 +47,20 @@ var tr = i18n.Tr
 // NewCommand created a new `monitor` command
 func NewCommand() *cobra.Command {
 	var (
-		portArgs   arguments.Port
-		fqbnArg    arguments.Fqbn
-		profileArg arguments.Profile
-		raw        bool
-		describe   bool
-		configs    []string
-		quiet      bool
-		timestamp  bool
+		portArgs     arguments.Port
+		fqbnArg      arguments.Fqbn
+		profileArg   arguments.Profile
+		raw          bool
+		describe     bool
+		configs      []string
+		quiet        bool
+		timestamp    bool
+		saveSettings bool
+		ctrl         bool
+		ansi         string
+		filters      []string
+		excludes     []string
+		highlight    bool
 	)
 	monitorCommand := &cobra.Command{
 		Use:   "monitor",
@@ -67,30 +74,84 @@ func NewCommand() *cobra.Command {
 			if len(args) > 0 {
 				sketchPath = args[0]
 			}
-			runMonitorCmd(&portArgs, &fqbnArg, &profileArg, sketchPath, configs, describe, timestamp, quiet, raw)
+			runMonitorCmd(&portArgs, &fqbnArg, &profileArg, sketchPath, configs, describe, timestamp, quiet, raw, saveSettings, ctrl, ansi, filters, excludes, highlight)
 		},
 	}
 	portArgs.AddToCommand(monitorCommand)
 	profileArg.AddToCommand(monitorCommand)
-	monitorCommand.Flags().BoolVar(&raw, "raw", false, tr("Set terminal in raw mode (unbuffered)."))
+	monitorCommand.Flags().BoolVar(&raw, "raw", false, tr("Set terminal in raw mode (unbuffered), the data received from the port is written unmodified unless --ansi is set."))
 	monitorCommand.Flags().BoolVar(&describe, "describe", false, tr("Show all the settings of the communication port."))
 	monitorCommand.Flags().StringSliceVarP(&configs, "config", "c", []string{}, tr("Configure communication port settings. The format is <ID>=<value>[,<ID>=<value>]..."))
 	monitorCommand.Flags().BoolVarP(&quiet, "quiet", "q", false, tr("Run in silent mode, show only monitor input and output."))
 	monitorCommand.Flags().BoolVar(&timestamp, "timestamp", false, tr("Timestamp each incoming line."))
+	monitorCommand.Flags().BoolVar(&saveSettings, "save-settings", false, tr("Save the port settings given with --config, they will be applied automatically the next time the monitor is opened on the same port or board."))
+	monitorCommand.Flags().BoolVar(&ctrl, "ctrl", false, tr("Enable the interactive controls, started with CTRL-T, to change the baud rate, toggle DTR and RTS, send a file or type hexadecimal data. Implies --raw."))
+	monitorCommand.Flags().StringVar(&ansi, "ansi", "auto", tr("How to output the ANSI escape sequences (colors, cursor movements) received from the port: render, strip or auto (render on a terminal, strip otherwise)."))
+	monitorCommand.RegisterFlagCompletionFunc("ansi", cobra.FixedCompletions([]string{"auto", "render", "strip"}, cobra.ShellCompDirectiveDefault))
+	monitorCommand.Flags().StringArrayVar(&filters, "filter", []string{}, tr("Show only the lines matching the regular expression, can be used multiple times."))
+	monitorCommand.Flags().StringArrayVar(&excludes, "exclude", []string{}, tr("Hide the lines matching the regular expression, can be used multiple times."))
+	monitorCommand.Flags().BoolVar(&highlight, "highlight", false, tr("Color the lines by their log level, the rules can be customized with the monitor.highlight setting."))
 	fqbnArg.AddToCommand(monitorCommand)
 	return monitorCommand
 }
 
 func runMonitorCmd(
 	portArgs *arguments.Port, fqbnArg *arguments.Fqbn, profileArg *arguments.Profile, sketchPathArg string,
-	configs []string, describe, timestamp, quiet, raw bool,
+	configs []string, describe, timestamp, quiet, raw, saveSettings, ctrl bool, ansi string,
+	filters, excludes []string, highlight bool,
 ) {
 	logrus.Info("Executing `arduino-cli monitor`")
 
+	if ctrl {
+		// The keys of the controls must be read as soon as they are typed
+		raw = true
+	}
+
+	switch ansi {
+	case "render", "strip":
+	case "auto":
+		// In raw mode the output is passed through, as the input
+		if raw || configuration.HasConsole {
+			ansi = "render"
+		} else {
+			ansi = "strip"
+		}
+	default:
+		feedback.Fatal(tr("Invalid value for --ansi: %s, must be one of render, strip or auto", ansi), feedback.ErrBadArgument)
+	}
+
 	if !configuration.HasConsole {
 		quiet = true
 	}
 
+	compileRegexps := func(patterns []string) []*regexp.Regexp {
+		res := []*regexp.Regexp{}
+		for _, pattern := range patterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				feedback.Fatal(tr("Invalid regular expression %[1]s: %[2]v", pattern, err), feedback.ErrBadArgument)
+			}
+			res = append(res, re)
+		}
+		return res
+	}
+	includeRegexps, excludeRegexps := compileRegexps(filters), compileRegexps(excludes)
+	var highlightRules []*highlightRule
+	if highlight {
+		highlightRules = defaultHighlightRules
+		if configuration.Settings.IsSet("monitor.highlight") {
+			highlightRules = []*highlightRule{}
+			if err := configuration.Settings.UnmarshalKey("monitor.highlight", &highlightRules); err != nil {
+				feedback.Fatal(tr("Invalid monitor.highlight setting: %v", err), feedback.ErrBadArgument)
+			}
+		}
+		for _, rule := range highlightRules {
+			if err := rule.compile(); err != nil {
+				feedback.Fatal(tr("Invalid monitor.highlight rule %[1]s: %[2]v", rule.Pattern, err), feedback.ErrBadArgument)
+			}
+		}
+	}
+
 	var (
 		inst                         *rpc.Instance
 		profile                      *rpc.Profile
@@ -140,10 +201,11 @@ func runMonitorCmd(
 		fqbn, _ = portArgs.DetectFQBN(inst)
 	}
 
-	portAddress, portProtocol, err := portArgs.GetPortAddressAndProtocol(inst, defaultPort, defaultProtocol)
+	port, err := portArgs.GetPortOrAddress(inst, defaultPort, defaultProtocol)
 	if err != nil {
 		feedback.FatalError(err, feedback.ErrGeneric)
 	}
+	portAddress, portProtocol := port.GetAddress(), port.GetProtocol()
 
 	enumerateResp, err := monitor.EnumerateMonitorPortSettings(context.Background(), &rpc.EnumerateMonitorPortSettingsRequest{
 		Instance:     inst,
@@ -158,44 +220,72 @@ func runMonitorCmd(
 		return
 	}
 
-	configuration := &rpc.MonitorPortConfiguration{}
-	if len(configs) > 0 {
-		for _, config := range configs {
-			split := strings.SplitN(config, "=", 2)
-			k := ""
-			v := config
-			if len(split) == 2 {
-				k = split[0]
-				v = split[1]
-			}
+	settingsFile := configuration.DataDir(configuration.Settings).Join("monitor_settings.yaml")
+	saved, err := loadSavedSettings(settingsFile)
+	if err != nil {
+		feedback.Warning(tr("Error loading saved monitor settings: %v", err))
+		saved = &savedSettings{}
+	}
 
-			var setting *rpc.MonitorPortSettingDescriptor
-			for _, s := range enumerateResp.GetSettings() {
-				if k == "" {
-					if contains(s.EnumValues, v) {
-						setting = s
-						break
-					}
-				} else {
-					if strings.EqualFold(s.SettingId, k) {
-						if !contains(s.EnumValues, v) {
-							feedback.Fatal(tr("invalid port configuration value for %s: %s", k, v), feedback.ErrBadArgument)
-						}
-						setting = s
-						break
-					}
-				}
-			}
-			if setting == nil {
-				feedback.Fatal(tr("invalid port configuration: %s", config), feedback.ErrBadArgument)
+	// The saved settings are applied first, so they can be overridden by the --config flags
+	settings := map[string]string{}
+	for k, v := range saved.get(fqbn, port.GetHardwareId()) {
+		if setting := findSetting(enumerateResp.GetSettings(), k, v); setting == nil {
+			feedback.Warning(tr("Ignoring invalid saved port configuration: %s=%s", k, v))
+		} else {
+			settings[setting.SettingId] = v
+		}
+	}
+	flagSettings := map[string]string{}
+	for _, config := range configs {
+		split := strings.SplitN(config, "=", 2)
+		k := ""
+		v := config
+		if len(split) == 2 {
+			k = split[0]
+			v = split[1]
+		}
+
+		setting := findSetting(enumerateResp.GetSettings(), k, v)
+		if setting == nil {
+			if k != "" && findSetting(enumerateResp.GetSettings(), k, "") != nil {
+				feedback.Fatal(tr("invalid port configuration value for %s: %s", k, v), feedback.ErrBadArgument)
 			}
+			feedback.Fatal(tr("invalid port configuration: %s", config), feedback.ErrBadArgument)
+		}
+		settings[setting.SettingId] = v
+		flagSettings[setting.SettingId] = v
+	}
+
+	if saveSettings {
+		if len(flagSettings) == 0 {
+			feedback.Fatal(tr("No port settings to save, use the --config flag to set them."), feedback.ErrBadArgument)
+		}
+		if err := saved.set(fqbn, port.GetHardwareId(), flagSettings); err != nil {
+			feedback.FatalError(err, feedback.ErrGeneric)
+		}
+		if err := saved.save(settingsFile); err != nil {
+			feedback.Fatal(tr("Error saving monitor settings: %v", err), feedback.ErrGeneric)
+		}
+	}
+
+	configuration := &rpc.MonitorPortConfiguration{}
+	if len(settings) > 0 {
+		ids := make([]string, 0, len(settings))
+		for id := range settings {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		if !quiet {
+			feedback.Print(tr("Monitor port settings:"))
+		}
+		for _, id := range ids {
 			configuration.Settings = append(configuration.Settings, &rpc.MonitorPortSetting{
-				SettingId: setting.SettingId,
-				Value:     v,
+				SettingId: id,
+				Value:     settings[id],
 			})
 			if !quiet {
-				feedback.Print(tr("Monitor port settings:"))
-				feedback.Print(fmt.Sprintf("%s=%s", setting.SettingId, v))
+				feedback.Print(fmt.Sprintf("%s=%s", id, settings[id]))
 			}
 		}
 	}
@@ -212,6 +302,9 @@ func runMonitorCmd(
 
 	if !quiet {
 		feedback.Print(tr("Connected to %s! Press CTRL-C to exit.", portAddress))
+		if ctrl {
+			feedback.Print(tr("Press CTRL-T followed by ? to show the monitor controls."))
+		}
 	}
 
 	ttyIn, ttyOut, err := feedback.InteractiveStreams()
@@ -219,9 +312,17 @@ func runMonitorCmd(
 		feedback.FatalError(err, feedback.ErrGeneric)
 	}
 
+	// The messages of the controls are not filtered
+	controlsOut := ttyOut
 	if timestamp {
 		ttyOut = newTimeStampWriter(ttyOut)
 	}
+	if len(includeRegexps) > 0 || len(excludeRegexps) > 0 || len(highlightRules) > 0 {
+		ttyOut = newLineFilterWriter(ttyOut, includeRegexps, excludeRegexps, highlightRules)
+	}
+	if ansi == "strip" {
+		ttyOut = feedback.NewANSIStripWriter(ttyOut)
+	}
 
 	ctx, cancel := cleanup.InterruptableContext(context.Background())
 	if raw {
@@ -242,7 +343,7 @@ func runMonitorCmd(
 	}
 
 	go func() {
-		_, err := io.Copy(ttyOut, portProxy)
+		err := copyFromPort(ttyOut, portProxy)
 		if err != nil && !errors.Is(err, io.EOF) {
 			if !quiet {
 				feedback.Print(tr("Port closed: %v", err))
@@ -251,7 +352,12 @@ func runMonitorCmd(
 		cancel()
 	}()
 	go func() {
-		_, err := io.Copy(portProxy, ttyIn)
+		var err error
+		if ctrl {
+			err = newControls(ttyIn, controlsOut, portProxy, enumerateResp.GetSettings(), settings).run()
+		} else {
+			_, err = io.Copy(portProxy, ttyIn)
+		}
 		if err != nil && !errors.Is(err, io.EOF) {
 			if !quiet {
 				feedback.Print(tr("Port closed: %v", err))
@@ -264,6 +370,19 @@ func runMonitorCmd(
 	<-ctx.Done()
 }
 
+// copyFromPort copies the data received from the port to the writer until
+// the port is closed, the data dropped by the monitor is reported with a warning.
+func copyFromPort(writer io.Writer, port io.Reader) error {
+	for {
+		_, err := io.Copy(writer, port)
+		var overrun *monitor.OverrunError
+		if !errors.As(err, &overrun) {
+			return err
+		}
+		feedback.Warning(overrun.Error())
+	}
+}
+
 type charDetectorWriter struct {
 	callback     func()
 	detectedChar byte
@@ -298,6 +417,24 @@ func (r *detailsResult) String() string {
 	return t.Render()
 }
 
+// findSetting returns the setting matching the given id and value. If the id is
+// empty the setting is searched by value. If the value is empty any value is accepted.
+func findSetting(settings []*rpc.MonitorPortSettingDescriptor, id, value string) *rpc.MonitorPortSettingDescriptor {
+	for _, s := range settings {
+		if id == "" {
+			if contains(s.EnumValues, value) {
+				return s
+			}
+		} else if strings.EqualFold(s.SettingId, id) {
+			if value != "" && !contains(s.EnumValues, value) {
+				return nil
+			}
+			return s
+		}
+	}
+	return nil
+}
+
 func contains(s []string, searchterm string) bool {
 	for _, item := range s {
 		if strings.EqualFold(item, searchterm) {
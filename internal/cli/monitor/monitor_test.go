@@ -17,7 +17,12 @@ package monitor
 
 import (
 	"bytes"
+	"regexp"
 	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/fatih/color"
 
 	"github.com/stretchr/testify/require"
 )
@@ -35,3 +40,77 @@ func TestTimeStampWriter(t *testing.T) {
 	// A timestamp should be inserted before the first char of the next line
 	require.Regexp(t, "^\n"+`\[\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\] bar`+"\n$", buf)
 }
+
+func TestSavedSettings(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "monitor_settings")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	file := tmp.Join("monitor_settings.yaml")
+
+	s, err := loadSavedSettings(file)
+	require.NoError(t, err)
+	require.Empty(t, s.get("arduino:avr:uno", "1234"))
+
+	require.NoError(t, s.set("arduino:avr:uno", "", map[string]string{"baudrate": "115200", "dtr": "on"}))
+	require.NoError(t, s.set("arduino:avr:uno", "1234", map[string]string{"baudrate": "9600"}))
+	require.Error(t, s.set("", "", map[string]string{"baudrate": "9600"}))
+	require.NoError(t, s.save(file))
+
+	s, err = loadSavedSettings(file)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"baudrate": "115200", "dtr": "on"}, s.get("arduino:avr:uno", ""))
+	require.Equal(t, map[string]string{"baudrate": "9600", "dtr": "on"}, s.get("arduino:avr:uno", "1234"))
+	require.Equal(t, map[string]string{"baudrate": "9600"}, s.get("", "1234"))
+}
+
+func TestLineFilterWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	include := []*regexp.Regexp{regexp.MustCompile(`sensor|boot`)}
+	exclude := []*regexp.Regexp{regexp.MustCompile(`^D `)}
+	writer := newLineFilterWriter(buf, include, exclude, nil)
+
+	writer.Write([]byte("boot ok\r\nD sensor raw=12\r\nI sensor"))
+	writer.Write([]byte(" temp=21\r\nwifi connected\n\x1b[31msensor\x1b[0m fail\n"))
+	require.Equal(t, "boot ok\r\nI sensor temp=21\r\n\x1b[31msensor\x1b[0m fail\n", buf.String())
+
+	// The lines not terminated are written after a timeout
+	buf.Reset()
+	writer.Write([]byte("boot> "))
+	require.Empty(t, buf.String())
+	require.Eventually(t, func() bool {
+		writer.mutex.Lock()
+		defer writer.mutex.Unlock()
+		return buf.String() == "boot> "
+	}, time.Second, 10*time.Millisecond)
+	writer.Write([]byte("reset\nhidden\n"))
+	require.Equal(t, "boot> reset\n", buf.String())
+}
+
+func TestHighlightRules(t *testing.T) {
+	rule := &highlightRule{Pattern: "(", Color: "red"}
+	require.Error(t, rule.compile())
+	rule = &highlightRule{Pattern: "fail", Color: "purple"}
+	require.Error(t, rule.compile())
+
+	for _, rule := range defaultHighlightRules {
+		require.NoError(t, rule.compile())
+	}
+	writer := newLineFilterWriter(&bytes.Buffer{}, nil, nil, defaultHighlightRules)
+	for line, expected := range map[string]string{
+		"E (1234) wifi: connection lost": "red",
+		"[ERROR] sensor not found":       "red",
+		"W (1234) wifi: weak signal":     "yellow",
+		"warning: low battery":           "yellow",
+		"I (1234) boot: ready":           "green",
+		"[DEBUG] loop":                   "cyan",
+		"Hello world":                    "",
+	} {
+		show, c := writer.match([]byte(line + "\n"))
+		require.True(t, show)
+		if expected == "" {
+			require.Nil(t, c, line)
+		} else {
+			require.Equal(t, color.New(highlightColors[expected]), c, line)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+	"gopkg.in/yaml.v3"
+)
+
+// savedSettings contains the monitor port settings saved with the
+// --save-settings flag, keyed by board FQBN or by port serial number.
+type savedSettings struct {
+	Boards map[string]map[string]string `yaml:"boards,omitempty"`
+	Ports  map[string]map[string]string `yaml:"ports,omitempty"`
+}
+
+// loadSavedSettings reads the saved monitor settings from the given file, an
+// empty set of settings is returned if the file doesn't exist.
+func loadSavedSettings(file *paths.Path) (*savedSettings, error) {
+	res := &savedSettings{}
+	if file.NotExist() {
+		return res, nil
+	}
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, res); err != nil {
+		return nil, fmt.Errorf(tr("invalid monitor settings file %[1]s: %[2]s"), file, err)
+	}
+	return res, nil
+}
+
+// save writes the monitor settings in the given file.
+func (s *savedSettings) save(file *paths.Path) error {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := file.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	return file.WriteFile(data)
+}
+
+// get returns the saved settings for the given board and port serial number,
+// the port settings take precedence over the board settings.
+func (s *savedSettings) get(fqbn, serialNumber string) map[string]string {
+	res := map[string]string{}
+	if fqbn != "" {
+		for k, v := range s.Boards[fqbn] {
+			res[k] = v
+		}
+	}
+	if serialNumber != "" {
+		for k, v := range s.Ports[serialNumber] {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// set saves the given settings for the port serial number if available,
+// otherwise for the board FQBN. It returns an error if both are empty.
+func (s *savedSettings) set(fqbn, serialNumber string, settings map[string]string) error {
+	var target *map[string]map[string]string
+	var key string
+	switch {
+	case serialNumber != "":
+		target, key = &s.Ports, serialNumber
+	case fqbn != "":
+		target, key = &s.Boards, fqbn
+	default:
+		return fmt.Errorf(tr("cannot save the monitor settings: the board and the port serial number are unknown"))
+	}
+	if *target == nil {
+		*target = map[string]map[string]string{}
+	}
+	saved := (*target)[key]
+	if saved == nil {
+		saved = map[string]string{}
+	}
+	for k, v := range settings {
+		saved[k] = v
+	}
+	(*target)[key] = saved
+	return nil
+}
@@ -22,44 +22,91 @@ import (
 	"strings"
 
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
 	"github.com/arduino/arduino-cli/internal/cli/core"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
 	"github.com/arduino/arduino-cli/internal/cli/feedback/result"
 	"github.com/arduino/arduino-cli/internal/cli/instance"
 	"github.com/arduino/arduino-cli/internal/cli/lib"
+	"github.com/arduino/arduino-cli/internal/cli/upgrade"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/arduino-cli/table"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	semver "go.bug.st/relaxed-semver"
 )
 
 var tr = i18n.Tr
 
 // NewCommand creates a new `outdated` command
 func NewCommand() *cobra.Command {
+	var advisoriesFlag arguments.AdvisoriesFlag
+	var all bool
+	var sketchPath string
 	outdatedCommand := &cobra.Command{
 		Use:   "outdated",
 		Short: tr("Lists cores and libraries that can be upgraded"),
 		Long: tr(`This commands shows a list of installed cores and/or libraries
-that can be upgraded. If nothing needs to be updated the output is empty.`),
-		Example: "  " + os.Args[0] + " outdated\n",
-		Args:    cobra.NoArgs,
-		Run:     runOutdatedCommand,
+that can be upgraded. If nothing needs to be updated the output is empty.
+The installed cores and libraries affected by the advisories of the
+configured feeds are listed too.
+With --all the upgrade plan applied by the upgrade command is shown: the
+version each core and library would be upgraded to, and the reason why
+some of them are held back.`),
+		Example: "" +
+			"  " + os.Args[0] + " outdated\n" +
+			"  " + os.Args[0] + " outdated --all --sketch ~/Arduino/MySketch\n",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runOutdatedCommand(&advisoriesFlag, all, sketchPath)
+		},
 	}
+	advisoriesFlag.AddToCommand(outdatedCommand)
+	outdatedCommand.Flags().BoolVar(&all, "all", false, tr("Show the upgrade plan of all the installed cores and libraries."))
+	outdatedCommand.Flags().StringVar(&sketchPath, "sketch", "", tr("Hold the platforms and libraries at the versions pinned by the profiles of this sketch."))
 	return outdatedCommand
 }
 
-func runOutdatedCommand(cmd *cobra.Command, args []string) {
+func runOutdatedCommand(advisoriesFlag *arguments.AdvisoriesFlag, all bool, sketchPath string) {
 	inst := instance.CreateAndInit()
 	logrus.Info("Executing `arduino-cli outdated`")
-	Outdated(inst)
+	if all || sketchPath != "" {
+		feedback.PrintResult(upgrade.NewPlanResult(upgrade.Plan(inst, sketchPath)))
+		if err := advisoriesFlag.Check(installedAdvisories(inst)); err != nil {
+			feedback.Fatal(err.Error(), feedback.ErrGeneric)
+		}
+		return
+	}
+	Outdated(inst, advisoriesFlag)
 }
 
-// Outdated prints a list of outdated platforms and libraries
-func Outdated(inst *rpc.Instance) {
-	feedback.PrintResult(
-		newOutdatedResult(core.GetList(inst, false, true), lib.GetList(inst, []string{}, false, true)),
-	)
+// Outdated prints a list of outdated platforms and libraries and of the
+// advisories affecting the installed ones. It exits with an error if
+// advisoriesFlag requires it.
+func Outdated(inst *rpc.Instance, advisoriesFlag *arguments.AdvisoriesFlag) {
+	res := newOutdatedResult(core.GetList(inst, false, true), lib.GetList(inst, []string{}, false, true))
+	res.Advisories = installedAdvisories(inst)
+	if err := advisoriesFlag.Check(res.Advisories); err != nil {
+		res.Error = err.Error()
+		feedback.FatalResult(res, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(res)
+}
+
+// installedAdvisories returns the advisories affecting the installed platforms
+// and libraries
+func installedAdvisories(inst *rpc.Instance) []*rpc.Advisory {
+	feed := arguments.LoadAdvisories()
+	res := []*rpc.Advisory{}
+	for _, platform := range core.GetList(inst, false, false) {
+		version, _ := semver.Parse(platform.GetInstalledVersion())
+		res = append(res, feed.Platform(platform.GetMetadata().GetId(), version)...)
+	}
+	for _, installedLib := range lib.GetList(inst, []string{}, false, false) {
+		version, _ := semver.Parse(installedLib.GetLibrary().GetVersion())
+		res = append(res, feed.Library(installedLib.GetLibrary().GetName(), version)...)
+	}
+	return res
 }
 
 // output from this command requires special formatting, let's create a dedicated
@@ -67,6 +114,8 @@ func Outdated(inst *rpc.Instance) {
 type outdatedResult struct {
 	Platforms     []*result.Platform      `json:"platforms,omitempty"`
 	InstalledLibs []*rpc.InstalledLibrary `json:"libraries,omitempty"`
+	Advisories    []*rpc.Advisory         `json:"advisories,omitempty"`
+	Error         string                  `json:"error,omitempty"`
 }
 
 func newOutdatedResult(inPlatforms []*rpc.PlatformSummary, inLibraries []*rpc.InstalledLibrary) *outdatedResult {
@@ -82,8 +131,16 @@ func (ir outdatedResult) Data() interface{} {
 	return &ir
 }
 
+// ErrorString implements feedback.ErrorResult
+func (ir outdatedResult) ErrorString() string {
+	return ir.Error
+}
+
 func (ir outdatedResult) String() string {
 	if len(ir.Platforms) == 0 && len(ir.InstalledLibs) == 0 {
+		if len(ir.Advisories) > 0 {
+			return tr("No outdated platforms or libraries found.") + "\n\n" + renderAdvisories(ir.Advisories)
+		}
 		return tr("No outdated platforms or libraries found.")
 	}
 
@@ -128,24 +185,21 @@ func (ir outdatedResult) String() string {
 	})
 	lastName := ""
 	for _, libMeta := range ir.InstalledLibs {
-		lib := libMeta.GetLibrary()
-		name := lib.Name
+		library := libMeta.GetLibrary()
+		name := library.Name
 		if name == lastName {
 			name = ` "`
 		} else {
 			lastName = name
 		}
 
-		location := lib.GetLocation().String()
-		if lib.ContainerPlatform != "" {
-			location = lib.GetContainerPlatform()
-		}
+		location := lib.LocationLabel(library)
 
 		available := ""
 		sentence := ""
 		if libMeta.GetRelease() != nil {
 			available = libMeta.GetRelease().GetVersion()
-			sentence = lib.Sentence
+			sentence = library.Sentence
 		}
 
 		if available == "" {
@@ -156,8 +210,41 @@ func (ir outdatedResult) String() string {
 		} else if len(sentence) > 40 {
 			sentence = sentence[:37] + "..."
 		}
-		t.AddRow("", name, lib.Version, available, location, sentence)
+		t.AddRow("", name, library.Version, available, location, sentence)
 	}
 
+	if len(ir.Advisories) > 0 {
+		return t.Render() + "\n\n" + renderAdvisories(ir.Advisories)
+	}
+	return t.Render()
+}
+
+// renderAdvisories returns the advisories as a table
+func renderAdvisories(advisories []*rpc.Advisory) string {
+	t := table.New()
+	t.SetHeader(
+		tr("Advisory"),
+		tr("Type"),
+		tr("Affects"),
+		tr("Installed"),
+		tr("Fixed in"),
+		tr("Summary"),
+	)
+	t.SetColumnWidthMode(5, table.Average)
+	for _, advisory := range advisories {
+		affects := advisory.GetLibrary()
+		if affects == "" {
+			affects = advisory.GetPlatform()
+		}
+		kind := advisory.GetType()
+		if advisory.GetSeverity() != "" {
+			kind += " (" + advisory.GetSeverity() + ")"
+		}
+		fixedIn := advisory.GetFixedIn()
+		if fixedIn == "" {
+			fixedIn = "-"
+		}
+		t.AddRow(advisory.GetId(), kind, affects, advisory.GetVersion(), fixedIn, advisory.GetSummary())
+	}
 	return t.Render()
 }
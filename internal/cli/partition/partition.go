@@ -0,0 +1,122 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package partition
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/partitions"
+	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `partition` command
+func NewCommand() *cobra.Command {
+	partitionCommand := &cobra.Command{
+		Use:   "partition",
+		Short: tr("Arduino CLI partition table commands."),
+		Long:  tr("Inspect, validate and select the partition table used by a sketch on boards with partitioned flash (e.g. ESP32)."),
+		Example: "  " + os.Args[0] + " partition show MySketch\n" +
+			"  " + os.Args[0] + " partition select partitions.csv MySketch",
+	}
+
+	partitionCommand.AddCommand(initShowCommand())
+	partitionCommand.AddCommand(initValidateCommand())
+	partitionCommand.AddCommand(initSelectCommand())
+
+	return partitionCommand
+}
+
+// resolvePartitionTable returns the partition table file referred by the
+// argument, that may be either a CSV file or a sketch with a partition table
+// selected in its project file, and the flash size of the target board (0 if
+// unknown).
+func resolvePartitionTable(arg string, fqbnArg *arguments.Fqbn) (*paths.Path, uint64) {
+	var file *paths.Path
+	fqbn := fqbnArg.String()
+	if strings.HasSuffix(strings.ToLower(arg), ".csv") {
+		file = paths.New(arg)
+	} else {
+		sketchPath := arguments.InitSketchPath(arg, true)
+		sk, err := sketch.LoadSketch(context.Background(), &rpc.LoadSketchRequest{SketchPath: sketchPath.String()})
+		if err != nil {
+			feedback.Fatal(tr("Error loading sketch: %v", err), feedback.ErrGeneric)
+		}
+		if sk.GetPartitions() == "" {
+			feedback.Fatal(tr("No partition table selected for sketch %s", sketchPath), feedback.ErrBadArgument)
+		}
+		file = paths.New(sk.GetPartitions())
+		if fqbn == "" {
+			fqbn = sk.GetDefaultFqbn()
+		}
+	}
+
+	if fqbn == "" {
+		return file, 0
+	}
+	inst := instance.CreateAndInit()
+	details, err := board.Details(context.Background(), &rpc.BoardDetailsRequest{Instance: inst, Fqbn: fqbn})
+	if err != nil {
+		feedback.Fatal(tr("Error getting board details: %v", err), feedback.ErrGeneric)
+	}
+	for _, prop := range details.GetBuildProperties() {
+		if value, ok := strings.CutPrefix(prop, "build.flash_size="); ok {
+			flashSize, _ := partitions.ParseSize(value)
+			return file, flashSize
+		}
+	}
+	return file, 0
+}
+
+// partitionTableResult is the layout of a partition table
+type partitionTableResult struct {
+	File       string                  `json:"file"`
+	FlashSize  uint64                  `json:"flash_size,omitempty"`
+	UsedSize   uint64                  `json:"used_size"`
+	Partitions []*partitions.Partition `json:"partitions"`
+}
+
+func (r *partitionTableResult) Data() interface{} {
+	return r
+}
+
+func (r *partitionTableResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Name"), tr("Type"), tr("SubType"), tr("Offset"), tr("Size"), tr("Flags"))
+	for _, p := range r.Partitions {
+		t.AddRow(p.Name, p.Type, p.SubType, fmt.Sprintf("0x%X", p.Offset), fmt.Sprintf("0x%X", p.Size), p.Flags)
+	}
+	res := tr("Partition table: %s", r.File) + "\n" + t.Render()
+	if r.FlashSize > 0 {
+		res += tr("Used %[1]d of %[2]d bytes of flash", r.UsedSize, r.FlashSize)
+	} else {
+		res += tr("Used %d bytes of flash", r.UsedSize)
+	}
+	return res
+}
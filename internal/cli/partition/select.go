@@ -0,0 +1,107 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package partition
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/partitions"
+	"github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initSelectCommand() *cobra.Command {
+	var reset bool
+	selectCommand := &cobra.Command{
+		Use:   fmt.Sprintf("select [%s] [%s]", tr("CSV_FILE"), tr("sketchPath")),
+		Short: tr("Selects the partition table used by a sketch."),
+		Long:  tr("Stores the partition table to use when compiling and uploading a sketch in its project file. The partition table is validated before being selected."),
+		Example: "  " + os.Args[0] + " partition select partitions.csv MySketch\n" +
+			"  " + os.Args[0] + " partition select --reset MySketch",
+		Args: cobra.RangeArgs(0, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			csvFile, sketchPath := "", ""
+			if reset {
+				if len(args) > 1 {
+					feedback.Fatal(tr("Too many arguments"), feedback.ErrBadArgument)
+				}
+				if len(args) > 0 {
+					sketchPath = args[0]
+				}
+			} else {
+				if len(args) == 0 {
+					feedback.Fatal(tr("Missing partition table file"), feedback.ErrBadArgument)
+				}
+				csvFile = args[0]
+				if len(args) > 1 {
+					sketchPath = args[1]
+				}
+			}
+			runSelectCommand(csvFile, sketchPath, reset)
+		},
+	}
+	selectCommand.Flags().BoolVar(&reset, "reset", false, tr("Removes the partition table selection, restoring the board default."))
+	return selectCommand
+}
+
+func runSelectCommand(csvFile, path string, reset bool) {
+	logrus.Info("Executing `arduino-cli partition select`")
+
+	sketchPath := arguments.InitSketchPath(path, true)
+	req := &rpc.SetSketchDefaultsRequest{
+		SketchPath:      sketchPath.String(),
+		ResetPartitions: reset,
+	}
+	if !reset {
+		file := paths.New(csvFile)
+		table, err := partitions.Load(file)
+		if err == nil {
+			err = table.Validate(0)
+		}
+		if err != nil {
+			feedback.Fatal(tr("Invalid partition table %[1]s: %[2]v", file, err), feedback.ErrBadArgument)
+		}
+		req.Partitions = file.String()
+	}
+
+	res, err := sketch.SetSketchDefaults(context.Background(), req)
+	if err != nil {
+		feedback.FatalError(err, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&partitionSelectResult{Partitions: res.GetPartitions()})
+}
+
+type partitionSelectResult struct {
+	Partitions string `json:"partitions,omitempty"`
+}
+
+func (r *partitionSelectResult) Data() interface{} {
+	return r
+}
+
+func (r *partitionSelectResult) String() string {
+	if r.Partitions == "" {
+		return tr("No partition table selected, the board default will be used")
+	}
+	return tr("Partition table set to: %s", r.Partitions)
+}
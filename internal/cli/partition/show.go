@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package partition
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/partitions"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initShowCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	showCommand := &cobra.Command{
+		Use:   fmt.Sprintf("show [-b <%s>] [%s | %s]", tr("FQBN"), tr("sketchPath"), tr("CSV_FILE")),
+		Short: tr("Shows the layout of a partition table."),
+		Long:  tr("Shows the layout of a partition table CSV file or of the partition table selected for a sketch."),
+		Example: "  " + os.Args[0] + " partition show MySketch\n" +
+			"  " + os.Args[0] + " partition show partitions.csv -b esp32:esp32:esp32",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			arg := ""
+			if len(args) > 0 {
+				arg = args[0]
+			}
+			runShowCommand(arg, &fqbn)
+		},
+	}
+	fqbn.AddToCommand(showCommand)
+	return showCommand
+}
+
+func runShowCommand(arg string, fqbn *arguments.Fqbn) {
+	logrus.Info("Executing `arduino-cli partition show`")
+
+	file, flashSize := resolvePartitionTable(arg, fqbn)
+	table, err := partitions.Load(file)
+	if err != nil {
+		feedback.Fatal(tr("Error reading partition table: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&partitionTableResult{
+		File:       file.String(),
+		FlashSize:  flashSize,
+		UsedSize:   table.UsedSize(),
+		Partitions: table.Partitions,
+	})
+}
@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package partition
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/partitions"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initValidateCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	validateCommand := &cobra.Command{
+		Use:   fmt.Sprintf("validate [-b <%s>] [%s | %s]", tr("FQBN"), tr("sketchPath"), tr("CSV_FILE")),
+		Short: tr("Validates a partition table."),
+		Long:  tr("Checks that a partition table is well formed, that partitions are aligned and do not overlap and, if a board is known, that they fit in its flash memory."),
+		Example: "  " + os.Args[0] + " partition validate MySketch\n" +
+			"  " + os.Args[0] + " partition validate partitions.csv -b esp32:esp32:esp32",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			arg := ""
+			if len(args) > 0 {
+				arg = args[0]
+			}
+			runValidateCommand(arg, &fqbn)
+		},
+	}
+	fqbn.AddToCommand(validateCommand)
+	return validateCommand
+}
+
+func runValidateCommand(arg string, fqbn *arguments.Fqbn) {
+	logrus.Info("Executing `arduino-cli partition validate`")
+
+	file, flashSize := resolvePartitionTable(arg, fqbn)
+	table, err := partitions.Load(file)
+	if err == nil {
+		err = table.Validate(flashSize)
+	}
+	if err != nil {
+		feedback.Fatal(tr("Invalid partition table %[1]s: %[2]v", file, err), feedback.ErrBadArgument)
+	}
+	feedback.PrintResult(&partitionValidateResult{
+		File:  file.String(),
+		Valid: true,
+	})
+}
+
+type partitionValidateResult struct {
+	File  string `json:"file"`
+	Valid bool   `json:"valid"`
+}
+
+func (r *partitionValidateResult) Data() interface{} {
+	return r
+}
+
+func (r *partitionValidateResult) String() string {
+	return tr("Partition table %s is valid", r.File)
+}
@@ -0,0 +1,80 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cli
+
+import (
+	"os"
+	"runtime"
+	"runtime/pprof"
+
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	profileCPUFile string
+	profileMemFile string
+)
+
+// startProfiling starts the CPU profiling, if requested, and registers the
+// exit hook that stops it and writes the memory profile.
+func startProfiling() {
+	if profileCPUFile == "" && profileMemFile == "" {
+		return
+	}
+
+	var cpuProfile *os.File
+	if profileCPUFile != "" {
+		f, err := os.Create(profileCPUFile)
+		if err != nil {
+			feedback.Fatal(tr("Error creating CPU profile file: %v", err), feedback.ErrGeneric)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			feedback.Fatal(tr("Error starting CPU profiling: %v", err), feedback.ErrGeneric)
+		}
+		logrus.Infof("Writing CPU profile to %s", profileCPUFile)
+		cpuProfile = f
+	}
+
+	feedback.OnExit(func() {
+		if cpuProfile != nil {
+			pprof.StopCPUProfile()
+			cpuProfile.Close()
+		}
+		if profileMemFile != "" {
+			writeMemProfile(profileMemFile)
+		}
+	})
+}
+
+// writeMemProfile writes the heap profile in the given file.
+func writeMemProfile(file string) {
+	f, err := os.Create(file)
+	if err != nil {
+		feedback.Warning(tr("Error creating memory profile file: %v", err))
+		return
+	}
+	defer f.Close()
+
+	// Run a GC to get up-to-date statistics
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		feedback.Warning(tr("Error writing memory profile: %v", err))
+		return
+	}
+	logrus.Infof("Memory profile written to %s", file)
+}
@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiling(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	cpuProfile := tmp.Join("cpu.pprof")
+	memProfile := tmp.Join("mem.pprof")
+	profileCPUFile, profileMemFile = cpuProfile.String(), memProfile.String()
+	defer func() { profileCPUFile, profileMemFile = "", "" }()
+
+	startProfiling()
+	require.True(t, cpuProfile.Exist())
+	require.False(t, memProfile.Exist())
+
+	// The profiles are completed by the exit hooks
+	feedback.RunExitHooks()
+	for _, profile := range []*paths.Path{cpuProfile, memProfile} {
+		data, err := profile.ReadFile()
+		require.NoError(t, err)
+		require.NotEmpty(t, data)
+	}
+}
@@ -0,0 +1,216 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// InitInstance creates and initializes an instance on the remote daemon, it's
+// used by the following calls and destroyed when the connection is closed.
+func (c *Client) InitInstance(ctx context.Context) error {
+	res, err := c.service.Create(ctx, &rpc.CreateRequest{})
+	if err != nil {
+		return convertError(err)
+	}
+	c.instance = res.GetInstance()
+	stream, err := c.service.Init(ctx, &rpc.InitRequest{Instance: c.instance})
+	if err != nil {
+		return convertError(err)
+	}
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return convertError(err)
+		}
+		if st := res.GetError(); st != nil {
+			logrus.Warnf("Error initializing remote instance: %s", st.GetMessage())
+		}
+	}
+}
+
+// destroyInstance destroys the instance created with InitInstance, if any
+func (c *Client) destroyInstance() {
+	if c.instance == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+	if _, err := c.service.Destroy(ctx, &rpc.DestroyRequest{Instance: c.instance}); err != nil {
+		logrus.WithError(err).Warn("Error destroying remote instance")
+	}
+	c.instance = nil
+}
+
+// BoardList returns the ports detected by the remote daemon that the user is
+// allowed to use.
+func (c *Client) BoardList(ctx context.Context) ([]*rpc.DetectedPort, error) {
+	res, err := c.service.BoardList(ctx, &rpc.BoardListRequest{Instance: c.instance})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return res.GetPorts(), nil
+}
+
+// Upload sends the build artifacts contained in artifactsDir to the remote
+// daemon and uploads them to the port of the request, the output of the
+// upload is streamed to outStream and errStream.
+func (c *Client) Upload(ctx context.Context, req *rpc.UploadRequest, artifactsDir *paths.Path, outStream, errStream io.Writer) (*rpc.UploadResult, error) {
+	artifacts, err := ReadArtifacts(artifactsDir)
+	if err != nil {
+		return nil, err
+	}
+	req.Instance = c.instance
+	req.ImportArtifacts = artifacts
+	stream, err := c.service.Upload(ctx, req)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	var res *rpc.UploadResult
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+		if err != nil {
+			return res, convertError(err)
+		}
+		switch {
+		case len(msg.GetOutStream()) > 0:
+			outStream.Write(msg.GetOutStream())
+		case len(msg.GetErrStream()) > 0:
+			errStream.Write(msg.GetErrStream())
+		case msg.GetResult() != nil:
+			res = msg.GetResult()
+		}
+	}
+}
+
+// ReadArtifacts returns the build artifacts contained in the directory, as
+// exported by `compile --export-binaries` or `compile --output-dir`.
+func ReadArtifacts(dir *paths.Path) ([]*rpc.BuildArtifact, error) {
+	files, err := dir.ReadDir()
+	if err != nil {
+		return nil, errors.New(tr("reading build artifacts: %v", err))
+	}
+	files.FilterOutDirs()
+	artifacts := []*rpc.BuildArtifact{}
+	size := 0
+	for _, file := range files {
+		data, err := file.ReadFile()
+		if err != nil {
+			return nil, errors.New(tr("reading build artifacts: %v", err))
+		}
+		if size += len(data); size > MaxMessageSize {
+			return nil, errors.New(tr("the build artifacts are too big to be uploaded remotely"))
+		}
+		artifacts = append(artifacts, &rpc.BuildArtifact{Name: file.Base(), Data: data})
+	}
+	if len(artifacts) == 0 {
+		return nil, errors.New(tr("no build artifacts found in %s", dir))
+	}
+	return artifacts, nil
+}
+
+// OpenPort opens a port of the remote daemon, the data written to the
+// returned stream is sent to the port and the data received from the port
+// can be read from it.
+func (c *Client) OpenPort(ctx context.Context, openReq *rpc.PortIOOpenRequest) (*PortStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.service.PortIO(ctx)
+	if err != nil {
+		cancel()
+		return nil, convertError(err)
+	}
+	openReq.Instance = c.instance
+	if err := stream.Send(&rpc.PortIORequest{Message: &rpc.PortIORequest_OpenRequest{OpenRequest: openReq}}); err != nil {
+		cancel()
+		return nil, convertError(err)
+	}
+	msg, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, convertError(err)
+	}
+	if !msg.GetOpened() {
+		cancel()
+		return nil, errors.New(tr("the remote port has not been opened"))
+	}
+	return &PortStream{stream: stream, cancel: cancel}, nil
+}
+
+// PortStream is a port of the remote daemon, tunneled through the gRPC
+// connection.
+type PortStream struct {
+	stream  rpc.ArduinoCoreService_PortIOClient
+	cancel  context.CancelFunc
+	sendMux sync.Mutex
+	rx      []byte
+	// Dropped is the number of bytes received by the port and dropped by the
+	// remote daemon because they were not read fast enough
+	Dropped uint64
+}
+
+// Read reads the data received from the port
+func (p *PortStream) Read(buff []byte) (int, error) {
+	for len(p.rx) == 0 {
+		msg, err := p.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, convertError(err)
+		}
+		if overrun := msg.GetOverrun(); overrun != nil {
+			p.Dropped = overrun.GetTotalDroppedBytes()
+		}
+		p.rx = msg.GetRxData()
+	}
+	n := copy(buff, p.rx)
+	p.rx = p.rx[n:]
+	return n, nil
+}
+
+// Write sends the data to the port
+func (p *PortStream) Write(data []byte) (int, error) {
+	p.sendMux.Lock()
+	defer p.sendMux.Unlock()
+	tx := make([]byte, len(data))
+	copy(tx, data)
+	if err := p.stream.Send(&rpc.PortIORequest{Message: &rpc.PortIORequest_TxData{TxData: tx}}); err != nil {
+		return 0, convertError(err)
+	}
+	return len(data), nil
+}
+
+// Close closes the port
+func (p *PortStream) Close() error {
+	p.sendMux.Lock()
+	err := p.stream.CloseSend()
+	p.sendMux.Unlock()
+	p.cancel()
+	return err
+}
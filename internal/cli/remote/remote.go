@@ -0,0 +1,217 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package remote allows the CLI commands to run on a remote daemon, reached
+// through the authenticated gRPC API configured with the `remote` settings.
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var tr = i18n.Tr
+
+// MaxMessageSize is the maximum size of the messages exchanged with the
+// remote daemon, large enough to hold a sketch archive or a build artifact
+const MaxMessageSize = 64 * 1024 * 1024
+
+// connectTimeout is the maximum time to wait for the remote daemon
+const connectTimeout = 10 * time.Second
+
+// Client is a connection to a remote daemon.
+type Client struct {
+	conn     *grpc.ClientConn
+	service  rpc.ArduinoCoreServiceClient
+	instance *rpc.Instance
+}
+
+// Connect connects to the remote daemon at the `remote.address` set in the
+// configuration, the `remote.token` is sent to authenticate each call.
+func Connect(ctx context.Context) (*Client, error) {
+	address := configuration.Settings.GetString("remote.address")
+	if address == "" {
+		return nil, errors.New(tr("the address of the remote daemon is not set, use the remote.address setting"))
+	}
+	transportCreds := insecure.NewCredentials()
+	if configuration.Settings.GetBool("remote.tls") {
+		transportCreds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxMessageSize), grpc.MaxCallSendMsgSize(MaxMessageSize)),
+		grpc.WithBlock(),
+	}
+	if token := configuration.Settings.GetString("remote.token"); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&bearerToken{
+			token:      token,
+			requireTLS: configuration.Settings.GetBool("remote.tls"),
+		}))
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", tr("connecting to the remote daemon at %s", address), err)
+	}
+	return &Client{conn: conn, service: rpc.NewArduinoCoreServiceClient(conn)}, nil
+}
+
+// Close destroys the remote instance, if any, and closes the connection to
+// the remote daemon.
+func (c *Client) Close() error {
+	c.destroyInstance()
+	return c.conn.Close()
+}
+
+// Compile archives the sketch and builds it on the remote daemon with the
+// given profile, the output of the compilation is streamed to outStream and
+// errStream and the artifacts of the build are saved in exportDir.
+func (c *Client) Compile(ctx context.Context, req *rpc.CompileRequest, exportDir *paths.Path, outStream, errStream io.Writer) (*rpc.CompileResponse, error) {
+	archive, err := archiveSketch(ctx, req.GetSketchPath())
+	if err != nil {
+		return nil, err
+	}
+	req = proto.Clone(req).(*rpc.CompileRequest)
+	req.SketchPath = ""
+	req.ExportDir = ""
+	req.SketchArchive = archive
+
+	stream, err := c.service.Compile(ctx, req)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	res := &rpc.CompileResponse{}
+	artifacts := []*rpc.BuildArtifact{}
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return res, convertError(err)
+		}
+		switch {
+		case len(msg.GetOutStream()) > 0:
+			outStream.Write(msg.GetOutStream())
+		case len(msg.GetErrStream()) > 0:
+			errStream.Write(msg.GetErrStream())
+		case msg.GetProgress() != nil:
+			// progress is not reported by the CLI
+		case msg.GetArtifact() != nil:
+			artifacts = append(artifacts, msg.GetArtifact())
+		default:
+			res = msg
+		}
+	}
+	if err := SaveArtifacts(exportDir, artifacts); err != nil {
+		return res, err
+	}
+	// The paths in the response refer to the remote daemon
+	res.BuildPath = exportDir.String()
+	return res, nil
+}
+
+// SaveArtifacts writes the artifacts received from the remote daemon in the
+// given directory. The names of the artifacts must be plain file names, so
+// that the remote daemon can't write outside the directory.
+func SaveArtifacts(dir *paths.Path, artifacts []*rpc.BuildArtifact) error {
+	for _, artifact := range artifacts {
+		name := artifact.GetName()
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\:`) {
+			return errors.New(tr("invalid artifact name received from the remote daemon: %s", name))
+		}
+	}
+	if len(artifacts) == 0 {
+		return nil
+	}
+	if err := dir.MkdirAll(); err != nil {
+		return fmt.Errorf("%s: %w", tr("creating output dir"), err)
+	}
+	for _, artifact := range artifacts {
+		file := dir.Join(artifact.GetName())
+		logrus.WithField("file", file).Trace("Saving artifact of the remote build.")
+		if err := file.WriteFile(artifact.GetData()); err != nil {
+			return fmt.Errorf("%s: %w", tr("saving artifact %s", artifact.GetName()), err)
+		}
+	}
+	return nil
+}
+
+// archiveSketch returns the zip archive of the sketch, without its build
+// folder
+func archiveSketch(ctx context.Context, sketchPath string) ([]byte, error) {
+	tmp, err := paths.MkTempDir("", "arduino-remote-build")
+	if err != nil {
+		return nil, err
+	}
+	defer tmp.RemoveAll()
+	archive := tmp.Join("sketch.zip")
+	if _, err := sketch.ArchiveSketch(ctx, &rpc.ArchiveSketchRequest{
+		SketchPath:  sketchPath,
+		ArchivePath: archive.String(),
+	}); err != nil {
+		return nil, err
+	}
+	data, err := archive.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxMessageSize {
+		return nil, errors.New(tr("the sketch is too big to be built remotely"))
+	}
+	return data, nil
+}
+
+// bearerToken sends the access token of the user in the `authorization`
+// metadata of each call
+type bearerToken struct {
+	token      string
+	requireTLS bool
+}
+
+func (t *bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t *bearerToken) RequireTransportSecurity() bool {
+	return t.requireTLS
+}
+
+// convertError extracts the error message from a gRPC status error.
+func convertError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return errors.New(st.Message())
+	}
+	return err
+}
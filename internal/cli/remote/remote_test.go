@@ -0,0 +1,65 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package remote
+
+import (
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveArtifacts(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	dir := tmp.Join("build", "arduino.avr.uno")
+	require.NoError(t, SaveArtifacts(dir, []*rpc.BuildArtifact{
+		{Name: "Blink.ino.hex", Data: []byte("hex")},
+		{Name: "Blink.ino.elf", Data: []byte("elf")},
+	}))
+	data, err := dir.Join("Blink.ino.hex").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "hex", string(data))
+	require.True(t, dir.Join("Blink.ino.elf").Exist())
+
+	// Nothing is written if a name would escape the directory
+	for _, name := range []string{"", "..", "../evil", "sub/file", `sub\file`} {
+		other := tmp.Join("other")
+		err := SaveArtifacts(other, []*rpc.BuildArtifact{{Name: "ok.hex"}, {Name: name}})
+		require.Error(t, err, name)
+		require.False(t, other.Exist())
+	}
+}
+
+func TestReadArtifacts(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	_, err = ReadArtifacts(tmp)
+	require.Error(t, err)
+
+	require.NoError(t, tmp.Join("Blink.ino.hex").WriteFile([]byte("hex")))
+	require.NoError(t, tmp.Join("sub").Mkdir())
+	artifacts, err := ReadArtifacts(tmp)
+	require.NoError(t, err)
+	require.Len(t, artifacts, 1)
+	require.Equal(t, "Blink.ino.hex", artifacts[0].GetName())
+	require.Equal(t, "hex", string(artifacts[0].GetData()))
+}
@@ -0,0 +1,98 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package report
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/envreport"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initDiffCommand() *cobra.Command {
+	diffCommand := &cobra.Command{
+		Use:   "diff <" + tr("REPORT") + "> <" + tr("REPORT") + ">",
+		Short: tr("Compares two environment reports."),
+		Long: tr(`Compares two reports saved by the environment subcommand, after checking
+their checksums, and lists the platforms, tools and libraries that are
+installed only on one of the machines or that have a different version or
+content. The command fails if the reports differ.`),
+		Example: "  " + os.Args[0] + " report diff lab-pc-1.json lab-pc-2.json",
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runDiffCommand(args[0], args[1])
+		},
+	}
+	return diffCommand
+}
+
+func runDiffCommand(leftPath, rightPath string) {
+	logrus.Info("Executing `arduino-cli report diff`")
+	left, err := envreport.Load(paths.New(leftPath))
+	if err != nil {
+		feedback.Fatal(tr("Error loading the environment report: %v", err), feedback.ErrBadArgument)
+	}
+	right, err := envreport.Load(paths.New(rightPath))
+	if err != nil {
+		feedback.Fatal(tr("Error loading the environment report: %v", err), feedback.ErrBadArgument)
+	}
+	res := &diffResult{Differences: envreport.Diff(left, right)}
+	if len(res.Differences) > 0 {
+		res.Error = tr("The environment reports differ")
+		feedback.FatalResult(res, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(res)
+}
+
+type diffResult struct {
+	Differences []*envreport.Difference `json:"differences"`
+	Error       string                  `json:"error,omitempty"`
+}
+
+func (r *diffResult) Data() interface{} {
+	return r
+}
+
+// ErrorString implements feedback.ErrorResult
+func (r *diffResult) ErrorString() string {
+	return r.Error
+}
+
+func (r *diffResult) String() string {
+	if len(r.Differences) == 0 {
+		return tr("The environment reports match.")
+	}
+	describe := func(item *rpc.EnvironmentReportItem) string {
+		if item == nil {
+			return tr("not installed")
+		}
+		if item.GetVersion() == "" {
+			return shortHash(item.GetHash())
+		}
+		return item.GetVersion() + " (" + shortHash(item.GetHash()) + ")"
+	}
+	t := table.New()
+	t.SetHeader(tr("Type"), tr("ID"), tr("Location"), tr("Left"), tr("Right"))
+	for _, d := range r.Differences {
+		t.AddRow(d.Type, d.ID, d.Location, describe(d.Left), describe(d.Right))
+	}
+	return t.Render()
+}
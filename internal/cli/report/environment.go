@@ -0,0 +1,96 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package report
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/envreport"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initEnvironmentCommand() *cobra.Command {
+	var outputFile string
+	environmentCommand := &cobra.Command{
+		Use:   "environment",
+		Short: tr("Lists the installed platforms, tools and libraries with the hashes of their files."),
+		Long: tr(`Lists the installed platforms, tools and libraries with the hashes of their files.
+The report carries a checksum of its content, so it can be saved with
+--output-file, moved to another machine and compared with the report of that
+machine using the diff subcommand.`),
+		Example: "  " + os.Args[0] + " report environment --output-file lab-pc-1.json",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runEnvironmentCommand(outputFile)
+		},
+	}
+	environmentCommand.Flags().StringVar(&outputFile, "output-file", "", tr("Save the report in JSON format to this file."))
+	return environmentCommand
+}
+
+func runEnvironmentCommand(outputFile string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli report environment`")
+
+	res, err := commands.EnvironmentReport(context.Background(), &rpc.EnvironmentReportRequest{Instance: inst})
+	if err != nil {
+		feedback.Fatal(tr("Error building the environment report: %v", err), feedback.ErrGeneric)
+	}
+	if outputFile != "" {
+		if err := envreport.Save(res.GetReport(), paths.New(outputFile)); err != nil {
+			feedback.Fatal(tr("Error saving the environment report: %v", err), feedback.ErrGeneric)
+		}
+	}
+	feedback.PrintResult(&environmentResult{Report: res.GetReport()})
+}
+
+type environmentResult struct {
+	Report *rpc.EnvironmentReport
+}
+
+func (r *environmentResult) Data() interface{} {
+	return r.Report
+}
+
+func (r *environmentResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Type"), tr("ID"), tr("Version"), tr("Location"), tr("Hash"))
+	addRows := func(itemType string, items []*rpc.EnvironmentReportItem) {
+		for _, item := range items {
+			t.AddRow(itemType, item.GetId(), item.GetVersion(), item.GetLocation(), shortHash(item.GetHash()))
+		}
+	}
+	addRows(tr("platform"), r.Report.GetPlatforms())
+	addRows(tr("tool"), r.Report.GetTools())
+	addRows(tr("library"), r.Report.GetLibraries())
+	return t.Render() + "\n" + tr("Checksum:") + " " + r.Report.GetChecksum()
+}
+
+// shortHash returns the first 12 digits of a hash in `SHA-256:<hex>` format
+func shortHash(hash string) string {
+	if len(hash) > len("SHA-256:")+12 {
+		return hash[len("SHA-256:") : len("SHA-256:")+12]
+	}
+	return hash
+}
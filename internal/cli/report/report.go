@@ -0,0 +1,42 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package report
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `report` command
+func NewCommand() *cobra.Command {
+	reportCommand := &cobra.Command{
+		Use:   "report",
+		Short: tr("Reports about the installation."),
+		Long:  tr("Reports about the installed platforms, tools and libraries, to audit the consistency of several machines."),
+		Example: "" +
+			"  " + os.Args[0] + " report environment --output-file lab-pc-1.json\n" +
+			"  " + os.Args[0] + " report diff lab-pc-1.json lab-pc-2.json",
+	}
+
+	reportCommand.AddCommand(initEnvironmentCommand())
+	reportCommand.AddCommand(initDiffCommand())
+
+	return reportCommand
+}
@@ -0,0 +1,95 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	sk "github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initConvertCommand() *cobra.Command {
+	var fqbn arguments.Fqbn
+	var toCpp, dryRun bool
+	convertCommand := &cobra.Command{
+		Use:   fmt.Sprintf("convert [%s] --to-cpp", tr("sketchPath")),
+		Short: tr("Converts the .ino files of a sketch to C++."),
+		Long: tr("Merges the .ino files of the sketch and adds the function prototypes, like the builder does before compiling, and saves the result in a C++ source file in the src folder of the sketch. " +
+			"The other .ino files are removed and the main sketch file is left empty. A board is needed to generate the prototypes, the default board of the sketch is used if not specified."),
+		Example: "  " + os.Args[0] + " sketch convert --to-cpp -b arduino:avr:uno\n" +
+			"  " + os.Args[0] + " sketch convert MySketch --to-cpp -b arduino:avr:uno --dry-run",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			sketchPath := ""
+			if len(args) > 0 {
+				sketchPath = args[0]
+			}
+			if !toCpp {
+				feedback.Fatal(tr("The conversion must be specified, e.g.: --to-cpp"), feedback.ErrBadArgument)
+			}
+			runConvertCommand(sketchPath, fqbn.String(), dryRun)
+		},
+	}
+	fqbn.AddToCommand(convertCommand)
+	convertCommand.Flags().BoolVar(&toCpp, "to-cpp", false, tr("Converts the .ino files to a C++ source file."))
+	convertCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Prints the converted source code without changing the sketch."))
+	return convertCommand
+}
+
+func runConvertCommand(sketchPathArg, fqbn string, dryRun bool) {
+	logrus.Info("Executing `arduino-cli sketch convert`")
+
+	inst := instance.CreateAndInit()
+	sketchPath := arguments.InitSketchPath(sketchPathArg, true)
+	res, err := sk.ConvertSketchToCpp(context.Background(), &rpc.ConvertSketchToCppRequest{
+		Instance:   inst,
+		SketchPath: sketchPath.String(),
+		Fqbn:       fqbn,
+		DryRun:     dryRun,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error converting sketch: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(convertResult{res: res, dryRun: dryRun})
+}
+
+type convertResult struct {
+	res    *rpc.ConvertSketchToCppResponse
+	dryRun bool
+}
+
+func (r convertResult) Data() interface{} {
+	return r.res
+}
+
+func (r convertResult) String() string {
+	if r.dryRun {
+		return r.res.GetSource()
+	}
+	out := tr("Sketch converted to %s", r.res.GetCppFile())
+	for _, file := range r.res.GetRemovedFiles() {
+		out += "\n" + tr("Removed %s", file)
+	}
+	return out
+}
@@ -0,0 +1,141 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	sk "github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	"github.com/arduino/arduino-cli/internal/cli/instance"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initImportPIOCommand() *cobra.Command {
+	var platformioIni string
+	var overwrite, dryRun bool
+	importPIOCommand := &cobra.Command{
+		Use:   fmt.Sprintf("import-pio [%s]", tr("sketchPath")),
+		Short: tr("Imports a PlatformIO project into the sketch project file."),
+		Long: tr("Converts the environments of a PlatformIO project file (platformio.ini) into profiles of the sketch project file (sketch.yaml): " +
+			"boards are mapped to FQBNs, lib_deps are pinned to versions of the library index and build_flags become build configurations. " +
+			"The options that can't be converted are reported."),
+		Example: "  " + os.Args[0] + " sketch import-pio\n" +
+			"  " + os.Args[0] + " sketch import-pio MySketch --platformio-ini ../pio-project/platformio.ini --dry-run",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			sketchPath := ""
+			if len(args) > 0 {
+				sketchPath = args[0]
+			}
+			runImportPIOCommand(sketchPath, platformioIni, overwrite, dryRun)
+		},
+	}
+	importPIOCommand.Flags().StringVar(&platformioIni, "platformio-ini", "", tr("Path of the platformio.ini file, defaults to the one in the sketch folder."))
+	importPIOCommand.Flags().BoolVar(&overwrite, "overwrite", false, tr("Replace the profiles and build configurations with the same name of an imported environment."))
+	importPIOCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Show the import result without changing the sketch project file."))
+	return importPIOCommand
+}
+
+func runImportPIOCommand(sketchPathArg, platformioIni string, overwrite, dryRun bool) {
+	logrus.Info("Executing `arduino-cli sketch import-pio`")
+
+	inst := instance.CreateAndInit()
+	sketchPath := arguments.InitSketchPath(sketchPathArg, true)
+	res, err := sk.ImportPlatformIOProject(context.Background(), &rpc.ImportPlatformIOProjectRequest{
+		Instance:      inst,
+		SketchPath:    sketchPath.String(),
+		PlatformioIni: platformioIni,
+		Overwrite:     overwrite,
+		DryRun:        dryRun,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error importing PlatformIO project: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&importPIOResult{Result: res, DryRun: dryRun})
+}
+
+type importPIOResult struct {
+	Result *rpc.ImportPlatformIOProjectResponse `json:"result"`
+	DryRun bool                                 `json:"dry_run"`
+}
+
+func (r *importPIOResult) Data() interface{} {
+	return r
+}
+
+func (r *importPIOResult) String() string {
+	titleColor := color.New(color.FgHiGreen)
+	nameColor := color.New(color.FgHiYellow)
+	pathColor := color.New(color.FgHiBlack)
+
+	res := ""
+	if profiles := r.Result.GetProfiles(); len(profiles) > 0 {
+		t := table.New()
+		t.SetHeader(
+			table.NewCell(tr("Profile"), titleColor),
+			table.NewCell(tr("FQBN"), titleColor),
+			table.NewCell(tr("Platforms"), titleColor),
+			table.NewCell(tr("Libraries"), titleColor),
+			table.NewCell(tr("Configuration"), titleColor))
+		for _, profile := range profiles {
+			name := profile.GetName()
+			if name == r.Result.GetDefaultProfile() {
+				name += " " + tr("(default)")
+			}
+			t.AddRow(
+				table.NewCell(name, nameColor),
+				profile.GetFqbn(),
+				strings.Join(profile.GetPlatforms(), ", "),
+				strings.Join(profile.GetLibraries(), ", "),
+				profile.GetConfiguration())
+		}
+		res += fmt.Sprintln(t.Render())
+	} else {
+		res += fmt.Sprintln(tr("No environment could be imported."))
+	}
+
+	if warnings := r.Result.GetWarnings(); len(warnings) > 0 {
+		t := table.New()
+		t.SetHeader(
+			table.NewCell(tr("Environment"), titleColor),
+			table.NewCell(tr("Option"), titleColor),
+			table.NewCell(tr("Notes"), titleColor))
+		for _, warning := range warnings {
+			t.AddRow(
+				table.NewCell(warning.GetEnvironment(), nameColor),
+				table.NewCell(warning.GetOption(), pathColor),
+				warning.GetMessage())
+		}
+		res += fmt.Sprintln(t.Render())
+	}
+
+	res = strings.TrimRight(res, "\n")
+	if r.DryRun {
+		res += "\n\n" + tr("Dry run, %s was not changed.", r.Result.GetProjectFile())
+	} else if len(r.Result.GetProfiles()) > 0 {
+		res += "\n\n" + tr("Profiles written to %s", r.Result.GetProjectFile())
+	}
+	return res
+}
@@ -0,0 +1,120 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	sk "github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// initRenameCommand creates a new `rename` command
+func initRenameCommand() *cobra.Command {
+	renameCommand := &cobra.Command{
+		Use:   fmt.Sprintf("rename <%s> <%s>", tr("sketchPath"), tr("newName")),
+		Short: tr("Renames a sketch."),
+		Long: tr("Renames the sketch folder and the main sketch file, together with the files named after the sketch.") + "\n" +
+			tr("The includes of the renamed files and the paths to the sketch in the project file are updated."),
+		Example: "" +
+			"  " + os.Args[0] + " sketch rename MySketch MyNewSketch\n" +
+			"  " + os.Args[0] + " sketch rename /home/user/Arduino/MySketch MyNewSketch",
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			logrus.Info("Executing `arduino-cli sketch rename`")
+			newName := args[1]
+			if strings.ContainsAny(newName, `/\`) {
+				feedback.Fatal(tr("The new name of the sketch must not be a path, use the move command to move the sketch to another folder"), feedback.ErrBadArgument)
+			}
+			sketchDir := sketchFolder(args[0])
+			runMoveCommand(sketchDir, sketchDir.Parent().Join(newName))
+		},
+	}
+	return renameCommand
+}
+
+// initMoveCommand creates a new `move` command
+func initMoveCommand() *cobra.Command {
+	moveCommand := &cobra.Command{
+		Use:   fmt.Sprintf("move <%s> <%s>", tr("sketchPath"), tr("destinationPath")),
+		Short: tr("Moves a sketch to another folder."),
+		Long: tr("Moves a sketch to another folder. If the destination is an existing folder the sketch is moved inside it, otherwise the destination is the new sketch folder and the sketch is renamed after it.") + "\n" +
+			tr("The includes of the renamed files and the paths to the sketch in the project file are updated."),
+		Example: "" +
+			"  " + os.Args[0] + " sketch move MySketch /home/user/Projects\n" +
+			"  " + os.Args[0] + " sketch move MySketch /home/user/Projects/MyNewSketch",
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			logrus.Info("Executing `arduino-cli sketch move`")
+			sketchDir := sketchFolder(args[0])
+			destination := paths.New(args[1])
+			if destination.IsDir() {
+				destination = destination.Join(sketchDir.Base())
+			}
+			runMoveCommand(sketchDir, destination)
+		},
+	}
+	return moveCommand
+}
+
+// sketchFolder returns the absolute path of the folder of the given sketch
+func sketchFolder(sketchPath string) *paths.Path {
+	sketchDir := paths.New(sketchPath)
+	if !sketchDir.IsDir() {
+		sketchDir = sketchDir.Parent()
+	}
+	if abs, err := sketchDir.Abs(); err == nil {
+		sketchDir = abs
+	}
+	return sketchDir
+}
+
+func runMoveCommand(sketchDir, destination *paths.Path) {
+	res, err := sk.MoveSketch(context.Background(), &rpc.MoveSketchRequest{
+		SketchPath:      sketchDir.String(),
+		DestinationPath: destination.String(),
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error moving sketch: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(moveResult{res})
+}
+
+type moveResult struct {
+	res *rpc.MoveSketchResponse
+}
+
+func (r moveResult) Data() interface{} {
+	return r.res
+}
+
+func (r moveResult) String() string {
+	out := tr("Sketch moved to %s", r.res.GetLocationPath())
+	if len(r.res.GetUpdatedFiles()) > 0 {
+		out += "\n" + tr("Updated files:")
+		for _, file := range r.res.GetUpdatedFiles() {
+			out += "\n  " + file
+		}
+	}
+	return out
+}
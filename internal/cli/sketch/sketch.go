@@ -35,6 +35,10 @@ func NewCommand() *cobra.Command {
 
 	sketchCommand.AddCommand(initNewCommand())
 	sketchCommand.AddCommand(initArchiveCommand())
+	sketchCommand.AddCommand(initImportPIOCommand())
+	sketchCommand.AddCommand(initRenameCommand())
+	sketchCommand.AddCommand(initMoveCommand())
+	sketchCommand.AddCommand(initConvertCommand())
 
 	return sketchCommand
 }
@@ -53,6 +53,6 @@ func runUpdateCommand(showOutdated bool) {
 	core.UpdateIndex(inst)
 	instance.Init(inst)
 	if showOutdated {
-		outdated.Outdated(inst)
+		outdated.Outdated(inst, nil)
 	}
 }
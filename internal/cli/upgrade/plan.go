@@ -0,0 +1,104 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upgrade
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/commands/upgrade"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+)
+
+// Plan computes the upgrade of the installed platforms and libraries, holding
+// the versions pinned by the profiles of the sketch if sketchPath is not empty.
+func Plan(inst *rpc.Instance, sketchPath string) *rpc.UpgradePlan {
+	res, err := upgrade.UpgradePlan(context.Background(), &rpc.UpgradePlanRequest{
+		Instance:   inst,
+		SketchPath: sketchPath,
+	})
+	if err != nil {
+		feedback.Fatal(tr("Error computing the upgrade plan: %v", err), feedback.ErrGeneric)
+	}
+	return res.GetPlan()
+}
+
+// PlanResult is the feedback.Result of an upgrade plan
+type PlanResult struct {
+	Platforms []*rpc.UpgradePlanItem `json:"platforms,omitempty"`
+	Libraries []*rpc.UpgradePlanItem `json:"libraries,omitempty"`
+}
+
+// NewPlanResult returns the PlanResult of the plan
+func NewPlanResult(plan *rpc.UpgradePlan) *PlanResult {
+	return &PlanResult{
+		Platforms: plan.GetPlatforms(),
+		Libraries: plan.GetLibraries(),
+	}
+}
+
+// Data implements feedback.Result
+func (r *PlanResult) Data() interface{} {
+	return r
+}
+
+// String implements feedback.Result
+func (r *PlanResult) String() string {
+	if len(r.Platforms) == 0 && len(r.Libraries) == 0 {
+		return tr("No outdated platforms or libraries found.")
+	}
+	t := table.New()
+	t.SetHeader(tr("Type"), tr("Name"), tr("Installed"), tr("Latest"), tr("Upgrade to"), tr("Notes"))
+	t.SetColumnWidthMode(5, table.Average)
+	addRows := func(kind string, items []*rpc.UpgradePlanItem) {
+		for _, item := range items {
+			installed := item.GetInstalledVersion()
+			if installed == "" {
+				installed = "-"
+			}
+			version := item.GetVersion()
+			if version == "" {
+				version = tr("held")
+			}
+			t.AddRow(kind, item.GetName(), installed, item.GetLatestVersion(), version, item.GetReason())
+		}
+	}
+	addRows(tr("platform"), r.Platforms)
+	addRows(tr("library"), r.Libraries)
+	return t.Render()
+}
+
+// HasUpgrades returns true if the plan installs anything
+func (r *PlanResult) HasUpgrades() bool {
+	for _, item := range append(r.Platforms, r.Libraries...) {
+		if item.GetVersion() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// appliedPlanResult is the feedback.Result of an applied upgrade plan: the
+// plan is printed before applying it, so only the structured output reports it.
+type appliedPlanResult struct {
+	*PlanResult
+}
+
+// String implements feedback.Result
+func (r *appliedPlanResult) String() string {
+	return ""
+}
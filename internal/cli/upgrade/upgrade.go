@@ -16,15 +16,18 @@
 package upgrade
 
 import (
+	"context"
 	"os"
 
+	"github.com/arduino/arduino-cli/commands/upgrade"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/internal/cli/arguments"
-	"github.com/arduino/arduino-cli/internal/cli/core"
+	"github.com/arduino/arduino-cli/internal/cli/feedback"
 	"github.com/arduino/arduino-cli/internal/cli/instance"
-	"github.com/arduino/arduino-cli/internal/cli/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 var tr = i18n.Tr
@@ -32,23 +35,53 @@ var tr = i18n.Tr
 // NewCommand creates a new `upgrade` command
 func NewCommand() *cobra.Command {
 	var postInstallFlags arguments.PrePostScriptsFlags
+	var sketchPath string
+	var dryRun bool
 	upgradeCommand := &cobra.Command{
-		Use:     "upgrade",
-		Short:   tr("Upgrades installed cores and libraries."),
-		Long:    tr("Upgrades installed cores and libraries to latest version."),
-		Example: "  " + os.Args[0] + " upgrade",
-		Args:    cobra.NoArgs,
+		Use:   "upgrade",
+		Short: tr("Upgrades installed cores and libraries."),
+		Long: tr(`Upgrades installed cores and libraries to latest version.
+The upgrade is planned as a whole: the libraries are moved to the newest
+versions satisfying the dependencies of the other installed libraries, and
+the versions pinned by the profiles of the sketch given with --sketch are
+held. Everything is downloaded before changing the installation, which is
+restored if any of the installations fails.`),
+		Example: "" +
+			"  " + os.Args[0] + " upgrade\n" +
+			"  " + os.Args[0] + " upgrade --dry-run --sketch ~/Arduino/MySketch",
+		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
-			runUpgradeCommand(postInstallFlags.DetectSkipPostInstallValue(), postInstallFlags.DetectSkipPreUninstallValue())
+			runUpgradeCommand(sketchPath, dryRun, postInstallFlags.DetectSkipPostInstallValue(), postInstallFlags.DetectSkipPreUninstallValue())
 		},
 	}
 	postInstallFlags.AddToCommand(upgradeCommand)
+	upgradeCommand.Flags().StringVar(&sketchPath, "sketch", "", tr("Hold the platforms and libraries at the versions pinned by the profiles of this sketch."))
+	upgradeCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Show the upgrade plan without applying it."))
 	return upgradeCommand
 }
 
-func runUpgradeCommand(skipPostInstall bool, skipPreUninstall bool) {
+func runUpgradeCommand(sketchPath string, dryRun, skipPostInstall, skipPreUninstall bool) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
 	inst := instance.CreateAndInit()
 	logrus.Info("Executing `arduino-cli upgrade`")
-	lib.Upgrade(inst, []string{})
-	core.Upgrade(inst, []string{}, skipPostInstall, skipPreUninstall)
+
+	plan := Plan(inst, sketchPath)
+	res := NewPlanResult(plan)
+	if dryRun || !res.HasUpgrades() {
+		feedback.PrintResult(res)
+		return
+	}
+	feedback.Print(res.String())
+	err := upgrade.UpgradeApply(ctx, &rpc.UpgradeApplyRequest{
+		Instance:         inst,
+		Plan:             plan,
+		SkipPostInstall:  skipPostInstall,
+		SkipPreUninstall: skipPreUninstall,
+	}, feedback.ProgressBar(), feedback.TaskProgress())
+	if err != nil {
+		feedback.Fatal(tr("Error upgrading: %v", err), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(&appliedPlanResult{res})
 }
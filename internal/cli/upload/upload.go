@@ -21,70 +21,99 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/commands/core"
 	sk "github.com/arduino/arduino-cli/commands/sketch"
 	"github.com/arduino/arduino-cli/commands/upload"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/internal/cli/arguments"
+	"github.com/arduino/arduino-cli/internal/cli/daemonclient"
 	"github.com/arduino/arduino-cli/internal/cli/feedback"
 	"github.com/arduino/arduino-cli/internal/cli/instance"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/arduino-cli/version"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.bug.st/cleanup"
 )
 
 var (
-	fqbnArg    arguments.Fqbn
-	portArgs   arguments.Port
-	profileArg arguments.Profile
-	verbose    bool
-	verify     bool
-	importDir  string
-	importFile string
-	programmer arguments.Programmer
-	dryRun     bool
-	tr         = i18n.Tr
+	fqbnArg             arguments.Fqbn
+	portArgs            arguments.Port
+	profileArg          arguments.Profile
+	verbosity           arguments.Verbosity
+	verify              bool
+	importDir           string
+	importFile          string
+	programmer          arguments.Programmer
+	dryRun              bool
+	withFs              bool
+	fsType              string
+	skipBootloaderCheck bool
+	uploadSpeed         string
+	noStub              bool
+	flashMode           string
+	flashFreq           string
+	tr                  = i18n.Tr
 )
 
 // NewCommand created a new `upload` command
 func NewCommand() *cobra.Command {
 	uploadFields := map[string]string{}
+	resetProperties := map[string]string{}
+	uploadOptions := map[string]string{}
 	uploadCommand := &cobra.Command{
 		Use:   "upload",
 		Short: tr("Upload Arduino sketches."),
 		Long:  tr("Upload Arduino sketches. This does NOT compile the sketch prior to upload."),
 		Example: "" +
 			"  " + os.Args[0] + " upload /home/user/Arduino/MySketch -p /dev/ttyACM0 -b arduino:avr:uno\n" +
-			"  " + os.Args[0] + " upload -p 192.168.10.1 -b arduino:avr:uno --upload-field password=abc",
+			"  " + os.Args[0] + " upload -p 192.168.10.1 -b arduino:avr:uno --upload-field password=abc\n" +
+			"  " + os.Args[0] + " upload /home/user/Arduino/MySketch -p /dev/ttyUSB0 -b esp32:esp32:esp32 --with-fs\n" +
+			"  " + os.Args[0] + " upload /home/user/Arduino/MySketch -p /dev/ttyUSB0 -b esp32:esp32:esp32 --upload-speed 115200 --no-stub\n" +
+			"  " + os.Args[0] + " upload -p /dev/ttyACM0 --input-file /home/user/Arduino/MySketch/build/arduino.avr.uno/MySketch.ino.manifest.json",
 		Args: cobra.MaximumNArgs(1),
 		PreRun: func(cmd *cobra.Command, args []string) {
 			arguments.CheckFlagsConflicts(cmd, "input-file", "input-dir")
 		},
 		Run: func(cmd *cobra.Command, args []string) {
-			runUploadCommand(args, uploadFields)
+			runUploadCommand(args, uploadFields, resetProperties, uploadOptions)
 		},
 	}
 
 	fqbnArg.AddToCommand(uploadCommand)
 	portArgs.AddToCommand(uploadCommand)
 	profileArg.AddToCommand(uploadCommand)
-	uploadCommand.Flags().StringVarP(&importDir, "input-dir", "", "", tr("Directory containing binaries to upload."))
-	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", tr("Binary file to upload."))
+	uploadCommand.Flags().StringVarP(&importDir, "input-dir", "", "", tr("Directory containing binaries to upload. It can be the folder of the binaries exported for several boards, the binaries of the board are selected."))
+	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", tr("Binary file to upload, or the build manifest written by compile --export-binaries."))
 	uploadCommand.Flags().BoolVarP(&verify, "verify", "t", false, tr("Verify uploaded binary after the upload."))
-	uploadCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	verbosity.AddToCommand(uploadCommand)
 	programmer.AddToCommand(uploadCommand)
 	uploadCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Do not perform the actual upload, just log out actions"))
 	uploadCommand.Flags().MarkHidden("dry-run")
+	uploadCommand.Flags().BoolVar(&withFs, "with-fs", false, tr("Build and upload also the filesystem image of the sketch data folder, after the sketch."))
+	uploadCommand.Flags().StringVar(&fsType, "fs-type", "", tr("Filesystem type of the image uploaded with --with-fs: littlefs, spiffs or fatfs. If not set the board default is used."))
+	uploadCommand.Flags().BoolVar(&skipBootloaderCheck, "skip-bootloader-check", false, tr("Skip the check for a bootloader answering on the upload port performed before the upload."))
+	uploadCommand.RegisterFlagCompletionFunc("fs-type", cobra.FixedCompletions([]string{"littlefs", "spiffs", "fatfs"}, cobra.ShellCompDirectiveDefault))
 	arguments.AddKeyValuePFlag(uploadCommand, &uploadFields, "upload-field", "F", nil, tr("Set a value for a field required to upload."))
+	arguments.AddKeyValuePFlag(uploadCommand, &resetProperties, "reset-property", "", nil, tr("Override a board property of the reset sequence performed before the upload (e.g. upload.reset.delay=1000)."))
+	uploadCommand.Flags().StringVar(&uploadSpeed, "upload-speed", "", tr("Baud rate used by the upload tool, if supported by the platform."))
+	uploadCommand.Flags().BoolVar(&noStub, "no-stub", false, tr("Upload without the flasher stub, if supported by the platform (e.g. esptool)."))
+	uploadCommand.Flags().StringVar(&flashMode, "flash-mode", "", tr("Flash mode used by the upload tool (e.g. qio, dio), if supported by the platform."))
+	uploadCommand.Flags().StringVar(&flashFreq, "flash-freq", "", tr("Flash frequency used by the upload tool (e.g. 40m, 80m), if supported by the platform."))
+	arguments.AddKeyValuePFlag(uploadCommand, &uploadOptions, "upload-option", "", nil, tr("Set an option of the upload tool declared by the platform (e.g. speed=115200)."))
 	return uploadCommand
 }
 
-func runUploadCommand(args []string, uploadFieldsArgs map[string]string) {
+func runUploadCommand(args []string, uploadFieldsArgs map[string]string, resetProperties map[string]string, uploadOptions map[string]string) {
 	logrus.Info("Executing `arduino-cli upload`")
 
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+
 	path := ""
 	if len(args) > 0 {
 		path = args[0]
@@ -103,10 +132,22 @@ func runUploadCommand(args []string, uploadFieldsArgs map[string]string) {
 	var inst *rpc.Instance
 	var profile *rpc.Profile
 
-	if profileArg.Get() == "" {
-		inst, profile = instance.CreateAndInitWithProfile(sketch.GetDefaultProfile().GetName(), sketchPath)
+	profileName := profileArg.Get()
+	if profileName == "" {
+		profileName = sketch.GetDefaultProfile().GetName()
+	}
+	daemonClient := connectToDaemon(ctx)
+	if daemonClient != nil {
+		defer daemonClient.Close()
+		if profileName != "" {
+			profile = instance.InitDaemonWithProfile(daemonClient, profileName, sketchPath)
+		}
+		// The ports are detected by the daemon
+		portArgs.SetPortLister(func(timeout time.Duration) ([]*rpc.DetectedPort, error) {
+			return daemonClient.BoardList(ctx, timeout)
+		})
 	} else {
-		inst, profile = instance.CreateAndInitWithProfile(profileArg.Get(), sketchPath)
+		inst, profile = instance.CreateAndInitWithProfile(profileName, sketchPath)
 	}
 
 	if fqbnArg.String() == "" {
@@ -114,22 +155,32 @@ func runUploadCommand(args []string, uploadFieldsArgs map[string]string) {
 	}
 
 	defaultFQBN := sketch.GetDefaultFqbn()
+	if defaultFQBN == "" {
+		// The manifest of the exported build records the board it was compiled for
+		defaultFQBN = upload.ImportedBuildFQBN(importFile, importDir)
+	}
 	defaultAddress := sketch.GetDefaultPort()
 	defaultProtocol := sketch.GetDefaultProtocol()
 	fqbn, port := arguments.CalculateFQBNAndPort(&portArgs, &fqbnArg, inst, defaultFQBN, defaultAddress, defaultProtocol)
 
-	userFieldRes, err := upload.SupportedUserFields(context.Background(), &rpc.SupportedUserFieldsRequest{
+	userFieldsReq := &rpc.SupportedUserFieldsRequest{
 		Instance: inst,
 		Fqbn:     fqbn,
 		Protocol: port.Protocol,
-	})
+	}
+	var userFieldRes *rpc.SupportedUserFieldsResponse
+	if daemonClient != nil {
+		userFieldRes, err = daemonClient.SupportedUserFields(ctx, userFieldsReq)
+	} else {
+		userFieldRes, err = upload.SupportedUserFields(context.Background(), userFieldsReq)
+	}
 	if err != nil {
 		msg := tr("Error during Upload: %v", err)
 
 		// Check the error type to give the user better feedback on how
 		// to resolve it
 		var platformErr *arduino.PlatformNotFoundError
-		if errors.As(err, &platformErr) {
+		if errors.As(err, &platformErr) && inst != nil {
 			split := strings.Split(platformErr.Platform, ":")
 			if len(split) < 2 {
 				panic(tr("Platform ID is not correct"))
@@ -178,21 +229,53 @@ func runUploadCommand(args []string, uploadFieldsArgs map[string]string) {
 		path = sketchPath.String()
 	}
 
+	// The first-class flags are shortcuts for the most common upload options
+	if uploadSpeed != "" {
+		uploadOptions["speed"] = uploadSpeed
+	}
+	if noStub {
+		uploadOptions["no_stub"] = "true"
+	}
+	if flashMode != "" {
+		uploadOptions["flash_mode"] = flashMode
+	}
+	if flashFreq != "" {
+		uploadOptions["flash_freq"] = flashFreq
+	}
+
 	stdOut, stdErr, stdIOResult := feedback.OutputStreams()
 	req := &rpc.UploadRequest{
-		Instance:   inst,
-		Fqbn:       fqbn,
-		SketchPath: path,
-		Port:       port,
-		Verbose:    verbose,
-		Verify:     verify,
-		ImportFile: importFile,
-		ImportDir:  importDir,
-		Programmer: programmer.String(),
-		DryRun:     dryRun,
-		UserFields: fields,
-	}
-	if res, err := upload.Upload(context.Background(), req, stdOut, stdErr); err != nil {
+		Instance:            inst,
+		Fqbn:                fqbn,
+		SketchPath:          path,
+		Port:                port,
+		Verbose:             verbosity.UploadVerbose(),
+		Verify:              verify,
+		ImportFile:          importFile,
+		ImportDir:           importDir,
+		Programmer:          programmer.String(),
+		DryRun:              dryRun,
+		UserFields:          fields,
+		ResetProperties:     resetProperties,
+		WithFs:              withFs,
+		FsType:              fsType,
+		SkipBootloaderCheck: skipBootloaderCheck,
+		UploadOptions:       uploadOptions,
+	}
+	var progressCB rpc.TaskProgressCB
+	uploadOut, uploadErr := stdOut, stdErr
+	if !verbosity.UploadVerbose() {
+		var progressDone func()
+		uploadOut, uploadErr, progressCB, progressDone = feedback.UploadProgress(stdOut, stdErr)
+		defer progressDone()
+	}
+	var res *rpc.UploadResult
+	if daemonClient != nil {
+		res, err = daemonClient.Upload(ctx, req, uploadOut, uploadErr, progressCB)
+	} else {
+		res, err = upload.Upload(ctx, req, uploadOut, uploadErr, progressCB)
+	}
+	if err != nil {
 		feedback.FatalError(err, feedback.ErrGeneric)
 	} else {
 		io := stdIOResult()
@@ -200,14 +283,31 @@ func runUploadCommand(args []string, uploadFieldsArgs map[string]string) {
 			Stdout:            io.Stdout,
 			Stderr:            io.Stderr,
 			UpdatedUploadPort: res.UpdatedUploadPort,
+			FilesystemImage:   res.GetFilesystemImage(),
 		})
 	}
 }
 
+// connectToDaemon returns a connection to the background daemon if the
+// cli.use_daemon setting is enabled, otherwise it returns nil and the upload
+// runs in-process.
+func connectToDaemon(ctx context.Context) *daemonclient.Client {
+	if !configuration.Settings.GetBool("cli.use_daemon") {
+		return nil
+	}
+	client, err := daemonclient.Connect(ctx)
+	if err != nil {
+		feedback.Warning(tr("Could not connect to the background daemon, uploading without it: %v", err))
+		return nil
+	}
+	return client
+}
+
 type uploadResult struct {
-	Stdout            string    `json:"stdout"`
-	Stderr            string    `json:"stderr"`
-	UpdatedUploadPort *rpc.Port `json:"updated_upload_port,omitempty"`
+	Stdout            string               `json:"stdout"`
+	Stderr            string               `json:"stderr"`
+	UpdatedUploadPort *rpc.Port            `json:"updated_upload_port,omitempty"`
+	FilesystemImage   *rpc.FilesystemImage `json:"filesystem_image,omitempty"`
 }
 
 func (r *uploadResult) Data() interface{} {
@@ -215,8 +315,12 @@ func (r *uploadResult) Data() interface{} {
 }
 
 func (r *uploadResult) String() string {
-	if r.UpdatedUploadPort == nil {
-		return ""
+	res := []string{}
+	if image := r.FilesystemImage; image != nil {
+		res = append(res, tr("Uploaded %[1]s image %[2]s (%[3]d bytes at offset %[4]s)", image.GetFsType(), image.GetImagePath(), image.GetSize(), fmt.Sprintf("0x%X", image.GetOffset())))
+	}
+	if r.UpdatedUploadPort != nil {
+		res = append(res, tr("New upload port: %[1]s (%[2]s)", r.UpdatedUploadPort.Address, r.UpdatedUploadPort.Protocol))
 	}
-	return tr("New upload port: %[1]s (%[2]s)", r.UpdatedUploadPort.Address, r.UpdatedUploadPort.Protocol)
+	return strings.Join(res, "\n")
 }
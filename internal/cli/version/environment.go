@@ -0,0 +1,109 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands/daemon"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/version"
+)
+
+// versionResult is the output of the version command, the environment is
+// printed only in the machine readable formats.
+type versionResult struct {
+	*version.Info
+	Environment *environmentInfo `json:"Environment"`
+}
+
+func (r *versionResult) Data() interface{} {
+	return r
+}
+
+func (r *versionResult) String() string {
+	return r.Info.String()
+}
+
+// environmentInfo describes the build and the runtime environment of the
+// CLI, to be captured by the support tools in a single call.
+type environmentInfo struct {
+	GoVersion             string                `json:"GoVersion"`
+	OS                    string                `json:"OS"`
+	Arch                  string                `json:"Arch"`
+	Features              []string              `json:"Features"`
+	ConfigFile            string                `json:"ConfigFile"`
+	Directories           *environmentDirectory `json:"Directories"`
+	DaemonProtocolVersion string                `json:"DaemonProtocolVersion"`
+}
+
+type environmentDirectory struct {
+	Data      string `json:"Data"`
+	Downloads string `json:"Downloads"`
+	User      string `json:"User"`
+}
+
+func newEnvironmentInfo() *environmentInfo {
+	settings := configuration.Settings
+	return &environmentInfo{
+		GoVersion:  runtime.Version(),
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Features:   enabledFeatures(),
+		ConfigFile: settings.ConfigFileUsed(),
+		Directories: &environmentDirectory{
+			Data:      configuration.DataDir(settings).String(),
+			Downloads: configuration.DownloadsDir(settings).String(),
+			User:      settings.GetString("directories.User"),
+		},
+		DaemonProtocolVersion: daemon.CurrentAPIVersion,
+	}
+}
+
+// enabledFeatures returns the optional features of the build (the build tags
+// and cgo) and the ones turned on in the configuration.
+func enabledFeatures() []string {
+	features := []string{}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch {
+			case setting.Key == "-tags" && setting.Value != "":
+				features = append(features, strings.Split(setting.Value, ",")...)
+			case setting.Key == "CGO_ENABLED" && setting.Value == "1":
+				features = append(features, "cgo")
+			}
+		}
+	}
+	settings := configuration.Settings
+	enabledInConfig := []string{}
+	for feature, enabled := range map[string]bool{
+		"daemon":                 settings.GetBool("cli.use_daemon"),
+		"ccache":                 settings.GetBool("build.use_ccache"),
+		"compile_wrapper":        settings.GetString("build.compile_wrapper") != "",
+		"metrics":                settings.GetBool("metrics.enabled"),
+		"updater_notification":   settings.GetBool("updater.enable_notification"),
+		"unsafe_library_install": settings.GetBool("library.enable_unsafe_install"),
+	} {
+		if enabled {
+			enabledInConfig = append(enabledInConfig, feature)
+		}
+	}
+	slices.Sort(enabledInConfig)
+	return append(features, enabledInConfig...)
+}
@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package version
+
+import (
+	"encoding/json"
+	"runtime"
+	"testing"
+
+	"github.com/arduino/arduino-cli/commands/daemon"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/version"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironmentInfo(t *testing.T) {
+	dataDir := paths.New(t.TempDir())
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.data", dataDir.String())
+	configuration.Settings.Set("directories.downloads", dataDir.Join("staging").String())
+	configuration.Settings.Set("directories.user", dataDir.Join("user").String())
+	configuration.Settings.Set("build.use_ccache", true)
+	configuration.Settings.Set("metrics.enabled", false)
+	configuration.Settings.Set("updater.enable_notification", true)
+
+	env := newEnvironmentInfo()
+	require.Equal(t, runtime.Version(), env.GoVersion)
+	require.Equal(t, runtime.GOOS, env.OS)
+	require.Equal(t, runtime.GOARCH, env.Arch)
+	require.Equal(t, dataDir.String(), env.Directories.Data)
+	require.Equal(t, dataDir.Join("staging").String(), env.Directories.Downloads)
+	require.Equal(t, dataDir.Join("user").String(), env.Directories.User)
+	require.Equal(t, daemon.CurrentAPIVersion, env.DaemonProtocolVersion)
+	require.Subset(t, env.Features, []string{"ccache", "updater_notification"})
+	require.NotContains(t, env.Features, "metrics")
+	require.NotContains(t, env.Features, "daemon")
+}
+
+func TestVersionResult(t *testing.T) {
+	previousSettings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = previousSettings })
+	configuration.Settings = configuration.Init("")
+	info := &version.Info{Application: "arduino-cli", VersionString: "1.2.3", Commit: "deadbeef", Date: "2023-01-01"}
+	res := &versionResult{Info: info, Environment: newEnvironmentInfo()}
+
+	// The text output is unchanged
+	require.Equal(t, info.String(), res.String())
+
+	// The JSON output keeps the version fields at the top level
+	data, err := json.Marshal(res.Data())
+	require.NoError(t, err)
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &output))
+	require.Equal(t, "arduino-cli", output["Application"])
+	require.Equal(t, "1.2.3", output["VersionString"])
+	require.Equal(t, "deadbeef", output["Commit"])
+	require.Contains(t, output, "Environment")
+	environment := output["Environment"].(map[string]interface{})
+	require.Equal(t, runtime.Version(), environment["GoVersion"])
+	require.Contains(t, environment, "Directories")
+}
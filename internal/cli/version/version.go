@@ -48,10 +48,11 @@ func runVersionCommand(cmd *cobra.Command, args []string) {
 	logrus.Info("Executing `arduino-cli version`")
 
 	info := version.VersionInfo
+	res := &versionResult{Info: info, Environment: newEnvironmentInfo()}
 	if strings.Contains(info.VersionString, "git-snapshot") || strings.Contains(info.VersionString, "nightly") {
 		// We're using a development version, no need to check if there's a
 		// new release available
-		feedback.PrintResult(info)
+		feedback.PrintResult(res)
 		return
 	}
 
@@ -66,7 +67,7 @@ func runVersionCommand(cmd *cobra.Command, args []string) {
 		info.LatestVersion = latestVersion.String()
 	}
 
-	feedback.PrintResult(info)
+	feedback.PrintResult(res)
 
 	if feedback.GetFormat() == feedback.Text && latestVersion != nil {
 		updater.NotifyNewVersionIsAvailable(latestVersion.String())
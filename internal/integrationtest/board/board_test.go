@@ -405,6 +405,81 @@ func TestBoardDetailsListProgrammersFlag(t *testing.T) {
 	require.Contains(t, lines, "sam_ice   Atmel SAM-ICE")
 }
 
+func TestBoardOptions(t *testing.T) {
+	env, cli := integrationtest.CreateArduinoCLIWithEnvironment(t)
+	defer env.CleanUp()
+
+	_, _, err := cli.Run("core", "update-index")
+	require.NoError(t, err)
+	_, _, err = cli.Run("core", "install", "arduino:avr@1.8.6")
+	require.NoError(t, err)
+
+	stdout, _, err := cli.Run("board", "options", "arduino:avr:nano:cpu=atmega168", "--format", "json")
+	require.NoError(t, err)
+	requirejson.Contains(t, stdout, `{
+		"fqbn": "arduino:avr:nano",
+		"name": "Arduino Nano",
+		"default_fqbn": "arduino:avr:nano:cpu=atmega328",
+		"selected_fqbn": "arduino:avr:nano:cpu=atmega168",
+		"combinations": 3,
+		"config_options": [
+			{
+				"option": "cpu",
+				"option_label": "Processor",
+				"values": [
+					{ "value": "atmega328", "value_label": "ATmega328P", "is_default": true },
+					{ "value": "atmega328old", "value_label": "ATmega328P (Old Bootloader)" },
+					{ "value": "atmega168", "value_label": "ATmega168", "selected": true }
+				]
+			}
+		]
+	}`)
+
+	// Boards without custom options
+	stdout, _, err = cli.Run("board", "options", "arduino:avr:uno", "--format", "json")
+	require.NoError(t, err)
+	requirejson.Query(t, stdout, ".combinations", "1")
+	requirejson.Query(t, stdout, ".default_fqbn", `"arduino:avr:uno"`)
+
+	_, _, err = cli.Run("board", "options", "arduino:avr:nonexistent")
+	require.Error(t, err)
+}
+
+func TestBoardDefine(t *testing.T) {
+	env, cli := integrationtest.CreateArduinoCLIWithEnvironment(t)
+	defer env.CleanUp()
+
+	_, _, err := cli.Run("core", "update-index")
+	require.NoError(t, err)
+	_, _, err = cli.Run("core", "install", "arduino:avr@1.8.6")
+	require.NoError(t, err)
+
+	// Dry run doesn't change the platform
+	stdout, _, err := cli.Run("board", "define", "nano_8mhz", "-b", "arduino:avr:nano:cpu=atmega168", "--clock", "8000000L", "--dry-run")
+	require.NoError(t, err)
+	require.Contains(t, string(stdout), "nano_8mhz.build.f_cpu=8000000L")
+	require.Contains(t, string(stdout), "nano_8mhz.build.mcu=atmega168")
+	require.NotContains(t, string(stdout), "nano_8mhz.menu.cpu")
+	boardsLocalTxt := cli.DataDir().Join("packages", "arduino", "hardware", "avr", "1.8.6", "boards.local.txt")
+	require.NoFileExists(t, boardsLocalTxt.String())
+
+	_, _, err = cli.Run("board", "define", "nano_8mhz", "-b", "arduino:avr:nano:cpu=atmega168", "--name", "Nano 8MHz", "--clock", "8000000L")
+	require.NoError(t, err)
+	require.FileExists(t, boardsLocalTxt.String())
+
+	stdout, _, err = cli.Run("board", "details", "-b", "arduino:avr:nano_8mhz", "--show-properties", "--format", "json")
+	require.NoError(t, err)
+	requirejson.Contains(t, stdout, `{ "build_properties": [ "name=Nano 8MHz", "build.f_cpu=8000000L", "build.mcu=atmega168" ] }`)
+
+	// Already defined board
+	_, _, err = cli.Run("board", "define", "nano_8mhz", "-b", "arduino:avr:nano")
+	require.Error(t, err)
+
+	// Invalid variant
+	_, _, err = cli.Run("board", "define", "nano_custom", "-b", "arduino:avr:nano", "--variant", "nonexistent")
+	require.Error(t, err)
+}
+
 func TestBoardSearch(t *testing.T) {
 	env, cli := integrationtest.CreateArduinoCLIWithEnvironment(t)
 	defer env.CleanUp()
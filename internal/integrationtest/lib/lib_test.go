@@ -862,16 +862,18 @@ func TestLibListWithUpdatableFlag(t *testing.T) {
 	var lines [][]string
 	for _, v := range strings.Split(strings.TrimSpace(string(stdout)), "\n") {
 		v = strings.Join(strings.Fields(v), " ")
-		lines = append(lines, strings.SplitN(v, " ", 5))
+		lines = append(lines, strings.SplitN(v, " ", 6))
 	}
 	require.Len(t, lines, 2)
-	require.Subset(t, lines[0], []string{"Name", "Installed", "Available", "Location", "Description"})
+	require.Subset(t, lines[0], []string{"Name", "Installed", "Available", "Location", "Source", "Description"})
 	require.Equal(t, "ArduinoJson", lines[1][0])
 	require.Equal(t, "6.11.0", lines[1][1])
 	// Verifies available version is not equal to installed one and not empty
 	require.NotEqual(t, "6.11.0", lines[1][2])
 	require.NotEmpty(t, lines[1][2])
-	require.Equal(t, "An efficient and elegant JSON library...", lines[1][4])
+	require.Equal(t, "user", lines[1][3])
+	require.Equal(t, "index", lines[1][4])
+	require.Equal(t, "An efficient and elegant JSON library...", lines[1][5])
 
 	// Look at the JSON output
 	stdout, stderr, err = cli.Run("lib", "list", "--updatable", "--format", "json")
@@ -26,7 +26,7 @@ import (
 
 func main() {
 	configuration.Settings = configuration.Init(configuration.FindConfigFileInArgs(os.Args))
-	i18n.Init(configuration.Settings.GetString("locale"))
+	i18n.Init(configuration.Locale(configuration.Settings, os.Args))
 	arduinoCmd := cli.NewCommand()
 	if err := arduinoCmd.Execute(); err != nil {
 		feedback.FatalError(err, feedback.ErrGeneric)
@@ -35,6 +35,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BoardSymbolKind int32
+
+const (
+	// The name of a pin (e.g., `A0`, `LED_BUILTIN`, `SDA`).
+	BoardSymbolKind_BOARD_SYMBOL_KIND_PIN BoardSymbolKind = 0
+	// A macro or a constant with a value (e.g., `NUM_DIGITAL_PINS`).
+	BoardSymbolKind_BOARD_SYMBOL_KIND_MACRO BoardSymbolKind = 1
+	// A macro without a value telling a feature of the board (e.g.,
+	// `HAVE_HWSERIAL1`, `ARDUINO_ARCH_AVR`).
+	BoardSymbolKind_BOARD_SYMBOL_KIND_FEATURE BoardSymbolKind = 2
+)
+
+// Enum value maps for BoardSymbolKind.
+var (
+	BoardSymbolKind_name = map[int32]string{
+		0: "BOARD_SYMBOL_KIND_PIN",
+		1: "BOARD_SYMBOL_KIND_MACRO",
+		2: "BOARD_SYMBOL_KIND_FEATURE",
+	}
+	BoardSymbolKind_value = map[string]int32{
+		"BOARD_SYMBOL_KIND_PIN":     0,
+		"BOARD_SYMBOL_KIND_MACRO":   1,
+		"BOARD_SYMBOL_KIND_FEATURE": 2,
+	}
+)
+
+func (x BoardSymbolKind) Enum() *BoardSymbolKind {
+	p := new(BoardSymbolKind)
+	*p = x
+	return p
+}
+
+func (x BoardSymbolKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BoardSymbolKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_commands_v1_board_proto_enumTypes[0].Descriptor()
+}
+
+func (BoardSymbolKind) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_commands_v1_board_proto_enumTypes[0]
+}
+
+func (x BoardSymbolKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BoardSymbolKind.Descriptor instead.
+func (BoardSymbolKind) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{0}
+}
+
 type BoardDetailsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -806,6 +859,9 @@ type ConfigValue struct {
 	ValueLabel string `protobuf:"bytes,2,opt,name=value_label,json=valueLabel,proto3" json:"value_label,omitempty"`
 	// Whether the configuration option is selected.
 	Selected bool `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	// Whether this is the default value of the configuration option, used when
+	// the option is not set in the FQBN.
+	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
 }
 
 func (x *ConfigValue) Reset() {
@@ -861,22 +917,27 @@ func (x *ConfigValue) GetSelected() bool {
 	return false
 }
 
-type BoardListRequest struct {
+func (x *ConfigValue) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type BoardOptionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Arduino Core Service instance from the `Init` response.
 	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	// Search for boards for the given time (in milliseconds)
-	Timeout int64 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	// The fully qualified board name of the board you want information about
-	// (e.g., `arduino:avr:uno`).
-	Fqbn string `protobuf:"bytes,3,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// The fully qualified board name of the board (e.g., `arduino:avr:nano`).
+	// The configuration options set in the FQBN are reported as selected.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
 }
 
-func (x *BoardListRequest) Reset() {
-	*x = BoardListRequest{}
+func (x *BoardOptionsRequest) Reset() {
+	*x = BoardOptionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,13 +945,13 @@ func (x *BoardListRequest) Reset() {
 	}
 }
 
-func (x *BoardListRequest) String() string {
+func (x *BoardOptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardListRequest) ProtoMessage() {}
+func (*BoardOptionsRequest) ProtoMessage() {}
 
-func (x *BoardListRequest) ProtoReflect() protoreflect.Message {
+func (x *BoardOptionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,43 +963,50 @@ func (x *BoardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardListRequest.ProtoReflect.Descriptor instead.
-func (*BoardListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardOptionsRequest.ProtoReflect.Descriptor instead.
+func (*BoardOptionsRequest) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BoardListRequest) GetInstance() *Instance {
+func (x *BoardOptionsRequest) GetInstance() *Instance {
 	if x != nil {
 		return x.Instance
 	}
 	return nil
 }
 
-func (x *BoardListRequest) GetTimeout() int64 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-func (x *BoardListRequest) GetFqbn() string {
+func (x *BoardOptionsRequest) GetFqbn() string {
 	if x != nil {
 		return x.Fqbn
 	}
 	return ""
 }
 
-type BoardListResponse struct {
+type BoardOptionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of ports and the boards detected on those ports.
-	Ports []*DetectedPort `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
-}
-
-func (x *BoardListResponse) Reset() {
-	*x = BoardListResponse{}
+	// The fully qualified board name of the board, without configuration
+	// options.
+	Fqbn string `protobuf:"bytes,1,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Name used to identify the board to humans.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The board's custom configuration options with all their possible values.
+	ConfigOptions []*ConfigOption `protobuf:"bytes,3,rep,name=config_options,json=configOptions,proto3" json:"config_options,omitempty"`
+	// The FQBN of the board with all the configuration options set to their
+	// default value.
+	DefaultFqbn string `protobuf:"bytes,4,opt,name=default_fqbn,json=defaultFqbn,proto3" json:"default_fqbn,omitempty"`
+	// The FQBN of the board with all the configuration options set to their
+	// selected value.
+	SelectedFqbn string `protobuf:"bytes,5,opt,name=selected_fqbn,json=selectedFqbn,proto3" json:"selected_fqbn,omitempty"`
+	// The number of different configurations obtainable by combining all the
+	// values of the configuration options.
+	Combinations int64 `protobuf:"varint,6,opt,name=combinations,proto3" json:"combinations,omitempty"`
+}
+
+func (x *BoardOptionsResponse) Reset() {
+	*x = BoardOptionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -946,13 +1014,13 @@ func (x *BoardListResponse) Reset() {
 	}
 }
 
-func (x *BoardListResponse) String() string {
+func (x *BoardOptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardListResponse) ProtoMessage() {}
+func (*BoardOptionsResponse) ProtoMessage() {}
 
-func (x *BoardListResponse) ProtoReflect() protoreflect.Message {
+func (x *BoardOptionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -964,31 +1032,78 @@ func (x *BoardListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardListResponse.ProtoReflect.Descriptor instead.
-func (*BoardListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardOptionsResponse.ProtoReflect.Descriptor instead.
+func (*BoardOptionsResponse) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BoardListResponse) GetPorts() []*DetectedPort {
+func (x *BoardOptionsResponse) GetFqbn() string {
 	if x != nil {
-		return x.Ports
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *BoardOptionsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardOptionsResponse) GetConfigOptions() []*ConfigOption {
+	if x != nil {
+		return x.ConfigOptions
 	}
 	return nil
 }
 
-type DetectedPort struct {
+func (x *BoardOptionsResponse) GetDefaultFqbn() string {
+	if x != nil {
+		return x.DefaultFqbn
+	}
+	return ""
+}
+
+func (x *BoardOptionsResponse) GetSelectedFqbn() string {
+	if x != nil {
+		return x.SelectedFqbn
+	}
+	return ""
+}
+
+func (x *BoardOptionsResponse) GetCombinations() int64 {
+	if x != nil {
+		return x.Combinations
+	}
+	return 0
+}
+
+type BoardDefineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The possible boards attached to the port.
-	MatchingBoards []*BoardListItem `protobuf:"bytes,1,rep,name=matching_boards,json=matchingBoards,proto3" json:"matching_boards,omitempty"`
-	// The port details
-	Port *Port `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
-}
-
-func (x *DetectedPort) Reset() {
-	*x = DetectedPort{}
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The fully qualified board name of the board to clone (e.g.,
+	// `arduino:avr:nano:cpu=atmega168`). The configuration options set in the
+	// FQBN are fixed in the new board and removed from its menus.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// The ID of the new board, used in the FQBN.
+	BoardId string `protobuf:"bytes,3,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	// The name of the new board. If empty the name of the cloned board is used.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Properties to add or change in the definition of the new board (e.g.,
+	// `build.f_cpu`, `build.variant`).
+	Properties map[string]string `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If set to true the definition is validated but not written to the
+	// `boards.local.txt` file.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *BoardDefineRequest) Reset() {
+	*x = BoardDefineRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,13 +1111,13 @@ func (x *DetectedPort) Reset() {
 	}
 }
 
-func (x *DetectedPort) String() string {
+func (x *BoardDefineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetectedPort) ProtoMessage() {}
+func (*BoardDefineRequest) ProtoMessage() {}
 
-func (x *DetectedPort) ProtoReflect() protoreflect.Message {
+func (x *BoardDefineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1014,40 +1129,69 @@ func (x *DetectedPort) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetectedPort.ProtoReflect.Descriptor instead.
-func (*DetectedPort) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardDefineRequest.ProtoReflect.Descriptor instead.
+func (*BoardDefineRequest) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DetectedPort) GetMatchingBoards() []*BoardListItem {
+func (x *BoardDefineRequest) GetInstance() *Instance {
 	if x != nil {
-		return x.MatchingBoards
+		return x.Instance
 	}
 	return nil
 }
 
-func (x *DetectedPort) GetPort() *Port {
+func (x *BoardDefineRequest) GetFqbn() string {
 	if x != nil {
-		return x.Port
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *BoardDefineRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *BoardDefineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardDefineRequest) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
 	}
 	return nil
 }
 
-type BoardListAllRequest struct {
+func (x *BoardDefineRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BoardDefineResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Arduino Core Service instance from the `Init` response.
-	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	// The search query to filter the board list by.
-	SearchArgs []string `protobuf:"bytes,2,rep,name=search_args,json=searchArgs,proto3" json:"search_args,omitempty"`
-	// Set to true to get also the boards marked as "hidden" in the platform
-	IncludeHiddenBoards bool `protobuf:"varint,3,opt,name=include_hidden_boards,json=includeHiddenBoards,proto3" json:"include_hidden_boards,omitempty"`
+	// The fully qualified board name of the new board.
+	Fqbn string `protobuf:"bytes,1,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// The definition of the new board, as added to the `boards.local.txt` file.
+	Definition string `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+	// The path of the `boards.local.txt` file containing the definition. The
+	// instance must be initialized again to load the new board.
+	BoardsLocalTxtPath string `protobuf:"bytes,3,opt,name=boards_local_txt_path,json=boardsLocalTxtPath,proto3" json:"boards_local_txt_path,omitempty"`
 }
 
-func (x *BoardListAllRequest) Reset() {
-	*x = BoardListAllRequest{}
+func (x *BoardDefineResponse) Reset() {
+	*x = BoardDefineResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1055,13 +1199,13 @@ func (x *BoardListAllRequest) Reset() {
 	}
 }
 
-func (x *BoardListAllRequest) String() string {
+func (x *BoardDefineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardListAllRequest) ProtoMessage() {}
+func (*BoardDefineResponse) ProtoMessage() {}
 
-func (x *BoardListAllRequest) ProtoReflect() protoreflect.Message {
+func (x *BoardDefineResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,43 +1217,45 @@ func (x *BoardListAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardListAllRequest.ProtoReflect.Descriptor instead.
-func (*BoardListAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardDefineResponse.ProtoReflect.Descriptor instead.
+func (*BoardDefineResponse) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BoardListAllRequest) GetInstance() *Instance {
+func (x *BoardDefineResponse) GetFqbn() string {
 	if x != nil {
-		return x.Instance
+		return x.Fqbn
 	}
-	return nil
+	return ""
 }
 
-func (x *BoardListAllRequest) GetSearchArgs() []string {
+func (x *BoardDefineResponse) GetDefinition() string {
 	if x != nil {
-		return x.SearchArgs
+		return x.Definition
 	}
-	return nil
+	return ""
 }
 
-func (x *BoardListAllRequest) GetIncludeHiddenBoards() bool {
+func (x *BoardDefineResponse) GetBoardsLocalTxtPath() string {
 	if x != nil {
-		return x.IncludeHiddenBoards
+		return x.BoardsLocalTxtPath
 	}
-	return false
+	return ""
 }
 
-type BoardListAllResponse struct {
+type BoardSymbolsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of installed boards.
-	Boards []*BoardListItem `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The fully qualified board name of the board (e.g., `arduino:avr:uno`).
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
 }
 
-func (x *BoardListAllResponse) Reset() {
-	*x = BoardListAllResponse{}
+func (x *BoardSymbolsRequest) Reset() {
+	*x = BoardSymbolsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1117,13 +1263,13 @@ func (x *BoardListAllResponse) Reset() {
 	}
 }
 
-func (x *BoardListAllResponse) String() string {
+func (x *BoardSymbolsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardListAllResponse) ProtoMessage() {}
+func (*BoardSymbolsRequest) ProtoMessage() {}
 
-func (x *BoardListAllResponse) ProtoReflect() protoreflect.Message {
+func (x *BoardSymbolsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1135,29 +1281,43 @@ func (x *BoardListAllResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardListAllResponse.ProtoReflect.Descriptor instead.
-func (*BoardListAllResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*BoardSymbolsRequest) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *BoardListAllResponse) GetBoards() []*BoardListItem {
+func (x *BoardSymbolsRequest) GetInstance() *Instance {
 	if x != nil {
-		return x.Boards
+		return x.Instance
 	}
 	return nil
 }
 
-type BoardListWatchRequest struct {
+func (x *BoardSymbolsRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+type BoardSymbolsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Arduino Core Service instance from the `Init` response.
-	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The constants of the board. The ones defined by the build properties come
+	// first, followed by the ones of the variant and of the core headers. When a
+	// constant is defined more than once only the first definition is kept.
+	Symbols []*BoardSymbol `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// The folder of the core of the board.
+	CorePath string `protobuf:"bytes,2,opt,name=core_path,json=corePath,proto3" json:"core_path,omitempty"`
+	// The folder of the variant of the board, empty if the board has no
+	// variant.
+	VariantPath string `protobuf:"bytes,3,opt,name=variant_path,json=variantPath,proto3" json:"variant_path,omitempty"`
 }
 
-func (x *BoardListWatchRequest) Reset() {
-	*x = BoardListWatchRequest{}
+func (x *BoardSymbolsResponse) Reset() {
+	*x = BoardSymbolsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1165,13 +1325,13 @@ func (x *BoardListWatchRequest) Reset() {
 	}
 }
 
-func (x *BoardListWatchRequest) String() string {
+func (x *BoardSymbolsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardListWatchRequest) ProtoMessage() {}
+func (*BoardSymbolsResponse) ProtoMessage() {}
 
-func (x *BoardListWatchRequest) ProtoReflect() protoreflect.Message {
+func (x *BoardSymbolsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1183,33 +1343,56 @@ func (x *BoardListWatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardListWatchRequest.ProtoReflect.Descriptor instead.
-func (*BoardListWatchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*BoardSymbolsResponse) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *BoardListWatchRequest) GetInstance() *Instance {
+func (x *BoardSymbolsResponse) GetSymbols() []*BoardSymbol {
 	if x != nil {
-		return x.Instance
+		return x.Symbols
 	}
 	return nil
 }
 
-type BoardListWatchResponse struct {
+func (x *BoardSymbolsResponse) GetCorePath() string {
+	if x != nil {
+		return x.CorePath
+	}
+	return ""
+}
+
+func (x *BoardSymbolsResponse) GetVariantPath() string {
+	if x != nil {
+		return x.VariantPath
+	}
+	return ""
+}
+
+type BoardSymbol struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Event type as received from the serial discovery tool
-	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	// Information about the port
-	Port *DetectedPort `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
-	// Eventual errors when detecting connected boards
-	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-}
-
-func (x *BoardListWatchResponse) Reset() {
-	*x = BoardListWatchResponse{}
+	// The name of the constant.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The value of the constant as written in its definition, empty for the
+	// features.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The value of the constant with the references to other constants
+	// resolved (e.g., `14` for `A0` defined as `PIN_A0`).
+	ResolvedValue string `protobuf:"bytes,3,opt,name=resolved_value,json=resolvedValue,proto3" json:"resolved_value,omitempty"`
+	// The kind of the constant.
+	Kind BoardSymbolKind `protobuf:"varint,4,opt,name=kind,proto3,enum=cc.arduino.cli.commands.v1.BoardSymbolKind" json:"kind,omitempty"`
+	// The header defining the constant, empty for the constants defined by the
+	// build properties.
+	File string `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+	// The line of the definition in the header.
+	Line uint32 `protobuf:"varint,6,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *BoardSymbol) Reset() {
+	*x = BoardSymbol{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1217,13 +1400,13 @@ func (x *BoardListWatchResponse) Reset() {
 	}
 }
 
-func (x *BoardListWatchResponse) String() string {
+func (x *BoardSymbol) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardListWatchResponse) ProtoMessage() {}
+func (*BoardSymbol) ProtoMessage() {}
 
-func (x *BoardListWatchResponse) ProtoReflect() protoreflect.Message {
+func (x *BoardSymbol) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1235,49 +1418,69 @@ func (x *BoardListWatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardListWatchResponse.ProtoReflect.Descriptor instead.
-func (*BoardListWatchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardSymbol.ProtoReflect.Descriptor instead.
+func (*BoardSymbol) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *BoardListWatchResponse) GetEventType() string {
+func (x *BoardSymbol) GetName() string {
 	if x != nil {
-		return x.EventType
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BoardListWatchResponse) GetPort() *DetectedPort {
+func (x *BoardSymbol) GetValue() string {
 	if x != nil {
-		return x.Port
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-func (x *BoardListWatchResponse) GetError() string {
+func (x *BoardSymbol) GetResolvedValue() string {
 	if x != nil {
-		return x.Error
+		return x.ResolvedValue
 	}
 	return ""
 }
 
-type BoardListItem struct {
+func (x *BoardSymbol) GetKind() BoardSymbolKind {
+	if x != nil {
+		return x.Kind
+	}
+	return BoardSymbolKind_BOARD_SYMBOL_KIND_PIN
+}
+
+func (x *BoardSymbol) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *BoardSymbol) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+type BoardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name for use when identifying the board to a human.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The fully qualified board name. Used to identify the board to a machine.
-	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
-	// If the board is marked as "hidden" in the platform
-	IsHidden bool `protobuf:"varint,3,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
-	// Platform this board belongs to
-	Platform *Platform `protobuf:"bytes,6,opt,name=platform,proto3" json:"platform,omitempty"`
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Search for boards for the given time (in milliseconds)
+	Timeout int64 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// The fully qualified board name of the board you want information about
+	// (e.g., `arduino:avr:uno`).
+	Fqbn string `protobuf:"bytes,3,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
 }
 
-func (x *BoardListItem) Reset() {
-	*x = BoardListItem{}
+func (x *BoardListRequest) Reset() {
+	*x = BoardListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1285,13 +1488,13 @@ func (x *BoardListItem) Reset() {
 	}
 }
 
-func (x *BoardListItem) String() string {
+func (x *BoardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardListItem) ProtoMessage() {}
+func (*BoardListRequest) ProtoMessage() {}
 
-func (x *BoardListItem) ProtoReflect() protoreflect.Message {
+func (x *BoardListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1303,40 +1506,138 @@ func (x *BoardListItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardListItem.ProtoReflect.Descriptor instead.
-func (*BoardListItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoardListRequest.ProtoReflect.Descriptor instead.
+func (*BoardListRequest) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *BoardListItem) GetName() string {
+func (x *BoardListRequest) GetInstance() *Instance {
 	if x != nil {
-		return x.Name
+		return x.Instance
 	}
-	return ""
+	return nil
 }
 
-func (x *BoardListItem) GetFqbn() string {
+func (x *BoardListRequest) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *BoardListRequest) GetFqbn() string {
 	if x != nil {
 		return x.Fqbn
 	}
 	return ""
 }
 
-func (x *BoardListItem) GetIsHidden() bool {
+type BoardListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of ports and the boards detected on those ports.
+	Ports []*DetectedPort `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+}
+
+func (x *BoardListResponse) Reset() {
+	*x = BoardListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardListResponse) ProtoMessage() {}
+
+func (x *BoardListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardListResponse.ProtoReflect.Descriptor instead.
+func (*BoardListResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BoardListResponse) GetPorts() []*DetectedPort {
 	if x != nil {
-		return x.IsHidden
+		return x.Ports
 	}
-	return false
+	return nil
 }
 
-func (x *BoardListItem) GetPlatform() *Platform {
+type DetectedPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The possible boards attached to the port.
+	MatchingBoards []*BoardListItem `protobuf:"bytes,1,rep,name=matching_boards,json=matchingBoards,proto3" json:"matching_boards,omitempty"`
+	// The port details
+	Port *Port `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *DetectedPort) Reset() {
+	*x = DetectedPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectedPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedPort) ProtoMessage() {}
+
+func (x *DetectedPort) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedPort.ProtoReflect.Descriptor instead.
+func (*DetectedPort) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DetectedPort) GetMatchingBoards() []*BoardListItem {
 	if x != nil {
-		return x.Platform
+		return x.MatchingBoards
 	}
 	return nil
 }
 
-type BoardSearchRequest struct {
+func (x *DetectedPort) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+type BoardListAllRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1344,29 +1645,28 @@ type BoardSearchRequest struct {
 	// Arduino Core Service instance from the `Init` response.
 	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
 	// The search query to filter the board list by.
-	SearchArgs string `protobuf:"bytes,2,opt,name=search_args,json=searchArgs,proto3" json:"search_args,omitempty"`
-	// Set to true to get also the boards marked as "hidden" in installed
-	// platforms
+	SearchArgs []string `protobuf:"bytes,2,rep,name=search_args,json=searchArgs,proto3" json:"search_args,omitempty"`
+	// Set to true to get also the boards marked as "hidden" in the platform
 	IncludeHiddenBoards bool `protobuf:"varint,3,opt,name=include_hidden_boards,json=includeHiddenBoards,proto3" json:"include_hidden_boards,omitempty"`
 }
 
-func (x *BoardSearchRequest) Reset() {
-	*x = BoardSearchRequest{}
+func (x *BoardListAllRequest) Reset() {
+	*x = BoardListAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[18]
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BoardSearchRequest) String() string {
+func (x *BoardListAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardSearchRequest) ProtoMessage() {}
+func (*BoardListAllRequest) ProtoMessage() {}
 
-func (x *BoardSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[18]
+func (x *BoardListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,58 +1677,1256 @@ func (x *BoardSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardSearchRequest.ProtoReflect.Descriptor instead.
-func (*BoardSearchRequest) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BoardListAllRequest.ProtoReflect.Descriptor instead.
+func (*BoardListAllRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *BoardSearchRequest) GetInstance() *Instance {
+func (x *BoardListAllRequest) GetInstance() *Instance {
 	if x != nil {
 		return x.Instance
 	}
 	return nil
 }
 
-func (x *BoardSearchRequest) GetSearchArgs() string {
+func (x *BoardListAllRequest) GetSearchArgs() []string {
 	if x != nil {
 		return x.SearchArgs
 	}
-	return ""
+	return nil
 }
 
-func (x *BoardSearchRequest) GetIncludeHiddenBoards() bool {
+func (x *BoardListAllRequest) GetIncludeHiddenBoards() bool {
 	if x != nil {
 		return x.IncludeHiddenBoards
 	}
 	return false
 }
 
-type BoardSearchResponse struct {
+type BoardListAllResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of installed and installable boards.
+	// List of installed boards.
 	Boards []*BoardListItem `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
 }
 
-func (x *BoardSearchResponse) Reset() {
-	*x = BoardSearchResponse{}
+func (x *BoardListAllResponse) Reset() {
+	*x = BoardListAllResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[19]
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BoardSearchResponse) String() string {
+func (x *BoardListAllResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardSearchResponse) ProtoMessage() {}
+func (*BoardListAllResponse) ProtoMessage() {}
+
+func (x *BoardListAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardListAllResponse.ProtoReflect.Descriptor instead.
+func (*BoardListAllResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BoardListAllResponse) GetBoards() []*BoardListItem {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+type BoardListWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+}
+
+func (x *BoardListWatchRequest) Reset() {
+	*x = BoardListWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardListWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardListWatchRequest) ProtoMessage() {}
+
+func (x *BoardListWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardListWatchRequest.ProtoReflect.Descriptor instead.
+func (*BoardListWatchRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BoardListWatchRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+type BoardListWatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Event type as received from the serial discovery tool
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Information about the port
+	Port *DetectedPort `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Eventual errors when detecting connected boards
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BoardListWatchResponse) Reset() {
+	*x = BoardListWatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardListWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardListWatchResponse) ProtoMessage() {}
+
+func (x *BoardListWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardListWatchResponse.ProtoReflect.Descriptor instead.
+func (*BoardListWatchResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BoardListWatchResponse) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *BoardListWatchResponse) GetPort() *DetectedPort {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *BoardListWatchResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BoardListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name for use when identifying the board to a human.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The fully qualified board name. Used to identify the board to a machine.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// If the board is marked as "hidden" in the platform
+	IsHidden bool `protobuf:"varint,3,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	// Platform this board belongs to
+	Platform *Platform `protobuf:"bytes,6,opt,name=platform,proto3" json:"platform,omitempty"`
+	// Set if the platform of the board is not installed, with the information
+	// needed to install it
+	MissingPlatform *BoardMissingPlatform `protobuf:"bytes,7,opt,name=missing_platform,json=missingPlatform,proto3" json:"missing_platform,omitempty"`
+	// The microcontroller of the board, if known.
+	Mcu string `protobuf:"bytes,8,opt,name=mcu,proto3" json:"mcu,omitempty"`
+	// The connectivity capabilities of the board (e.g., `wifi`, `ble`), as
+	// declared in the package index.
+	Connectivity []string `protobuf:"bytes,9,rep,name=connectivity,proto3" json:"connectivity,omitempty"`
+}
+
+func (x *BoardListItem) Reset() {
+	*x = BoardListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardListItem) ProtoMessage() {}
+
+func (x *BoardListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardListItem.ProtoReflect.Descriptor instead.
+func (*BoardListItem) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BoardListItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardListItem) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *BoardListItem) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *BoardListItem) GetPlatform() *Platform {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+func (x *BoardListItem) GetMissingPlatform() *BoardMissingPlatform {
+	if x != nil {
+		return x.MissingPlatform
+	}
+	return nil
+}
+
+func (x *BoardListItem) GetMcu() string {
+	if x != nil {
+		return x.Mcu
+	}
+	return ""
+}
+
+func (x *BoardListItem) GetConnectivity() []string {
+	if x != nil {
+		return x.Connectivity
+	}
+	return nil
+}
+
+type BoardMissingPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the platform (e.g. `arduino:samd`)
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// URL of the package index providing the platform, empty if it's the
+	// default package index or if it's unknown
+	IndexUrl string `protobuf:"bytes,2,opt,name=index_url,json=indexUrl,proto3" json:"index_url,omitempty"`
+	// True if the platform is available in the package indexes already loaded,
+	// otherwise the package index at `index_url` must be added before installing
+	// the platform
+	Indexed bool `protobuf:"varint,3,opt,name=indexed,proto3" json:"indexed,omitempty"`
+}
+
+func (x *BoardMissingPlatform) Reset() {
+	*x = BoardMissingPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardMissingPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardMissingPlatform) ProtoMessage() {}
+
+func (x *BoardMissingPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardMissingPlatform.ProtoReflect.Descriptor instead.
+func (*BoardMissingPlatform) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BoardMissingPlatform) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardMissingPlatform) GetIndexUrl() string {
+	if x != nil {
+		return x.IndexUrl
+	}
+	return ""
+}
+
+func (x *BoardMissingPlatform) GetIndexed() bool {
+	if x != nil {
+		return x.Indexed
+	}
+	return false
+}
+
+type BoardSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The search query to filter the board list by.
+	SearchArgs string `protobuf:"bytes,2,opt,name=search_args,json=searchArgs,proto3" json:"search_args,omitempty"`
+	// Set to true to get also the boards marked as "hidden" in installed
+	// platforms
+	IncludeHiddenBoards bool `protobuf:"varint,3,opt,name=include_hidden_boards,json=includeHiddenBoards,proto3" json:"include_hidden_boards,omitempty"`
+	// If set, only the boards having all the given connectivity capabilities
+	// (e.g., `wifi`, `ble`) are returned.
+	Connectivity []string `protobuf:"bytes,4,rep,name=connectivity,proto3" json:"connectivity,omitempty"`
+	// If set, only the boards with a microcontroller matching the given family
+	// (e.g., `esp32`, `samd21`) are returned.
+	Mcu string `protobuf:"bytes,5,opt,name=mcu,proto3" json:"mcu,omitempty"`
+	// Set to true to get only the boards of the installed platforms.
+	InstalledOnly bool `protobuf:"varint,6,opt,name=installed_only,json=installedOnly,proto3" json:"installed_only,omitempty"`
+	// Set to true to get only the boards of the platforms that are not
+	// installed.
+	NotInstalledOnly bool `protobuf:"varint,7,opt,name=not_installed_only,json=notInstalledOnly,proto3" json:"not_installed_only,omitempty"`
+}
+
+func (x *BoardSearchRequest) Reset() {
+	*x = BoardSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardSearchRequest) ProtoMessage() {}
+
+func (x *BoardSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardSearchRequest.ProtoReflect.Descriptor instead.
+func (*BoardSearchRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BoardSearchRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *BoardSearchRequest) GetSearchArgs() string {
+	if x != nil {
+		return x.SearchArgs
+	}
+	return ""
+}
+
+func (x *BoardSearchRequest) GetIncludeHiddenBoards() bool {
+	if x != nil {
+		return x.IncludeHiddenBoards
+	}
+	return false
+}
+
+func (x *BoardSearchRequest) GetConnectivity() []string {
+	if x != nil {
+		return x.Connectivity
+	}
+	return nil
+}
+
+func (x *BoardSearchRequest) GetMcu() string {
+	if x != nil {
+		return x.Mcu
+	}
+	return ""
+}
+
+func (x *BoardSearchRequest) GetInstalledOnly() bool {
+	if x != nil {
+		return x.InstalledOnly
+	}
+	return false
+}
+
+func (x *BoardSearchRequest) GetNotInstalledOnly() bool {
+	if x != nil {
+		return x.NotInstalledOnly
+	}
+	return false
+}
+
+type BoardSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of installed and installable boards.
+	Boards []*BoardListItem `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
+}
+
+func (x *BoardSearchResponse) Reset() {
+	*x = BoardSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardSearchResponse) ProtoMessage() {}
+
+func (x *BoardSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardSearchResponse.ProtoReflect.Descriptor instead.
+func (*BoardSearchResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BoardSearchResponse) GetBoards() []*BoardListItem {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+type BoardEEPROMReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully qualified board name of the target board (e.g., `arduino:avr:uno`).
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// The port of the board.
+	Port *Port `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Turns on verbose mode.
+	Verbose bool `protobuf:"varint,4,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (x *BoardEEPROMReadRequest) Reset() {
+	*x = BoardEEPROMReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardEEPROMReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardEEPROMReadRequest) ProtoMessage() {}
+
+func (x *BoardEEPROMReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardEEPROMReadRequest.ProtoReflect.Descriptor instead.
+func (*BoardEEPROMReadRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BoardEEPROMReadRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *BoardEEPROMReadRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *BoardEEPROMReadRequest) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *BoardEEPROMReadRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+type BoardEEPROMReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*BoardEEPROMReadResponse_OutStream
+	//	*BoardEEPROMReadResponse_ErrStream
+	//	*BoardEEPROMReadResponse_Result
+	Message isBoardEEPROMReadResponse_Message `protobuf_oneof:"message"`
+}
+
+func (x *BoardEEPROMReadResponse) Reset() {
+	*x = BoardEEPROMReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardEEPROMReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardEEPROMReadResponse) ProtoMessage() {}
+
+func (x *BoardEEPROMReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardEEPROMReadResponse.ProtoReflect.Descriptor instead.
+func (*BoardEEPROMReadResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *BoardEEPROMReadResponse) GetMessage() isBoardEEPROMReadResponse_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *BoardEEPROMReadResponse) GetOutStream() []byte {
+	if x, ok := x.GetMessage().(*BoardEEPROMReadResponse_OutStream); ok {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *BoardEEPROMReadResponse) GetErrStream() []byte {
+	if x, ok := x.GetMessage().(*BoardEEPROMReadResponse_ErrStream); ok {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *BoardEEPROMReadResponse) GetResult() *BoardEEPROMReadResult {
+	if x, ok := x.GetMessage().(*BoardEEPROMReadResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isBoardEEPROMReadResponse_Message interface {
+	isBoardEEPROMReadResponse_Message()
+}
+
+type BoardEEPROMReadResponse_OutStream struct {
+	// The output of the tool reading the EEPROM.
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3,oneof"`
+}
+
+type BoardEEPROMReadResponse_ErrStream struct {
+	// The error output of the tool reading the EEPROM.
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3,oneof"`
+}
+
+type BoardEEPROMReadResponse_Result struct {
+	// The content of the EEPROM.
+	Result *BoardEEPROMReadResult `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+func (*BoardEEPROMReadResponse_OutStream) isBoardEEPROMReadResponse_Message() {}
+
+func (*BoardEEPROMReadResponse_ErrStream) isBoardEEPROMReadResponse_Message() {}
+
+func (*BoardEEPROMReadResponse_Result) isBoardEEPROMReadResponse_Message() {}
+
+type BoardEEPROMReadResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The content of the EEPROM.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BoardEEPROMReadResult) Reset() {
+	*x = BoardEEPROMReadResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardEEPROMReadResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardEEPROMReadResult) ProtoMessage() {}
+
+func (x *BoardEEPROMReadResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardEEPROMReadResult.ProtoReflect.Descriptor instead.
+func (*BoardEEPROMReadResult) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BoardEEPROMReadResult) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BoardEEPROMWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully qualified board name of the target board (e.g., `arduino:avr:uno`).
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// The port of the board.
+	Port *Port `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The data to write in the EEPROM, starting from its first byte.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// Turns on verbose mode.
+	Verbose bool `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (x *BoardEEPROMWriteRequest) Reset() {
+	*x = BoardEEPROMWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardEEPROMWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardEEPROMWriteRequest) ProtoMessage() {}
+
+func (x *BoardEEPROMWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardEEPROMWriteRequest.ProtoReflect.Descriptor instead.
+func (*BoardEEPROMWriteRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BoardEEPROMWriteRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *BoardEEPROMWriteRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *BoardEEPROMWriteRequest) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *BoardEEPROMWriteRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BoardEEPROMWriteRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+type BoardEEPROMWriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*BoardEEPROMWriteResponse_OutStream
+	//	*BoardEEPROMWriteResponse_ErrStream
+	//	*BoardEEPROMWriteResponse_Result
+	Message isBoardEEPROMWriteResponse_Message `protobuf_oneof:"message"`
+}
+
+func (x *BoardEEPROMWriteResponse) Reset() {
+	*x = BoardEEPROMWriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardEEPROMWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardEEPROMWriteResponse) ProtoMessage() {}
+
+func (x *BoardEEPROMWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardEEPROMWriteResponse.ProtoReflect.Descriptor instead.
+func (*BoardEEPROMWriteResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{32}
+}
+
+func (m *BoardEEPROMWriteResponse) GetMessage() isBoardEEPROMWriteResponse_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *BoardEEPROMWriteResponse) GetOutStream() []byte {
+	if x, ok := x.GetMessage().(*BoardEEPROMWriteResponse_OutStream); ok {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *BoardEEPROMWriteResponse) GetErrStream() []byte {
+	if x, ok := x.GetMessage().(*BoardEEPROMWriteResponse_ErrStream); ok {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *BoardEEPROMWriteResponse) GetResult() *BoardEEPROMWriteResult {
+	if x, ok := x.GetMessage().(*BoardEEPROMWriteResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isBoardEEPROMWriteResponse_Message interface {
+	isBoardEEPROMWriteResponse_Message()
+}
+
+type BoardEEPROMWriteResponse_OutStream struct {
+	// The output of the tool writing the EEPROM.
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3,oneof"`
+}
+
+type BoardEEPROMWriteResponse_ErrStream struct {
+	// The error output of the tool writing the EEPROM.
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3,oneof"`
+}
+
+type BoardEEPROMWriteResponse_Result struct {
+	// The result of the write.
+	Result *BoardEEPROMWriteResult `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+func (*BoardEEPROMWriteResponse_OutStream) isBoardEEPROMWriteResponse_Message() {}
+
+func (*BoardEEPROMWriteResponse_ErrStream) isBoardEEPROMWriteResponse_Message() {}
+
+func (*BoardEEPROMWriteResponse_Result) isBoardEEPROMWriteResponse_Message() {}
+
+type BoardEEPROMWriteResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BoardEEPROMWriteResult) Reset() {
+	*x = BoardEEPROMWriteResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardEEPROMWriteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardEEPROMWriteResult) ProtoMessage() {}
+
+func (x *BoardEEPROMWriteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardEEPROMWriteResult.ProtoReflect.Descriptor instead.
+func (*BoardEEPROMWriteResult) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{33}
+}
+
+type BoardNVSReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully qualified board name of the target board (e.g., `esp32:esp32:esp32`).
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// The port of the board.
+	Port *Port `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Path of a sketch whose selected partition table is used to locate the NVS
+	// partition. If empty, or if the sketch has no partition table selected,
+	// the partition table of the board is used.
+	SketchPath string `protobuf:"bytes,4,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	// Name of the NVS partition, `nvs` if empty.
+	Partition string `protobuf:"bytes,5,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Content of the NVS partition already read from the board. If set the
+	// board is not accessed and this content is parsed instead.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// Turns on verbose mode.
+	Verbose bool `protobuf:"varint,7,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (x *BoardNVSReadRequest) Reset() {
+	*x = BoardNVSReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardNVSReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardNVSReadRequest) ProtoMessage() {}
+
+func (x *BoardNVSReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardNVSReadRequest.ProtoReflect.Descriptor instead.
+func (*BoardNVSReadRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BoardNVSReadRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *BoardNVSReadRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *BoardNVSReadRequest) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *BoardNVSReadRequest) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *BoardNVSReadRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *BoardNVSReadRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BoardNVSReadRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+type BoardNVSReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*BoardNVSReadResponse_OutStream
+	//	*BoardNVSReadResponse_ErrStream
+	//	*BoardNVSReadResponse_Result
+	Message isBoardNVSReadResponse_Message `protobuf_oneof:"message"`
+}
+
+func (x *BoardNVSReadResponse) Reset() {
+	*x = BoardNVSReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardNVSReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardNVSReadResponse) ProtoMessage() {}
+
+func (x *BoardNVSReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardNVSReadResponse.ProtoReflect.Descriptor instead.
+func (*BoardNVSReadResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{35}
+}
+
+func (m *BoardNVSReadResponse) GetMessage() isBoardNVSReadResponse_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *BoardNVSReadResponse) GetOutStream() []byte {
+	if x, ok := x.GetMessage().(*BoardNVSReadResponse_OutStream); ok {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *BoardNVSReadResponse) GetErrStream() []byte {
+	if x, ok := x.GetMessage().(*BoardNVSReadResponse_ErrStream); ok {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *BoardNVSReadResponse) GetResult() *BoardNVSReadResult {
+	if x, ok := x.GetMessage().(*BoardNVSReadResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isBoardNVSReadResponse_Message interface {
+	isBoardNVSReadResponse_Message()
+}
+
+type BoardNVSReadResponse_OutStream struct {
+	// The output of the tool reading the flash.
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3,oneof"`
+}
+
+type BoardNVSReadResponse_ErrStream struct {
+	// The error output of the tool reading the flash.
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3,oneof"`
+}
+
+type BoardNVSReadResponse_Result struct {
+	// The content of the NVS partition.
+	Result *BoardNVSReadResult `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+func (*BoardNVSReadResponse_OutStream) isBoardNVSReadResponse_Message() {}
+
+func (*BoardNVSReadResponse_ErrStream) isBoardNVSReadResponse_Message() {}
+
+func (*BoardNVSReadResponse_Result) isBoardNVSReadResponse_Message() {}
+
+type BoardNVSReadResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entries stored in the NVS partition.
+	Entries []*NVSEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// The raw content of the NVS partition.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BoardNVSReadResult) Reset() {
+	*x = BoardNVSReadResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardNVSReadResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardNVSReadResult) ProtoMessage() {}
+
+func (x *BoardNVSReadResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardNVSReadResult.ProtoReflect.Descriptor instead.
+func (*BoardNVSReadResult) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BoardNVSReadResult) GetEntries() []*NVSEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *BoardNVSReadResult) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type NVSEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The namespace of the entry.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The key of the entry.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The type of the entry: `u8`, `i8`, `u16`, `i16`, `u32`, `i32`, `u64`,
+	// `i64`, `string` or `blob`.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// The value of the entry. Integers are in decimal notation and blobs in
+	// hexadecimal notation.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *NVSEntry) Reset() {
+	*x = NVSEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NVSEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NVSEntry) ProtoMessage() {}
 
-func (x *BoardSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[19]
+func (x *NVSEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_board_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,16 +2937,37 @@ func (x *BoardSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardSearchResponse.ProtoReflect.Descriptor instead.
-func (*BoardSearchResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use NVSEntry.ProtoReflect.Descriptor instead.
+func (*NVSEntry) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BoardSearchResponse) GetBoards() []*BoardListItem {
+func (x *NVSEntry) GetNamespace() string {
 	if x != nil {
-		return x.Boards
+		return x.Namespace
 	}
-	return nil
+	return ""
+}
+
+func (x *NVSEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NVSEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NVSEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
 var File_cc_arduino_cli_commands_v1_board_proto protoreflect.FileDescriptor
@@ -1591,99 +3110,306 @@ var file_cc_arduino_cli_commands_v1_board_proto_rawDesc = []byte{
 	0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61,
 	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
 	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x0b, 0x43, 0x6f,
+	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x0b, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
 	0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
 	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a,
-	0x10, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x28, 0x08, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x6b, 0x0a, 0x13, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x22, 0xfb, 0x01, 0x0a, 0x14, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x71, 0x62, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x71,
+	0x62, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x12, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x71, 0x62, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x1a, 0x3d, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c, 0x0a, 0x13, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x54, 0x78, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x6b, 0x0a, 0x13, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x71, 0x62, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x14, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x50, 0x61, 0x74,
+	0x68, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x10,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x71, 0x62, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e,
+	0x22, 0x53, 0x0a, 0x11, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x52, 0x0a, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0xac, 0x01, 0x0a, 0x13, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22,
+	0x59, 0x0a, 0x14, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x15, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x16, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x3c, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xa9, 0x02, 0x0a, 0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x5b, 0x0a, 0x10,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x63, 0x75,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x63, 0x75, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22,
+	0x5d, 0x0a, 0x14, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x22, 0xb6,
+	0x02, 0x0a, 0x12, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x63, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x63, 0x75, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x58, 0x0a, 0x13, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x73, 0x22, 0xbe, 0x01, 0x0a, 0x16, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x45, 0x50, 0x52, 0x4f,
+	0x4d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71,
+	0x62, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62,
+	0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x17, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x45, 0x50, 0x52,
+	0x4f, 0x4d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x1f, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x4b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x45, 0x45, 0x50, 0x52, 0x4f, 0x4d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x45, 0x50, 0x52, 0x4f, 0x4d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd3, 0x01, 0x0a, 0x17, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45,
+	0x45, 0x50, 0x52, 0x4f, 0x4d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
 	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
 	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62,
-	0x6e, 0x22, 0x53, 0x0a, 0x11, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
 	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52,
-	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x52, 0x0a, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
-	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0e, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x18,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x45, 0x50, 0x52, 0x4f, 0x4d, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09,
+	0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x45, 0x50,
+	0x52, 0x4f, 0x4d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x45, 0x50, 0x52,
+	0x4f, 0x4d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8e, 0x02,
+	0x0a, 0x13, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x56, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61,
 	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
 	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x22, 0xac, 0x01, 0x0a, 0x13, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63,
-	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x15,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73,
-	0x22, 0x59, 0x0a, 0x14, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
-	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x15, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
-	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x16, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x3c, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71,
-	0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x40, 0x0a, 0x08, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0xab, 0x01,
-	0x0a, 0x12, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
-	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x13, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
-	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x73, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75,
-	0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61,
-	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0xad,
+	0x01, 0x0a, 0x14, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x56, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x6f,
+	0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x48, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x56, 0x53, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x68,
+	0x0a, 0x12, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x56, 0x53, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x56, 0x53, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x64, 0x0a, 0x08, 0x4e, 0x56, 0x53, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x68,
+	0x0a, 0x0f, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f,
+	0x4c, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17,
+	0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x4d, 0x41, 0x43, 0x52, 0x4f, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x4f, 0x41,
+	0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46,
+	0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x02, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63,
+	0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1698,62 +3424,101 @@ func file_cc_arduino_cli_commands_v1_board_proto_rawDescGZIP() []byte {
 	return file_cc_arduino_cli_commands_v1_board_proto_rawDescData
 }
 
-var file_cc_arduino_cli_commands_v1_board_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_cc_arduino_cli_commands_v1_board_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cc_arduino_cli_commands_v1_board_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_cc_arduino_cli_commands_v1_board_proto_goTypes = []interface{}{
-	(*BoardDetailsRequest)(nil),           // 0: cc.arduino.cli.commands.v1.BoardDetailsRequest
-	(*BoardDetailsResponse)(nil),          // 1: cc.arduino.cli.commands.v1.BoardDetailsResponse
-	(*BoardIdentificationProperties)(nil), // 2: cc.arduino.cli.commands.v1.BoardIdentificationProperties
-	(*Package)(nil),                       // 3: cc.arduino.cli.commands.v1.Package
-	(*Help)(nil),                          // 4: cc.arduino.cli.commands.v1.Help
-	(*BoardPlatform)(nil),                 // 5: cc.arduino.cli.commands.v1.BoardPlatform
-	(*ToolsDependencies)(nil),             // 6: cc.arduino.cli.commands.v1.ToolsDependencies
-	(*Systems)(nil),                       // 7: cc.arduino.cli.commands.v1.Systems
-	(*ConfigOption)(nil),                  // 8: cc.arduino.cli.commands.v1.ConfigOption
-	(*ConfigValue)(nil),                   // 9: cc.arduino.cli.commands.v1.ConfigValue
-	(*BoardListRequest)(nil),              // 10: cc.arduino.cli.commands.v1.BoardListRequest
-	(*BoardListResponse)(nil),             // 11: cc.arduino.cli.commands.v1.BoardListResponse
-	(*DetectedPort)(nil),                  // 12: cc.arduino.cli.commands.v1.DetectedPort
-	(*BoardListAllRequest)(nil),           // 13: cc.arduino.cli.commands.v1.BoardListAllRequest
-	(*BoardListAllResponse)(nil),          // 14: cc.arduino.cli.commands.v1.BoardListAllResponse
-	(*BoardListWatchRequest)(nil),         // 15: cc.arduino.cli.commands.v1.BoardListWatchRequest
-	(*BoardListWatchResponse)(nil),        // 16: cc.arduino.cli.commands.v1.BoardListWatchResponse
-	(*BoardListItem)(nil),                 // 17: cc.arduino.cli.commands.v1.BoardListItem
-	(*BoardSearchRequest)(nil),            // 18: cc.arduino.cli.commands.v1.BoardSearchRequest
-	(*BoardSearchResponse)(nil),           // 19: cc.arduino.cli.commands.v1.BoardSearchResponse
-	nil,                                   // 20: cc.arduino.cli.commands.v1.BoardIdentificationProperties.PropertiesEntry
-	(*Instance)(nil),                      // 21: cc.arduino.cli.commands.v1.Instance
-	(*Programmer)(nil),                    // 22: cc.arduino.cli.commands.v1.Programmer
-	(*Port)(nil),                          // 23: cc.arduino.cli.commands.v1.Port
-	(*Platform)(nil),                      // 24: cc.arduino.cli.commands.v1.Platform
+	(BoardSymbolKind)(0),                  // 0: cc.arduino.cli.commands.v1.BoardSymbolKind
+	(*BoardDetailsRequest)(nil),           // 1: cc.arduino.cli.commands.v1.BoardDetailsRequest
+	(*BoardDetailsResponse)(nil),          // 2: cc.arduino.cli.commands.v1.BoardDetailsResponse
+	(*BoardIdentificationProperties)(nil), // 3: cc.arduino.cli.commands.v1.BoardIdentificationProperties
+	(*Package)(nil),                       // 4: cc.arduino.cli.commands.v1.Package
+	(*Help)(nil),                          // 5: cc.arduino.cli.commands.v1.Help
+	(*BoardPlatform)(nil),                 // 6: cc.arduino.cli.commands.v1.BoardPlatform
+	(*ToolsDependencies)(nil),             // 7: cc.arduino.cli.commands.v1.ToolsDependencies
+	(*Systems)(nil),                       // 8: cc.arduino.cli.commands.v1.Systems
+	(*ConfigOption)(nil),                  // 9: cc.arduino.cli.commands.v1.ConfigOption
+	(*ConfigValue)(nil),                   // 10: cc.arduino.cli.commands.v1.ConfigValue
+	(*BoardOptionsRequest)(nil),           // 11: cc.arduino.cli.commands.v1.BoardOptionsRequest
+	(*BoardOptionsResponse)(nil),          // 12: cc.arduino.cli.commands.v1.BoardOptionsResponse
+	(*BoardDefineRequest)(nil),            // 13: cc.arduino.cli.commands.v1.BoardDefineRequest
+	(*BoardDefineResponse)(nil),           // 14: cc.arduino.cli.commands.v1.BoardDefineResponse
+	(*BoardSymbolsRequest)(nil),           // 15: cc.arduino.cli.commands.v1.BoardSymbolsRequest
+	(*BoardSymbolsResponse)(nil),          // 16: cc.arduino.cli.commands.v1.BoardSymbolsResponse
+	(*BoardSymbol)(nil),                   // 17: cc.arduino.cli.commands.v1.BoardSymbol
+	(*BoardListRequest)(nil),              // 18: cc.arduino.cli.commands.v1.BoardListRequest
+	(*BoardListResponse)(nil),             // 19: cc.arduino.cli.commands.v1.BoardListResponse
+	(*DetectedPort)(nil),                  // 20: cc.arduino.cli.commands.v1.DetectedPort
+	(*BoardListAllRequest)(nil),           // 21: cc.arduino.cli.commands.v1.BoardListAllRequest
+	(*BoardListAllResponse)(nil),          // 22: cc.arduino.cli.commands.v1.BoardListAllResponse
+	(*BoardListWatchRequest)(nil),         // 23: cc.arduino.cli.commands.v1.BoardListWatchRequest
+	(*BoardListWatchResponse)(nil),        // 24: cc.arduino.cli.commands.v1.BoardListWatchResponse
+	(*BoardListItem)(nil),                 // 25: cc.arduino.cli.commands.v1.BoardListItem
+	(*BoardMissingPlatform)(nil),          // 26: cc.arduino.cli.commands.v1.BoardMissingPlatform
+	(*BoardSearchRequest)(nil),            // 27: cc.arduino.cli.commands.v1.BoardSearchRequest
+	(*BoardSearchResponse)(nil),           // 28: cc.arduino.cli.commands.v1.BoardSearchResponse
+	(*BoardEEPROMReadRequest)(nil),        // 29: cc.arduino.cli.commands.v1.BoardEEPROMReadRequest
+	(*BoardEEPROMReadResponse)(nil),       // 30: cc.arduino.cli.commands.v1.BoardEEPROMReadResponse
+	(*BoardEEPROMReadResult)(nil),         // 31: cc.arduino.cli.commands.v1.BoardEEPROMReadResult
+	(*BoardEEPROMWriteRequest)(nil),       // 32: cc.arduino.cli.commands.v1.BoardEEPROMWriteRequest
+	(*BoardEEPROMWriteResponse)(nil),      // 33: cc.arduino.cli.commands.v1.BoardEEPROMWriteResponse
+	(*BoardEEPROMWriteResult)(nil),        // 34: cc.arduino.cli.commands.v1.BoardEEPROMWriteResult
+	(*BoardNVSReadRequest)(nil),           // 35: cc.arduino.cli.commands.v1.BoardNVSReadRequest
+	(*BoardNVSReadResponse)(nil),          // 36: cc.arduino.cli.commands.v1.BoardNVSReadResponse
+	(*BoardNVSReadResult)(nil),            // 37: cc.arduino.cli.commands.v1.BoardNVSReadResult
+	(*NVSEntry)(nil),                      // 38: cc.arduino.cli.commands.v1.NVSEntry
+	nil,                                   // 39: cc.arduino.cli.commands.v1.BoardIdentificationProperties.PropertiesEntry
+	nil,                                   // 40: cc.arduino.cli.commands.v1.BoardDefineRequest.PropertiesEntry
+	(*Instance)(nil),                      // 41: cc.arduino.cli.commands.v1.Instance
+	(*Programmer)(nil),                    // 42: cc.arduino.cli.commands.v1.Programmer
+	(*Port)(nil),                          // 43: cc.arduino.cli.commands.v1.Port
+	(*Platform)(nil),                      // 44: cc.arduino.cli.commands.v1.Platform
 }
 var file_cc_arduino_cli_commands_v1_board_proto_depIdxs = []int32{
-	21, // 0: cc.arduino.cli.commands.v1.BoardDetailsRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	3,  // 1: cc.arduino.cli.commands.v1.BoardDetailsResponse.package:type_name -> cc.arduino.cli.commands.v1.Package
-	5,  // 2: cc.arduino.cli.commands.v1.BoardDetailsResponse.platform:type_name -> cc.arduino.cli.commands.v1.BoardPlatform
-	6,  // 3: cc.arduino.cli.commands.v1.BoardDetailsResponse.tools_dependencies:type_name -> cc.arduino.cli.commands.v1.ToolsDependencies
-	8,  // 4: cc.arduino.cli.commands.v1.BoardDetailsResponse.config_options:type_name -> cc.arduino.cli.commands.v1.ConfigOption
-	22, // 5: cc.arduino.cli.commands.v1.BoardDetailsResponse.programmers:type_name -> cc.arduino.cli.commands.v1.Programmer
-	2,  // 6: cc.arduino.cli.commands.v1.BoardDetailsResponse.identification_properties:type_name -> cc.arduino.cli.commands.v1.BoardIdentificationProperties
-	20, // 7: cc.arduino.cli.commands.v1.BoardIdentificationProperties.properties:type_name -> cc.arduino.cli.commands.v1.BoardIdentificationProperties.PropertiesEntry
-	4,  // 8: cc.arduino.cli.commands.v1.Package.help:type_name -> cc.arduino.cli.commands.v1.Help
-	7,  // 9: cc.arduino.cli.commands.v1.ToolsDependencies.systems:type_name -> cc.arduino.cli.commands.v1.Systems
-	9,  // 10: cc.arduino.cli.commands.v1.ConfigOption.values:type_name -> cc.arduino.cli.commands.v1.ConfigValue
-	21, // 11: cc.arduino.cli.commands.v1.BoardListRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	12, // 12: cc.arduino.cli.commands.v1.BoardListResponse.ports:type_name -> cc.arduino.cli.commands.v1.DetectedPort
-	17, // 13: cc.arduino.cli.commands.v1.DetectedPort.matching_boards:type_name -> cc.arduino.cli.commands.v1.BoardListItem
-	23, // 14: cc.arduino.cli.commands.v1.DetectedPort.port:type_name -> cc.arduino.cli.commands.v1.Port
-	21, // 15: cc.arduino.cli.commands.v1.BoardListAllRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	17, // 16: cc.arduino.cli.commands.v1.BoardListAllResponse.boards:type_name -> cc.arduino.cli.commands.v1.BoardListItem
-	21, // 17: cc.arduino.cli.commands.v1.BoardListWatchRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	12, // 18: cc.arduino.cli.commands.v1.BoardListWatchResponse.port:type_name -> cc.arduino.cli.commands.v1.DetectedPort
-	24, // 19: cc.arduino.cli.commands.v1.BoardListItem.platform:type_name -> cc.arduino.cli.commands.v1.Platform
-	21, // 20: cc.arduino.cli.commands.v1.BoardSearchRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	17, // 21: cc.arduino.cli.commands.v1.BoardSearchResponse.boards:type_name -> cc.arduino.cli.commands.v1.BoardListItem
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	41, // 0: cc.arduino.cli.commands.v1.BoardDetailsRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	4,  // 1: cc.arduino.cli.commands.v1.BoardDetailsResponse.package:type_name -> cc.arduino.cli.commands.v1.Package
+	6,  // 2: cc.arduino.cli.commands.v1.BoardDetailsResponse.platform:type_name -> cc.arduino.cli.commands.v1.BoardPlatform
+	7,  // 3: cc.arduino.cli.commands.v1.BoardDetailsResponse.tools_dependencies:type_name -> cc.arduino.cli.commands.v1.ToolsDependencies
+	9,  // 4: cc.arduino.cli.commands.v1.BoardDetailsResponse.config_options:type_name -> cc.arduino.cli.commands.v1.ConfigOption
+	42, // 5: cc.arduino.cli.commands.v1.BoardDetailsResponse.programmers:type_name -> cc.arduino.cli.commands.v1.Programmer
+	3,  // 6: cc.arduino.cli.commands.v1.BoardDetailsResponse.identification_properties:type_name -> cc.arduino.cli.commands.v1.BoardIdentificationProperties
+	39, // 7: cc.arduino.cli.commands.v1.BoardIdentificationProperties.properties:type_name -> cc.arduino.cli.commands.v1.BoardIdentificationProperties.PropertiesEntry
+	5,  // 8: cc.arduino.cli.commands.v1.Package.help:type_name -> cc.arduino.cli.commands.v1.Help
+	8,  // 9: cc.arduino.cli.commands.v1.ToolsDependencies.systems:type_name -> cc.arduino.cli.commands.v1.Systems
+	10, // 10: cc.arduino.cli.commands.v1.ConfigOption.values:type_name -> cc.arduino.cli.commands.v1.ConfigValue
+	41, // 11: cc.arduino.cli.commands.v1.BoardOptionsRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	9,  // 12: cc.arduino.cli.commands.v1.BoardOptionsResponse.config_options:type_name -> cc.arduino.cli.commands.v1.ConfigOption
+	41, // 13: cc.arduino.cli.commands.v1.BoardDefineRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	40, // 14: cc.arduino.cli.commands.v1.BoardDefineRequest.properties:type_name -> cc.arduino.cli.commands.v1.BoardDefineRequest.PropertiesEntry
+	41, // 15: cc.arduino.cli.commands.v1.BoardSymbolsRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	17, // 16: cc.arduino.cli.commands.v1.BoardSymbolsResponse.symbols:type_name -> cc.arduino.cli.commands.v1.BoardSymbol
+	0,  // 17: cc.arduino.cli.commands.v1.BoardSymbol.kind:type_name -> cc.arduino.cli.commands.v1.BoardSymbolKind
+	41, // 18: cc.arduino.cli.commands.v1.BoardListRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	20, // 19: cc.arduino.cli.commands.v1.BoardListResponse.ports:type_name -> cc.arduino.cli.commands.v1.DetectedPort
+	25, // 20: cc.arduino.cli.commands.v1.DetectedPort.matching_boards:type_name -> cc.arduino.cli.commands.v1.BoardListItem
+	43, // 21: cc.arduino.cli.commands.v1.DetectedPort.port:type_name -> cc.arduino.cli.commands.v1.Port
+	41, // 22: cc.arduino.cli.commands.v1.BoardListAllRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	25, // 23: cc.arduino.cli.commands.v1.BoardListAllResponse.boards:type_name -> cc.arduino.cli.commands.v1.BoardListItem
+	41, // 24: cc.arduino.cli.commands.v1.BoardListWatchRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	20, // 25: cc.arduino.cli.commands.v1.BoardListWatchResponse.port:type_name -> cc.arduino.cli.commands.v1.DetectedPort
+	44, // 26: cc.arduino.cli.commands.v1.BoardListItem.platform:type_name -> cc.arduino.cli.commands.v1.Platform
+	26, // 27: cc.arduino.cli.commands.v1.BoardListItem.missing_platform:type_name -> cc.arduino.cli.commands.v1.BoardMissingPlatform
+	41, // 28: cc.arduino.cli.commands.v1.BoardSearchRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	25, // 29: cc.arduino.cli.commands.v1.BoardSearchResponse.boards:type_name -> cc.arduino.cli.commands.v1.BoardListItem
+	41, // 30: cc.arduino.cli.commands.v1.BoardEEPROMReadRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	43, // 31: cc.arduino.cli.commands.v1.BoardEEPROMReadRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
+	31, // 32: cc.arduino.cli.commands.v1.BoardEEPROMReadResponse.result:type_name -> cc.arduino.cli.commands.v1.BoardEEPROMReadResult
+	41, // 33: cc.arduino.cli.commands.v1.BoardEEPROMWriteRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	43, // 34: cc.arduino.cli.commands.v1.BoardEEPROMWriteRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
+	34, // 35: cc.arduino.cli.commands.v1.BoardEEPROMWriteResponse.result:type_name -> cc.arduino.cli.commands.v1.BoardEEPROMWriteResult
+	41, // 36: cc.arduino.cli.commands.v1.BoardNVSReadRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	43, // 37: cc.arduino.cli.commands.v1.BoardNVSReadRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
+	37, // 38: cc.arduino.cli.commands.v1.BoardNVSReadResponse.result:type_name -> cc.arduino.cli.commands.v1.BoardNVSReadResult
+	38, // 39: cc.arduino.cli.commands.v1.BoardNVSReadResult.entries:type_name -> cc.arduino.cli.commands.v1.NVSEntry
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_cc_arduino_cli_commands_v1_board_proto_init() }
@@ -1885,7 +3650,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListRequest); i {
+			switch v := v.(*BoardOptionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1897,7 +3662,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListResponse); i {
+			switch v := v.(*BoardOptionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1909,7 +3674,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectedPort); i {
+			switch v := v.(*BoardDefineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1921,7 +3686,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListAllRequest); i {
+			switch v := v.(*BoardDefineResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1933,7 +3698,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListAllResponse); i {
+			switch v := v.(*BoardSymbolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1945,7 +3710,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListWatchRequest); i {
+			switch v := v.(*BoardSymbolsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1957,7 +3722,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListWatchResponse); i {
+			switch v := v.(*BoardSymbol); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1969,7 +3734,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListItem); i {
+			switch v := v.(*BoardListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1981,7 +3746,7 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardSearchRequest); i {
+			switch v := v.(*BoardListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1993,6 +3758,102 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectedPort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardListAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardListAllResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardListWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardListWatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardMissingPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BoardSearchResponse); i {
 			case 0:
 				return &v.state
@@ -2004,19 +3865,155 @@ func file_cc_arduino_cli_commands_v1_board_proto_init() {
 				return nil
 			}
 		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardEEPROMReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardEEPROMReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardEEPROMReadResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardEEPROMWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardEEPROMWriteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardEEPROMWriteResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardNVSReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardNVSReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardNVSReadResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_board_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NVSEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_cc_arduino_cli_commands_v1_board_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*BoardEEPROMReadResponse_OutStream)(nil),
+		(*BoardEEPROMReadResponse_ErrStream)(nil),
+		(*BoardEEPROMReadResponse_Result)(nil),
+	}
+	file_cc_arduino_cli_commands_v1_board_proto_msgTypes[32].OneofWrappers = []interface{}{
+		(*BoardEEPROMWriteResponse_OutStream)(nil),
+		(*BoardEEPROMWriteResponse_ErrStream)(nil),
+		(*BoardEEPROMWriteResponse_Result)(nil),
+	}
+	file_cc_arduino_cli_commands_v1_board_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*BoardNVSReadResponse_OutStream)(nil),
+		(*BoardNVSReadResponse_ErrStream)(nil),
+		(*BoardNVSReadResponse_Result)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cc_arduino_cli_commands_v1_board_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      1,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cc_arduino_cli_commands_v1_board_proto_goTypes,
 		DependencyIndexes: file_cc_arduino_cli_commands_v1_board_proto_depIdxs,
+		EnumInfos:         file_cc_arduino_cli_commands_v1_board_proto_enumTypes,
 		MessageInfos:      file_cc_arduino_cli_commands_v1_board_proto_msgTypes,
 	}.Build()
 	File_cc_arduino_cli_commands_v1_board_proto = out.File
@@ -40,26 +40,44 @@ const (
 	ArduinoCoreService_UpdateIndex_FullMethodName                       = "/cc.arduino.cli.commands.v1.ArduinoCoreService/UpdateIndex"
 	ArduinoCoreService_UpdateLibrariesIndex_FullMethodName              = "/cc.arduino.cli.commands.v1.ArduinoCoreService/UpdateLibrariesIndex"
 	ArduinoCoreService_Version_FullMethodName                           = "/cc.arduino.cli.commands.v1.ArduinoCoreService/Version"
+	ArduinoCoreService_NegotiateApiVersion_FullMethodName               = "/cc.arduino.cli.commands.v1.ArduinoCoreService/NegotiateApiVersion"
+	ArduinoCoreService_EnvironmentReport_FullMethodName                 = "/cc.arduino.cli.commands.v1.ArduinoCoreService/EnvironmentReport"
 	ArduinoCoreService_NewSketch_FullMethodName                         = "/cc.arduino.cli.commands.v1.ArduinoCoreService/NewSketch"
 	ArduinoCoreService_LoadSketch_FullMethodName                        = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LoadSketch"
 	ArduinoCoreService_ArchiveSketch_FullMethodName                     = "/cc.arduino.cli.commands.v1.ArduinoCoreService/ArchiveSketch"
 	ArduinoCoreService_SetSketchDefaults_FullMethodName                 = "/cc.arduino.cli.commands.v1.ArduinoCoreService/SetSketchDefaults"
+	ArduinoCoreService_ImportPlatformIOProject_FullMethodName           = "/cc.arduino.cli.commands.v1.ArduinoCoreService/ImportPlatformIOProject"
+	ArduinoCoreService_MoveSketch_FullMethodName                        = "/cc.arduino.cli.commands.v1.ArduinoCoreService/MoveSketch"
+	ArduinoCoreService_ConvertSketchToCpp_FullMethodName                = "/cc.arduino.cli.commands.v1.ArduinoCoreService/ConvertSketchToCpp"
 	ArduinoCoreService_BoardDetails_FullMethodName                      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardDetails"
+	ArduinoCoreService_BoardOptions_FullMethodName                      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardOptions"
+	ArduinoCoreService_BoardDefine_FullMethodName                       = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardDefine"
+	ArduinoCoreService_BoardSymbols_FullMethodName                      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardSymbols"
 	ArduinoCoreService_BoardList_FullMethodName                         = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardList"
 	ArduinoCoreService_BoardListAll_FullMethodName                      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardListAll"
 	ArduinoCoreService_BoardSearch_FullMethodName                       = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardSearch"
 	ArduinoCoreService_BoardListWatch_FullMethodName                    = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardListWatch"
+	ArduinoCoreService_BoardEEPROMRead_FullMethodName                   = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardEEPROMRead"
+	ArduinoCoreService_BoardEEPROMWrite_FullMethodName                  = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardEEPROMWrite"
+	ArduinoCoreService_BoardNVSRead_FullMethodName                      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardNVSRead"
 	ArduinoCoreService_Compile_FullMethodName                           = "/cc.arduino.cli.commands.v1.ArduinoCoreService/Compile"
+	ArduinoCoreService_PrecompileCore_FullMethodName                    = "/cc.arduino.cli.commands.v1.ArduinoCoreService/PrecompileCore"
+	ArduinoCoreService_GetCompilerFragment_FullMethodName               = "/cc.arduino.cli.commands.v1.ArduinoCoreService/GetCompilerFragment"
 	ArduinoCoreService_PlatformInstall_FullMethodName                   = "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformInstall"
 	ArduinoCoreService_PlatformDownload_FullMethodName                  = "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformDownload"
 	ArduinoCoreService_PlatformUninstall_FullMethodName                 = "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUninstall"
 	ArduinoCoreService_PlatformUpgrade_FullMethodName                   = "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade"
+	ArduinoCoreService_UpgradePlan_FullMethodName                       = "/cc.arduino.cli.commands.v1.ArduinoCoreService/UpgradePlan"
+	ArduinoCoreService_UpgradeApply_FullMethodName                      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/UpgradeApply"
 	ArduinoCoreService_Upload_FullMethodName                            = "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload"
 	ArduinoCoreService_UploadUsingProgrammer_FullMethodName             = "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer"
+	ArduinoCoreService_FilesystemImageBuild_FullMethodName              = "/cc.arduino.cli.commands.v1.ArduinoCoreService/FilesystemImageBuild"
+	ArduinoCoreService_FilesystemImageUpload_FullMethodName             = "/cc.arduino.cli.commands.v1.ArduinoCoreService/FilesystemImageUpload"
 	ArduinoCoreService_SupportedUserFields_FullMethodName               = "/cc.arduino.cli.commands.v1.ArduinoCoreService/SupportedUserFields"
 	ArduinoCoreService_ListProgrammersAvailableForUpload_FullMethodName = "/cc.arduino.cli.commands.v1.ArduinoCoreService/ListProgrammersAvailableForUpload"
 	ArduinoCoreService_BurnBootloader_FullMethodName                    = "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader"
 	ArduinoCoreService_PlatformSearch_FullMethodName                    = "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformSearch"
+	ArduinoCoreService_PlatformPreload_FullMethodName                   = "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformPreload"
 	ArduinoCoreService_LibraryDownload_FullMethodName                   = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload"
 	ArduinoCoreService_LibraryInstall_FullMethodName                    = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall"
 	ArduinoCoreService_LibraryUpgrade_FullMethodName                    = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgrade"
@@ -70,8 +88,17 @@ const (
 	ArduinoCoreService_LibraryResolveDependencies_FullMethodName        = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryResolveDependencies"
 	ArduinoCoreService_LibrarySearch_FullMethodName                     = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibrarySearch"
 	ArduinoCoreService_LibraryList_FullMethodName                       = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryList"
+	ArduinoCoreService_LibraryUsage_FullMethodName                      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUsage"
+	ArduinoCoreService_LibraryListConflicts_FullMethodName              = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryListConflicts"
+	ArduinoCoreService_LibraryQuery_FullMethodName                      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryQuery"
+	ArduinoCoreService_LibraryResolveSymbol_FullMethodName              = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryResolveSymbol"
+	ArduinoCoreService_LibraryPrecompile_FullMethodName                 = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryPrecompile"
+	ArduinoCoreService_LibraryCheckExamples_FullMethodName              = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryCheckExamples"
+	ArduinoCoreService_LibraryPropertiesGet_FullMethodName              = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryPropertiesGet"
+	ArduinoCoreService_LibraryPropertiesSet_FullMethodName              = "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryPropertiesSet"
 	ArduinoCoreService_Monitor_FullMethodName                           = "/cc.arduino.cli.commands.v1.ArduinoCoreService/Monitor"
 	ArduinoCoreService_EnumerateMonitorPortSettings_FullMethodName      = "/cc.arduino.cli.commands.v1.ArduinoCoreService/EnumerateMonitorPortSettings"
+	ArduinoCoreService_PortIO_FullMethodName                            = "/cc.arduino.cli.commands.v1.ArduinoCoreService/PortIO"
 	ArduinoCoreService_Debug_FullMethodName                             = "/cc.arduino.cli.commands.v1.ArduinoCoreService/Debug"
 	ArduinoCoreService_GetDebugConfig_FullMethodName                    = "/cc.arduino.cli.commands.v1.ArduinoCoreService/GetDebugConfig"
 )
@@ -93,6 +120,12 @@ type ArduinoCoreServiceClient interface {
 	UpdateLibrariesIndex(ctx context.Context, in *UpdateLibrariesIndexRequest, opts ...grpc.CallOption) (ArduinoCoreService_UpdateLibrariesIndexClient, error)
 	// Get the version of Arduino CLI in use.
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// Negotiate the version of the gRPC API to use between the versions
+	// supported by the client and the ones served by the daemon.
+	NegotiateApiVersion(ctx context.Context, in *NegotiateApiVersionRequest, opts ...grpc.CallOption) (*NegotiateApiVersionResponse, error)
+	// Build the inventory of the installed platforms, tools and libraries, with
+	// the hashes of their files.
+	EnvironmentReport(ctx context.Context, in *EnvironmentReportRequest, opts ...grpc.CallOption) (*EnvironmentReportResponse, error)
 	// Create a new Sketch
 	NewSketch(ctx context.Context, in *NewSketchRequest, opts ...grpc.CallOption) (*NewSketchResponse, error)
 	// Returns all files composing a Sketch
@@ -103,8 +136,27 @@ type ArduinoCoreServiceClient interface {
 	// the sketch project file (sketch.yaml). These metadata can be retrieved
 	// using LoadSketch.
 	SetSketchDefaults(ctx context.Context, in *SetSketchDefaultsRequest, opts ...grpc.CallOption) (*SetSketchDefaultsResponse, error)
+	// Imports the environments of a PlatformIO project file (platformio.ini)
+	// as profiles of the sketch project file (sketch.yaml).
+	ImportPlatformIOProject(ctx context.Context, in *ImportPlatformIOProjectRequest, opts ...grpc.CallOption) (*ImportPlatformIOProjectResponse, error)
+	// Renames or moves a sketch. The main file and the files named after the
+	// sketch are renamed to match the new sketch folder, the references to them
+	// and to the old location of the sketch are updated.
+	MoveSketch(ctx context.Context, in *MoveSketchRequest, opts ...grpc.CallOption) (*MoveSketchResponse, error)
+	// Converts the .ino files of a sketch to a C++ source file in the `src`
+	// folder, merging them and adding the prototypes like the builder does.
+	ConvertSketchToCpp(ctx context.Context, in *ConvertSketchToCppRequest, opts ...grpc.CallOption) (*ConvertSketchToCppResponse, error)
 	// Requests details about a board
 	BoardDetails(ctx context.Context, in *BoardDetailsRequest, opts ...grpc.CallOption) (*BoardDetailsResponse, error)
+	// List all the custom configuration options of a board with their values.
+	BoardOptions(ctx context.Context, in *BoardOptionsRequest, opts ...grpc.CallOption) (*BoardOptionsResponse, error)
+	// Define a custom board in the `boards.local.txt` of a platform, by cloning
+	// an existing board.
+	BoardDefine(ctx context.Context, in *BoardDefineRequest, opts ...grpc.CallOption) (*BoardDefineResponse, error)
+	// List the constants defined for a board by the headers of its variant and
+	// core and by its build properties: the names of the pins, the macros and
+	// the features, for the completions of the editors.
+	BoardSymbols(ctx context.Context, in *BoardSymbolsRequest, opts ...grpc.CallOption) (*BoardSymbolsResponse, error)
 	// List the boards currently connected to the computer.
 	BoardList(ctx context.Context, in *BoardListRequest, opts ...grpc.CallOption) (*BoardListResponse, error)
 	// List all the boards provided by installed platforms.
@@ -113,8 +165,23 @@ type ArduinoCoreServiceClient interface {
 	BoardSearch(ctx context.Context, in *BoardSearchRequest, opts ...grpc.CallOption) (*BoardSearchResponse, error)
 	// List boards connection and disconnected events.
 	BoardListWatch(ctx context.Context, in *BoardListWatchRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardListWatchClient, error)
+	// Read the content of the EEPROM of a board, using the upload tool.
+	BoardEEPROMRead(ctx context.Context, in *BoardEEPROMReadRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardEEPROMReadClient, error)
+	// Write the content of the EEPROM of a board, using the upload tool.
+	BoardEEPROMWrite(ctx context.Context, in *BoardEEPROMWriteRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardEEPROMWriteClient, error)
+	// Read and parse the NVS partition of an ESP32-class board.
+	BoardNVSRead(ctx context.Context, in *BoardNVSReadRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardNVSReadClient, error)
 	// Compile an Arduino sketch.
 	Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileClient, error)
+	// Pre-build and store in the build cache the core archives of the given
+	// boards, to speed up the first compilation of a sketch.
+	PrecompileCore(ctx context.Context, in *PrecompileCoreRequest, opts ...grpc.CallOption) (ArduinoCoreService_PrecompileCoreClient, error)
+	// Return the compiler command used to build the sketch, split in compiler
+	// path, include directories, defines and flags, without building it. The
+	// result is cached until the includes of the sketch or the installed
+	// platforms and libraries change. This call is meant to configure language
+	// servers (e.g. clangd) and its response is kept stable.
+	GetCompilerFragment(ctx context.Context, in *GetCompilerFragmentRequest, opts ...grpc.CallOption) (*GetCompilerFragmentResponse, error)
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(ctx context.Context, in *PlatformInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformInstallClient, error)
 	// Download a platform and its tool dependencies to the `staging/packages`
@@ -125,10 +192,24 @@ type ArduinoCoreServiceClient interface {
 	PlatformUninstall(ctx context.Context, in *PlatformUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUninstallClient, error)
 	// Upgrade an installed platform to the latest version.
 	PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error)
-	// Upload a compiled sketch to a board.
+	// Compute a consistent upgrade of the installed platforms and libraries,
+	// respecting the dependencies of the libraries and the versions pinned by
+	// the profiles of a sketch.
+	UpgradePlan(ctx context.Context, in *UpgradePlanRequest, opts ...grpc.CallOption) (*UpgradePlanResponse, error)
+	// Apply an upgrade plan. All the archives are downloaded before installing
+	// anything, and the previous installation is restored if any step fails.
+	UpgradeApply(ctx context.Context, in *UpgradeApplyRequest, opts ...grpc.CallOption) (ArduinoCoreService_UpgradeApplyClient, error)
+	// Upload a compiled sketch to a board. The Monitor sessions opened on the
+	// upload port are paused during the upload and resumed afterward.
 	Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error)
 	// Upload a compiled sketch to a board using a programmer.
 	UploadUsingProgrammer(ctx context.Context, in *UploadUsingProgrammerRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadUsingProgrammerClient, error)
+	// Build the filesystem image (LittleFS, SPIFFS or FatFS) of the `data`
+	// folder of a sketch.
+	FilesystemImageBuild(ctx context.Context, in *FilesystemImageBuildRequest, opts ...grpc.CallOption) (ArduinoCoreService_FilesystemImageBuildClient, error)
+	// Upload the filesystem image of the `data` folder of a sketch to the
+	// partition of the board reserved to the filesystem.
+	FilesystemImageUpload(ctx context.Context, in *FilesystemImageUploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_FilesystemImageUploadClient, error)
 	// Returns the list of users fields necessary to upload to that board
 	// using the specified protocol.
 	SupportedUserFields(ctx context.Context, in *SupportedUserFieldsRequest, opts ...grpc.CallOption) (*SupportedUserFieldsResponse, error)
@@ -138,6 +219,9 @@ type ArduinoCoreServiceClient interface {
 	BurnBootloader(ctx context.Context, in *BurnBootloaderRequest, opts ...grpc.CallOption) (ArduinoCoreService_BurnBootloaderClient, error)
 	// Search for a platform in the platforms indexes.
 	PlatformSearch(ctx context.Context, in *PlatformSearchRequest, opts ...grpc.CallOption) (*PlatformSearchResponse, error)
+	// Load the installed platforms whose loading has been deferred by an `Init`
+	// with `lazy_platforms_loading` enabled.
+	PlatformPreload(ctx context.Context, in *PlatformPreloadRequest, opts ...grpc.CallOption) (*PlatformPreloadResponse, error)
 	// Download the archive file of an Arduino library in the libraries index to
 	// the staging directory.
 	LibraryDownload(ctx context.Context, in *LibraryDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryDownloadClient, error)
@@ -160,10 +244,37 @@ type ArduinoCoreServiceClient interface {
 	LibrarySearch(ctx context.Context, in *LibrarySearchRequest, opts ...grpc.CallOption) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(ctx context.Context, in *LibraryListRequest, opts ...grpc.CallOption) (*LibraryListResponse, error)
-	// Open a monitor connection to a board port
+	// Returns the usage of the installed libraries by the compilations.
+	LibraryUsage(ctx context.Context, in *LibraryUsageRequest, opts ...grpc.CallOption) (*LibraryUsageResponse, error)
+	// List the headers provided by more than one installed library, with the
+	// library selected when compiling and the shadowed ones.
+	LibraryListConflicts(ctx context.Context, in *LibraryListConflictsRequest, opts ...grpc.CallOption) (*LibraryListConflictsResponse, error)
+	// Search the libraries in the libraries index and the installed libraries,
+	// returning for each library both the index data and the installed copies.
+	LibraryQuery(ctx context.Context, in *LibraryQueryRequest, opts ...grpc.CallOption) (*LibraryQueryResponse, error)
+	// Find the libraries declaring a symbol in their public headers.
+	LibraryResolveSymbol(ctx context.Context, in *LibraryResolveSymbolRequest, opts ...grpc.CallOption) (*LibraryResolveSymbolResponse, error)
+	// Compile an installed library into static archives, placed in the folder
+	// used by the builder to look for the precompiled libraries of each board.
+	LibraryPrecompile(ctx context.Context, in *LibraryPrecompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryPrecompileClient, error)
+	// Compile every example of a library for a set of boards, reporting the
+	// result of each compilation.
+	LibraryCheckExamples(ctx context.Context, in *LibraryCheckExamplesRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryCheckExamplesClient, error)
+	// Read the fields of the library.properties of a library folder.
+	LibraryPropertiesGet(ctx context.Context, in *LibraryPropertiesGetRequest, opts ...grpc.CallOption) (*LibraryPropertiesGetResponse, error)
+	// Change the fields of the library.properties of a library folder, after
+	// validating them.
+	LibraryPropertiesSet(ctx context.Context, in *LibraryPropertiesSetRequest, opts ...grpc.CallOption) (*LibraryPropertiesSetResponse, error)
+	// Open a monitor connection to a board port. The connection is paused while
+	// an Upload or a BurnBootloader is running on the same port.
 	Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error)
 	// Returns the parameters that can be set in the MonitorRequest calls
 	EnumerateMonitorPortSettings(ctx context.Context, in *EnumerateMonitorPortSettingsRequest, opts ...grpc.CallOption) (*EnumerateMonitorPortSettingsResponse, error)
+	// Open a raw connection to a board port, with explicit flow control in both
+	// directions, for the clients implementing binary protocols (e.g. firmware
+	// updates) through the port. The data is forwarded without any buffering
+	// delay.
+	PortIO(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_PortIOClient, error)
 	// Start a debug session and communicate with the debugger tool.
 	Debug(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_DebugClient, error)
 	GetDebugConfig(ctx context.Context, in *GetDebugConfigRequest, opts ...grpc.CallOption) (*GetDebugConfigResponse, error)
@@ -300,6 +411,24 @@ func (c *arduinoCoreServiceClient) Version(ctx context.Context, in *VersionReque
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) NegotiateApiVersion(ctx context.Context, in *NegotiateApiVersionRequest, opts ...grpc.CallOption) (*NegotiateApiVersionResponse, error) {
+	out := new(NegotiateApiVersionResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_NegotiateApiVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) EnvironmentReport(ctx context.Context, in *EnvironmentReportRequest, opts ...grpc.CallOption) (*EnvironmentReportResponse, error) {
+	out := new(EnvironmentReportResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_EnvironmentReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) NewSketch(ctx context.Context, in *NewSketchRequest, opts ...grpc.CallOption) (*NewSketchResponse, error) {
 	out := new(NewSketchResponse)
 	err := c.cc.Invoke(ctx, ArduinoCoreService_NewSketch_FullMethodName, in, out, opts...)
@@ -336,6 +465,33 @@ func (c *arduinoCoreServiceClient) SetSketchDefaults(ctx context.Context, in *Se
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) ImportPlatformIOProject(ctx context.Context, in *ImportPlatformIOProjectRequest, opts ...grpc.CallOption) (*ImportPlatformIOProjectResponse, error) {
+	out := new(ImportPlatformIOProjectResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_ImportPlatformIOProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) MoveSketch(ctx context.Context, in *MoveSketchRequest, opts ...grpc.CallOption) (*MoveSketchResponse, error) {
+	out := new(MoveSketchResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_MoveSketch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) ConvertSketchToCpp(ctx context.Context, in *ConvertSketchToCppRequest, opts ...grpc.CallOption) (*ConvertSketchToCppResponse, error) {
+	out := new(ConvertSketchToCppResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_ConvertSketchToCpp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) BoardDetails(ctx context.Context, in *BoardDetailsRequest, opts ...grpc.CallOption) (*BoardDetailsResponse, error) {
 	out := new(BoardDetailsResponse)
 	err := c.cc.Invoke(ctx, ArduinoCoreService_BoardDetails_FullMethodName, in, out, opts...)
@@ -345,6 +501,33 @@ func (c *arduinoCoreServiceClient) BoardDetails(ctx context.Context, in *BoardDe
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) BoardOptions(ctx context.Context, in *BoardOptionsRequest, opts ...grpc.CallOption) (*BoardOptionsResponse, error) {
+	out := new(BoardOptionsResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_BoardOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) BoardDefine(ctx context.Context, in *BoardDefineRequest, opts ...grpc.CallOption) (*BoardDefineResponse, error) {
+	out := new(BoardDefineResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_BoardDefine_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) BoardSymbols(ctx context.Context, in *BoardSymbolsRequest, opts ...grpc.CallOption) (*BoardSymbolsResponse, error) {
+	out := new(BoardSymbolsResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_BoardSymbols_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) BoardList(ctx context.Context, in *BoardListRequest, opts ...grpc.CallOption) (*BoardListResponse, error) {
 	out := new(BoardListResponse)
 	err := c.cc.Invoke(ctx, ArduinoCoreService_BoardList_FullMethodName, in, out, opts...)
@@ -404,8 +587,104 @@ func (x *arduinoCoreServiceBoardListWatchClient) Recv() (*BoardListWatchResponse
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) BoardEEPROMRead(ctx context.Context, in *BoardEEPROMReadRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardEEPROMReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[4], ArduinoCoreService_BoardEEPROMRead_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceBoardEEPROMReadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_BoardEEPROMReadClient interface {
+	Recv() (*BoardEEPROMReadResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceBoardEEPROMReadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceBoardEEPROMReadClient) Recv() (*BoardEEPROMReadResponse, error) {
+	m := new(BoardEEPROMReadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) BoardEEPROMWrite(ctx context.Context, in *BoardEEPROMWriteRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardEEPROMWriteClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[5], ArduinoCoreService_BoardEEPROMWrite_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceBoardEEPROMWriteClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_BoardEEPROMWriteClient interface {
+	Recv() (*BoardEEPROMWriteResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceBoardEEPROMWriteClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceBoardEEPROMWriteClient) Recv() (*BoardEEPROMWriteResponse, error) {
+	m := new(BoardEEPROMWriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) BoardNVSRead(ctx context.Context, in *BoardNVSReadRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardNVSReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[6], ArduinoCoreService_BoardNVSRead_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceBoardNVSReadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_BoardNVSReadClient interface {
+	Recv() (*BoardNVSReadResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceBoardNVSReadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceBoardNVSReadClient) Recv() (*BoardNVSReadResponse, error) {
+	m := new(BoardNVSReadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[4], ArduinoCoreService_Compile_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[7], ArduinoCoreService_Compile_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -436,8 +715,49 @@ func (x *arduinoCoreServiceCompileClient) Recv() (*CompileResponse, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) PrecompileCore(ctx context.Context, in *PrecompileCoreRequest, opts ...grpc.CallOption) (ArduinoCoreService_PrecompileCoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[8], ArduinoCoreService_PrecompileCore_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServicePrecompileCoreClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_PrecompileCoreClient interface {
+	Recv() (*PrecompileCoreResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServicePrecompileCoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServicePrecompileCoreClient) Recv() (*PrecompileCoreResponse, error) {
+	m := new(PrecompileCoreResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) GetCompilerFragment(ctx context.Context, in *GetCompilerFragmentRequest, opts ...grpc.CallOption) (*GetCompilerFragmentResponse, error) {
+	out := new(GetCompilerFragmentResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_GetCompilerFragment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) PlatformInstall(ctx context.Context, in *PlatformInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[5], ArduinoCoreService_PlatformInstall_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[9], ArduinoCoreService_PlatformInstall_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -469,7 +789,7 @@ func (x *arduinoCoreServicePlatformInstallClient) Recv() (*PlatformInstallRespon
 }
 
 func (c *arduinoCoreServiceClient) PlatformDownload(ctx context.Context, in *PlatformDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[6], ArduinoCoreService_PlatformDownload_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[10], ArduinoCoreService_PlatformDownload_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -501,7 +821,7 @@ func (x *arduinoCoreServicePlatformDownloadClient) Recv() (*PlatformDownloadResp
 }
 
 func (c *arduinoCoreServiceClient) PlatformUninstall(ctx context.Context, in *PlatformUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[7], ArduinoCoreService_PlatformUninstall_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], ArduinoCoreService_PlatformUninstall_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -533,7 +853,7 @@ func (x *arduinoCoreServicePlatformUninstallClient) Recv() (*PlatformUninstallRe
 }
 
 func (c *arduinoCoreServiceClient) PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[8], ArduinoCoreService_PlatformUpgrade_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], ArduinoCoreService_PlatformUpgrade_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -564,8 +884,49 @@ func (x *arduinoCoreServicePlatformUpgradeClient) Recv() (*PlatformUpgradeRespon
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) UpgradePlan(ctx context.Context, in *UpgradePlanRequest, opts ...grpc.CallOption) (*UpgradePlanResponse, error) {
+	out := new(UpgradePlanResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_UpgradePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) UpgradeApply(ctx context.Context, in *UpgradeApplyRequest, opts ...grpc.CallOption) (ArduinoCoreService_UpgradeApplyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], ArduinoCoreService_UpgradeApply_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceUpgradeApplyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_UpgradeApplyClient interface {
+	Recv() (*UpgradeApplyResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceUpgradeApplyClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceUpgradeApplyClient) Recv() (*UpgradeApplyResponse, error) {
+	m := new(UpgradeApplyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[9], ArduinoCoreService_Upload_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], ArduinoCoreService_Upload_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -597,7 +958,7 @@ func (x *arduinoCoreServiceUploadClient) Recv() (*UploadResponse, error) {
 }
 
 func (c *arduinoCoreServiceClient) UploadUsingProgrammer(ctx context.Context, in *UploadUsingProgrammerRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadUsingProgrammerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[10], ArduinoCoreService_UploadUsingProgrammer_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], ArduinoCoreService_UploadUsingProgrammer_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -628,6 +989,70 @@ func (x *arduinoCoreServiceUploadUsingProgrammerClient) Recv() (*UploadUsingProg
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) FilesystemImageBuild(ctx context.Context, in *FilesystemImageBuildRequest, opts ...grpc.CallOption) (ArduinoCoreService_FilesystemImageBuildClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], ArduinoCoreService_FilesystemImageBuild_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceFilesystemImageBuildClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_FilesystemImageBuildClient interface {
+	Recv() (*FilesystemImageBuildResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceFilesystemImageBuildClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceFilesystemImageBuildClient) Recv() (*FilesystemImageBuildResponse, error) {
+	m := new(FilesystemImageBuildResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) FilesystemImageUpload(ctx context.Context, in *FilesystemImageUploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_FilesystemImageUploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], ArduinoCoreService_FilesystemImageUpload_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceFilesystemImageUploadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_FilesystemImageUploadClient interface {
+	Recv() (*FilesystemImageUploadResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceFilesystemImageUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceFilesystemImageUploadClient) Recv() (*FilesystemImageUploadResponse, error) {
+	m := new(FilesystemImageUploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) SupportedUserFields(ctx context.Context, in *SupportedUserFieldsRequest, opts ...grpc.CallOption) (*SupportedUserFieldsResponse, error) {
 	out := new(SupportedUserFieldsResponse)
 	err := c.cc.Invoke(ctx, ArduinoCoreService_SupportedUserFields_FullMethodName, in, out, opts...)
@@ -647,7 +1072,7 @@ func (c *arduinoCoreServiceClient) ListProgrammersAvailableForUpload(ctx context
 }
 
 func (c *arduinoCoreServiceClient) BurnBootloader(ctx context.Context, in *BurnBootloaderRequest, opts ...grpc.CallOption) (ArduinoCoreService_BurnBootloaderClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], ArduinoCoreService_BurnBootloader_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], ArduinoCoreService_BurnBootloader_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -687,8 +1112,17 @@ func (c *arduinoCoreServiceClient) PlatformSearch(ctx context.Context, in *Platf
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) PlatformPreload(ctx context.Context, in *PlatformPreloadRequest, opts ...grpc.CallOption) (*PlatformPreloadResponse, error) {
+	out := new(PlatformPreloadResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_PlatformPreload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) LibraryDownload(ctx context.Context, in *LibraryDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], ArduinoCoreService_LibraryDownload_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], ArduinoCoreService_LibraryDownload_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -720,7 +1154,7 @@ func (x *arduinoCoreServiceLibraryDownloadClient) Recv() (*LibraryDownloadRespon
 }
 
 func (c *arduinoCoreServiceClient) LibraryInstall(ctx context.Context, in *LibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], ArduinoCoreService_LibraryInstall_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], ArduinoCoreService_LibraryInstall_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -752,7 +1186,7 @@ func (x *arduinoCoreServiceLibraryInstallClient) Recv() (*LibraryInstallResponse
 }
 
 func (c *arduinoCoreServiceClient) LibraryUpgrade(ctx context.Context, in *LibraryUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], ArduinoCoreService_LibraryUpgrade_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[21], ArduinoCoreService_LibraryUpgrade_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -784,7 +1218,7 @@ func (x *arduinoCoreServiceLibraryUpgradeClient) Recv() (*LibraryUpgradeResponse
 }
 
 func (c *arduinoCoreServiceClient) ZipLibraryInstall(ctx context.Context, in *ZipLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_ZipLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], ArduinoCoreService_ZipLibraryInstall_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[22], ArduinoCoreService_ZipLibraryInstall_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -816,7 +1250,7 @@ func (x *arduinoCoreServiceZipLibraryInstallClient) Recv() (*ZipLibraryInstallRe
 }
 
 func (c *arduinoCoreServiceClient) GitLibraryInstall(ctx context.Context, in *GitLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_GitLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], ArduinoCoreService_GitLibraryInstall_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[23], ArduinoCoreService_GitLibraryInstall_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -839,20 +1273,147 @@ type arduinoCoreServiceGitLibraryInstallClient struct {
 	grpc.ClientStream
 }
 
-func (x *arduinoCoreServiceGitLibraryInstallClient) Recv() (*GitLibraryInstallResponse, error) {
-	m := new(GitLibraryInstallResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (x *arduinoCoreServiceGitLibraryInstallClient) Recv() (*GitLibraryInstallResponse, error) {
+	m := new(GitLibraryInstallResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUninstallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[24], ArduinoCoreService_LibraryUninstall_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceLibraryUninstallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_LibraryUninstallClient interface {
+	Recv() (*LibraryUninstallResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceLibraryUninstallClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceLibraryUninstallClient) Recv() (*LibraryUninstallResponse, error) {
+	m := new(LibraryUninstallResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUpgradeAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[25], ArduinoCoreService_LibraryUpgradeAll_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceLibraryUpgradeAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_LibraryUpgradeAllClient interface {
+	Recv() (*LibraryUpgradeAllResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceLibraryUpgradeAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceLibraryUpgradeAllClient) Recv() (*LibraryUpgradeAllResponse, error) {
+	m := new(LibraryUpgradeAllResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryResolveDependencies(ctx context.Context, in *LibraryResolveDependenciesRequest, opts ...grpc.CallOption) (*LibraryResolveDependenciesResponse, error) {
+	out := new(LibraryResolveDependenciesResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryResolveDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibrarySearch(ctx context.Context, in *LibrarySearchRequest, opts ...grpc.CallOption) (*LibrarySearchResponse, error) {
+	out := new(LibrarySearchResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibrarySearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryList(ctx context.Context, in *LibraryListRequest, opts ...grpc.CallOption) (*LibraryListResponse, error) {
+	out := new(LibraryListResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryUsage(ctx context.Context, in *LibraryUsageRequest, opts ...grpc.CallOption) (*LibraryUsageResponse, error) {
+	out := new(LibraryUsageResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryListConflicts(ctx context.Context, in *LibraryListConflictsRequest, opts ...grpc.CallOption) (*LibraryListConflictsResponse, error) {
+	out := new(LibraryListConflictsResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryListConflicts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryQuery(ctx context.Context, in *LibraryQueryRequest, opts ...grpc.CallOption) (*LibraryQueryResponse, error) {
+	out := new(LibraryQueryResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryResolveSymbol(ctx context.Context, in *LibraryResolveSymbolRequest, opts ...grpc.CallOption) (*LibraryResolveSymbolResponse, error) {
+	out := new(LibraryResolveSymbolResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryResolveSymbol_FullMethodName, in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *arduinoCoreServiceClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], ArduinoCoreService_LibraryUninstall_FullMethodName, opts...)
+func (c *arduinoCoreServiceClient) LibraryPrecompile(ctx context.Context, in *LibraryPrecompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryPrecompileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[26], ArduinoCoreService_LibraryPrecompile_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &arduinoCoreServiceLibraryUninstallClient{stream}
+	x := &arduinoCoreServiceLibraryPrecompileClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -862,29 +1423,29 @@ func (c *arduinoCoreServiceClient) LibraryUninstall(ctx context.Context, in *Lib
 	return x, nil
 }
 
-type ArduinoCoreService_LibraryUninstallClient interface {
-	Recv() (*LibraryUninstallResponse, error)
+type ArduinoCoreService_LibraryPrecompileClient interface {
+	Recv() (*LibraryPrecompileResponse, error)
 	grpc.ClientStream
 }
 
-type arduinoCoreServiceLibraryUninstallClient struct {
+type arduinoCoreServiceLibraryPrecompileClient struct {
 	grpc.ClientStream
 }
 
-func (x *arduinoCoreServiceLibraryUninstallClient) Recv() (*LibraryUninstallResponse, error) {
-	m := new(LibraryUninstallResponse)
+func (x *arduinoCoreServiceLibraryPrecompileClient) Recv() (*LibraryPrecompileResponse, error) {
+	m := new(LibraryPrecompileResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *arduinoCoreServiceClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUpgradeAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], ArduinoCoreService_LibraryUpgradeAll_FullMethodName, opts...)
+func (c *arduinoCoreServiceClient) LibraryCheckExamples(ctx context.Context, in *LibraryCheckExamplesRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryCheckExamplesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[27], ArduinoCoreService_LibraryCheckExamples_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &arduinoCoreServiceLibraryUpgradeAllClient{stream}
+	x := &arduinoCoreServiceLibraryCheckExamplesClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -894,44 +1455,35 @@ func (c *arduinoCoreServiceClient) LibraryUpgradeAll(ctx context.Context, in *Li
 	return x, nil
 }
 
-type ArduinoCoreService_LibraryUpgradeAllClient interface {
-	Recv() (*LibraryUpgradeAllResponse, error)
+type ArduinoCoreService_LibraryCheckExamplesClient interface {
+	Recv() (*LibraryCheckExamplesResponse, error)
 	grpc.ClientStream
 }
 
-type arduinoCoreServiceLibraryUpgradeAllClient struct {
+type arduinoCoreServiceLibraryCheckExamplesClient struct {
 	grpc.ClientStream
 }
 
-func (x *arduinoCoreServiceLibraryUpgradeAllClient) Recv() (*LibraryUpgradeAllResponse, error) {
-	m := new(LibraryUpgradeAllResponse)
+func (x *arduinoCoreServiceLibraryCheckExamplesClient) Recv() (*LibraryCheckExamplesResponse, error) {
+	m := new(LibraryCheckExamplesResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *arduinoCoreServiceClient) LibraryResolveDependencies(ctx context.Context, in *LibraryResolveDependenciesRequest, opts ...grpc.CallOption) (*LibraryResolveDependenciesResponse, error) {
-	out := new(LibraryResolveDependenciesResponse)
-	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryResolveDependencies_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *arduinoCoreServiceClient) LibrarySearch(ctx context.Context, in *LibrarySearchRequest, opts ...grpc.CallOption) (*LibrarySearchResponse, error) {
-	out := new(LibrarySearchResponse)
-	err := c.cc.Invoke(ctx, ArduinoCoreService_LibrarySearch_FullMethodName, in, out, opts...)
+func (c *arduinoCoreServiceClient) LibraryPropertiesGet(ctx context.Context, in *LibraryPropertiesGetRequest, opts ...grpc.CallOption) (*LibraryPropertiesGetResponse, error) {
+	out := new(LibraryPropertiesGetResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryPropertiesGet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *arduinoCoreServiceClient) LibraryList(ctx context.Context, in *LibraryListRequest, opts ...grpc.CallOption) (*LibraryListResponse, error) {
-	out := new(LibraryListResponse)
-	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryList_FullMethodName, in, out, opts...)
+func (c *arduinoCoreServiceClient) LibraryPropertiesSet(ctx context.Context, in *LibraryPropertiesSetRequest, opts ...grpc.CallOption) (*LibraryPropertiesSetResponse, error) {
+	out := new(LibraryPropertiesSetResponse)
+	err := c.cc.Invoke(ctx, ArduinoCoreService_LibraryPropertiesSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -939,7 +1491,7 @@ func (c *arduinoCoreServiceClient) LibraryList(ctx context.Context, in *LibraryL
 }
 
 func (c *arduinoCoreServiceClient) Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], ArduinoCoreService_Monitor_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[28], ArduinoCoreService_Monitor_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -978,8 +1530,39 @@ func (c *arduinoCoreServiceClient) EnumerateMonitorPortSettings(ctx context.Cont
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) PortIO(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_PortIOClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[29], ArduinoCoreService_PortIO_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServicePortIOClient{stream}
+	return x, nil
+}
+
+type ArduinoCoreService_PortIOClient interface {
+	Send(*PortIORequest) error
+	Recv() (*PortIOResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServicePortIOClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServicePortIOClient) Send(m *PortIORequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *arduinoCoreServicePortIOClient) Recv() (*PortIOResponse, error) {
+	m := new(PortIOResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) Debug(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_DebugClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], ArduinoCoreService_Debug_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[30], ArduinoCoreService_Debug_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1035,6 +1618,12 @@ type ArduinoCoreServiceServer interface {
 	UpdateLibrariesIndex(*UpdateLibrariesIndexRequest, ArduinoCoreService_UpdateLibrariesIndexServer) error
 	// Get the version of Arduino CLI in use.
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// Negotiate the version of the gRPC API to use between the versions
+	// supported by the client and the ones served by the daemon.
+	NegotiateApiVersion(context.Context, *NegotiateApiVersionRequest) (*NegotiateApiVersionResponse, error)
+	// Build the inventory of the installed platforms, tools and libraries, with
+	// the hashes of their files.
+	EnvironmentReport(context.Context, *EnvironmentReportRequest) (*EnvironmentReportResponse, error)
 	// Create a new Sketch
 	NewSketch(context.Context, *NewSketchRequest) (*NewSketchResponse, error)
 	// Returns all files composing a Sketch
@@ -1045,8 +1634,27 @@ type ArduinoCoreServiceServer interface {
 	// the sketch project file (sketch.yaml). These metadata can be retrieved
 	// using LoadSketch.
 	SetSketchDefaults(context.Context, *SetSketchDefaultsRequest) (*SetSketchDefaultsResponse, error)
+	// Imports the environments of a PlatformIO project file (platformio.ini)
+	// as profiles of the sketch project file (sketch.yaml).
+	ImportPlatformIOProject(context.Context, *ImportPlatformIOProjectRequest) (*ImportPlatformIOProjectResponse, error)
+	// Renames or moves a sketch. The main file and the files named after the
+	// sketch are renamed to match the new sketch folder, the references to them
+	// and to the old location of the sketch are updated.
+	MoveSketch(context.Context, *MoveSketchRequest) (*MoveSketchResponse, error)
+	// Converts the .ino files of a sketch to a C++ source file in the `src`
+	// folder, merging them and adding the prototypes like the builder does.
+	ConvertSketchToCpp(context.Context, *ConvertSketchToCppRequest) (*ConvertSketchToCppResponse, error)
 	// Requests details about a board
 	BoardDetails(context.Context, *BoardDetailsRequest) (*BoardDetailsResponse, error)
+	// List all the custom configuration options of a board with their values.
+	BoardOptions(context.Context, *BoardOptionsRequest) (*BoardOptionsResponse, error)
+	// Define a custom board in the `boards.local.txt` of a platform, by cloning
+	// an existing board.
+	BoardDefine(context.Context, *BoardDefineRequest) (*BoardDefineResponse, error)
+	// List the constants defined for a board by the headers of its variant and
+	// core and by its build properties: the names of the pins, the macros and
+	// the features, for the completions of the editors.
+	BoardSymbols(context.Context, *BoardSymbolsRequest) (*BoardSymbolsResponse, error)
 	// List the boards currently connected to the computer.
 	BoardList(context.Context, *BoardListRequest) (*BoardListResponse, error)
 	// List all the boards provided by installed platforms.
@@ -1055,8 +1663,23 @@ type ArduinoCoreServiceServer interface {
 	BoardSearch(context.Context, *BoardSearchRequest) (*BoardSearchResponse, error)
 	// List boards connection and disconnected events.
 	BoardListWatch(*BoardListWatchRequest, ArduinoCoreService_BoardListWatchServer) error
+	// Read the content of the EEPROM of a board, using the upload tool.
+	BoardEEPROMRead(*BoardEEPROMReadRequest, ArduinoCoreService_BoardEEPROMReadServer) error
+	// Write the content of the EEPROM of a board, using the upload tool.
+	BoardEEPROMWrite(*BoardEEPROMWriteRequest, ArduinoCoreService_BoardEEPROMWriteServer) error
+	// Read and parse the NVS partition of an ESP32-class board.
+	BoardNVSRead(*BoardNVSReadRequest, ArduinoCoreService_BoardNVSReadServer) error
 	// Compile an Arduino sketch.
 	Compile(*CompileRequest, ArduinoCoreService_CompileServer) error
+	// Pre-build and store in the build cache the core archives of the given
+	// boards, to speed up the first compilation of a sketch.
+	PrecompileCore(*PrecompileCoreRequest, ArduinoCoreService_PrecompileCoreServer) error
+	// Return the compiler command used to build the sketch, split in compiler
+	// path, include directories, defines and flags, without building it. The
+	// result is cached until the includes of the sketch or the installed
+	// platforms and libraries change. This call is meant to configure language
+	// servers (e.g. clangd) and its response is kept stable.
+	GetCompilerFragment(context.Context, *GetCompilerFragmentRequest) (*GetCompilerFragmentResponse, error)
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(*PlatformInstallRequest, ArduinoCoreService_PlatformInstallServer) error
 	// Download a platform and its tool dependencies to the `staging/packages`
@@ -1067,10 +1690,24 @@ type ArduinoCoreServiceServer interface {
 	PlatformUninstall(*PlatformUninstallRequest, ArduinoCoreService_PlatformUninstallServer) error
 	// Upgrade an installed platform to the latest version.
 	PlatformUpgrade(*PlatformUpgradeRequest, ArduinoCoreService_PlatformUpgradeServer) error
-	// Upload a compiled sketch to a board.
+	// Compute a consistent upgrade of the installed platforms and libraries,
+	// respecting the dependencies of the libraries and the versions pinned by
+	// the profiles of a sketch.
+	UpgradePlan(context.Context, *UpgradePlanRequest) (*UpgradePlanResponse, error)
+	// Apply an upgrade plan. All the archives are downloaded before installing
+	// anything, and the previous installation is restored if any step fails.
+	UpgradeApply(*UpgradeApplyRequest, ArduinoCoreService_UpgradeApplyServer) error
+	// Upload a compiled sketch to a board. The Monitor sessions opened on the
+	// upload port are paused during the upload and resumed afterward.
 	Upload(*UploadRequest, ArduinoCoreService_UploadServer) error
 	// Upload a compiled sketch to a board using a programmer.
 	UploadUsingProgrammer(*UploadUsingProgrammerRequest, ArduinoCoreService_UploadUsingProgrammerServer) error
+	// Build the filesystem image (LittleFS, SPIFFS or FatFS) of the `data`
+	// folder of a sketch.
+	FilesystemImageBuild(*FilesystemImageBuildRequest, ArduinoCoreService_FilesystemImageBuildServer) error
+	// Upload the filesystem image of the `data` folder of a sketch to the
+	// partition of the board reserved to the filesystem.
+	FilesystemImageUpload(*FilesystemImageUploadRequest, ArduinoCoreService_FilesystemImageUploadServer) error
 	// Returns the list of users fields necessary to upload to that board
 	// using the specified protocol.
 	SupportedUserFields(context.Context, *SupportedUserFieldsRequest) (*SupportedUserFieldsResponse, error)
@@ -1080,6 +1717,9 @@ type ArduinoCoreServiceServer interface {
 	BurnBootloader(*BurnBootloaderRequest, ArduinoCoreService_BurnBootloaderServer) error
 	// Search for a platform in the platforms indexes.
 	PlatformSearch(context.Context, *PlatformSearchRequest) (*PlatformSearchResponse, error)
+	// Load the installed platforms whose loading has been deferred by an `Init`
+	// with `lazy_platforms_loading` enabled.
+	PlatformPreload(context.Context, *PlatformPreloadRequest) (*PlatformPreloadResponse, error)
 	// Download the archive file of an Arduino library in the libraries index to
 	// the staging directory.
 	LibraryDownload(*LibraryDownloadRequest, ArduinoCoreService_LibraryDownloadServer) error
@@ -1102,10 +1742,37 @@ type ArduinoCoreServiceServer interface {
 	LibrarySearch(context.Context, *LibrarySearchRequest) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error)
-	// Open a monitor connection to a board port
+	// Returns the usage of the installed libraries by the compilations.
+	LibraryUsage(context.Context, *LibraryUsageRequest) (*LibraryUsageResponse, error)
+	// List the headers provided by more than one installed library, with the
+	// library selected when compiling and the shadowed ones.
+	LibraryListConflicts(context.Context, *LibraryListConflictsRequest) (*LibraryListConflictsResponse, error)
+	// Search the libraries in the libraries index and the installed libraries,
+	// returning for each library both the index data and the installed copies.
+	LibraryQuery(context.Context, *LibraryQueryRequest) (*LibraryQueryResponse, error)
+	// Find the libraries declaring a symbol in their public headers.
+	LibraryResolveSymbol(context.Context, *LibraryResolveSymbolRequest) (*LibraryResolveSymbolResponse, error)
+	// Compile an installed library into static archives, placed in the folder
+	// used by the builder to look for the precompiled libraries of each board.
+	LibraryPrecompile(*LibraryPrecompileRequest, ArduinoCoreService_LibraryPrecompileServer) error
+	// Compile every example of a library for a set of boards, reporting the
+	// result of each compilation.
+	LibraryCheckExamples(*LibraryCheckExamplesRequest, ArduinoCoreService_LibraryCheckExamplesServer) error
+	// Read the fields of the library.properties of a library folder.
+	LibraryPropertiesGet(context.Context, *LibraryPropertiesGetRequest) (*LibraryPropertiesGetResponse, error)
+	// Change the fields of the library.properties of a library folder, after
+	// validating them.
+	LibraryPropertiesSet(context.Context, *LibraryPropertiesSetRequest) (*LibraryPropertiesSetResponse, error)
+	// Open a monitor connection to a board port. The connection is paused while
+	// an Upload or a BurnBootloader is running on the same port.
 	Monitor(ArduinoCoreService_MonitorServer) error
 	// Returns the parameters that can be set in the MonitorRequest calls
 	EnumerateMonitorPortSettings(context.Context, *EnumerateMonitorPortSettingsRequest) (*EnumerateMonitorPortSettingsResponse, error)
+	// Open a raw connection to a board port, with explicit flow control in both
+	// directions, for the clients implementing binary protocols (e.g. firmware
+	// updates) through the port. The data is forwarded without any buffering
+	// delay.
+	PortIO(ArduinoCoreService_PortIOServer) error
 	// Start a debug session and communicate with the debugger tool.
 	Debug(ArduinoCoreService_DebugServer) error
 	GetDebugConfig(context.Context, *GetDebugConfigRequest) (*GetDebugConfigResponse, error)
@@ -1134,6 +1801,12 @@ func (UnimplementedArduinoCoreServiceServer) UpdateLibrariesIndex(*UpdateLibrari
 func (UnimplementedArduinoCoreServiceServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) NegotiateApiVersion(context.Context, *NegotiateApiVersionRequest) (*NegotiateApiVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NegotiateApiVersion not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) EnvironmentReport(context.Context, *EnvironmentReportRequest) (*EnvironmentReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnvironmentReport not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) NewSketch(context.Context, *NewSketchRequest) (*NewSketchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewSketch not implemented")
 }
@@ -1146,9 +1819,27 @@ func (UnimplementedArduinoCoreServiceServer) ArchiveSketch(context.Context, *Arc
 func (UnimplementedArduinoCoreServiceServer) SetSketchDefaults(context.Context, *SetSketchDefaultsRequest) (*SetSketchDefaultsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSketchDefaults not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) ImportPlatformIOProject(context.Context, *ImportPlatformIOProjectRequest) (*ImportPlatformIOProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPlatformIOProject not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) MoveSketch(context.Context, *MoveSketchRequest) (*MoveSketchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveSketch not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) ConvertSketchToCpp(context.Context, *ConvertSketchToCppRequest) (*ConvertSketchToCppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertSketchToCpp not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) BoardDetails(context.Context, *BoardDetailsRequest) (*BoardDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardDetails not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) BoardOptions(context.Context, *BoardOptionsRequest) (*BoardOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardOptions not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) BoardDefine(context.Context, *BoardDefineRequest) (*BoardDefineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardDefine not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) BoardSymbols(context.Context, *BoardSymbolsRequest) (*BoardSymbolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardSymbols not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) BoardList(context.Context, *BoardListRequest) (*BoardListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardList not implemented")
 }
@@ -1161,9 +1852,24 @@ func (UnimplementedArduinoCoreServiceServer) BoardSearch(context.Context, *Board
 func (UnimplementedArduinoCoreServiceServer) BoardListWatch(*BoardListWatchRequest, ArduinoCoreService_BoardListWatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method BoardListWatch not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) BoardEEPROMRead(*BoardEEPROMReadRequest, ArduinoCoreService_BoardEEPROMReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method BoardEEPROMRead not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) BoardEEPROMWrite(*BoardEEPROMWriteRequest, ArduinoCoreService_BoardEEPROMWriteServer) error {
+	return status.Errorf(codes.Unimplemented, "method BoardEEPROMWrite not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) BoardNVSRead(*BoardNVSReadRequest, ArduinoCoreService_BoardNVSReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method BoardNVSRead not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Compile(*CompileRequest, ArduinoCoreService_CompileServer) error {
 	return status.Errorf(codes.Unimplemented, "method Compile not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) PrecompileCore(*PrecompileCoreRequest, ArduinoCoreService_PrecompileCoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method PrecompileCore not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) GetCompilerFragment(context.Context, *GetCompilerFragmentRequest) (*GetCompilerFragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompilerFragment not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) PlatformInstall(*PlatformInstallRequest, ArduinoCoreService_PlatformInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformInstall not implemented")
 }
@@ -1176,12 +1882,24 @@ func (UnimplementedArduinoCoreServiceServer) PlatformUninstall(*PlatformUninstal
 func (UnimplementedArduinoCoreServiceServer) PlatformUpgrade(*PlatformUpgradeRequest, ArduinoCoreService_PlatformUpgradeServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformUpgrade not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) UpgradePlan(context.Context, *UpgradePlanRequest) (*UpgradePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePlan not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) UpgradeApply(*UpgradeApplyRequest, ArduinoCoreService_UpgradeApplyServer) error {
+	return status.Errorf(codes.Unimplemented, "method UpgradeApply not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Upload(*UploadRequest, ArduinoCoreService_UploadServer) error {
 	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
 func (UnimplementedArduinoCoreServiceServer) UploadUsingProgrammer(*UploadUsingProgrammerRequest, ArduinoCoreService_UploadUsingProgrammerServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadUsingProgrammer not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) FilesystemImageBuild(*FilesystemImageBuildRequest, ArduinoCoreService_FilesystemImageBuildServer) error {
+	return status.Errorf(codes.Unimplemented, "method FilesystemImageBuild not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) FilesystemImageUpload(*FilesystemImageUploadRequest, ArduinoCoreService_FilesystemImageUploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method FilesystemImageUpload not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) SupportedUserFields(context.Context, *SupportedUserFieldsRequest) (*SupportedUserFieldsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupportedUserFields not implemented")
 }
@@ -1194,6 +1912,9 @@ func (UnimplementedArduinoCoreServiceServer) BurnBootloader(*BurnBootloaderReque
 func (UnimplementedArduinoCoreServiceServer) PlatformSearch(context.Context, *PlatformSearchRequest) (*PlatformSearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlatformSearch not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) PlatformPreload(context.Context, *PlatformPreloadRequest) (*PlatformPreloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformPreload not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) LibraryDownload(*LibraryDownloadRequest, ArduinoCoreService_LibraryDownloadServer) error {
 	return status.Errorf(codes.Unimplemented, "method LibraryDownload not implemented")
 }
@@ -1224,12 +1945,39 @@ func (UnimplementedArduinoCoreServiceServer) LibrarySearch(context.Context, *Lib
 func (UnimplementedArduinoCoreServiceServer) LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LibraryList not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) LibraryUsage(context.Context, *LibraryUsageRequest) (*LibraryUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryUsage not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryListConflicts(context.Context, *LibraryListConflictsRequest) (*LibraryListConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryListConflicts not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryQuery(context.Context, *LibraryQueryRequest) (*LibraryQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryQuery not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryResolveSymbol(context.Context, *LibraryResolveSymbolRequest) (*LibraryResolveSymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryResolveSymbol not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryPrecompile(*LibraryPrecompileRequest, ArduinoCoreService_LibraryPrecompileServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryPrecompile not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryCheckExamples(*LibraryCheckExamplesRequest, ArduinoCoreService_LibraryCheckExamplesServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryCheckExamples not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryPropertiesGet(context.Context, *LibraryPropertiesGetRequest) (*LibraryPropertiesGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryPropertiesGet not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryPropertiesSet(context.Context, *LibraryPropertiesSetRequest) (*LibraryPropertiesSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryPropertiesSet not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Monitor(ArduinoCoreService_MonitorServer) error {
 	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
 }
 func (UnimplementedArduinoCoreServiceServer) EnumerateMonitorPortSettings(context.Context, *EnumerateMonitorPortSettingsRequest) (*EnumerateMonitorPortSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnumerateMonitorPortSettings not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) PortIO(ArduinoCoreService_PortIOServer) error {
+	return status.Errorf(codes.Unimplemented, "method PortIO not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Debug(ArduinoCoreService_DebugServer) error {
 	return status.Errorf(codes.Unimplemented, "method Debug not implemented")
 }
@@ -1366,6 +2114,42 @@ func _ArduinoCoreService_Version_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_NegotiateApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NegotiateApiVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).NegotiateApiVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_NegotiateApiVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).NegotiateApiVersion(ctx, req.(*NegotiateApiVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_EnvironmentReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnvironmentReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).EnvironmentReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_EnvironmentReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).EnvironmentReport(ctx, req.(*EnvironmentReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_NewSketch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NewSketchRequest)
 	if err := dec(in); err != nil {
@@ -1438,6 +2222,60 @@ func _ArduinoCoreService_SetSketchDefaults_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_ImportPlatformIOProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPlatformIOProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).ImportPlatformIOProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_ImportPlatformIOProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).ImportPlatformIOProject(ctx, req.(*ImportPlatformIOProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_MoveSketch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveSketchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).MoveSketch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_MoveSketch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).MoveSketch(ctx, req.(*MoveSketchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_ConvertSketchToCpp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertSketchToCppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).ConvertSketchToCpp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_ConvertSketchToCpp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).ConvertSketchToCpp(ctx, req.(*ConvertSketchToCppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_BoardDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoardDetailsRequest)
 	if err := dec(in); err != nil {
@@ -1456,6 +2294,60 @@ func _ArduinoCoreService_BoardDetails_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_BoardOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).BoardOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_BoardOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).BoardOptions(ctx, req.(*BoardOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_BoardDefine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardDefineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).BoardDefine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_BoardDefine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).BoardDefine(ctx, req.(*BoardDefineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_BoardSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).BoardSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_BoardSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).BoardSymbols(ctx, req.(*BoardSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_BoardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoardListRequest)
 	if err := dec(in); err != nil {
@@ -1531,6 +2423,69 @@ func (x *arduinoCoreServiceBoardListWatchServer) Send(m *BoardListWatchResponse)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_BoardEEPROMRead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BoardEEPROMReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).BoardEEPROMRead(m, &arduinoCoreServiceBoardEEPROMReadServer{stream})
+}
+
+type ArduinoCoreService_BoardEEPROMReadServer interface {
+	Send(*BoardEEPROMReadResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceBoardEEPROMReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceBoardEEPROMReadServer) Send(m *BoardEEPROMReadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_BoardEEPROMWrite_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BoardEEPROMWriteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).BoardEEPROMWrite(m, &arduinoCoreServiceBoardEEPROMWriteServer{stream})
+}
+
+type ArduinoCoreService_BoardEEPROMWriteServer interface {
+	Send(*BoardEEPROMWriteResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceBoardEEPROMWriteServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceBoardEEPROMWriteServer) Send(m *BoardEEPROMWriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_BoardNVSRead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BoardNVSReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).BoardNVSRead(m, &arduinoCoreServiceBoardNVSReadServer{stream})
+}
+
+type ArduinoCoreService_BoardNVSReadServer interface {
+	Send(*BoardNVSReadResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceBoardNVSReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceBoardNVSReadServer) Send(m *BoardNVSReadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_Compile_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(CompileRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1552,6 +2507,45 @@ func (x *arduinoCoreServiceCompileServer) Send(m *CompileResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_PrecompileCore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PrecompileCoreRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).PrecompileCore(m, &arduinoCoreServicePrecompileCoreServer{stream})
+}
+
+type ArduinoCoreService_PrecompileCoreServer interface {
+	Send(*PrecompileCoreResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServicePrecompileCoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServicePrecompileCoreServer) Send(m *PrecompileCoreResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_GetCompilerFragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompilerFragmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).GetCompilerFragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_GetCompilerFragment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).GetCompilerFragment(ctx, req.(*GetCompilerFragmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_PlatformInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformInstallRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1636,6 +2630,45 @@ func (x *arduinoCoreServicePlatformUpgradeServer) Send(m *PlatformUpgradeRespons
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_UpgradePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).UpgradePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_UpgradePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).UpgradePlan(ctx, req.(*UpgradePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_UpgradeApply_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UpgradeApplyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).UpgradeApply(m, &arduinoCoreServiceUpgradeApplyServer{stream})
+}
+
+type ArduinoCoreService_UpgradeApplyServer interface {
+	Send(*UpgradeApplyResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceUpgradeApplyServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceUpgradeApplyServer) Send(m *UpgradeApplyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(UploadRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1678,6 +2711,48 @@ func (x *arduinoCoreServiceUploadUsingProgrammerServer) Send(m *UploadUsingProgr
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_FilesystemImageBuild_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FilesystemImageBuildRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).FilesystemImageBuild(m, &arduinoCoreServiceFilesystemImageBuildServer{stream})
+}
+
+type ArduinoCoreService_FilesystemImageBuildServer interface {
+	Send(*FilesystemImageBuildResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceFilesystemImageBuildServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceFilesystemImageBuildServer) Send(m *FilesystemImageBuildResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_FilesystemImageUpload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FilesystemImageUploadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).FilesystemImageUpload(m, &arduinoCoreServiceFilesystemImageUploadServer{stream})
+}
+
+type ArduinoCoreService_FilesystemImageUploadServer interface {
+	Send(*FilesystemImageUploadResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceFilesystemImageUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceFilesystemImageUploadServer) Send(m *FilesystemImageUploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_SupportedUserFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SupportedUserFieldsRequest)
 	if err := dec(in); err != nil {
@@ -1753,6 +2828,24 @@ func _ArduinoCoreService_PlatformSearch_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_PlatformPreload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformPreloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).PlatformPreload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_PlatformPreload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).PlatformPreload(ctx, req.(*PlatformPreloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_LibraryDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(LibraryDownloadRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1954,6 +3047,156 @@ func _ArduinoCoreService_LibraryList_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_LibraryUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_LibraryUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryUsage(ctx, req.(*LibraryUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_LibraryListConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryListConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryListConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_LibraryListConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryListConflicts(ctx, req.(*LibraryListConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_LibraryQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_LibraryQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryQuery(ctx, req.(*LibraryQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_LibraryResolveSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryResolveSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryResolveSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_LibraryResolveSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryResolveSymbol(ctx, req.(*LibraryResolveSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_LibraryPrecompile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryPrecompileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).LibraryPrecompile(m, &arduinoCoreServiceLibraryPrecompileServer{stream})
+}
+
+type ArduinoCoreService_LibraryPrecompileServer interface {
+	Send(*LibraryPrecompileResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceLibraryPrecompileServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceLibraryPrecompileServer) Send(m *LibraryPrecompileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_LibraryCheckExamples_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryCheckExamplesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).LibraryCheckExamples(m, &arduinoCoreServiceLibraryCheckExamplesServer{stream})
+}
+
+type ArduinoCoreService_LibraryCheckExamplesServer interface {
+	Send(*LibraryCheckExamplesResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceLibraryCheckExamplesServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceLibraryCheckExamplesServer) Send(m *LibraryCheckExamplesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_LibraryPropertiesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryPropertiesGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryPropertiesGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_LibraryPropertiesGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryPropertiesGet(ctx, req.(*LibraryPropertiesGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_LibraryPropertiesSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryPropertiesSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryPropertiesSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCoreService_LibraryPropertiesSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryPropertiesSet(ctx, req.(*LibraryPropertiesSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ArduinoCoreServiceServer).Monitor(&arduinoCoreServiceMonitorServer{stream})
 }
@@ -1998,6 +3241,32 @@ func _ArduinoCoreService_EnumerateMonitorPortSettings_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_PortIO_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ArduinoCoreServiceServer).PortIO(&arduinoCoreServicePortIOServer{stream})
+}
+
+type ArduinoCoreService_PortIOServer interface {
+	Send(*PortIOResponse) error
+	Recv() (*PortIORequest, error)
+	grpc.ServerStream
+}
+
+type arduinoCoreServicePortIOServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServicePortIOServer) Send(m *PortIOResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *arduinoCoreServicePortIOServer) Recv() (*PortIORequest, error) {
+	m := new(PortIORequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _ArduinoCoreService_Debug_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ArduinoCoreServiceServer).Debug(&arduinoCoreServiceDebugServer{stream})
 }
@@ -2061,6 +3330,14 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _ArduinoCoreService_Version_Handler,
 		},
+		{
+			MethodName: "NegotiateApiVersion",
+			Handler:    _ArduinoCoreService_NegotiateApiVersion_Handler,
+		},
+		{
+			MethodName: "EnvironmentReport",
+			Handler:    _ArduinoCoreService_EnvironmentReport_Handler,
+		},
 		{
 			MethodName: "NewSketch",
 			Handler:    _ArduinoCoreService_NewSketch_Handler,
@@ -2077,10 +3354,34 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSketchDefaults",
 			Handler:    _ArduinoCoreService_SetSketchDefaults_Handler,
 		},
+		{
+			MethodName: "ImportPlatformIOProject",
+			Handler:    _ArduinoCoreService_ImportPlatformIOProject_Handler,
+		},
+		{
+			MethodName: "MoveSketch",
+			Handler:    _ArduinoCoreService_MoveSketch_Handler,
+		},
+		{
+			MethodName: "ConvertSketchToCpp",
+			Handler:    _ArduinoCoreService_ConvertSketchToCpp_Handler,
+		},
 		{
 			MethodName: "BoardDetails",
 			Handler:    _ArduinoCoreService_BoardDetails_Handler,
 		},
+		{
+			MethodName: "BoardOptions",
+			Handler:    _ArduinoCoreService_BoardOptions_Handler,
+		},
+		{
+			MethodName: "BoardDefine",
+			Handler:    _ArduinoCoreService_BoardDefine_Handler,
+		},
+		{
+			MethodName: "BoardSymbols",
+			Handler:    _ArduinoCoreService_BoardSymbols_Handler,
+		},
 		{
 			MethodName: "BoardList",
 			Handler:    _ArduinoCoreService_BoardList_Handler,
@@ -2093,6 +3394,14 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BoardSearch",
 			Handler:    _ArduinoCoreService_BoardSearch_Handler,
 		},
+		{
+			MethodName: "GetCompilerFragment",
+			Handler:    _ArduinoCoreService_GetCompilerFragment_Handler,
+		},
+		{
+			MethodName: "UpgradePlan",
+			Handler:    _ArduinoCoreService_UpgradePlan_Handler,
+		},
 		{
 			MethodName: "SupportedUserFields",
 			Handler:    _ArduinoCoreService_SupportedUserFields_Handler,
@@ -2105,6 +3414,10 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlatformSearch",
 			Handler:    _ArduinoCoreService_PlatformSearch_Handler,
 		},
+		{
+			MethodName: "PlatformPreload",
+			Handler:    _ArduinoCoreService_PlatformPreload_Handler,
+		},
 		{
 			MethodName: "LibraryResolveDependencies",
 			Handler:    _ArduinoCoreService_LibraryResolveDependencies_Handler,
@@ -2117,6 +3430,30 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LibraryList",
 			Handler:    _ArduinoCoreService_LibraryList_Handler,
 		},
+		{
+			MethodName: "LibraryUsage",
+			Handler:    _ArduinoCoreService_LibraryUsage_Handler,
+		},
+		{
+			MethodName: "LibraryListConflicts",
+			Handler:    _ArduinoCoreService_LibraryListConflicts_Handler,
+		},
+		{
+			MethodName: "LibraryQuery",
+			Handler:    _ArduinoCoreService_LibraryQuery_Handler,
+		},
+		{
+			MethodName: "LibraryResolveSymbol",
+			Handler:    _ArduinoCoreService_LibraryResolveSymbol_Handler,
+		},
+		{
+			MethodName: "LibraryPropertiesGet",
+			Handler:    _ArduinoCoreService_LibraryPropertiesGet_Handler,
+		},
+		{
+			MethodName: "LibraryPropertiesSet",
+			Handler:    _ArduinoCoreService_LibraryPropertiesSet_Handler,
+		},
 		{
 			MethodName: "EnumerateMonitorPortSettings",
 			Handler:    _ArduinoCoreService_EnumerateMonitorPortSettings_Handler,
@@ -2147,11 +3484,31 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_BoardListWatch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BoardEEPROMRead",
+			Handler:       _ArduinoCoreService_BoardEEPROMRead_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BoardEEPROMWrite",
+			Handler:       _ArduinoCoreService_BoardEEPROMWrite_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BoardNVSRead",
+			Handler:       _ArduinoCoreService_BoardNVSRead_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Compile",
 			Handler:       _ArduinoCoreService_Compile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PrecompileCore",
+			Handler:       _ArduinoCoreService_PrecompileCore_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "PlatformInstall",
 			Handler:       _ArduinoCoreService_PlatformInstall_Handler,
@@ -2172,6 +3529,11 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_PlatformUpgrade_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "UpgradeApply",
+			Handler:       _ArduinoCoreService_UpgradeApply_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Upload",
 			Handler:       _ArduinoCoreService_Upload_Handler,
@@ -2182,6 +3544,16 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_UploadUsingProgrammer_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "FilesystemImageBuild",
+			Handler:       _ArduinoCoreService_FilesystemImageBuild_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FilesystemImageUpload",
+			Handler:       _ArduinoCoreService_FilesystemImageUpload_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "BurnBootloader",
 			Handler:       _ArduinoCoreService_BurnBootloader_Handler,
@@ -2222,12 +3594,28 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_LibraryUpgradeAll_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "LibraryPrecompile",
+			Handler:       _ArduinoCoreService_LibraryPrecompile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LibraryCheckExamples",
+			Handler:       _ArduinoCoreService_LibraryCheckExamples_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Monitor",
 			Handler:       _ArduinoCoreService_Monitor_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PortIO",
+			Handler:       _ArduinoCoreService_PortIO_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Debug",
 			Handler:       _ArduinoCoreService_Debug_Handler,
@@ -66,7 +66,7 @@ type CompileRequest struct {
 	Warnings string `protobuf:"bytes,9,opt,name=warnings,proto3" json:"warnings,omitempty"`
 	// Turns on verbose mode.
 	Verbose bool `protobuf:"varint,10,opt,name=verbose,proto3" json:"verbose,omitempty"`
-	// Suppresses almost every output.
+	// Prints only the warnings, the errors and the size summary of the build.
 	Quiet bool `protobuf:"varint,11,opt,name=quiet,proto3" json:"quiet,omitempty"`
 	// The max number of concurrent compiler instances to run (as `make -jx`).
 	// If jobs is set to 0, it will use the number of available CPUs as the
@@ -111,6 +111,54 @@ type CompileRequest struct {
 	// If set to true the returned build properties will be left unexpanded, with
 	// the variables placeholders exactly as defined in the platform.
 	DoNotExpandBuildProperties bool `protobuf:"varint,29,opt,name=do_not_expand_build_properties,json=doNotExpandBuildProperties,proto3" json:"do_not_expand_build_properties,omitempty"`
+	// If set to true the response will contain the wall time spent in each
+	// phase of the build and in the compilation of each source file.
+	ReportTimings bool `protobuf:"varint,30,opt,name=report_timings,json=reportTimings,proto3" json:"report_timings,omitempty"`
+	// Optional: the path of the ELF or map file of a previous build to compare
+	// the sections and symbols sizes of the current build with.
+	CompareWith string `protobuf:"bytes,31,opt,name=compare_with,json=compareWith,proto3" json:"compare_with,omitempty"`
+	// If set to true the response will contain the libraries and the object
+	// files that contributed nothing to the executable and the sketch functions
+	// removed by the linker.
+	ReportUnusedCode bool `protobuf:"varint,32,opt,name=report_unused_code,json=reportUnusedCode,proto3" json:"report_unused_code,omitempty"`
+	// If set to true the response will contain the placement of the sections
+	// and of the symbols in the memory of the board.
+	ReportMemoryLayout bool `protobuf:"varint,33,opt,name=report_memory_layout,json=reportMemoryLayout,proto3" json:"report_memory_layout,omitempty"`
+	// Optional: the name of the build configuration of the sketch project file
+	// to use (e.g. `debug` or `release`). If empty the default configuration
+	// of the project file, if any, is used.
+	Configuration string `protobuf:"bytes,34,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// Optional: generate a standalone build system that reproduces the build
+	// with the resolved toolchain, flags and sources. The supported values are
+	// `cmake` and `make`.
+	ExportBuildSystem string `protobuf:"bytes,35,opt,name=export_build_system,json=exportBuildSystem,proto3" json:"export_build_system,omitempty"`
+	// Optional: upload the build artifacts and a manifest of the build to these
+	// destinations. Each destination is the name of a publisher of the
+	// configuration or a URL template (`http`, `https` or `s3://bucket/key`)
+	// with the placeholders `{sketch}`, `{fqbn}`, `{configuration}`, `{date}`,
+	// `{timestamp}` and `{file}`.
+	PublishTo []string `protobuf:"bytes,36,rep,name=publish_to,json=publishTo,proto3" json:"publish_to,omitempty"`
+	// Optional: the level of detail of the verbose output, from 1 to 3, used
+	// when `verbose` is set. Defaults to 1.
+	VerbosityLevel int32 `protobuf:"varint,37,opt,name=verbosity_level,json=verbosityLevel,proto3" json:"verbosity_level,omitempty"`
+	// Optional: print the verbose output, when `verbose` is set, only during
+	// these phases of the build: `libraries-detection`,
+	// `prototype-generation`, `sketch`, `libraries`, `core`, `link`, `objcopy`
+	// and `size`.
+	VerbosePhases []string `protobuf:"bytes,38,rep,name=verbose_phases,json=verbosePhases,proto3" json:"verbose_phases,omitempty"`
+	// If set to true the compilation fails when the sketch uses libraries that
+	// don't declare the architecture of the board, unless they are accepted in
+	// the `accept_incompatible` list of the sketch project file.
+	FailOnIncompatibleLibraries bool `protobuf:"varint,39,opt,name=fail_on_incompatible_libraries,json=failOnIncompatibleLibraries,proto3" json:"fail_on_incompatible_libraries,omitempty"`
+	// A zip archive of the sketch folder, as created by `ArchiveSketch`, to
+	// build a sketch that the daemon can't access (for example on a remote
+	// build server). The sketch is extracted in a temporary folder and built in
+	// place of `sketch_path`, with a temporary instance initialized with the
+	// `profile` of the sketch project file (if set). The artifacts of the build
+	// are sent back in the `artifact` field of the response.
+	SketchArchive []byte `protobuf:"bytes,40,opt,name=sketch_archive,json=sketchArchive,proto3" json:"sketch_archive,omitempty"`
+	// The profile of the sketch project file used to build the `sketch_archive`.
+	Profile string `protobuf:"bytes,41,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
 func (x *CompileRequest) Reset() {
@@ -320,6 +368,90 @@ func (x *CompileRequest) GetDoNotExpandBuildProperties() bool {
 	return false
 }
 
+func (x *CompileRequest) GetReportTimings() bool {
+	if x != nil {
+		return x.ReportTimings
+	}
+	return false
+}
+
+func (x *CompileRequest) GetCompareWith() string {
+	if x != nil {
+		return x.CompareWith
+	}
+	return ""
+}
+
+func (x *CompileRequest) GetReportUnusedCode() bool {
+	if x != nil {
+		return x.ReportUnusedCode
+	}
+	return false
+}
+
+func (x *CompileRequest) GetReportMemoryLayout() bool {
+	if x != nil {
+		return x.ReportMemoryLayout
+	}
+	return false
+}
+
+func (x *CompileRequest) GetConfiguration() string {
+	if x != nil {
+		return x.Configuration
+	}
+	return ""
+}
+
+func (x *CompileRequest) GetExportBuildSystem() string {
+	if x != nil {
+		return x.ExportBuildSystem
+	}
+	return ""
+}
+
+func (x *CompileRequest) GetPublishTo() []string {
+	if x != nil {
+		return x.PublishTo
+	}
+	return nil
+}
+
+func (x *CompileRequest) GetVerbosityLevel() int32 {
+	if x != nil {
+		return x.VerbosityLevel
+	}
+	return 0
+}
+
+func (x *CompileRequest) GetVerbosePhases() []string {
+	if x != nil {
+		return x.VerbosePhases
+	}
+	return nil
+}
+
+func (x *CompileRequest) GetFailOnIncompatibleLibraries() bool {
+	if x != nil {
+		return x.FailOnIncompatibleLibraries
+	}
+	return false
+}
+
+func (x *CompileRequest) GetSketchArchive() []byte {
+	if x != nil {
+		return x.SketchArchive
+	}
+	return nil
+}
+
+func (x *CompileRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
 type CompileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -343,6 +475,37 @@ type CompileResponse struct {
 	Progress *TaskProgress `protobuf:"bytes,8,opt,name=progress,proto3" json:"progress,omitempty"`
 	// Build properties used for compiling
 	BuildProperties []string `protobuf:"bytes,9,rep,name=build_properties,json=buildProperties,proto3" json:"build_properties,omitempty"`
+	// The time spent in each phase of the build (only if `report_timings` was
+	// set in the request)
+	Timings *BuildTimings `protobuf:"bytes,10,opt,name=timings,proto3" json:"timings,omitempty"`
+	// Groups of files, in the sketch or in the libraries used, with names
+	// differing only by case. These files collide on case-insensitive file
+	// systems.
+	FileNameCaseCollisions []*FileNameCaseCollision `protobuf:"bytes,11,rep,name=file_name_case_collisions,json=fileNameCaseCollisions,proto3" json:"file_name_case_collisions,omitempty"`
+	// The changes of the sections and symbols sizes compared to a previous build
+	// (only if `compare_with` was set in the request)
+	SizeDiff *SizeDiff `protobuf:"bytes,12,opt,name=size_diff,json=sizeDiff,proto3" json:"size_diff,omitempty"`
+	// The code that didn't end up in the executable (only if
+	// `report_unused_code` was set in the request)
+	UnusedCode *UnusedCodeReport `protobuf:"bytes,13,opt,name=unused_code,json=unusedCode,proto3" json:"unused_code,omitempty"`
+	// The placement of the sections and of the symbols in the memory of the
+	// board (only if `report_memory_layout` was set in the request)
+	MemoryLayout *MemoryLayout `protobuf:"bytes,14,opt,name=memory_layout,json=memoryLayout,proto3" json:"memory_layout,omitempty"`
+	// The build configuration of the sketch project file used for the build,
+	// empty if none.
+	Configuration string `protobuf:"bytes,15,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// The directory containing the generated build system, if requested.
+	BuildSystemPath string `protobuf:"bytes,16,opt,name=build_system_path,json=buildSystemPath,proto3" json:"build_system_path,omitempty"`
+	// The artifacts uploaded to the destinations of `publish_to`.
+	PublishedArtifacts []*PublishedArtifact `protobuf:"bytes,17,rep,name=published_artifacts,json=publishedArtifacts,proto3" json:"published_artifacts,omitempty"`
+	// The advisories of the advisories feeds affecting the libraries and the
+	// platforms used in the build.
+	Advisories []*Advisory `protobuf:"bytes,18,rep,name=advisories,proto3" json:"advisories,omitempty"`
+	// The libraries used by the sketch that don't declare the architecture of
+	// the board.
+	IncompatibleLibraries []*IncompatibleLibrary `protobuf:"bytes,19,rep,name=incompatible_libraries,json=incompatibleLibraries,proto3" json:"incompatible_libraries,omitempty"`
+	// An artifact of the build of the `sketch_archive` (stream)
+	Artifact *BuildArtifact `protobuf:"bytes,20,opt,name=artifact,proto3" json:"artifact,omitempty"`
 }
 
 func (x *CompileResponse) Reset() {
@@ -426,47 +589,1439 @@ func (x *CompileResponse) GetBuildPlatform() *InstalledPlatformReference {
 	return nil
 }
 
-func (x *CompileResponse) GetProgress() *TaskProgress {
+func (x *CompileResponse) GetProgress() *TaskProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetBuildProperties() []string {
+	if x != nil {
+		return x.BuildProperties
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetTimings() *BuildTimings {
+	if x != nil {
+		return x.Timings
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetFileNameCaseCollisions() []*FileNameCaseCollision {
+	if x != nil {
+		return x.FileNameCaseCollisions
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetSizeDiff() *SizeDiff {
+	if x != nil {
+		return x.SizeDiff
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetUnusedCode() *UnusedCodeReport {
+	if x != nil {
+		return x.UnusedCode
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetMemoryLayout() *MemoryLayout {
+	if x != nil {
+		return x.MemoryLayout
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetConfiguration() string {
+	if x != nil {
+		return x.Configuration
+	}
+	return ""
+}
+
+func (x *CompileResponse) GetBuildSystemPath() string {
+	if x != nil {
+		return x.BuildSystemPath
+	}
+	return ""
+}
+
+func (x *CompileResponse) GetPublishedArtifacts() []*PublishedArtifact {
+	if x != nil {
+		return x.PublishedArtifacts
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetAdvisories() []*Advisory {
+	if x != nil {
+		return x.Advisories
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetIncompatibleLibraries() []*IncompatibleLibrary {
+	if x != nil {
+		return x.IncompatibleLibraries
+	}
+	return nil
+}
+
+func (x *CompileResponse) GetArtifact() *BuildArtifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+type BuildArtifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the file
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The content of the file
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BuildArtifact) Reset() {
+	*x = BuildArtifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildArtifact) ProtoMessage() {}
+
+func (x *BuildArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildArtifact.ProtoReflect.Descriptor instead.
+func (*BuildArtifact) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BuildArtifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildArtifact) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type IncompatibleLibrary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the library
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The architectures declared by the library
+	Architectures []string `protobuf:"bytes,2,rep,name=architectures,proto3" json:"architectures,omitempty"`
+	// The architectures of the board, including the ones set in
+	// `architecture.override_check`
+	BoardArchitectures []string `protobuf:"bytes,3,rep,name=board_architectures,json=boardArchitectures,proto3" json:"board_architectures,omitempty"`
+	// True if the library is accepted in the `accept_incompatible` list of the
+	// sketch project file
+	Accepted bool `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (x *IncompatibleLibrary) Reset() {
+	*x = IncompatibleLibrary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncompatibleLibrary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncompatibleLibrary) ProtoMessage() {}
+
+func (x *IncompatibleLibrary) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncompatibleLibrary.ProtoReflect.Descriptor instead.
+func (*IncompatibleLibrary) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IncompatibleLibrary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IncompatibleLibrary) GetArchitectures() []string {
+	if x != nil {
+		return x.Architectures
+	}
+	return nil
+}
+
+func (x *IncompatibleLibrary) GetBoardArchitectures() []string {
+	if x != nil {
+		return x.BoardArchitectures
+	}
+	return nil
+}
+
+func (x *IncompatibleLibrary) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type PublishedArtifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the artifact file.
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// The URL where the artifact has been uploaded.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *PublishedArtifact) Reset() {
+	*x = PublishedArtifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishedArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishedArtifact) ProtoMessage() {}
+
+func (x *PublishedArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishedArtifact.ProtoReflect.Descriptor instead.
+func (*PublishedArtifact) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PublishedArtifact) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *PublishedArtifact) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type FileNameCaseCollision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The paths of the colliding files
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *FileNameCaseCollision) Reset() {
+	*x = FileNameCaseCollision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileNameCaseCollision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileNameCaseCollision) ProtoMessage() {}
+
+func (x *FileNameCaseCollision) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileNameCaseCollision.ProtoReflect.Descriptor instead.
+func (*FileNameCaseCollision) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FileNameCaseCollision) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type ExecutableSectionSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size    int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	MaxSize int64  `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+}
+
+func (x *ExecutableSectionSize) Reset() {
+	*x = ExecutableSectionSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutableSectionSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutableSectionSize) ProtoMessage() {}
+
+func (x *ExecutableSectionSize) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutableSectionSize.ProtoReflect.Descriptor instead.
+func (*ExecutableSectionSize) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExecutableSectionSize) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecutableSectionSize) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExecutableSectionSize) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+type BuildTimings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time spent in each phase of the build, in execution order.
+	Phases []*BuildPhaseTiming `protobuf:"bytes,1,rep,name=phases,proto3" json:"phases,omitempty"`
+	// The time spent compiling each source file, in completion order.
+	Files []*BuildFileTiming `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	// The results of the compilations run through ccache (only if the
+	// `build.use_ccache` setting is enabled and ccache reports them).
+	Ccache *CcacheStatistics `protobuf:"bytes,3,opt,name=ccache,proto3" json:"ccache,omitempty"`
+}
+
+func (x *BuildTimings) Reset() {
+	*x = BuildTimings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildTimings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildTimings) ProtoMessage() {}
+
+func (x *BuildTimings) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildTimings.ProtoReflect.Descriptor instead.
+func (*BuildTimings) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BuildTimings) GetPhases() []*BuildPhaseTiming {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *BuildTimings) GetFiles() []*BuildFileTiming {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *BuildTimings) GetCcache() *CcacheStatistics {
+	if x != nil {
+		return x.Ccache
+	}
+	return nil
+}
+
+type CcacheStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of compilations found in the cache.
+	Hits int32 `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	// The number of compilations not found in the cache.
+	Misses int32 `protobuf:"varint,2,opt,name=misses,proto3" json:"misses,omitempty"`
+	// The number of compilations that ccache could not cache.
+	Uncacheable int32 `protobuf:"varint,3,opt,name=uncacheable,proto3" json:"uncacheable,omitempty"`
+}
+
+func (x *CcacheStatistics) Reset() {
+	*x = CcacheStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CcacheStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CcacheStatistics) ProtoMessage() {}
+
+func (x *CcacheStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CcacheStatistics.ProtoReflect.Descriptor instead.
+func (*CcacheStatistics) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CcacheStatistics) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CcacheStatistics) GetMisses() int32 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CcacheStatistics) GetUncacheable() int32 {
+	if x != nil {
+		return x.Uncacheable
+	}
+	return 0
+}
+
+type BuildPhaseTiming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the build phase (e.g. `prototype-generation`, `core`, `link`).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The wall time spent in the phase, in milliseconds.
+	DurationMs int64 `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *BuildPhaseTiming) Reset() {
+	*x = BuildPhaseTiming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildPhaseTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildPhaseTiming) ProtoMessage() {}
+
+func (x *BuildPhaseTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildPhaseTiming.ProtoReflect.Descriptor instead.
+func (*BuildPhaseTiming) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BuildPhaseTiming) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildPhaseTiming) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type BuildFileTiming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the compiled source file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The wall time spent compiling the file, in milliseconds.
+	DurationMs int64 `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// True if the object file was up to date and the compilation was skipped.
+	UpToDate bool `protobuf:"varint,3,opt,name=up_to_date,json=upToDate,proto3" json:"up_to_date,omitempty"`
+}
+
+func (x *BuildFileTiming) Reset() {
+	*x = BuildFileTiming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildFileTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFileTiming) ProtoMessage() {}
+
+func (x *BuildFileTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFileTiming.ProtoReflect.Descriptor instead.
+func (*BuildFileTiming) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BuildFileTiming) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *BuildFileTiming) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *BuildFileTiming) GetUpToDate() bool {
+	if x != nil {
+		return x.UpToDate
+	}
+	return false
+}
+
+type SizeDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ELF or map file of the previous build.
+	ComparedWith string `protobuf:"bytes,1,opt,name=compared_with,json=comparedWith,proto3" json:"compared_with,omitempty"`
+	// The sizes of the sections of the two builds, sorted by name.
+	Sections []*SizeChange `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	// The symbols whose size changed, from the one that grew the most to the
+	// one that shrank the most.
+	Symbols []*SizeChange `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`
+}
+
+func (x *SizeDiff) Reset() {
+	*x = SizeDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SizeDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeDiff) ProtoMessage() {}
+
+func (x *SizeDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeDiff.ProtoReflect.Descriptor instead.
+func (*SizeDiff) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SizeDiff) GetComparedWith() string {
+	if x != nil {
+		return x.ComparedWith
+	}
+	return ""
+}
+
+func (x *SizeDiff) GetSections() []*SizeChange {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *SizeDiff) GetSymbols() []*SizeChange {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type SizeChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the section or of the symbol.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The size in the previous build (0 if missing).
+	OldSize int64 `protobuf:"varint,2,opt,name=old_size,json=oldSize,proto3" json:"old_size,omitempty"`
+	// The size in the current build (0 if missing).
+	NewSize int64 `protobuf:"varint,3,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`
+}
+
+func (x *SizeChange) Reset() {
+	*x = SizeChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SizeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeChange) ProtoMessage() {}
+
+func (x *SizeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeChange.ProtoReflect.Descriptor instead.
+func (*SizeChange) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SizeChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SizeChange) GetOldSize() int64 {
+	if x != nil {
+		return x.OldSize
+	}
+	return 0
+}
+
+func (x *SizeChange) GetNewSize() int64 {
+	if x != nil {
+		return x.NewSize
+	}
+	return 0
+}
+
+type UnusedCodeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The libraries included in the build that contributed nothing to the
+	// executable.
+	UnusedLibraries []string `protobuf:"bytes,1,rep,name=unused_libraries,json=unusedLibraries,proto3" json:"unused_libraries,omitempty"`
+	// The object files, of the sketch or of the libraries, that contributed
+	// nothing to the executable.
+	UnusedObjects []string `protobuf:"bytes,2,rep,name=unused_objects,json=unusedObjects,proto3" json:"unused_objects,omitempty"`
+	// The functions defined in the sketch and removed by the linker.
+	EliminatedFunctions []string `protobuf:"bytes,3,rep,name=eliminated_functions,json=eliminatedFunctions,proto3" json:"eliminated_functions,omitempty"`
+}
+
+func (x *UnusedCodeReport) Reset() {
+	*x = UnusedCodeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnusedCodeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnusedCodeReport) ProtoMessage() {}
+
+func (x *UnusedCodeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnusedCodeReport.ProtoReflect.Descriptor instead.
+func (*UnusedCodeReport) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UnusedCodeReport) GetUnusedLibraries() []string {
+	if x != nil {
+		return x.UnusedLibraries
+	}
+	return nil
+}
+
+func (x *UnusedCodeReport) GetUnusedObjects() []string {
+	if x != nil {
+		return x.UnusedObjects
+	}
+	return nil
+}
+
+func (x *UnusedCodeReport) GetEliminatedFunctions() []string {
+	if x != nil {
+		return x.EliminatedFunctions
+	}
+	return nil
+}
+
+type MemoryLayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The file the layout has been read from, the map file generated by the
+	// linker if available, otherwise the ELF file.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The sections of the executable that take space in the memory of the
+	// board, sorted by address.
+	Sections []*MemorySection `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+}
+
+func (x *MemoryLayout) Reset() {
+	*x = MemoryLayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryLayout) ProtoMessage() {}
+
+func (x *MemoryLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryLayout.ProtoReflect.Descriptor instead.
+func (*MemoryLayout) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MemoryLayout) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MemoryLayout) GetSections() []*MemorySection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type MemorySection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the section (e.g. `.text`, `.data`, `.bss`).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The start address of the section.
+	Address uint64 `protobuf:"varint,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The size of the section in bytes.
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The symbols placed in the section, sorted by address.
+	Symbols []*MemorySymbol `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"`
+}
+
+func (x *MemorySection) Reset() {
+	*x = MemorySection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemorySection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemorySection) ProtoMessage() {}
+
+func (x *MemorySection) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemorySection.ProtoReflect.Descriptor instead.
+func (*MemorySection) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MemorySection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemorySection) GetAddress() uint64 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *MemorySection) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MemorySection) GetSymbols() []*MemorySymbol {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type MemorySymbol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the symbol, or of the input section for the parts of the
+	// section not assigned to a symbol.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address of the symbol.
+	Address uint64 `protobuf:"varint,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The size of the symbol in bytes.
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The object file containing the symbol (only available from a map file).
+	ObjectFile string `protobuf:"bytes,4,opt,name=object_file,json=objectFile,proto3" json:"object_file,omitempty"`
+}
+
+func (x *MemorySymbol) Reset() {
+	*x = MemorySymbol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemorySymbol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemorySymbol) ProtoMessage() {}
+
+func (x *MemorySymbol) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemorySymbol.ProtoReflect.Descriptor instead.
+func (*MemorySymbol) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MemorySymbol) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemorySymbol) GetAddress() uint64 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *MemorySymbol) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MemorySymbol) GetObjectFile() string {
+	if x != nil {
+		return x.ObjectFile
+	}
+	return ""
+}
+
+type PrecompileCoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully Qualified Board Names of the boards to precompile the core for
+	// (e.g., `arduino:avr:uno`).
+	Fqbns []string `protobuf:"bytes,2,rep,name=fqbns,proto3" json:"fqbns,omitempty"`
+	// Precompile the core for all the combinations of the board options not
+	// already set in the FQBNs.
+	AllBoardOptions bool `protobuf:"varint,3,opt,name=all_board_options,json=allBoardOptions,proto3" json:"all_board_options,omitempty"`
+	// Precompile the core with the debug optimization flags.
+	OptimizeForDebug bool `protobuf:"varint,4,opt,name=optimize_for_debug,json=optimizeForDebug,proto3" json:"optimize_for_debug,omitempty"`
+	// The path where the core archives are cached. If empty the default core
+	// cache is used, the same used by `Compile` when no `build_cache_path` is
+	// given.
+	BuildCachePath string `protobuf:"bytes,5,opt,name=build_cache_path,json=buildCachePath,proto3" json:"build_cache_path,omitempty"`
+	// Turns on verbose mode.
+	Verbose bool `protobuf:"varint,6,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (x *PrecompileCoreRequest) Reset() {
+	*x = PrecompileCoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecompileCoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecompileCoreRequest) ProtoMessage() {}
+
+func (x *PrecompileCoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrecompileCoreRequest.ProtoReflect.Descriptor instead.
+func (*PrecompileCoreRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PrecompileCoreRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *PrecompileCoreRequest) GetFqbns() []string {
+	if x != nil {
+		return x.Fqbns
+	}
+	return nil
+}
+
+func (x *PrecompileCoreRequest) GetAllBoardOptions() bool {
+	if x != nil {
+		return x.AllBoardOptions
+	}
+	return false
+}
+
+func (x *PrecompileCoreRequest) GetOptimizeForDebug() bool {
+	if x != nil {
+		return x.OptimizeForDebug
+	}
+	return false
+}
+
+func (x *PrecompileCoreRequest) GetBuildCachePath() string {
+	if x != nil {
+		return x.BuildCachePath
+	}
+	return ""
+}
+
+func (x *PrecompileCoreRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+type PrecompileCoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*PrecompileCoreResponse_OutStream
+	//	*PrecompileCoreResponse_ErrStream
+	//	*PrecompileCoreResponse_Result
+	Message isPrecompileCoreResponse_Message `protobuf_oneof:"message"`
+}
+
+func (x *PrecompileCoreResponse) Reset() {
+	*x = PrecompileCoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecompileCoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecompileCoreResponse) ProtoMessage() {}
+
+func (x *PrecompileCoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrecompileCoreResponse.ProtoReflect.Descriptor instead.
+func (*PrecompileCoreResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *PrecompileCoreResponse) GetMessage() isPrecompileCoreResponse_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *PrecompileCoreResponse) GetOutStream() []byte {
+	if x, ok := x.GetMessage().(*PrecompileCoreResponse_OutStream); ok {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *PrecompileCoreResponse) GetErrStream() []byte {
+	if x, ok := x.GetMessage().(*PrecompileCoreResponse_ErrStream); ok {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *PrecompileCoreResponse) GetResult() *PrecompileCoreResult {
+	if x, ok := x.GetMessage().(*PrecompileCoreResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isPrecompileCoreResponse_Message interface {
+	isPrecompileCoreResponse_Message()
+}
+
+type PrecompileCoreResponse_OutStream struct {
+	// The output of the compilations.
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3,oneof"`
+}
+
+type PrecompileCoreResponse_ErrStream struct {
+	// The error output of the compilations.
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3,oneof"`
+}
+
+type PrecompileCoreResponse_Result struct {
+	// The result of the precompilation.
+	Result *PrecompileCoreResult `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+func (*PrecompileCoreResponse_OutStream) isPrecompileCoreResponse_Message() {}
+
+func (*PrecompileCoreResponse_ErrStream) isPrecompileCoreResponse_Message() {}
+
+func (*PrecompileCoreResponse_Result) isPrecompileCoreResponse_Message() {}
+
+type PrecompileCoreResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The FQBNs for which the core has been precompiled.
+	PrecompiledFqbns []string `protobuf:"bytes,1,rep,name=precompiled_fqbns,json=precompiledFqbns,proto3" json:"precompiled_fqbns,omitempty"`
+}
+
+func (x *PrecompileCoreResult) Reset() {
+	*x = PrecompileCoreResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecompileCoreResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecompileCoreResult) ProtoMessage() {}
+
+func (x *PrecompileCoreResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrecompileCoreResult.ProtoReflect.Descriptor instead.
+func (*PrecompileCoreResult) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PrecompileCoreResult) GetPrecompiledFqbns() []string {
+	if x != nil {
+		return x.PrecompiledFqbns
+	}
+	return nil
+}
+
+type GetCompilerFragmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully Qualified Board Name, e.g.: `arduino:avr:uno`.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// The path where the sketch is stored.
+	SketchPath string `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	// A list of custom build properties separated by commas, as in
+	// `CompileRequest`.
+	BuildProperties []string `protobuf:"bytes,4,rep,name=build_properties,json=buildProperties,proto3" json:"build_properties,omitempty"`
+	// The path where the compilation database is created. If empty a folder
+	// dedicated to the sketch and the board is used, separated from the one of
+	// the builds.
+	BuildPath string `protobuf:"bytes,5,opt,name=build_path,json=buildPath,proto3" json:"build_path,omitempty"`
+}
+
+func (x *GetCompilerFragmentRequest) Reset() {
+	*x = GetCompilerFragmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompilerFragmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompilerFragmentRequest) ProtoMessage() {}
+
+func (x *GetCompilerFragmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompilerFragmentRequest.ProtoReflect.Descriptor instead.
+func (*GetCompilerFragmentRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCompilerFragmentRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *GetCompilerFragmentRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *GetCompilerFragmentRequest) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *GetCompilerFragmentRequest) GetBuildProperties() []string {
 	if x != nil {
-		return x.Progress
+		return x.BuildProperties
 	}
 	return nil
 }
 
-func (x *CompileResponse) GetBuildProperties() []string {
+func (x *GetCompilerFragmentRequest) GetBuildPath() string {
 	if x != nil {
-		return x.BuildProperties
+		return x.BuildPath
 	}
-	return nil
+	return ""
 }
 
-type ExecutableSectionSize struct {
+type GetCompilerFragmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Size    int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	MaxSize int64  `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// The path of the compiler.
+	CompilerPath string `protobuf:"bytes,1,opt,name=compiler_path,json=compilerPath,proto3" json:"compiler_path,omitempty"`
+	// The include directories, in the order used by the compiler.
+	IncludeDirs []string `protobuf:"bytes,2,rep,name=include_dirs,json=includeDirs,proto3" json:"include_dirs,omitempty"`
+	// The macros defined on the command line, as `NAME` or `NAME=VALUE`.
+	Defines []string `protobuf:"bytes,3,rep,name=defines,proto3" json:"defines,omitempty"`
+	// The other flags of the compiler, without the input and output files.
+	Flags []string `protobuf:"bytes,4,rep,name=flags,proto3" json:"flags,omitempty"`
+	// The complete command used to compile the main file of the sketch.
+	Arguments []string `protobuf:"bytes,5,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	// The file compiled by the command: the main file of the sketch converted
+	// to C++.
+	File string `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty"`
+	// The directory where the command is run.
+	Directory string `protobuf:"bytes,7,opt,name=directory,proto3" json:"directory,omitempty"`
+	// The build path containing the compilation database.
+	BuildPath string `protobuf:"bytes,8,opt,name=build_path,json=buildPath,proto3" json:"build_path,omitempty"`
+	// True if the fragment has been returned from the cache.
+	Cached bool `protobuf:"varint,9,opt,name=cached,proto3" json:"cached,omitempty"`
 }
 
-func (x *ExecutableSectionSize) Reset() {
-	*x = ExecutableSectionSize{}
+func (x *GetCompilerFragmentResponse) Reset() {
+	*x = GetCompilerFragmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[2]
+		mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecutableSectionSize) String() string {
+func (x *GetCompilerFragmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutableSectionSize) ProtoMessage() {}
+func (*GetCompilerFragmentResponse) ProtoMessage() {}
 
-func (x *ExecutableSectionSize) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[2]
+func (x *GetCompilerFragmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,30 +2032,72 @@ func (x *ExecutableSectionSize) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutableSectionSize.ProtoReflect.Descriptor instead.
-func (*ExecutableSectionSize) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetCompilerFragmentResponse.ProtoReflect.Descriptor instead.
+func (*GetCompilerFragmentResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ExecutableSectionSize) GetName() string {
+func (x *GetCompilerFragmentResponse) GetCompilerPath() string {
 	if x != nil {
-		return x.Name
+		return x.CompilerPath
 	}
 	return ""
 }
 
-func (x *ExecutableSectionSize) GetSize() int64 {
+func (x *GetCompilerFragmentResponse) GetIncludeDirs() []string {
 	if x != nil {
-		return x.Size
+		return x.IncludeDirs
 	}
-	return 0
+	return nil
 }
 
-func (x *ExecutableSectionSize) GetMaxSize() int64 {
+func (x *GetCompilerFragmentResponse) GetDefines() []string {
 	if x != nil {
-		return x.MaxSize
+		return x.Defines
 	}
-	return 0
+	return nil
+}
+
+func (x *GetCompilerFragmentResponse) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *GetCompilerFragmentResponse) GetArguments() []string {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *GetCompilerFragmentResponse) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *GetCompilerFragmentResponse) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *GetCompilerFragmentResponse) GetBuildPath() string {
+	if x != nil {
+		return x.BuildPath
+	}
+	return ""
+}
+
+func (x *GetCompilerFragmentResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
 }
 
 var File_cc_arduino_cli_commands_v1_compile_proto protoreflect.FileDescriptor
@@ -517,7 +2114,7 @@ var file_cc_arduino_cli_commands_v1_compile_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x24, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f,
 	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x08, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x0c, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
 	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e,
 	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
@@ -582,60 +2179,309 @@ var file_cc_arduino_cli_commands_v1_compile_proto_rawDesc = []byte{
 	0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64,
 	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x1a, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x41, 0x0a,
-	0x13, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xd6, 0x04, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x4a, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x75, 0x73, 0x65,
+	0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x21, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x13, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x18, 0x24, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x12, 0x27, 0x0a, 0x0f,
+	0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x25, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65,
+	0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x26, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x1e,
+	0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x27,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x6b, 0x65, 0x74, 0x63,
+	0x68, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x0b, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f,
+	0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4a, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x6b, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x5d, 0x0a, 0x0e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x0d, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x5d,
+	0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0d,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x44, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x42,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x6c, 0x0a, 0x19, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x63, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x43, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x41, 0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x7a, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x08, 0x73, 0x69, 0x7a, 0x65, 0x44,
+	0x69, 0x66, 0x66, 0x12, 0x4d, 0x0a, 0x0b, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61,
 	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x0d,
-	0x75, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x6b, 0x0a,
-	0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
-	0x7a, 0x65, 0x52, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
-	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x5d, 0x0a, 0x0e, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x36, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
-	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x44, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e,
-	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29,
-	0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x15, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61,
-	0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61,
-	0x78, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75,
-	0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61,
-	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x5e, 0x0a, 0x13, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52,
+	0x12, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x16, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x6c, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x45, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0x37, 0x0a, 0x0d, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x6c, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x22, 0x39, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x2d, 0x0a, 0x15, 0x46,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x5a, 0x0a, 0x15, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x0c, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x41, 0x0a,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x44, 0x0a, 0x06, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x06,
+	0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x43, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6e, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x22, 0x64, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x69,
+	0x6d, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x75, 0x70, 0x5f,
+	0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75,
+	0x70, 0x54, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x08, 0x53, 0x69, 0x7a, 0x65,
+	0x44, 0x69, 0x66, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a,
+	0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x7a, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22,
+	0x56, 0x0a, 0x0a, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x65, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x55, 0x6e, 0x75, 0x73,
+	0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x31,
+	0x0a, 0x14, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x6d, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x95, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52,
+	0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0x71, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x15,
+	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x71, 0x62, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x71, 0x62, 0x6e, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x70, 0x74,
+	0x69, 0x6d, 0x69, 0x7a, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x46,
+	0x6f, 0x72, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x16,
+	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x75,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x4a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x43, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x66, 0x71, 0x62, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x46,
+	0x71, 0x62, 0x6e, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x72, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65,
+	0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x22, 0x9c, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x72, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -650,32 +2496,71 @@ func file_cc_arduino_cli_commands_v1_compile_proto_rawDescGZIP() []byte {
 	return file_cc_arduino_cli_commands_v1_compile_proto_rawDescData
 }
 
-var file_cc_arduino_cli_commands_v1_compile_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cc_arduino_cli_commands_v1_compile_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_cc_arduino_cli_commands_v1_compile_proto_goTypes = []interface{}{
-	(*CompileRequest)(nil),             // 0: cc.arduino.cli.commands.v1.CompileRequest
-	(*CompileResponse)(nil),            // 1: cc.arduino.cli.commands.v1.CompileResponse
-	(*ExecutableSectionSize)(nil),      // 2: cc.arduino.cli.commands.v1.ExecutableSectionSize
-	nil,                                // 3: cc.arduino.cli.commands.v1.CompileRequest.SourceOverrideEntry
-	(*Instance)(nil),                   // 4: cc.arduino.cli.commands.v1.Instance
-	(*wrapperspb.BoolValue)(nil),       // 5: google.protobuf.BoolValue
-	(*Library)(nil),                    // 6: cc.arduino.cli.commands.v1.Library
-	(*InstalledPlatformReference)(nil), // 7: cc.arduino.cli.commands.v1.InstalledPlatformReference
-	(*TaskProgress)(nil),               // 8: cc.arduino.cli.commands.v1.TaskProgress
+	(*CompileRequest)(nil),              // 0: cc.arduino.cli.commands.v1.CompileRequest
+	(*CompileResponse)(nil),             // 1: cc.arduino.cli.commands.v1.CompileResponse
+	(*BuildArtifact)(nil),               // 2: cc.arduino.cli.commands.v1.BuildArtifact
+	(*IncompatibleLibrary)(nil),         // 3: cc.arduino.cli.commands.v1.IncompatibleLibrary
+	(*PublishedArtifact)(nil),           // 4: cc.arduino.cli.commands.v1.PublishedArtifact
+	(*FileNameCaseCollision)(nil),       // 5: cc.arduino.cli.commands.v1.FileNameCaseCollision
+	(*ExecutableSectionSize)(nil),       // 6: cc.arduino.cli.commands.v1.ExecutableSectionSize
+	(*BuildTimings)(nil),                // 7: cc.arduino.cli.commands.v1.BuildTimings
+	(*CcacheStatistics)(nil),            // 8: cc.arduino.cli.commands.v1.CcacheStatistics
+	(*BuildPhaseTiming)(nil),            // 9: cc.arduino.cli.commands.v1.BuildPhaseTiming
+	(*BuildFileTiming)(nil),             // 10: cc.arduino.cli.commands.v1.BuildFileTiming
+	(*SizeDiff)(nil),                    // 11: cc.arduino.cli.commands.v1.SizeDiff
+	(*SizeChange)(nil),                  // 12: cc.arduino.cli.commands.v1.SizeChange
+	(*UnusedCodeReport)(nil),            // 13: cc.arduino.cli.commands.v1.UnusedCodeReport
+	(*MemoryLayout)(nil),                // 14: cc.arduino.cli.commands.v1.MemoryLayout
+	(*MemorySection)(nil),               // 15: cc.arduino.cli.commands.v1.MemorySection
+	(*MemorySymbol)(nil),                // 16: cc.arduino.cli.commands.v1.MemorySymbol
+	(*PrecompileCoreRequest)(nil),       // 17: cc.arduino.cli.commands.v1.PrecompileCoreRequest
+	(*PrecompileCoreResponse)(nil),      // 18: cc.arduino.cli.commands.v1.PrecompileCoreResponse
+	(*PrecompileCoreResult)(nil),        // 19: cc.arduino.cli.commands.v1.PrecompileCoreResult
+	(*GetCompilerFragmentRequest)(nil),  // 20: cc.arduino.cli.commands.v1.GetCompilerFragmentRequest
+	(*GetCompilerFragmentResponse)(nil), // 21: cc.arduino.cli.commands.v1.GetCompilerFragmentResponse
+	nil,                                 // 22: cc.arduino.cli.commands.v1.CompileRequest.SourceOverrideEntry
+	(*Instance)(nil),                    // 23: cc.arduino.cli.commands.v1.Instance
+	(*wrapperspb.BoolValue)(nil),        // 24: google.protobuf.BoolValue
+	(*Library)(nil),                     // 25: cc.arduino.cli.commands.v1.Library
+	(*InstalledPlatformReference)(nil),  // 26: cc.arduino.cli.commands.v1.InstalledPlatformReference
+	(*TaskProgress)(nil),                // 27: cc.arduino.cli.commands.v1.TaskProgress
+	(*Advisory)(nil),                    // 28: cc.arduino.cli.commands.v1.Advisory
 }
 var file_cc_arduino_cli_commands_v1_compile_proto_depIdxs = []int32{
-	4, // 0: cc.arduino.cli.commands.v1.CompileRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	3, // 1: cc.arduino.cli.commands.v1.CompileRequest.source_override:type_name -> cc.arduino.cli.commands.v1.CompileRequest.SourceOverrideEntry
-	5, // 2: cc.arduino.cli.commands.v1.CompileRequest.export_binaries:type_name -> google.protobuf.BoolValue
-	6, // 3: cc.arduino.cli.commands.v1.CompileResponse.used_libraries:type_name -> cc.arduino.cli.commands.v1.Library
-	2, // 4: cc.arduino.cli.commands.v1.CompileResponse.executable_sections_size:type_name -> cc.arduino.cli.commands.v1.ExecutableSectionSize
-	7, // 5: cc.arduino.cli.commands.v1.CompileResponse.board_platform:type_name -> cc.arduino.cli.commands.v1.InstalledPlatformReference
-	7, // 6: cc.arduino.cli.commands.v1.CompileResponse.build_platform:type_name -> cc.arduino.cli.commands.v1.InstalledPlatformReference
-	8, // 7: cc.arduino.cli.commands.v1.CompileResponse.progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	23, // 0: cc.arduino.cli.commands.v1.CompileRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	22, // 1: cc.arduino.cli.commands.v1.CompileRequest.source_override:type_name -> cc.arduino.cli.commands.v1.CompileRequest.SourceOverrideEntry
+	24, // 2: cc.arduino.cli.commands.v1.CompileRequest.export_binaries:type_name -> google.protobuf.BoolValue
+	25, // 3: cc.arduino.cli.commands.v1.CompileResponse.used_libraries:type_name -> cc.arduino.cli.commands.v1.Library
+	6,  // 4: cc.arduino.cli.commands.v1.CompileResponse.executable_sections_size:type_name -> cc.arduino.cli.commands.v1.ExecutableSectionSize
+	26, // 5: cc.arduino.cli.commands.v1.CompileResponse.board_platform:type_name -> cc.arduino.cli.commands.v1.InstalledPlatformReference
+	26, // 6: cc.arduino.cli.commands.v1.CompileResponse.build_platform:type_name -> cc.arduino.cli.commands.v1.InstalledPlatformReference
+	27, // 7: cc.arduino.cli.commands.v1.CompileResponse.progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
+	7,  // 8: cc.arduino.cli.commands.v1.CompileResponse.timings:type_name -> cc.arduino.cli.commands.v1.BuildTimings
+	5,  // 9: cc.arduino.cli.commands.v1.CompileResponse.file_name_case_collisions:type_name -> cc.arduino.cli.commands.v1.FileNameCaseCollision
+	11, // 10: cc.arduino.cli.commands.v1.CompileResponse.size_diff:type_name -> cc.arduino.cli.commands.v1.SizeDiff
+	13, // 11: cc.arduino.cli.commands.v1.CompileResponse.unused_code:type_name -> cc.arduino.cli.commands.v1.UnusedCodeReport
+	14, // 12: cc.arduino.cli.commands.v1.CompileResponse.memory_layout:type_name -> cc.arduino.cli.commands.v1.MemoryLayout
+	4,  // 13: cc.arduino.cli.commands.v1.CompileResponse.published_artifacts:type_name -> cc.arduino.cli.commands.v1.PublishedArtifact
+	28, // 14: cc.arduino.cli.commands.v1.CompileResponse.advisories:type_name -> cc.arduino.cli.commands.v1.Advisory
+	3,  // 15: cc.arduino.cli.commands.v1.CompileResponse.incompatible_libraries:type_name -> cc.arduino.cli.commands.v1.IncompatibleLibrary
+	2,  // 16: cc.arduino.cli.commands.v1.CompileResponse.artifact:type_name -> cc.arduino.cli.commands.v1.BuildArtifact
+	9,  // 17: cc.arduino.cli.commands.v1.BuildTimings.phases:type_name -> cc.arduino.cli.commands.v1.BuildPhaseTiming
+	10, // 18: cc.arduino.cli.commands.v1.BuildTimings.files:type_name -> cc.arduino.cli.commands.v1.BuildFileTiming
+	8,  // 19: cc.arduino.cli.commands.v1.BuildTimings.ccache:type_name -> cc.arduino.cli.commands.v1.CcacheStatistics
+	12, // 20: cc.arduino.cli.commands.v1.SizeDiff.sections:type_name -> cc.arduino.cli.commands.v1.SizeChange
+	12, // 21: cc.arduino.cli.commands.v1.SizeDiff.symbols:type_name -> cc.arduino.cli.commands.v1.SizeChange
+	15, // 22: cc.arduino.cli.commands.v1.MemoryLayout.sections:type_name -> cc.arduino.cli.commands.v1.MemorySection
+	16, // 23: cc.arduino.cli.commands.v1.MemorySection.symbols:type_name -> cc.arduino.cli.commands.v1.MemorySymbol
+	23, // 24: cc.arduino.cli.commands.v1.PrecompileCoreRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	19, // 25: cc.arduino.cli.commands.v1.PrecompileCoreResponse.result:type_name -> cc.arduino.cli.commands.v1.PrecompileCoreResult
+	23, // 26: cc.arduino.cli.commands.v1.GetCompilerFragmentRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_cc_arduino_cli_commands_v1_compile_proto_init() }
@@ -711,6 +2596,54 @@ func file_cc_arduino_cli_commands_v1_compile_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildArtifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncompatibleLibrary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishedArtifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileNameCaseCollision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecutableSectionSize); i {
 			case 0:
 				return &v.state
@@ -722,6 +2655,191 @@ func file_cc_arduino_cli_commands_v1_compile_proto_init() {
 				return nil
 			}
 		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildTimings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CcacheStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildPhaseTiming); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildFileTiming); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SizeDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SizeChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnusedCodeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryLayout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemorySection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemorySymbol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrecompileCoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrecompileCoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrecompileCoreResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompilerFragmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompilerFragmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_cc_arduino_cli_commands_v1_compile_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*PrecompileCoreResponse_OutStream)(nil),
+		(*PrecompileCoreResponse_ErrStream)(nil),
+		(*PrecompileCoreResponse_Result)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -729,7 +2847,7 @@ func file_cc_arduino_cli_commands_v1_compile_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cc_arduino_cli_commands_v1_compile_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
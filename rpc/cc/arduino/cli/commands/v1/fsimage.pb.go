@@ -0,0 +1,823 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2023 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.3
+// source: cc/arduino/cli/commands/v1/fsimage.proto
+
+package commands
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FilesystemImageBuildRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully qualified board name of the target board (e.g., `esp32:esp32:esp32`).
+	// If this field is not defined, the FQBN of the board attached to the sketch
+	// is used.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Path of the sketch whose `data` folder is packed in the image.
+	SketchPath string `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	// Type of filesystem: `littlefs`, `spiffs` or `fatfs`. If empty the
+	// filesystem preferred by the board is used.
+	FsType string `protobuf:"bytes,4,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	// Path of the image to build. If empty the image is saved in the build
+	// directory of the sketch.
+	OutputPath string `protobuf:"bytes,5,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`
+	// Turns on verbose mode.
+	Verbose bool `protobuf:"varint,6,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (x *FilesystemImageBuildRequest) Reset() {
+	*x = FilesystemImageBuildRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesystemImageBuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesystemImageBuildRequest) ProtoMessage() {}
+
+func (x *FilesystemImageBuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesystemImageBuildRequest.ProtoReflect.Descriptor instead.
+func (*FilesystemImageBuildRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FilesystemImageBuildRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *FilesystemImageBuildRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *FilesystemImageBuildRequest) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *FilesystemImageBuildRequest) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *FilesystemImageBuildRequest) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *FilesystemImageBuildRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+type FilesystemImageBuildResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*FilesystemImageBuildResponse_OutStream
+	//	*FilesystemImageBuildResponse_ErrStream
+	//	*FilesystemImageBuildResponse_Result
+	Message isFilesystemImageBuildResponse_Message `protobuf_oneof:"message"`
+}
+
+func (x *FilesystemImageBuildResponse) Reset() {
+	*x = FilesystemImageBuildResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesystemImageBuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesystemImageBuildResponse) ProtoMessage() {}
+
+func (x *FilesystemImageBuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesystemImageBuildResponse.ProtoReflect.Descriptor instead.
+func (*FilesystemImageBuildResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *FilesystemImageBuildResponse) GetMessage() isFilesystemImageBuildResponse_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *FilesystemImageBuildResponse) GetOutStream() []byte {
+	if x, ok := x.GetMessage().(*FilesystemImageBuildResponse_OutStream); ok {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *FilesystemImageBuildResponse) GetErrStream() []byte {
+	if x, ok := x.GetMessage().(*FilesystemImageBuildResponse_ErrStream); ok {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *FilesystemImageBuildResponse) GetResult() *FilesystemImage {
+	if x, ok := x.GetMessage().(*FilesystemImageBuildResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isFilesystemImageBuildResponse_Message interface {
+	isFilesystemImageBuildResponse_Message()
+}
+
+type FilesystemImageBuildResponse_OutStream struct {
+	// The output of the image build tool.
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3,oneof"`
+}
+
+type FilesystemImageBuildResponse_ErrStream struct {
+	// The error output of the image build tool.
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3,oneof"`
+}
+
+type FilesystemImageBuildResponse_Result struct {
+	// The built image.
+	Result *FilesystemImage `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+func (*FilesystemImageBuildResponse_OutStream) isFilesystemImageBuildResponse_Message() {}
+
+func (*FilesystemImageBuildResponse_ErrStream) isFilesystemImageBuildResponse_Message() {}
+
+func (*FilesystemImageBuildResponse_Result) isFilesystemImageBuildResponse_Message() {}
+
+type FilesystemImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the image file.
+	ImagePath string `protobuf:"bytes,1,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
+	// Type of filesystem.
+	FsType string `protobuf:"bytes,2,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	// Offset of the image in the flash memory of the board.
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Size of the image.
+	Size uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *FilesystemImage) Reset() {
+	*x = FilesystemImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesystemImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesystemImage) ProtoMessage() {}
+
+func (x *FilesystemImage) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesystemImage.ProtoReflect.Descriptor instead.
+func (*FilesystemImage) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FilesystemImage) GetImagePath() string {
+	if x != nil {
+		return x.ImagePath
+	}
+	return ""
+}
+
+func (x *FilesystemImage) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *FilesystemImage) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FilesystemImage) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FilesystemImageUploadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully qualified board name of the target board (e.g., `esp32:esp32:esp32`).
+	// If this field is not defined, the FQBN of the board attached to the sketch
+	// is used.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Path of the sketch whose `data` folder is packed in the image.
+	SketchPath string `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	// The port of the board.
+	Port *Port `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
+	// Type of filesystem: `littlefs`, `spiffs` or `fatfs`. If empty the
+	// filesystem preferred by the board is used.
+	FsType string `protobuf:"bytes,5,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	// Path of an already built image to upload. If empty the image is built
+	// from the `data` folder of the sketch before the upload.
+	ImagePath string `protobuf:"bytes,6,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
+	// Turns on verbose mode.
+	Verbose bool `protobuf:"varint,7,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	// If set to true, the actual upload will not be performed but a trace output
+	// will be printed stdout. This is for debugging purposes.
+	DryRun bool `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *FilesystemImageUploadRequest) Reset() {
+	*x = FilesystemImageUploadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesystemImageUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesystemImageUploadRequest) ProtoMessage() {}
+
+func (x *FilesystemImageUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesystemImageUploadRequest.ProtoReflect.Descriptor instead.
+func (*FilesystemImageUploadRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FilesystemImageUploadRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *FilesystemImageUploadRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *FilesystemImageUploadRequest) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *FilesystemImageUploadRequest) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *FilesystemImageUploadRequest) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *FilesystemImageUploadRequest) GetImagePath() string {
+	if x != nil {
+		return x.ImagePath
+	}
+	return ""
+}
+
+func (x *FilesystemImageUploadRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *FilesystemImageUploadRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type FilesystemImageUploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*FilesystemImageUploadResponse_OutStream
+	//	*FilesystemImageUploadResponse_ErrStream
+	//	*FilesystemImageUploadResponse_Result
+	Message isFilesystemImageUploadResponse_Message `protobuf_oneof:"message"`
+}
+
+func (x *FilesystemImageUploadResponse) Reset() {
+	*x = FilesystemImageUploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesystemImageUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesystemImageUploadResponse) ProtoMessage() {}
+
+func (x *FilesystemImageUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesystemImageUploadResponse.ProtoReflect.Descriptor instead.
+func (*FilesystemImageUploadResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *FilesystemImageUploadResponse) GetMessage() isFilesystemImageUploadResponse_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *FilesystemImageUploadResponse) GetOutStream() []byte {
+	if x, ok := x.GetMessage().(*FilesystemImageUploadResponse_OutStream); ok {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *FilesystemImageUploadResponse) GetErrStream() []byte {
+	if x, ok := x.GetMessage().(*FilesystemImageUploadResponse_ErrStream); ok {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *FilesystemImageUploadResponse) GetResult() *FilesystemImageUploadResult {
+	if x, ok := x.GetMessage().(*FilesystemImageUploadResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isFilesystemImageUploadResponse_Message interface {
+	isFilesystemImageUploadResponse_Message()
+}
+
+type FilesystemImageUploadResponse_OutStream struct {
+	// The output of the build and upload tools.
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3,oneof"`
+}
+
+type FilesystemImageUploadResponse_ErrStream struct {
+	// The error output of the build and upload tools.
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3,oneof"`
+}
+
+type FilesystemImageUploadResponse_Result struct {
+	// The upload result.
+	Result *FilesystemImageUploadResult `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+func (*FilesystemImageUploadResponse_OutStream) isFilesystemImageUploadResponse_Message() {}
+
+func (*FilesystemImageUploadResponse_ErrStream) isFilesystemImageUploadResponse_Message() {}
+
+func (*FilesystemImageUploadResponse_Result) isFilesystemImageUploadResponse_Message() {}
+
+type FilesystemImageUploadResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The uploaded image.
+	Image *FilesystemImage `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// When a board requires a port disconnection to perform the upload, this
+	// field returns the port where the board reconnects after the upload.
+	UpdatedUploadPort *Port `protobuf:"bytes,2,opt,name=updated_upload_port,json=updatedUploadPort,proto3" json:"updated_upload_port,omitempty"`
+}
+
+func (x *FilesystemImageUploadResult) Reset() {
+	*x = FilesystemImageUploadResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesystemImageUploadResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesystemImageUploadResult) ProtoMessage() {}
+
+func (x *FilesystemImageUploadResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesystemImageUploadResult.ProtoReflect.Descriptor instead.
+func (*FilesystemImageUploadResult) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FilesystemImageUploadResult) GetImage() *FilesystemImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *FilesystemImageUploadResult) GetUpdatedUploadPort() *Port {
+	if x != nil {
+		return x.UpdatedUploadPort
+	}
+	return nil
+}
+
+var File_cc_arduino_cli_commands_v1_fsimage_proto protoreflect.FileDescriptor
+
+var file_cc_arduino_cli_commands_v1_fsimage_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x73, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x27, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x25, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x01, 0x0a, 0x1b, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x22, 0xb2, 0x01, 0x0a, 0x1c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x75, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x73, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xb6, 0x02,
+	0x0a, 0x1c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x71, 0x62, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63,
+	0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09,
+	0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x51, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x1b, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x48, 0x5a,
+	0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f,
+	0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63,
+	0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescOnce sync.Once
+	file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescData = file_cc_arduino_cli_commands_v1_fsimage_proto_rawDesc
+)
+
+func file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescGZIP() []byte {
+	file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescOnce.Do(func() {
+		file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescData = protoimpl.X.CompressGZIP(file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescData)
+	})
+	return file_cc_arduino_cli_commands_v1_fsimage_proto_rawDescData
+}
+
+var file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cc_arduino_cli_commands_v1_fsimage_proto_goTypes = []interface{}{
+	(*FilesystemImageBuildRequest)(nil),   // 0: cc.arduino.cli.commands.v1.FilesystemImageBuildRequest
+	(*FilesystemImageBuildResponse)(nil),  // 1: cc.arduino.cli.commands.v1.FilesystemImageBuildResponse
+	(*FilesystemImage)(nil),               // 2: cc.arduino.cli.commands.v1.FilesystemImage
+	(*FilesystemImageUploadRequest)(nil),  // 3: cc.arduino.cli.commands.v1.FilesystemImageUploadRequest
+	(*FilesystemImageUploadResponse)(nil), // 4: cc.arduino.cli.commands.v1.FilesystemImageUploadResponse
+	(*FilesystemImageUploadResult)(nil),   // 5: cc.arduino.cli.commands.v1.FilesystemImageUploadResult
+	(*Instance)(nil),                      // 6: cc.arduino.cli.commands.v1.Instance
+	(*Port)(nil),                          // 7: cc.arduino.cli.commands.v1.Port
+}
+var file_cc_arduino_cli_commands_v1_fsimage_proto_depIdxs = []int32{
+	6, // 0: cc.arduino.cli.commands.v1.FilesystemImageBuildRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	2, // 1: cc.arduino.cli.commands.v1.FilesystemImageBuildResponse.result:type_name -> cc.arduino.cli.commands.v1.FilesystemImage
+	6, // 2: cc.arduino.cli.commands.v1.FilesystemImageUploadRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	7, // 3: cc.arduino.cli.commands.v1.FilesystemImageUploadRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
+	5, // 4: cc.arduino.cli.commands.v1.FilesystemImageUploadResponse.result:type_name -> cc.arduino.cli.commands.v1.FilesystemImageUploadResult
+	2, // 5: cc.arduino.cli.commands.v1.FilesystemImageUploadResult.image:type_name -> cc.arduino.cli.commands.v1.FilesystemImage
+	7, // 6: cc.arduino.cli.commands.v1.FilesystemImageUploadResult.updated_upload_port:type_name -> cc.arduino.cli.commands.v1.Port
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cc_arduino_cli_commands_v1_fsimage_proto_init() }
+func file_cc_arduino_cli_commands_v1_fsimage_proto_init() {
+	if File_cc_arduino_cli_commands_v1_fsimage_proto != nil {
+		return
+	}
+	file_cc_arduino_cli_commands_v1_common_proto_init()
+	file_cc_arduino_cli_commands_v1_port_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesystemImageBuildRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesystemImageBuildResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesystemImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesystemImageUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesystemImageUploadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesystemImageUploadResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*FilesystemImageBuildResponse_OutStream)(nil),
+		(*FilesystemImageBuildResponse_ErrStream)(nil),
+		(*FilesystemImageBuildResponse_Result)(nil),
+	}
+	file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*FilesystemImageUploadResponse_OutStream)(nil),
+		(*FilesystemImageUploadResponse_ErrStream)(nil),
+		(*FilesystemImageUploadResponse_Result)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cc_arduino_cli_commands_v1_fsimage_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cc_arduino_cli_commands_v1_fsimage_proto_goTypes,
+		DependencyIndexes: file_cc_arduino_cli_commands_v1_fsimage_proto_depIdxs,
+		MessageInfos:      file_cc_arduino_cli_commands_v1_fsimage_proto_msgTypes,
+	}.Build()
+	File_cc_arduino_cli_commands_v1_fsimage_proto = out.File
+	file_cc_arduino_cli_commands_v1_fsimage_proto_rawDesc = nil
+	file_cc_arduino_cli_commands_v1_fsimage_proto_goTypes = nil
+	file_cc_arduino_cli_commands_v1_fsimage_proto_depIdxs = nil
+}
@@ -35,6 +35,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LibraryResolutionStrategy int32
+
+const (
+	// The newest version of each dependency satisfying the constraints of the
+	// library and of the installed libraries. This is the default if not
+	// specified.
+	LibraryResolutionStrategy_LIBRARY_RESOLUTION_STRATEGY_LATEST LibraryResolutionStrategy = 0
+	// The installed version of each dependency if it satisfies the constraints,
+	// otherwise the oldest version satisfying them.
+	LibraryResolutionStrategy_LIBRARY_RESOLUTION_STRATEGY_MINIMAL LibraryResolutionStrategy = 1
+)
+
+// Enum value maps for LibraryResolutionStrategy.
+var (
+	LibraryResolutionStrategy_name = map[int32]string{
+		0: "LIBRARY_RESOLUTION_STRATEGY_LATEST",
+		1: "LIBRARY_RESOLUTION_STRATEGY_MINIMAL",
+	}
+	LibraryResolutionStrategy_value = map[string]int32{
+		"LIBRARY_RESOLUTION_STRATEGY_LATEST":  0,
+		"LIBRARY_RESOLUTION_STRATEGY_MINIMAL": 1,
+	}
+)
+
+func (x LibraryResolutionStrategy) Enum() *LibraryResolutionStrategy {
+	p := new(LibraryResolutionStrategy)
+	*p = x
+	return p
+}
+
+func (x LibraryResolutionStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibraryResolutionStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[0].Descriptor()
+}
+
+func (LibraryResolutionStrategy) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[0]
+}
+
+func (x LibraryResolutionStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibraryResolutionStrategy.Descriptor instead.
+func (LibraryResolutionStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{0}
+}
+
 type LibraryInstallLocation int32
 
 const (
@@ -68,11 +119,11 @@ func (x LibraryInstallLocation) String() string {
 }
 
 func (LibraryInstallLocation) Descriptor() protoreflect.EnumDescriptor {
-	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[0].Descriptor()
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[1].Descriptor()
 }
 
 func (LibraryInstallLocation) Type() protoreflect.EnumType {
-	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[0]
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[1]
 }
 
 func (x LibraryInstallLocation) Number() protoreflect.EnumNumber {
@@ -81,7 +132,116 @@ func (x LibraryInstallLocation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LibraryInstallLocation.Descriptor instead.
 func (LibraryInstallLocation) EnumDescriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{0}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{1}
+}
+
+type LibraryExampleCheckStatus int32
+
+const (
+	// The example compiled successfully.
+	LibraryExampleCheckStatus_LIBRARY_EXAMPLE_CHECK_STATUS_PASSED LibraryExampleCheckStatus = 0
+	// The compilation of the example failed.
+	LibraryExampleCheckStatus_LIBRARY_EXAMPLE_CHECK_STATUS_FAILED LibraryExampleCheckStatus = 1
+	// The example has not been compiled for the board, because of its
+	// `check-examples` annotations.
+	LibraryExampleCheckStatus_LIBRARY_EXAMPLE_CHECK_STATUS_SKIPPED LibraryExampleCheckStatus = 2
+)
+
+// Enum value maps for LibraryExampleCheckStatus.
+var (
+	LibraryExampleCheckStatus_name = map[int32]string{
+		0: "LIBRARY_EXAMPLE_CHECK_STATUS_PASSED",
+		1: "LIBRARY_EXAMPLE_CHECK_STATUS_FAILED",
+		2: "LIBRARY_EXAMPLE_CHECK_STATUS_SKIPPED",
+	}
+	LibraryExampleCheckStatus_value = map[string]int32{
+		"LIBRARY_EXAMPLE_CHECK_STATUS_PASSED":  0,
+		"LIBRARY_EXAMPLE_CHECK_STATUS_FAILED":  1,
+		"LIBRARY_EXAMPLE_CHECK_STATUS_SKIPPED": 2,
+	}
+)
+
+func (x LibraryExampleCheckStatus) Enum() *LibraryExampleCheckStatus {
+	p := new(LibraryExampleCheckStatus)
+	*p = x
+	return p
+}
+
+func (x LibraryExampleCheckStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibraryExampleCheckStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[2].Descriptor()
+}
+
+func (LibraryExampleCheckStatus) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[2]
+}
+
+func (x LibraryExampleCheckStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibraryExampleCheckStatus.Descriptor instead.
+func (LibraryExampleCheckStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{2}
+}
+
+type LibraryVersionBump int32
+
+const (
+	// The version is not changed.
+	LibraryVersionBump_LIBRARY_VERSION_BUMP_NONE LibraryVersionBump = 0
+	// Increment the major version, e.g. from 1.2.3 to 2.0.0.
+	LibraryVersionBump_LIBRARY_VERSION_BUMP_MAJOR LibraryVersionBump = 1
+	// Increment the minor version, e.g. from 1.2.3 to 1.3.0.
+	LibraryVersionBump_LIBRARY_VERSION_BUMP_MINOR LibraryVersionBump = 2
+	// Increment the patch version, e.g. from 1.2.3 to 1.2.4.
+	LibraryVersionBump_LIBRARY_VERSION_BUMP_PATCH LibraryVersionBump = 3
+)
+
+// Enum value maps for LibraryVersionBump.
+var (
+	LibraryVersionBump_name = map[int32]string{
+		0: "LIBRARY_VERSION_BUMP_NONE",
+		1: "LIBRARY_VERSION_BUMP_MAJOR",
+		2: "LIBRARY_VERSION_BUMP_MINOR",
+		3: "LIBRARY_VERSION_BUMP_PATCH",
+	}
+	LibraryVersionBump_value = map[string]int32{
+		"LIBRARY_VERSION_BUMP_NONE":  0,
+		"LIBRARY_VERSION_BUMP_MAJOR": 1,
+		"LIBRARY_VERSION_BUMP_MINOR": 2,
+		"LIBRARY_VERSION_BUMP_PATCH": 3,
+	}
+)
+
+func (x LibraryVersionBump) Enum() *LibraryVersionBump {
+	p := new(LibraryVersionBump)
+	*p = x
+	return p
+}
+
+func (x LibraryVersionBump) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibraryVersionBump) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[3].Descriptor()
+}
+
+func (LibraryVersionBump) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[3]
+}
+
+func (x LibraryVersionBump) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibraryVersionBump.Descriptor instead.
+func (LibraryVersionBump) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{3}
 }
 
 type LibrarySearchStatus int32
@@ -116,11 +276,11 @@ func (x LibrarySearchStatus) String() string {
 }
 
 func (LibrarySearchStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[1].Descriptor()
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[4].Descriptor()
 }
 
 func (LibrarySearchStatus) Type() protoreflect.EnumType {
-	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[1]
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[4]
 }
 
 func (x LibrarySearchStatus) Number() protoreflect.EnumNumber {
@@ -129,7 +289,180 @@ func (x LibrarySearchStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LibrarySearchStatus.Descriptor instead.
 func (LibrarySearchStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{1}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{4}
+}
+
+type LibraryQueryFilter int32
+
+const (
+	// All the libraries, installed or available in the libraries index.
+	LibraryQueryFilter_LIBRARY_QUERY_FILTER_ALL LibraryQueryFilter = 0
+	// Only the installed libraries.
+	LibraryQueryFilter_LIBRARY_QUERY_FILTER_INSTALLED LibraryQueryFilter = 1
+	// Only the libraries of the libraries index that are not installed.
+	LibraryQueryFilter_LIBRARY_QUERY_FILTER_NOT_INSTALLED LibraryQueryFilter = 2
+	// Only the installed libraries with a newer version in the libraries index.
+	LibraryQueryFilter_LIBRARY_QUERY_FILTER_UPDATABLE LibraryQueryFilter = 3
+)
+
+// Enum value maps for LibraryQueryFilter.
+var (
+	LibraryQueryFilter_name = map[int32]string{
+		0: "LIBRARY_QUERY_FILTER_ALL",
+		1: "LIBRARY_QUERY_FILTER_INSTALLED",
+		2: "LIBRARY_QUERY_FILTER_NOT_INSTALLED",
+		3: "LIBRARY_QUERY_FILTER_UPDATABLE",
+	}
+	LibraryQueryFilter_value = map[string]int32{
+		"LIBRARY_QUERY_FILTER_ALL":           0,
+		"LIBRARY_QUERY_FILTER_INSTALLED":     1,
+		"LIBRARY_QUERY_FILTER_NOT_INSTALLED": 2,
+		"LIBRARY_QUERY_FILTER_UPDATABLE":     3,
+	}
+)
+
+func (x LibraryQueryFilter) Enum() *LibraryQueryFilter {
+	p := new(LibraryQueryFilter)
+	*p = x
+	return p
+}
+
+func (x LibraryQueryFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibraryQueryFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[5].Descriptor()
+}
+
+func (LibraryQueryFilter) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[5]
+}
+
+func (x LibraryQueryFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibraryQueryFilter.Descriptor instead.
+func (LibraryQueryFilter) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{5}
+}
+
+type LibrarySymbolKind int32
+
+const (
+	// A class, a struct or a union.
+	LibrarySymbolKind_LIBRARY_SYMBOL_KIND_CLASS LibrarySymbolKind = 0
+	// An enum, a typedef or a type alias.
+	LibrarySymbolKind_LIBRARY_SYMBOL_KIND_TYPE LibrarySymbolKind = 1
+	// A function.
+	LibrarySymbolKind_LIBRARY_SYMBOL_KIND_FUNCTION LibrarySymbolKind = 2
+	// A global variable or object.
+	LibrarySymbolKind_LIBRARY_SYMBOL_KIND_VARIABLE LibrarySymbolKind = 3
+	// A preprocessor macro.
+	LibrarySymbolKind_LIBRARY_SYMBOL_KIND_MACRO LibrarySymbolKind = 4
+)
+
+// Enum value maps for LibrarySymbolKind.
+var (
+	LibrarySymbolKind_name = map[int32]string{
+		0: "LIBRARY_SYMBOL_KIND_CLASS",
+		1: "LIBRARY_SYMBOL_KIND_TYPE",
+		2: "LIBRARY_SYMBOL_KIND_FUNCTION",
+		3: "LIBRARY_SYMBOL_KIND_VARIABLE",
+		4: "LIBRARY_SYMBOL_KIND_MACRO",
+	}
+	LibrarySymbolKind_value = map[string]int32{
+		"LIBRARY_SYMBOL_KIND_CLASS":    0,
+		"LIBRARY_SYMBOL_KIND_TYPE":     1,
+		"LIBRARY_SYMBOL_KIND_FUNCTION": 2,
+		"LIBRARY_SYMBOL_KIND_VARIABLE": 3,
+		"LIBRARY_SYMBOL_KIND_MACRO":    4,
+	}
+)
+
+func (x LibrarySymbolKind) Enum() *LibrarySymbolKind {
+	p := new(LibrarySymbolKind)
+	*p = x
+	return p
+}
+
+func (x LibrarySymbolKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibrarySymbolKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[6].Descriptor()
+}
+
+func (LibrarySymbolKind) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[6]
+}
+
+func (x LibrarySymbolKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibrarySymbolKind.Descriptor instead.
+func (LibrarySymbolKind) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{6}
+}
+
+type LibraryInstallSource int32
+
+const (
+	// Copied in the libraries directory by the user, or installed by a version
+	// of the CLI that didn't record the source.
+	LibraryInstallSource_LIBRARY_INSTALL_SOURCE_LOCAL LibraryInstallSource = 0
+	// Installed from the libraries index.
+	LibraryInstallSource_LIBRARY_INSTALL_SOURCE_INDEX LibraryInstallSource = 1
+	// Installed from a git repository.
+	LibraryInstallSource_LIBRARY_INSTALL_SOURCE_GIT LibraryInstallSource = 2
+	// Installed from a zip archive.
+	LibraryInstallSource_LIBRARY_INSTALL_SOURCE_ZIP LibraryInstallSource = 3
+)
+
+// Enum value maps for LibraryInstallSource.
+var (
+	LibraryInstallSource_name = map[int32]string{
+		0: "LIBRARY_INSTALL_SOURCE_LOCAL",
+		1: "LIBRARY_INSTALL_SOURCE_INDEX",
+		2: "LIBRARY_INSTALL_SOURCE_GIT",
+		3: "LIBRARY_INSTALL_SOURCE_ZIP",
+	}
+	LibraryInstallSource_value = map[string]int32{
+		"LIBRARY_INSTALL_SOURCE_LOCAL": 0,
+		"LIBRARY_INSTALL_SOURCE_INDEX": 1,
+		"LIBRARY_INSTALL_SOURCE_GIT":   2,
+		"LIBRARY_INSTALL_SOURCE_ZIP":   3,
+	}
+)
+
+func (x LibraryInstallSource) Enum() *LibraryInstallSource {
+	p := new(LibraryInstallSource)
+	*p = x
+	return p
+}
+
+func (x LibraryInstallSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibraryInstallSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[7].Descriptor()
+}
+
+func (LibraryInstallSource) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[7]
+}
+
+func (x LibraryInstallSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibraryInstallSource.Descriptor instead.
+func (LibraryInstallSource) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{7}
 }
 
 type LibraryLayout int32
@@ -164,11 +497,11 @@ func (x LibraryLayout) String() string {
 }
 
 func (LibraryLayout) Descriptor() protoreflect.EnumDescriptor {
-	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[2].Descriptor()
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[8].Descriptor()
 }
 
 func (LibraryLayout) Type() protoreflect.EnumType {
-	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[2]
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[8]
 }
 
 func (x LibraryLayout) Number() protoreflect.EnumNumber {
@@ -177,7 +510,7 @@ func (x LibraryLayout) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LibraryLayout.Descriptor instead.
 func (LibraryLayout) EnumDescriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{2}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{8}
 }
 
 type LibraryLocation int32
@@ -195,6 +528,12 @@ const (
 	LibraryLocation_LIBRARY_LOCATION_REFERENCED_PLATFORM_BUILTIN LibraryLocation = 3
 	// Outside the `libraries` folders managed by the CLI.
 	LibraryLocation_LIBRARY_LOCATION_UNMANAGED LibraryLocation = 4
+	// In the folder of the libraries installed for the build profiles of the
+	// sketches.
+	LibraryLocation_LIBRARY_LOCATION_PROFILE LibraryLocation = 5
+	// Outside the `libraries` folders managed by the CLI, like
+	// `LIBRARY_LOCATION_UNMANAGED`, but vendored inside the sketch folder.
+	LibraryLocation_LIBRARY_LOCATION_SKETCH LibraryLocation = 6
 )
 
 // Enum value maps for LibraryLocation.
@@ -205,6 +544,8 @@ var (
 		2: "LIBRARY_LOCATION_PLATFORM_BUILTIN",
 		3: "LIBRARY_LOCATION_REFERENCED_PLATFORM_BUILTIN",
 		4: "LIBRARY_LOCATION_UNMANAGED",
+		5: "LIBRARY_LOCATION_PROFILE",
+		6: "LIBRARY_LOCATION_SKETCH",
 	}
 	LibraryLocation_value = map[string]int32{
 		"LIBRARY_LOCATION_BUILTIN":                     0,
@@ -212,6 +553,8 @@ var (
 		"LIBRARY_LOCATION_PLATFORM_BUILTIN":            2,
 		"LIBRARY_LOCATION_REFERENCED_PLATFORM_BUILTIN": 3,
 		"LIBRARY_LOCATION_UNMANAGED":                   4,
+		"LIBRARY_LOCATION_PROFILE":                     5,
+		"LIBRARY_LOCATION_SKETCH":                      6,
 	}
 )
 
@@ -226,11 +569,11 @@ func (x LibraryLocation) String() string {
 }
 
 func (LibraryLocation) Descriptor() protoreflect.EnumDescriptor {
-	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[3].Descriptor()
+	return file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[9].Descriptor()
 }
 
 func (LibraryLocation) Type() protoreflect.EnumType {
-	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[3]
+	return &file_cc_arduino_cli_commands_v1_lib_proto_enumTypes[9]
 }
 
 func (x LibraryLocation) Number() protoreflect.EnumNumber {
@@ -239,7 +582,7 @@ func (x LibraryLocation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LibraryLocation.Descriptor instead.
 func (LibraryLocation) EnumDescriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{3}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{9}
 }
 
 type LibraryDownloadRequest struct {
@@ -375,6 +718,14 @@ type LibraryInstallRequest struct {
 	NoOverwrite bool `protobuf:"varint,5,opt,name=no_overwrite,json=noOverwrite,proto3" json:"no_overwrite,omitempty"`
 	// Install the library and dependencies in the specified location
 	InstallLocation LibraryInstallLocation `protobuf:"varint,6,opt,name=install_location,json=installLocation,proto3,enum=cc.arduino.cli.commands.v1.LibraryInstallLocation" json:"install_location,omitempty"`
+	// The strategy used to choose the version of the dependencies.
+	Resolution LibraryResolutionStrategy `protobuf:"varint,7,opt,name=resolution,proto3,enum=cc.arduino.cli.commands.v1.LibraryResolutionStrategy" json:"resolution,omitempty"`
+	// Set to true to run the post-install hooks declared by the installed
+	// libraries, if the libraries are approved by the
+	// `library.post_install_libraries` setting and the permissions the hooks
+	// require are allowed by the `library.post_install_permissions` setting.
+	// Defaults to false.
+	RunPostInstall bool `protobuf:"varint,8,opt,name=run_post_install,json=runPostInstall,proto3" json:"run_post_install,omitempty"`
 }
 
 func (x *LibraryInstallRequest) Reset() {
@@ -451,6 +802,20 @@ func (x *LibraryInstallRequest) GetInstallLocation() LibraryInstallLocation {
 	return LibraryInstallLocation_LIBRARY_INSTALL_LOCATION_USER
 }
 
+func (x *LibraryInstallRequest) GetResolution() LibraryResolutionStrategy {
+	if x != nil {
+		return x.Resolution
+	}
+	return LibraryResolutionStrategy_LIBRARY_RESOLUTION_STRATEGY_LATEST
+}
+
+func (x *LibraryInstallRequest) GetRunPostInstall() bool {
+	if x != nil {
+		return x.RunPostInstall
+	}
+	return false
+}
+
 type LibraryInstallResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -746,17 +1111,26 @@ func (x *LibraryUninstallResponse) GetTaskProgress() *TaskProgress {
 	return nil
 }
 
-type LibraryUpgradeAllRequest struct {
+type LibraryPrecompileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Arduino Core Service instance from the `Init` response.
 	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Name of the installed library to precompile.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Fully Qualified Board Names of the boards to precompile the library for
+	// (e.g., `arduino:samd:mkr1000`).
+	Fqbns []string `protobuf:"bytes,3,rep,name=fqbns,proto3" json:"fqbns,omitempty"`
+	// Overwrite the archives already present in the library.
+	Overwrite bool `protobuf:"varint,4,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	// Turns on verbose mode.
+	Verbose bool `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty"`
 }
 
-func (x *LibraryUpgradeAllRequest) Reset() {
-	*x = LibraryUpgradeAllRequest{}
+func (x *LibraryPrecompileRequest) Reset() {
+	*x = LibraryPrecompileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -764,13 +1138,13 @@ func (x *LibraryUpgradeAllRequest) Reset() {
 	}
 }
 
-func (x *LibraryUpgradeAllRequest) String() string {
+func (x *LibraryPrecompileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryUpgradeAllRequest) ProtoMessage() {}
+func (*LibraryPrecompileRequest) ProtoMessage() {}
 
-func (x *LibraryUpgradeAllRequest) ProtoReflect() protoreflect.Message {
+func (x *LibraryPrecompileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -782,31 +1156,61 @@ func (x *LibraryUpgradeAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryUpgradeAllRequest.ProtoReflect.Descriptor instead.
-func (*LibraryUpgradeAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LibraryPrecompileRequest.ProtoReflect.Descriptor instead.
+func (*LibraryPrecompileRequest) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LibraryUpgradeAllRequest) GetInstance() *Instance {
+func (x *LibraryPrecompileRequest) GetInstance() *Instance {
 	if x != nil {
 		return x.Instance
 	}
 	return nil
 }
 
-type LibraryUpgradeAllResponse struct {
+func (x *LibraryPrecompileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LibraryPrecompileRequest) GetFqbns() []string {
+	if x != nil {
+		return x.Fqbns
+	}
+	return nil
+}
+
+func (x *LibraryPrecompileRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+func (x *LibraryPrecompileRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+type LibraryPrecompileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Progress of the downloads of files needed for the upgrades.
-	Progress *DownloadProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
-	// Description of the current stage of the upgrade.
-	TaskProgress *TaskProgress `protobuf:"bytes,2,opt,name=task_progress,json=taskProgress,proto3" json:"task_progress,omitempty"`
+	// Types that are assignable to Message:
+	//
+	//	*LibraryPrecompileResponse_OutStream
+	//	*LibraryPrecompileResponse_ErrStream
+	//	*LibraryPrecompileResponse_Result
+	Message isLibraryPrecompileResponse_Message `protobuf_oneof:"message"`
 }
 
-func (x *LibraryUpgradeAllResponse) Reset() {
-	*x = LibraryUpgradeAllResponse{}
+func (x *LibraryPrecompileResponse) Reset() {
+	*x = LibraryPrecompileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -814,13 +1218,13 @@ func (x *LibraryUpgradeAllResponse) Reset() {
 	}
 }
 
-func (x *LibraryUpgradeAllResponse) String() string {
+func (x *LibraryPrecompileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryUpgradeAllResponse) ProtoMessage() {}
+func (*LibraryPrecompileResponse) ProtoMessage() {}
 
-func (x *LibraryUpgradeAllResponse) ProtoReflect() protoreflect.Message {
+func (x *LibraryPrecompileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -832,41 +1236,75 @@ func (x *LibraryUpgradeAllResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryUpgradeAllResponse.ProtoReflect.Descriptor instead.
-func (*LibraryUpgradeAllResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LibraryPrecompileResponse.ProtoReflect.Descriptor instead.
+func (*LibraryPrecompileResponse) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LibraryUpgradeAllResponse) GetProgress() *DownloadProgress {
-	if x != nil {
-		return x.Progress
+func (m *LibraryPrecompileResponse) GetMessage() isLibraryPrecompileResponse_Message {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-func (x *LibraryUpgradeAllResponse) GetTaskProgress() *TaskProgress {
-	if x != nil {
-		return x.TaskProgress
+func (x *LibraryPrecompileResponse) GetOutStream() []byte {
+	if x, ok := x.GetMessage().(*LibraryPrecompileResponse_OutStream); ok {
+		return x.OutStream
 	}
 	return nil
 }
 
-type LibraryResolveDependenciesRequest struct {
+func (x *LibraryPrecompileResponse) GetErrStream() []byte {
+	if x, ok := x.GetMessage().(*LibraryPrecompileResponse_ErrStream); ok {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *LibraryPrecompileResponse) GetResult() *LibraryPrecompileResult {
+	if x, ok := x.GetMessage().(*LibraryPrecompileResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isLibraryPrecompileResponse_Message interface {
+	isLibraryPrecompileResponse_Message()
+}
+
+type LibraryPrecompileResponse_OutStream struct {
+	// The output of the compilations.
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3,oneof"`
+}
+
+type LibraryPrecompileResponse_ErrStream struct {
+	// The error output of the compilations.
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3,oneof"`
+}
+
+type LibraryPrecompileResponse_Result struct {
+	// The result of the precompilation.
+	Result *LibraryPrecompileResult `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+func (*LibraryPrecompileResponse_OutStream) isLibraryPrecompileResponse_Message() {}
+
+func (*LibraryPrecompileResponse_ErrStream) isLibraryPrecompileResponse_Message() {}
+
+func (*LibraryPrecompileResponse_Result) isLibraryPrecompileResponse_Message() {}
+
+type LibraryPrecompileResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Arduino Core Service instance from the `Init` response.
-	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	// Name of the library.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The version of the library to check dependencies of. If no version is
-	// specified, dependencies of the newest version will be listed.
-	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The paths of the generated archives.
+	Archives []string `protobuf:"bytes,1,rep,name=archives,proto3" json:"archives,omitempty"`
 }
 
-func (x *LibraryResolveDependenciesRequest) Reset() {
-	*x = LibraryResolveDependenciesRequest{}
+func (x *LibraryPrecompileResult) Reset() {
+	*x = LibraryPrecompileResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,13 +1312,13 @@ func (x *LibraryResolveDependenciesRequest) Reset() {
 	}
 }
 
-func (x *LibraryResolveDependenciesRequest) String() string {
+func (x *LibraryPrecompileResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryResolveDependenciesRequest) ProtoMessage() {}
+func (*LibraryPrecompileResult) ProtoMessage() {}
 
-func (x *LibraryResolveDependenciesRequest) ProtoReflect() protoreflect.Message {
+func (x *LibraryPrecompileResult) ProtoReflect() protoreflect.Message {
 	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -892,58 +1330,135 @@ func (x *LibraryResolveDependenciesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryResolveDependenciesRequest.ProtoReflect.Descriptor instead.
-func (*LibraryResolveDependenciesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LibraryPrecompileResult.ProtoReflect.Descriptor instead.
+func (*LibraryPrecompileResult) Descriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *LibraryResolveDependenciesRequest) GetInstance() *Instance {
+func (x *LibraryPrecompileResult) GetArchives() []string {
+	if x != nil {
+		return x.Archives
+	}
+	return nil
+}
+
+type LibraryCheckExamplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Name of the installed library or path of the folder of the library to
+	// check.
+	Library string `protobuf:"bytes,2,opt,name=library,proto3" json:"library,omitempty"`
+	// Fully Qualified Board Names of the boards to compile the examples for
+	// (e.g., `arduino:samd:mkr1000`).
+	Fqbns []string `protobuf:"bytes,3,rep,name=fqbns,proto3" json:"fqbns,omitempty"`
+	// Used to tell gcc which warning level to use (none, default, more, all).
+	Warnings string `protobuf:"bytes,4,opt,name=warnings,proto3" json:"warnings,omitempty"`
+	// Turns on verbose mode.
+	Verbose bool `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (x *LibraryCheckExamplesRequest) Reset() {
+	*x = LibraryCheckExamplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryCheckExamplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryCheckExamplesRequest) ProtoMessage() {}
+
+func (x *LibraryCheckExamplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryCheckExamplesRequest.ProtoReflect.Descriptor instead.
+func (*LibraryCheckExamplesRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LibraryCheckExamplesRequest) GetInstance() *Instance {
 	if x != nil {
 		return x.Instance
 	}
 	return nil
 }
 
-func (x *LibraryResolveDependenciesRequest) GetName() string {
+func (x *LibraryCheckExamplesRequest) GetLibrary() string {
 	if x != nil {
-		return x.Name
+		return x.Library
 	}
 	return ""
 }
 
-func (x *LibraryResolveDependenciesRequest) GetVersion() string {
+func (x *LibraryCheckExamplesRequest) GetFqbns() []string {
 	if x != nil {
-		return x.Version
+		return x.Fqbns
+	}
+	return nil
+}
+
+func (x *LibraryCheckExamplesRequest) GetWarnings() string {
+	if x != nil {
+		return x.Warnings
 	}
 	return ""
 }
 
-type LibraryResolveDependenciesResponse struct {
+func (x *LibraryCheckExamplesRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+type LibraryCheckExamplesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Dependencies of the library.
-	Dependencies []*LibraryDependencyStatus `protobuf:"bytes,1,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// Types that are assignable to Message:
+	//
+	//	*LibraryCheckExamplesResponse_OutStream
+	//	*LibraryCheckExamplesResponse_ErrStream
+	//	*LibraryCheckExamplesResponse_Check
+	//	*LibraryCheckExamplesResponse_Result
+	Message isLibraryCheckExamplesResponse_Message `protobuf_oneof:"message"`
 }
 
-func (x *LibraryResolveDependenciesResponse) Reset() {
-	*x = LibraryResolveDependenciesResponse{}
+func (x *LibraryCheckExamplesResponse) Reset() {
+	*x = LibraryCheckExamplesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[11]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LibraryResolveDependenciesResponse) String() string {
+func (x *LibraryCheckExamplesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryResolveDependenciesResponse) ProtoMessage() {}
+func (*LibraryCheckExamplesResponse) ProtoMessage() {}
 
-func (x *LibraryResolveDependenciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[11]
+func (x *LibraryCheckExamplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,48 +1469,113 @@ func (x *LibraryResolveDependenciesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryResolveDependenciesResponse.ProtoReflect.Descriptor instead.
-func (*LibraryResolveDependenciesResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LibraryCheckExamplesResponse.ProtoReflect.Descriptor instead.
+func (*LibraryCheckExamplesResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LibraryResolveDependenciesResponse) GetDependencies() []*LibraryDependencyStatus {
-	if x != nil {
-		return x.Dependencies
+func (m *LibraryCheckExamplesResponse) GetMessage() isLibraryCheckExamplesResponse_Message {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-type LibraryDependencyStatus struct {
+func (x *LibraryCheckExamplesResponse) GetOutStream() []byte {
+	if x, ok := x.GetMessage().(*LibraryCheckExamplesResponse_OutStream); ok {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *LibraryCheckExamplesResponse) GetErrStream() []byte {
+	if x, ok := x.GetMessage().(*LibraryCheckExamplesResponse_ErrStream); ok {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *LibraryCheckExamplesResponse) GetCheck() *LibraryExampleCheck {
+	if x, ok := x.GetMessage().(*LibraryCheckExamplesResponse_Check); ok {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *LibraryCheckExamplesResponse) GetResult() *LibraryCheckExamplesResult {
+	if x, ok := x.GetMessage().(*LibraryCheckExamplesResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isLibraryCheckExamplesResponse_Message interface {
+	isLibraryCheckExamplesResponse_Message()
+}
+
+type LibraryCheckExamplesResponse_OutStream struct {
+	// The output of the compilations.
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3,oneof"`
+}
+
+type LibraryCheckExamplesResponse_ErrStream struct {
+	// The error output of the compilations.
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3,oneof"`
+}
+
+type LibraryCheckExamplesResponse_Check struct {
+	// The result of the check of an example for a board, sent as soon as it
+	// completes.
+	Check *LibraryExampleCheck `protobuf:"bytes,3,opt,name=check,proto3,oneof"`
+}
+
+type LibraryCheckExamplesResponse_Result struct {
+	// The result of the checks of all the examples.
+	Result *LibraryCheckExamplesResult `protobuf:"bytes,4,opt,name=result,proto3,oneof"`
+}
+
+func (*LibraryCheckExamplesResponse_OutStream) isLibraryCheckExamplesResponse_Message() {}
+
+func (*LibraryCheckExamplesResponse_ErrStream) isLibraryCheckExamplesResponse_Message() {}
+
+func (*LibraryCheckExamplesResponse_Check) isLibraryCheckExamplesResponse_Message() {}
+
+func (*LibraryCheckExamplesResponse_Result) isLibraryCheckExamplesResponse_Message() {}
+
+type LibraryExampleCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the library dependency.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The required version of the library dependency.
-	VersionRequired string `protobuf:"bytes,2,opt,name=version_required,json=versionRequired,proto3" json:"version_required,omitempty"`
-	// Version of the library dependency currently installed.
-	VersionInstalled string `protobuf:"bytes,3,opt,name=version_installed,json=versionInstalled,proto3" json:"version_installed,omitempty"`
+	// Path of the example, relative to the folder of the library.
+	Example string `protobuf:"bytes,1,opt,name=example,proto3" json:"example,omitempty"`
+	// FQBN of the board the example has been compiled for.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Result of the compilation.
+	Status LibraryExampleCheckStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cc.arduino.cli.commands.v1.LibraryExampleCheckStatus" json:"status,omitempty"`
+	// The error output of the failed compilations.
+	Output string `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	// The number of warnings reported by the compiler.
+	Warnings int32 `protobuf:"varint,5,opt,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *LibraryDependencyStatus) Reset() {
-	*x = LibraryDependencyStatus{}
+func (x *LibraryExampleCheck) Reset() {
+	*x = LibraryExampleCheck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[12]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LibraryDependencyStatus) String() string {
+func (x *LibraryExampleCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryDependencyStatus) ProtoMessage() {}
+func (*LibraryExampleCheck) ProtoMessage() {}
 
-func (x *LibraryDependencyStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[12]
+func (x *LibraryExampleCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1006,63 +1586,80 @@ func (x *LibraryDependencyStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryDependencyStatus.ProtoReflect.Descriptor instead.
-func (*LibraryDependencyStatus) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use LibraryExampleCheck.ProtoReflect.Descriptor instead.
+func (*LibraryExampleCheck) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LibraryDependencyStatus) GetName() string {
+func (x *LibraryExampleCheck) GetExample() string {
 	if x != nil {
-		return x.Name
+		return x.Example
 	}
 	return ""
 }
 
-func (x *LibraryDependencyStatus) GetVersionRequired() string {
+func (x *LibraryExampleCheck) GetFqbn() string {
 	if x != nil {
-		return x.VersionRequired
+		return x.Fqbn
 	}
 	return ""
 }
 
-func (x *LibraryDependencyStatus) GetVersionInstalled() string {
+func (x *LibraryExampleCheck) GetStatus() LibraryExampleCheckStatus {
 	if x != nil {
-		return x.VersionInstalled
+		return x.Status
+	}
+	return LibraryExampleCheckStatus_LIBRARY_EXAMPLE_CHECK_STATUS_PASSED
+}
+
+func (x *LibraryExampleCheck) GetOutput() string {
+	if x != nil {
+		return x.Output
 	}
 	return ""
 }
 
-type LibrarySearchRequest struct {
+func (x *LibraryExampleCheck) GetWarnings() int32 {
+	if x != nil {
+		return x.Warnings
+	}
+	return 0
+}
+
+type LibraryCheckExamplesResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Arduino Core Service instance from the `Init` response.
-	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	// Set to true to not populate the releases field in the response (may save a
-	// lot of bandwidth/CPU).
-	OmitReleasesDetails bool `protobuf:"varint,2,opt,name=omit_releases_details,json=omitReleasesDetails,proto3" json:"omit_releases_details,omitempty"`
-	// Keywords for the search.
-	SearchArgs string `protobuf:"bytes,3,opt,name=search_args,json=searchArgs,proto3" json:"search_args,omitempty"`
+	// Name of the checked library.
+	Library string `protobuf:"bytes,1,opt,name=library,proto3" json:"library,omitempty"`
+	// Paths of the examples, relative to the folder of the library.
+	Examples []string `protobuf:"bytes,2,rep,name=examples,proto3" json:"examples,omitempty"`
+	// FQBNs of the boards the examples have been compiled for.
+	Fqbns []string `protobuf:"bytes,3,rep,name=fqbns,proto3" json:"fqbns,omitempty"`
+	// The results of the checks, for each example and board.
+	Checks []*LibraryExampleCheck `protobuf:"bytes,4,rep,name=checks,proto3" json:"checks,omitempty"`
+	// The number of failed compilations.
+	Failed int32 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
-func (x *LibrarySearchRequest) Reset() {
-	*x = LibrarySearchRequest{}
+func (x *LibraryCheckExamplesResult) Reset() {
+	*x = LibraryCheckExamplesResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[13]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LibrarySearchRequest) String() string {
+func (x *LibraryCheckExamplesResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibrarySearchRequest) ProtoMessage() {}
+func (*LibraryCheckExamplesResult) ProtoMessage() {}
 
-func (x *LibrarySearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[13]
+func (x *LibraryCheckExamplesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,60 +1670,74 @@ func (x *LibrarySearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibrarySearchRequest.ProtoReflect.Descriptor instead.
-func (*LibrarySearchRequest) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use LibraryCheckExamplesResult.ProtoReflect.Descriptor instead.
+func (*LibraryCheckExamplesResult) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LibrarySearchRequest) GetInstance() *Instance {
+func (x *LibraryCheckExamplesResult) GetLibrary() string {
 	if x != nil {
-		return x.Instance
+		return x.Library
+	}
+	return ""
+}
+
+func (x *LibraryCheckExamplesResult) GetExamples() []string {
+	if x != nil {
+		return x.Examples
 	}
 	return nil
 }
 
-func (x *LibrarySearchRequest) GetOmitReleasesDetails() bool {
+func (x *LibraryCheckExamplesResult) GetFqbns() []string {
 	if x != nil {
-		return x.OmitReleasesDetails
+		return x.Fqbns
 	}
-	return false
+	return nil
 }
 
-func (x *LibrarySearchRequest) GetSearchArgs() string {
+func (x *LibraryCheckExamplesResult) GetChecks() []*LibraryExampleCheck {
 	if x != nil {
-		return x.SearchArgs
+		return x.Checks
 	}
-	return ""
+	return nil
 }
 
-type LibrarySearchResponse struct {
+func (x *LibraryCheckExamplesResult) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type LibraryProperty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The results of the search.
-	Libraries []*SearchedLibrary `protobuf:"bytes,1,rep,name=libraries,proto3" json:"libraries,omitempty"`
-	// Whether the search yielded results.
-	Status LibrarySearchStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cc.arduino.cli.commands.v1.LibrarySearchStatus" json:"status,omitempty"`
+	// Name of the field.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Value of the field.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *LibrarySearchResponse) Reset() {
-	*x = LibrarySearchResponse{}
+func (x *LibraryProperty) Reset() {
+	*x = LibraryProperty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[14]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LibrarySearchResponse) String() string {
+func (x *LibraryProperty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibrarySearchResponse) ProtoMessage() {}
+func (*LibraryProperty) ProtoMessage() {}
 
-func (x *LibrarySearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[14]
+func (x *LibraryProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,58 +1748,1700 @@ func (x *LibrarySearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibrarySearchResponse.ProtoReflect.Descriptor instead.
-func (*LibrarySearchResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use LibraryProperty.ProtoReflect.Descriptor instead.
+func (*LibraryProperty) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LibrarySearchResponse) GetLibraries() []*SearchedLibrary {
+func (x *LibraryProperty) GetName() string {
 	if x != nil {
-		return x.Libraries
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *LibrarySearchResponse) GetStatus() LibrarySearchStatus {
+func (x *LibraryProperty) GetValue() string {
 	if x != nil {
-		return x.Status
+		return x.Value
 	}
-	return LibrarySearchStatus_LIBRARY_SEARCH_STATUS_FAILED
+	return ""
 }
 
-type SearchedLibrary struct {
+type LibraryPropertiesGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Library name.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The index data for the available versions of the library. The key of the
-	// map is the library version.
-	Releases map[string]*LibraryRelease `protobuf:"bytes,2,rep,name=releases,proto3" json:"releases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The index data for the latest version of the library.
-	Latest *LibraryRelease `protobuf:"bytes,3,opt,name=latest,proto3" json:"latest,omitempty"`
-	// The available versions of this library.
-	AvailableVersions []string `protobuf:"bytes,4,rep,name=available_versions,json=availableVersions,proto3" json:"available_versions,omitempty"`
+	// Path of the folder of the library.
+	LibraryPath string `protobuf:"bytes,1,opt,name=library_path,json=libraryPath,proto3" json:"library_path,omitempty"`
+	// Names of the fields to read, all the fields if empty.
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *LibraryPropertiesGetRequest) Reset() {
+	*x = LibraryPropertiesGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryPropertiesGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryPropertiesGetRequest) ProtoMessage() {}
+
+func (x *LibraryPropertiesGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryPropertiesGetRequest.ProtoReflect.Descriptor instead.
+func (*LibraryPropertiesGetRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LibraryPropertiesGetRequest) GetLibraryPath() string {
+	if x != nil {
+		return x.LibraryPath
+	}
+	return ""
+}
+
+func (x *LibraryPropertiesGetRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type LibraryPropertiesGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fields of the library.properties, in the order of the file.
+	Properties []*LibraryProperty `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *LibraryPropertiesGetResponse) Reset() {
+	*x = LibraryPropertiesGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryPropertiesGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryPropertiesGetResponse) ProtoMessage() {}
+
+func (x *LibraryPropertiesGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryPropertiesGetResponse.ProtoReflect.Descriptor instead.
+func (*LibraryPropertiesGetResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LibraryPropertiesGetResponse) GetProperties() []*LibraryProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type LibraryPropertiesSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the folder of the library.
+	LibraryPath string `protobuf:"bytes,1,opt,name=library_path,json=libraryPath,proto3" json:"library_path,omitempty"`
+	// The fields to set, added to the file if not present.
+	Properties []*LibraryProperty `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+	// Names of the fields to remove.
+	Unset []string `protobuf:"bytes,3,rep,name=unset,proto3" json:"unset,omitempty"`
+	// Increment the version of the library.
+	BumpVersion LibraryVersionBump `protobuf:"varint,4,opt,name=bump_version,json=bumpVersion,proto3,enum=cc.arduino.cli.commands.v1.LibraryVersionBump" json:"bump_version,omitempty"`
+	// Architectures to add to the `architectures` field.
+	AddArchitectures []string `protobuf:"bytes,5,rep,name=add_architectures,json=addArchitectures,proto3" json:"add_architectures,omitempty"`
+	// Architectures to remove from the `architectures` field.
+	RemoveArchitectures []string `protobuf:"bytes,6,rep,name=remove_architectures,json=removeArchitectures,proto3" json:"remove_architectures,omitempty"`
+	// Validate the changes without writing the file.
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *LibraryPropertiesSetRequest) Reset() {
+	*x = LibraryPropertiesSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryPropertiesSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryPropertiesSetRequest) ProtoMessage() {}
+
+func (x *LibraryPropertiesSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryPropertiesSetRequest.ProtoReflect.Descriptor instead.
+func (*LibraryPropertiesSetRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LibraryPropertiesSetRequest) GetLibraryPath() string {
+	if x != nil {
+		return x.LibraryPath
+	}
+	return ""
+}
+
+func (x *LibraryPropertiesSetRequest) GetProperties() []*LibraryProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *LibraryPropertiesSetRequest) GetUnset() []string {
+	if x != nil {
+		return x.Unset
+	}
+	return nil
+}
+
+func (x *LibraryPropertiesSetRequest) GetBumpVersion() LibraryVersionBump {
+	if x != nil {
+		return x.BumpVersion
+	}
+	return LibraryVersionBump_LIBRARY_VERSION_BUMP_NONE
+}
+
+func (x *LibraryPropertiesSetRequest) GetAddArchitectures() []string {
+	if x != nil {
+		return x.AddArchitectures
+	}
+	return nil
+}
+
+func (x *LibraryPropertiesSetRequest) GetRemoveArchitectures() []string {
+	if x != nil {
+		return x.RemoveArchitectures
+	}
+	return nil
+}
+
+func (x *LibraryPropertiesSetRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type LibraryPropertiesSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fields of the library.properties after the changes, in the order of
+	// the file.
+	Properties []*LibraryProperty `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	// The changed fields, with their new value (empty for the removed fields).
+	Changed []*LibraryProperty `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (x *LibraryPropertiesSetResponse) Reset() {
+	*x = LibraryPropertiesSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryPropertiesSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryPropertiesSetResponse) ProtoMessage() {}
+
+func (x *LibraryPropertiesSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryPropertiesSetResponse.ProtoReflect.Descriptor instead.
+func (*LibraryPropertiesSetResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LibraryPropertiesSetResponse) GetProperties() []*LibraryProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *LibraryPropertiesSetResponse) GetChanged() []*LibraryProperty {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+type LibraryUpgradeAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+}
+
+func (x *LibraryUpgradeAllRequest) Reset() {
+	*x = LibraryUpgradeAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryUpgradeAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryUpgradeAllRequest) ProtoMessage() {}
+
+func (x *LibraryUpgradeAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryUpgradeAllRequest.ProtoReflect.Descriptor instead.
+func (*LibraryUpgradeAllRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LibraryUpgradeAllRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+type LibraryUpgradeAllResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Progress of the downloads of files needed for the upgrades.
+	Progress *DownloadProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Description of the current stage of the upgrade.
+	TaskProgress *TaskProgress `protobuf:"bytes,2,opt,name=task_progress,json=taskProgress,proto3" json:"task_progress,omitempty"`
+}
+
+func (x *LibraryUpgradeAllResponse) Reset() {
+	*x = LibraryUpgradeAllResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryUpgradeAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryUpgradeAllResponse) ProtoMessage() {}
+
+func (x *LibraryUpgradeAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryUpgradeAllResponse.ProtoReflect.Descriptor instead.
+func (*LibraryUpgradeAllResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LibraryUpgradeAllResponse) GetProgress() *DownloadProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *LibraryUpgradeAllResponse) GetTaskProgress() *TaskProgress {
+	if x != nil {
+		return x.TaskProgress
+	}
+	return nil
+}
+
+type LibraryResolveDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Name of the library.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The version of the library to check dependencies of. If no version is
+	// specified, dependencies of the newest version will be listed.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The strategy used to choose the version of the dependencies.
+	Resolution LibraryResolutionStrategy `protobuf:"varint,4,opt,name=resolution,proto3,enum=cc.arduino.cli.commands.v1.LibraryResolutionStrategy" json:"resolution,omitempty"`
+}
+
+func (x *LibraryResolveDependenciesRequest) Reset() {
+	*x = LibraryResolveDependenciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryResolveDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryResolveDependenciesRequest) ProtoMessage() {}
+
+func (x *LibraryResolveDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryResolveDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*LibraryResolveDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LibraryResolveDependenciesRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *LibraryResolveDependenciesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LibraryResolveDependenciesRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LibraryResolveDependenciesRequest) GetResolution() LibraryResolutionStrategy {
+	if x != nil {
+		return x.Resolution
+	}
+	return LibraryResolutionStrategy_LIBRARY_RESOLUTION_STRATEGY_LATEST
+}
+
+type LibraryResolveDependenciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dependencies of the library.
+	Dependencies []*LibraryDependencyStatus `protobuf:"bytes,1,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *LibraryResolveDependenciesResponse) Reset() {
+	*x = LibraryResolveDependenciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryResolveDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryResolveDependenciesResponse) ProtoMessage() {}
+
+func (x *LibraryResolveDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryResolveDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*LibraryResolveDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LibraryResolveDependenciesResponse) GetDependencies() []*LibraryDependencyStatus {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type LibraryDependencyStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the library dependency.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The required version of the library dependency.
+	VersionRequired string `protobuf:"bytes,2,opt,name=version_required,json=versionRequired,proto3" json:"version_required,omitempty"`
+	// Version of the library dependency currently installed.
+	VersionInstalled string `protobuf:"bytes,3,opt,name=version_installed,json=versionInstalled,proto3" json:"version_installed,omitempty"`
+}
+
+func (x *LibraryDependencyStatus) Reset() {
+	*x = LibraryDependencyStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryDependencyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryDependencyStatus) ProtoMessage() {}
+
+func (x *LibraryDependencyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryDependencyStatus.ProtoReflect.Descriptor instead.
+func (*LibraryDependencyStatus) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LibraryDependencyStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LibraryDependencyStatus) GetVersionRequired() string {
+	if x != nil {
+		return x.VersionRequired
+	}
+	return ""
+}
+
+func (x *LibraryDependencyStatus) GetVersionInstalled() string {
+	if x != nil {
+		return x.VersionInstalled
+	}
+	return ""
+}
+
+type LibrarySearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Set to true to not populate the releases field in the response (may save a
+	// lot of bandwidth/CPU).
+	OmitReleasesDetails bool `protobuf:"varint,2,opt,name=omit_releases_details,json=omitReleasesDetails,proto3" json:"omit_releases_details,omitempty"`
+	// Keywords for the search.
+	SearchArgs string `protobuf:"bytes,3,opt,name=search_args,json=searchArgs,proto3" json:"search_args,omitempty"`
+	// The number of results to skip, to get the following pages of results.
+	Offset uint32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The max number of results to return, 0 means no limit.
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Set to true to tolerate typos in the search terms, a term also matches the
+	// words that differ by one edit (two for terms longer than 7 characters).
+	Fuzzy bool `protobuf:"varint,6,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+}
+
+func (x *LibrarySearchRequest) Reset() {
+	*x = LibrarySearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibrarySearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibrarySearchRequest) ProtoMessage() {}
+
+func (x *LibrarySearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibrarySearchRequest.ProtoReflect.Descriptor instead.
+func (*LibrarySearchRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LibrarySearchRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *LibrarySearchRequest) GetOmitReleasesDetails() bool {
+	if x != nil {
+		return x.OmitReleasesDetails
+	}
+	return false
+}
+
+func (x *LibrarySearchRequest) GetSearchArgs() string {
+	if x != nil {
+		return x.SearchArgs
+	}
+	return ""
+}
+
+func (x *LibrarySearchRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LibrarySearchRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LibrarySearchRequest) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+type LibrarySearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The results of the search.
+	Libraries []*SearchedLibrary `protobuf:"bytes,1,rep,name=libraries,proto3" json:"libraries,omitempty"`
+	// Whether the search yielded results.
+	Status LibrarySearchStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cc.arduino.cli.commands.v1.LibrarySearchStatus" json:"status,omitempty"`
+	// The total number of libraries matching the search, including the ones
+	// excluded by the `offset` and `limit` of the request.
+	Total uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *LibrarySearchResponse) Reset() {
+	*x = LibrarySearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibrarySearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibrarySearchResponse) ProtoMessage() {}
+
+func (x *LibrarySearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibrarySearchResponse.ProtoReflect.Descriptor instead.
+func (*LibrarySearchResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LibrarySearchResponse) GetLibraries() []*SearchedLibrary {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+func (x *LibrarySearchResponse) GetStatus() LibrarySearchStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LibrarySearchStatus_LIBRARY_SEARCH_STATUS_FAILED
+}
+
+func (x *LibrarySearchResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SearchedLibrary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Library name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The index data for the available versions of the library. The key of the
+	// map is the library version.
+	Releases map[string]*LibraryRelease `protobuf:"bytes,2,rep,name=releases,proto3" json:"releases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The index data for the latest version of the library.
+	Latest *LibraryRelease `protobuf:"bytes,3,opt,name=latest,proto3" json:"latest,omitempty"`
+	// The available versions of this library.
+	AvailableVersions []string `protobuf:"bytes,4,rep,name=available_versions,json=availableVersions,proto3" json:"available_versions,omitempty"`
+}
+
+func (x *SearchedLibrary) Reset() {
+	*x = SearchedLibrary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchedLibrary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchedLibrary) ProtoMessage() {}
+
+func (x *SearchedLibrary) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchedLibrary.ProtoReflect.Descriptor instead.
+func (*SearchedLibrary) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchedLibrary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchedLibrary) GetReleases() map[string]*LibraryRelease {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+func (x *SearchedLibrary) GetLatest() *LibraryRelease {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *SearchedLibrary) GetAvailableVersions() []string {
+	if x != nil {
+		return x.AvailableVersions
+	}
+	return nil
+}
+
+type LibraryRelease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value of the `author` field in library.properties.
+	Author string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	// Value of the `version` field in library.properties.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Value of the `maintainer` field in library.properties.
+	Maintainer string `protobuf:"bytes,3,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	// Value of the `sentence` field in library.properties.
+	Sentence string `protobuf:"bytes,4,opt,name=sentence,proto3" json:"sentence,omitempty"`
+	// Value of the `paragraph` field in library.properties.
+	Paragraph string `protobuf:"bytes,5,opt,name=paragraph,proto3" json:"paragraph,omitempty"`
+	// Value of the `url` field in library.properties.
+	Website string `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
+	// Value of the `category` field in library.properties.
+	Category string `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	// Value of the `architectures` field in library.properties.
+	Architectures []string `protobuf:"bytes,8,rep,name=architectures,proto3" json:"architectures,omitempty"`
+	// The type categories of the library, as defined in the libraries index.
+	// Possible values: `Arduino`, `Partner`, `Recommended`, `Contributed`,
+	// `Retired`.
+	Types []string `protobuf:"bytes,9,rep,name=types,proto3" json:"types,omitempty"`
+	// Information about the library archive file.
+	Resources *DownloadResource `protobuf:"bytes,10,opt,name=resources,proto3" json:"resources,omitempty"`
+	// Value of the `license` field in library.properties.
+	License string `protobuf:"bytes,11,opt,name=license,proto3" json:"license,omitempty"`
+	// Value of the `includes` field in library.properties.
+	ProvidesIncludes []string `protobuf:"bytes,12,rep,name=provides_includes,json=providesIncludes,proto3" json:"provides_includes,omitempty"`
+	// The names of the library's dependencies, as defined by the 'depends'
+	// field of library.properties.
+	Dependencies []*LibraryDependency `protobuf:"bytes,13,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *LibraryRelease) Reset() {
+	*x = LibraryRelease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryRelease) ProtoMessage() {}
+
+func (x *LibraryRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryRelease.ProtoReflect.Descriptor instead.
+func (*LibraryRelease) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LibraryRelease) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *LibraryRelease) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LibraryRelease) GetMaintainer() string {
+	if x != nil {
+		return x.Maintainer
+	}
+	return ""
+}
+
+func (x *LibraryRelease) GetSentence() string {
+	if x != nil {
+		return x.Sentence
+	}
+	return ""
+}
+
+func (x *LibraryRelease) GetParagraph() string {
+	if x != nil {
+		return x.Paragraph
+	}
+	return ""
+}
+
+func (x *LibraryRelease) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *LibraryRelease) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *LibraryRelease) GetArchitectures() []string {
+	if x != nil {
+		return x.Architectures
+	}
+	return nil
+}
+
+func (x *LibraryRelease) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *LibraryRelease) GetResources() *DownloadResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *LibraryRelease) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *LibraryRelease) GetProvidesIncludes() []string {
+	if x != nil {
+		return x.ProvidesIncludes
+	}
+	return nil
+}
+
+func (x *LibraryRelease) GetDependencies() []*LibraryDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type LibraryDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Library name of the dependency.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version constraint of the dependency.
+	VersionConstraint string `protobuf:"bytes,2,opt,name=version_constraint,json=versionConstraint,proto3" json:"version_constraint,omitempty"`
+}
+
+func (x *LibraryDependency) Reset() {
+	*x = LibraryDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryDependency) ProtoMessage() {}
+
+func (x *LibraryDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryDependency.ProtoReflect.Descriptor instead.
+func (*LibraryDependency) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LibraryDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LibraryDependency) GetVersionConstraint() string {
+	if x != nil {
+		return x.VersionConstraint
+	}
+	return ""
+}
+
+type DownloadResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Download URL of the library archive.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Filename of the library archive.
+	ArchiveFilename string `protobuf:"bytes,2,opt,name=archive_filename,json=archiveFilename,proto3" json:"archive_filename,omitempty"`
+	// Checksum of the library archive.
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// File size of the library archive.
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// The directory under the staging subdirectory of the data directory the
+	// library archive file will be downloaded to.
+	CachePath string `protobuf:"bytes,5,opt,name=cache_path,json=cachePath,proto3" json:"cache_path,omitempty"`
+}
+
+func (x *DownloadResource) Reset() {
+	*x = DownloadResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadResource) ProtoMessage() {}
+
+func (x *DownloadResource) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadResource.ProtoReflect.Descriptor instead.
+func (*DownloadResource) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DownloadResource) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DownloadResource) GetArchiveFilename() string {
+	if x != nil {
+		return x.ArchiveFilename
+	}
+	return ""
+}
+
+func (x *DownloadResource) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *DownloadResource) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadResource) GetCachePath() string {
+	if x != nil {
+		return x.CachePath
+	}
+	return ""
+}
+
+type LibraryListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Whether to include built-in libraries (from platforms and the Arduino
+	// IDE) in the listing.
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	// Whether to list only libraries for which there is a newer version than
+	// the installed version available in the libraries index.
+	Updatable bool `protobuf:"varint,3,opt,name=updatable,proto3" json:"updatable,omitempty"`
+	// If set filters out the libraries not matching name
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// By setting this field all duplicate libraries are filtered out leaving
+	// only the libraries that will be used to compile for the specified board
+	// FQBN.
+	Fqbn string `protobuf:"bytes,5,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Whether to list only the libraries installed from the libraries index,
+	// git or zip whose files have been changed, added or removed since the
+	// installation.
+	Modified bool `protobuf:"varint,6,opt,name=modified,proto3" json:"modified,omitempty"`
+	// If greater than zero, lists only the libraries that have not been used by
+	// a compilation in the last `unused_since_seconds` seconds. The libraries
+	// never used are considered used when they have been installed or when the
+	// recording of the usage has started.
+	UnusedSinceSeconds int64 `protobuf:"varint,7,opt,name=unused_since_seconds,json=unusedSinceSeconds,proto3" json:"unused_since_seconds,omitempty"`
+	// If not empty, lists only the libraries installed in these locations. The
+	// libraries built-in in the platforms and the IDE are included if their
+	// location is requested, even if `all` is not set.
+	Locations []LibraryLocation `protobuf:"varint,8,rep,packed,name=locations,proto3,enum=cc.arduino.cli.commands.v1.LibraryLocation" json:"locations,omitempty"`
+}
+
+func (x *LibraryListRequest) Reset() {
+	*x = LibraryListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryListRequest) ProtoMessage() {}
+
+func (x *LibraryListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryListRequest.ProtoReflect.Descriptor instead.
+func (*LibraryListRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LibraryListRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *LibraryListRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *LibraryListRequest) GetUpdatable() bool {
+	if x != nil {
+		return x.Updatable
+	}
+	return false
+}
+
+func (x *LibraryListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LibraryListRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *LibraryListRequest) GetModified() bool {
+	if x != nil {
+		return x.Modified
+	}
+	return false
+}
+
+func (x *LibraryListRequest) GetUnusedSinceSeconds() int64 {
+	if x != nil {
+		return x.UnusedSinceSeconds
+	}
+	return 0
+}
+
+func (x *LibraryListRequest) GetLocations() []LibraryLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type LibraryListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of installed libraries.
+	InstalledLibraries []*InstalledLibrary `protobuf:"bytes,1,rep,name=installed_libraries,json=installedLibraries,proto3" json:"installed_libraries,omitempty"`
+}
+
+func (x *LibraryListResponse) Reset() {
+	*x = LibraryListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryListResponse) ProtoMessage() {}
+
+func (x *LibraryListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryListResponse.ProtoReflect.Descriptor instead.
+func (*LibraryListResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LibraryListResponse) GetInstalledLibraries() []*InstalledLibrary {
+	if x != nil {
+		return x.InstalledLibraries
+	}
+	return nil
+}
+
+type InstalledLibrary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Information about the library.
+	Library *Library `protobuf:"bytes,1,opt,name=library,proto3" json:"library,omitempty"`
+	// When the `updatable` field of the `LibraryList` request is set to `true`,
+	// this will contain information on the latest version of the library in the
+	// libraries index.
+	Release *LibraryRelease `protobuf:"bytes,2,opt,name=release,proto3" json:"release,omitempty"`
+	// When the `modified` field of the `LibraryList` request is set to `true`,
+	// this will contain the files, relative to the library folder, that have
+	// been changed, added or removed since the installation.
+	ModifiedFiles []string `protobuf:"bytes,3,rep,name=modified_files,json=modifiedFiles,proto3" json:"modified_files,omitempty"`
+	// When the `unused_since_seconds` field of the `LibraryList` request is set,
+	// or in the `LibraryUsage` response, the usage of the library by the
+	// compilations.
+	Usage *LibraryUsage `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *InstalledLibrary) Reset() {
+	*x = InstalledLibrary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledLibrary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledLibrary) ProtoMessage() {}
+
+func (x *InstalledLibrary) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledLibrary.ProtoReflect.Descriptor instead.
+func (*InstalledLibrary) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InstalledLibrary) GetLibrary() *Library {
+	if x != nil {
+		return x.Library
+	}
+	return nil
+}
+
+func (x *InstalledLibrary) GetRelease() *LibraryRelease {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *InstalledLibrary) GetModifiedFiles() []string {
+	if x != nil {
+		return x.ModifiedFiles
+	}
+	return nil
+}
+
+func (x *InstalledLibrary) GetUsage() *LibraryUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type LibraryUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The last time, as seconds since the Unix epoch, the library has been used
+	// by a compilation, 0 if it has never been used since the recording of the
+	// usage has started.
+	LastUsed int64 `protobuf:"varint,1,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	// The number of compilations that used the library.
+	Compiles uint32 `protobuf:"varint,2,opt,name=compiles,proto3" json:"compiles,omitempty"`
+	// The last time, as seconds since the Unix epoch, the library has been used
+	// or, if never used, installed or the recording of the usage has started.
+	LastActivity int64 `protobuf:"varint,3,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+}
+
+func (x *LibraryUsage) Reset() {
+	*x = LibraryUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryUsage) ProtoMessage() {}
+
+func (x *LibraryUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryUsage.ProtoReflect.Descriptor instead.
+func (*LibraryUsage) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LibraryUsage) GetLastUsed() int64 {
+	if x != nil {
+		return x.LastUsed
+	}
+	return 0
+}
+
+func (x *LibraryUsage) GetCompiles() uint32 {
+	if x != nil {
+		return x.Compiles
+	}
+	return 0
+}
+
+func (x *LibraryUsage) GetLastActivity() int64 {
+	if x != nil {
+		return x.LastActivity
+	}
+	return 0
+}
+
+type LibraryUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Whether to include built-in libraries (from platforms and the Arduino
+	// IDE).
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *LibraryUsageRequest) Reset() {
+	*x = LibraryUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryUsageRequest) ProtoMessage() {}
+
+func (x *LibraryUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryUsageRequest.ProtoReflect.Descriptor instead.
+func (*LibraryUsageRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LibraryUsageRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *LibraryUsageRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type LibraryUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The installed libraries with their usage.
+	Libraries []*InstalledLibrary `protobuf:"bytes,1,rep,name=libraries,proto3" json:"libraries,omitempty"`
+	// The time, as seconds since the Unix epoch, the recording of the usage has
+	// started.
+	RecordingStarted int64 `protobuf:"varint,2,opt,name=recording_started,json=recordingStarted,proto3" json:"recording_started,omitempty"`
+}
+
+func (x *LibraryUsageResponse) Reset() {
+	*x = LibraryUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryUsageResponse) ProtoMessage() {}
+
+func (x *LibraryUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryUsageResponse.ProtoReflect.Descriptor instead.
+func (*LibraryUsageResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LibraryUsageResponse) GetLibraries() []*InstalledLibrary {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+func (x *LibraryUsageResponse) GetRecordingStarted() int64 {
+	if x != nil {
+		return x.RecordingStarted
+	}
+	return 0
+}
+
+type LibraryListConflictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The FQBN of the board to check the conflicts for: the libraries bundled
+	// with the board platform are included and its architecture is used to
+	// select the libraries. If empty the libraries bundled with the platforms
+	// are not considered.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+}
+
+func (x *LibraryListConflictsRequest) Reset() {
+	*x = LibraryListConflictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryListConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryListConflictsRequest) ProtoMessage() {}
+
+func (x *LibraryListConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryListConflictsRequest.ProtoReflect.Descriptor instead.
+func (*LibraryListConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LibraryListConflictsRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *LibraryListConflictsRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+type LibraryListConflictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The conflicts, sorted by header.
+	Conflicts []*LibraryConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *LibraryListConflictsResponse) Reset() {
+	*x = LibraryListConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryListConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryListConflictsResponse) ProtoMessage() {}
+
+func (x *LibraryListConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryListConflictsResponse.ProtoReflect.Descriptor instead.
+func (*LibraryListConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LibraryListConflictsResponse) GetConflicts() []*LibraryConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type LibraryConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The header provided by more than one library.
+	Header string `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The library selected when compiling a sketch including the header.
+	Selected *Library `protobuf:"bytes,2,opt,name=selected,proto3" json:"selected,omitempty"`
+	// The reason why the library is selected.
+	SelectionReason string `protobuf:"bytes,3,opt,name=selection_reason,json=selectionReason,proto3" json:"selection_reason,omitempty"`
+	// The other libraries providing the header, shadowed by the selected one.
+	Shadowed []*Library `protobuf:"bytes,4,rep,name=shadowed,proto3" json:"shadowed,omitempty"`
 }
 
-func (x *SearchedLibrary) Reset() {
-	*x = SearchedLibrary{}
+func (x *LibraryConflict) Reset() {
+	*x = LibraryConflict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[15]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchedLibrary) String() string {
+func (x *LibraryConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchedLibrary) ProtoMessage() {}
+func (*LibraryConflict) ProtoMessage() {}
 
-func (x *SearchedLibrary) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[15]
+func (x *LibraryConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,92 +3452,83 @@ func (x *SearchedLibrary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchedLibrary.ProtoReflect.Descriptor instead.
-func (*SearchedLibrary) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use LibraryConflict.ProtoReflect.Descriptor instead.
+func (*LibraryConflict) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SearchedLibrary) GetName() string {
+func (x *LibraryConflict) GetHeader() string {
 	if x != nil {
-		return x.Name
+		return x.Header
 	}
 	return ""
 }
 
-func (x *SearchedLibrary) GetReleases() map[string]*LibraryRelease {
+func (x *LibraryConflict) GetSelected() *Library {
 	if x != nil {
-		return x.Releases
+		return x.Selected
 	}
 	return nil
 }
 
-func (x *SearchedLibrary) GetLatest() *LibraryRelease {
+func (x *LibraryConflict) GetSelectionReason() string {
 	if x != nil {
-		return x.Latest
+		return x.SelectionReason
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchedLibrary) GetAvailableVersions() []string {
+func (x *LibraryConflict) GetShadowed() []*Library {
 	if x != nil {
-		return x.AvailableVersions
+		return x.Shadowed
 	}
 	return nil
 }
 
-type LibraryRelease struct {
+type LibraryQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Value of the `author` field in library.properties.
-	Author string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
-	// Value of the `version` field in library.properties.
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// Value of the `maintainer` field in library.properties.
-	Maintainer string `protobuf:"bytes,3,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
-	// Value of the `sentence` field in library.properties.
-	Sentence string `protobuf:"bytes,4,opt,name=sentence,proto3" json:"sentence,omitempty"`
-	// Value of the `paragraph` field in library.properties.
-	Paragraph string `protobuf:"bytes,5,opt,name=paragraph,proto3" json:"paragraph,omitempty"`
-	// Value of the `url` field in library.properties.
-	Website string `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
-	// Value of the `category` field in library.properties.
-	Category string `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	// Value of the `architectures` field in library.properties.
-	Architectures []string `protobuf:"bytes,8,rep,name=architectures,proto3" json:"architectures,omitempty"`
-	// The type categories of the library, as defined in the libraries index.
-	// Possible values: `Arduino`, `Partner`, `Recommended`, `Contributed`,
-	// `Retired`.
-	Types []string `protobuf:"bytes,9,rep,name=types,proto3" json:"types,omitempty"`
-	// Information about the library archive file.
-	Resources *DownloadResource `protobuf:"bytes,10,opt,name=resources,proto3" json:"resources,omitempty"`
-	// Value of the `license` field in library.properties.
-	License string `protobuf:"bytes,11,opt,name=license,proto3" json:"license,omitempty"`
-	// Value of the `includes` field in library.properties.
-	ProvidesIncludes []string `protobuf:"bytes,12,rep,name=provides_includes,json=providesIncludes,proto3" json:"provides_includes,omitempty"`
-	// The names of the library's dependencies, as defined by the 'depends'
-	// field of library.properties.
-	Dependencies []*LibraryDependency `protobuf:"bytes,13,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
-}
-
-func (x *LibraryRelease) Reset() {
-	*x = LibraryRelease{}
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Keywords for the search, with the same syntax of the `LibrarySearch`
+	// request. If empty all the libraries are returned.
+	SearchArgs string `protobuf:"bytes,2,opt,name=search_args,json=searchArgs,proto3" json:"search_args,omitempty"`
+	// Set to true to not populate the releases field of the index data (may
+	// save a lot of bandwidth/CPU).
+	OmitReleasesDetails bool `protobuf:"varint,3,opt,name=omit_releases_details,json=omitReleasesDetails,proto3" json:"omit_releases_details,omitempty"`
+	// Filter the libraries by their installation state.
+	Filter LibraryQueryFilter `protobuf:"varint,4,opt,name=filter,proto3,enum=cc.arduino.cli.commands.v1.LibraryQueryFilter" json:"filter,omitempty"`
+	// Whether to include the built-in libraries (from platforms and the Arduino
+	// IDE) in the installed libraries.
+	IncludeBuiltin bool `protobuf:"varint,5,opt,name=include_builtin,json=includeBuiltin,proto3" json:"include_builtin,omitempty"`
+	// The number of results to skip, to get the following pages of results.
+	Offset uint32 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The max number of results to return, 0 means no limit.
+	Limit uint32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Set to true to tolerate typos in the search terms, as in the
+	// `LibrarySearch` request.
+	Fuzzy bool `protobuf:"varint,8,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+}
+
+func (x *LibraryQueryRequest) Reset() {
+	*x = LibraryQueryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[16]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LibraryRelease) String() string {
+func (x *LibraryQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryRelease) ProtoMessage() {}
+func (*LibraryQueryRequest) ProtoMessage() {}
 
-func (x *LibraryRelease) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[16]
+func (x *LibraryQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,130 +3539,97 @@ func (x *LibraryRelease) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryRelease.ProtoReflect.Descriptor instead.
-func (*LibraryRelease) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *LibraryRelease) GetAuthor() string {
-	if x != nil {
-		return x.Author
-	}
-	return ""
-}
-
-func (x *LibraryRelease) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *LibraryRelease) GetMaintainer() string {
-	if x != nil {
-		return x.Maintainer
-	}
-	return ""
-}
-
-func (x *LibraryRelease) GetSentence() string {
-	if x != nil {
-		return x.Sentence
-	}
-	return ""
-}
-
-func (x *LibraryRelease) GetParagraph() string {
-	if x != nil {
-		return x.Paragraph
-	}
-	return ""
+// Deprecated: Use LibraryQueryRequest.ProtoReflect.Descriptor instead.
+func (*LibraryQueryRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LibraryRelease) GetWebsite() string {
+func (x *LibraryQueryRequest) GetInstance() *Instance {
 	if x != nil {
-		return x.Website
+		return x.Instance
 	}
-	return ""
+	return nil
 }
 
-func (x *LibraryRelease) GetCategory() string {
+func (x *LibraryQueryRequest) GetSearchArgs() string {
 	if x != nil {
-		return x.Category
+		return x.SearchArgs
 	}
 	return ""
 }
 
-func (x *LibraryRelease) GetArchitectures() []string {
+func (x *LibraryQueryRequest) GetOmitReleasesDetails() bool {
 	if x != nil {
-		return x.Architectures
+		return x.OmitReleasesDetails
 	}
-	return nil
+	return false
 }
 
-func (x *LibraryRelease) GetTypes() []string {
+func (x *LibraryQueryRequest) GetFilter() LibraryQueryFilter {
 	if x != nil {
-		return x.Types
+		return x.Filter
 	}
-	return nil
+	return LibraryQueryFilter_LIBRARY_QUERY_FILTER_ALL
 }
 
-func (x *LibraryRelease) GetResources() *DownloadResource {
+func (x *LibraryQueryRequest) GetIncludeBuiltin() bool {
 	if x != nil {
-		return x.Resources
+		return x.IncludeBuiltin
 	}
-	return nil
+	return false
 }
 
-func (x *LibraryRelease) GetLicense() string {
+func (x *LibraryQueryRequest) GetOffset() uint32 {
 	if x != nil {
-		return x.License
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *LibraryRelease) GetProvidesIncludes() []string {
+func (x *LibraryQueryRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.ProvidesIncludes
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *LibraryRelease) GetDependencies() []*LibraryDependency {
+func (x *LibraryQueryRequest) GetFuzzy() bool {
 	if x != nil {
-		return x.Dependencies
+		return x.Fuzzy
 	}
-	return nil
+	return false
 }
 
-type LibraryDependency struct {
+type LibraryQueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Library name of the dependency.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Version constraint of the dependency.
-	VersionConstraint string `protobuf:"bytes,2,opt,name=version_constraint,json=versionConstraint,proto3" json:"version_constraint,omitempty"`
+	// The libraries matching the query, sorted by name with the exact matches
+	// first.
+	Libraries []*QueriedLibrary `protobuf:"bytes,1,rep,name=libraries,proto3" json:"libraries,omitempty"`
+	// The total number of libraries matching the query, including the ones
+	// excluded by the `offset` and `limit` of the request.
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *LibraryDependency) Reset() {
-	*x = LibraryDependency{}
+func (x *LibraryQueryResponse) Reset() {
+	*x = LibraryQueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[17]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LibraryDependency) String() string {
+func (x *LibraryQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryDependency) ProtoMessage() {}
+func (*LibraryQueryResponse) ProtoMessage() {}
 
-func (x *LibraryDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[17]
+func (x *LibraryQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,60 +3640,64 @@ func (x *LibraryDependency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryDependency.ProtoReflect.Descriptor instead.
-func (*LibraryDependency) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use LibraryQueryResponse.ProtoReflect.Descriptor instead.
+func (*LibraryQueryResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *LibraryDependency) GetName() string {
+func (x *LibraryQueryResponse) GetLibraries() []*QueriedLibrary {
 	if x != nil {
-		return x.Name
+		return x.Libraries
 	}
-	return ""
+	return nil
 }
 
-func (x *LibraryDependency) GetVersionConstraint() string {
+func (x *LibraryQueryResponse) GetTotal() uint32 {
 	if x != nil {
-		return x.VersionConstraint
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type DownloadResource struct {
+type QueriedLibrary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Download URL of the library archive.
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	// Filename of the library archive.
-	ArchiveFilename string `protobuf:"bytes,2,opt,name=archive_filename,json=archiveFilename,proto3" json:"archive_filename,omitempty"`
-	// Checksum of the library archive.
-	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	// File size of the library archive.
-	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	// The directory under the staging subdirectory of the data directory the
-	// library archive file will be downloaded to.
-	CachePath string `protobuf:"bytes,5,opt,name=cache_path,json=cachePath,proto3" json:"cache_path,omitempty"`
+	// Library name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The data of the library in the libraries index, not set if the library is
+	// not in the index.
+	IndexLibrary *SearchedLibrary `protobuf:"bytes,2,opt,name=index_library,json=indexLibrary,proto3" json:"index_library,omitempty"`
+	// The installed copies of the library, the `release` field of each one is
+	// set to the latest release in the index if it's newer than the installed
+	// version.
+	Installed []*InstalledLibrary `protobuf:"bytes,3,rep,name=installed,proto3" json:"installed,omitempty"`
+	// The version of the installed library in the user directory, empty if the
+	// library is not installed there.
+	InstalledVersion string `protobuf:"bytes,4,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version,omitempty"`
+	// True if a newer version than `installed_version` is available in the
+	// libraries index.
+	UpdateAvailable bool `protobuf:"varint,5,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
 }
 
-func (x *DownloadResource) Reset() {
-	*x = DownloadResource{}
+func (x *QueriedLibrary) Reset() {
+	*x = QueriedLibrary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[18]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownloadResource) String() string {
+func (x *QueriedLibrary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadResource) ProtoMessage() {}
+func (*QueriedLibrary) ProtoMessage() {}
 
-func (x *DownloadResource) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[18]
+func (x *QueriedLibrary) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1493,84 +3708,78 @@ func (x *DownloadResource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadResource.ProtoReflect.Descriptor instead.
-func (*DownloadResource) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use QueriedLibrary.ProtoReflect.Descriptor instead.
+func (*QueriedLibrary) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DownloadResource) GetUrl() string {
+func (x *QueriedLibrary) GetName() string {
 	if x != nil {
-		return x.Url
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DownloadResource) GetArchiveFilename() string {
+func (x *QueriedLibrary) GetIndexLibrary() *SearchedLibrary {
 	if x != nil {
-		return x.ArchiveFilename
+		return x.IndexLibrary
 	}
-	return ""
+	return nil
 }
 
-func (x *DownloadResource) GetChecksum() string {
+func (x *QueriedLibrary) GetInstalled() []*InstalledLibrary {
 	if x != nil {
-		return x.Checksum
+		return x.Installed
 	}
-	return ""
+	return nil
 }
 
-func (x *DownloadResource) GetSize() int64 {
+func (x *QueriedLibrary) GetInstalledVersion() string {
 	if x != nil {
-		return x.Size
+		return x.InstalledVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *DownloadResource) GetCachePath() string {
+func (x *QueriedLibrary) GetUpdateAvailable() bool {
 	if x != nil {
-		return x.CachePath
+		return x.UpdateAvailable
 	}
-	return ""
+	return false
 }
 
-type LibraryListRequest struct {
+type LibraryResolveSymbolRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Arduino Core Service instance from the `Init` response.
 	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	// Whether to include built-in libraries (from platforms and the Arduino
-	// IDE) in the listing.
-	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
-	// Whether to list only libraries for which there is a newer version than
-	// the installed version available in the libraries index.
-	Updatable bool `protobuf:"varint,3,opt,name=updatable,proto3" json:"updatable,omitempty"`
-	// If set filters out the libraries not matching name
-	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// By setting this field all duplicate libraries are filtered out leaving
-	// only the libraries that will be used to compile for the specified board
-	// FQBN.
-	Fqbn string `protobuf:"bytes,5,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// The identifier of the class, function, variable, type or macro to look
+	// for, optionally qualified with its namespace (e.g. `SD` or `SDLib::File`).
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Search also the public headers of the library archives in the downloads
+	// cache.
+	IncludeDownloaded bool `protobuf:"varint,3,opt,name=include_downloaded,json=includeDownloaded,proto3" json:"include_downloaded,omitempty"`
 }
 
-func (x *LibraryListRequest) Reset() {
-	*x = LibraryListRequest{}
+func (x *LibraryResolveSymbolRequest) Reset() {
+	*x = LibraryResolveSymbolRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[19]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LibraryListRequest) String() string {
+func (x *LibraryResolveSymbolRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryListRequest) ProtoMessage() {}
+func (*LibraryResolveSymbolRequest) ProtoMessage() {}
 
-func (x *LibraryListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[19]
+func (x *LibraryResolveSymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,72 +3790,58 @@ func (x *LibraryListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryListRequest.ProtoReflect.Descriptor instead.
-func (*LibraryListRequest) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use LibraryResolveSymbolRequest.ProtoReflect.Descriptor instead.
+func (*LibraryResolveSymbolRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *LibraryListRequest) GetInstance() *Instance {
+func (x *LibraryResolveSymbolRequest) GetInstance() *Instance {
 	if x != nil {
 		return x.Instance
 	}
 	return nil
 }
 
-func (x *LibraryListRequest) GetAll() bool {
-	if x != nil {
-		return x.All
-	}
-	return false
-}
-
-func (x *LibraryListRequest) GetUpdatable() bool {
-	if x != nil {
-		return x.Updatable
-	}
-	return false
-}
-
-func (x *LibraryListRequest) GetName() string {
+func (x *LibraryResolveSymbolRequest) GetSymbol() string {
 	if x != nil {
-		return x.Name
+		return x.Symbol
 	}
 	return ""
 }
 
-func (x *LibraryListRequest) GetFqbn() string {
+func (x *LibraryResolveSymbolRequest) GetIncludeDownloaded() bool {
 	if x != nil {
-		return x.Fqbn
+		return x.IncludeDownloaded
 	}
-	return ""
+	return false
 }
 
-type LibraryListResponse struct {
+type LibraryResolveSymbolResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of installed libraries.
-	InstalledLibraries []*InstalledLibrary `protobuf:"bytes,1,rep,name=installed_libraries,json=installedLibraries,proto3" json:"installed_libraries,omitempty"`
+	// The declarations of the symbol, sorted by library name.
+	Declarations []*LibrarySymbolDeclaration `protobuf:"bytes,1,rep,name=declarations,proto3" json:"declarations,omitempty"`
 }
 
-func (x *LibraryListResponse) Reset() {
-	*x = LibraryListResponse{}
+func (x *LibraryResolveSymbolResponse) Reset() {
+	*x = LibraryResolveSymbolResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[20]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LibraryListResponse) String() string {
+func (x *LibraryResolveSymbolResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryListResponse) ProtoMessage() {}
-
-func (x *LibraryListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[20]
+func (*LibraryResolveSymbolResponse) ProtoMessage() {}
+
+func (x *LibraryResolveSymbolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1657,48 +3852,61 @@ func (x *LibraryListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryListResponse.ProtoReflect.Descriptor instead.
-func (*LibraryListResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use LibraryResolveSymbolResponse.ProtoReflect.Descriptor instead.
+func (*LibraryResolveSymbolResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *LibraryListResponse) GetInstalledLibraries() []*InstalledLibrary {
+func (x *LibraryResolveSymbolResponse) GetDeclarations() []*LibrarySymbolDeclaration {
 	if x != nil {
-		return x.InstalledLibraries
+		return x.Declarations
 	}
 	return nil
 }
 
-type InstalledLibrary struct {
+type LibrarySymbolDeclaration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Information about the library.
-	Library *Library `protobuf:"bytes,1,opt,name=library,proto3" json:"library,omitempty"`
-	// When the `updatable` field of the `LibraryList` request is set to `true`,
-	// this will contain information on the latest version of the library in the
-	// libraries index.
-	Release *LibraryRelease `protobuf:"bytes,2,opt,name=release,proto3" json:"release,omitempty"`
-}
-
-func (x *InstalledLibrary) Reset() {
-	*x = InstalledLibrary{}
+	// The name of the library declaring the symbol.
+	Library string `protobuf:"bytes,1,opt,name=library,proto3" json:"library,omitempty"`
+	// The version of the library.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// True if the library is a downloaded archive, not an installed library.
+	Downloaded bool `protobuf:"varint,3,opt,name=downloaded,proto3" json:"downloaded,omitempty"`
+	// The location of the installed library, meaningless for the downloaded
+	// archives.
+	Location LibraryLocation `protobuf:"varint,4,opt,name=location,proto3,enum=cc.arduino.cli.commands.v1.LibraryLocation" json:"location,omitempty"`
+	// The installation directory of the library or the path of the archive.
+	Path string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	// The path of the header declaring the symbol, relative to the library root.
+	Header string `protobuf:"bytes,6,opt,name=header,proto3" json:"header,omitempty"`
+	// The line of the declaration in the header.
+	Line uint32 `protobuf:"varint,7,opt,name=line,proto3" json:"line,omitempty"`
+	// The kind of the symbol.
+	Kind LibrarySymbolKind `protobuf:"varint,8,opt,name=kind,proto3,enum=cc.arduino.cli.commands.v1.LibrarySymbolKind" json:"kind,omitempty"`
+	// The name of the symbol qualified with its namespace.
+	QualifiedName string `protobuf:"bytes,9,opt,name=qualified_name,json=qualifiedName,proto3" json:"qualified_name,omitempty"`
+}
+
+func (x *LibrarySymbolDeclaration) Reset() {
+	*x = LibrarySymbolDeclaration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[21]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledLibrary) String() string {
+func (x *LibrarySymbolDeclaration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledLibrary) ProtoMessage() {}
+func (*LibrarySymbolDeclaration) ProtoMessage() {}
 
-func (x *InstalledLibrary) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[21]
+func (x *LibrarySymbolDeclaration) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1709,23 +3917,72 @@ func (x *InstalledLibrary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledLibrary.ProtoReflect.Descriptor instead.
-func (*InstalledLibrary) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use LibrarySymbolDeclaration.ProtoReflect.Descriptor instead.
+func (*LibrarySymbolDeclaration) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *InstalledLibrary) GetLibrary() *Library {
+func (x *LibrarySymbolDeclaration) GetLibrary() string {
 	if x != nil {
 		return x.Library
 	}
-	return nil
+	return ""
 }
 
-func (x *InstalledLibrary) GetRelease() *LibraryRelease {
+func (x *LibrarySymbolDeclaration) GetVersion() string {
 	if x != nil {
-		return x.Release
+		return x.Version
 	}
-	return nil
+	return ""
+}
+
+func (x *LibrarySymbolDeclaration) GetDownloaded() bool {
+	if x != nil {
+		return x.Downloaded
+	}
+	return false
+}
+
+func (x *LibrarySymbolDeclaration) GetLocation() LibraryLocation {
+	if x != nil {
+		return x.Location
+	}
+	return LibraryLocation_LIBRARY_LOCATION_BUILTIN
+}
+
+func (x *LibrarySymbolDeclaration) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *LibrarySymbolDeclaration) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *LibrarySymbolDeclaration) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *LibrarySymbolDeclaration) GetKind() LibrarySymbolKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LibrarySymbolKind_LIBRARY_SYMBOL_KIND_CLASS
+}
+
+func (x *LibrarySymbolDeclaration) GetQualifiedName() string {
+	if x != nil {
+		return x.QualifiedName
+	}
+	return ""
 }
 
 type Library struct {
@@ -1792,12 +4049,17 @@ type Library struct {
 	// be treated as read-only. This status is determined by the presence of a
 	// `.development` file in the library root directory.
 	InDevelopment bool `protobuf:"varint,29,opt,name=in_development,json=inDevelopment,proto3" json:"in_development,omitempty"`
+	// Where the library has been installed from.
+	InstallSource LibraryInstallSource `protobuf:"varint,30,opt,name=install_source,json=installSource,proto3,enum=cc.arduino.cli.commands.v1.LibraryInstallSource" json:"install_source,omitempty"`
+	// If `install_source` is `git`, the URL of the repository, followed by
+	// `#` and the installed reference if any.
+	InstallSourceUrl string `protobuf:"bytes,31,opt,name=install_source_url,json=installSourceUrl,proto3" json:"install_source_url,omitempty"`
 }
 
 func (x *Library) Reset() {
 	*x = Library{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[22]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1810,7 +4072,7 @@ func (x *Library) String() string {
 func (*Library) ProtoMessage() {}
 
 func (x *Library) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[22]
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1823,7 +4085,7 @@ func (x *Library) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Library.ProtoReflect.Descriptor instead.
 func (*Library) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{22}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *Library) GetName() string {
@@ -2008,6 +4270,20 @@ func (x *Library) GetInDevelopment() bool {
 	return false
 }
 
+func (x *Library) GetInstallSource() LibraryInstallSource {
+	if x != nil {
+		return x.InstallSource
+	}
+	return LibraryInstallSource_LIBRARY_INSTALL_SOURCE_LOCAL
+}
+
+func (x *Library) GetInstallSourceUrl() string {
+	if x != nil {
+		return x.InstallSourceUrl
+	}
+	return ""
+}
+
 type ZipLibraryInstallRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2025,7 +4301,7 @@ type ZipLibraryInstallRequest struct {
 func (x *ZipLibraryInstallRequest) Reset() {
 	*x = ZipLibraryInstallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[23]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2038,7 +4314,7 @@ func (x *ZipLibraryInstallRequest) String() string {
 func (*ZipLibraryInstallRequest) ProtoMessage() {}
 
 func (x *ZipLibraryInstallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[23]
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +4327,7 @@ func (x *ZipLibraryInstallRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZipLibraryInstallRequest.ProtoReflect.Descriptor instead.
 func (*ZipLibraryInstallRequest) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{23}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ZipLibraryInstallRequest) GetInstance() *Instance {
@@ -2087,7 +4363,7 @@ type ZipLibraryInstallResponse struct {
 func (x *ZipLibraryInstallResponse) Reset() {
 	*x = ZipLibraryInstallResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[24]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2100,7 +4376,7 @@ func (x *ZipLibraryInstallResponse) String() string {
 func (*ZipLibraryInstallResponse) ProtoMessage() {}
 
 func (x *ZipLibraryInstallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[24]
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,7 +4389,7 @@ func (x *ZipLibraryInstallResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZipLibraryInstallResponse.ProtoReflect.Descriptor instead.
 func (*ZipLibraryInstallResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{24}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ZipLibraryInstallResponse) GetTaskProgress() *TaskProgress {
@@ -2140,7 +4416,7 @@ type GitLibraryInstallRequest struct {
 func (x *GitLibraryInstallRequest) Reset() {
 	*x = GitLibraryInstallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[25]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2153,7 +4429,7 @@ func (x *GitLibraryInstallRequest) String() string {
 func (*GitLibraryInstallRequest) ProtoMessage() {}
 
 func (x *GitLibraryInstallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[25]
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,7 +4442,7 @@ func (x *GitLibraryInstallRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitLibraryInstallRequest.ProtoReflect.Descriptor instead.
 func (*GitLibraryInstallRequest) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{25}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GitLibraryInstallRequest) GetInstance() *Instance {
@@ -2202,7 +4478,7 @@ type GitLibraryInstallResponse struct {
 func (x *GitLibraryInstallResponse) Reset() {
 	*x = GitLibraryInstallResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[26]
+		mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2215,7 +4491,7 @@ func (x *GitLibraryInstallResponse) String() string {
 func (*GitLibraryInstallResponse) ProtoMessage() {}
 
 func (x *GitLibraryInstallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[26]
+	mi := &file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2228,7 +4504,7 @@ func (x *GitLibraryInstallResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitLibraryInstallResponse.ProtoReflect.Descriptor instead.
 func (*GitLibraryInstallResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{26}
+	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GitLibraryInstallResponse) GetTaskProgress() *TaskProgress {
@@ -2262,7 +4538,7 @@ var file_cc_arduino_cli_commands_v1_lib_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
 	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
 	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x15,
+	0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa3, 0x03, 0x0a, 0x15,
 	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
@@ -2281,352 +4557,727 @@ var file_cc_arduino_cli_commands_v1_lib_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
 	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
 	0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xb1, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x12, 0x55, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x75, 0x6e, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x22, 0xb1, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
 	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
-	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x64, 0x65, 0x70, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x44, 0x65, 0x70, 0x73, 0x22, 0xb1,
+	0x01, 0x0a, 0x16, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x6e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
 	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
 	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x44, 0x65, 0x70, 0x73, 0x22, 0xb1, 0x01,
-	0x0a, 0x16, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x69,
+	0x0a, 0x18, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73,
+	0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x18, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x71, 0x62, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x71, 0x62,
+	0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x19, 0x4c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09,
+	0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x4d, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x63, 0x2e,
 	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x1b,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x71, 0x62, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x71, 0x62, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62,
+	0x6f, 0x73, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x47, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x50, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc6, 0x01, 0x0a,
+	0x13, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71,
+	0x62, 0x6e, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x35, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x71,
+	0x62, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x71, 0x62, 0x6e, 0x73,
+	0x12, 0x47, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x22, 0x3b, 0x0a, 0x0f, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x56,
+	0x0a, 0x1b, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x1c, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0xef, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x12, 0x51, 0x0a, 0x0c, 0x62, 0x75, 0x6d,
+	0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x6d, 0x70, 0x52,
+	0x0b, 0x62, 0x75, 0x6d, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x64, 0x64, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x18, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xea, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x63, 0x63, 0x2e, 0x61,
 	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x6e, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x22,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x17,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x6f, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6f,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x22, 0xc1, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x49, 0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xd8, 0x02, 0x0a, 0x0f,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x55, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x67, 0x0a,
+	0x0d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf4, 0x03, 0x0a, 0x0e, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0c, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x56, 0x0a,
+	0x11, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x10,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0xc7, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
 	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
 	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a,
-	0x18, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61, 0x73,
-	0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
-	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5c, 0x0a, 0x18, 0x4c, 0x69, 0x62, 0x72,
-	0x61, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
-	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x74, 0x0a, 0x13, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x07, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
 	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d,
-	0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x93, 0x01,
-	0x0a, 0x21, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0x69, 0x0a, 0x13, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c,
+	0x22, 0x8f, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x22, 0x73, 0x0a, 0x1b, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x22, 0x69, 0x0a, 0x1c, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3f,
+	0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x68,
+	0x61, 0x64, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x52, 0x08, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x65, 0x64, 0x22, 0xe1, 0x02, 0x0a, 0x13,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
 	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
 	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x22, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x64, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x6d, 0x69, 0x74, 0x5f, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6f, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x46, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x62, 0x75,
+	0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x7a,
+	0x7a, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x22,
+	0x76, 0x0a, 0x14, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x64, 0x4c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x9a, 0x02, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x50,
+	0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x12, 0x4a, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2d,
+	0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x22, 0x78, 0x0a,
+	0x1c, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
+	0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65,
+	0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe1, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x41, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62,
-	0x72, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a,
-	0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x14, 0x4c,
-	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x72, 0x61, 0x72, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xff, 0x09, 0x0a, 0x07,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x69, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x69, 0x72,
+	0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x22, 0x0a, 0x0d, 0x64, 0x6f, 0x74, 0x5f, 0x61, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x6f, 0x74, 0x41, 0x4c, 0x69, 0x6e, 0x6b,
+	0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x64, 0x5f, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
 	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6f, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x15, 0x4c,
-	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
-	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x62,
-	0x72, 0x61, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x41, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62,
-	0x72, 0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x55, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
-	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
-	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
-	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x67, 0x0a, 0x0d, 0x52, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x40, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63,
+	0x72, 0x61, 0x72, 0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x1a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x1c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x44, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x63,
 	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xf4, 0x03, 0x0a, 0x0e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61,
-	0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x74,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x74,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x67, 0x72, 0x61,
-	0x70, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x72, 0x63, 0x68,
-	0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
-	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18,
-	0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x49,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x11, 0x4c, 0x69,
-	0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x50,
-	0x61, 0x74, 0x68, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e,
+	0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0d,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x1a, 0x3d, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8e, 0x01,
+	0x0a, 0x18, 0x5a, 0x69, 0x70, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
 	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x1c,
-	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x66, 0x71, 0x62, 0x6e, 0x22, 0x74, 0x0a, 0x13, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x13, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
-	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4c,
-	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12,
-	0x3d, 0x0a, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
-	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x44,
-	0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62,
-	0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x22, 0xf8, 0x08, 0x0a, 0x07, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72,
-	0x61, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x69, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75,
-	0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x44, 0x69, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x6f, 0x74, 0x5f,
-	0x61, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x64, 0x6f, 0x74, 0x41, 0x4c, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x6c, 0x64, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
-	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
-	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72,
-	0x61, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
-	0x47, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
-	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f,
-	0x75, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
-	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x61, 0x79,
-	0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x49, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
-	0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74,
-	0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
-	0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x69, 0x6e, 0x44, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x3d, 0x0a,
-	0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x8e, 0x01, 0x0a, 0x18, 0x5a, 0x69, 0x70, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x22, 0x6a, 0x0a, 0x19, 0x5a, 0x69, 0x70, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
-	0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
-	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c,
-	0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8c, 0x01, 0x0a,
-	0x18, 0x47, 0x69, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63,
-	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a,
-	0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x6a, 0x0a, 0x19, 0x47,
+	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x6a,
+	0x0a, 0x19, 0x5a, 0x69, 0x70, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61,
+	0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x47,
 	0x69, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b,
-	0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2a, 0x61, 0x0a, 0x16, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x53,
-	0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53,
-	0x45, 0x52, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x5a, 0x0a, 0x13, 0x4c, 0x69,
-	0x62, 0x72, 0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x45, 0x41,
-	0x52, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53,
-	0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x2a, 0x46, 0x0a, 0x0d, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49, 0x42, 0x52, 0x41,
-	0x52, 0x59, 0x5f, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x00,
-	0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x4c, 0x41, 0x59, 0x4f,
-	0x55, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45, 0x10, 0x01, 0x2a, 0xc3,
-	0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x4c, 0x4f,
-	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54, 0x49, 0x4e, 0x10, 0x00,
-	0x12, 0x19, 0x0a, 0x15, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x4c,
-	0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54, 0x49, 0x4e,
-	0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x4c, 0x4f,
-	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45,
-	0x44, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54,
-	0x49, 0x4e, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f,
-	0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4d, 0x41, 0x4e, 0x41, 0x47,
-	0x45, 0x44, 0x10, 0x04, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72,
-	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x6a, 0x0a, 0x19, 0x47, 0x69, 0x74,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x2a, 0x6c, 0x0a, 0x19, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x49,
+	0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x41,
+	0x4c, 0x10, 0x01, 0x2a, 0x61, 0x0a, 0x16, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x1d, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c,
+	0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00,
+	0x12, 0x24, 0x0a, 0x20, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4c, 0x4c, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x49,
+	0x4c, 0x54, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x97, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f,
+	0x45, 0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a,
+	0x23, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45,
+	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x5f, 0x45, 0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02,
+	0x2a, 0x93, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x75, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x42, 0x52, 0x41,
+	0x52, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x4d, 0x50, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x4d, 0x50, 0x5f, 0x4d,
+	0x41, 0x4a, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x4d, 0x50, 0x5f, 0x4d,
+	0x49, 0x4e, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x4d, 0x50, 0x5f, 0x50,
+	0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x2a, 0x5a, 0x0a, 0x13, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a,
+	0x1c, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x21, 0x0a, 0x1d, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43,
+	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x01, 0x2a, 0xa2, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x42,
+	0x52, 0x41, 0x52, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45,
+	0x52, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49, 0x42, 0x52, 0x41,
+	0x52, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x4c,
+	0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c,
+	0x54, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x51,
+	0x55, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x2a, 0xb3, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
+	0x19, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49,
+	0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c,
+	0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x1d,
+	0x0a, 0x19, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x41, 0x43, 0x52, 0x4f, 0x10, 0x04, 0x2a, 0x9a, 0x01,
+	0x0a, 0x14, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49,
+	0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x49, 0x54, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49,
+	0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x5a, 0x49, 0x50, 0x10, 0x03, 0x2a, 0x46, 0x0a, 0x0d, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x4c,
+	0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x46, 0x4c,
+	0x41, 0x54, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f,
+	0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45,
+	0x10, 0x01, 0x2a, 0xfe, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54,
+	0x49, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f,
+	0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x25, 0x0a, 0x21, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x55, 0x49,
+	0x4c, 0x54, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52,
+	0x45, 0x4e, 0x43, 0x45, 0x44, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42,
+	0x55, 0x49, 0x4c, 0x54, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4d,
+	0x41, 0x4e, 0x41, 0x47, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x52, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
+	0x46, 0x49, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x45, 0x54, 0x43,
+	0x48, 0x10, 0x06, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2641,89 +5292,151 @@ func file_cc_arduino_cli_commands_v1_lib_proto_rawDescGZIP() []byte {
 	return file_cc_arduino_cli_commands_v1_lib_proto_rawDescData
 }
 
-var file_cc_arduino_cli_commands_v1_lib_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_cc_arduino_cli_commands_v1_lib_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_cc_arduino_cli_commands_v1_lib_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_cc_arduino_cli_commands_v1_lib_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_cc_arduino_cli_commands_v1_lib_proto_goTypes = []interface{}{
-	(LibraryInstallLocation)(0),                // 0: cc.arduino.cli.commands.v1.LibraryInstallLocation
-	(LibrarySearchStatus)(0),                   // 1: cc.arduino.cli.commands.v1.LibrarySearchStatus
-	(LibraryLayout)(0),                         // 2: cc.arduino.cli.commands.v1.LibraryLayout
-	(LibraryLocation)(0),                       // 3: cc.arduino.cli.commands.v1.LibraryLocation
-	(*LibraryDownloadRequest)(nil),             // 4: cc.arduino.cli.commands.v1.LibraryDownloadRequest
-	(*LibraryDownloadResponse)(nil),            // 5: cc.arduino.cli.commands.v1.LibraryDownloadResponse
-	(*LibraryInstallRequest)(nil),              // 6: cc.arduino.cli.commands.v1.LibraryInstallRequest
-	(*LibraryInstallResponse)(nil),             // 7: cc.arduino.cli.commands.v1.LibraryInstallResponse
-	(*LibraryUpgradeRequest)(nil),              // 8: cc.arduino.cli.commands.v1.LibraryUpgradeRequest
-	(*LibraryUpgradeResponse)(nil),             // 9: cc.arduino.cli.commands.v1.LibraryUpgradeResponse
-	(*LibraryUninstallRequest)(nil),            // 10: cc.arduino.cli.commands.v1.LibraryUninstallRequest
-	(*LibraryUninstallResponse)(nil),           // 11: cc.arduino.cli.commands.v1.LibraryUninstallResponse
-	(*LibraryUpgradeAllRequest)(nil),           // 12: cc.arduino.cli.commands.v1.LibraryUpgradeAllRequest
-	(*LibraryUpgradeAllResponse)(nil),          // 13: cc.arduino.cli.commands.v1.LibraryUpgradeAllResponse
-	(*LibraryResolveDependenciesRequest)(nil),  // 14: cc.arduino.cli.commands.v1.LibraryResolveDependenciesRequest
-	(*LibraryResolveDependenciesResponse)(nil), // 15: cc.arduino.cli.commands.v1.LibraryResolveDependenciesResponse
-	(*LibraryDependencyStatus)(nil),            // 16: cc.arduino.cli.commands.v1.LibraryDependencyStatus
-	(*LibrarySearchRequest)(nil),               // 17: cc.arduino.cli.commands.v1.LibrarySearchRequest
-	(*LibrarySearchResponse)(nil),              // 18: cc.arduino.cli.commands.v1.LibrarySearchResponse
-	(*SearchedLibrary)(nil),                    // 19: cc.arduino.cli.commands.v1.SearchedLibrary
-	(*LibraryRelease)(nil),                     // 20: cc.arduino.cli.commands.v1.LibraryRelease
-	(*LibraryDependency)(nil),                  // 21: cc.arduino.cli.commands.v1.LibraryDependency
-	(*DownloadResource)(nil),                   // 22: cc.arduino.cli.commands.v1.DownloadResource
-	(*LibraryListRequest)(nil),                 // 23: cc.arduino.cli.commands.v1.LibraryListRequest
-	(*LibraryListResponse)(nil),                // 24: cc.arduino.cli.commands.v1.LibraryListResponse
-	(*InstalledLibrary)(nil),                   // 25: cc.arduino.cli.commands.v1.InstalledLibrary
-	(*Library)(nil),                            // 26: cc.arduino.cli.commands.v1.Library
-	(*ZipLibraryInstallRequest)(nil),           // 27: cc.arduino.cli.commands.v1.ZipLibraryInstallRequest
-	(*ZipLibraryInstallResponse)(nil),          // 28: cc.arduino.cli.commands.v1.ZipLibraryInstallResponse
-	(*GitLibraryInstallRequest)(nil),           // 29: cc.arduino.cli.commands.v1.GitLibraryInstallRequest
-	(*GitLibraryInstallResponse)(nil),          // 30: cc.arduino.cli.commands.v1.GitLibraryInstallResponse
-	nil,                                        // 31: cc.arduino.cli.commands.v1.SearchedLibrary.ReleasesEntry
-	nil,                                        // 32: cc.arduino.cli.commands.v1.Library.PropertiesEntry
-	nil,                                        // 33: cc.arduino.cli.commands.v1.Library.CompatibleWithEntry
-	(*Instance)(nil),                           // 34: cc.arduino.cli.commands.v1.Instance
-	(*DownloadProgress)(nil),                   // 35: cc.arduino.cli.commands.v1.DownloadProgress
-	(*TaskProgress)(nil),                       // 36: cc.arduino.cli.commands.v1.TaskProgress
+	(LibraryResolutionStrategy)(0),             // 0: cc.arduino.cli.commands.v1.LibraryResolutionStrategy
+	(LibraryInstallLocation)(0),                // 1: cc.arduino.cli.commands.v1.LibraryInstallLocation
+	(LibraryExampleCheckStatus)(0),             // 2: cc.arduino.cli.commands.v1.LibraryExampleCheckStatus
+	(LibraryVersionBump)(0),                    // 3: cc.arduino.cli.commands.v1.LibraryVersionBump
+	(LibrarySearchStatus)(0),                   // 4: cc.arduino.cli.commands.v1.LibrarySearchStatus
+	(LibraryQueryFilter)(0),                    // 5: cc.arduino.cli.commands.v1.LibraryQueryFilter
+	(LibrarySymbolKind)(0),                     // 6: cc.arduino.cli.commands.v1.LibrarySymbolKind
+	(LibraryInstallSource)(0),                  // 7: cc.arduino.cli.commands.v1.LibraryInstallSource
+	(LibraryLayout)(0),                         // 8: cc.arduino.cli.commands.v1.LibraryLayout
+	(LibraryLocation)(0),                       // 9: cc.arduino.cli.commands.v1.LibraryLocation
+	(*LibraryDownloadRequest)(nil),             // 10: cc.arduino.cli.commands.v1.LibraryDownloadRequest
+	(*LibraryDownloadResponse)(nil),            // 11: cc.arduino.cli.commands.v1.LibraryDownloadResponse
+	(*LibraryInstallRequest)(nil),              // 12: cc.arduino.cli.commands.v1.LibraryInstallRequest
+	(*LibraryInstallResponse)(nil),             // 13: cc.arduino.cli.commands.v1.LibraryInstallResponse
+	(*LibraryUpgradeRequest)(nil),              // 14: cc.arduino.cli.commands.v1.LibraryUpgradeRequest
+	(*LibraryUpgradeResponse)(nil),             // 15: cc.arduino.cli.commands.v1.LibraryUpgradeResponse
+	(*LibraryUninstallRequest)(nil),            // 16: cc.arduino.cli.commands.v1.LibraryUninstallRequest
+	(*LibraryUninstallResponse)(nil),           // 17: cc.arduino.cli.commands.v1.LibraryUninstallResponse
+	(*LibraryPrecompileRequest)(nil),           // 18: cc.arduino.cli.commands.v1.LibraryPrecompileRequest
+	(*LibraryPrecompileResponse)(nil),          // 19: cc.arduino.cli.commands.v1.LibraryPrecompileResponse
+	(*LibraryPrecompileResult)(nil),            // 20: cc.arduino.cli.commands.v1.LibraryPrecompileResult
+	(*LibraryCheckExamplesRequest)(nil),        // 21: cc.arduino.cli.commands.v1.LibraryCheckExamplesRequest
+	(*LibraryCheckExamplesResponse)(nil),       // 22: cc.arduino.cli.commands.v1.LibraryCheckExamplesResponse
+	(*LibraryExampleCheck)(nil),                // 23: cc.arduino.cli.commands.v1.LibraryExampleCheck
+	(*LibraryCheckExamplesResult)(nil),         // 24: cc.arduino.cli.commands.v1.LibraryCheckExamplesResult
+	(*LibraryProperty)(nil),                    // 25: cc.arduino.cli.commands.v1.LibraryProperty
+	(*LibraryPropertiesGetRequest)(nil),        // 26: cc.arduino.cli.commands.v1.LibraryPropertiesGetRequest
+	(*LibraryPropertiesGetResponse)(nil),       // 27: cc.arduino.cli.commands.v1.LibraryPropertiesGetResponse
+	(*LibraryPropertiesSetRequest)(nil),        // 28: cc.arduino.cli.commands.v1.LibraryPropertiesSetRequest
+	(*LibraryPropertiesSetResponse)(nil),       // 29: cc.arduino.cli.commands.v1.LibraryPropertiesSetResponse
+	(*LibraryUpgradeAllRequest)(nil),           // 30: cc.arduino.cli.commands.v1.LibraryUpgradeAllRequest
+	(*LibraryUpgradeAllResponse)(nil),          // 31: cc.arduino.cli.commands.v1.LibraryUpgradeAllResponse
+	(*LibraryResolveDependenciesRequest)(nil),  // 32: cc.arduino.cli.commands.v1.LibraryResolveDependenciesRequest
+	(*LibraryResolveDependenciesResponse)(nil), // 33: cc.arduino.cli.commands.v1.LibraryResolveDependenciesResponse
+	(*LibraryDependencyStatus)(nil),            // 34: cc.arduino.cli.commands.v1.LibraryDependencyStatus
+	(*LibrarySearchRequest)(nil),               // 35: cc.arduino.cli.commands.v1.LibrarySearchRequest
+	(*LibrarySearchResponse)(nil),              // 36: cc.arduino.cli.commands.v1.LibrarySearchResponse
+	(*SearchedLibrary)(nil),                    // 37: cc.arduino.cli.commands.v1.SearchedLibrary
+	(*LibraryRelease)(nil),                     // 38: cc.arduino.cli.commands.v1.LibraryRelease
+	(*LibraryDependency)(nil),                  // 39: cc.arduino.cli.commands.v1.LibraryDependency
+	(*DownloadResource)(nil),                   // 40: cc.arduino.cli.commands.v1.DownloadResource
+	(*LibraryListRequest)(nil),                 // 41: cc.arduino.cli.commands.v1.LibraryListRequest
+	(*LibraryListResponse)(nil),                // 42: cc.arduino.cli.commands.v1.LibraryListResponse
+	(*InstalledLibrary)(nil),                   // 43: cc.arduino.cli.commands.v1.InstalledLibrary
+	(*LibraryUsage)(nil),                       // 44: cc.arduino.cli.commands.v1.LibraryUsage
+	(*LibraryUsageRequest)(nil),                // 45: cc.arduino.cli.commands.v1.LibraryUsageRequest
+	(*LibraryUsageResponse)(nil),               // 46: cc.arduino.cli.commands.v1.LibraryUsageResponse
+	(*LibraryListConflictsRequest)(nil),        // 47: cc.arduino.cli.commands.v1.LibraryListConflictsRequest
+	(*LibraryListConflictsResponse)(nil),       // 48: cc.arduino.cli.commands.v1.LibraryListConflictsResponse
+	(*LibraryConflict)(nil),                    // 49: cc.arduino.cli.commands.v1.LibraryConflict
+	(*LibraryQueryRequest)(nil),                // 50: cc.arduino.cli.commands.v1.LibraryQueryRequest
+	(*LibraryQueryResponse)(nil),               // 51: cc.arduino.cli.commands.v1.LibraryQueryResponse
+	(*QueriedLibrary)(nil),                     // 52: cc.arduino.cli.commands.v1.QueriedLibrary
+	(*LibraryResolveSymbolRequest)(nil),        // 53: cc.arduino.cli.commands.v1.LibraryResolveSymbolRequest
+	(*LibraryResolveSymbolResponse)(nil),       // 54: cc.arduino.cli.commands.v1.LibraryResolveSymbolResponse
+	(*LibrarySymbolDeclaration)(nil),           // 55: cc.arduino.cli.commands.v1.LibrarySymbolDeclaration
+	(*Library)(nil),                            // 56: cc.arduino.cli.commands.v1.Library
+	(*ZipLibraryInstallRequest)(nil),           // 57: cc.arduino.cli.commands.v1.ZipLibraryInstallRequest
+	(*ZipLibraryInstallResponse)(nil),          // 58: cc.arduino.cli.commands.v1.ZipLibraryInstallResponse
+	(*GitLibraryInstallRequest)(nil),           // 59: cc.arduino.cli.commands.v1.GitLibraryInstallRequest
+	(*GitLibraryInstallResponse)(nil),          // 60: cc.arduino.cli.commands.v1.GitLibraryInstallResponse
+	nil,                                        // 61: cc.arduino.cli.commands.v1.SearchedLibrary.ReleasesEntry
+	nil,                                        // 62: cc.arduino.cli.commands.v1.Library.PropertiesEntry
+	nil,                                        // 63: cc.arduino.cli.commands.v1.Library.CompatibleWithEntry
+	(*Instance)(nil),                           // 64: cc.arduino.cli.commands.v1.Instance
+	(*DownloadProgress)(nil),                   // 65: cc.arduino.cli.commands.v1.DownloadProgress
+	(*TaskProgress)(nil),                       // 66: cc.arduino.cli.commands.v1.TaskProgress
 }
 var file_cc_arduino_cli_commands_v1_lib_proto_depIdxs = []int32{
-	34, // 0: cc.arduino.cli.commands.v1.LibraryDownloadRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	35, // 1: cc.arduino.cli.commands.v1.LibraryDownloadResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
-	34, // 2: cc.arduino.cli.commands.v1.LibraryInstallRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	0,  // 3: cc.arduino.cli.commands.v1.LibraryInstallRequest.install_location:type_name -> cc.arduino.cli.commands.v1.LibraryInstallLocation
-	35, // 4: cc.arduino.cli.commands.v1.LibraryInstallResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
-	36, // 5: cc.arduino.cli.commands.v1.LibraryInstallResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
-	34, // 6: cc.arduino.cli.commands.v1.LibraryUpgradeRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	35, // 7: cc.arduino.cli.commands.v1.LibraryUpgradeResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
-	36, // 8: cc.arduino.cli.commands.v1.LibraryUpgradeResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
-	34, // 9: cc.arduino.cli.commands.v1.LibraryUninstallRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	36, // 10: cc.arduino.cli.commands.v1.LibraryUninstallResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
-	34, // 11: cc.arduino.cli.commands.v1.LibraryUpgradeAllRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	35, // 12: cc.arduino.cli.commands.v1.LibraryUpgradeAllResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
-	36, // 13: cc.arduino.cli.commands.v1.LibraryUpgradeAllResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
-	34, // 14: cc.arduino.cli.commands.v1.LibraryResolveDependenciesRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	16, // 15: cc.arduino.cli.commands.v1.LibraryResolveDependenciesResponse.dependencies:type_name -> cc.arduino.cli.commands.v1.LibraryDependencyStatus
-	34, // 16: cc.arduino.cli.commands.v1.LibrarySearchRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	19, // 17: cc.arduino.cli.commands.v1.LibrarySearchResponse.libraries:type_name -> cc.arduino.cli.commands.v1.SearchedLibrary
-	1,  // 18: cc.arduino.cli.commands.v1.LibrarySearchResponse.status:type_name -> cc.arduino.cli.commands.v1.LibrarySearchStatus
-	31, // 19: cc.arduino.cli.commands.v1.SearchedLibrary.releases:type_name -> cc.arduino.cli.commands.v1.SearchedLibrary.ReleasesEntry
-	20, // 20: cc.arduino.cli.commands.v1.SearchedLibrary.latest:type_name -> cc.arduino.cli.commands.v1.LibraryRelease
-	22, // 21: cc.arduino.cli.commands.v1.LibraryRelease.resources:type_name -> cc.arduino.cli.commands.v1.DownloadResource
-	21, // 22: cc.arduino.cli.commands.v1.LibraryRelease.dependencies:type_name -> cc.arduino.cli.commands.v1.LibraryDependency
-	34, // 23: cc.arduino.cli.commands.v1.LibraryListRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	25, // 24: cc.arduino.cli.commands.v1.LibraryListResponse.installed_libraries:type_name -> cc.arduino.cli.commands.v1.InstalledLibrary
-	26, // 25: cc.arduino.cli.commands.v1.InstalledLibrary.library:type_name -> cc.arduino.cli.commands.v1.Library
-	20, // 26: cc.arduino.cli.commands.v1.InstalledLibrary.release:type_name -> cc.arduino.cli.commands.v1.LibraryRelease
-	32, // 27: cc.arduino.cli.commands.v1.Library.properties:type_name -> cc.arduino.cli.commands.v1.Library.PropertiesEntry
-	3,  // 28: cc.arduino.cli.commands.v1.Library.location:type_name -> cc.arduino.cli.commands.v1.LibraryLocation
-	2,  // 29: cc.arduino.cli.commands.v1.Library.layout:type_name -> cc.arduino.cli.commands.v1.LibraryLayout
-	33, // 30: cc.arduino.cli.commands.v1.Library.compatible_with:type_name -> cc.arduino.cli.commands.v1.Library.CompatibleWithEntry
-	34, // 31: cc.arduino.cli.commands.v1.ZipLibraryInstallRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	36, // 32: cc.arduino.cli.commands.v1.ZipLibraryInstallResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
-	34, // 33: cc.arduino.cli.commands.v1.GitLibraryInstallRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	36, // 34: cc.arduino.cli.commands.v1.GitLibraryInstallResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
-	20, // 35: cc.arduino.cli.commands.v1.SearchedLibrary.ReleasesEntry.value:type_name -> cc.arduino.cli.commands.v1.LibraryRelease
-	36, // [36:36] is the sub-list for method output_type
-	36, // [36:36] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	64, // 0: cc.arduino.cli.commands.v1.LibraryDownloadRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	65, // 1: cc.arduino.cli.commands.v1.LibraryDownloadResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
+	64, // 2: cc.arduino.cli.commands.v1.LibraryInstallRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	1,  // 3: cc.arduino.cli.commands.v1.LibraryInstallRequest.install_location:type_name -> cc.arduino.cli.commands.v1.LibraryInstallLocation
+	0,  // 4: cc.arduino.cli.commands.v1.LibraryInstallRequest.resolution:type_name -> cc.arduino.cli.commands.v1.LibraryResolutionStrategy
+	65, // 5: cc.arduino.cli.commands.v1.LibraryInstallResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
+	66, // 6: cc.arduino.cli.commands.v1.LibraryInstallResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
+	64, // 7: cc.arduino.cli.commands.v1.LibraryUpgradeRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	65, // 8: cc.arduino.cli.commands.v1.LibraryUpgradeResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
+	66, // 9: cc.arduino.cli.commands.v1.LibraryUpgradeResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
+	64, // 10: cc.arduino.cli.commands.v1.LibraryUninstallRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	66, // 11: cc.arduino.cli.commands.v1.LibraryUninstallResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
+	64, // 12: cc.arduino.cli.commands.v1.LibraryPrecompileRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	20, // 13: cc.arduino.cli.commands.v1.LibraryPrecompileResponse.result:type_name -> cc.arduino.cli.commands.v1.LibraryPrecompileResult
+	64, // 14: cc.arduino.cli.commands.v1.LibraryCheckExamplesRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	23, // 15: cc.arduino.cli.commands.v1.LibraryCheckExamplesResponse.check:type_name -> cc.arduino.cli.commands.v1.LibraryExampleCheck
+	24, // 16: cc.arduino.cli.commands.v1.LibraryCheckExamplesResponse.result:type_name -> cc.arduino.cli.commands.v1.LibraryCheckExamplesResult
+	2,  // 17: cc.arduino.cli.commands.v1.LibraryExampleCheck.status:type_name -> cc.arduino.cli.commands.v1.LibraryExampleCheckStatus
+	23, // 18: cc.arduino.cli.commands.v1.LibraryCheckExamplesResult.checks:type_name -> cc.arduino.cli.commands.v1.LibraryExampleCheck
+	25, // 19: cc.arduino.cli.commands.v1.LibraryPropertiesGetResponse.properties:type_name -> cc.arduino.cli.commands.v1.LibraryProperty
+	25, // 20: cc.arduino.cli.commands.v1.LibraryPropertiesSetRequest.properties:type_name -> cc.arduino.cli.commands.v1.LibraryProperty
+	3,  // 21: cc.arduino.cli.commands.v1.LibraryPropertiesSetRequest.bump_version:type_name -> cc.arduino.cli.commands.v1.LibraryVersionBump
+	25, // 22: cc.arduino.cli.commands.v1.LibraryPropertiesSetResponse.properties:type_name -> cc.arduino.cli.commands.v1.LibraryProperty
+	25, // 23: cc.arduino.cli.commands.v1.LibraryPropertiesSetResponse.changed:type_name -> cc.arduino.cli.commands.v1.LibraryProperty
+	64, // 24: cc.arduino.cli.commands.v1.LibraryUpgradeAllRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	65, // 25: cc.arduino.cli.commands.v1.LibraryUpgradeAllResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
+	66, // 26: cc.arduino.cli.commands.v1.LibraryUpgradeAllResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
+	64, // 27: cc.arduino.cli.commands.v1.LibraryResolveDependenciesRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	0,  // 28: cc.arduino.cli.commands.v1.LibraryResolveDependenciesRequest.resolution:type_name -> cc.arduino.cli.commands.v1.LibraryResolutionStrategy
+	34, // 29: cc.arduino.cli.commands.v1.LibraryResolveDependenciesResponse.dependencies:type_name -> cc.arduino.cli.commands.v1.LibraryDependencyStatus
+	64, // 30: cc.arduino.cli.commands.v1.LibrarySearchRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	37, // 31: cc.arduino.cli.commands.v1.LibrarySearchResponse.libraries:type_name -> cc.arduino.cli.commands.v1.SearchedLibrary
+	4,  // 32: cc.arduino.cli.commands.v1.LibrarySearchResponse.status:type_name -> cc.arduino.cli.commands.v1.LibrarySearchStatus
+	61, // 33: cc.arduino.cli.commands.v1.SearchedLibrary.releases:type_name -> cc.arduino.cli.commands.v1.SearchedLibrary.ReleasesEntry
+	38, // 34: cc.arduino.cli.commands.v1.SearchedLibrary.latest:type_name -> cc.arduino.cli.commands.v1.LibraryRelease
+	40, // 35: cc.arduino.cli.commands.v1.LibraryRelease.resources:type_name -> cc.arduino.cli.commands.v1.DownloadResource
+	39, // 36: cc.arduino.cli.commands.v1.LibraryRelease.dependencies:type_name -> cc.arduino.cli.commands.v1.LibraryDependency
+	64, // 37: cc.arduino.cli.commands.v1.LibraryListRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	9,  // 38: cc.arduino.cli.commands.v1.LibraryListRequest.locations:type_name -> cc.arduino.cli.commands.v1.LibraryLocation
+	43, // 39: cc.arduino.cli.commands.v1.LibraryListResponse.installed_libraries:type_name -> cc.arduino.cli.commands.v1.InstalledLibrary
+	56, // 40: cc.arduino.cli.commands.v1.InstalledLibrary.library:type_name -> cc.arduino.cli.commands.v1.Library
+	38, // 41: cc.arduino.cli.commands.v1.InstalledLibrary.release:type_name -> cc.arduino.cli.commands.v1.LibraryRelease
+	44, // 42: cc.arduino.cli.commands.v1.InstalledLibrary.usage:type_name -> cc.arduino.cli.commands.v1.LibraryUsage
+	64, // 43: cc.arduino.cli.commands.v1.LibraryUsageRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	43, // 44: cc.arduino.cli.commands.v1.LibraryUsageResponse.libraries:type_name -> cc.arduino.cli.commands.v1.InstalledLibrary
+	64, // 45: cc.arduino.cli.commands.v1.LibraryListConflictsRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	49, // 46: cc.arduino.cli.commands.v1.LibraryListConflictsResponse.conflicts:type_name -> cc.arduino.cli.commands.v1.LibraryConflict
+	56, // 47: cc.arduino.cli.commands.v1.LibraryConflict.selected:type_name -> cc.arduino.cli.commands.v1.Library
+	56, // 48: cc.arduino.cli.commands.v1.LibraryConflict.shadowed:type_name -> cc.arduino.cli.commands.v1.Library
+	64, // 49: cc.arduino.cli.commands.v1.LibraryQueryRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	5,  // 50: cc.arduino.cli.commands.v1.LibraryQueryRequest.filter:type_name -> cc.arduino.cli.commands.v1.LibraryQueryFilter
+	52, // 51: cc.arduino.cli.commands.v1.LibraryQueryResponse.libraries:type_name -> cc.arduino.cli.commands.v1.QueriedLibrary
+	37, // 52: cc.arduino.cli.commands.v1.QueriedLibrary.index_library:type_name -> cc.arduino.cli.commands.v1.SearchedLibrary
+	43, // 53: cc.arduino.cli.commands.v1.QueriedLibrary.installed:type_name -> cc.arduino.cli.commands.v1.InstalledLibrary
+	64, // 54: cc.arduino.cli.commands.v1.LibraryResolveSymbolRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	55, // 55: cc.arduino.cli.commands.v1.LibraryResolveSymbolResponse.declarations:type_name -> cc.arduino.cli.commands.v1.LibrarySymbolDeclaration
+	9,  // 56: cc.arduino.cli.commands.v1.LibrarySymbolDeclaration.location:type_name -> cc.arduino.cli.commands.v1.LibraryLocation
+	6,  // 57: cc.arduino.cli.commands.v1.LibrarySymbolDeclaration.kind:type_name -> cc.arduino.cli.commands.v1.LibrarySymbolKind
+	62, // 58: cc.arduino.cli.commands.v1.Library.properties:type_name -> cc.arduino.cli.commands.v1.Library.PropertiesEntry
+	9,  // 59: cc.arduino.cli.commands.v1.Library.location:type_name -> cc.arduino.cli.commands.v1.LibraryLocation
+	8,  // 60: cc.arduino.cli.commands.v1.Library.layout:type_name -> cc.arduino.cli.commands.v1.LibraryLayout
+	63, // 61: cc.arduino.cli.commands.v1.Library.compatible_with:type_name -> cc.arduino.cli.commands.v1.Library.CompatibleWithEntry
+	7,  // 62: cc.arduino.cli.commands.v1.Library.install_source:type_name -> cc.arduino.cli.commands.v1.LibraryInstallSource
+	64, // 63: cc.arduino.cli.commands.v1.ZipLibraryInstallRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	66, // 64: cc.arduino.cli.commands.v1.ZipLibraryInstallResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
+	64, // 65: cc.arduino.cli.commands.v1.GitLibraryInstallRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	66, // 66: cc.arduino.cli.commands.v1.GitLibraryInstallResponse.task_progress:type_name -> cc.arduino.cli.commands.v1.TaskProgress
+	38, // 67: cc.arduino.cli.commands.v1.SearchedLibrary.ReleasesEntry.value:type_name -> cc.arduino.cli.commands.v1.LibraryRelease
+	68, // [68:68] is the sub-list for method output_type
+	68, // [68:68] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_cc_arduino_cli_commands_v1_lib_proto_init() }
@@ -2745,8 +5458,68 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibraryDownloadResponse); i {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryDownloadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryInstallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryInstallResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryUpgradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryUpgradeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryUninstallRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2757,8 +5530,8 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibraryInstallRequest); i {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryUninstallResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2769,8 +5542,8 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibraryInstallResponse); i {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryPrecompileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2781,8 +5554,8 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibraryUpgradeRequest); i {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryPrecompileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2793,8 +5566,8 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibraryUpgradeResponse); i {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryPrecompileResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2805,8 +5578,8 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibraryUninstallRequest); i {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryCheckExamplesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2817,8 +5590,8 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibraryUninstallResponse); i {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryCheckExamplesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2829,7 +5602,91 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryExampleCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryCheckExamplesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryProperty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryPropertiesGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryPropertiesGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryPropertiesSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryPropertiesSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryUpgradeAllRequest); i {
 			case 0:
 				return &v.state
@@ -2841,7 +5698,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryUpgradeAllResponse); i {
 			case 0:
 				return &v.state
@@ -2853,7 +5710,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryResolveDependenciesRequest); i {
 			case 0:
 				return &v.state
@@ -2865,7 +5722,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryResolveDependenciesResponse); i {
 			case 0:
 				return &v.state
@@ -2877,7 +5734,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryDependencyStatus); i {
 			case 0:
 				return &v.state
@@ -2889,7 +5746,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibrarySearchRequest); i {
 			case 0:
 				return &v.state
@@ -2901,7 +5758,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibrarySearchResponse); i {
 			case 0:
 				return &v.state
@@ -2913,7 +5770,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchedLibrary); i {
 			case 0:
 				return &v.state
@@ -2925,7 +5782,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryRelease); i {
 			case 0:
 				return &v.state
@@ -2937,7 +5794,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryDependency); i {
 			case 0:
 				return &v.state
@@ -2949,7 +5806,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadResource); i {
 			case 0:
 				return &v.state
@@ -2961,7 +5818,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryListRequest); i {
 			case 0:
 				return &v.state
@@ -2973,7 +5830,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LibraryListResponse); i {
 			case 0:
 				return &v.state
@@ -2985,7 +5842,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledLibrary); i {
 			case 0:
 				return &v.state
@@ -2997,7 +5854,151 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryListConflictsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryListConflictsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueriedLibrary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryResolveSymbolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryResolveSymbolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibrarySymbolDeclaration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Library); i {
 			case 0:
 				return &v.state
@@ -3009,7 +6010,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ZipLibraryInstallRequest); i {
 			case 0:
 				return &v.state
@@ -3021,7 +6022,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ZipLibraryInstallResponse); i {
 			case 0:
 				return &v.state
@@ -3033,7 +6034,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GitLibraryInstallRequest); i {
 			case 0:
 				return &v.state
@@ -3045,7 +6046,7 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 				return nil
 			}
 		}
-		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GitLibraryInstallResponse); i {
 			case 0:
 				return &v.state
@@ -3058,13 +6059,24 @@ func file_cc_arduino_cli_commands_v1_lib_proto_init() {
 			}
 		}
 	}
+	file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*LibraryPrecompileResponse_OutStream)(nil),
+		(*LibraryPrecompileResponse_ErrStream)(nil),
+		(*LibraryPrecompileResponse_Result)(nil),
+	}
+	file_cc_arduino_cli_commands_v1_lib_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*LibraryCheckExamplesResponse_OutStream)(nil),
+		(*LibraryCheckExamplesResponse_ErrStream)(nil),
+		(*LibraryCheckExamplesResponse_Check)(nil),
+		(*LibraryCheckExamplesResponse_Result)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cc_arduino_cli_commands_v1_lib_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   30,
+			NumEnums:      10,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
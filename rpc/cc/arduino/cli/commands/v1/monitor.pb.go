@@ -185,6 +185,10 @@ type MonitorResponse struct {
 	// A message with this field set to true is sent as soon as the port is
 	// succesfully opened
 	Success bool `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Sent, at the position of the gap in the rx_data, when the data received
+	// from the port has been dropped because the receive buffer of the monitor
+	// was full.
+	Overrun *MonitorOverrun `protobuf:"bytes,5,opt,name=overrun,proto3" json:"overrun,omitempty"`
 }
 
 func (x *MonitorResponse) Reset() {
@@ -247,6 +251,70 @@ func (x *MonitorResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *MonitorResponse) GetOverrun() *MonitorOverrun {
+	if x != nil {
+		return x.Overrun
+	}
+	return nil
+}
+
+type MonitorOverrun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of bytes dropped in this gap.
+	DroppedBytes uint64 `protobuf:"varint,1,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	// Number of bytes dropped since the port was opened.
+	TotalDroppedBytes uint64 `protobuf:"varint,2,opt,name=total_dropped_bytes,json=totalDroppedBytes,proto3" json:"total_dropped_bytes,omitempty"`
+}
+
+func (x *MonitorOverrun) Reset() {
+	*x = MonitorOverrun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorOverrun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorOverrun) ProtoMessage() {}
+
+func (x *MonitorOverrun) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorOverrun.ProtoReflect.Descriptor instead.
+func (*MonitorOverrun) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MonitorOverrun) GetDroppedBytes() uint64 {
+	if x != nil {
+		return x.DroppedBytes
+	}
+	return 0
+}
+
+func (x *MonitorOverrun) GetTotalDroppedBytes() uint64 {
+	if x != nil {
+		return x.TotalDroppedBytes
+	}
+	return 0
+}
+
 type MonitorPortSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -259,7 +327,7 @@ type MonitorPortSetting struct {
 func (x *MonitorPortSetting) Reset() {
 	*x = MonitorPortSetting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[3]
+		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -272,7 +340,7 @@ func (x *MonitorPortSetting) String() string {
 func (*MonitorPortSetting) ProtoMessage() {}
 
 func (x *MonitorPortSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[3]
+	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +353,7 @@ func (x *MonitorPortSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonitorPortSetting.ProtoReflect.Descriptor instead.
 func (*MonitorPortSetting) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{3}
+	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MonitorPortSetting) GetSettingId() string {
@@ -302,6 +370,339 @@ func (x *MonitorPortSetting) GetValue() string {
 	return ""
 }
 
+type PortIORequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*PortIORequest_OpenRequest
+	//	*PortIORequest_TxData
+	//	*PortIORequest_RxWindowUpdate
+	//	*PortIORequest_PortConfiguration
+	Message isPortIORequest_Message `protobuf_oneof:"message"`
+}
+
+func (x *PortIORequest) Reset() {
+	*x = PortIORequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortIORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortIORequest) ProtoMessage() {}
+
+func (x *PortIORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortIORequest.ProtoReflect.Descriptor instead.
+func (*PortIORequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *PortIORequest) GetMessage() isPortIORequest_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *PortIORequest) GetOpenRequest() *PortIOOpenRequest {
+	if x, ok := x.GetMessage().(*PortIORequest_OpenRequest); ok {
+		return x.OpenRequest
+	}
+	return nil
+}
+
+func (x *PortIORequest) GetTxData() []byte {
+	if x, ok := x.GetMessage().(*PortIORequest_TxData); ok {
+		return x.TxData
+	}
+	return nil
+}
+
+func (x *PortIORequest) GetRxWindowUpdate() uint32 {
+	if x, ok := x.GetMessage().(*PortIORequest_RxWindowUpdate); ok {
+		return x.RxWindowUpdate
+	}
+	return 0
+}
+
+func (x *PortIORequest) GetPortConfiguration() *MonitorPortConfiguration {
+	if x, ok := x.GetMessage().(*PortIORequest_PortConfiguration); ok {
+		return x.PortConfiguration
+	}
+	return nil
+}
+
+type isPortIORequest_Message interface {
+	isPortIORequest_Message()
+}
+
+type PortIORequest_OpenRequest struct {
+	// The port to open, must be sent in the first message of the stream.
+	OpenRequest *PortIOOpenRequest `protobuf:"bytes,1,opt,name=open_request,json=openRequest,proto3,oneof"`
+}
+
+type PortIORequest_TxData struct {
+	// Data to write to the port. The next messages are not processed until the
+	// data is written, and a `tx_written` response is sent for each message.
+	TxData []byte `protobuf:"bytes,2,opt,name=tx_data,json=txData,proto3,oneof"`
+}
+
+type PortIORequest_RxWindowUpdate struct {
+	// Number of bytes that the client is ready to receive in addition to the
+	// current receive window.
+	RxWindowUpdate uint32 `protobuf:"varint,3,opt,name=rx_window_update,json=rxWindowUpdate,proto3,oneof"`
+}
+
+type PortIORequest_PortConfiguration struct {
+	// Settings to apply to the port.
+	PortConfiguration *MonitorPortConfiguration `protobuf:"bytes,4,opt,name=port_configuration,json=portConfiguration,proto3,oneof"`
+}
+
+func (*PortIORequest_OpenRequest) isPortIORequest_Message() {}
+
+func (*PortIORequest_TxData) isPortIORequest_Message() {}
+
+func (*PortIORequest_RxWindowUpdate) isPortIORequest_Message() {}
+
+func (*PortIORequest_PortConfiguration) isPortIORequest_Message() {}
+
+type PortIOOpenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Port to open.
+	Port *Port `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	// The board FQBN, optional, used to disambiguate the pluggable monitor of
+	// the port protocol.
+	Fqbn string `protobuf:"bytes,3,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Settings to apply to the port when it's opened, optional.
+	PortConfiguration *MonitorPortConfiguration `protobuf:"bytes,4,opt,name=port_configuration,json=portConfiguration,proto3" json:"port_configuration,omitempty"`
+	// The initial receive window: the number of bytes that the client is ready
+	// to receive. The data is not read from the port while the window is
+	// exhausted, until it's extended with a `rx_window_update`. If 0 the flow
+	// control of the received data is disabled.
+	RxWindow uint32 `protobuf:"varint,5,opt,name=rx_window,json=rxWindow,proto3" json:"rx_window,omitempty"`
+}
+
+func (x *PortIOOpenRequest) Reset() {
+	*x = PortIOOpenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortIOOpenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortIOOpenRequest) ProtoMessage() {}
+
+func (x *PortIOOpenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortIOOpenRequest.ProtoReflect.Descriptor instead.
+func (*PortIOOpenRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PortIOOpenRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *PortIOOpenRequest) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *PortIOOpenRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *PortIOOpenRequest) GetPortConfiguration() *MonitorPortConfiguration {
+	if x != nil {
+		return x.PortConfiguration
+	}
+	return nil
+}
+
+func (x *PortIOOpenRequest) GetRxWindow() uint32 {
+	if x != nil {
+		return x.RxWindow
+	}
+	return 0
+}
+
+type PortIOResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*PortIOResponse_Opened
+	//	*PortIOResponse_RxData
+	//	*PortIOResponse_TxWritten
+	//	*PortIOResponse_AppliedConfiguration
+	//	*PortIOResponse_Overrun
+	Message isPortIOResponse_Message `protobuf_oneof:"message"`
+}
+
+func (x *PortIOResponse) Reset() {
+	*x = PortIOResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortIOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortIOResponse) ProtoMessage() {}
+
+func (x *PortIOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortIOResponse.ProtoReflect.Descriptor instead.
+func (*PortIOResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *PortIOResponse) GetMessage() isPortIOResponse_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *PortIOResponse) GetOpened() bool {
+	if x, ok := x.GetMessage().(*PortIOResponse_Opened); ok {
+		return x.Opened
+	}
+	return false
+}
+
+func (x *PortIOResponse) GetRxData() []byte {
+	if x, ok := x.GetMessage().(*PortIOResponse_RxData); ok {
+		return x.RxData
+	}
+	return nil
+}
+
+func (x *PortIOResponse) GetTxWritten() uint32 {
+	if x, ok := x.GetMessage().(*PortIOResponse_TxWritten); ok {
+		return x.TxWritten
+	}
+	return 0
+}
+
+func (x *PortIOResponse) GetAppliedConfiguration() *MonitorPortConfiguration {
+	if x, ok := x.GetMessage().(*PortIOResponse_AppliedConfiguration); ok {
+		return x.AppliedConfiguration
+	}
+	return nil
+}
+
+func (x *PortIOResponse) GetOverrun() *MonitorOverrun {
+	if x, ok := x.GetMessage().(*PortIOResponse_Overrun); ok {
+		return x.Overrun
+	}
+	return nil
+}
+
+type isPortIOResponse_Message interface {
+	isPortIOResponse_Message()
+}
+
+type PortIOResponse_Opened struct {
+	// Sent when the port is opened.
+	Opened bool `protobuf:"varint,1,opt,name=opened,proto3,oneof"`
+}
+
+type PortIOResponse_RxData struct {
+	// Data received from the port.
+	RxData []byte `protobuf:"bytes,2,opt,name=rx_data,json=rxData,proto3,oneof"`
+}
+
+type PortIOResponse_TxWritten struct {
+	// Number of bytes written to the port, sent when the data of a `tx_data`
+	// request has been written.
+	TxWritten uint32 `protobuf:"varint,3,opt,name=tx_written,json=txWritten,proto3,oneof"`
+}
+
+type PortIOResponse_AppliedConfiguration struct {
+	// Settings applied to the port after a `port_configuration` request.
+	AppliedConfiguration *MonitorPortConfiguration `protobuf:"bytes,4,opt,name=applied_configuration,json=appliedConfiguration,proto3,oneof"`
+}
+
+type PortIOResponse_Overrun struct {
+	// Sent, at the position of the gap in the rx_data, when the data received
+	// from the port has been dropped because the receive buffer was full.
+	Overrun *MonitorOverrun `protobuf:"bytes,5,opt,name=overrun,proto3,oneof"`
+}
+
+func (*PortIOResponse_Opened) isPortIOResponse_Message() {}
+
+func (*PortIOResponse_RxData) isPortIOResponse_Message() {}
+
+func (*PortIOResponse_TxWritten) isPortIOResponse_Message() {}
+
+func (*PortIOResponse_AppliedConfiguration) isPortIOResponse_Message() {}
+
+func (*PortIOResponse_Overrun) isPortIOResponse_Message() {}
+
 type EnumerateMonitorPortSettingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -320,7 +721,7 @@ type EnumerateMonitorPortSettingsRequest struct {
 func (x *EnumerateMonitorPortSettingsRequest) Reset() {
 	*x = EnumerateMonitorPortSettingsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[4]
+		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -333,7 +734,7 @@ func (x *EnumerateMonitorPortSettingsRequest) String() string {
 func (*EnumerateMonitorPortSettingsRequest) ProtoMessage() {}
 
 func (x *EnumerateMonitorPortSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[4]
+	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +747,7 @@ func (x *EnumerateMonitorPortSettingsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use EnumerateMonitorPortSettingsRequest.ProtoReflect.Descriptor instead.
 func (*EnumerateMonitorPortSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{4}
+	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EnumerateMonitorPortSettingsRequest) GetInstance() *Instance {
@@ -383,7 +784,7 @@ type EnumerateMonitorPortSettingsResponse struct {
 func (x *EnumerateMonitorPortSettingsResponse) Reset() {
 	*x = EnumerateMonitorPortSettingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[5]
+		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +797,7 @@ func (x *EnumerateMonitorPortSettingsResponse) String() string {
 func (*EnumerateMonitorPortSettingsResponse) ProtoMessage() {}
 
 func (x *EnumerateMonitorPortSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[5]
+	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +810,7 @@ func (x *EnumerateMonitorPortSettingsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use EnumerateMonitorPortSettingsResponse.ProtoReflect.Descriptor instead.
 func (*EnumerateMonitorPortSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{5}
+	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EnumerateMonitorPortSettingsResponse) GetSettings() []*MonitorPortSettingDescriptor {
@@ -439,7 +840,7 @@ type MonitorPortSettingDescriptor struct {
 func (x *MonitorPortSettingDescriptor) Reset() {
 	*x = MonitorPortSettingDescriptor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[6]
+		mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -452,7 +853,7 @@ func (x *MonitorPortSettingDescriptor) String() string {
 func (*MonitorPortSettingDescriptor) ProtoMessage() {}
 
 func (x *MonitorPortSettingDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[6]
+	mi := &file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +866,7 @@ func (x *MonitorPortSettingDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonitorPortSettingDescriptor.ProtoReflect.Descriptor instead.
 func (*MonitorPortSettingDescriptor) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{6}
+	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MonitorPortSettingDescriptor) GetSettingId() string {
@@ -539,7 +940,7 @@ var file_cc_arduino_cli_commands_v1_monitor_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
 	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
 	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0f,
+	0x67, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0f,
 	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61,
@@ -551,45 +952,110 @@ var file_cc_arduino_cli_commands_v1_monitor_proto_rawDesc = []byte{
 	0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
 	0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0x49, 0x0a, 0x12, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f,
-	0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa0,
-	0x01, 0x0a, 0x23, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e,
+	0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x22, 0x65, 0x0a, 0x0e, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x49, 0x0a, 0x12, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9c, 0x02, 0x0a, 0x0d,
+	0x50, 0x6f, 0x72, 0x74, 0x49, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x4f, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x10,
+	0x72, 0x78, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x78, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x11, 0x50,
+	0x6f, 0x72, 0x74, 0x49, 0x4f, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x63, 0x0a, 0x12,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
 	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x72, 0x74,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a,
-	0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62,
-	0x6e, 0x22, 0x7c, 0x0a, 0x24, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x08, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x63,
-	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
-	0x9e, 0x01, 0x0a, 0x1c, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x75,
-	0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
-	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63,
-	0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
-	0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76,
-	0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xa6,
+	0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x72,
+	0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06,
+	0x72, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x09, 0x74, 0x78,
+	0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x6b, 0x0a, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x14,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x75,
+	0x6e, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x42, 0x09, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x23, 0x45, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x22, 0x7c, 0x0a, 0x24, 0x45, 0x6e,
+	0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f,
+	0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x1c, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f,
+	0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -604,31 +1070,43 @@ func file_cc_arduino_cli_commands_v1_monitor_proto_rawDescGZIP() []byte {
 	return file_cc_arduino_cli_commands_v1_monitor_proto_rawDescData
 }
 
-var file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_cc_arduino_cli_commands_v1_monitor_proto_goTypes = []interface{}{
 	(*MonitorRequest)(nil),                       // 0: cc.arduino.cli.commands.v1.MonitorRequest
 	(*MonitorPortConfiguration)(nil),             // 1: cc.arduino.cli.commands.v1.MonitorPortConfiguration
 	(*MonitorResponse)(nil),                      // 2: cc.arduino.cli.commands.v1.MonitorResponse
-	(*MonitorPortSetting)(nil),                   // 3: cc.arduino.cli.commands.v1.MonitorPortSetting
-	(*EnumerateMonitorPortSettingsRequest)(nil),  // 4: cc.arduino.cli.commands.v1.EnumerateMonitorPortSettingsRequest
-	(*EnumerateMonitorPortSettingsResponse)(nil), // 5: cc.arduino.cli.commands.v1.EnumerateMonitorPortSettingsResponse
-	(*MonitorPortSettingDescriptor)(nil),         // 6: cc.arduino.cli.commands.v1.MonitorPortSettingDescriptor
-	(*Instance)(nil),                             // 7: cc.arduino.cli.commands.v1.Instance
-	(*Port)(nil),                                 // 8: cc.arduino.cli.commands.v1.Port
+	(*MonitorOverrun)(nil),                       // 3: cc.arduino.cli.commands.v1.MonitorOverrun
+	(*MonitorPortSetting)(nil),                   // 4: cc.arduino.cli.commands.v1.MonitorPortSetting
+	(*PortIORequest)(nil),                        // 5: cc.arduino.cli.commands.v1.PortIORequest
+	(*PortIOOpenRequest)(nil),                    // 6: cc.arduino.cli.commands.v1.PortIOOpenRequest
+	(*PortIOResponse)(nil),                       // 7: cc.arduino.cli.commands.v1.PortIOResponse
+	(*EnumerateMonitorPortSettingsRequest)(nil),  // 8: cc.arduino.cli.commands.v1.EnumerateMonitorPortSettingsRequest
+	(*EnumerateMonitorPortSettingsResponse)(nil), // 9: cc.arduino.cli.commands.v1.EnumerateMonitorPortSettingsResponse
+	(*MonitorPortSettingDescriptor)(nil),         // 10: cc.arduino.cli.commands.v1.MonitorPortSettingDescriptor
+	(*Instance)(nil),                             // 11: cc.arduino.cli.commands.v1.Instance
+	(*Port)(nil),                                 // 12: cc.arduino.cli.commands.v1.Port
 }
 var file_cc_arduino_cli_commands_v1_monitor_proto_depIdxs = []int32{
-	7, // 0: cc.arduino.cli.commands.v1.MonitorRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	8, // 1: cc.arduino.cli.commands.v1.MonitorRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
-	1, // 2: cc.arduino.cli.commands.v1.MonitorRequest.port_configuration:type_name -> cc.arduino.cli.commands.v1.MonitorPortConfiguration
-	3, // 3: cc.arduino.cli.commands.v1.MonitorPortConfiguration.settings:type_name -> cc.arduino.cli.commands.v1.MonitorPortSetting
-	3, // 4: cc.arduino.cli.commands.v1.MonitorResponse.applied_settings:type_name -> cc.arduino.cli.commands.v1.MonitorPortSetting
-	7, // 5: cc.arduino.cli.commands.v1.EnumerateMonitorPortSettingsRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	6, // 6: cc.arduino.cli.commands.v1.EnumerateMonitorPortSettingsResponse.settings:type_name -> cc.arduino.cli.commands.v1.MonitorPortSettingDescriptor
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	11, // 0: cc.arduino.cli.commands.v1.MonitorRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	12, // 1: cc.arduino.cli.commands.v1.MonitorRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
+	1,  // 2: cc.arduino.cli.commands.v1.MonitorRequest.port_configuration:type_name -> cc.arduino.cli.commands.v1.MonitorPortConfiguration
+	4,  // 3: cc.arduino.cli.commands.v1.MonitorPortConfiguration.settings:type_name -> cc.arduino.cli.commands.v1.MonitorPortSetting
+	4,  // 4: cc.arduino.cli.commands.v1.MonitorResponse.applied_settings:type_name -> cc.arduino.cli.commands.v1.MonitorPortSetting
+	3,  // 5: cc.arduino.cli.commands.v1.MonitorResponse.overrun:type_name -> cc.arduino.cli.commands.v1.MonitorOverrun
+	6,  // 6: cc.arduino.cli.commands.v1.PortIORequest.open_request:type_name -> cc.arduino.cli.commands.v1.PortIOOpenRequest
+	1,  // 7: cc.arduino.cli.commands.v1.PortIORequest.port_configuration:type_name -> cc.arduino.cli.commands.v1.MonitorPortConfiguration
+	11, // 8: cc.arduino.cli.commands.v1.PortIOOpenRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	12, // 9: cc.arduino.cli.commands.v1.PortIOOpenRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
+	1,  // 10: cc.arduino.cli.commands.v1.PortIOOpenRequest.port_configuration:type_name -> cc.arduino.cli.commands.v1.MonitorPortConfiguration
+	1,  // 11: cc.arduino.cli.commands.v1.PortIOResponse.applied_configuration:type_name -> cc.arduino.cli.commands.v1.MonitorPortConfiguration
+	3,  // 12: cc.arduino.cli.commands.v1.PortIOResponse.overrun:type_name -> cc.arduino.cli.commands.v1.MonitorOverrun
+	11, // 13: cc.arduino.cli.commands.v1.EnumerateMonitorPortSettingsRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	10, // 14: cc.arduino.cli.commands.v1.EnumerateMonitorPortSettingsResponse.settings:type_name -> cc.arduino.cli.commands.v1.MonitorPortSettingDescriptor
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_cc_arduino_cli_commands_v1_monitor_proto_init() }
@@ -676,7 +1154,7 @@ func file_cc_arduino_cli_commands_v1_monitor_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorPortSetting); i {
+			switch v := v.(*MonitorOverrun); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -688,7 +1166,7 @@ func file_cc_arduino_cli_commands_v1_monitor_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumerateMonitorPortSettingsRequest); i {
+			switch v := v.(*MonitorPortSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -700,7 +1178,7 @@ func file_cc_arduino_cli_commands_v1_monitor_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumerateMonitorPortSettingsResponse); i {
+			switch v := v.(*PortIORequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -712,6 +1190,54 @@ func file_cc_arduino_cli_commands_v1_monitor_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortIOOpenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortIOResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumerateMonitorPortSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumerateMonitorPortSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MonitorPortSettingDescriptor); i {
 			case 0:
 				return &v.state
@@ -724,13 +1250,26 @@ func file_cc_arduino_cli_commands_v1_monitor_proto_init() {
 			}
 		}
 	}
+	file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*PortIORequest_OpenRequest)(nil),
+		(*PortIORequest_TxData)(nil),
+		(*PortIORequest_RxWindowUpdate)(nil),
+		(*PortIORequest_PortConfiguration)(nil),
+	}
+	file_cc_arduino_cli_commands_v1_monitor_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*PortIOResponse_Opened)(nil),
+		(*PortIOResponse_RxData)(nil),
+		(*PortIOResponse_TxWritten)(nil),
+		(*PortIOResponse_AppliedConfiguration)(nil),
+		(*PortIOResponse_Overrun)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cc_arduino_cli_commands_v1_monitor_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
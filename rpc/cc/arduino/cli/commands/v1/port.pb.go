@@ -129,6 +129,123 @@ func (x *Port) GetHardwareId() string {
 	return ""
 }
 
+// PortBusyError is returned when an operation on a port fails because the
+// port is kept open by other processes
+type PortBusyError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the busy port.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The processes that are keeping the port open.
+	Holders []*PortHolder `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders,omitempty"`
+}
+
+func (x *PortBusyError) Reset() {
+	*x = PortBusyError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_port_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortBusyError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortBusyError) ProtoMessage() {}
+
+func (x *PortBusyError) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_port_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortBusyError.ProtoReflect.Descriptor instead.
+func (*PortBusyError) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_port_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PortBusyError) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PortBusyError) GetHolders() []*PortHolder {
+	if x != nil {
+		return x.Holders
+	}
+	return nil
+}
+
+// PortHolder is a process that keeps a port open
+type PortHolder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The process ID.
+	Pid uint32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// The process name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PortHolder) Reset() {
+	*x = PortHolder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_port_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortHolder) ProtoMessage() {}
+
+func (x *PortHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_port_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortHolder.ProtoReflect.Descriptor instead.
+func (*PortHolder) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_port_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PortHolder) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *PortHolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 var File_cc_arduino_cli_commands_v1_port_proto protoreflect.FileDescriptor
 
 var file_cc_arduino_cli_commands_v1_port_proto_rawDesc = []byte{
@@ -155,12 +272,22 @@ var file_cc_arduino_cli_commands_v1_port_proto_rawDesc = []byte{
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d,
-	0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f,
-	0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x01, 0x22, 0x6b, 0x0a, 0x0d, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x73, 0x79, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x07,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x48,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x32,
+	0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -175,18 +302,21 @@ func file_cc_arduino_cli_commands_v1_port_proto_rawDescGZIP() []byte {
 	return file_cc_arduino_cli_commands_v1_port_proto_rawDescData
 }
 
-var file_cc_arduino_cli_commands_v1_port_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cc_arduino_cli_commands_v1_port_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cc_arduino_cli_commands_v1_port_proto_goTypes = []interface{}{
-	(*Port)(nil), // 0: cc.arduino.cli.commands.v1.Port
-	nil,          // 1: cc.arduino.cli.commands.v1.Port.PropertiesEntry
+	(*Port)(nil),          // 0: cc.arduino.cli.commands.v1.Port
+	(*PortBusyError)(nil), // 1: cc.arduino.cli.commands.v1.PortBusyError
+	(*PortHolder)(nil),    // 2: cc.arduino.cli.commands.v1.PortHolder
+	nil,                   // 3: cc.arduino.cli.commands.v1.Port.PropertiesEntry
 }
 var file_cc_arduino_cli_commands_v1_port_proto_depIdxs = []int32{
-	1, // 0: cc.arduino.cli.commands.v1.Port.properties:type_name -> cc.arduino.cli.commands.v1.Port.PropertiesEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: cc.arduino.cli.commands.v1.Port.properties:type_name -> cc.arduino.cli.commands.v1.Port.PropertiesEntry
+	2, // 1: cc.arduino.cli.commands.v1.PortBusyError.holders:type_name -> cc.arduino.cli.commands.v1.PortHolder
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_cc_arduino_cli_commands_v1_port_proto_init() }
@@ -207,6 +337,30 @@ func file_cc_arduino_cli_commands_v1_port_proto_init() {
 				return nil
 			}
 		}
+		file_cc_arduino_cli_commands_v1_port_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortBusyError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_port_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortHolder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -214,7 +368,7 @@ func file_cc_arduino_cli_commands_v1_port_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cc_arduino_cli_commands_v1_port_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
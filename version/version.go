@@ -18,6 +18,7 @@ package version
 import (
 	"os"
 	"path/filepath"
+	"runtime/debug"
 
 	"github.com/arduino/arduino-cli/i18n"
 )
@@ -69,6 +70,18 @@ func init() {
 	if versionString == "" {
 		versionString = defaultVersionString
 	}
+	// Fallback to the VCS data stamped by the go toolchain when the build
+	// didn't inject them
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && commit == "":
+				commit = setting.Value
+			case setting.Key == "vcs.time" && date == "":
+				date = setting.Value
+			}
+		}
+	}
 
 	VersionInfo = NewInfo(filepath.Base(os.Args[0]))
 }